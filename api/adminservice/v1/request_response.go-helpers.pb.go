@@ -3519,3 +3519,2038 @@ func (this *MigrateScheduleResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type DescribeOutboundDestinationHealthRequest to the protobuf v3 wire format
+func (val *DescribeOutboundDestinationHealthRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeOutboundDestinationHealthRequest from the protobuf v3 wire format
+func (val *DescribeOutboundDestinationHealthRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeOutboundDestinationHealthRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeOutboundDestinationHealthRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeOutboundDestinationHealthRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeOutboundDestinationHealthRequest
+	switch t := that.(type) {
+	case *DescribeOutboundDestinationHealthRequest:
+		that1 = t
+	case DescribeOutboundDestinationHealthRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeOutboundDestinationHealthResponse to the protobuf v3 wire format
+func (val *DescribeOutboundDestinationHealthResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeOutboundDestinationHealthResponse from the protobuf v3 wire format
+func (val *DescribeOutboundDestinationHealthResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeOutboundDestinationHealthResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeOutboundDestinationHealthResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeOutboundDestinationHealthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeOutboundDestinationHealthResponse
+	switch t := that.(type) {
+	case *DescribeOutboundDestinationHealthResponse:
+		that1 = t
+	case DescribeOutboundDestinationHealthResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateOutboundCircuitBreakerRequest to the protobuf v3 wire format
+func (val *UpdateOutboundCircuitBreakerRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateOutboundCircuitBreakerRequest from the protobuf v3 wire format
+func (val *UpdateOutboundCircuitBreakerRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateOutboundCircuitBreakerRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateOutboundCircuitBreakerRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateOutboundCircuitBreakerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateOutboundCircuitBreakerRequest
+	switch t := that.(type) {
+	case *UpdateOutboundCircuitBreakerRequest:
+		that1 = t
+	case UpdateOutboundCircuitBreakerRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateOutboundCircuitBreakerResponse to the protobuf v3 wire format
+func (val *UpdateOutboundCircuitBreakerResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateOutboundCircuitBreakerResponse from the protobuf v3 wire format
+func (val *UpdateOutboundCircuitBreakerResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateOutboundCircuitBreakerResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateOutboundCircuitBreakerResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateOutboundCircuitBreakerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateOutboundCircuitBreakerResponse
+	switch t := that.(type) {
+	case *UpdateOutboundCircuitBreakerResponse:
+		that1 = t
+	case UpdateOutboundCircuitBreakerResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeNexusEndpointRequest to the protobuf v3 wire format
+func (val *DescribeNexusEndpointRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNexusEndpointRequest from the protobuf v3 wire format
+func (val *DescribeNexusEndpointRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNexusEndpointRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNexusEndpointRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNexusEndpointRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNexusEndpointRequest
+	switch t := that.(type) {
+	case *DescribeNexusEndpointRequest:
+		that1 = t
+	case DescribeNexusEndpointRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeNexusEndpointResponse to the protobuf v3 wire format
+func (val *DescribeNexusEndpointResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNexusEndpointResponse from the protobuf v3 wire format
+func (val *DescribeNexusEndpointResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNexusEndpointResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNexusEndpointResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNexusEndpointResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNexusEndpointResponse
+	switch t := that.(type) {
+	case *DescribeNexusEndpointResponse:
+		that1 = t
+	case DescribeNexusEndpointResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointHttpTargetRequest to the protobuf v3 wire format
+func (val *UpdateNexusEndpointHttpTargetRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointHttpTargetRequest from the protobuf v3 wire format
+func (val *UpdateNexusEndpointHttpTargetRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointHttpTargetRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointHttpTargetRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointHttpTargetRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointHttpTargetRequest
+	switch t := that.(type) {
+	case *UpdateNexusEndpointHttpTargetRequest:
+		that1 = t
+	case UpdateNexusEndpointHttpTargetRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNexusEndpointHttpTargetResponse to the protobuf v3 wire format
+func (val *UpdateNexusEndpointHttpTargetResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNexusEndpointHttpTargetResponse from the protobuf v3 wire format
+func (val *UpdateNexusEndpointHttpTargetResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNexusEndpointHttpTargetResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNexusEndpointHttpTargetResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNexusEndpointHttpTargetResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNexusEndpointHttpTargetResponse
+	switch t := that.(type) {
+	case *UpdateNexusEndpointHttpTargetResponse:
+		that1 = t
+	case UpdateNexusEndpointHttpTargetResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeadLetteredCallbackMessage to the protobuf v3 wire format
+func (val *DeadLetteredCallbackMessage) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeadLetteredCallbackMessage from the protobuf v3 wire format
+func (val *DeadLetteredCallbackMessage) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeadLetteredCallbackMessage) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeadLetteredCallbackMessage values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeadLetteredCallbackMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeadLetteredCallbackMessage
+	switch t := that.(type) {
+	case *DeadLetteredCallbackMessage:
+		that1 = t
+	case DeadLetteredCallbackMessage:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDeadLetteredCallbacksRequest to the protobuf v3 wire format
+func (val *ListDeadLetteredCallbacksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDeadLetteredCallbacksRequest from the protobuf v3 wire format
+func (val *ListDeadLetteredCallbacksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDeadLetteredCallbacksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDeadLetteredCallbacksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDeadLetteredCallbacksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDeadLetteredCallbacksRequest
+	switch t := that.(type) {
+	case *ListDeadLetteredCallbacksRequest:
+		that1 = t
+	case ListDeadLetteredCallbacksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDeadLetteredCallbacksResponse to the protobuf v3 wire format
+func (val *ListDeadLetteredCallbacksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDeadLetteredCallbacksResponse from the protobuf v3 wire format
+func (val *ListDeadLetteredCallbacksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDeadLetteredCallbacksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDeadLetteredCallbacksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDeadLetteredCallbacksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDeadLetteredCallbacksResponse
+	switch t := that.(type) {
+	case *ListDeadLetteredCallbacksResponse:
+		that1 = t
+	case ListDeadLetteredCallbacksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDeadLetteredCallbackRequest to the protobuf v3 wire format
+func (val *GetDeadLetteredCallbackRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDeadLetteredCallbackRequest from the protobuf v3 wire format
+func (val *GetDeadLetteredCallbackRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDeadLetteredCallbackRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDeadLetteredCallbackRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDeadLetteredCallbackRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDeadLetteredCallbackRequest
+	switch t := that.(type) {
+	case *GetDeadLetteredCallbackRequest:
+		that1 = t
+	case GetDeadLetteredCallbackRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDeadLetteredCallbackResponse to the protobuf v3 wire format
+func (val *GetDeadLetteredCallbackResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDeadLetteredCallbackResponse from the protobuf v3 wire format
+func (val *GetDeadLetteredCallbackResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDeadLetteredCallbackResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDeadLetteredCallbackResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDeadLetteredCallbackResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDeadLetteredCallbackResponse
+	switch t := that.(type) {
+	case *GetDeadLetteredCallbackResponse:
+		that1 = t
+	case GetDeadLetteredCallbackResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReplayDeadLetteredCallbacksRequest to the protobuf v3 wire format
+func (val *ReplayDeadLetteredCallbacksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplayDeadLetteredCallbacksRequest from the protobuf v3 wire format
+func (val *ReplayDeadLetteredCallbacksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplayDeadLetteredCallbacksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplayDeadLetteredCallbacksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplayDeadLetteredCallbacksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplayDeadLetteredCallbacksRequest
+	switch t := that.(type) {
+	case *ReplayDeadLetteredCallbacksRequest:
+		that1 = t
+	case ReplayDeadLetteredCallbacksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReplayDeadLetteredCallbacksResponse to the protobuf v3 wire format
+func (val *ReplayDeadLetteredCallbacksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplayDeadLetteredCallbacksResponse from the protobuf v3 wire format
+func (val *ReplayDeadLetteredCallbacksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplayDeadLetteredCallbacksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplayDeadLetteredCallbacksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplayDeadLetteredCallbacksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplayDeadLetteredCallbacksResponse
+	switch t := that.(type) {
+	case *ReplayDeadLetteredCallbacksResponse:
+		that1 = t
+	case ReplayDeadLetteredCallbacksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeDeadLetteredCallbacksRequest to the protobuf v3 wire format
+func (val *PurgeDeadLetteredCallbacksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeDeadLetteredCallbacksRequest from the protobuf v3 wire format
+func (val *PurgeDeadLetteredCallbacksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeDeadLetteredCallbacksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeDeadLetteredCallbacksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeDeadLetteredCallbacksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeDeadLetteredCallbacksRequest
+	switch t := that.(type) {
+	case *PurgeDeadLetteredCallbacksRequest:
+		that1 = t
+	case PurgeDeadLetteredCallbacksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeDeadLetteredCallbacksResponse to the protobuf v3 wire format
+func (val *PurgeDeadLetteredCallbacksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeDeadLetteredCallbacksResponse from the protobuf v3 wire format
+func (val *PurgeDeadLetteredCallbacksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeDeadLetteredCallbacksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeDeadLetteredCallbacksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeDeadLetteredCallbacksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeDeadLetteredCallbacksResponse
+	switch t := that.(type) {
+	case *PurgeDeadLetteredCallbacksResponse:
+		that1 = t
+	case PurgeDeadLetteredCallbacksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RotateCallbackSigningKeyRequest to the protobuf v3 wire format
+func (val *RotateCallbackSigningKeyRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RotateCallbackSigningKeyRequest from the protobuf v3 wire format
+func (val *RotateCallbackSigningKeyRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RotateCallbackSigningKeyRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RotateCallbackSigningKeyRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RotateCallbackSigningKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RotateCallbackSigningKeyRequest
+	switch t := that.(type) {
+	case *RotateCallbackSigningKeyRequest:
+		that1 = t
+	case RotateCallbackSigningKeyRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RotateCallbackSigningKeyResponse to the protobuf v3 wire format
+func (val *RotateCallbackSigningKeyResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RotateCallbackSigningKeyResponse from the protobuf v3 wire format
+func (val *RotateCallbackSigningKeyResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RotateCallbackSigningKeyResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RotateCallbackSigningKeyResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RotateCallbackSigningKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RotateCallbackSigningKeyResponse
+	switch t := that.(type) {
+	case *RotateCallbackSigningKeyResponse:
+		that1 = t
+	case RotateCallbackSigningKeyResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListCallbackSigningKeysRequest to the protobuf v3 wire format
+func (val *ListCallbackSigningKeysRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListCallbackSigningKeysRequest from the protobuf v3 wire format
+func (val *ListCallbackSigningKeysRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListCallbackSigningKeysRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListCallbackSigningKeysRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListCallbackSigningKeysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListCallbackSigningKeysRequest
+	switch t := that.(type) {
+	case *ListCallbackSigningKeysRequest:
+		that1 = t
+	case ListCallbackSigningKeysRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListCallbackSigningKeysResponse to the protobuf v3 wire format
+func (val *ListCallbackSigningKeysResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListCallbackSigningKeysResponse from the protobuf v3 wire format
+func (val *ListCallbackSigningKeysResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListCallbackSigningKeysResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListCallbackSigningKeysResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListCallbackSigningKeysResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListCallbackSigningKeysResponse
+	switch t := that.(type) {
+	case *ListCallbackSigningKeysResponse:
+		that1 = t
+	case ListCallbackSigningKeysResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteCallbackSigningKeyRequest to the protobuf v3 wire format
+func (val *DeleteCallbackSigningKeyRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteCallbackSigningKeyRequest from the protobuf v3 wire format
+func (val *DeleteCallbackSigningKeyRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteCallbackSigningKeyRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteCallbackSigningKeyRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteCallbackSigningKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteCallbackSigningKeyRequest
+	switch t := that.(type) {
+	case *DeleteCallbackSigningKeyRequest:
+		that1 = t
+	case DeleteCallbackSigningKeyRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteCallbackSigningKeyResponse to the protobuf v3 wire format
+func (val *DeleteCallbackSigningKeyResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteCallbackSigningKeyResponse from the protobuf v3 wire format
+func (val *DeleteCallbackSigningKeyResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteCallbackSigningKeyResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteCallbackSigningKeyResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteCallbackSigningKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteCallbackSigningKeyResponse
+	switch t := that.(type) {
+	case *DeleteCallbackSigningKeyResponse:
+		that1 = t
+	case DeleteCallbackSigningKeyResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribePersistenceConcurrencyLimiterRequest to the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribePersistenceConcurrencyLimiterRequest from the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribePersistenceConcurrencyLimiterRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribePersistenceConcurrencyLimiterRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribePersistenceConcurrencyLimiterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribePersistenceConcurrencyLimiterRequest
+	switch t := that.(type) {
+	case *DescribePersistenceConcurrencyLimiterRequest:
+		that1 = t
+	case DescribePersistenceConcurrencyLimiterRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribePersistenceConcurrencyLimiterResponse to the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribePersistenceConcurrencyLimiterResponse from the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribePersistenceConcurrencyLimiterResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribePersistenceConcurrencyLimiterResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribePersistenceConcurrencyLimiterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribePersistenceConcurrencyLimiterResponse
+	switch t := that.(type) {
+	case *DescribePersistenceConcurrencyLimiterResponse:
+		that1 = t
+	case DescribePersistenceConcurrencyLimiterResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateFaultInjectionRequest to the protobuf v3 wire format
+func (val *UpdateFaultInjectionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateFaultInjectionRequest from the protobuf v3 wire format
+func (val *UpdateFaultInjectionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateFaultInjectionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateFaultInjectionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateFaultInjectionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateFaultInjectionRequest
+	switch t := that.(type) {
+	case *UpdateFaultInjectionRequest:
+		that1 = t
+	case UpdateFaultInjectionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateFaultInjectionResponse to the protobuf v3 wire format
+func (val *UpdateFaultInjectionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateFaultInjectionResponse from the protobuf v3 wire format
+func (val *UpdateFaultInjectionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateFaultInjectionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateFaultInjectionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateFaultInjectionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateFaultInjectionResponse
+	switch t := that.(type) {
+	case *UpdateFaultInjectionResponse:
+		that1 = t
+	case UpdateFaultInjectionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeFaultInjectionRequest to the protobuf v3 wire format
+func (val *DescribeFaultInjectionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeFaultInjectionRequest from the protobuf v3 wire format
+func (val *DescribeFaultInjectionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeFaultInjectionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeFaultInjectionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeFaultInjectionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeFaultInjectionRequest
+	switch t := that.(type) {
+	case *DescribeFaultInjectionRequest:
+		that1 = t
+	case DescribeFaultInjectionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeFaultInjectionResponse to the protobuf v3 wire format
+func (val *DescribeFaultInjectionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeFaultInjectionResponse from the protobuf v3 wire format
+func (val *DescribeFaultInjectionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeFaultInjectionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeFaultInjectionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeFaultInjectionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeFaultInjectionResponse
+	switch t := that.(type) {
+	case *DescribeFaultInjectionResponse:
+		that1 = t
+	case DescribeFaultInjectionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeReplicationLagRequest to the protobuf v3 wire format
+func (val *DescribeReplicationLagRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeReplicationLagRequest from the protobuf v3 wire format
+func (val *DescribeReplicationLagRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeReplicationLagRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeReplicationLagRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeReplicationLagRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeReplicationLagRequest
+	switch t := that.(type) {
+	case *DescribeReplicationLagRequest:
+		that1 = t
+	case DescribeReplicationLagRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeReplicationLagResponse to the protobuf v3 wire format
+func (val *DescribeReplicationLagResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeReplicationLagResponse from the protobuf v3 wire format
+func (val *DescribeReplicationLagResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeReplicationLagResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeReplicationLagResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeReplicationLagResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeReplicationLagResponse
+	switch t := that.(type) {
+	case *DescribeReplicationLagResponse:
+		that1 = t
+	case DescribeReplicationLagResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReplicationDLQSize to the protobuf v3 wire format
+func (val *ReplicationDLQSize) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplicationDLQSize from the protobuf v3 wire format
+func (val *ReplicationDLQSize) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplicationDLQSize) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplicationDLQSize values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplicationDLQSize) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplicationDLQSize
+	switch t := that.(type) {
+	case *ReplicationDLQSize:
+		that1 = t
+	case ReplicationDLQSize:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetWorkerScalingRecommendationRequest to the protobuf v3 wire format
+func (val *GetWorkerScalingRecommendationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetWorkerScalingRecommendationRequest from the protobuf v3 wire format
+func (val *GetWorkerScalingRecommendationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetWorkerScalingRecommendationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetWorkerScalingRecommendationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetWorkerScalingRecommendationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetWorkerScalingRecommendationRequest
+	switch t := that.(type) {
+	case *GetWorkerScalingRecommendationRequest:
+		that1 = t
+	case GetWorkerScalingRecommendationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetWorkerScalingRecommendationResponse to the protobuf v3 wire format
+func (val *GetWorkerScalingRecommendationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetWorkerScalingRecommendationResponse from the protobuf v3 wire format
+func (val *GetWorkerScalingRecommendationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetWorkerScalingRecommendationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetWorkerScalingRecommendationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetWorkerScalingRecommendationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetWorkerScalingRecommendationResponse
+	switch t := that.(type) {
+	case *GetWorkerScalingRecommendationResponse:
+		that1 = t
+	case GetWorkerScalingRecommendationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartWorkerDeploymentRolloutRequest to the protobuf v3 wire format
+func (val *StartWorkerDeploymentRolloutRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartWorkerDeploymentRolloutRequest from the protobuf v3 wire format
+func (val *StartWorkerDeploymentRolloutRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartWorkerDeploymentRolloutRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartWorkerDeploymentRolloutRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartWorkerDeploymentRolloutRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartWorkerDeploymentRolloutRequest
+	switch t := that.(type) {
+	case *StartWorkerDeploymentRolloutRequest:
+		that1 = t
+	case StartWorkerDeploymentRolloutRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartWorkerDeploymentRolloutResponse to the protobuf v3 wire format
+func (val *StartWorkerDeploymentRolloutResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartWorkerDeploymentRolloutResponse from the protobuf v3 wire format
+func (val *StartWorkerDeploymentRolloutResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartWorkerDeploymentRolloutResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartWorkerDeploymentRolloutResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartWorkerDeploymentRolloutResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartWorkerDeploymentRolloutResponse
+	switch t := that.(type) {
+	case *StartWorkerDeploymentRolloutResponse:
+		that1 = t
+	case StartWorkerDeploymentRolloutResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateWorkerDeploymentRolloutRequest to the protobuf v3 wire format
+func (val *UpdateWorkerDeploymentRolloutRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateWorkerDeploymentRolloutRequest from the protobuf v3 wire format
+func (val *UpdateWorkerDeploymentRolloutRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateWorkerDeploymentRolloutRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateWorkerDeploymentRolloutRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateWorkerDeploymentRolloutRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateWorkerDeploymentRolloutRequest
+	switch t := that.(type) {
+	case *UpdateWorkerDeploymentRolloutRequest:
+		that1 = t
+	case UpdateWorkerDeploymentRolloutRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateWorkerDeploymentRolloutResponse to the protobuf v3 wire format
+func (val *UpdateWorkerDeploymentRolloutResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateWorkerDeploymentRolloutResponse from the protobuf v3 wire format
+func (val *UpdateWorkerDeploymentRolloutResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateWorkerDeploymentRolloutResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateWorkerDeploymentRolloutResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateWorkerDeploymentRolloutResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateWorkerDeploymentRolloutResponse
+	switch t := that.(type) {
+	case *UpdateWorkerDeploymentRolloutResponse:
+		that1 = t
+	case UpdateWorkerDeploymentRolloutResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkerDeploymentRolloutRequest to the protobuf v3 wire format
+func (val *DescribeWorkerDeploymentRolloutRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkerDeploymentRolloutRequest from the protobuf v3 wire format
+func (val *DescribeWorkerDeploymentRolloutRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkerDeploymentRolloutRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkerDeploymentRolloutRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkerDeploymentRolloutRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkerDeploymentRolloutRequest
+	switch t := that.(type) {
+	case *DescribeWorkerDeploymentRolloutRequest:
+		that1 = t
+	case DescribeWorkerDeploymentRolloutRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkerDeploymentRolloutResponse to the protobuf v3 wire format
+func (val *DescribeWorkerDeploymentRolloutResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkerDeploymentRolloutResponse from the protobuf v3 wire format
+func (val *DescribeWorkerDeploymentRolloutResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkerDeploymentRolloutResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkerDeploymentRolloutResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkerDeploymentRolloutResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkerDeploymentRolloutResponse
+	switch t := that.(type) {
+	case *DescribeWorkerDeploymentRolloutResponse:
+		that1 = t
+	case DescribeWorkerDeploymentRolloutResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RenameSearchAttributeAliasRequest to the protobuf v3 wire format
+func (val *RenameSearchAttributeAliasRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RenameSearchAttributeAliasRequest from the protobuf v3 wire format
+func (val *RenameSearchAttributeAliasRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RenameSearchAttributeAliasRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RenameSearchAttributeAliasRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RenameSearchAttributeAliasRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RenameSearchAttributeAliasRequest
+	switch t := that.(type) {
+	case *RenameSearchAttributeAliasRequest:
+		that1 = t
+	case RenameSearchAttributeAliasRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RenameSearchAttributeAliasResponse to the protobuf v3 wire format
+func (val *RenameSearchAttributeAliasResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RenameSearchAttributeAliasResponse from the protobuf v3 wire format
+func (val *RenameSearchAttributeAliasResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RenameSearchAttributeAliasResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RenameSearchAttributeAliasResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RenameSearchAttributeAliasResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RenameSearchAttributeAliasResponse
+	switch t := that.(type) {
+	case *RenameSearchAttributeAliasResponse:
+		that1 = t
+	case RenameSearchAttributeAliasResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MigrateSearchAttributeTypeRequest to the protobuf v3 wire format
+func (val *MigrateSearchAttributeTypeRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MigrateSearchAttributeTypeRequest from the protobuf v3 wire format
+func (val *MigrateSearchAttributeTypeRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MigrateSearchAttributeTypeRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MigrateSearchAttributeTypeRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MigrateSearchAttributeTypeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MigrateSearchAttributeTypeRequest
+	switch t := that.(type) {
+	case *MigrateSearchAttributeTypeRequest:
+		that1 = t
+	case MigrateSearchAttributeTypeRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MigrateSearchAttributeTypeResponse to the protobuf v3 wire format
+func (val *MigrateSearchAttributeTypeResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MigrateSearchAttributeTypeResponse from the protobuf v3 wire format
+func (val *MigrateSearchAttributeTypeResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MigrateSearchAttributeTypeResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MigrateSearchAttributeTypeResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MigrateSearchAttributeTypeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MigrateSearchAttributeTypeResponse
+	switch t := that.(type) {
+	case *MigrateSearchAttributeTypeResponse:
+		that1 = t
+	case MigrateSearchAttributeTypeResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CreateWorkflowRuleRequest to the protobuf v3 wire format
+func (val *CreateWorkflowRuleRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CreateWorkflowRuleRequest from the protobuf v3 wire format
+func (val *CreateWorkflowRuleRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CreateWorkflowRuleRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CreateWorkflowRuleRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CreateWorkflowRuleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CreateWorkflowRuleRequest
+	switch t := that.(type) {
+	case *CreateWorkflowRuleRequest:
+		that1 = t
+	case CreateWorkflowRuleRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CreateWorkflowRuleResponse to the protobuf v3 wire format
+func (val *CreateWorkflowRuleResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CreateWorkflowRuleResponse from the protobuf v3 wire format
+func (val *CreateWorkflowRuleResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CreateWorkflowRuleResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CreateWorkflowRuleResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CreateWorkflowRuleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CreateWorkflowRuleResponse
+	switch t := that.(type) {
+	case *CreateWorkflowRuleResponse:
+		that1 = t
+	case CreateWorkflowRuleResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkflowRuleRequest to the protobuf v3 wire format
+func (val *DescribeWorkflowRuleRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowRuleRequest from the protobuf v3 wire format
+func (val *DescribeWorkflowRuleRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowRuleRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowRuleRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowRuleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowRuleRequest
+	switch t := that.(type) {
+	case *DescribeWorkflowRuleRequest:
+		that1 = t
+	case DescribeWorkflowRuleRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkflowRuleResponse to the protobuf v3 wire format
+func (val *DescribeWorkflowRuleResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowRuleResponse from the protobuf v3 wire format
+func (val *DescribeWorkflowRuleResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowRuleResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowRuleResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowRuleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowRuleResponse
+	switch t := that.(type) {
+	case *DescribeWorkflowRuleResponse:
+		that1 = t
+	case DescribeWorkflowRuleResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkerRequest to the protobuf v3 wire format
+func (val *DescribeWorkerRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkerRequest from the protobuf v3 wire format
+func (val *DescribeWorkerRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkerRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkerRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkerRequest
+	switch t := that.(type) {
+	case *DescribeWorkerRequest:
+		that1 = t
+	case DescribeWorkerRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkerResponse to the protobuf v3 wire format
+func (val *DescribeWorkerResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkerResponse from the protobuf v3 wire format
+func (val *DescribeWorkerResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkerResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkerResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkerResponse
+	switch t := that.(type) {
+	case *DescribeWorkerResponse:
+		that1 = t
+	case DescribeWorkerResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartVisibilityBackfillRequest to the protobuf v3 wire format
+func (val *StartVisibilityBackfillRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartVisibilityBackfillRequest from the protobuf v3 wire format
+func (val *StartVisibilityBackfillRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartVisibilityBackfillRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartVisibilityBackfillRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartVisibilityBackfillRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartVisibilityBackfillRequest
+	switch t := that.(type) {
+	case *StartVisibilityBackfillRequest:
+		that1 = t
+	case StartVisibilityBackfillRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartVisibilityBackfillResponse to the protobuf v3 wire format
+func (val *StartVisibilityBackfillResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartVisibilityBackfillResponse from the protobuf v3 wire format
+func (val *StartVisibilityBackfillResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartVisibilityBackfillResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartVisibilityBackfillResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartVisibilityBackfillResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartVisibilityBackfillResponse
+	switch t := that.(type) {
+	case *StartVisibilityBackfillResponse:
+		that1 = t
+	case StartVisibilityBackfillResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityBackfillRequest to the protobuf v3 wire format
+func (val *DescribeVisibilityBackfillRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityBackfillRequest from the protobuf v3 wire format
+func (val *DescribeVisibilityBackfillRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityBackfillRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityBackfillRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityBackfillRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityBackfillRequest
+	switch t := that.(type) {
+	case *DescribeVisibilityBackfillRequest:
+		that1 = t
+	case DescribeVisibilityBackfillRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityBackfillResponse to the protobuf v3 wire format
+func (val *DescribeVisibilityBackfillResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityBackfillResponse from the protobuf v3 wire format
+func (val *DescribeVisibilityBackfillResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityBackfillResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityBackfillResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityBackfillResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityBackfillResponse
+	switch t := that.(type) {
+	case *DescribeVisibilityBackfillResponse:
+		that1 = t
+	case DescribeVisibilityBackfillResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type VisibilityCountMismatch to the protobuf v3 wire format
+func (val *VisibilityCountMismatch) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityCountMismatch from the protobuf v3 wire format
+func (val *VisibilityCountMismatch) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityCountMismatch) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityCountMismatch values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityCountMismatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityCountMismatch
+	switch t := that.(type) {
+	case *VisibilityCountMismatch:
+		that1 = t
+	case VisibilityCountMismatch:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -13,15 +13,21 @@ import (
 	unsafe "unsafe"
 
 	v1 "go.temporal.io/api/common/v1"
+	v118 "go.temporal.io/api/deployment/v1"
 	v16 "go.temporal.io/api/enums/v1"
 	v110 "go.temporal.io/api/namespace/v1"
+	v116 "go.temporal.io/api/nexus/v1"
 	v111 "go.temporal.io/api/replication/v1"
+	v120 "go.temporal.io/api/rules/v1"
 	v115 "go.temporal.io/api/taskqueue/v1"
 	v19 "go.temporal.io/api/version/v1"
+	v121 "go.temporal.io/api/worker/v1"
 	v17 "go.temporal.io/api/workflow/v1"
 	v18 "go.temporal.io/server/api/cluster/v1"
 	v112 "go.temporal.io/server/api/common/v1"
+	v119 "go.temporal.io/server/api/deployment/v1"
 	v14 "go.temporal.io/server/api/enums/v1"
+	v117 "go.temporal.io/server/api/faultinjection/v1"
 	v113 "go.temporal.io/server/api/health/v1"
 	v11 "go.temporal.io/server/api/history/v1"
 	v13 "go.temporal.io/server/api/namespace/v1"
@@ -3896,8 +3902,10 @@ type GetNamespaceResponse struct {
 	FailoverVersion   int64                            `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
 	FailoverHistory   []*v111.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
 	IsGlobalNamespace bool                             `protobuf:"varint,9,opt,name=is_global_namespace,json=isGlobalNamespace,proto3" json:"is_global_namespace,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Previous aliases of renamed custom search attributes.
+	SearchAttributeAliasTransitions map[string]*v12.SearchAttributeAliasTransition `protobuf:"bytes,10,rep,name=search_attribute_alias_transitions,json=searchAttributeAliasTransitions,proto3" json:"search_attribute_alias_transitions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
 }
 
 func (x *GetNamespaceResponse) Reset() {
@@ -3979,6 +3987,13 @@ func (x *GetNamespaceResponse) GetIsGlobalNamespace() bool {
 	return false
 }
 
+func (x *GetNamespaceResponse) GetSearchAttributeAliasTransitions() map[string]*v12.SearchAttributeAliasTransition {
+	if x != nil {
+		return x.SearchAttributeAliasTransitions
+	}
+	return nil
+}
+
 type GetDLQTasksRequest struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	DlqKey *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
@@ -5795,29 +5810,31 @@ func (*MigrateScheduleResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{94}
 }
 
-type AddTasksRequest_Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Blob          *v1.DataBlob           `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+type DescribeOutboundDestinationHealthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional filters. Empty values match all destinations.
+	Namespace     string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskGroup     string `protobuf:"bytes,2,opt,name=task_group,json=taskGroup,proto3" json:"task_group,omitempty"`
+	Destination   string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTasksRequest_Task) Reset() {
-	*x = AddTasksRequest_Task{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+func (x *DescribeOutboundDestinationHealthRequest) Reset() {
+	*x = DescribeOutboundDestinationHealthRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTasksRequest_Task) String() string {
+func (x *DescribeOutboundDestinationHealthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTasksRequest_Task) ProtoMessage() {}
+func (*DescribeOutboundDestinationHealthRequest) ProtoMessage() {}
 
-func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+func (x *DescribeOutboundDestinationHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5828,49 +5845,123 @@ func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTasksRequest_Task.ProtoReflect.Descriptor instead.
-func (*AddTasksRequest_Task) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74, 0}
+// Deprecated: Use DescribeOutboundDestinationHealthRequest.ProtoReflect.Descriptor instead.
+func (*DescribeOutboundDestinationHealthRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *AddTasksRequest_Task) GetCategoryId() int32 {
+func (x *DescribeOutboundDestinationHealthRequest) GetNamespace() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *AddTasksRequest_Task) GetBlob() *v1.DataBlob {
+func (x *DescribeOutboundDestinationHealthRequest) GetTaskGroup() string {
 	if x != nil {
-		return x.Blob
+		return x.TaskGroup
+	}
+	return ""
+}
+
+func (x *DescribeOutboundDestinationHealthRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+type DescribeOutboundDestinationHealthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Health aggregated across all reachable history hosts, one entry per namespace, task group and destination.
+	Destinations []*v113.OutboundDestinationHealth `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Health as reported by each history host.
+	HostDestinations []*v113.OutboundDestinationHealth `protobuf:"bytes,2,rep,name=host_destinations,json=hostDestinations,proto3" json:"host_destinations,omitempty"`
+	// Addresses of history hosts that could not be reached.
+	UnreachableHosts []string `protobuf:"bytes,3,rep,name=unreachable_hosts,json=unreachableHosts,proto3" json:"unreachable_hosts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DescribeOutboundDestinationHealthResponse) Reset() {
+	*x = DescribeOutboundDestinationHealthResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeOutboundDestinationHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeOutboundDestinationHealthResponse) ProtoMessage() {}
+
+func (x *DescribeOutboundDestinationHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeOutboundDestinationHealthResponse.ProtoReflect.Descriptor instead.
+func (*DescribeOutboundDestinationHealthResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DescribeOutboundDestinationHealthResponse) GetDestinations() []*v113.OutboundDestinationHealth {
+	if x != nil {
+		return x.Destinations
 	}
 	return nil
 }
 
-type ListQueuesResponse_QueueInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueueName     string                 `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queue_name,omitempty"`
-	MessageCount  int64                  `protobuf:"varint,2,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
-	LastMessageId int64                  `protobuf:"varint,3,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`
+func (x *DescribeOutboundDestinationHealthResponse) GetHostDestinations() []*v113.OutboundDestinationHealth {
+	if x != nil {
+		return x.HostDestinations
+	}
+	return nil
+}
+
+func (x *DescribeOutboundDestinationHealthResponse) GetUnreachableHosts() []string {
+	if x != nil {
+		return x.UnreachableHosts
+	}
+	return nil
+}
+
+type UpdateOutboundCircuitBreakerRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Optional. When empty, all task groups with a circuit breaker for the destination are updated.
+	TaskGroup   string `protobuf:"bytes,2,opt,name=task_group,json=taskGroup,proto3" json:"task_group,omitempty"`
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// When true, the circuit breaker is forced open until it is reset. When false, any manual trip is
+	// cleared and the circuit breaker restarts in the closed state.
+	Trip          bool `protobuf:"varint,4,opt,name=trip,proto3" json:"trip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListQueuesResponse_QueueInfo) Reset() {
-	*x = ListQueuesResponse_QueueInfo{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+func (x *UpdateOutboundCircuitBreakerRequest) Reset() {
+	*x = UpdateOutboundCircuitBreakerRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesResponse_QueueInfo) String() string {
+func (x *UpdateOutboundCircuitBreakerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesResponse_QueueInfo) ProtoMessage() {}
+func (*UpdateOutboundCircuitBreakerRequest) ProtoMessage() {}
 
-func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+func (x *UpdateOutboundCircuitBreakerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5881,37 +5972,3080 @@ func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesResponse_QueueInfo.ProtoReflect.Descriptor instead.
-func (*ListQueuesResponse_QueueInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77, 0}
+// Deprecated: Use UpdateOutboundCircuitBreakerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOutboundCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *ListQueuesResponse_QueueInfo) GetQueueName() string {
+func (x *UpdateOutboundCircuitBreakerRequest) GetNamespace() string {
 	if x != nil {
-		return x.QueueName
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ListQueuesResponse_QueueInfo) GetMessageCount() int64 {
+func (x *UpdateOutboundCircuitBreakerRequest) GetTaskGroup() string {
 	if x != nil {
-		return x.MessageCount
+		return x.TaskGroup
+	}
+	return ""
+}
+
+func (x *UpdateOutboundCircuitBreakerRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *UpdateOutboundCircuitBreakerRequest) GetTrip() bool {
+	if x != nil {
+		return x.Trip
+	}
+	return false
+}
+
+type UpdateOutboundCircuitBreakerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total number of circuit breakers that were updated across all reachable history hosts.
+	UpdatedCount int32 `protobuf:"varint,1,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	// Addresses of history hosts that could not be reached.
+	UnreachableHosts []string `protobuf:"bytes,2,rep,name=unreachable_hosts,json=unreachableHosts,proto3" json:"unreachable_hosts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateOutboundCircuitBreakerResponse) Reset() {
+	*x = UpdateOutboundCircuitBreakerResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOutboundCircuitBreakerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOutboundCircuitBreakerResponse) ProtoMessage() {}
+
+func (x *UpdateOutboundCircuitBreakerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOutboundCircuitBreakerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOutboundCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateOutboundCircuitBreakerResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
 	}
 	return 0
 }
 
-func (x *ListQueuesResponse_QueueInfo) GetLastMessageId() int64 {
+func (x *UpdateOutboundCircuitBreakerResponse) GetUnreachableHosts() []string {
 	if x != nil {
-		return x.LastMessageId
+		return x.UnreachableHosts
+	}
+	return nil
+}
+
+type DescribeNexusEndpointRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the endpoint to describe.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeNexusEndpointRequest) Reset() {
+	*x = DescribeNexusEndpointRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeNexusEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeNexusEndpointRequest) ProtoMessage() {}
+
+func (x *DescribeNexusEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeNexusEndpointRequest.ProtoReflect.Descriptor instead.
+func (*DescribeNexusEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DescribeNexusEndpointRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DescribeNexusEndpointResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint *v116.Endpoint         `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Health of the endpoint aggregated across all reachable history hosts, one entry per namespace and task group.
+	Destinations []*v113.OutboundDestinationHealth `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Health as reported by each history host.
+	HostDestinations []*v113.OutboundDestinationHealth `protobuf:"bytes,3,rep,name=host_destinations,json=hostDestinations,proto3" json:"host_destinations,omitempty"`
+	// Addresses of history hosts that could not be reached.
+	UnreachableHosts []string `protobuf:"bytes,4,rep,name=unreachable_hosts,json=unreachableHosts,proto3" json:"unreachable_hosts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DescribeNexusEndpointResponse) Reset() {
+	*x = DescribeNexusEndpointResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeNexusEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeNexusEndpointResponse) ProtoMessage() {}
+
+func (x *DescribeNexusEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeNexusEndpointResponse.ProtoReflect.Descriptor instead.
+func (*DescribeNexusEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DescribeNexusEndpointResponse) GetEndpoint() *v116.Endpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *DescribeNexusEndpointResponse) GetDestinations() []*v113.OutboundDestinationHealth {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *DescribeNexusEndpointResponse) GetHostDestinations() []*v113.OutboundDestinationHealth {
+	if x != nil {
+		return x.HostDestinations
+	}
+	return nil
+}
+
+func (x *DescribeNexusEndpointResponse) GetUnreachableHosts() []string {
+	if x != nil {
+		return x.UnreachableHosts
+	}
+	return nil
+}
+
+type UpdateNexusEndpointHttpTargetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the endpoint to update.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version of the endpoint, used for optimistic concurrency. Must match the current version in persistence.
+	Version       int64                         `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Target        *v12.NexusEndpointTarget_Http `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNexusEndpointHttpTargetRequest) Reset() {
+	*x = UpdateNexusEndpointHttpTargetRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNexusEndpointHttpTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNexusEndpointHttpTargetRequest) ProtoMessage() {}
+
+func (x *UpdateNexusEndpointHttpTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNexusEndpointHttpTargetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNexusEndpointHttpTargetRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UpdateNexusEndpointHttpTargetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateNexusEndpointHttpTargetRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
 	}
 	return 0
 }
 
-var File_temporal_server_api_adminservice_v1_request_response_proto protoreflect.FileDescriptor
+func (x *UpdateNexusEndpointHttpTargetRequest) GetTarget() *v12.NexusEndpointTarget_Http {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
 
-const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc = "" +
+type UpdateNexusEndpointHttpTargetResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Entry         *v12.NexusEndpointEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNexusEndpointHttpTargetResponse) Reset() {
+	*x = UpdateNexusEndpointHttpTargetResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNexusEndpointHttpTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNexusEndpointHttpTargetResponse) ProtoMessage() {}
+
+func (x *UpdateNexusEndpointHttpTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNexusEndpointHttpTargetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNexusEndpointHttpTargetResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UpdateNexusEndpointHttpTargetResponse) GetEntry() *v12.NexusEndpointEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type DeadLetteredCallbackMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the message in the namespace's callback dead-letter queue.
+	MessageId     int64                     `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Callback      *v12.DeadLetteredCallback `protobuf:"bytes,2,opt,name=callback,proto3" json:"callback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetteredCallbackMessage) Reset() {
+	*x = DeadLetteredCallbackMessage{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetteredCallbackMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetteredCallbackMessage) ProtoMessage() {}
+
+func (x *DeadLetteredCallbackMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetteredCallbackMessage.ProtoReflect.Descriptor instead.
+func (*DeadLetteredCallbackMessage) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DeadLetteredCallbackMessage) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *DeadLetteredCallbackMessage) GetCallback() *v12.DeadLetteredCallback {
+	if x != nil {
+		return x.Callback
+	}
+	return nil
+}
+
+type ListDeadLetteredCallbacksRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// page_size must be positive.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLetteredCallbacksRequest) Reset() {
+	*x = ListDeadLetteredCallbacksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLetteredCallbacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetteredCallbacksRequest) ProtoMessage() {}
+
+func (x *ListDeadLetteredCallbacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetteredCallbacksRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLetteredCallbacksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListDeadLetteredCallbacksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListDeadLetteredCallbacksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeadLetteredCallbacksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListDeadLetteredCallbacksResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Messages      []*DeadLetteredCallbackMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	NextPageToken []byte                         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLetteredCallbacksResponse) Reset() {
+	*x = ListDeadLetteredCallbacksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLetteredCallbacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetteredCallbacksResponse) ProtoMessage() {}
+
+func (x *ListDeadLetteredCallbacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetteredCallbacksResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLetteredCallbacksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListDeadLetteredCallbacksResponse) GetMessages() []*DeadLetteredCallbackMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListDeadLetteredCallbacksResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type GetDeadLetteredCallbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	MessageId     int64                  `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeadLetteredCallbackRequest) Reset() {
+	*x = GetDeadLetteredCallbackRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeadLetteredCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetteredCallbackRequest) ProtoMessage() {}
+
+func (x *GetDeadLetteredCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetteredCallbackRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadLetteredCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetDeadLetteredCallbackRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetDeadLetteredCallbackRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type GetDeadLetteredCallbackResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Message       *DeadLetteredCallbackMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeadLetteredCallbackResponse) Reset() {
+	*x = GetDeadLetteredCallbackResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeadLetteredCallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetteredCallbackResponse) ProtoMessage() {}
+
+func (x *GetDeadLetteredCallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetteredCallbackResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadLetteredCallbackResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetDeadLetteredCallbackResponse) GetMessage() *DeadLetteredCallbackMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ReplayDeadLetteredCallbacksRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Only callbacks with a message ID up to and including this one are re-delivered.
+	InclusiveMaxMessageId int64 `protobuf:"varint,2,opt,name=inclusive_max_message_id,json=inclusiveMaxMessageId,proto3" json:"inclusive_max_message_id,omitempty"`
+	// When true, callbacks that were delivered are removed from the dead-letter queue.
+	DeleteDelivered bool `protobuf:"varint,3,opt,name=delete_delivered,json=deleteDelivered,proto3" json:"delete_delivered,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLetteredCallbacksRequest) Reset() {
+	*x = ReplayDeadLetteredCallbacksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLetteredCallbacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetteredCallbacksRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLetteredCallbacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetteredCallbacksRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetteredCallbacksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ReplayDeadLetteredCallbacksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ReplayDeadLetteredCallbacksRequest) GetInclusiveMaxMessageId() int64 {
+	if x != nil {
+		return x.InclusiveMaxMessageId
+	}
+	return 0
+}
+
+func (x *ReplayDeadLetteredCallbacksRequest) GetDeleteDelivered() bool {
+	if x != nil {
+		return x.DeleteDelivered
+	}
+	return false
+}
+
+type ReplayDeadLetteredCallbacksResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DeliveredCount  int32                  `protobuf:"varint,1,opt,name=delivered_count,json=deliveredCount,proto3" json:"delivered_count,omitempty"`
+	MessagesDeleted int64                  `protobuf:"varint,2,opt,name=messages_deleted,json=messagesDeleted,proto3" json:"messages_deleted,omitempty"`
+	// Set when a re-delivery failed. Callbacks starting from this message ID remain in the dead-letter queue.
+	FailedMessageId int64  `protobuf:"varint,3,opt,name=failed_message_id,json=failedMessageId,proto3" json:"failed_message_id,omitempty"`
+	FailureMessage  string `protobuf:"bytes,4,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLetteredCallbacksResponse) Reset() {
+	*x = ReplayDeadLetteredCallbacksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLetteredCallbacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetteredCallbacksResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLetteredCallbacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetteredCallbacksResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetteredCallbacksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ReplayDeadLetteredCallbacksResponse) GetDeliveredCount() int32 {
+	if x != nil {
+		return x.DeliveredCount
+	}
+	return 0
+}
+
+func (x *ReplayDeadLetteredCallbacksResponse) GetMessagesDeleted() int64 {
+	if x != nil {
+		return x.MessagesDeleted
+	}
+	return 0
+}
+
+func (x *ReplayDeadLetteredCallbacksResponse) GetFailedMessageId() int64 {
+	if x != nil {
+		return x.FailedMessageId
+	}
+	return 0
+}
+
+func (x *ReplayDeadLetteredCallbacksResponse) GetFailureMessage() string {
+	if x != nil {
+		return x.FailureMessage
+	}
+	return ""
+}
+
+type PurgeDeadLetteredCallbacksRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Namespace             string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	InclusiveMaxMessageId int64                  `protobuf:"varint,2,opt,name=inclusive_max_message_id,json=inclusiveMaxMessageId,proto3" json:"inclusive_max_message_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PurgeDeadLetteredCallbacksRequest) Reset() {
+	*x = PurgeDeadLetteredCallbacksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeadLetteredCallbacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeadLetteredCallbacksRequest) ProtoMessage() {}
+
+func (x *PurgeDeadLetteredCallbacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeadLetteredCallbacksRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLetteredCallbacksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *PurgeDeadLetteredCallbacksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PurgeDeadLetteredCallbacksRequest) GetInclusiveMaxMessageId() int64 {
+	if x != nil {
+		return x.InclusiveMaxMessageId
+	}
+	return 0
+}
+
+type PurgeDeadLetteredCallbacksResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MessagesDeleted int64                  `protobuf:"varint,1,opt,name=messages_deleted,json=messagesDeleted,proto3" json:"messages_deleted,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PurgeDeadLetteredCallbacksResponse) Reset() {
+	*x = PurgeDeadLetteredCallbacksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeadLetteredCallbacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeadLetteredCallbacksResponse) ProtoMessage() {}
+
+func (x *PurgeDeadLetteredCallbacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeadLetteredCallbacksResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLetteredCallbacksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *PurgeDeadLetteredCallbacksResponse) GetMessagesDeleted() int64 {
+	if x != nil {
+		return x.MessagesDeleted
+	}
+	return 0
+}
+
+type RotateCallbackSigningKeyRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// ID of the new key. A random ID is generated when empty.
+	KeyId string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// Secret of the new key. A random 32 byte secret is generated when empty.
+	Secret []byte `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// How long the previous keys keep signing callbacks alongside the new key. Defaults to 24 hours when unset.
+	PreviousKeyOverlap *durationpb.Duration `protobuf:"bytes,4,opt,name=previous_key_overlap,json=previousKeyOverlap,proto3" json:"previous_key_overlap,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateCallbackSigningKeyRequest) Reset() {
+	*x = RotateCallbackSigningKeyRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCallbackSigningKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCallbackSigningKeyRequest) ProtoMessage() {}
+
+func (x *RotateCallbackSigningKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCallbackSigningKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateCallbackSigningKeyRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RotateCallbackSigningKeyRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RotateCallbackSigningKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *RotateCallbackSigningKeyRequest) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *RotateCallbackSigningKeyRequest) GetPreviousKeyOverlap() *durationpb.Duration {
+	if x != nil {
+		return x.PreviousKeyOverlap
+	}
+	return nil
+}
+
+type RotateCallbackSigningKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new key, including its secret.
+	Key           *v12.CallbackSigningKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCallbackSigningKeyResponse) Reset() {
+	*x = RotateCallbackSigningKeyResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCallbackSigningKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCallbackSigningKeyResponse) ProtoMessage() {}
+
+func (x *RotateCallbackSigningKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCallbackSigningKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateCallbackSigningKeyResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *RotateCallbackSigningKeyResponse) GetKey() *v12.CallbackSigningKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type ListCallbackSigningKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallbackSigningKeysRequest) Reset() {
+	*x = ListCallbackSigningKeysRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallbackSigningKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallbackSigningKeysRequest) ProtoMessage() {}
+
+func (x *ListCallbackSigningKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallbackSigningKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListCallbackSigningKeysRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListCallbackSigningKeysRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListCallbackSigningKeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keys of the namespace with their secrets omitted.
+	Keys          []*v12.CallbackSigningKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallbackSigningKeysResponse) Reset() {
+	*x = ListCallbackSigningKeysResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallbackSigningKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallbackSigningKeysResponse) ProtoMessage() {}
+
+func (x *ListCallbackSigningKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallbackSigningKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListCallbackSigningKeysResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListCallbackSigningKeysResponse) GetKeys() []*v12.CallbackSigningKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type DeleteCallbackSigningKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCallbackSigningKeyRequest) Reset() {
+	*x = DeleteCallbackSigningKeyRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCallbackSigningKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCallbackSigningKeyRequest) ProtoMessage() {}
+
+func (x *DeleteCallbackSigningKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCallbackSigningKeyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCallbackSigningKeyRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *DeleteCallbackSigningKeyRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteCallbackSigningKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type DeleteCallbackSigningKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCallbackSigningKeyResponse) Reset() {
+	*x = DeleteCallbackSigningKeyResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCallbackSigningKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCallbackSigningKeyResponse) ProtoMessage() {}
+
+func (x *DeleteCallbackSigningKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCallbackSigningKeyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCallbackSigningKeyResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{117}
+}
+
+type DescribePersistenceConcurrencyLimiterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribePersistenceConcurrencyLimiterRequest) Reset() {
+	*x = DescribePersistenceConcurrencyLimiterRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribePersistenceConcurrencyLimiterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribePersistenceConcurrencyLimiterRequest) ProtoMessage() {}
+
+func (x *DescribePersistenceConcurrencyLimiterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribePersistenceConcurrencyLimiterRequest.ProtoReflect.Descriptor instead.
+func (*DescribePersistenceConcurrencyLimiterRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{118}
+}
+
+type DescribePersistenceConcurrencyLimiterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// State reported by the frontend host serving the request and by each reachable history and matching host.
+	States []*v113.PersistenceConcurrencyLimiterState `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	// Addresses of history and matching hosts that could not be reached.
+	UnreachableHosts []string `protobuf:"bytes,2,rep,name=unreachable_hosts,json=unreachableHosts,proto3" json:"unreachable_hosts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DescribePersistenceConcurrencyLimiterResponse) Reset() {
+	*x = DescribePersistenceConcurrencyLimiterResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribePersistenceConcurrencyLimiterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribePersistenceConcurrencyLimiterResponse) ProtoMessage() {}
+
+func (x *DescribePersistenceConcurrencyLimiterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribePersistenceConcurrencyLimiterResponse.ProtoReflect.Descriptor instead.
+func (*DescribePersistenceConcurrencyLimiterResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DescribePersistenceConcurrencyLimiterResponse) GetStates() []*v113.PersistenceConcurrencyLimiterState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *DescribePersistenceConcurrencyLimiterResponse) GetUnreachableHosts() []string {
+	if x != nil {
+		return x.UnreachableHosts
+	}
+	return nil
+}
+
+type UpdateFaultInjectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Faults to add, replacing the faults with the same IDs.
+	UpsertFaults   []*v117.Fault `protobuf:"bytes,1,rep,name=upsert_faults,json=upsertFaults,proto3" json:"upsert_faults,omitempty"`
+	DeleteFaultIds []string      `protobuf:"bytes,2,rep,name=delete_fault_ids,json=deleteFaultIds,proto3" json:"delete_fault_ids,omitempty"`
+	// Delete all faults before applying upsert_faults.
+	DeleteAllFaults bool `protobuf:"varint,3,opt,name=delete_all_faults,json=deleteAllFaults,proto3" json:"delete_all_faults,omitempty"`
+	// Only update the frontend host serving the request. Used by frontend hosts to forward the update to each other.
+	SkipForwarding bool `protobuf:"varint,4,opt,name=skip_forwarding,json=skipForwarding,proto3" json:"skip_forwarding,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateFaultInjectionRequest) Reset() {
+	*x = UpdateFaultInjectionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFaultInjectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFaultInjectionRequest) ProtoMessage() {}
+
+func (x *UpdateFaultInjectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFaultInjectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFaultInjectionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *UpdateFaultInjectionRequest) GetUpsertFaults() []*v117.Fault {
+	if x != nil {
+		return x.UpsertFaults
+	}
+	return nil
+}
+
+func (x *UpdateFaultInjectionRequest) GetDeleteFaultIds() []string {
+	if x != nil {
+		return x.DeleteFaultIds
+	}
+	return nil
+}
+
+func (x *UpdateFaultInjectionRequest) GetDeleteAllFaults() bool {
+	if x != nil {
+		return x.DeleteAllFaults
+	}
+	return false
+}
+
+func (x *UpdateFaultInjectionRequest) GetSkipForwarding() bool {
+	if x != nil {
+		return x.SkipForwarding
+	}
+	return false
+}
+
+type UpdateFaultInjectionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Faults of each reachable host after the update.
+	Hosts []*v117.HostFaults `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	// Addresses of hosts that could not be reached.
+	UnreachableHosts []string `protobuf:"bytes,2,rep,name=unreachable_hosts,json=unreachableHosts,proto3" json:"unreachable_hosts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateFaultInjectionResponse) Reset() {
+	*x = UpdateFaultInjectionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFaultInjectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFaultInjectionResponse) ProtoMessage() {}
+
+func (x *UpdateFaultInjectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFaultInjectionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFaultInjectionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *UpdateFaultInjectionResponse) GetHosts() []*v117.HostFaults {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *UpdateFaultInjectionResponse) GetUnreachableHosts() []string {
+	if x != nil {
+		return x.UnreachableHosts
+	}
+	return nil
+}
+
+type DescribeFaultInjectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only describe the frontend host serving the request.
+	SkipForwarding bool `protobuf:"varint,1,opt,name=skip_forwarding,json=skipForwarding,proto3" json:"skip_forwarding,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DescribeFaultInjectionRequest) Reset() {
+	*x = DescribeFaultInjectionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeFaultInjectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeFaultInjectionRequest) ProtoMessage() {}
+
+func (x *DescribeFaultInjectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeFaultInjectionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeFaultInjectionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *DescribeFaultInjectionRequest) GetSkipForwarding() bool {
+	if x != nil {
+		return x.SkipForwarding
+	}
+	return false
+}
+
+type DescribeFaultInjectionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Hosts []*v117.HostFaults     `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	// Addresses of hosts that could not be reached.
+	UnreachableHosts []string `protobuf:"bytes,2,rep,name=unreachable_hosts,json=unreachableHosts,proto3" json:"unreachable_hosts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DescribeFaultInjectionResponse) Reset() {
+	*x = DescribeFaultInjectionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeFaultInjectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeFaultInjectionResponse) ProtoMessage() {}
+
+func (x *DescribeFaultInjectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeFaultInjectionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeFaultInjectionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *DescribeFaultInjectionResponse) GetHosts() []*v117.HostFaults {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *DescribeFaultInjectionResponse) GetUnreachableHosts() []string {
+	if x != nil {
+		return x.UnreachableHosts
+	}
+	return nil
+}
+
+type DescribeReplicationLagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeReplicationLagRequest) Reset() {
+	*x = DescribeReplicationLagRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeReplicationLagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeReplicationLagRequest) ProtoMessage() {}
+
+func (x *DescribeReplicationLagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeReplicationLagRequest.ProtoReflect.Descriptor instead.
+func (*DescribeReplicationLagRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{124}
+}
+
+type DescribeReplicationLagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lag of each remote cluster for each shard owned by a reachable history host, sorted by shard ID.
+	Shards []*v15.ShardReplicationLag `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
+	Dlqs   []*ReplicationDLQSize      `protobuf:"bytes,2,rep,name=dlqs,proto3" json:"dlqs,omitempty"`
+	// Addresses of history hosts that could not be reached.
+	UnreachableHosts []string `protobuf:"bytes,3,rep,name=unreachable_hosts,json=unreachableHosts,proto3" json:"unreachable_hosts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DescribeReplicationLagResponse) Reset() {
+	*x = DescribeReplicationLagResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeReplicationLagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeReplicationLagResponse) ProtoMessage() {}
+
+func (x *DescribeReplicationLagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeReplicationLagResponse.ProtoReflect.Descriptor instead.
+func (*DescribeReplicationLagResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *DescribeReplicationLagResponse) GetShards() []*v15.ShardReplicationLag {
+	if x != nil {
+		return x.Shards
+	}
+	return nil
+}
+
+func (x *DescribeReplicationLagResponse) GetDlqs() []*ReplicationDLQSize {
+	if x != nil {
+		return x.Dlqs
+	}
+	return nil
+}
+
+func (x *DescribeReplicationLagResponse) GetUnreachableHosts() []string {
+	if x != nil {
+		return x.UnreachableHosts
+	}
+	return nil
+}
+
+// ReplicationDLQSize is the number of replication tasks from a remote cluster in the DLQ of the current cluster.
+type ReplicationDLQSize struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceCluster string                 `protobuf:"bytes,1,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	QueueName     string                 `protobuf:"bytes,2,opt,name=queue_name,json=queueName,proto3" json:"queue_name,omitempty"`
+	MessageCount  int64                  `protobuf:"varint,3,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplicationDLQSize) Reset() {
+	*x = ReplicationDLQSize{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicationDLQSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicationDLQSize) ProtoMessage() {}
+
+func (x *ReplicationDLQSize) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicationDLQSize.ProtoReflect.Descriptor instead.
+func (*ReplicationDLQSize) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ReplicationDLQSize) GetSourceCluster() string {
+	if x != nil {
+		return x.SourceCluster
+	}
+	return ""
+}
+
+func (x *ReplicationDLQSize) GetQueueName() string {
+	if x != nil {
+		return x.QueueName
+	}
+	return ""
+}
+
+func (x *ReplicationDLQSize) GetMessageCount() int64 {
+	if x != nil {
+		return x.MessageCount
+	}
+	return 0
+}
+
+type GetWorkerScalingRecommendationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	// Deployment version of the workers to scale. Absent means unversioned workers.
+	DeploymentVersion *v118.WorkerDeploymentVersion `protobuf:"bytes,4,opt,name=deployment_version,json=deploymentVersion,proto3" json:"deployment_version,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetWorkerScalingRecommendationRequest) Reset() {
+	*x = GetWorkerScalingRecommendationRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerScalingRecommendationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerScalingRecommendationRequest) ProtoMessage() {}
+
+func (x *GetWorkerScalingRecommendationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerScalingRecommendationRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkerScalingRecommendationRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetWorkerScalingRecommendationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetWorkerScalingRecommendationRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *GetWorkerScalingRecommendationRequest) GetTaskQueueType() v16.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v16.TaskQueueType(0)
+}
+
+func (x *GetWorkerScalingRecommendationRequest) GetDeploymentVersion() *v118.WorkerDeploymentVersion {
+	if x != nil {
+		return x.DeploymentVersion
+	}
+	return nil
+}
+
+type GetWorkerScalingRecommendationResponse struct {
+	state          protoimpl.MessageState            `protogen:"open.v1"`
+	Recommendation *v114.WorkerScalingRecommendation `protobuf:"bytes,1,opt,name=recommendation,proto3" json:"recommendation,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetWorkerScalingRecommendationResponse) Reset() {
+	*x = GetWorkerScalingRecommendationResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerScalingRecommendationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerScalingRecommendationResponse) ProtoMessage() {}
+
+func (x *GetWorkerScalingRecommendationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerScalingRecommendationResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkerScalingRecommendationResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetWorkerScalingRecommendationResponse) GetRecommendation() *v114.WorkerScalingRecommendation {
+	if x != nil {
+		return x.Recommendation
+	}
+	return nil
+}
+
+type StartWorkerDeploymentRolloutRequest struct {
+	state          protoimpl.MessageState            `protogen:"open.v1"`
+	Namespace      string                            `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	DeploymentName string                            `protobuf:"bytes,2,opt,name=deployment_name,json=deploymentName,proto3" json:"deployment_name,omitempty"`
+	BuildId        string                            `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	Plan           *v119.WorkerDeploymentRolloutPlan `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`
+	Identity       string                            `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Skip the check that the version polls on all task queues of the current version when it starts ramping.
+	IgnoreMissingTaskQueues bool `protobuf:"varint,6,opt,name=ignore_missing_task_queues,json=ignoreMissingTaskQueues,proto3" json:"ignore_missing_task_queues,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *StartWorkerDeploymentRolloutRequest) Reset() {
+	*x = StartWorkerDeploymentRolloutRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartWorkerDeploymentRolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartWorkerDeploymentRolloutRequest) ProtoMessage() {}
+
+func (x *StartWorkerDeploymentRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartWorkerDeploymentRolloutRequest.ProtoReflect.Descriptor instead.
+func (*StartWorkerDeploymentRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *StartWorkerDeploymentRolloutRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StartWorkerDeploymentRolloutRequest) GetDeploymentName() string {
+	if x != nil {
+		return x.DeploymentName
+	}
+	return ""
+}
+
+func (x *StartWorkerDeploymentRolloutRequest) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (x *StartWorkerDeploymentRolloutRequest) GetPlan() *v119.WorkerDeploymentRolloutPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *StartWorkerDeploymentRolloutRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *StartWorkerDeploymentRolloutRequest) GetIgnoreMissingTaskQueues() bool {
+	if x != nil {
+		return x.IgnoreMissingTaskQueues
+	}
+	return false
+}
+
+type StartWorkerDeploymentRolloutResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Rollout       *v119.WorkerDeploymentRollout `protobuf:"bytes,1,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartWorkerDeploymentRolloutResponse) Reset() {
+	*x = StartWorkerDeploymentRolloutResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartWorkerDeploymentRolloutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartWorkerDeploymentRolloutResponse) ProtoMessage() {}
+
+func (x *StartWorkerDeploymentRolloutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartWorkerDeploymentRolloutResponse.ProtoReflect.Descriptor instead.
+func (*StartWorkerDeploymentRolloutResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *StartWorkerDeploymentRolloutResponse) GetRollout() *v119.WorkerDeploymentRollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+type UpdateWorkerDeploymentRolloutRequest struct {
+	state          protoimpl.MessageState            `protogen:"open.v1"`
+	Namespace      string                            `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	DeploymentName string                            `protobuf:"bytes,2,opt,name=deployment_name,json=deploymentName,proto3" json:"deployment_name,omitempty"`
+	Action         v14.WorkerDeploymentRolloutAction `protobuf:"varint,3,opt,name=action,proto3,enum=temporal.server.api.enums.v1.WorkerDeploymentRolloutAction" json:"action,omitempty"`
+	Identity       string                            `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateWorkerDeploymentRolloutRequest) Reset() {
+	*x = UpdateWorkerDeploymentRolloutRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkerDeploymentRolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkerDeploymentRolloutRequest) ProtoMessage() {}
+
+func (x *UpdateWorkerDeploymentRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkerDeploymentRolloutRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkerDeploymentRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *UpdateWorkerDeploymentRolloutRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateWorkerDeploymentRolloutRequest) GetDeploymentName() string {
+	if x != nil {
+		return x.DeploymentName
+	}
+	return ""
+}
+
+func (x *UpdateWorkerDeploymentRolloutRequest) GetAction() v14.WorkerDeploymentRolloutAction {
+	if x != nil {
+		return x.Action
+	}
+	return v14.WorkerDeploymentRolloutAction(0)
+}
+
+func (x *UpdateWorkerDeploymentRolloutRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type UpdateWorkerDeploymentRolloutResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Rollout       *v119.WorkerDeploymentRollout `protobuf:"bytes,1,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkerDeploymentRolloutResponse) Reset() {
+	*x = UpdateWorkerDeploymentRolloutResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkerDeploymentRolloutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkerDeploymentRolloutResponse) ProtoMessage() {}
+
+func (x *UpdateWorkerDeploymentRolloutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkerDeploymentRolloutResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkerDeploymentRolloutResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *UpdateWorkerDeploymentRolloutResponse) GetRollout() *v119.WorkerDeploymentRollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+type DescribeWorkerDeploymentRolloutRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	DeploymentName string                 `protobuf:"bytes,2,opt,name=deployment_name,json=deploymentName,proto3" json:"deployment_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DescribeWorkerDeploymentRolloutRequest) Reset() {
+	*x = DescribeWorkerDeploymentRolloutRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeWorkerDeploymentRolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeWorkerDeploymentRolloutRequest) ProtoMessage() {}
+
+func (x *DescribeWorkerDeploymentRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeWorkerDeploymentRolloutRequest.ProtoReflect.Descriptor instead.
+func (*DescribeWorkerDeploymentRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *DescribeWorkerDeploymentRolloutRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeWorkerDeploymentRolloutRequest) GetDeploymentName() string {
+	if x != nil {
+		return x.DeploymentName
+	}
+	return ""
+}
+
+type DescribeWorkerDeploymentRolloutResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The running rollout of the deployment, or the last one that finished, with its history.
+	Rollout       *v119.WorkerDeploymentRollout `protobuf:"bytes,1,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeWorkerDeploymentRolloutResponse) Reset() {
+	*x = DescribeWorkerDeploymentRolloutResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeWorkerDeploymentRolloutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeWorkerDeploymentRolloutResponse) ProtoMessage() {}
+
+func (x *DescribeWorkerDeploymentRolloutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeWorkerDeploymentRolloutResponse.ProtoReflect.Descriptor instead.
+func (*DescribeWorkerDeploymentRolloutResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DescribeWorkerDeploymentRolloutResponse) GetRollout() *v119.WorkerDeploymentRollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+type RenameSearchAttributeAliasRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Current alias of the search attribute.
+	SearchAttribute string `protobuf:"bytes,2,opt,name=search_attribute,json=searchAttribute,proto3" json:"search_attribute,omitempty"`
+	NewAlias        string `protobuf:"bytes,3,opt,name=new_alias,json=newAlias,proto3" json:"new_alias,omitempty"`
+	// Time during which queries keep accepting the current alias. Defaults to 24 hours.
+	TransitionWindow *durationpb.Duration `protobuf:"bytes,4,opt,name=transition_window,json=transitionWindow,proto3" json:"transition_window,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RenameSearchAttributeAliasRequest) Reset() {
+	*x = RenameSearchAttributeAliasRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameSearchAttributeAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameSearchAttributeAliasRequest) ProtoMessage() {}
+
+func (x *RenameSearchAttributeAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameSearchAttributeAliasRequest.ProtoReflect.Descriptor instead.
+func (*RenameSearchAttributeAliasRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *RenameSearchAttributeAliasRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RenameSearchAttributeAliasRequest) GetSearchAttribute() string {
+	if x != nil {
+		return x.SearchAttribute
+	}
+	return ""
+}
+
+func (x *RenameSearchAttributeAliasRequest) GetNewAlias() string {
+	if x != nil {
+		return x.NewAlias
+	}
+	return ""
+}
+
+func (x *RenameSearchAttributeAliasRequest) GetTransitionWindow() *durationpb.Duration {
+	if x != nil {
+		return x.TransitionWindow
+	}
+	return nil
+}
+
+type RenameSearchAttributeAliasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameSearchAttributeAliasResponse) Reset() {
+	*x = RenameSearchAttributeAliasResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameSearchAttributeAliasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameSearchAttributeAliasResponse) ProtoMessage() {}
+
+func (x *RenameSearchAttributeAliasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameSearchAttributeAliasResponse.ProtoReflect.Descriptor instead.
+func (*RenameSearchAttributeAliasResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{136}
+}
+
+type MigrateSearchAttributeTypeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Namespace       string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	SearchAttribute string                 `protobuf:"bytes,2,opt,name=search_attribute,json=searchAttribute,proto3" json:"search_attribute,omitempty"`
+	Type            v16.IndexedValueType   `protobuf:"varint,3,opt,name=type,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"type,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MigrateSearchAttributeTypeRequest) Reset() {
+	*x = MigrateSearchAttributeTypeRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateSearchAttributeTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateSearchAttributeTypeRequest) ProtoMessage() {}
+
+func (x *MigrateSearchAttributeTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateSearchAttributeTypeRequest.ProtoReflect.Descriptor instead.
+func (*MigrateSearchAttributeTypeRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *MigrateSearchAttributeTypeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *MigrateSearchAttributeTypeRequest) GetSearchAttribute() string {
+	if x != nil {
+		return x.SearchAttribute
+	}
+	return ""
+}
+
+func (x *MigrateSearchAttributeTypeRequest) GetType() v16.IndexedValueType {
+	if x != nil {
+		return x.Type
+	}
+	return v16.IndexedValueType(0)
+}
+
+type MigrateSearchAttributeTypeResponse struct {
+	state     protoimpl.MessageState            `protogen:"open.v1"`
+	Migration *v12.SearchAttributeTypeMigration `protobuf:"bytes,1,opt,name=migration,proto3" json:"migration,omitempty"`
+	// ID of the system workflow rewriting the visibility records.
+	WorkflowId    string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateSearchAttributeTypeResponse) Reset() {
+	*x = MigrateSearchAttributeTypeResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateSearchAttributeTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateSearchAttributeTypeResponse) ProtoMessage() {}
+
+func (x *MigrateSearchAttributeTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateSearchAttributeTypeResponse.ProtoReflect.Descriptor instead.
+func (*MigrateSearchAttributeTypeResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *MigrateSearchAttributeTypeResponse) GetMigration() *v12.SearchAttributeTypeMigration {
+	if x != nil {
+		return x.Migration
+	}
+	return nil
+}
+
+func (x *MigrateSearchAttributeTypeResponse) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *MigrateSearchAttributeTypeResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type CreateWorkflowRuleRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// When the extension is set, the spec must have neither a trigger nor actions.
+	Spec          *v120.WorkflowRuleSpec     `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Extension     *v12.WorkflowRuleExtension `protobuf:"bytes,3,opt,name=extension,proto3" json:"extension,omitempty"`
+	Description   string                     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Identity      string                     `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkflowRuleRequest) Reset() {
+	*x = CreateWorkflowRuleRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkflowRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkflowRuleRequest) ProtoMessage() {}
+
+func (x *CreateWorkflowRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkflowRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkflowRuleRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *CreateWorkflowRuleRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateWorkflowRuleRequest) GetSpec() *v120.WorkflowRuleSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *CreateWorkflowRuleRequest) GetExtension() *v12.WorkflowRuleExtension {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+func (x *CreateWorkflowRuleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateWorkflowRuleRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type CreateWorkflowRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *v120.WorkflowRule     `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkflowRuleResponse) Reset() {
+	*x = CreateWorkflowRuleResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkflowRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkflowRuleResponse) ProtoMessage() {}
+
+func (x *CreateWorkflowRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkflowRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkflowRuleResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *CreateWorkflowRuleResponse) GetRule() *v120.WorkflowRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DescribeWorkflowRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeWorkflowRuleRequest) Reset() {
+	*x = DescribeWorkflowRuleRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeWorkflowRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeWorkflowRuleRequest) ProtoMessage() {}
+
+func (x *DescribeWorkflowRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeWorkflowRuleRequest.ProtoReflect.Descriptor instead.
+func (*DescribeWorkflowRuleRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *DescribeWorkflowRuleRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeWorkflowRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type DescribeWorkflowRuleResponse struct {
+	state     protoimpl.MessageState     `protogen:"open.v1"`
+	Rule      *v120.WorkflowRule         `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Extension *v12.WorkflowRuleExtension `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+	HitCount  int64                      `protobuf:"varint,3,opt,name=hit_count,json=hitCount,proto3" json:"hit_count,omitempty"`
+	// Addresses of the history hosts which could not be reached. Their hits are not part of the hit count.
+	UnreachableHosts []string `protobuf:"bytes,4,rep,name=unreachable_hosts,json=unreachableHosts,proto3" json:"unreachable_hosts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DescribeWorkflowRuleResponse) Reset() {
+	*x = DescribeWorkflowRuleResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeWorkflowRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeWorkflowRuleResponse) ProtoMessage() {}
+
+func (x *DescribeWorkflowRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeWorkflowRuleResponse.ProtoReflect.Descriptor instead.
+func (*DescribeWorkflowRuleResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *DescribeWorkflowRuleResponse) GetRule() *v120.WorkflowRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *DescribeWorkflowRuleResponse) GetExtension() *v12.WorkflowRuleExtension {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+func (x *DescribeWorkflowRuleResponse) GetHitCount() int64 {
+	if x != nil {
+		return x.HitCount
+	}
+	return 0
+}
+
+func (x *DescribeWorkflowRuleResponse) GetUnreachableHosts() []string {
+	if x != nil {
+		return x.UnreachableHosts
+	}
+	return nil
+}
+
+type DescribeWorkerRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Namespace         string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	WorkerInstanceKey string                 `protobuf:"bytes,2,opt,name=worker_instance_key,json=workerInstanceKey,proto3" json:"worker_instance_key,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DescribeWorkerRequest) Reset() {
+	*x = DescribeWorkerRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeWorkerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeWorkerRequest) ProtoMessage() {}
+
+func (x *DescribeWorkerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeWorkerRequest.ProtoReflect.Descriptor instead.
+func (*DescribeWorkerRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *DescribeWorkerRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeWorkerRequest) GetWorkerInstanceKey() string {
+	if x != nil {
+		return x.WorkerInstanceKey
+	}
+	return ""
+}
+
+type DescribeWorkerResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkerInfo *v121.WorkerInfo       `protobuf:"bytes,1,opt,name=worker_info,json=workerInfo,proto3" json:"worker_info,omitempty"`
+	// Recent heartbeats of the worker, oldest first, trimmed to the fields that change between heartbeats: slot usage,
+	// poller counts and host info.
+	HeartbeatHistory []*v121.WorkerHeartbeat `protobuf:"bytes,2,rep,name=heartbeat_history,json=heartbeatHistory,proto3" json:"heartbeat_history,omitempty"`
+	// Liveness of the worker: "Healthy", "Stale" when it stopped heartbeating, or "Saturated" when its slots are
+	// persistently used up.
+	Liveness      string `protobuf:"bytes,3,opt,name=liveness,proto3" json:"liveness,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeWorkerResponse) Reset() {
+	*x = DescribeWorkerResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeWorkerResponse) ProtoMessage() {}
+
+func (x *DescribeWorkerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeWorkerResponse.ProtoReflect.Descriptor instead.
+func (*DescribeWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *DescribeWorkerResponse) GetWorkerInfo() *v121.WorkerInfo {
+	if x != nil {
+		return x.WorkerInfo
+	}
+	return nil
+}
+
+func (x *DescribeWorkerResponse) GetHeartbeatHistory() []*v121.WorkerHeartbeat {
+	if x != nil {
+		return x.HeartbeatHistory
+	}
+	return nil
+}
+
+func (x *DescribeWorkerResponse) GetLiveness() string {
+	if x != nil {
+		return x.Liveness
+	}
+	return ""
+}
+
+type StartVisibilityBackfillRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of shards backfilled in parallel. Defaults to 4.
+	ConcurrentShardCount int32 `protobuf:"varint,1,opt,name=concurrent_shard_count,json=concurrentShardCount,proto3" json:"concurrent_shard_count,omitempty"`
+	// Maximum number of visibility records backfilled per second, across all shards. Defaults to 100.
+	Rps float64 `protobuf:"fixed64,2,opt,name=rps,proto3" json:"rps,omitempty"`
+	// Number of executions loaded from the execution store at a time. Defaults to 100.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Skip verifying the count parity between both stores once all shards are backfilled.
+	SkipVerification bool `protobuf:"varint,4,opt,name=skip_verification,json=skipVerification,proto3" json:"skip_verification,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StartVisibilityBackfillRequest) Reset() {
+	*x = StartVisibilityBackfillRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVisibilityBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVisibilityBackfillRequest) ProtoMessage() {}
+
+func (x *StartVisibilityBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVisibilityBackfillRequest.ProtoReflect.Descriptor instead.
+func (*StartVisibilityBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *StartVisibilityBackfillRequest) GetConcurrentShardCount() int32 {
+	if x != nil {
+		return x.ConcurrentShardCount
+	}
+	return 0
+}
+
+func (x *StartVisibilityBackfillRequest) GetRps() float64 {
+	if x != nil {
+		return x.Rps
+	}
+	return 0
+}
+
+func (x *StartVisibilityBackfillRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *StartVisibilityBackfillRequest) GetSkipVerification() bool {
+	if x != nil {
+		return x.SkipVerification
+	}
+	return false
+}
+
+type StartVisibilityBackfillResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the system workflow running the backfill.
+	WorkflowId    string `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartVisibilityBackfillResponse) Reset() {
+	*x = StartVisibilityBackfillResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVisibilityBackfillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVisibilityBackfillResponse) ProtoMessage() {}
+
+func (x *StartVisibilityBackfillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVisibilityBackfillResponse.ProtoReflect.Descriptor instead.
+func (*StartVisibilityBackfillResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *StartVisibilityBackfillResponse) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *StartVisibilityBackfillResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type DescribeVisibilityBackfillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeVisibilityBackfillRequest) Reset() {
+	*x = DescribeVisibilityBackfillRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeVisibilityBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeVisibilityBackfillRequest) ProtoMessage() {}
+
+func (x *DescribeVisibilityBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeVisibilityBackfillRequest.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{147}
+}
+
+type DescribeVisibilityBackfillResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// Run ID of the current run. The backfill continues as new after a number of shards.
+	RunId               string                      `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Status              v16.WorkflowExecutionStatus `protobuf:"varint,3,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	ShardCount          int32                       `protobuf:"varint,4,opt,name=shard_count,json=shardCount,proto3" json:"shard_count,omitempty"`
+	CompletedShardCount int32                       `protobuf:"varint,5,opt,name=completed_shard_count,json=completedShardCount,proto3" json:"completed_shard_count,omitempty"`
+	BackfilledCount     int64                       `protobuf:"varint,6,opt,name=backfilled_count,json=backfilledCount,proto3" json:"backfilled_count,omitempty"`
+	SkippedCount        int64                       `protobuf:"varint,7,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	// Whether the execution counts of both stores are being compared.
+	Verifying bool `protobuf:"varint,8,opt,name=verifying,proto3" json:"verifying,omitempty"`
+	// Archetypes whose execution counts differ between both stores, set once the verification is done. Executions
+	// created, closed or deleted during the verification may cause transient mismatches.
+	CountMismatches []*VisibilityCountMismatch `protobuf:"bytes,9,rep,name=count_mismatches,json=countMismatches,proto3" json:"count_mismatches,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DescribeVisibilityBackfillResponse) Reset() {
+	*x = DescribeVisibilityBackfillResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeVisibilityBackfillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeVisibilityBackfillResponse) ProtoMessage() {}
+
+func (x *DescribeVisibilityBackfillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeVisibilityBackfillResponse.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityBackfillResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *DescribeVisibilityBackfillResponse) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *DescribeVisibilityBackfillResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *DescribeVisibilityBackfillResponse) GetStatus() v16.WorkflowExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v16.WorkflowExecutionStatus(0)
+}
+
+func (x *DescribeVisibilityBackfillResponse) GetShardCount() int32 {
+	if x != nil {
+		return x.ShardCount
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityBackfillResponse) GetCompletedShardCount() int32 {
+	if x != nil {
+		return x.CompletedShardCount
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityBackfillResponse) GetBackfilledCount() int64 {
+	if x != nil {
+		return x.BackfilledCount
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityBackfillResponse) GetSkippedCount() int64 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityBackfillResponse) GetVerifying() bool {
+	if x != nil {
+		return x.Verifying
+	}
+	return false
+}
+
+func (x *DescribeVisibilityBackfillResponse) GetCountMismatches() []*VisibilityCountMismatch {
+	if x != nil {
+		return x.CountMismatches
+	}
+	return nil
+}
+
+type VisibilityCountMismatch struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Namespace   string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// (-- api-linter: core::0141::forbidden-types=disabled --)
+	ArchetypeId    uint32 `protobuf:"varint,3,opt,name=archetype_id,json=archetypeId,proto3" json:"archetype_id,omitempty"`
+	Archetype      string `protobuf:"bytes,4,opt,name=archetype,proto3" json:"archetype,omitempty"`
+	PrimaryCount   int64  `protobuf:"varint,5,opt,name=primary_count,json=primaryCount,proto3" json:"primary_count,omitempty"`
+	SecondaryCount int64  `protobuf:"varint,6,opt,name=secondary_count,json=secondaryCount,proto3" json:"secondary_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VisibilityCountMismatch) Reset() {
+	*x = VisibilityCountMismatch{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisibilityCountMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisibilityCountMismatch) ProtoMessage() {}
+
+func (x *VisibilityCountMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisibilityCountMismatch.ProtoReflect.Descriptor instead.
+func (*VisibilityCountMismatch) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *VisibilityCountMismatch) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *VisibilityCountMismatch) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *VisibilityCountMismatch) GetArchetypeId() uint32 {
+	if x != nil {
+		return x.ArchetypeId
+	}
+	return 0
+}
+
+func (x *VisibilityCountMismatch) GetArchetype() string {
+	if x != nil {
+		return x.Archetype
+	}
+	return ""
+}
+
+func (x *VisibilityCountMismatch) GetPrimaryCount() int64 {
+	if x != nil {
+		return x.PrimaryCount
+	}
+	return 0
+}
+
+func (x *VisibilityCountMismatch) GetSecondaryCount() int64 {
+	if x != nil {
+		return x.SecondaryCount
+	}
+	return 0
+}
+
+type AddTasksRequest_Task struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Blob          *v1.DataBlob           `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTasksRequest_Task) Reset() {
+	*x = AddTasksRequest_Task{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTasksRequest_Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTasksRequest_Task) ProtoMessage() {}
+
+func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTasksRequest_Task.ProtoReflect.Descriptor instead.
+func (*AddTasksRequest_Task) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74, 0}
+}
+
+func (x *AddTasksRequest_Task) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *AddTasksRequest_Task) GetBlob() *v1.DataBlob {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+type ListQueuesResponse_QueueInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueName     string                 `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queue_name,omitempty"`
+	MessageCount  int64                  `protobuf:"varint,2,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
+	LastMessageId int64                  `protobuf:"varint,3,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesResponse_QueueInfo) Reset() {
+	*x = ListQueuesResponse_QueueInfo{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesResponse_QueueInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesResponse_QueueInfo) ProtoMessage() {}
+
+func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesResponse_QueueInfo.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse_QueueInfo) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77, 0}
+}
+
+func (x *ListQueuesResponse_QueueInfo) GetQueueName() string {
+	if x != nil {
+		return x.QueueName
+	}
+	return ""
+}
+
+func (x *ListQueuesResponse_QueueInfo) GetMessageCount() int64 {
+	if x != nil {
+		return x.MessageCount
+	}
+	return 0
+}
+
+func (x *ListQueuesResponse_QueueInfo) GetLastMessageId() int64 {
+	if x != nil {
+		return x.LastMessageId
+	}
+	return 0
+}
+
+var File_temporal_server_api_adminservice_v1_request_response_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc = "" +
 	"\n" +
-	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a+temporal/server/api/health/v1/message.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a4temporal/server/api/persistence/v1/task_queues.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
+	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a(temporal/api/deployment/v1/message.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a'temporal/api/namespace/v1/message.proto\x1a#temporal/api/nexus/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a#temporal/api/rules/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a$temporal/api/worker/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a/temporal/server/api/deployment/v1/message.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a-temporal/server/api/enums/v1/deployment.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a3temporal/server/api/faultinjection/v1/message.proto\x1a+temporal/server/api/health/v1/message.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a2temporal/server/api/persistence/v1/callbacks.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a3temporal/server/api/persistence/v1/namespaces.proto\x1a.temporal/server/api/persistence/v1/nexus.proto\x1a4temporal/server/api/persistence/v1/task_queues.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
 	"\x1aRebuildMutableStateRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
 	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x1d\n" +
@@ -6199,7 +9333,7 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\tnamespace\x18\x01 \x01(\tH\x00R\tnamespace\x12\x10\n" +
 	"\x02id\x18\x02 \x01(\tH\x00R\x02idB\f\n" +
 	"\n" +
-	"attributes\"\xda\x03\n" +
+	"attributes\"\xa1\x06\n" +
 	"\x14GetNamespaceResponse\x12<\n" +
 	"\x04info\x18\x03 \x01(\v2(.temporal.api.namespace.v1.NamespaceInfoR\x04info\x12B\n" +
 	"\x06config\x18\x04 \x01(\v2*.temporal.api.namespace.v1.NamespaceConfigR\x06config\x12f\n" +
@@ -6207,7 +9341,12 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x0econfig_version\x18\x06 \x01(\x03R\rconfigVersion\x12)\n" +
 	"\x10failover_version\x18\a \x01(\x03R\x0ffailoverVersion\x12V\n" +
 	"\x10failover_history\x18\b \x03(\v2+.temporal.api.replication.v1.FailoverStatusR\x0ffailoverHistory\x12.\n" +
-	"\x13is_global_namespace\x18\t \x01(\bR\x11isGlobalNamespace\"\xa0\x01\n" +
+	"\x13is_global_namespace\x18\t \x01(\bR\x11isGlobalNamespace\x12\xab\x01\n" +
+	"\"search_attribute_alias_transitions\x18\n" +
+	" \x03(\v2^.temporal.server.api.adminservice.v1.GetNamespaceResponse.SearchAttributeAliasTransitionsEntryR\x1fsearchAttributeAliasTransitions\x1a\x96\x01\n" +
+	"$SearchAttributeAliasTransitionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12X\n" +
+	"\x05value\x18\x02 \x01(\v2B.temporal.server.api.persistence.v1.SearchAttributeAliasTransitionR\x05value:\x028\x01\"\xa0\x01\n" +
 	"\x12GetDLQTasksRequest\x12E\n" +
 	"\adlq_key\x18\x01 \x01(\v2,.temporal.server.api.common.v1.HistoryDLQKeyR\x06dlqKey\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12&\n" +
@@ -6341,7 +9480,208 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x1cSCHEDULER_TARGET_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16SCHEDULER_TARGET_CHASM\x10\x01\x12\x1d\n" +
 	"\x19SCHEDULER_TARGET_WORKFLOW\x10\x02\"\x19\n" +
-	"\x17MigrateScheduleResponseB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"\x17MigrateScheduleResponse\"\x89\x01\n" +
+	"(DescribeOutboundDestinationHealthRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_group\x18\x02 \x01(\tR\ttaskGroup\x12 \n" +
+	"\vdestination\x18\x03 \x01(\tR\vdestination\"\x9d\x02\n" +
+	")DescribeOutboundDestinationHealthResponse\x12\\\n" +
+	"\fdestinations\x18\x01 \x03(\v28.temporal.server.api.health.v1.OutboundDestinationHealthR\fdestinations\x12e\n" +
+	"\x11host_destinations\x18\x02 \x03(\v28.temporal.server.api.health.v1.OutboundDestinationHealthR\x10hostDestinations\x12+\n" +
+	"\x11unreachable_hosts\x18\x03 \x03(\tR\x10unreachableHosts\"\x98\x01\n" +
+	"#UpdateOutboundCircuitBreakerRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_group\x18\x02 \x01(\tR\ttaskGroup\x12 \n" +
+	"\vdestination\x18\x03 \x01(\tR\vdestination\x12\x12\n" +
+	"\x04trip\x18\x04 \x01(\bR\x04trip\"x\n" +
+	"$UpdateOutboundCircuitBreakerResponse\x12#\n" +
+	"\rupdated_count\x18\x01 \x01(\x05R\fupdatedCount\x12+\n" +
+	"\x11unreachable_hosts\x18\x02 \x03(\tR\x10unreachableHosts\".\n" +
+	"\x1cDescribeNexusEndpointRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xce\x02\n" +
+	"\x1dDescribeNexusEndpointResponse\x12;\n" +
+	"\bendpoint\x18\x01 \x01(\v2\x1f.temporal.api.nexus.v1.EndpointR\bendpoint\x12\\\n" +
+	"\fdestinations\x18\x02 \x03(\v28.temporal.server.api.health.v1.OutboundDestinationHealthR\fdestinations\x12e\n" +
+	"\x11host_destinations\x18\x03 \x03(\v28.temporal.server.api.health.v1.OutboundDestinationHealthR\x10hostDestinations\x12+\n" +
+	"\x11unreachable_hosts\x18\x04 \x03(\tR\x10unreachableHosts\"\xa6\x01\n" +
+	"$UpdateNexusEndpointHttpTargetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12T\n" +
+	"\x06target\x18\x03 \x01(\v2<.temporal.server.api.persistence.v1.NexusEndpointTarget.HttpR\x06target\"u\n" +
+	"%UpdateNexusEndpointHttpTargetResponse\x12L\n" +
+	"\x05entry\x18\x01 \x01(\v26.temporal.server.api.persistence.v1.NexusEndpointEntryR\x05entry\"\x92\x01\n" +
+	"\x1bDeadLetteredCallbackMessage\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\x12T\n" +
+	"\bcallback\x18\x02 \x01(\v28.temporal.server.api.persistence.v1.DeadLetteredCallbackR\bcallback\"\x85\x01\n" +
+	" ListDeadLetteredCallbacksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\fR\rnextPageToken\"\xa9\x01\n" +
+	"!ListDeadLetteredCallbacksResponse\x12\\\n" +
+	"\bmessages\x18\x01 \x03(\v2@.temporal.server.api.adminservice.v1.DeadLetteredCallbackMessageR\bmessages\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"]\n" +
+	"\x1eGetDeadLetteredCallbackRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\x03R\tmessageId\"}\n" +
+	"\x1fGetDeadLetteredCallbackResponse\x12Z\n" +
+	"\amessage\x18\x01 \x01(\v2@.temporal.server.api.adminservice.v1.DeadLetteredCallbackMessageR\amessage\"\xa6\x01\n" +
+	"\"ReplayDeadLetteredCallbacksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x127\n" +
+	"\x18inclusive_max_message_id\x18\x02 \x01(\x03R\x15inclusiveMaxMessageId\x12)\n" +
+	"\x10delete_delivered\x18\x03 \x01(\bR\x0fdeleteDelivered\"\xce\x01\n" +
+	"#ReplayDeadLetteredCallbacksResponse\x12'\n" +
+	"\x0fdelivered_count\x18\x01 \x01(\x05R\x0edeliveredCount\x12)\n" +
+	"\x10messages_deleted\x18\x02 \x01(\x03R\x0fmessagesDeleted\x12*\n" +
+	"\x11failed_message_id\x18\x03 \x01(\x03R\x0ffailedMessageId\x12'\n" +
+	"\x0ffailure_message\x18\x04 \x01(\tR\x0efailureMessage\"z\n" +
+	"!PurgeDeadLetteredCallbacksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x127\n" +
+	"\x18inclusive_max_message_id\x18\x02 \x01(\x03R\x15inclusiveMaxMessageId\"O\n" +
+	"\"PurgeDeadLetteredCallbacksResponse\x12)\n" +
+	"\x10messages_deleted\x18\x01 \x01(\x03R\x0fmessagesDeleted\"\xbb\x01\n" +
+	"\x1fRotateCallbackSigningKeyRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\fR\x06secret\x12K\n" +
+	"\x14previous_key_overlap\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x12previousKeyOverlap\"l\n" +
+	" RotateCallbackSigningKeyResponse\x12H\n" +
+	"\x03key\x18\x01 \x01(\v26.temporal.server.api.persistence.v1.CallbackSigningKeyR\x03key\">\n" +
+	"\x1eListCallbackSigningKeysRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"m\n" +
+	"\x1fListCallbackSigningKeysResponse\x12J\n" +
+	"\x04keys\x18\x01 \x03(\v26.temporal.server.api.persistence.v1.CallbackSigningKeyR\x04keys\"V\n" +
+	"\x1fDeleteCallbackSigningKeyRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\"\"\n" +
+	" DeleteCallbackSigningKeyResponse\".\n" +
+	",DescribePersistenceConcurrencyLimiterRequest\"\xb7\x01\n" +
+	"-DescribePersistenceConcurrencyLimiterResponse\x12Y\n" +
+	"\x06states\x18\x01 \x03(\v2A.temporal.server.api.health.v1.PersistenceConcurrencyLimiterStateR\x06states\x12+\n" +
+	"\x11unreachable_hosts\x18\x02 \x03(\tR\x10unreachableHosts\"\xef\x01\n" +
+	"\x1bUpdateFaultInjectionRequest\x12Q\n" +
+	"\rupsert_faults\x18\x01 \x03(\v2,.temporal.server.api.faultinjection.v1.FaultR\fupsertFaults\x12(\n" +
+	"\x10delete_fault_ids\x18\x02 \x03(\tR\x0edeleteFaultIds\x12*\n" +
+	"\x11delete_all_faults\x18\x03 \x01(\bR\x0fdeleteAllFaults\x12'\n" +
+	"\x0fskip_forwarding\x18\x04 \x01(\bR\x0eskipForwarding\"\x94\x01\n" +
+	"\x1cUpdateFaultInjectionResponse\x12G\n" +
+	"\x05hosts\x18\x01 \x03(\v21.temporal.server.api.faultinjection.v1.HostFaultsR\x05hosts\x12+\n" +
+	"\x11unreachable_hosts\x18\x02 \x03(\tR\x10unreachableHosts\"H\n" +
+	"\x1dDescribeFaultInjectionRequest\x12'\n" +
+	"\x0fskip_forwarding\x18\x01 \x01(\bR\x0eskipForwarding\"\x96\x01\n" +
+	"\x1eDescribeFaultInjectionResponse\x12G\n" +
+	"\x05hosts\x18\x01 \x03(\v21.temporal.server.api.faultinjection.v1.HostFaultsR\x05hosts\x12+\n" +
+	"\x11unreachable_hosts\x18\x02 \x03(\tR\x10unreachableHosts\"\x1f\n" +
+	"\x1dDescribeReplicationLagRequest\"\xeb\x01\n" +
+	"\x1eDescribeReplicationLagResponse\x12O\n" +
+	"\x06shards\x18\x01 \x03(\v27.temporal.server.api.replication.v1.ShardReplicationLagR\x06shards\x12K\n" +
+	"\x04dlqs\x18\x02 \x03(\v27.temporal.server.api.adminservice.v1.ReplicationDLQSizeR\x04dlqs\x12+\n" +
+	"\x11unreachable_hosts\x18\x03 \x03(\tR\x10unreachableHosts\"\x7f\n" +
+	"\x12ReplicationDLQSize\x12%\n" +
+	"\x0esource_cluster\x18\x01 \x01(\tR\rsourceCluster\x12\x1d\n" +
+	"\n" +
+	"queue_name\x18\x02 \x01(\tR\tqueueName\x12#\n" +
+	"\rmessage_count\x18\x03 \x01(\x03R\fmessageCount\"\x96\x02\n" +
+	"%GetWorkerScalingRecommendationRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12L\n" +
+	"\x0ftask_queue_type\x18\x03 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12b\n" +
+	"\x12deployment_version\x18\x04 \x01(\v23.temporal.api.deployment.v1.WorkerDeploymentVersionR\x11deploymentVersion\"\x8f\x01\n" +
+	"&GetWorkerScalingRecommendationResponse\x12e\n" +
+	"\x0erecommendation\x18\x01 \x01(\v2=.temporal.server.api.taskqueue.v1.WorkerScalingRecommendationR\x0erecommendation\"\xb4\x02\n" +
+	"#StartWorkerDeploymentRolloutRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12'\n" +
+	"\x0fdeployment_name\x18\x02 \x01(\tR\x0edeploymentName\x12\x19\n" +
+	"\bbuild_id\x18\x03 \x01(\tR\abuildId\x12R\n" +
+	"\x04plan\x18\x04 \x01(\v2>.temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlanR\x04plan\x12\x1a\n" +
+	"\bidentity\x18\x05 \x01(\tR\bidentity\x12;\n" +
+	"\x1aignore_missing_task_queues\x18\x06 \x01(\bR\x17ignoreMissingTaskQueues\"|\n" +
+	"$StartWorkerDeploymentRolloutResponse\x12T\n" +
+	"\arollout\x18\x01 \x01(\v2:.temporal.server.api.deployment.v1.WorkerDeploymentRolloutR\arollout\"\xde\x01\n" +
+	"$UpdateWorkerDeploymentRolloutRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12'\n" +
+	"\x0fdeployment_name\x18\x02 \x01(\tR\x0edeploymentName\x12S\n" +
+	"\x06action\x18\x03 \x01(\x0e2;.temporal.server.api.enums.v1.WorkerDeploymentRolloutActionR\x06action\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\"}\n" +
+	"%UpdateWorkerDeploymentRolloutResponse\x12T\n" +
+	"\arollout\x18\x01 \x01(\v2:.temporal.server.api.deployment.v1.WorkerDeploymentRolloutR\arollout\"o\n" +
+	"&DescribeWorkerDeploymentRolloutRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12'\n" +
+	"\x0fdeployment_name\x18\x02 \x01(\tR\x0edeploymentName\"\x7f\n" +
+	"'DescribeWorkerDeploymentRolloutResponse\x12T\n" +
+	"\arollout\x18\x01 \x01(\v2:.temporal.server.api.deployment.v1.WorkerDeploymentRolloutR\arollout\"\xd1\x01\n" +
+	"!RenameSearchAttributeAliasRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12)\n" +
+	"\x10search_attribute\x18\x02 \x01(\tR\x0fsearchAttribute\x12\x1b\n" +
+	"\tnew_alias\x18\x03 \x01(\tR\bnewAlias\x12F\n" +
+	"\x11transition_window\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x10transitionWindow\"$\n" +
+	"\"RenameSearchAttributeAliasResponse\"\xa9\x01\n" +
+	"!MigrateSearchAttributeTypeRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12)\n" +
+	"\x10search_attribute\x18\x02 \x01(\tR\x0fsearchAttribute\x12;\n" +
+	"\x04type\x18\x03 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\x04type\"\xbc\x01\n" +
+	"\"MigrateSearchAttributeTypeResponse\x12^\n" +
+	"\tmigration\x18\x01 \x01(\v2@.temporal.server.api.persistence.v1.SearchAttributeTypeMigrationR\tmigration\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\"\x8d\x02\n" +
+	"\x19CreateWorkflowRuleRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12;\n" +
+	"\x04spec\x18\x02 \x01(\v2'.temporal.api.rules.v1.WorkflowRuleSpecR\x04spec\x12W\n" +
+	"\textension\x18\x03 \x01(\v29.temporal.server.api.persistence.v1.WorkflowRuleExtensionR\textension\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bidentity\x18\x05 \x01(\tR\bidentity\"U\n" +
+	"\x1aCreateWorkflowRuleResponse\x127\n" +
+	"\x04rule\x18\x01 \x01(\v2#.temporal.api.rules.v1.WorkflowRuleR\x04rule\"T\n" +
+	"\x1bDescribeWorkflowRuleRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\"\xfa\x01\n" +
+	"\x1cDescribeWorkflowRuleResponse\x127\n" +
+	"\x04rule\x18\x01 \x01(\v2#.temporal.api.rules.v1.WorkflowRuleR\x04rule\x12W\n" +
+	"\textension\x18\x02 \x01(\v29.temporal.server.api.persistence.v1.WorkflowRuleExtensionR\textension\x12\x1b\n" +
+	"\thit_count\x18\x03 \x01(\x03R\bhitCount\x12+\n" +
+	"\x11unreachable_hosts\x18\x04 \x03(\tR\x10unreachableHosts\"e\n" +
+	"\x15DescribeWorkerRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12.\n" +
+	"\x13worker_instance_key\x18\x02 \x01(\tR\x11workerInstanceKey\"\xcf\x01\n" +
+	"\x16DescribeWorkerResponse\x12C\n" +
+	"\vworker_info\x18\x01 \x01(\v2\".temporal.api.worker.v1.WorkerInfoR\n" +
+	"workerInfo\x12T\n" +
+	"\x11heartbeat_history\x18\x02 \x03(\v2'.temporal.api.worker.v1.WorkerHeartbeatR\x10heartbeatHistory\x12\x1a\n" +
+	"\bliveness\x18\x03 \x01(\tR\bliveness\"\xb2\x01\n" +
+	"\x1eStartVisibilityBackfillRequest\x124\n" +
+	"\x16concurrent_shard_count\x18\x01 \x01(\x05R\x14concurrentShardCount\x12\x10\n" +
+	"\x03rps\x18\x02 \x01(\x01R\x03rps\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12+\n" +
+	"\x11skip_verification\x18\x04 \x01(\bR\x10skipVerification\"Y\n" +
+	"\x1fStartVisibilityBackfillResponse\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"#\n" +
+	"!DescribeVisibilityBackfillRequest\"\xd0\x03\n" +
+	"\"DescribeVisibilityBackfillResponse\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12F\n" +
+	"\x06status\x18\x03 \x01(\x0e2..temporal.api.enums.v1.WorkflowExecutionStatusR\x06status\x12\x1f\n" +
+	"\vshard_count\x18\x04 \x01(\x05R\n" +
+	"shardCount\x122\n" +
+	"\x15completed_shard_count\x18\x05 \x01(\x05R\x13completedShardCount\x12)\n" +
+	"\x10backfilled_count\x18\x06 \x01(\x03R\x0fbackfilledCount\x12#\n" +
+	"\rskipped_count\x18\a \x01(\x03R\fskippedCount\x12\x1c\n" +
+	"\tverifying\x18\b \x01(\bR\tverifying\x12g\n" +
+	"\x10count_mismatches\x18\t \x03(\v2<.temporal.server.api.adminservice.v1.VisibilityCountMismatchR\x0fcountMismatches\"\xe9\x01\n" +
+	"\x17VisibilityCountMismatch\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12!\n" +
+	"\farchetype_id\x18\x03 \x01(\rR\varchetypeId\x12\x1c\n" +
+	"\tarchetype\x18\x04 \x01(\tR\tarchetype\x12#\n" +
+	"\rprimary_count\x18\x05 \x01(\x03R\fprimaryCount\x12'\n" +
+	"\x0fsecondary_count\x18\x06 \x01(\x03R\x0esecondaryCountB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var (
 	file_temporal_server_api_adminservice_v1_request_response_proto_rawDescOnce sync.Once
@@ -6356,258 +9696,378 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP
 }
 
 var file_temporal_server_api_adminservice_v1_request_response_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 105)
+var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 161)
 var file_temporal_server_api_adminservice_v1_request_response_proto_goTypes = []any{
-	(MigrateScheduleRequest_SchedulerTarget)(0),         // 0: temporal.server.api.adminservice.v1.MigrateScheduleRequest.SchedulerTarget
-	(*RebuildMutableStateRequest)(nil),                  // 1: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
-	(*RebuildMutableStateResponse)(nil),                 // 2: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	(*ImportWorkflowExecutionRequest)(nil),              // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	(*ImportWorkflowExecutionResponse)(nil),             // 4: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	(*DescribeMutableStateRequest)(nil),                 // 5: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	(*DescribeMutableStateResponse)(nil),                // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	(*DescribeHistoryHostRequest)(nil),                  // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	(*DescribeHistoryHostResponse)(nil),                 // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	(*CloseShardRequest)(nil),                           // 9: temporal.server.api.adminservice.v1.CloseShardRequest
-	(*CloseShardResponse)(nil),                          // 10: temporal.server.api.adminservice.v1.CloseShardResponse
-	(*GetShardRequest)(nil),                             // 11: temporal.server.api.adminservice.v1.GetShardRequest
-	(*GetShardResponse)(nil),                            // 12: temporal.server.api.adminservice.v1.GetShardResponse
-	(*ListHistoryTasksRequest)(nil),                     // 13: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	(*ListHistoryTasksResponse)(nil),                    // 14: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	(*Task)(nil),                                        // 15: temporal.server.api.adminservice.v1.Task
-	(*RemoveTaskRequest)(nil),                           // 16: temporal.server.api.adminservice.v1.RemoveTaskRequest
-	(*RemoveTaskResponse)(nil),                          // 17: temporal.server.api.adminservice.v1.RemoveTaskResponse
-	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 21: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	(*GetReplicationMessagesRequest)(nil),               // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	(*GetReplicationMessagesResponse)(nil),              // 23: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	(*GetNamespaceReplicationMessagesRequest)(nil),      // 24: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	(*GetNamespaceReplicationMessagesResponse)(nil),     // 25: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	(*GetDLQReplicationMessagesRequest)(nil),            // 26: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	(*GetDLQReplicationMessagesResponse)(nil),           // 27: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	(*ReapplyEventsRequest)(nil),                        // 28: temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	(*ReapplyEventsResponse)(nil),                       // 29: temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	(*AddSearchAttributesRequest)(nil),                  // 30: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	(*AddSearchAttributesResponse)(nil),                 // 31: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	(*RemoveSearchAttributesRequest)(nil),               // 32: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	(*RemoveSearchAttributesResponse)(nil),              // 33: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	(*GetSearchAttributesRequest)(nil),                  // 34: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	(*GetSearchAttributesResponse)(nil),                 // 35: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	(*DescribeClusterRequest)(nil),                      // 36: temporal.server.api.adminservice.v1.DescribeClusterRequest
-	(*DescribeClusterResponse)(nil),                     // 37: temporal.server.api.adminservice.v1.DescribeClusterResponse
-	(*ListClustersRequest)(nil),                         // 38: temporal.server.api.adminservice.v1.ListClustersRequest
-	(*ListClustersResponse)(nil),                        // 39: temporal.server.api.adminservice.v1.ListClustersResponse
-	(*AddOrUpdateRemoteClusterRequest)(nil),             // 40: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	(*AddOrUpdateRemoteClusterResponse)(nil),            // 41: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	(*RemoveRemoteClusterRequest)(nil),                  // 42: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	(*RemoveRemoteClusterResponse)(nil),                 // 43: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	(*ListClusterMembersRequest)(nil),                   // 44: temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	(*ListClusterMembersResponse)(nil),                  // 45: temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	(*GetDLQMessagesRequest)(nil),                       // 46: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	(*GetDLQMessagesResponse)(nil),                      // 47: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	(*PurgeDLQMessagesRequest)(nil),                     // 48: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	(*PurgeDLQMessagesResponse)(nil),                    // 49: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	(*MergeDLQMessagesRequest)(nil),                     // 50: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	(*MergeDLQMessagesResponse)(nil),                    // 51: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	(*RefreshWorkflowTasksRequest)(nil),                 // 52: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	(*RefreshWorkflowTasksResponse)(nil),                // 53: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	(*ResendReplicationTasksRequest)(nil),               // 54: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	(*ResendReplicationTasksResponse)(nil),              // 55: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	(*GetTaskQueueTasksRequest)(nil),                    // 56: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	(*GetTaskQueueTasksResponse)(nil),                   // 57: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionRequest)(nil),              // 58: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	(*DeleteWorkflowExecutionResponse)(nil),             // 59: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 60: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 61: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceRequest)(nil),                         // 62: temporal.server.api.adminservice.v1.GetNamespaceRequest
-	(*GetNamespaceResponse)(nil),                        // 63: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksRequest)(nil),                          // 64: temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	(*GetDLQTasksResponse)(nil),                         // 65: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksRequest)(nil),                        // 66: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	(*PurgeDLQTasksResponse)(nil),                       // 67: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*DLQJobToken)(nil),                                 // 68: temporal.server.api.adminservice.v1.DLQJobToken
-	(*MergeDLQTasksRequest)(nil),                        // 69: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	(*MergeDLQTasksResponse)(nil),                       // 70: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobRequest)(nil),                       // 71: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	(*DescribeDLQJobResponse)(nil),                      // 72: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobRequest)(nil),                         // 73: temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	(*CancelDLQJobResponse)(nil),                        // 74: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksRequest)(nil),                             // 75: temporal.server.api.adminservice.v1.AddTasksRequest
-	(*AddTasksResponse)(nil),                            // 76: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesRequest)(nil),                           // 77: temporal.server.api.adminservice.v1.ListQueuesRequest
-	(*ListQueuesResponse)(nil),                          // 78: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckRequest)(nil),                      // 79: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	(*DeepHealthCheckResponse)(nil),                     // 80: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateRequest)(nil),                    // 81: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	(*SyncWorkflowStateResponse)(nil),                   // 82: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 84: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionRequest)(nil),           // 85: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 86: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 87: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 88: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	(*GetTaskQueueUserDataRequest)(nil),                 // 89: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
-	(*GetTaskQueueUserDataResponse)(nil),                // 90: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
-	(*StartAdminBatchOperationRequest)(nil),             // 91: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
-	(*StartAdminBatchOperationResponse)(nil),            // 92: temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
-	(*BatchOperationRefreshTasks)(nil),                  // 93: temporal.server.api.adminservice.v1.BatchOperationRefreshTasks
-	(*MigrateScheduleRequest)(nil),                      // 94: temporal.server.api.adminservice.v1.MigrateScheduleRequest
-	(*MigrateScheduleResponse)(nil),                     // 95: temporal.server.api.adminservice.v1.MigrateScheduleResponse
-	nil,                                                 // 96: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	nil,                                                 // 97: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	nil,                                                 // 98: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	nil,                                                 // 99: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	nil,                                                 // 100: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	nil,                                                 // 101: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	nil,                                                 // 102: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	(*AddTasksRequest_Task)(nil),                        // 103: temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	(*ListQueuesResponse_QueueInfo)(nil),                // 104: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	nil,                                                 // 105: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	(*v1.WorkflowExecution)(nil),                        // 106: temporal.api.common.v1.WorkflowExecution
-	(*v1.DataBlob)(nil),                                 // 107: temporal.api.common.v1.DataBlob
-	(*v11.VersionHistory)(nil),                          // 108: temporal.server.api.history.v1.VersionHistory
-	(*v12.WorkflowMutableState)(nil),                    // 109: temporal.server.api.persistence.v1.WorkflowMutableState
-	(*v13.NamespaceCacheInfo)(nil),                      // 110: temporal.server.api.namespace.v1.NamespaceCacheInfo
-	(*v12.ShardInfo)(nil),                               // 111: temporal.server.api.persistence.v1.ShardInfo
-	(*v11.TaskRange)(nil),                               // 112: temporal.server.api.history.v1.TaskRange
-	(v14.TaskType)(0),                                   // 113: temporal.server.api.enums.v1.TaskType
-	(*timestamppb.Timestamp)(nil),                       // 114: google.protobuf.Timestamp
-	(*v15.ReplicationToken)(nil),                        // 115: temporal.server.api.replication.v1.ReplicationToken
-	(*v15.ReplicationMessages)(nil),                     // 116: temporal.server.api.replication.v1.ReplicationMessages
-	(*v15.ReplicationTaskInfo)(nil),                     // 117: temporal.server.api.replication.v1.ReplicationTaskInfo
-	(*v15.ReplicationTask)(nil),                         // 118: temporal.server.api.replication.v1.ReplicationTask
-	(*v17.WorkflowExecutionInfo)(nil),                   // 119: temporal.api.workflow.v1.WorkflowExecutionInfo
-	(*v18.MembershipInfo)(nil),                          // 120: temporal.server.api.cluster.v1.MembershipInfo
-	(*v19.VersionInfo)(nil),                             // 121: temporal.api.version.v1.VersionInfo
-	(*v12.ClusterMetadata)(nil),                         // 122: temporal.server.api.persistence.v1.ClusterMetadata
-	(*durationpb.Duration)(nil),                         // 123: google.protobuf.Duration
-	(v14.ClusterMemberRole)(0),                          // 124: temporal.server.api.enums.v1.ClusterMemberRole
-	(*v18.ClusterMember)(nil),                           // 125: temporal.server.api.cluster.v1.ClusterMember
-	(v14.DeadLetterQueueType)(0),                        // 126: temporal.server.api.enums.v1.DeadLetterQueueType
-	(v16.TaskQueueType)(0),                              // 127: temporal.api.enums.v1.TaskQueueType
-	(*v12.AllocatedTaskInfo)(nil),                       // 128: temporal.server.api.persistence.v1.AllocatedTaskInfo
-	(*v15.SyncReplicationState)(nil),                    // 129: temporal.server.api.replication.v1.SyncReplicationState
-	(*v15.WorkflowReplicationMessages)(nil),             // 130: temporal.server.api.replication.v1.WorkflowReplicationMessages
-	(*v110.NamespaceInfo)(nil),                          // 131: temporal.api.namespace.v1.NamespaceInfo
-	(*v110.NamespaceConfig)(nil),                        // 132: temporal.api.namespace.v1.NamespaceConfig
-	(*v111.NamespaceReplicationConfig)(nil),             // 133: temporal.api.replication.v1.NamespaceReplicationConfig
-	(*v111.FailoverStatus)(nil),                         // 134: temporal.api.replication.v1.FailoverStatus
-	(*v112.HistoryDLQKey)(nil),                          // 135: temporal.server.api.common.v1.HistoryDLQKey
-	(*v112.HistoryDLQTask)(nil),                         // 136: temporal.server.api.common.v1.HistoryDLQTask
-	(*v112.HistoryDLQTaskMetadata)(nil),                 // 137: temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	(v14.DLQOperationType)(0),                           // 138: temporal.server.api.enums.v1.DLQOperationType
-	(v14.DLQOperationState)(0),                          // 139: temporal.server.api.enums.v1.DLQOperationState
-	(v14.HealthState)(0),                                // 140: temporal.server.api.enums.v1.HealthState
-	(*v113.ServiceHealthDetail)(nil),                    // 141: temporal.server.api.health.v1.ServiceHealthDetail
-	(*v12.VersionedTransition)(nil),                     // 142: temporal.server.api.persistence.v1.VersionedTransition
-	(*v11.VersionHistories)(nil),                        // 143: temporal.server.api.history.v1.VersionHistories
-	(*v15.VersionedTransitionArtifact)(nil),             // 144: temporal.server.api.replication.v1.VersionedTransitionArtifact
-	(*v114.TaskQueuePartition)(nil),                     // 145: temporal.server.api.taskqueue.v1.TaskQueuePartition
-	(*v115.TaskQueueVersionSelection)(nil),              // 146: temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	(*v12.TaskQueueTypeUserData)(nil),                   // 147: temporal.server.api.persistence.v1.TaskQueueTypeUserData
-	(v16.IndexedValueType)(0),                           // 148: temporal.api.enums.v1.IndexedValueType
-	(*v114.TaskQueueVersionInfoInternal)(nil),           // 149: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	(MigrateScheduleRequest_SchedulerTarget)(0),           // 0: temporal.server.api.adminservice.v1.MigrateScheduleRequest.SchedulerTarget
+	(*RebuildMutableStateRequest)(nil),                    // 1: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
+	(*RebuildMutableStateResponse)(nil),                   // 2: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	(*ImportWorkflowExecutionRequest)(nil),                // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	(*ImportWorkflowExecutionResponse)(nil),               // 4: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	(*DescribeMutableStateRequest)(nil),                   // 5: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	(*DescribeMutableStateResponse)(nil),                  // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	(*DescribeHistoryHostRequest)(nil),                    // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	(*DescribeHistoryHostResponse)(nil),                   // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	(*CloseShardRequest)(nil),                             // 9: temporal.server.api.adminservice.v1.CloseShardRequest
+	(*CloseShardResponse)(nil),                            // 10: temporal.server.api.adminservice.v1.CloseShardResponse
+	(*GetShardRequest)(nil),                               // 11: temporal.server.api.adminservice.v1.GetShardRequest
+	(*GetShardResponse)(nil),                              // 12: temporal.server.api.adminservice.v1.GetShardResponse
+	(*ListHistoryTasksRequest)(nil),                       // 13: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	(*ListHistoryTasksResponse)(nil),                      // 14: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	(*Task)(nil),                                          // 15: temporal.server.api.adminservice.v1.Task
+	(*RemoveTaskRequest)(nil),                             // 16: temporal.server.api.adminservice.v1.RemoveTaskRequest
+	(*RemoveTaskResponse)(nil),                            // 17: temporal.server.api.adminservice.v1.RemoveTaskResponse
+	(*GetWorkflowExecutionRawHistoryV2Request)(nil),       // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	(*GetWorkflowExecutionRawHistoryV2Response)(nil),      // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	(*GetWorkflowExecutionRawHistoryRequest)(nil),         // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	(*GetWorkflowExecutionRawHistoryResponse)(nil),        // 21: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	(*GetReplicationMessagesRequest)(nil),                 // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	(*GetReplicationMessagesResponse)(nil),                // 23: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	(*GetNamespaceReplicationMessagesRequest)(nil),        // 24: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	(*GetNamespaceReplicationMessagesResponse)(nil),       // 25: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	(*GetDLQReplicationMessagesRequest)(nil),              // 26: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	(*GetDLQReplicationMessagesResponse)(nil),             // 27: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	(*ReapplyEventsRequest)(nil),                          // 28: temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	(*ReapplyEventsResponse)(nil),                         // 29: temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	(*AddSearchAttributesRequest)(nil),                    // 30: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	(*AddSearchAttributesResponse)(nil),                   // 31: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributesRequest)(nil),                 // 32: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	(*RemoveSearchAttributesResponse)(nil),                // 33: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	(*GetSearchAttributesRequest)(nil),                    // 34: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	(*GetSearchAttributesResponse)(nil),                   // 35: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	(*DescribeClusterRequest)(nil),                        // 36: temporal.server.api.adminservice.v1.DescribeClusterRequest
+	(*DescribeClusterResponse)(nil),                       // 37: temporal.server.api.adminservice.v1.DescribeClusterResponse
+	(*ListClustersRequest)(nil),                           // 38: temporal.server.api.adminservice.v1.ListClustersRequest
+	(*ListClustersResponse)(nil),                          // 39: temporal.server.api.adminservice.v1.ListClustersResponse
+	(*AddOrUpdateRemoteClusterRequest)(nil),               // 40: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	(*AddOrUpdateRemoteClusterResponse)(nil),              // 41: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	(*RemoveRemoteClusterRequest)(nil),                    // 42: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	(*RemoveRemoteClusterResponse)(nil),                   // 43: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	(*ListClusterMembersRequest)(nil),                     // 44: temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	(*ListClusterMembersResponse)(nil),                    // 45: temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	(*GetDLQMessagesRequest)(nil),                         // 46: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	(*GetDLQMessagesResponse)(nil),                        // 47: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	(*PurgeDLQMessagesRequest)(nil),                       // 48: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	(*PurgeDLQMessagesResponse)(nil),                      // 49: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	(*MergeDLQMessagesRequest)(nil),                       // 50: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	(*MergeDLQMessagesResponse)(nil),                      // 51: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	(*RefreshWorkflowTasksRequest)(nil),                   // 52: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	(*RefreshWorkflowTasksResponse)(nil),                  // 53: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	(*ResendReplicationTasksRequest)(nil),                 // 54: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	(*ResendReplicationTasksResponse)(nil),                // 55: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	(*GetTaskQueueTasksRequest)(nil),                      // 56: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	(*GetTaskQueueTasksResponse)(nil),                     // 57: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	(*DeleteWorkflowExecutionRequest)(nil),                // 58: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	(*DeleteWorkflowExecutionResponse)(nil),               // 59: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesRequest)(nil),      // 60: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	(*StreamWorkflowReplicationMessagesResponse)(nil),     // 61: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceRequest)(nil),                           // 62: temporal.server.api.adminservice.v1.GetNamespaceRequest
+	(*GetNamespaceResponse)(nil),                          // 63: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*GetDLQTasksRequest)(nil),                            // 64: temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	(*GetDLQTasksResponse)(nil),                           // 65: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksRequest)(nil),                          // 66: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	(*PurgeDLQTasksResponse)(nil),                         // 67: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*DLQJobToken)(nil),                                   // 68: temporal.server.api.adminservice.v1.DLQJobToken
+	(*MergeDLQTasksRequest)(nil),                          // 69: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	(*MergeDLQTasksResponse)(nil),                         // 70: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobRequest)(nil),                         // 71: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	(*DescribeDLQJobResponse)(nil),                        // 72: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*CancelDLQJobRequest)(nil),                           // 73: temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	(*CancelDLQJobResponse)(nil),                          // 74: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksRequest)(nil),                               // 75: temporal.server.api.adminservice.v1.AddTasksRequest
+	(*AddTasksResponse)(nil),                              // 76: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesRequest)(nil),                             // 77: temporal.server.api.adminservice.v1.ListQueuesRequest
+	(*ListQueuesResponse)(nil),                            // 78: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckRequest)(nil),                        // 79: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	(*DeepHealthCheckResponse)(nil),                       // 80: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateRequest)(nil),                      // 81: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	(*SyncWorkflowStateResponse)(nil),                     // 82: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksRequest)(nil),    // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	(*GenerateLastHistoryReplicationTasksResponse)(nil),   // 84: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionRequest)(nil),             // 85: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	(*DescribeTaskQueuePartitionResponse)(nil),            // 86: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionRequest)(nil),          // 87: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),         // 88: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*GetTaskQueueUserDataRequest)(nil),                   // 89: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
+	(*GetTaskQueueUserDataResponse)(nil),                  // 90: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
+	(*StartAdminBatchOperationRequest)(nil),               // 91: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
+	(*StartAdminBatchOperationResponse)(nil),              // 92: temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
+	(*BatchOperationRefreshTasks)(nil),                    // 93: temporal.server.api.adminservice.v1.BatchOperationRefreshTasks
+	(*MigrateScheduleRequest)(nil),                        // 94: temporal.server.api.adminservice.v1.MigrateScheduleRequest
+	(*MigrateScheduleResponse)(nil),                       // 95: temporal.server.api.adminservice.v1.MigrateScheduleResponse
+	(*DescribeOutboundDestinationHealthRequest)(nil),      // 96: temporal.server.api.adminservice.v1.DescribeOutboundDestinationHealthRequest
+	(*DescribeOutboundDestinationHealthResponse)(nil),     // 97: temporal.server.api.adminservice.v1.DescribeOutboundDestinationHealthResponse
+	(*UpdateOutboundCircuitBreakerRequest)(nil),           // 98: temporal.server.api.adminservice.v1.UpdateOutboundCircuitBreakerRequest
+	(*UpdateOutboundCircuitBreakerResponse)(nil),          // 99: temporal.server.api.adminservice.v1.UpdateOutboundCircuitBreakerResponse
+	(*DescribeNexusEndpointRequest)(nil),                  // 100: temporal.server.api.adminservice.v1.DescribeNexusEndpointRequest
+	(*DescribeNexusEndpointResponse)(nil),                 // 101: temporal.server.api.adminservice.v1.DescribeNexusEndpointResponse
+	(*UpdateNexusEndpointHttpTargetRequest)(nil),          // 102: temporal.server.api.adminservice.v1.UpdateNexusEndpointHttpTargetRequest
+	(*UpdateNexusEndpointHttpTargetResponse)(nil),         // 103: temporal.server.api.adminservice.v1.UpdateNexusEndpointHttpTargetResponse
+	(*DeadLetteredCallbackMessage)(nil),                   // 104: temporal.server.api.adminservice.v1.DeadLetteredCallbackMessage
+	(*ListDeadLetteredCallbacksRequest)(nil),              // 105: temporal.server.api.adminservice.v1.ListDeadLetteredCallbacksRequest
+	(*ListDeadLetteredCallbacksResponse)(nil),             // 106: temporal.server.api.adminservice.v1.ListDeadLetteredCallbacksResponse
+	(*GetDeadLetteredCallbackRequest)(nil),                // 107: temporal.server.api.adminservice.v1.GetDeadLetteredCallbackRequest
+	(*GetDeadLetteredCallbackResponse)(nil),               // 108: temporal.server.api.adminservice.v1.GetDeadLetteredCallbackResponse
+	(*ReplayDeadLetteredCallbacksRequest)(nil),            // 109: temporal.server.api.adminservice.v1.ReplayDeadLetteredCallbacksRequest
+	(*ReplayDeadLetteredCallbacksResponse)(nil),           // 110: temporal.server.api.adminservice.v1.ReplayDeadLetteredCallbacksResponse
+	(*PurgeDeadLetteredCallbacksRequest)(nil),             // 111: temporal.server.api.adminservice.v1.PurgeDeadLetteredCallbacksRequest
+	(*PurgeDeadLetteredCallbacksResponse)(nil),            // 112: temporal.server.api.adminservice.v1.PurgeDeadLetteredCallbacksResponse
+	(*RotateCallbackSigningKeyRequest)(nil),               // 113: temporal.server.api.adminservice.v1.RotateCallbackSigningKeyRequest
+	(*RotateCallbackSigningKeyResponse)(nil),              // 114: temporal.server.api.adminservice.v1.RotateCallbackSigningKeyResponse
+	(*ListCallbackSigningKeysRequest)(nil),                // 115: temporal.server.api.adminservice.v1.ListCallbackSigningKeysRequest
+	(*ListCallbackSigningKeysResponse)(nil),               // 116: temporal.server.api.adminservice.v1.ListCallbackSigningKeysResponse
+	(*DeleteCallbackSigningKeyRequest)(nil),               // 117: temporal.server.api.adminservice.v1.DeleteCallbackSigningKeyRequest
+	(*DeleteCallbackSigningKeyResponse)(nil),              // 118: temporal.server.api.adminservice.v1.DeleteCallbackSigningKeyResponse
+	(*DescribePersistenceConcurrencyLimiterRequest)(nil),  // 119: temporal.server.api.adminservice.v1.DescribePersistenceConcurrencyLimiterRequest
+	(*DescribePersistenceConcurrencyLimiterResponse)(nil), // 120: temporal.server.api.adminservice.v1.DescribePersistenceConcurrencyLimiterResponse
+	(*UpdateFaultInjectionRequest)(nil),                   // 121: temporal.server.api.adminservice.v1.UpdateFaultInjectionRequest
+	(*UpdateFaultInjectionResponse)(nil),                  // 122: temporal.server.api.adminservice.v1.UpdateFaultInjectionResponse
+	(*DescribeFaultInjectionRequest)(nil),                 // 123: temporal.server.api.adminservice.v1.DescribeFaultInjectionRequest
+	(*DescribeFaultInjectionResponse)(nil),                // 124: temporal.server.api.adminservice.v1.DescribeFaultInjectionResponse
+	(*DescribeReplicationLagRequest)(nil),                 // 125: temporal.server.api.adminservice.v1.DescribeReplicationLagRequest
+	(*DescribeReplicationLagResponse)(nil),                // 126: temporal.server.api.adminservice.v1.DescribeReplicationLagResponse
+	(*ReplicationDLQSize)(nil),                            // 127: temporal.server.api.adminservice.v1.ReplicationDLQSize
+	(*GetWorkerScalingRecommendationRequest)(nil),         // 128: temporal.server.api.adminservice.v1.GetWorkerScalingRecommendationRequest
+	(*GetWorkerScalingRecommendationResponse)(nil),        // 129: temporal.server.api.adminservice.v1.GetWorkerScalingRecommendationResponse
+	(*StartWorkerDeploymentRolloutRequest)(nil),           // 130: temporal.server.api.adminservice.v1.StartWorkerDeploymentRolloutRequest
+	(*StartWorkerDeploymentRolloutResponse)(nil),          // 131: temporal.server.api.adminservice.v1.StartWorkerDeploymentRolloutResponse
+	(*UpdateWorkerDeploymentRolloutRequest)(nil),          // 132: temporal.server.api.adminservice.v1.UpdateWorkerDeploymentRolloutRequest
+	(*UpdateWorkerDeploymentRolloutResponse)(nil),         // 133: temporal.server.api.adminservice.v1.UpdateWorkerDeploymentRolloutResponse
+	(*DescribeWorkerDeploymentRolloutRequest)(nil),        // 134: temporal.server.api.adminservice.v1.DescribeWorkerDeploymentRolloutRequest
+	(*DescribeWorkerDeploymentRolloutResponse)(nil),       // 135: temporal.server.api.adminservice.v1.DescribeWorkerDeploymentRolloutResponse
+	(*RenameSearchAttributeAliasRequest)(nil),             // 136: temporal.server.api.adminservice.v1.RenameSearchAttributeAliasRequest
+	(*RenameSearchAttributeAliasResponse)(nil),            // 137: temporal.server.api.adminservice.v1.RenameSearchAttributeAliasResponse
+	(*MigrateSearchAttributeTypeRequest)(nil),             // 138: temporal.server.api.adminservice.v1.MigrateSearchAttributeTypeRequest
+	(*MigrateSearchAttributeTypeResponse)(nil),            // 139: temporal.server.api.adminservice.v1.MigrateSearchAttributeTypeResponse
+	(*CreateWorkflowRuleRequest)(nil),                     // 140: temporal.server.api.adminservice.v1.CreateWorkflowRuleRequest
+	(*CreateWorkflowRuleResponse)(nil),                    // 141: temporal.server.api.adminservice.v1.CreateWorkflowRuleResponse
+	(*DescribeWorkflowRuleRequest)(nil),                   // 142: temporal.server.api.adminservice.v1.DescribeWorkflowRuleRequest
+	(*DescribeWorkflowRuleResponse)(nil),                  // 143: temporal.server.api.adminservice.v1.DescribeWorkflowRuleResponse
+	(*DescribeWorkerRequest)(nil),                         // 144: temporal.server.api.adminservice.v1.DescribeWorkerRequest
+	(*DescribeWorkerResponse)(nil),                        // 145: temporal.server.api.adminservice.v1.DescribeWorkerResponse
+	(*StartVisibilityBackfillRequest)(nil),                // 146: temporal.server.api.adminservice.v1.StartVisibilityBackfillRequest
+	(*StartVisibilityBackfillResponse)(nil),               // 147: temporal.server.api.adminservice.v1.StartVisibilityBackfillResponse
+	(*DescribeVisibilityBackfillRequest)(nil),             // 148: temporal.server.api.adminservice.v1.DescribeVisibilityBackfillRequest
+	(*DescribeVisibilityBackfillResponse)(nil),            // 149: temporal.server.api.adminservice.v1.DescribeVisibilityBackfillResponse
+	(*VisibilityCountMismatch)(nil),                       // 150: temporal.server.api.adminservice.v1.VisibilityCountMismatch
+	nil,                                                   // 151: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	nil,                                                   // 152: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	nil,                                                   // 153: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	nil,                                                   // 154: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	nil,                                                   // 155: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	nil,                                                   // 156: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	nil,                                                   // 157: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	nil,                                                   // 158: temporal.server.api.adminservice.v1.GetNamespaceResponse.SearchAttributeAliasTransitionsEntry
+	(*AddTasksRequest_Task)(nil),                          // 159: temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	(*ListQueuesResponse_QueueInfo)(nil),                  // 160: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	nil,                                                   // 161: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	(*v1.WorkflowExecution)(nil),                          // 162: temporal.api.common.v1.WorkflowExecution
+	(*v1.DataBlob)(nil),                                   // 163: temporal.api.common.v1.DataBlob
+	(*v11.VersionHistory)(nil),                            // 164: temporal.server.api.history.v1.VersionHistory
+	(*v12.WorkflowMutableState)(nil),                      // 165: temporal.server.api.persistence.v1.WorkflowMutableState
+	(*v13.NamespaceCacheInfo)(nil),                        // 166: temporal.server.api.namespace.v1.NamespaceCacheInfo
+	(*v12.ShardInfo)(nil),                                 // 167: temporal.server.api.persistence.v1.ShardInfo
+	(*v11.TaskRange)(nil),                                 // 168: temporal.server.api.history.v1.TaskRange
+	(v14.TaskType)(0),                                     // 169: temporal.server.api.enums.v1.TaskType
+	(*timestamppb.Timestamp)(nil),                         // 170: google.protobuf.Timestamp
+	(*v15.ReplicationToken)(nil),                          // 171: temporal.server.api.replication.v1.ReplicationToken
+	(*v15.ReplicationMessages)(nil),                       // 172: temporal.server.api.replication.v1.ReplicationMessages
+	(*v15.ReplicationTaskInfo)(nil),                       // 173: temporal.server.api.replication.v1.ReplicationTaskInfo
+	(*v15.ReplicationTask)(nil),                           // 174: temporal.server.api.replication.v1.ReplicationTask
+	(*v17.WorkflowExecutionInfo)(nil),                     // 175: temporal.api.workflow.v1.WorkflowExecutionInfo
+	(*v18.MembershipInfo)(nil),                            // 176: temporal.server.api.cluster.v1.MembershipInfo
+	(*v19.VersionInfo)(nil),                               // 177: temporal.api.version.v1.VersionInfo
+	(*v12.ClusterMetadata)(nil),                           // 178: temporal.server.api.persistence.v1.ClusterMetadata
+	(*durationpb.Duration)(nil),                           // 179: google.protobuf.Duration
+	(v14.ClusterMemberRole)(0),                            // 180: temporal.server.api.enums.v1.ClusterMemberRole
+	(*v18.ClusterMember)(nil),                             // 181: temporal.server.api.cluster.v1.ClusterMember
+	(v14.DeadLetterQueueType)(0),                          // 182: temporal.server.api.enums.v1.DeadLetterQueueType
+	(v16.TaskQueueType)(0),                                // 183: temporal.api.enums.v1.TaskQueueType
+	(*v12.AllocatedTaskInfo)(nil),                         // 184: temporal.server.api.persistence.v1.AllocatedTaskInfo
+	(*v15.SyncReplicationState)(nil),                      // 185: temporal.server.api.replication.v1.SyncReplicationState
+	(*v15.WorkflowReplicationMessages)(nil),               // 186: temporal.server.api.replication.v1.WorkflowReplicationMessages
+	(*v110.NamespaceInfo)(nil),                            // 187: temporal.api.namespace.v1.NamespaceInfo
+	(*v110.NamespaceConfig)(nil),                          // 188: temporal.api.namespace.v1.NamespaceConfig
+	(*v111.NamespaceReplicationConfig)(nil),               // 189: temporal.api.replication.v1.NamespaceReplicationConfig
+	(*v111.FailoverStatus)(nil),                           // 190: temporal.api.replication.v1.FailoverStatus
+	(*v112.HistoryDLQKey)(nil),                            // 191: temporal.server.api.common.v1.HistoryDLQKey
+	(*v112.HistoryDLQTask)(nil),                           // 192: temporal.server.api.common.v1.HistoryDLQTask
+	(*v112.HistoryDLQTaskMetadata)(nil),                   // 193: temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	(v14.DLQOperationType)(0),                             // 194: temporal.server.api.enums.v1.DLQOperationType
+	(v14.DLQOperationState)(0),                            // 195: temporal.server.api.enums.v1.DLQOperationState
+	(v14.HealthState)(0),                                  // 196: temporal.server.api.enums.v1.HealthState
+	(*v113.ServiceHealthDetail)(nil),                      // 197: temporal.server.api.health.v1.ServiceHealthDetail
+	(*v12.VersionedTransition)(nil),                       // 198: temporal.server.api.persistence.v1.VersionedTransition
+	(*v11.VersionHistories)(nil),                          // 199: temporal.server.api.history.v1.VersionHistories
+	(*v15.VersionedTransitionArtifact)(nil),               // 200: temporal.server.api.replication.v1.VersionedTransitionArtifact
+	(*v114.TaskQueuePartition)(nil),                       // 201: temporal.server.api.taskqueue.v1.TaskQueuePartition
+	(*v115.TaskQueueVersionSelection)(nil),                // 202: temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	(*v12.TaskQueueTypeUserData)(nil),                     // 203: temporal.server.api.persistence.v1.TaskQueueTypeUserData
+	(*v113.OutboundDestinationHealth)(nil),                // 204: temporal.server.api.health.v1.OutboundDestinationHealth
+	(*v116.Endpoint)(nil),                                 // 205: temporal.api.nexus.v1.Endpoint
+	(*v12.NexusEndpointTarget_Http)(nil),                  // 206: temporal.server.api.persistence.v1.NexusEndpointTarget.Http
+	(*v12.NexusEndpointEntry)(nil),                        // 207: temporal.server.api.persistence.v1.NexusEndpointEntry
+	(*v12.DeadLetteredCallback)(nil),                      // 208: temporal.server.api.persistence.v1.DeadLetteredCallback
+	(*v12.CallbackSigningKey)(nil),                        // 209: temporal.server.api.persistence.v1.CallbackSigningKey
+	(*v113.PersistenceConcurrencyLimiterState)(nil),       // 210: temporal.server.api.health.v1.PersistenceConcurrencyLimiterState
+	(*v117.Fault)(nil),                                    // 211: temporal.server.api.faultinjection.v1.Fault
+	(*v117.HostFaults)(nil),                               // 212: temporal.server.api.faultinjection.v1.HostFaults
+	(*v15.ShardReplicationLag)(nil),                       // 213: temporal.server.api.replication.v1.ShardReplicationLag
+	(*v118.WorkerDeploymentVersion)(nil),                  // 214: temporal.api.deployment.v1.WorkerDeploymentVersion
+	(*v114.WorkerScalingRecommendation)(nil),              // 215: temporal.server.api.taskqueue.v1.WorkerScalingRecommendation
+	(*v119.WorkerDeploymentRolloutPlan)(nil),              // 216: temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlan
+	(*v119.WorkerDeploymentRollout)(nil),                  // 217: temporal.server.api.deployment.v1.WorkerDeploymentRollout
+	(v14.WorkerDeploymentRolloutAction)(0),                // 218: temporal.server.api.enums.v1.WorkerDeploymentRolloutAction
+	(v16.IndexedValueType)(0),                             // 219: temporal.api.enums.v1.IndexedValueType
+	(*v12.SearchAttributeTypeMigration)(nil),              // 220: temporal.server.api.persistence.v1.SearchAttributeTypeMigration
+	(*v120.WorkflowRuleSpec)(nil),                         // 221: temporal.api.rules.v1.WorkflowRuleSpec
+	(*v12.WorkflowRuleExtension)(nil),                     // 222: temporal.server.api.persistence.v1.WorkflowRuleExtension
+	(*v120.WorkflowRule)(nil),                             // 223: temporal.api.rules.v1.WorkflowRule
+	(*v121.WorkerInfo)(nil),                               // 224: temporal.api.worker.v1.WorkerInfo
+	(*v121.WorkerHeartbeat)(nil),                          // 225: temporal.api.worker.v1.WorkerHeartbeat
+	(v16.WorkflowExecutionStatus)(0),                      // 226: temporal.api.enums.v1.WorkflowExecutionStatus
+	(*v12.SearchAttributeAliasTransition)(nil),            // 227: temporal.server.api.persistence.v1.SearchAttributeAliasTransition
+	(*v114.TaskQueueVersionInfoInternal)(nil),             // 228: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
 }
 var file_temporal_server_api_adminservice_v1_request_response_proto_depIdxs = []int32{
-	106, // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	106, // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	108, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	106, // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	109, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	109, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	106, // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	110, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
-	111, // 9: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
-	112, // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
+	162, // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	162, // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	163, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	164, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	162, // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	165, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	165, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	162, // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	166, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
+	167, // 9: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
+	168, // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
 	15,  // 11: temporal.server.api.adminservice.v1.ListHistoryTasksResponse.tasks:type_name -> temporal.server.api.adminservice.v1.Task
-	113, // 12: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
-	114, // 13: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
-	114, // 14: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
-	106, // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 16: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	108, // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	106, // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	108, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	115, // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
-	96,  // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	116, // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	117, // 24: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	118, // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	106, // 26: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	107, // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
-	97,  // 28: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	98,  // 29: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	99,  // 30: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	100, // 31: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	119, // 32: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
-	101, // 33: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	120, // 34: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
-	121, // 35: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
-	102, // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	122, // 37: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
-	123, // 38: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
-	124, // 39: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
-	114, // 40: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
-	125, // 41: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
-	126, // 42: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	126, // 43: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	118, // 44: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	117, // 45: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	126, // 46: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	126, // 47: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	106, // 48: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	127, // 49: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	128, // 50: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
-	106, // 51: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	129, // 52: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
-	130, // 53: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
-	131, // 54: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
-	132, // 55: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
-	133, // 56: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
-	134, // 57: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
-	135, // 58: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	136, // 59: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
-	135, // 60: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	137, // 61: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	135, // 62: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	137, // 63: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	135, // 64: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	138, // 65: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
-	139, // 66: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
-	114, // 67: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
-	114, // 68: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
-	103, // 69: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	104, // 70: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	140, // 71: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
-	141, // 72: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.services:type_name -> temporal.server.api.health.v1.ServiceHealthDetail
-	106, // 73: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	142, // 74: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	143, // 75: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
-	144, // 76: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
-	106, // 77: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	145, // 78: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	146, // 79: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	105, // 80: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	145, // 81: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	127, // 82: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	147, // 83: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse.user_data:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
-	106, // 84: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.executions:type_name -> temporal.api.common.v1.WorkflowExecution
-	93,  // 85: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.refresh_tasks_operation:type_name -> temporal.server.api.adminservice.v1.BatchOperationRefreshTasks
-	0,   // 86: temporal.server.api.adminservice.v1.MigrateScheduleRequest.target:type_name -> temporal.server.api.adminservice.v1.MigrateScheduleRequest.SchedulerTarget
-	116, // 87: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	148, // 88: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	148, // 89: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	148, // 90: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	107, // 91: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
-	149, // 92: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
-	93,  // [93:93] is the sub-list for method output_type
-	93,  // [93:93] is the sub-list for method input_type
-	93,  // [93:93] is the sub-list for extension type_name
-	93,  // [93:93] is the sub-list for extension extendee
-	0,   // [0:93] is the sub-list for field type_name
+	169, // 12: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	170, // 13: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
+	170, // 14: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
+	162, // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	163, // 16: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	164, // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	162, // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	163, // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	164, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	171, // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
+	151, // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	172, // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	173, // 24: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	174, // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	162, // 26: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	163, // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
+	152, // 28: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	153, // 29: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	154, // 30: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	155, // 31: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	175, // 32: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
+	156, // 33: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	176, // 34: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
+	177, // 35: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
+	157, // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	178, // 37: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
+	179, // 38: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
+	180, // 39: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
+	170, // 40: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
+	181, // 41: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
+	182, // 42: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	182, // 43: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	174, // 44: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	173, // 45: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	182, // 46: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	182, // 47: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	162, // 48: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	183, // 49: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	184, // 50: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
+	162, // 51: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	185, // 52: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
+	186, // 53: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
+	187, // 54: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
+	188, // 55: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
+	189, // 56: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
+	190, // 57: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
+	158, // 58: temporal.server.api.adminservice.v1.GetNamespaceResponse.search_attribute_alias_transitions:type_name -> temporal.server.api.adminservice.v1.GetNamespaceResponse.SearchAttributeAliasTransitionsEntry
+	191, // 59: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	192, // 60: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
+	191, // 61: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	193, // 62: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	191, // 63: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	193, // 64: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	191, // 65: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	194, // 66: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
+	195, // 67: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
+	170, // 68: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
+	170, // 69: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
+	159, // 70: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	160, // 71: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	196, // 72: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
+	197, // 73: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.services:type_name -> temporal.server.api.health.v1.ServiceHealthDetail
+	162, // 74: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	198, // 75: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	199, // 76: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
+	200, // 77: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
+	162, // 78: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	201, // 79: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	202, // 80: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	161, // 81: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	201, // 82: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	183, // 83: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	203, // 84: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse.user_data:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
+	162, // 85: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.executions:type_name -> temporal.api.common.v1.WorkflowExecution
+	93,  // 86: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest.refresh_tasks_operation:type_name -> temporal.server.api.adminservice.v1.BatchOperationRefreshTasks
+	0,   // 87: temporal.server.api.adminservice.v1.MigrateScheduleRequest.target:type_name -> temporal.server.api.adminservice.v1.MigrateScheduleRequest.SchedulerTarget
+	204, // 88: temporal.server.api.adminservice.v1.DescribeOutboundDestinationHealthResponse.destinations:type_name -> temporal.server.api.health.v1.OutboundDestinationHealth
+	204, // 89: temporal.server.api.adminservice.v1.DescribeOutboundDestinationHealthResponse.host_destinations:type_name -> temporal.server.api.health.v1.OutboundDestinationHealth
+	205, // 90: temporal.server.api.adminservice.v1.DescribeNexusEndpointResponse.endpoint:type_name -> temporal.api.nexus.v1.Endpoint
+	204, // 91: temporal.server.api.adminservice.v1.DescribeNexusEndpointResponse.destinations:type_name -> temporal.server.api.health.v1.OutboundDestinationHealth
+	204, // 92: temporal.server.api.adminservice.v1.DescribeNexusEndpointResponse.host_destinations:type_name -> temporal.server.api.health.v1.OutboundDestinationHealth
+	206, // 93: temporal.server.api.adminservice.v1.UpdateNexusEndpointHttpTargetRequest.target:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Http
+	207, // 94: temporal.server.api.adminservice.v1.UpdateNexusEndpointHttpTargetResponse.entry:type_name -> temporal.server.api.persistence.v1.NexusEndpointEntry
+	208, // 95: temporal.server.api.adminservice.v1.DeadLetteredCallbackMessage.callback:type_name -> temporal.server.api.persistence.v1.DeadLetteredCallback
+	104, // 96: temporal.server.api.adminservice.v1.ListDeadLetteredCallbacksResponse.messages:type_name -> temporal.server.api.adminservice.v1.DeadLetteredCallbackMessage
+	104, // 97: temporal.server.api.adminservice.v1.GetDeadLetteredCallbackResponse.message:type_name -> temporal.server.api.adminservice.v1.DeadLetteredCallbackMessage
+	179, // 98: temporal.server.api.adminservice.v1.RotateCallbackSigningKeyRequest.previous_key_overlap:type_name -> google.protobuf.Duration
+	209, // 99: temporal.server.api.adminservice.v1.RotateCallbackSigningKeyResponse.key:type_name -> temporal.server.api.persistence.v1.CallbackSigningKey
+	209, // 100: temporal.server.api.adminservice.v1.ListCallbackSigningKeysResponse.keys:type_name -> temporal.server.api.persistence.v1.CallbackSigningKey
+	210, // 101: temporal.server.api.adminservice.v1.DescribePersistenceConcurrencyLimiterResponse.states:type_name -> temporal.server.api.health.v1.PersistenceConcurrencyLimiterState
+	211, // 102: temporal.server.api.adminservice.v1.UpdateFaultInjectionRequest.upsert_faults:type_name -> temporal.server.api.faultinjection.v1.Fault
+	212, // 103: temporal.server.api.adminservice.v1.UpdateFaultInjectionResponse.hosts:type_name -> temporal.server.api.faultinjection.v1.HostFaults
+	212, // 104: temporal.server.api.adminservice.v1.DescribeFaultInjectionResponse.hosts:type_name -> temporal.server.api.faultinjection.v1.HostFaults
+	213, // 105: temporal.server.api.adminservice.v1.DescribeReplicationLagResponse.shards:type_name -> temporal.server.api.replication.v1.ShardReplicationLag
+	127, // 106: temporal.server.api.adminservice.v1.DescribeReplicationLagResponse.dlqs:type_name -> temporal.server.api.adminservice.v1.ReplicationDLQSize
+	183, // 107: temporal.server.api.adminservice.v1.GetWorkerScalingRecommendationRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	214, // 108: temporal.server.api.adminservice.v1.GetWorkerScalingRecommendationRequest.deployment_version:type_name -> temporal.api.deployment.v1.WorkerDeploymentVersion
+	215, // 109: temporal.server.api.adminservice.v1.GetWorkerScalingRecommendationResponse.recommendation:type_name -> temporal.server.api.taskqueue.v1.WorkerScalingRecommendation
+	216, // 110: temporal.server.api.adminservice.v1.StartWorkerDeploymentRolloutRequest.plan:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlan
+	217, // 111: temporal.server.api.adminservice.v1.StartWorkerDeploymentRolloutResponse.rollout:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentRollout
+	218, // 112: temporal.server.api.adminservice.v1.UpdateWorkerDeploymentRolloutRequest.action:type_name -> temporal.server.api.enums.v1.WorkerDeploymentRolloutAction
+	217, // 113: temporal.server.api.adminservice.v1.UpdateWorkerDeploymentRolloutResponse.rollout:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentRollout
+	217, // 114: temporal.server.api.adminservice.v1.DescribeWorkerDeploymentRolloutResponse.rollout:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentRollout
+	179, // 115: temporal.server.api.adminservice.v1.RenameSearchAttributeAliasRequest.transition_window:type_name -> google.protobuf.Duration
+	219, // 116: temporal.server.api.adminservice.v1.MigrateSearchAttributeTypeRequest.type:type_name -> temporal.api.enums.v1.IndexedValueType
+	220, // 117: temporal.server.api.adminservice.v1.MigrateSearchAttributeTypeResponse.migration:type_name -> temporal.server.api.persistence.v1.SearchAttributeTypeMigration
+	221, // 118: temporal.server.api.adminservice.v1.CreateWorkflowRuleRequest.spec:type_name -> temporal.api.rules.v1.WorkflowRuleSpec
+	222, // 119: temporal.server.api.adminservice.v1.CreateWorkflowRuleRequest.extension:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtension
+	223, // 120: temporal.server.api.adminservice.v1.CreateWorkflowRuleResponse.rule:type_name -> temporal.api.rules.v1.WorkflowRule
+	223, // 121: temporal.server.api.adminservice.v1.DescribeWorkflowRuleResponse.rule:type_name -> temporal.api.rules.v1.WorkflowRule
+	222, // 122: temporal.server.api.adminservice.v1.DescribeWorkflowRuleResponse.extension:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtension
+	224, // 123: temporal.server.api.adminservice.v1.DescribeWorkerResponse.worker_info:type_name -> temporal.api.worker.v1.WorkerInfo
+	225, // 124: temporal.server.api.adminservice.v1.DescribeWorkerResponse.heartbeat_history:type_name -> temporal.api.worker.v1.WorkerHeartbeat
+	226, // 125: temporal.server.api.adminservice.v1.DescribeVisibilityBackfillResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	150, // 126: temporal.server.api.adminservice.v1.DescribeVisibilityBackfillResponse.count_mismatches:type_name -> temporal.server.api.adminservice.v1.VisibilityCountMismatch
+	172, // 127: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	219, // 128: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	219, // 129: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	219, // 130: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	227, // 131: temporal.server.api.adminservice.v1.GetNamespaceResponse.SearchAttributeAliasTransitionsEntry.value:type_name -> temporal.server.api.persistence.v1.SearchAttributeAliasTransition
+	163, // 132: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
+	228, // 133: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	134, // [134:134] is the sub-list for method output_type
+	134, // [134:134] is the sub-list for method input_type
+	134, // [134:134] is the sub-list for extension type_name
+	134, // [134:134] is the sub-list for extension extendee
+	0,   // [0:134] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_request_response_proto_init() }
@@ -6634,7 +10094,7 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc), len(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   105,
+			NumMessages:   161,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,7 +26,7 @@ var File_temporal_server_api_adminservice_v1_service_proto protoreflect.FileDesc
 
 const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto\x1a0temporal/server/api/common/v1/api_category.proto2\xb3:\n" +
+	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto\x1a0temporal/server/api/common/v1/api_category.proto2\xa4_\n" +
 	"\fAdminService\x12\xa0\x01\n" +
 	"\x13RebuildMutableState\x12?.temporal.server.api.adminservice.v1.RebuildMutableStateRequest\x1a@.temporal.server.api.adminservice.v1.RebuildMutableStateResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xac\x01\n" +
 	"\x17ImportWorkflowExecution\x12C.temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest\x1aD.temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa3\x01\n" +
@@ -76,200 +76,330 @@ const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\x1aDescribeTaskQueuePartition\x12F.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest\x1aG.temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xbe\x01\n" +
 	"\x1dForceUnloadTaskQueuePartition\x12I.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest\x1aJ.temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa3\x01\n" +
 	"\x14GetTaskQueueUserData\x12@.temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest\x1aA.temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x94\x01\n" +
-	"\x0fMigrateSchedule\x12;.temporal.server.api.adminservice.v1.MigrateScheduleRequest\x1a<.temporal.server.api.adminservice.v1.MigrateScheduleResponse\"\x06\x8a\xb5\x18\x02\b\x03B8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"\x0fMigrateSchedule\x12;.temporal.server.api.adminservice.v1.MigrateScheduleRequest\x1a<.temporal.server.api.adminservice.v1.MigrateScheduleResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xca\x01\n" +
+	"!DescribeOutboundDestinationHealth\x12M.temporal.server.api.adminservice.v1.DescribeOutboundDestinationHealthRequest\x1aN.temporal.server.api.adminservice.v1.DescribeOutboundDestinationHealthResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xbb\x01\n" +
+	"\x1cUpdateOutboundCircuitBreaker\x12H.temporal.server.api.adminservice.v1.UpdateOutboundCircuitBreakerRequest\x1aI.temporal.server.api.adminservice.v1.UpdateOutboundCircuitBreakerResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa6\x01\n" +
+	"\x15DescribeNexusEndpoint\x12A.temporal.server.api.adminservice.v1.DescribeNexusEndpointRequest\x1aB.temporal.server.api.adminservice.v1.DescribeNexusEndpointResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xbe\x01\n" +
+	"\x1dUpdateNexusEndpointHttpTarget\x12I.temporal.server.api.adminservice.v1.UpdateNexusEndpointHttpTargetRequest\x1aJ.temporal.server.api.adminservice.v1.UpdateNexusEndpointHttpTargetResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb2\x01\n" +
+	"\x19ListDeadLetteredCallbacks\x12E.temporal.server.api.adminservice.v1.ListDeadLetteredCallbacksRequest\x1aF.temporal.server.api.adminservice.v1.ListDeadLetteredCallbacksResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xac\x01\n" +
+	"\x17GetDeadLetteredCallback\x12C.temporal.server.api.adminservice.v1.GetDeadLetteredCallbackRequest\x1aD.temporal.server.api.adminservice.v1.GetDeadLetteredCallbackResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb8\x01\n" +
+	"\x1bReplayDeadLetteredCallbacks\x12G.temporal.server.api.adminservice.v1.ReplayDeadLetteredCallbacksRequest\x1aH.temporal.server.api.adminservice.v1.ReplayDeadLetteredCallbacksResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb5\x01\n" +
+	"\x1aPurgeDeadLetteredCallbacks\x12F.temporal.server.api.adminservice.v1.PurgeDeadLetteredCallbacksRequest\x1aG.temporal.server.api.adminservice.v1.PurgeDeadLetteredCallbacksResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xaf\x01\n" +
+	"\x18RotateCallbackSigningKey\x12D.temporal.server.api.adminservice.v1.RotateCallbackSigningKeyRequest\x1aE.temporal.server.api.adminservice.v1.RotateCallbackSigningKeyResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xac\x01\n" +
+	"\x17ListCallbackSigningKeys\x12C.temporal.server.api.adminservice.v1.ListCallbackSigningKeysRequest\x1aD.temporal.server.api.adminservice.v1.ListCallbackSigningKeysResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xaf\x01\n" +
+	"\x18DeleteCallbackSigningKey\x12D.temporal.server.api.adminservice.v1.DeleteCallbackSigningKeyRequest\x1aE.temporal.server.api.adminservice.v1.DeleteCallbackSigningKeyResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xd6\x01\n" +
+	"%DescribePersistenceConcurrencyLimiter\x12Q.temporal.server.api.adminservice.v1.DescribePersistenceConcurrencyLimiterRequest\x1aR.temporal.server.api.adminservice.v1.DescribePersistenceConcurrencyLimiterResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa3\x01\n" +
+	"\x14UpdateFaultInjection\x12@.temporal.server.api.adminservice.v1.UpdateFaultInjectionRequest\x1aA.temporal.server.api.adminservice.v1.UpdateFaultInjectionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa9\x01\n" +
+	"\x16DescribeFaultInjection\x12B.temporal.server.api.adminservice.v1.DescribeFaultInjectionRequest\x1aC.temporal.server.api.adminservice.v1.DescribeFaultInjectionResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa9\x01\n" +
+	"\x16DescribeReplicationLag\x12B.temporal.server.api.adminservice.v1.DescribeReplicationLagRequest\x1aC.temporal.server.api.adminservice.v1.DescribeReplicationLagResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xc1\x01\n" +
+	"\x1eGetWorkerScalingRecommendation\x12J.temporal.server.api.adminservice.v1.GetWorkerScalingRecommendationRequest\x1aK.temporal.server.api.adminservice.v1.GetWorkerScalingRecommendationResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xbb\x01\n" +
+	"\x1cStartWorkerDeploymentRollout\x12H.temporal.server.api.adminservice.v1.StartWorkerDeploymentRolloutRequest\x1aI.temporal.server.api.adminservice.v1.StartWorkerDeploymentRolloutResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xbe\x01\n" +
+	"\x1dUpdateWorkerDeploymentRollout\x12I.temporal.server.api.adminservice.v1.UpdateWorkerDeploymentRolloutRequest\x1aJ.temporal.server.api.adminservice.v1.UpdateWorkerDeploymentRolloutResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xc4\x01\n" +
+	"\x1fDescribeWorkerDeploymentRollout\x12K.temporal.server.api.adminservice.v1.DescribeWorkerDeploymentRolloutRequest\x1aL.temporal.server.api.adminservice.v1.DescribeWorkerDeploymentRolloutResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb5\x01\n" +
+	"\x1aRenameSearchAttributeAlias\x12F.temporal.server.api.adminservice.v1.RenameSearchAttributeAliasRequest\x1aG.temporal.server.api.adminservice.v1.RenameSearchAttributeAliasResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb5\x01\n" +
+	"\x1aMigrateSearchAttributeType\x12F.temporal.server.api.adminservice.v1.MigrateSearchAttributeTypeRequest\x1aG.temporal.server.api.adminservice.v1.MigrateSearchAttributeTypeResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x9d\x01\n" +
+	"\x12CreateWorkflowRule\x12>.temporal.server.api.adminservice.v1.CreateWorkflowRuleRequest\x1a?.temporal.server.api.adminservice.v1.CreateWorkflowRuleResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xa3\x01\n" +
+	"\x14DescribeWorkflowRule\x12@.temporal.server.api.adminservice.v1.DescribeWorkflowRuleRequest\x1aA.temporal.server.api.adminservice.v1.DescribeWorkflowRuleResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\x91\x01\n" +
+	"\x0eDescribeWorker\x12:.temporal.server.api.adminservice.v1.DescribeWorkerRequest\x1a;.temporal.server.api.adminservice.v1.DescribeWorkerResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xac\x01\n" +
+	"\x17StartVisibilityBackfill\x12C.temporal.server.api.adminservice.v1.StartVisibilityBackfillRequest\x1aD.temporal.server.api.adminservice.v1.StartVisibilityBackfillResponse\"\x06\x8a\xb5\x18\x02\b\x03\x12\xb5\x01\n" +
+	"\x1aDescribeVisibilityBackfill\x12F.temporal.server.api.adminservice.v1.DescribeVisibilityBackfillRequest\x1aG.temporal.server.api.adminservice.v1.DescribeVisibilityBackfillResponse\"\x06\x8a\xb5\x18\x02\b\x03B8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var file_temporal_server_api_adminservice_v1_service_proto_goTypes = []any{
-	(*RebuildMutableStateRequest)(nil),                  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
-	(*ImportWorkflowExecutionRequest)(nil),              // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	(*DescribeMutableStateRequest)(nil),                 // 2: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	(*DescribeHistoryHostRequest)(nil),                  // 3: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	(*GetShardRequest)(nil),                             // 4: temporal.server.api.adminservice.v1.GetShardRequest
-	(*CloseShardRequest)(nil),                           // 5: temporal.server.api.adminservice.v1.CloseShardRequest
-	(*ListHistoryTasksRequest)(nil),                     // 6: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	(*RemoveTaskRequest)(nil),                           // 7: temporal.server.api.adminservice.v1.RemoveTaskRequest
-	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 8: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 9: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	(*GetReplicationMessagesRequest)(nil),               // 10: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	(*GetNamespaceReplicationMessagesRequest)(nil),      // 11: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	(*GetDLQReplicationMessagesRequest)(nil),            // 12: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	(*ReapplyEventsRequest)(nil),                        // 13: temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	(*AddSearchAttributesRequest)(nil),                  // 14: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	(*RemoveSearchAttributesRequest)(nil),               // 15: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	(*GetSearchAttributesRequest)(nil),                  // 16: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	(*DescribeClusterRequest)(nil),                      // 17: temporal.server.api.adminservice.v1.DescribeClusterRequest
-	(*ListClustersRequest)(nil),                         // 18: temporal.server.api.adminservice.v1.ListClustersRequest
-	(*ListClusterMembersRequest)(nil),                   // 19: temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	(*AddOrUpdateRemoteClusterRequest)(nil),             // 20: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	(*RemoveRemoteClusterRequest)(nil),                  // 21: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	(*GetDLQMessagesRequest)(nil),                       // 22: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	(*PurgeDLQMessagesRequest)(nil),                     // 23: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	(*MergeDLQMessagesRequest)(nil),                     // 24: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	(*RefreshWorkflowTasksRequest)(nil),                 // 25: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	(*StartAdminBatchOperationRequest)(nil),             // 26: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
-	(*ResendReplicationTasksRequest)(nil),               // 27: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	(*GetTaskQueueTasksRequest)(nil),                    // 28: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	(*DeleteWorkflowExecutionRequest)(nil),              // 29: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 30: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	(*GetNamespaceRequest)(nil),                         // 31: temporal.server.api.adminservice.v1.GetNamespaceRequest
-	(*GetDLQTasksRequest)(nil),                          // 32: temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	(*PurgeDLQTasksRequest)(nil),                        // 33: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	(*MergeDLQTasksRequest)(nil),                        // 34: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	(*DescribeDLQJobRequest)(nil),                       // 35: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	(*CancelDLQJobRequest)(nil),                         // 36: temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	(*AddTasksRequest)(nil),                             // 37: temporal.server.api.adminservice.v1.AddTasksRequest
-	(*ListQueuesRequest)(nil),                           // 38: temporal.server.api.adminservice.v1.ListQueuesRequest
-	(*DeepHealthCheckRequest)(nil),                      // 39: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	(*SyncWorkflowStateRequest)(nil),                    // 40: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 41: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	(*DescribeTaskQueuePartitionRequest)(nil),           // 42: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 43: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	(*GetTaskQueueUserDataRequest)(nil),                 // 44: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
-	(*MigrateScheduleRequest)(nil),                      // 45: temporal.server.api.adminservice.v1.MigrateScheduleRequest
-	(*RebuildMutableStateResponse)(nil),                 // 46: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	(*ImportWorkflowExecutionResponse)(nil),             // 47: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	(*DescribeMutableStateResponse)(nil),                // 48: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	(*DescribeHistoryHostResponse)(nil),                 // 49: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	(*GetShardResponse)(nil),                            // 50: temporal.server.api.adminservice.v1.GetShardResponse
-	(*CloseShardResponse)(nil),                          // 51: temporal.server.api.adminservice.v1.CloseShardResponse
-	(*ListHistoryTasksResponse)(nil),                    // 52: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	(*RemoveTaskResponse)(nil),                          // 53: temporal.server.api.adminservice.v1.RemoveTaskResponse
-	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 54: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 55: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	(*GetReplicationMessagesResponse)(nil),              // 56: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	(*GetNamespaceReplicationMessagesResponse)(nil),     // 57: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	(*GetDLQReplicationMessagesResponse)(nil),           // 58: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	(*ReapplyEventsResponse)(nil),                       // 59: temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	(*AddSearchAttributesResponse)(nil),                 // 60: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	(*RemoveSearchAttributesResponse)(nil),              // 61: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	(*GetSearchAttributesResponse)(nil),                 // 62: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	(*DescribeClusterResponse)(nil),                     // 63: temporal.server.api.adminservice.v1.DescribeClusterResponse
-	(*ListClustersResponse)(nil),                        // 64: temporal.server.api.adminservice.v1.ListClustersResponse
-	(*ListClusterMembersResponse)(nil),                  // 65: temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	(*AddOrUpdateRemoteClusterResponse)(nil),            // 66: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	(*RemoveRemoteClusterResponse)(nil),                 // 67: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	(*GetDLQMessagesResponse)(nil),                      // 68: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	(*PurgeDLQMessagesResponse)(nil),                    // 69: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	(*MergeDLQMessagesResponse)(nil),                    // 70: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	(*RefreshWorkflowTasksResponse)(nil),                // 71: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	(*StartAdminBatchOperationResponse)(nil),            // 72: temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
-	(*ResendReplicationTasksResponse)(nil),              // 73: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	(*GetTaskQueueTasksResponse)(nil),                   // 74: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionResponse)(nil),             // 75: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 76: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceResponse)(nil),                        // 77: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksResponse)(nil),                         // 78: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksResponse)(nil),                       // 79: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*MergeDLQTasksResponse)(nil),                       // 80: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobResponse)(nil),                      // 81: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobResponse)(nil),                        // 82: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksResponse)(nil),                            // 83: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesResponse)(nil),                          // 84: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckResponse)(nil),                     // 85: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateResponse)(nil),                   // 86: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 87: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 88: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 89: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	(*GetTaskQueueUserDataResponse)(nil),                // 90: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
-	(*MigrateScheduleResponse)(nil),                     // 91: temporal.server.api.adminservice.v1.MigrateScheduleResponse
+	(*RebuildMutableStateRequest)(nil),                    // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
+	(*ImportWorkflowExecutionRequest)(nil),                // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	(*DescribeMutableStateRequest)(nil),                   // 2: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	(*DescribeHistoryHostRequest)(nil),                    // 3: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	(*GetShardRequest)(nil),                               // 4: temporal.server.api.adminservice.v1.GetShardRequest
+	(*CloseShardRequest)(nil),                             // 5: temporal.server.api.adminservice.v1.CloseShardRequest
+	(*ListHistoryTasksRequest)(nil),                       // 6: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	(*RemoveTaskRequest)(nil),                             // 7: temporal.server.api.adminservice.v1.RemoveTaskRequest
+	(*GetWorkflowExecutionRawHistoryV2Request)(nil),       // 8: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	(*GetWorkflowExecutionRawHistoryRequest)(nil),         // 9: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	(*GetReplicationMessagesRequest)(nil),                 // 10: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	(*GetNamespaceReplicationMessagesRequest)(nil),        // 11: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	(*GetDLQReplicationMessagesRequest)(nil),              // 12: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	(*ReapplyEventsRequest)(nil),                          // 13: temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	(*AddSearchAttributesRequest)(nil),                    // 14: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	(*RemoveSearchAttributesRequest)(nil),                 // 15: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	(*GetSearchAttributesRequest)(nil),                    // 16: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	(*DescribeClusterRequest)(nil),                        // 17: temporal.server.api.adminservice.v1.DescribeClusterRequest
+	(*ListClustersRequest)(nil),                           // 18: temporal.server.api.adminservice.v1.ListClustersRequest
+	(*ListClusterMembersRequest)(nil),                     // 19: temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	(*AddOrUpdateRemoteClusterRequest)(nil),               // 20: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	(*RemoveRemoteClusterRequest)(nil),                    // 21: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	(*GetDLQMessagesRequest)(nil),                         // 22: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	(*PurgeDLQMessagesRequest)(nil),                       // 23: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	(*MergeDLQMessagesRequest)(nil),                       // 24: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	(*RefreshWorkflowTasksRequest)(nil),                   // 25: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	(*StartAdminBatchOperationRequest)(nil),               // 26: temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
+	(*ResendReplicationTasksRequest)(nil),                 // 27: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	(*GetTaskQueueTasksRequest)(nil),                      // 28: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	(*DeleteWorkflowExecutionRequest)(nil),                // 29: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	(*StreamWorkflowReplicationMessagesRequest)(nil),      // 30: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	(*GetNamespaceRequest)(nil),                           // 31: temporal.server.api.adminservice.v1.GetNamespaceRequest
+	(*GetDLQTasksRequest)(nil),                            // 32: temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	(*PurgeDLQTasksRequest)(nil),                          // 33: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	(*MergeDLQTasksRequest)(nil),                          // 34: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	(*DescribeDLQJobRequest)(nil),                         // 35: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	(*CancelDLQJobRequest)(nil),                           // 36: temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	(*AddTasksRequest)(nil),                               // 37: temporal.server.api.adminservice.v1.AddTasksRequest
+	(*ListQueuesRequest)(nil),                             // 38: temporal.server.api.adminservice.v1.ListQueuesRequest
+	(*DeepHealthCheckRequest)(nil),                        // 39: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	(*SyncWorkflowStateRequest)(nil),                      // 40: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	(*GenerateLastHistoryReplicationTasksRequest)(nil),    // 41: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	(*DescribeTaskQueuePartitionRequest)(nil),             // 42: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	(*ForceUnloadTaskQueuePartitionRequest)(nil),          // 43: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	(*GetTaskQueueUserDataRequest)(nil),                   // 44: temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
+	(*MigrateScheduleRequest)(nil),                        // 45: temporal.server.api.adminservice.v1.MigrateScheduleRequest
+	(*DescribeOutboundDestinationHealthRequest)(nil),      // 46: temporal.server.api.adminservice.v1.DescribeOutboundDestinationHealthRequest
+	(*UpdateOutboundCircuitBreakerRequest)(nil),           // 47: temporal.server.api.adminservice.v1.UpdateOutboundCircuitBreakerRequest
+	(*DescribeNexusEndpointRequest)(nil),                  // 48: temporal.server.api.adminservice.v1.DescribeNexusEndpointRequest
+	(*UpdateNexusEndpointHttpTargetRequest)(nil),          // 49: temporal.server.api.adminservice.v1.UpdateNexusEndpointHttpTargetRequest
+	(*ListDeadLetteredCallbacksRequest)(nil),              // 50: temporal.server.api.adminservice.v1.ListDeadLetteredCallbacksRequest
+	(*GetDeadLetteredCallbackRequest)(nil),                // 51: temporal.server.api.adminservice.v1.GetDeadLetteredCallbackRequest
+	(*ReplayDeadLetteredCallbacksRequest)(nil),            // 52: temporal.server.api.adminservice.v1.ReplayDeadLetteredCallbacksRequest
+	(*PurgeDeadLetteredCallbacksRequest)(nil),             // 53: temporal.server.api.adminservice.v1.PurgeDeadLetteredCallbacksRequest
+	(*RotateCallbackSigningKeyRequest)(nil),               // 54: temporal.server.api.adminservice.v1.RotateCallbackSigningKeyRequest
+	(*ListCallbackSigningKeysRequest)(nil),                // 55: temporal.server.api.adminservice.v1.ListCallbackSigningKeysRequest
+	(*DeleteCallbackSigningKeyRequest)(nil),               // 56: temporal.server.api.adminservice.v1.DeleteCallbackSigningKeyRequest
+	(*DescribePersistenceConcurrencyLimiterRequest)(nil),  // 57: temporal.server.api.adminservice.v1.DescribePersistenceConcurrencyLimiterRequest
+	(*UpdateFaultInjectionRequest)(nil),                   // 58: temporal.server.api.adminservice.v1.UpdateFaultInjectionRequest
+	(*DescribeFaultInjectionRequest)(nil),                 // 59: temporal.server.api.adminservice.v1.DescribeFaultInjectionRequest
+	(*DescribeReplicationLagRequest)(nil),                 // 60: temporal.server.api.adminservice.v1.DescribeReplicationLagRequest
+	(*GetWorkerScalingRecommendationRequest)(nil),         // 61: temporal.server.api.adminservice.v1.GetWorkerScalingRecommendationRequest
+	(*StartWorkerDeploymentRolloutRequest)(nil),           // 62: temporal.server.api.adminservice.v1.StartWorkerDeploymentRolloutRequest
+	(*UpdateWorkerDeploymentRolloutRequest)(nil),          // 63: temporal.server.api.adminservice.v1.UpdateWorkerDeploymentRolloutRequest
+	(*DescribeWorkerDeploymentRolloutRequest)(nil),        // 64: temporal.server.api.adminservice.v1.DescribeWorkerDeploymentRolloutRequest
+	(*RenameSearchAttributeAliasRequest)(nil),             // 65: temporal.server.api.adminservice.v1.RenameSearchAttributeAliasRequest
+	(*MigrateSearchAttributeTypeRequest)(nil),             // 66: temporal.server.api.adminservice.v1.MigrateSearchAttributeTypeRequest
+	(*CreateWorkflowRuleRequest)(nil),                     // 67: temporal.server.api.adminservice.v1.CreateWorkflowRuleRequest
+	(*DescribeWorkflowRuleRequest)(nil),                   // 68: temporal.server.api.adminservice.v1.DescribeWorkflowRuleRequest
+	(*DescribeWorkerRequest)(nil),                         // 69: temporal.server.api.adminservice.v1.DescribeWorkerRequest
+	(*StartVisibilityBackfillRequest)(nil),                // 70: temporal.server.api.adminservice.v1.StartVisibilityBackfillRequest
+	(*DescribeVisibilityBackfillRequest)(nil),             // 71: temporal.server.api.adminservice.v1.DescribeVisibilityBackfillRequest
+	(*RebuildMutableStateResponse)(nil),                   // 72: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	(*ImportWorkflowExecutionResponse)(nil),               // 73: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	(*DescribeMutableStateResponse)(nil),                  // 74: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	(*DescribeHistoryHostResponse)(nil),                   // 75: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	(*GetShardResponse)(nil),                              // 76: temporal.server.api.adminservice.v1.GetShardResponse
+	(*CloseShardResponse)(nil),                            // 77: temporal.server.api.adminservice.v1.CloseShardResponse
+	(*ListHistoryTasksResponse)(nil),                      // 78: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	(*RemoveTaskResponse)(nil),                            // 79: temporal.server.api.adminservice.v1.RemoveTaskResponse
+	(*GetWorkflowExecutionRawHistoryV2Response)(nil),      // 80: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	(*GetWorkflowExecutionRawHistoryResponse)(nil),        // 81: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	(*GetReplicationMessagesResponse)(nil),                // 82: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	(*GetNamespaceReplicationMessagesResponse)(nil),       // 83: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	(*GetDLQReplicationMessagesResponse)(nil),             // 84: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	(*ReapplyEventsResponse)(nil),                         // 85: temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	(*AddSearchAttributesResponse)(nil),                   // 86: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributesResponse)(nil),                // 87: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	(*GetSearchAttributesResponse)(nil),                   // 88: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	(*DescribeClusterResponse)(nil),                       // 89: temporal.server.api.adminservice.v1.DescribeClusterResponse
+	(*ListClustersResponse)(nil),                          // 90: temporal.server.api.adminservice.v1.ListClustersResponse
+	(*ListClusterMembersResponse)(nil),                    // 91: temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	(*AddOrUpdateRemoteClusterResponse)(nil),              // 92: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	(*RemoveRemoteClusterResponse)(nil),                   // 93: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	(*GetDLQMessagesResponse)(nil),                        // 94: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	(*PurgeDLQMessagesResponse)(nil),                      // 95: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	(*MergeDLQMessagesResponse)(nil),                      // 96: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	(*RefreshWorkflowTasksResponse)(nil),                  // 97: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	(*StartAdminBatchOperationResponse)(nil),              // 98: temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
+	(*ResendReplicationTasksResponse)(nil),                // 99: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	(*GetTaskQueueTasksResponse)(nil),                     // 100: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	(*DeleteWorkflowExecutionResponse)(nil),               // 101: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesResponse)(nil),     // 102: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceResponse)(nil),                          // 103: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*GetDLQTasksResponse)(nil),                           // 104: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksResponse)(nil),                         // 105: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*MergeDLQTasksResponse)(nil),                         // 106: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobResponse)(nil),                        // 107: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*CancelDLQJobResponse)(nil),                          // 108: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksResponse)(nil),                              // 109: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesResponse)(nil),                            // 110: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckResponse)(nil),                       // 111: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateResponse)(nil),                     // 112: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksResponse)(nil),   // 113: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionResponse)(nil),            // 114: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),         // 115: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*GetTaskQueueUserDataResponse)(nil),                  // 116: temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
+	(*MigrateScheduleResponse)(nil),                       // 117: temporal.server.api.adminservice.v1.MigrateScheduleResponse
+	(*DescribeOutboundDestinationHealthResponse)(nil),     // 118: temporal.server.api.adminservice.v1.DescribeOutboundDestinationHealthResponse
+	(*UpdateOutboundCircuitBreakerResponse)(nil),          // 119: temporal.server.api.adminservice.v1.UpdateOutboundCircuitBreakerResponse
+	(*DescribeNexusEndpointResponse)(nil),                 // 120: temporal.server.api.adminservice.v1.DescribeNexusEndpointResponse
+	(*UpdateNexusEndpointHttpTargetResponse)(nil),         // 121: temporal.server.api.adminservice.v1.UpdateNexusEndpointHttpTargetResponse
+	(*ListDeadLetteredCallbacksResponse)(nil),             // 122: temporal.server.api.adminservice.v1.ListDeadLetteredCallbacksResponse
+	(*GetDeadLetteredCallbackResponse)(nil),               // 123: temporal.server.api.adminservice.v1.GetDeadLetteredCallbackResponse
+	(*ReplayDeadLetteredCallbacksResponse)(nil),           // 124: temporal.server.api.adminservice.v1.ReplayDeadLetteredCallbacksResponse
+	(*PurgeDeadLetteredCallbacksResponse)(nil),            // 125: temporal.server.api.adminservice.v1.PurgeDeadLetteredCallbacksResponse
+	(*RotateCallbackSigningKeyResponse)(nil),              // 126: temporal.server.api.adminservice.v1.RotateCallbackSigningKeyResponse
+	(*ListCallbackSigningKeysResponse)(nil),               // 127: temporal.server.api.adminservice.v1.ListCallbackSigningKeysResponse
+	(*DeleteCallbackSigningKeyResponse)(nil),              // 128: temporal.server.api.adminservice.v1.DeleteCallbackSigningKeyResponse
+	(*DescribePersistenceConcurrencyLimiterResponse)(nil), // 129: temporal.server.api.adminservice.v1.DescribePersistenceConcurrencyLimiterResponse
+	(*UpdateFaultInjectionResponse)(nil),                  // 130: temporal.server.api.adminservice.v1.UpdateFaultInjectionResponse
+	(*DescribeFaultInjectionResponse)(nil),                // 131: temporal.server.api.adminservice.v1.DescribeFaultInjectionResponse
+	(*DescribeReplicationLagResponse)(nil),                // 132: temporal.server.api.adminservice.v1.DescribeReplicationLagResponse
+	(*GetWorkerScalingRecommendationResponse)(nil),        // 133: temporal.server.api.adminservice.v1.GetWorkerScalingRecommendationResponse
+	(*StartWorkerDeploymentRolloutResponse)(nil),          // 134: temporal.server.api.adminservice.v1.StartWorkerDeploymentRolloutResponse
+	(*UpdateWorkerDeploymentRolloutResponse)(nil),         // 135: temporal.server.api.adminservice.v1.UpdateWorkerDeploymentRolloutResponse
+	(*DescribeWorkerDeploymentRolloutResponse)(nil),       // 136: temporal.server.api.adminservice.v1.DescribeWorkerDeploymentRolloutResponse
+	(*RenameSearchAttributeAliasResponse)(nil),            // 137: temporal.server.api.adminservice.v1.RenameSearchAttributeAliasResponse
+	(*MigrateSearchAttributeTypeResponse)(nil),            // 138: temporal.server.api.adminservice.v1.MigrateSearchAttributeTypeResponse
+	(*CreateWorkflowRuleResponse)(nil),                    // 139: temporal.server.api.adminservice.v1.CreateWorkflowRuleResponse
+	(*DescribeWorkflowRuleResponse)(nil),                  // 140: temporal.server.api.adminservice.v1.DescribeWorkflowRuleResponse
+	(*DescribeWorkerResponse)(nil),                        // 141: temporal.server.api.adminservice.v1.DescribeWorkerResponse
+	(*StartVisibilityBackfillResponse)(nil),               // 142: temporal.server.api.adminservice.v1.StartVisibilityBackfillResponse
+	(*DescribeVisibilityBackfillResponse)(nil),            // 143: temporal.server.api.adminservice.v1.DescribeVisibilityBackfillResponse
 }
 var file_temporal_server_api_adminservice_v1_service_proto_depIdxs = []int32{
-	0,  // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
-	1,  // 1: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	2,  // 2: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	3,  // 3: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	4,  // 4: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
-	5,  // 5: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
-	6,  // 6: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	7,  // 7: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
-	8,  // 8: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	9,  // 9: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	10, // 10: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	11, // 11: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	12, // 12: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	13, // 13: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	14, // 14: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	15, // 15: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	16, // 16: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	17, // 17: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
-	18, // 18: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
-	19, // 19: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	20, // 20: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	21, // 21: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	22, // 22: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	23, // 23: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	24, // 24: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	25, // 25: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	26, // 26: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:input_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
-	27, // 27: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	28, // 28: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	29, // 29: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	30, // 30: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	31, // 31: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
-	32, // 32: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	33, // 33: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	34, // 34: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	35, // 35: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	36, // 36: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	37, // 37: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
-	38, // 38: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
-	39, // 39: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	40, // 40: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	41, // 41: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	42, // 42: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	43, // 43: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	44, // 44: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
-	45, // 45: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:input_type -> temporal.server.api.adminservice.v1.MigrateScheduleRequest
-	46, // 46: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	47, // 47: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	48, // 48: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	49, // 49: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	50, // 50: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
-	51, // 51: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
-	52, // 52: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	53, // 53: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
-	54, // 54: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	55, // 55: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	56, // 56: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	57, // 57: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	58, // 58: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	59, // 59: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	60, // 60: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	61, // 61: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	62, // 62: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	63, // 63: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
-	64, // 64: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
-	65, // 65: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	66, // 66: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	67, // 67: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	68, // 68: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	69, // 69: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	70, // 70: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	71, // 71: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	72, // 72: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:output_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
-	73, // 73: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	74, // 74: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	75, // 75: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	76, // 76: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	77, // 77: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
-	78, // 78: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	79, // 79: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	80, // 80: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	81, // 81: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	82, // 82: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	83, // 83: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
-	84, // 84: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
-	85, // 85: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	86, // 86: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	87, // 87: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	88, // 88: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	89, // 89: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	90, // 90: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
-	91, // 91: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:output_type -> temporal.server.api.adminservice.v1.MigrateScheduleResponse
-	46, // [46:92] is the sub-list for method output_type
-	0,  // [0:46] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
+	1,   // 1: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	2,   // 2: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	3,   // 3: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	4,   // 4: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
+	5,   // 5: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
+	6,   // 6: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	7,   // 7: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
+	8,   // 8: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	9,   // 9: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	10,  // 10: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	11,  // 11: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	12,  // 12: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	13,  // 13: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	14,  // 14: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	15,  // 15: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	16,  // 16: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	17,  // 17: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
+	18,  // 18: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
+	19,  // 19: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	20,  // 20: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	21,  // 21: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	22,  // 22: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	23,  // 23: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	24,  // 24: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	25,  // 25: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	26,  // 26: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:input_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationRequest
+	27,  // 27: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	28,  // 28: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	29,  // 29: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	30,  // 30: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	31,  // 31: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
+	32,  // 32: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	33,  // 33: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	34,  // 34: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	35,  // 35: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	36,  // 36: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	37,  // 37: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
+	38,  // 38: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
+	39,  // 39: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	40,  // 40: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	41,  // 41: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	42,  // 42: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	43,  // 43: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	44,  // 44: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataRequest
+	45,  // 45: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:input_type -> temporal.server.api.adminservice.v1.MigrateScheduleRequest
+	46,  // 46: temporal.server.api.adminservice.v1.AdminService.DescribeOutboundDestinationHealth:input_type -> temporal.server.api.adminservice.v1.DescribeOutboundDestinationHealthRequest
+	47,  // 47: temporal.server.api.adminservice.v1.AdminService.UpdateOutboundCircuitBreaker:input_type -> temporal.server.api.adminservice.v1.UpdateOutboundCircuitBreakerRequest
+	48,  // 48: temporal.server.api.adminservice.v1.AdminService.DescribeNexusEndpoint:input_type -> temporal.server.api.adminservice.v1.DescribeNexusEndpointRequest
+	49,  // 49: temporal.server.api.adminservice.v1.AdminService.UpdateNexusEndpointHttpTarget:input_type -> temporal.server.api.adminservice.v1.UpdateNexusEndpointHttpTargetRequest
+	50,  // 50: temporal.server.api.adminservice.v1.AdminService.ListDeadLetteredCallbacks:input_type -> temporal.server.api.adminservice.v1.ListDeadLetteredCallbacksRequest
+	51,  // 51: temporal.server.api.adminservice.v1.AdminService.GetDeadLetteredCallback:input_type -> temporal.server.api.adminservice.v1.GetDeadLetteredCallbackRequest
+	52,  // 52: temporal.server.api.adminservice.v1.AdminService.ReplayDeadLetteredCallbacks:input_type -> temporal.server.api.adminservice.v1.ReplayDeadLetteredCallbacksRequest
+	53,  // 53: temporal.server.api.adminservice.v1.AdminService.PurgeDeadLetteredCallbacks:input_type -> temporal.server.api.adminservice.v1.PurgeDeadLetteredCallbacksRequest
+	54,  // 54: temporal.server.api.adminservice.v1.AdminService.RotateCallbackSigningKey:input_type -> temporal.server.api.adminservice.v1.RotateCallbackSigningKeyRequest
+	55,  // 55: temporal.server.api.adminservice.v1.AdminService.ListCallbackSigningKeys:input_type -> temporal.server.api.adminservice.v1.ListCallbackSigningKeysRequest
+	56,  // 56: temporal.server.api.adminservice.v1.AdminService.DeleteCallbackSigningKey:input_type -> temporal.server.api.adminservice.v1.DeleteCallbackSigningKeyRequest
+	57,  // 57: temporal.server.api.adminservice.v1.AdminService.DescribePersistenceConcurrencyLimiter:input_type -> temporal.server.api.adminservice.v1.DescribePersistenceConcurrencyLimiterRequest
+	58,  // 58: temporal.server.api.adminservice.v1.AdminService.UpdateFaultInjection:input_type -> temporal.server.api.adminservice.v1.UpdateFaultInjectionRequest
+	59,  // 59: temporal.server.api.adminservice.v1.AdminService.DescribeFaultInjection:input_type -> temporal.server.api.adminservice.v1.DescribeFaultInjectionRequest
+	60,  // 60: temporal.server.api.adminservice.v1.AdminService.DescribeReplicationLag:input_type -> temporal.server.api.adminservice.v1.DescribeReplicationLagRequest
+	61,  // 61: temporal.server.api.adminservice.v1.AdminService.GetWorkerScalingRecommendation:input_type -> temporal.server.api.adminservice.v1.GetWorkerScalingRecommendationRequest
+	62,  // 62: temporal.server.api.adminservice.v1.AdminService.StartWorkerDeploymentRollout:input_type -> temporal.server.api.adminservice.v1.StartWorkerDeploymentRolloutRequest
+	63,  // 63: temporal.server.api.adminservice.v1.AdminService.UpdateWorkerDeploymentRollout:input_type -> temporal.server.api.adminservice.v1.UpdateWorkerDeploymentRolloutRequest
+	64,  // 64: temporal.server.api.adminservice.v1.AdminService.DescribeWorkerDeploymentRollout:input_type -> temporal.server.api.adminservice.v1.DescribeWorkerDeploymentRolloutRequest
+	65,  // 65: temporal.server.api.adminservice.v1.AdminService.RenameSearchAttributeAlias:input_type -> temporal.server.api.adminservice.v1.RenameSearchAttributeAliasRequest
+	66,  // 66: temporal.server.api.adminservice.v1.AdminService.MigrateSearchAttributeType:input_type -> temporal.server.api.adminservice.v1.MigrateSearchAttributeTypeRequest
+	67,  // 67: temporal.server.api.adminservice.v1.AdminService.CreateWorkflowRule:input_type -> temporal.server.api.adminservice.v1.CreateWorkflowRuleRequest
+	68,  // 68: temporal.server.api.adminservice.v1.AdminService.DescribeWorkflowRule:input_type -> temporal.server.api.adminservice.v1.DescribeWorkflowRuleRequest
+	69,  // 69: temporal.server.api.adminservice.v1.AdminService.DescribeWorker:input_type -> temporal.server.api.adminservice.v1.DescribeWorkerRequest
+	70,  // 70: temporal.server.api.adminservice.v1.AdminService.StartVisibilityBackfill:input_type -> temporal.server.api.adminservice.v1.StartVisibilityBackfillRequest
+	71,  // 71: temporal.server.api.adminservice.v1.AdminService.DescribeVisibilityBackfill:input_type -> temporal.server.api.adminservice.v1.DescribeVisibilityBackfillRequest
+	72,  // 72: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	73,  // 73: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	74,  // 74: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	75,  // 75: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	76,  // 76: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
+	77,  // 77: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
+	78,  // 78: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	79,  // 79: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
+	80,  // 80: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	81,  // 81: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	82,  // 82: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	83,  // 83: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	84,  // 84: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	85,  // 85: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	86,  // 86: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	87,  // 87: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	88,  // 88: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	89,  // 89: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
+	90,  // 90: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
+	91,  // 91: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	92,  // 92: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	93,  // 93: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	94,  // 94: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	95,  // 95: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	96,  // 96: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	97,  // 97: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	98,  // 98: temporal.server.api.adminservice.v1.AdminService.StartAdminBatchOperation:output_type -> temporal.server.api.adminservice.v1.StartAdminBatchOperationResponse
+	99,  // 99: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	100, // 100: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	101, // 101: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	102, // 102: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	103, // 103: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
+	104, // 104: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	105, // 105: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	106, // 106: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	107, // 107: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	108, // 108: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	109, // 109: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
+	110, // 110: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
+	111, // 111: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	112, // 112: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	113, // 113: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	114, // 114: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	115, // 115: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	116, // 116: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueUserData:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueUserDataResponse
+	117, // 117: temporal.server.api.adminservice.v1.AdminService.MigrateSchedule:output_type -> temporal.server.api.adminservice.v1.MigrateScheduleResponse
+	118, // 118: temporal.server.api.adminservice.v1.AdminService.DescribeOutboundDestinationHealth:output_type -> temporal.server.api.adminservice.v1.DescribeOutboundDestinationHealthResponse
+	119, // 119: temporal.server.api.adminservice.v1.AdminService.UpdateOutboundCircuitBreaker:output_type -> temporal.server.api.adminservice.v1.UpdateOutboundCircuitBreakerResponse
+	120, // 120: temporal.server.api.adminservice.v1.AdminService.DescribeNexusEndpoint:output_type -> temporal.server.api.adminservice.v1.DescribeNexusEndpointResponse
+	121, // 121: temporal.server.api.adminservice.v1.AdminService.UpdateNexusEndpointHttpTarget:output_type -> temporal.server.api.adminservice.v1.UpdateNexusEndpointHttpTargetResponse
+	122, // 122: temporal.server.api.adminservice.v1.AdminService.ListDeadLetteredCallbacks:output_type -> temporal.server.api.adminservice.v1.ListDeadLetteredCallbacksResponse
+	123, // 123: temporal.server.api.adminservice.v1.AdminService.GetDeadLetteredCallback:output_type -> temporal.server.api.adminservice.v1.GetDeadLetteredCallbackResponse
+	124, // 124: temporal.server.api.adminservice.v1.AdminService.ReplayDeadLetteredCallbacks:output_type -> temporal.server.api.adminservice.v1.ReplayDeadLetteredCallbacksResponse
+	125, // 125: temporal.server.api.adminservice.v1.AdminService.PurgeDeadLetteredCallbacks:output_type -> temporal.server.api.adminservice.v1.PurgeDeadLetteredCallbacksResponse
+	126, // 126: temporal.server.api.adminservice.v1.AdminService.RotateCallbackSigningKey:output_type -> temporal.server.api.adminservice.v1.RotateCallbackSigningKeyResponse
+	127, // 127: temporal.server.api.adminservice.v1.AdminService.ListCallbackSigningKeys:output_type -> temporal.server.api.adminservice.v1.ListCallbackSigningKeysResponse
+	128, // 128: temporal.server.api.adminservice.v1.AdminService.DeleteCallbackSigningKey:output_type -> temporal.server.api.adminservice.v1.DeleteCallbackSigningKeyResponse
+	129, // 129: temporal.server.api.adminservice.v1.AdminService.DescribePersistenceConcurrencyLimiter:output_type -> temporal.server.api.adminservice.v1.DescribePersistenceConcurrencyLimiterResponse
+	130, // 130: temporal.server.api.adminservice.v1.AdminService.UpdateFaultInjection:output_type -> temporal.server.api.adminservice.v1.UpdateFaultInjectionResponse
+	131, // 131: temporal.server.api.adminservice.v1.AdminService.DescribeFaultInjection:output_type -> temporal.server.api.adminservice.v1.DescribeFaultInjectionResponse
+	132, // 132: temporal.server.api.adminservice.v1.AdminService.DescribeReplicationLag:output_type -> temporal.server.api.adminservice.v1.DescribeReplicationLagResponse
+	133, // 133: temporal.server.api.adminservice.v1.AdminService.GetWorkerScalingRecommendation:output_type -> temporal.server.api.adminservice.v1.GetWorkerScalingRecommendationResponse
+	134, // 134: temporal.server.api.adminservice.v1.AdminService.StartWorkerDeploymentRollout:output_type -> temporal.server.api.adminservice.v1.StartWorkerDeploymentRolloutResponse
+	135, // 135: temporal.server.api.adminservice.v1.AdminService.UpdateWorkerDeploymentRollout:output_type -> temporal.server.api.adminservice.v1.UpdateWorkerDeploymentRolloutResponse
+	136, // 136: temporal.server.api.adminservice.v1.AdminService.DescribeWorkerDeploymentRollout:output_type -> temporal.server.api.adminservice.v1.DescribeWorkerDeploymentRolloutResponse
+	137, // 137: temporal.server.api.adminservice.v1.AdminService.RenameSearchAttributeAlias:output_type -> temporal.server.api.adminservice.v1.RenameSearchAttributeAliasResponse
+	138, // 138: temporal.server.api.adminservice.v1.AdminService.MigrateSearchAttributeType:output_type -> temporal.server.api.adminservice.v1.MigrateSearchAttributeTypeResponse
+	139, // 139: temporal.server.api.adminservice.v1.AdminService.CreateWorkflowRule:output_type -> temporal.server.api.adminservice.v1.CreateWorkflowRuleResponse
+	140, // 140: temporal.server.api.adminservice.v1.AdminService.DescribeWorkflowRule:output_type -> temporal.server.api.adminservice.v1.DescribeWorkflowRuleResponse
+	141, // 141: temporal.server.api.adminservice.v1.AdminService.DescribeWorker:output_type -> temporal.server.api.adminservice.v1.DescribeWorkerResponse
+	142, // 142: temporal.server.api.adminservice.v1.AdminService.StartVisibilityBackfill:output_type -> temporal.server.api.adminservice.v1.StartVisibilityBackfillResponse
+	143, // 143: temporal.server.api.adminservice.v1.AdminService.DescribeVisibilityBackfill:output_type -> temporal.server.api.adminservice.v1.DescribeVisibilityBackfillResponse
+	72,  // [72:144] is the sub-list for method output_type
+	0,   // [0:72] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_service_proto_init() }
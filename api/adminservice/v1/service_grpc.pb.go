@@ -20,52 +20,78 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AdminService_RebuildMutableState_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
-	AdminService_ImportWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
-	AdminService_DescribeMutableState_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
-	AdminService_DescribeHistoryHost_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
-	AdminService_GetShard_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
-	AdminService_CloseShard_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
-	AdminService_ListHistoryTasks_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
-	AdminService_RemoveTask_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
-	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
-	AdminService_GetWorkflowExecutionRawHistory_FullMethodName      = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistory"
-	AdminService_GetReplicationMessages_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages"
-	AdminService_GetNamespaceReplicationMessages_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages"
-	AdminService_GetDLQReplicationMessages_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages"
-	AdminService_ReapplyEvents_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents"
-	AdminService_AddSearchAttributes_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes"
-	AdminService_RemoveSearchAttributes_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes"
-	AdminService_GetSearchAttributes_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes"
-	AdminService_DescribeCluster_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster"
-	AdminService_ListClusters_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/ListClusters"
-	AdminService_ListClusterMembers_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ListClusterMembers"
-	AdminService_AddOrUpdateRemoteCluster_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/AddOrUpdateRemoteCluster"
-	AdminService_RemoveRemoteCluster_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/RemoveRemoteCluster"
-	AdminService_GetDLQMessages_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages"
-	AdminService_PurgeDLQMessages_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages"
-	AdminService_MergeDLQMessages_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages"
-	AdminService_RefreshWorkflowTasks_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks"
-	AdminService_StartAdminBatchOperation_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/StartAdminBatchOperation"
-	AdminService_ResendReplicationTasks_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
-	AdminService_GetTaskQueueTasks_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
-	AdminService_DeleteWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
-	AdminService_StreamWorkflowReplicationMessages_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
-	AdminService_GetNamespace_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
-	AdminService_GetDLQTasks_FullMethodName                         = "/temporal.server.api.adminservice.v1.AdminService/GetDLQTasks"
-	AdminService_PurgeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQTasks"
-	AdminService_MergeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQTasks"
-	AdminService_DescribeDLQJob_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/DescribeDLQJob"
-	AdminService_CancelDLQJob_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/CancelDLQJob"
-	AdminService_AddTasks_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/AddTasks"
-	AdminService_ListQueues_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/ListQueues"
-	AdminService_DeepHealthCheck_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/DeepHealthCheck"
-	AdminService_SyncWorkflowState_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/SyncWorkflowState"
-	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
-	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
-	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
-	AdminService_GetTaskQueueUserData_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueUserData"
-	AdminService_MigrateSchedule_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/MigrateSchedule"
+	AdminService_RebuildMutableState_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
+	AdminService_ImportWorkflowExecution_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
+	AdminService_DescribeMutableState_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
+	AdminService_DescribeHistoryHost_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
+	AdminService_GetShard_FullMethodName                              = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
+	AdminService_CloseShard_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
+	AdminService_ListHistoryTasks_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
+	AdminService_RemoveTask_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
+	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName      = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
+	AdminService_GetWorkflowExecutionRawHistory_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistory"
+	AdminService_GetReplicationMessages_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages"
+	AdminService_GetNamespaceReplicationMessages_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages"
+	AdminService_GetDLQReplicationMessages_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages"
+	AdminService_ReapplyEvents_FullMethodName                         = "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents"
+	AdminService_AddSearchAttributes_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes"
+	AdminService_RemoveSearchAttributes_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes"
+	AdminService_GetSearchAttributes_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes"
+	AdminService_DescribeCluster_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster"
+	AdminService_ListClusters_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/ListClusters"
+	AdminService_ListClusterMembers_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/ListClusterMembers"
+	AdminService_AddOrUpdateRemoteCluster_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/AddOrUpdateRemoteCluster"
+	AdminService_RemoveRemoteCluster_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/RemoveRemoteCluster"
+	AdminService_GetDLQMessages_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages"
+	AdminService_PurgeDLQMessages_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages"
+	AdminService_MergeDLQMessages_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages"
+	AdminService_RefreshWorkflowTasks_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks"
+	AdminService_StartAdminBatchOperation_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/StartAdminBatchOperation"
+	AdminService_ResendReplicationTasks_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
+	AdminService_GetTaskQueueTasks_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
+	AdminService_DeleteWorkflowExecution_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
+	AdminService_StreamWorkflowReplicationMessages_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
+	AdminService_GetNamespace_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
+	AdminService_GetDLQTasks_FullMethodName                           = "/temporal.server.api.adminservice.v1.AdminService/GetDLQTasks"
+	AdminService_PurgeDLQTasks_FullMethodName                         = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQTasks"
+	AdminService_MergeDLQTasks_FullMethodName                         = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQTasks"
+	AdminService_DescribeDLQJob_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/DescribeDLQJob"
+	AdminService_CancelDLQJob_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/CancelDLQJob"
+	AdminService_AddTasks_FullMethodName                              = "/temporal.server.api.adminservice.v1.AdminService/AddTasks"
+	AdminService_ListQueues_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/ListQueues"
+	AdminService_DeepHealthCheck_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/DeepHealthCheck"
+	AdminService_SyncWorkflowState_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/SyncWorkflowState"
+	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
+	AdminService_DescribeTaskQueuePartition_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
+	AdminService_ForceUnloadTaskQueuePartition_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_GetTaskQueueUserData_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueUserData"
+	AdminService_MigrateSchedule_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/MigrateSchedule"
+	AdminService_DescribeOutboundDestinationHealth_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/DescribeOutboundDestinationHealth"
+	AdminService_UpdateOutboundCircuitBreaker_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/UpdateOutboundCircuitBreaker"
+	AdminService_DescribeNexusEndpoint_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DescribeNexusEndpoint"
+	AdminService_UpdateNexusEndpointHttpTarget_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/UpdateNexusEndpointHttpTarget"
+	AdminService_ListDeadLetteredCallbacks_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ListDeadLetteredCallbacks"
+	AdminService_GetDeadLetteredCallback_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/GetDeadLetteredCallback"
+	AdminService_ReplayDeadLetteredCallbacks_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ReplayDeadLetteredCallbacks"
+	AdminService_PurgeDeadLetteredCallbacks_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/PurgeDeadLetteredCallbacks"
+	AdminService_RotateCallbackSigningKey_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/RotateCallbackSigningKey"
+	AdminService_ListCallbackSigningKeys_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/ListCallbackSigningKeys"
+	AdminService_DeleteCallbackSigningKey_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/DeleteCallbackSigningKey"
+	AdminService_DescribePersistenceConcurrencyLimiter_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/DescribePersistenceConcurrencyLimiter"
+	AdminService_UpdateFaultInjection_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/UpdateFaultInjection"
+	AdminService_DescribeFaultInjection_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DescribeFaultInjection"
+	AdminService_DescribeReplicationLag_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DescribeReplicationLag"
+	AdminService_GetWorkerScalingRecommendation_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/GetWorkerScalingRecommendation"
+	AdminService_StartWorkerDeploymentRollout_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/StartWorkerDeploymentRollout"
+	AdminService_UpdateWorkerDeploymentRollout_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkerDeploymentRollout"
+	AdminService_DescribeWorkerDeploymentRollout_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/DescribeWorkerDeploymentRollout"
+	AdminService_RenameSearchAttributeAlias_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/RenameSearchAttributeAlias"
+	AdminService_MigrateSearchAttributeType_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/MigrateSearchAttributeType"
+	AdminService_CreateWorkflowRule_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/CreateWorkflowRule"
+	AdminService_DescribeWorkflowRule_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/DescribeWorkflowRule"
+	AdminService_DescribeWorker_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/DescribeWorker"
+	AdminService_StartVisibilityBackfill_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/StartVisibilityBackfill"
+	AdminService_DescribeVisibilityBackfill_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/DescribeVisibilityBackfill"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -161,6 +187,82 @@ type AdminServiceClient interface {
 	GetTaskQueueUserData(ctx context.Context, in *GetTaskQueueUserDataRequest, opts ...grpc.CallOption) (*GetTaskQueueUserDataResponse, error)
 	// MigrateSchedule migrates a schedule between V1 (workflow-backed) and V2 (CHASM-backed) implementations.
 	MigrateSchedule(ctx context.Context, in *MigrateScheduleRequest, opts ...grpc.CallOption) (*MigrateScheduleResponse, error)
+	// DescribeOutboundDestinationHealth returns the outbound queue circuit breaker state and recent request
+	// outcomes of Nexus endpoints and callback destinations, aggregated across all history hosts.
+	DescribeOutboundDestinationHealth(ctx context.Context, in *DescribeOutboundDestinationHealthRequest, opts ...grpc.CallOption) (*DescribeOutboundDestinationHealthResponse, error)
+	// UpdateOutboundCircuitBreaker manually trips or resets the outbound queue circuit breakers of a
+	// destination on all history hosts.
+	UpdateOutboundCircuitBreaker(ctx context.Context, in *UpdateOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*UpdateOutboundCircuitBreakerResponse, error)
+	// DescribeNexusEndpoint returns a Nexus endpoint together with the circuit breaker state, recent error classes and
+	// latencies of its outbound destination, aggregated across all namespaces and history hosts.
+	DescribeNexusEndpoint(ctx context.Context, in *DescribeNexusEndpointRequest, opts ...grpc.CallOption) (*DescribeNexusEndpointResponse, error)
+	// UpdateNexusEndpointHttpTarget replaces the target of an existing Nexus endpoint with a plain HTTP service target.
+	// HTTP targets cannot be expressed in the public API, so endpoints are created through the operator service and
+	// then switched to an HTTP target with this API.
+	UpdateNexusEndpointHttpTarget(ctx context.Context, in *UpdateNexusEndpointHttpTargetRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointHttpTargetResponse, error)
+	// ListDeadLetteredCallbacks returns a page of the callbacks of a namespace whose delivery was abandoned after a
+	// non-retryable failure or after their retry policy was exhausted.
+	ListDeadLetteredCallbacks(ctx context.Context, in *ListDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*ListDeadLetteredCallbacksResponse, error)
+	// GetDeadLetteredCallback returns a single dead-lettered callback, including the request that was sent.
+	GetDeadLetteredCallback(ctx context.Context, in *GetDeadLetteredCallbackRequest, opts ...grpc.CallOption) (*GetDeadLetteredCallbackResponse, error)
+	// ReplayDeadLetteredCallbacks re-delivers dead-lettered callbacks in message ID order and stops at the first
+	// delivery failure.
+	ReplayDeadLetteredCallbacks(ctx context.Context, in *ReplayDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*ReplayDeadLetteredCallbacksResponse, error)
+	// PurgeDeadLetteredCallbacks deletes dead-lettered callbacks up to and including the given message ID.
+	PurgeDeadLetteredCallbacks(ctx context.Context, in *PurgeDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*PurgeDeadLetteredCallbacksResponse, error)
+	// RotateCallbackSigningKey adds a new key used to sign the outbound completion callbacks of a namespace. Previous
+	// keys keep signing callbacks alongside the new key for the requested overlap and are removed on a later rotation.
+	RotateCallbackSigningKey(ctx context.Context, in *RotateCallbackSigningKeyRequest, opts ...grpc.CallOption) (*RotateCallbackSigningKeyResponse, error)
+	// ListCallbackSigningKeys returns the callback signing keys of a namespace without their secrets.
+	ListCallbackSigningKeys(ctx context.Context, in *ListCallbackSigningKeysRequest, opts ...grpc.CallOption) (*ListCallbackSigningKeysResponse, error)
+	// DeleteCallbackSigningKey removes a callback signing key of a namespace. Callbacks are sent unsigned once a
+	// namespace has no keys left.
+	DeleteCallbackSigningKey(ctx context.Context, in *DeleteCallbackSigningKeyRequest, opts ...grpc.CallOption) (*DeleteCallbackSigningKeyResponse, error)
+	// DescribePersistenceConcurrencyLimiter returns the state of the adaptive persistence concurrency limiter of the
+	// frontend host serving the request and of all history and matching hosts.
+	DescribePersistenceConcurrencyLimiter(ctx context.Context, in *DescribePersistenceConcurrencyLimiterRequest, opts ...grpc.CallOption) (*DescribePersistenceConcurrencyLimiterResponse, error)
+	// UpdateFaultInjection adds or deletes the faults injected at runtime into persistence and RPC client calls, on
+	// all frontend, history and matching hosts. Runtime fault injection must be enabled in dynamic config.
+	UpdateFaultInjection(ctx context.Context, in *UpdateFaultInjectionRequest, opts ...grpc.CallOption) (*UpdateFaultInjectionResponse, error)
+	// DescribeFaultInjection returns the faults injected at runtime by all frontend, history and matching hosts.
+	DescribeFaultInjection(ctx context.Context, in *DescribeFaultInjectionRequest, opts ...grpc.CallOption) (*DescribeFaultInjectionResponse, error)
+	// DescribeReplicationLag returns, for each shard and remote cluster, the replication lag in task IDs and wall-clock
+	// time and the state of the replication streams, along with the sizes of the replication DLQs.
+	DescribeReplicationLag(ctx context.Context, in *DescribeReplicationLagRequest, opts ...grpc.CallOption) (*DescribeReplicationLagResponse, error)
+	// GetWorkerScalingRecommendation returns the number of workers and slots a task queue needs for the given deployment
+	// version, so that autoscalers do not have to derive it from metrics.
+	GetWorkerScalingRecommendation(ctx context.Context, in *GetWorkerScalingRecommendationRequest, opts ...grpc.CallOption) (*GetWorkerScalingRecommendationResponse, error)
+	// StartWorkerDeploymentRollout starts a managed rollout of a Worker Deployment Version. The rollout ramps the version
+	// through the steps of the plan, and rolls it back or pauses when a health gate fails.
+	StartWorkerDeploymentRollout(ctx context.Context, in *StartWorkerDeploymentRolloutRequest, opts ...grpc.CallOption) (*StartWorkerDeploymentRolloutResponse, error)
+	// UpdateWorkerDeploymentRollout pauses, resumes, cancels or rolls back the managed rollout of a Worker Deployment.
+	UpdateWorkerDeploymentRollout(ctx context.Context, in *UpdateWorkerDeploymentRolloutRequest, opts ...grpc.CallOption) (*UpdateWorkerDeploymentRolloutResponse, error)
+	// DescribeWorkerDeploymentRollout returns the managed rollout of a Worker Deployment and its history. The public
+	// DescribeWorkerDeployment response has no field for rollouts, so their history is only available through this API.
+	DescribeWorkerDeploymentRollout(ctx context.Context, in *DescribeWorkerDeploymentRolloutRequest, opts ...grpc.CallOption) (*DescribeWorkerDeploymentRolloutResponse, error)
+	// RenameSearchAttributeAlias atomically renames the alias of a custom search attribute of a namespace. Queries keep
+	// accepting the previous alias until the transition window expires.
+	RenameSearchAttributeAlias(ctx context.Context, in *RenameSearchAttributeAliasRequest, opts ...grpc.CallOption) (*RenameSearchAttributeAliasResponse, error)
+	// MigrateSearchAttributeType starts migrating a custom search attribute of a namespace to another type. A system
+	// workflow rewrites the visibility records of the namespace and maps the alias to a field of the new type once done.
+	// Only SQL visibility stores and namespaces that are not replicated to other clusters are supported, the call fails
+	// with FailedPrecondition for Elasticsearch visibility and for multi-cluster namespaces.
+	MigrateSearchAttributeType(ctx context.Context, in *MigrateSearchAttributeTypeRequest, opts ...grpc.CallOption) (*MigrateSearchAttributeTypeResponse, error)
+	// CreateWorkflowRule creates a workflow rule of a namespace with triggers and actions which are evaluated by the
+	// history service in addition to the ones of the public rule spec.
+	CreateWorkflowRule(ctx context.Context, in *CreateWorkflowRuleRequest, opts ...grpc.CallOption) (*CreateWorkflowRuleResponse, error)
+	// DescribeWorkflowRule returns a workflow rule of a namespace with its server side triggers and actions and the
+	// number of times it matched, aggregated across all history hosts.
+	DescribeWorkflowRule(ctx context.Context, in *DescribeWorkflowRuleRequest, opts ...grpc.CallOption) (*DescribeWorkflowRuleResponse, error)
+	// DescribeWorker returns a worker of a namespace along with its recent heartbeats and its liveness, which the public
+	// DescribeWorker does not expose.
+	DescribeWorker(ctx context.Context, in *DescribeWorkerRequest, opts ...grpc.CallOption) (*DescribeWorkerResponse, error)
+	// StartVisibilityBackfill starts backfilling the visibility records of all executions in the secondary visibility
+	// store. Dual writes must be enabled first. If a backfill is already running, it is returned instead.
+	StartVisibilityBackfill(ctx context.Context, in *StartVisibilityBackfillRequest, opts ...grpc.CallOption) (*StartVisibilityBackfillResponse, error)
+	// DescribeVisibilityBackfill returns the progress of the last visibility backfill and, once it is verified, the
+	// archetypes whose execution counts differ between the primary and secondary visibility stores.
+	DescribeVisibilityBackfill(ctx context.Context, in *DescribeVisibilityBackfillRequest, opts ...grpc.CallOption) (*DescribeVisibilityBackfillResponse, error)
 }
 
 type adminServiceClient struct {
@@ -607,6 +709,240 @@ func (c *adminServiceClient) MigrateSchedule(ctx context.Context, in *MigrateSch
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeOutboundDestinationHealth(ctx context.Context, in *DescribeOutboundDestinationHealthRequest, opts ...grpc.CallOption) (*DescribeOutboundDestinationHealthResponse, error) {
+	out := new(DescribeOutboundDestinationHealthResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeOutboundDestinationHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateOutboundCircuitBreaker(ctx context.Context, in *UpdateOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*UpdateOutboundCircuitBreakerResponse, error) {
+	out := new(UpdateOutboundCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateOutboundCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeNexusEndpoint(ctx context.Context, in *DescribeNexusEndpointRequest, opts ...grpc.CallOption) (*DescribeNexusEndpointResponse, error) {
+	out := new(DescribeNexusEndpointResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeNexusEndpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNexusEndpointHttpTarget(ctx context.Context, in *UpdateNexusEndpointHttpTargetRequest, opts ...grpc.CallOption) (*UpdateNexusEndpointHttpTargetResponse, error) {
+	out := new(UpdateNexusEndpointHttpTargetResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateNexusEndpointHttpTarget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDeadLetteredCallbacks(ctx context.Context, in *ListDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*ListDeadLetteredCallbacksResponse, error) {
+	out := new(ListDeadLetteredCallbacksResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDeadLetteredCallbacks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDeadLetteredCallback(ctx context.Context, in *GetDeadLetteredCallbackRequest, opts ...grpc.CallOption) (*GetDeadLetteredCallbackResponse, error) {
+	out := new(GetDeadLetteredCallbackResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDeadLetteredCallback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReplayDeadLetteredCallbacks(ctx context.Context, in *ReplayDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*ReplayDeadLetteredCallbacksResponse, error) {
+	out := new(ReplayDeadLetteredCallbacksResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReplayDeadLetteredCallbacks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PurgeDeadLetteredCallbacks(ctx context.Context, in *PurgeDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*PurgeDeadLetteredCallbacksResponse, error) {
+	out := new(PurgeDeadLetteredCallbacksResponse)
+	err := c.cc.Invoke(ctx, AdminService_PurgeDeadLetteredCallbacks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RotateCallbackSigningKey(ctx context.Context, in *RotateCallbackSigningKeyRequest, opts ...grpc.CallOption) (*RotateCallbackSigningKeyResponse, error) {
+	out := new(RotateCallbackSigningKeyResponse)
+	err := c.cc.Invoke(ctx, AdminService_RotateCallbackSigningKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListCallbackSigningKeys(ctx context.Context, in *ListCallbackSigningKeysRequest, opts ...grpc.CallOption) (*ListCallbackSigningKeysResponse, error) {
+	out := new(ListCallbackSigningKeysResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListCallbackSigningKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteCallbackSigningKey(ctx context.Context, in *DeleteCallbackSigningKeyRequest, opts ...grpc.CallOption) (*DeleteCallbackSigningKeyResponse, error) {
+	out := new(DeleteCallbackSigningKeyResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteCallbackSigningKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribePersistenceConcurrencyLimiter(ctx context.Context, in *DescribePersistenceConcurrencyLimiterRequest, opts ...grpc.CallOption) (*DescribePersistenceConcurrencyLimiterResponse, error) {
+	out := new(DescribePersistenceConcurrencyLimiterResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribePersistenceConcurrencyLimiter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateFaultInjection(ctx context.Context, in *UpdateFaultInjectionRequest, opts ...grpc.CallOption) (*UpdateFaultInjectionResponse, error) {
+	out := new(UpdateFaultInjectionResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateFaultInjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeFaultInjection(ctx context.Context, in *DescribeFaultInjectionRequest, opts ...grpc.CallOption) (*DescribeFaultInjectionResponse, error) {
+	out := new(DescribeFaultInjectionResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeFaultInjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeReplicationLag(ctx context.Context, in *DescribeReplicationLagRequest, opts ...grpc.CallOption) (*DescribeReplicationLagResponse, error) {
+	out := new(DescribeReplicationLagResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeReplicationLag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWorkerScalingRecommendation(ctx context.Context, in *GetWorkerScalingRecommendationRequest, opts ...grpc.CallOption) (*GetWorkerScalingRecommendationResponse, error) {
+	out := new(GetWorkerScalingRecommendationResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetWorkerScalingRecommendation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartWorkerDeploymentRollout(ctx context.Context, in *StartWorkerDeploymentRolloutRequest, opts ...grpc.CallOption) (*StartWorkerDeploymentRolloutResponse, error) {
+	out := new(StartWorkerDeploymentRolloutResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartWorkerDeploymentRollout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateWorkerDeploymentRollout(ctx context.Context, in *UpdateWorkerDeploymentRolloutRequest, opts ...grpc.CallOption) (*UpdateWorkerDeploymentRolloutResponse, error) {
+	out := new(UpdateWorkerDeploymentRolloutResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateWorkerDeploymentRollout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeWorkerDeploymentRollout(ctx context.Context, in *DescribeWorkerDeploymentRolloutRequest, opts ...grpc.CallOption) (*DescribeWorkerDeploymentRolloutResponse, error) {
+	out := new(DescribeWorkerDeploymentRolloutResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeWorkerDeploymentRollout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RenameSearchAttributeAlias(ctx context.Context, in *RenameSearchAttributeAliasRequest, opts ...grpc.CallOption) (*RenameSearchAttributeAliasResponse, error) {
+	out := new(RenameSearchAttributeAliasResponse)
+	err := c.cc.Invoke(ctx, AdminService_RenameSearchAttributeAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MigrateSearchAttributeType(ctx context.Context, in *MigrateSearchAttributeTypeRequest, opts ...grpc.CallOption) (*MigrateSearchAttributeTypeResponse, error) {
+	out := new(MigrateSearchAttributeTypeResponse)
+	err := c.cc.Invoke(ctx, AdminService_MigrateSearchAttributeType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateWorkflowRule(ctx context.Context, in *CreateWorkflowRuleRequest, opts ...grpc.CallOption) (*CreateWorkflowRuleResponse, error) {
+	out := new(CreateWorkflowRuleResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateWorkflowRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeWorkflowRule(ctx context.Context, in *DescribeWorkflowRuleRequest, opts ...grpc.CallOption) (*DescribeWorkflowRuleResponse, error) {
+	out := new(DescribeWorkflowRuleResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeWorkflowRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeWorker(ctx context.Context, in *DescribeWorkerRequest, opts ...grpc.CallOption) (*DescribeWorkerResponse, error) {
+	out := new(DescribeWorkerResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeWorker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartVisibilityBackfill(ctx context.Context, in *StartVisibilityBackfillRequest, opts ...grpc.CallOption) (*StartVisibilityBackfillResponse, error) {
+	out := new(StartVisibilityBackfillResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartVisibilityBackfill_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeVisibilityBackfill(ctx context.Context, in *DescribeVisibilityBackfillRequest, opts ...grpc.CallOption) (*DescribeVisibilityBackfillResponse, error) {
+	out := new(DescribeVisibilityBackfillResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeVisibilityBackfill_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -700,6 +1036,82 @@ type AdminServiceServer interface {
 	GetTaskQueueUserData(context.Context, *GetTaskQueueUserDataRequest) (*GetTaskQueueUserDataResponse, error)
 	// MigrateSchedule migrates a schedule between V1 (workflow-backed) and V2 (CHASM-backed) implementations.
 	MigrateSchedule(context.Context, *MigrateScheduleRequest) (*MigrateScheduleResponse, error)
+	// DescribeOutboundDestinationHealth returns the outbound queue circuit breaker state and recent request
+	// outcomes of Nexus endpoints and callback destinations, aggregated across all history hosts.
+	DescribeOutboundDestinationHealth(context.Context, *DescribeOutboundDestinationHealthRequest) (*DescribeOutboundDestinationHealthResponse, error)
+	// UpdateOutboundCircuitBreaker manually trips or resets the outbound queue circuit breakers of a
+	// destination on all history hosts.
+	UpdateOutboundCircuitBreaker(context.Context, *UpdateOutboundCircuitBreakerRequest) (*UpdateOutboundCircuitBreakerResponse, error)
+	// DescribeNexusEndpoint returns a Nexus endpoint together with the circuit breaker state, recent error classes and
+	// latencies of its outbound destination, aggregated across all namespaces and history hosts.
+	DescribeNexusEndpoint(context.Context, *DescribeNexusEndpointRequest) (*DescribeNexusEndpointResponse, error)
+	// UpdateNexusEndpointHttpTarget replaces the target of an existing Nexus endpoint with a plain HTTP service target.
+	// HTTP targets cannot be expressed in the public API, so endpoints are created through the operator service and
+	// then switched to an HTTP target with this API.
+	UpdateNexusEndpointHttpTarget(context.Context, *UpdateNexusEndpointHttpTargetRequest) (*UpdateNexusEndpointHttpTargetResponse, error)
+	// ListDeadLetteredCallbacks returns a page of the callbacks of a namespace whose delivery was abandoned after a
+	// non-retryable failure or after their retry policy was exhausted.
+	ListDeadLetteredCallbacks(context.Context, *ListDeadLetteredCallbacksRequest) (*ListDeadLetteredCallbacksResponse, error)
+	// GetDeadLetteredCallback returns a single dead-lettered callback, including the request that was sent.
+	GetDeadLetteredCallback(context.Context, *GetDeadLetteredCallbackRequest) (*GetDeadLetteredCallbackResponse, error)
+	// ReplayDeadLetteredCallbacks re-delivers dead-lettered callbacks in message ID order and stops at the first
+	// delivery failure.
+	ReplayDeadLetteredCallbacks(context.Context, *ReplayDeadLetteredCallbacksRequest) (*ReplayDeadLetteredCallbacksResponse, error)
+	// PurgeDeadLetteredCallbacks deletes dead-lettered callbacks up to and including the given message ID.
+	PurgeDeadLetteredCallbacks(context.Context, *PurgeDeadLetteredCallbacksRequest) (*PurgeDeadLetteredCallbacksResponse, error)
+	// RotateCallbackSigningKey adds a new key used to sign the outbound completion callbacks of a namespace. Previous
+	// keys keep signing callbacks alongside the new key for the requested overlap and are removed on a later rotation.
+	RotateCallbackSigningKey(context.Context, *RotateCallbackSigningKeyRequest) (*RotateCallbackSigningKeyResponse, error)
+	// ListCallbackSigningKeys returns the callback signing keys of a namespace without their secrets.
+	ListCallbackSigningKeys(context.Context, *ListCallbackSigningKeysRequest) (*ListCallbackSigningKeysResponse, error)
+	// DeleteCallbackSigningKey removes a callback signing key of a namespace. Callbacks are sent unsigned once a
+	// namespace has no keys left.
+	DeleteCallbackSigningKey(context.Context, *DeleteCallbackSigningKeyRequest) (*DeleteCallbackSigningKeyResponse, error)
+	// DescribePersistenceConcurrencyLimiter returns the state of the adaptive persistence concurrency limiter of the
+	// frontend host serving the request and of all history and matching hosts.
+	DescribePersistenceConcurrencyLimiter(context.Context, *DescribePersistenceConcurrencyLimiterRequest) (*DescribePersistenceConcurrencyLimiterResponse, error)
+	// UpdateFaultInjection adds or deletes the faults injected at runtime into persistence and RPC client calls, on
+	// all frontend, history and matching hosts. Runtime fault injection must be enabled in dynamic config.
+	UpdateFaultInjection(context.Context, *UpdateFaultInjectionRequest) (*UpdateFaultInjectionResponse, error)
+	// DescribeFaultInjection returns the faults injected at runtime by all frontend, history and matching hosts.
+	DescribeFaultInjection(context.Context, *DescribeFaultInjectionRequest) (*DescribeFaultInjectionResponse, error)
+	// DescribeReplicationLag returns, for each shard and remote cluster, the replication lag in task IDs and wall-clock
+	// time and the state of the replication streams, along with the sizes of the replication DLQs.
+	DescribeReplicationLag(context.Context, *DescribeReplicationLagRequest) (*DescribeReplicationLagResponse, error)
+	// GetWorkerScalingRecommendation returns the number of workers and slots a task queue needs for the given deployment
+	// version, so that autoscalers do not have to derive it from metrics.
+	GetWorkerScalingRecommendation(context.Context, *GetWorkerScalingRecommendationRequest) (*GetWorkerScalingRecommendationResponse, error)
+	// StartWorkerDeploymentRollout starts a managed rollout of a Worker Deployment Version. The rollout ramps the version
+	// through the steps of the plan, and rolls it back or pauses when a health gate fails.
+	StartWorkerDeploymentRollout(context.Context, *StartWorkerDeploymentRolloutRequest) (*StartWorkerDeploymentRolloutResponse, error)
+	// UpdateWorkerDeploymentRollout pauses, resumes, cancels or rolls back the managed rollout of a Worker Deployment.
+	UpdateWorkerDeploymentRollout(context.Context, *UpdateWorkerDeploymentRolloutRequest) (*UpdateWorkerDeploymentRolloutResponse, error)
+	// DescribeWorkerDeploymentRollout returns the managed rollout of a Worker Deployment and its history. The public
+	// DescribeWorkerDeployment response has no field for rollouts, so their history is only available through this API.
+	DescribeWorkerDeploymentRollout(context.Context, *DescribeWorkerDeploymentRolloutRequest) (*DescribeWorkerDeploymentRolloutResponse, error)
+	// RenameSearchAttributeAlias atomically renames the alias of a custom search attribute of a namespace. Queries keep
+	// accepting the previous alias until the transition window expires.
+	RenameSearchAttributeAlias(context.Context, *RenameSearchAttributeAliasRequest) (*RenameSearchAttributeAliasResponse, error)
+	// MigrateSearchAttributeType starts migrating a custom search attribute of a namespace to another type. A system
+	// workflow rewrites the visibility records of the namespace and maps the alias to a field of the new type once done.
+	// Only SQL visibility stores and namespaces that are not replicated to other clusters are supported, the call fails
+	// with FailedPrecondition for Elasticsearch visibility and for multi-cluster namespaces.
+	MigrateSearchAttributeType(context.Context, *MigrateSearchAttributeTypeRequest) (*MigrateSearchAttributeTypeResponse, error)
+	// CreateWorkflowRule creates a workflow rule of a namespace with triggers and actions which are evaluated by the
+	// history service in addition to the ones of the public rule spec.
+	CreateWorkflowRule(context.Context, *CreateWorkflowRuleRequest) (*CreateWorkflowRuleResponse, error)
+	// DescribeWorkflowRule returns a workflow rule of a namespace with its server side triggers and actions and the
+	// number of times it matched, aggregated across all history hosts.
+	DescribeWorkflowRule(context.Context, *DescribeWorkflowRuleRequest) (*DescribeWorkflowRuleResponse, error)
+	// DescribeWorker returns a worker of a namespace along with its recent heartbeats and its liveness, which the public
+	// DescribeWorker does not expose.
+	DescribeWorker(context.Context, *DescribeWorkerRequest) (*DescribeWorkerResponse, error)
+	// StartVisibilityBackfill starts backfilling the visibility records of all executions in the secondary visibility
+	// store. Dual writes must be enabled first. If a backfill is already running, it is returned instead.
+	StartVisibilityBackfill(context.Context, *StartVisibilityBackfillRequest) (*StartVisibilityBackfillResponse, error)
+	// DescribeVisibilityBackfill returns the progress of the last visibility backfill and, once it is verified, the
+	// archetypes whose execution counts differ between the primary and secondary visibility stores.
+	DescribeVisibilityBackfill(context.Context, *DescribeVisibilityBackfillRequest) (*DescribeVisibilityBackfillResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -845,6 +1257,84 @@ func (UnimplementedAdminServiceServer) GetTaskQueueUserData(context.Context, *Ge
 func (UnimplementedAdminServiceServer) MigrateSchedule(context.Context, *MigrateScheduleRequest) (*MigrateScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MigrateSchedule not implemented")
 }
+func (UnimplementedAdminServiceServer) DescribeOutboundDestinationHealth(context.Context, *DescribeOutboundDestinationHealthRequest) (*DescribeOutboundDestinationHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeOutboundDestinationHealth not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateOutboundCircuitBreaker(context.Context, *UpdateOutboundCircuitBreakerRequest) (*UpdateOutboundCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOutboundCircuitBreaker not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeNexusEndpoint(context.Context, *DescribeNexusEndpointRequest) (*DescribeNexusEndpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNexusEndpoint not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateNexusEndpointHttpTarget(context.Context, *UpdateNexusEndpointHttpTargetRequest) (*UpdateNexusEndpointHttpTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNexusEndpointHttpTarget not implemented")
+}
+func (UnimplementedAdminServiceServer) ListDeadLetteredCallbacks(context.Context, *ListDeadLetteredCallbacksRequest) (*ListDeadLetteredCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetteredCallbacks not implemented")
+}
+func (UnimplementedAdminServiceServer) GetDeadLetteredCallback(context.Context, *GetDeadLetteredCallbackRequest) (*GetDeadLetteredCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetteredCallback not implemented")
+}
+func (UnimplementedAdminServiceServer) ReplayDeadLetteredCallbacks(context.Context, *ReplayDeadLetteredCallbacksRequest) (*ReplayDeadLetteredCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetteredCallbacks not implemented")
+}
+func (UnimplementedAdminServiceServer) PurgeDeadLetteredCallbacks(context.Context, *PurgeDeadLetteredCallbacksRequest) (*PurgeDeadLetteredCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeadLetteredCallbacks not implemented")
+}
+func (UnimplementedAdminServiceServer) RotateCallbackSigningKey(context.Context, *RotateCallbackSigningKeyRequest) (*RotateCallbackSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCallbackSigningKey not implemented")
+}
+func (UnimplementedAdminServiceServer) ListCallbackSigningKeys(context.Context, *ListCallbackSigningKeysRequest) (*ListCallbackSigningKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCallbackSigningKeys not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteCallbackSigningKey(context.Context, *DeleteCallbackSigningKeyRequest) (*DeleteCallbackSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCallbackSigningKey not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribePersistenceConcurrencyLimiter(context.Context, *DescribePersistenceConcurrencyLimiterRequest) (*DescribePersistenceConcurrencyLimiterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribePersistenceConcurrencyLimiter not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateFaultInjection(context.Context, *UpdateFaultInjectionRequest) (*UpdateFaultInjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFaultInjection not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeFaultInjection(context.Context, *DescribeFaultInjectionRequest) (*DescribeFaultInjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeFaultInjection not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeReplicationLag(context.Context, *DescribeReplicationLagRequest) (*DescribeReplicationLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeReplicationLag not implemented")
+}
+func (UnimplementedAdminServiceServer) GetWorkerScalingRecommendation(context.Context, *GetWorkerScalingRecommendationRequest) (*GetWorkerScalingRecommendationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerScalingRecommendation not implemented")
+}
+func (UnimplementedAdminServiceServer) StartWorkerDeploymentRollout(context.Context, *StartWorkerDeploymentRolloutRequest) (*StartWorkerDeploymentRolloutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartWorkerDeploymentRollout not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateWorkerDeploymentRollout(context.Context, *UpdateWorkerDeploymentRolloutRequest) (*UpdateWorkerDeploymentRolloutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerDeploymentRollout not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeWorkerDeploymentRollout(context.Context, *DescribeWorkerDeploymentRolloutRequest) (*DescribeWorkerDeploymentRolloutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkerDeploymentRollout not implemented")
+}
+func (UnimplementedAdminServiceServer) RenameSearchAttributeAlias(context.Context, *RenameSearchAttributeAliasRequest) (*RenameSearchAttributeAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameSearchAttributeAlias not implemented")
+}
+func (UnimplementedAdminServiceServer) MigrateSearchAttributeType(context.Context, *MigrateSearchAttributeTypeRequest) (*MigrateSearchAttributeTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateSearchAttributeType not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateWorkflowRule(context.Context, *CreateWorkflowRuleRequest) (*CreateWorkflowRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkflowRule not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeWorkflowRule(context.Context, *DescribeWorkflowRuleRequest) (*DescribeWorkflowRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkflowRule not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeWorker(context.Context, *DescribeWorkerRequest) (*DescribeWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorker not implemented")
+}
+func (UnimplementedAdminServiceServer) StartVisibilityBackfill(context.Context, *StartVisibilityBackfillRequest) (*StartVisibilityBackfillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartVisibilityBackfill not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeVisibilityBackfill(context.Context, *DescribeVisibilityBackfillRequest) (*DescribeVisibilityBackfillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVisibilityBackfill not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1694,80 +2184,548 @@ func _AdminService_MigrateSchedule_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var AdminService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
-	HandlerType: (*AdminServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RebuildMutableState",
-			Handler:    _AdminService_RebuildMutableState_Handler,
-		},
-		{
-			MethodName: "ImportWorkflowExecution",
-			Handler:    _AdminService_ImportWorkflowExecution_Handler,
-		},
-		{
-			MethodName: "DescribeMutableState",
-			Handler:    _AdminService_DescribeMutableState_Handler,
-		},
-		{
-			MethodName: "DescribeHistoryHost",
-			Handler:    _AdminService_DescribeHistoryHost_Handler,
-		},
-		{
-			MethodName: "GetShard",
-			Handler:    _AdminService_GetShard_Handler,
-		},
-		{
-			MethodName: "CloseShard",
-			Handler:    _AdminService_CloseShard_Handler,
-		},
-		{
-			MethodName: "ListHistoryTasks",
-			Handler:    _AdminService_ListHistoryTasks_Handler,
-		},
-		{
-			MethodName: "RemoveTask",
-			Handler:    _AdminService_RemoveTask_Handler,
-		},
-		{
-			MethodName: "GetWorkflowExecutionRawHistoryV2",
-			Handler:    _AdminService_GetWorkflowExecutionRawHistoryV2_Handler,
-		},
-		{
-			MethodName: "GetWorkflowExecutionRawHistory",
-			Handler:    _AdminService_GetWorkflowExecutionRawHistory_Handler,
-		},
-		{
-			MethodName: "GetReplicationMessages",
-			Handler:    _AdminService_GetReplicationMessages_Handler,
-		},
-		{
-			MethodName: "GetNamespaceReplicationMessages",
-			Handler:    _AdminService_GetNamespaceReplicationMessages_Handler,
-		},
-		{
-			MethodName: "GetDLQReplicationMessages",
-			Handler:    _AdminService_GetDLQReplicationMessages_Handler,
-		},
-		{
-			MethodName: "ReapplyEvents",
-			Handler:    _AdminService_ReapplyEvents_Handler,
-		},
-		{
-			MethodName: "AddSearchAttributes",
-			Handler:    _AdminService_AddSearchAttributes_Handler,
-		},
-		{
-			MethodName: "RemoveSearchAttributes",
-			Handler:    _AdminService_RemoveSearchAttributes_Handler,
-		},
-		{
-			MethodName: "GetSearchAttributes",
-			Handler:    _AdminService_GetSearchAttributes_Handler,
+func _AdminService_DescribeOutboundDestinationHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeOutboundDestinationHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeOutboundDestinationHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeOutboundDestinationHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeOutboundDestinationHealth(ctx, req.(*DescribeOutboundDestinationHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateOutboundCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOutboundCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateOutboundCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateOutboundCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateOutboundCircuitBreaker(ctx, req.(*UpdateOutboundCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeNexusEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNexusEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeNexusEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeNexusEndpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeNexusEndpoint(ctx, req.(*DescribeNexusEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateNexusEndpointHttpTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNexusEndpointHttpTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNexusEndpointHttpTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateNexusEndpointHttpTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNexusEndpointHttpTarget(ctx, req.(*UpdateNexusEndpointHttpTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDeadLetteredCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetteredCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDeadLetteredCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDeadLetteredCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDeadLetteredCallbacks(ctx, req.(*ListDeadLetteredCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDeadLetteredCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetteredCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDeadLetteredCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDeadLetteredCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDeadLetteredCallback(ctx, req.(*GetDeadLetteredCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReplayDeadLetteredCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetteredCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReplayDeadLetteredCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReplayDeadLetteredCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReplayDeadLetteredCallbacks(ctx, req.(*ReplayDeadLetteredCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PurgeDeadLetteredCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeadLetteredCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PurgeDeadLetteredCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PurgeDeadLetteredCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PurgeDeadLetteredCallbacks(ctx, req.(*PurgeDeadLetteredCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RotateCallbackSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCallbackSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RotateCallbackSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RotateCallbackSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RotateCallbackSigningKey(ctx, req.(*RotateCallbackSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListCallbackSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCallbackSigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListCallbackSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListCallbackSigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListCallbackSigningKeys(ctx, req.(*ListCallbackSigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteCallbackSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCallbackSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteCallbackSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteCallbackSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteCallbackSigningKey(ctx, req.(*DeleteCallbackSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribePersistenceConcurrencyLimiter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribePersistenceConcurrencyLimiterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribePersistenceConcurrencyLimiter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribePersistenceConcurrencyLimiter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribePersistenceConcurrencyLimiter(ctx, req.(*DescribePersistenceConcurrencyLimiterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateFaultInjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFaultInjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateFaultInjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateFaultInjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateFaultInjection(ctx, req.(*UpdateFaultInjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeFaultInjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeFaultInjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeFaultInjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeFaultInjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeFaultInjection(ctx, req.(*DescribeFaultInjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeReplicationLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeReplicationLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeReplicationLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeReplicationLag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeReplicationLag(ctx, req.(*DescribeReplicationLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorkerScalingRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerScalingRecommendationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorkerScalingRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetWorkerScalingRecommendation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorkerScalingRecommendation(ctx, req.(*GetWorkerScalingRecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartWorkerDeploymentRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartWorkerDeploymentRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartWorkerDeploymentRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartWorkerDeploymentRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartWorkerDeploymentRollout(ctx, req.(*StartWorkerDeploymentRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateWorkerDeploymentRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerDeploymentRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWorkerDeploymentRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateWorkerDeploymentRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWorkerDeploymentRollout(ctx, req.(*UpdateWorkerDeploymentRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeWorkerDeploymentRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkerDeploymentRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeWorkerDeploymentRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeWorkerDeploymentRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeWorkerDeploymentRollout(ctx, req.(*DescribeWorkerDeploymentRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RenameSearchAttributeAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameSearchAttributeAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RenameSearchAttributeAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RenameSearchAttributeAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RenameSearchAttributeAlias(ctx, req.(*RenameSearchAttributeAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MigrateSearchAttributeType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateSearchAttributeTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MigrateSearchAttributeType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MigrateSearchAttributeType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MigrateSearchAttributeType(ctx, req.(*MigrateSearchAttributeTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateWorkflowRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkflowRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateWorkflowRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateWorkflowRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateWorkflowRule(ctx, req.(*CreateWorkflowRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeWorkflowRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkflowRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeWorkflowRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeWorkflowRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeWorkflowRule(ctx, req.(*DescribeWorkflowRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeWorker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeWorker(ctx, req.(*DescribeWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartVisibilityBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartVisibilityBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartVisibilityBackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartVisibilityBackfill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartVisibilityBackfill(ctx, req.(*StartVisibilityBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeVisibilityBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVisibilityBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeVisibilityBackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeVisibilityBackfill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeVisibilityBackfill(ctx, req.(*DescribeVisibilityBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RebuildMutableState",
+			Handler:    _AdminService_RebuildMutableState_Handler,
+		},
+		{
+			MethodName: "ImportWorkflowExecution",
+			Handler:    _AdminService_ImportWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DescribeMutableState",
+			Handler:    _AdminService_DescribeMutableState_Handler,
+		},
+		{
+			MethodName: "DescribeHistoryHost",
+			Handler:    _AdminService_DescribeHistoryHost_Handler,
+		},
+		{
+			MethodName: "GetShard",
+			Handler:    _AdminService_GetShard_Handler,
+		},
+		{
+			MethodName: "CloseShard",
+			Handler:    _AdminService_CloseShard_Handler,
+		},
+		{
+			MethodName: "ListHistoryTasks",
+			Handler:    _AdminService_ListHistoryTasks_Handler,
+		},
+		{
+			MethodName: "RemoveTask",
+			Handler:    _AdminService_RemoveTask_Handler,
+		},
+		{
+			MethodName: "GetWorkflowExecutionRawHistoryV2",
+			Handler:    _AdminService_GetWorkflowExecutionRawHistoryV2_Handler,
+		},
+		{
+			MethodName: "GetWorkflowExecutionRawHistory",
+			Handler:    _AdminService_GetWorkflowExecutionRawHistory_Handler,
+		},
+		{
+			MethodName: "GetReplicationMessages",
+			Handler:    _AdminService_GetReplicationMessages_Handler,
+		},
+		{
+			MethodName: "GetNamespaceReplicationMessages",
+			Handler:    _AdminService_GetNamespaceReplicationMessages_Handler,
+		},
+		{
+			MethodName: "GetDLQReplicationMessages",
+			Handler:    _AdminService_GetDLQReplicationMessages_Handler,
+		},
+		{
+			MethodName: "ReapplyEvents",
+			Handler:    _AdminService_ReapplyEvents_Handler,
+		},
+		{
+			MethodName: "AddSearchAttributes",
+			Handler:    _AdminService_AddSearchAttributes_Handler,
+		},
+		{
+			MethodName: "RemoveSearchAttributes",
+			Handler:    _AdminService_RemoveSearchAttributes_Handler,
+		},
+		{
+			MethodName: "GetSearchAttributes",
+			Handler:    _AdminService_GetSearchAttributes_Handler,
 		},
 		{
 			MethodName: "DescribeCluster",
@@ -1881,6 +2839,110 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MigrateSchedule",
 			Handler:    _AdminService_MigrateSchedule_Handler,
 		},
+		{
+			MethodName: "DescribeOutboundDestinationHealth",
+			Handler:    _AdminService_DescribeOutboundDestinationHealth_Handler,
+		},
+		{
+			MethodName: "UpdateOutboundCircuitBreaker",
+			Handler:    _AdminService_UpdateOutboundCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "DescribeNexusEndpoint",
+			Handler:    _AdminService_DescribeNexusEndpoint_Handler,
+		},
+		{
+			MethodName: "UpdateNexusEndpointHttpTarget",
+			Handler:    _AdminService_UpdateNexusEndpointHttpTarget_Handler,
+		},
+		{
+			MethodName: "ListDeadLetteredCallbacks",
+			Handler:    _AdminService_ListDeadLetteredCallbacks_Handler,
+		},
+		{
+			MethodName: "GetDeadLetteredCallback",
+			Handler:    _AdminService_GetDeadLetteredCallback_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetteredCallbacks",
+			Handler:    _AdminService_ReplayDeadLetteredCallbacks_Handler,
+		},
+		{
+			MethodName: "PurgeDeadLetteredCallbacks",
+			Handler:    _AdminService_PurgeDeadLetteredCallbacks_Handler,
+		},
+		{
+			MethodName: "RotateCallbackSigningKey",
+			Handler:    _AdminService_RotateCallbackSigningKey_Handler,
+		},
+		{
+			MethodName: "ListCallbackSigningKeys",
+			Handler:    _AdminService_ListCallbackSigningKeys_Handler,
+		},
+		{
+			MethodName: "DeleteCallbackSigningKey",
+			Handler:    _AdminService_DeleteCallbackSigningKey_Handler,
+		},
+		{
+			MethodName: "DescribePersistenceConcurrencyLimiter",
+			Handler:    _AdminService_DescribePersistenceConcurrencyLimiter_Handler,
+		},
+		{
+			MethodName: "UpdateFaultInjection",
+			Handler:    _AdminService_UpdateFaultInjection_Handler,
+		},
+		{
+			MethodName: "DescribeFaultInjection",
+			Handler:    _AdminService_DescribeFaultInjection_Handler,
+		},
+		{
+			MethodName: "DescribeReplicationLag",
+			Handler:    _AdminService_DescribeReplicationLag_Handler,
+		},
+		{
+			MethodName: "GetWorkerScalingRecommendation",
+			Handler:    _AdminService_GetWorkerScalingRecommendation_Handler,
+		},
+		{
+			MethodName: "StartWorkerDeploymentRollout",
+			Handler:    _AdminService_StartWorkerDeploymentRollout_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerDeploymentRollout",
+			Handler:    _AdminService_UpdateWorkerDeploymentRollout_Handler,
+		},
+		{
+			MethodName: "DescribeWorkerDeploymentRollout",
+			Handler:    _AdminService_DescribeWorkerDeploymentRollout_Handler,
+		},
+		{
+			MethodName: "RenameSearchAttributeAlias",
+			Handler:    _AdminService_RenameSearchAttributeAlias_Handler,
+		},
+		{
+			MethodName: "MigrateSearchAttributeType",
+			Handler:    _AdminService_MigrateSearchAttributeType_Handler,
+		},
+		{
+			MethodName: "CreateWorkflowRule",
+			Handler:    _AdminService_CreateWorkflowRule_Handler,
+		},
+		{
+			MethodName: "DescribeWorkflowRule",
+			Handler:    _AdminService_DescribeWorkflowRule_Handler,
+		},
+		{
+			MethodName: "DescribeWorker",
+			Handler:    _AdminService_DescribeWorker_Handler,
+		},
+		{
+			MethodName: "StartVisibilityBackfill",
+			Handler:    _AdminService_StartVisibilityBackfill_Handler,
+		},
+		{
+			MethodName: "DescribeVisibilityBackfill",
+			Handler:    _AdminService_DescribeVisibilityBackfill_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
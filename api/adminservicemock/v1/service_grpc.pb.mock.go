@@ -143,6 +143,26 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CreateWorkflowRule mocks base method.
+func (m *MockAdminServiceClient) CreateWorkflowRule(ctx context.Context, in *adminservice.CreateWorkflowRuleRequest, opts ...grpc.CallOption) (*adminservice.CreateWorkflowRuleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWorkflowRule", varargs...)
+	ret0, _ := ret[0].(*adminservice.CreateWorkflowRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkflowRule indicates an expected call of CreateWorkflowRule.
+func (mr *MockAdminServiceClientMockRecorder) CreateWorkflowRule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkflowRule", reflect.TypeOf((*MockAdminServiceClient)(nil).CreateWorkflowRule), varargs...)
+}
+
 // DeepHealthCheck mocks base method.
 func (m *MockAdminServiceClient) DeepHealthCheck(ctx context.Context, in *adminservice.DeepHealthCheckRequest, opts ...grpc.CallOption) (*adminservice.DeepHealthCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +183,26 @@ func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
 }
 
+// DeleteCallbackSigningKey mocks base method.
+func (m *MockAdminServiceClient) DeleteCallbackSigningKey(ctx context.Context, in *adminservice.DeleteCallbackSigningKeyRequest, opts ...grpc.CallOption) (*adminservice.DeleteCallbackSigningKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCallbackSigningKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteCallbackSigningKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCallbackSigningKey indicates an expected call of DeleteCallbackSigningKey.
+func (mr *MockAdminServiceClientMockRecorder) DeleteCallbackSigningKey(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCallbackSigningKey", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteCallbackSigningKey), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +263,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeDLQJob(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDLQJob", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeDLQJob), varargs...)
 }
 
+// DescribeFaultInjection mocks base method.
+func (m *MockAdminServiceClient) DescribeFaultInjection(ctx context.Context, in *adminservice.DescribeFaultInjectionRequest, opts ...grpc.CallOption) (*adminservice.DescribeFaultInjectionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeFaultInjection", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeFaultInjectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeFaultInjection indicates an expected call of DescribeFaultInjection.
+func (mr *MockAdminServiceClientMockRecorder) DescribeFaultInjection(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeFaultInjection", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeFaultInjection), varargs...)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockAdminServiceClient) DescribeHistoryHost(ctx context.Context, in *adminservice.DescribeHistoryHostRequest, opts ...grpc.CallOption) (*adminservice.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +323,86 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeNexusEndpoint mocks base method.
+func (m *MockAdminServiceClient) DescribeNexusEndpoint(ctx context.Context, in *adminservice.DescribeNexusEndpointRequest, opts ...grpc.CallOption) (*adminservice.DescribeNexusEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeNexusEndpoint", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeNexusEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNexusEndpoint indicates an expected call of DescribeNexusEndpoint.
+func (mr *MockAdminServiceClientMockRecorder) DescribeNexusEndpoint(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNexusEndpoint", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeNexusEndpoint), varargs...)
+}
+
+// DescribeOutboundDestinationHealth mocks base method.
+func (m *MockAdminServiceClient) DescribeOutboundDestinationHealth(ctx context.Context, in *adminservice.DescribeOutboundDestinationHealthRequest, opts ...grpc.CallOption) (*adminservice.DescribeOutboundDestinationHealthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOutboundDestinationHealth", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeOutboundDestinationHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundDestinationHealth indicates an expected call of DescribeOutboundDestinationHealth.
+func (mr *MockAdminServiceClientMockRecorder) DescribeOutboundDestinationHealth(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundDestinationHealth", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeOutboundDestinationHealth), varargs...)
+}
+
+// DescribePersistenceConcurrencyLimiter mocks base method.
+func (m *MockAdminServiceClient) DescribePersistenceConcurrencyLimiter(ctx context.Context, in *adminservice.DescribePersistenceConcurrencyLimiterRequest, opts ...grpc.CallOption) (*adminservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePersistenceConcurrencyLimiter", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribePersistenceConcurrencyLimiterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePersistenceConcurrencyLimiter indicates an expected call of DescribePersistenceConcurrencyLimiter.
+func (mr *MockAdminServiceClientMockRecorder) DescribePersistenceConcurrencyLimiter(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePersistenceConcurrencyLimiter", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribePersistenceConcurrencyLimiter), varargs...)
+}
+
+// DescribeReplicationLag mocks base method.
+func (m *MockAdminServiceClient) DescribeReplicationLag(ctx context.Context, in *adminservice.DescribeReplicationLagRequest, opts ...grpc.CallOption) (*adminservice.DescribeReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeReplicationLag", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationLag indicates an expected call of DescribeReplicationLag.
+func (mr *MockAdminServiceClientMockRecorder) DescribeReplicationLag(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationLag", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeReplicationLag), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +423,86 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
 }
 
+// DescribeVisibilityBackfill mocks base method.
+func (m *MockAdminServiceClient) DescribeVisibilityBackfill(ctx context.Context, in *adminservice.DescribeVisibilityBackfillRequest, opts ...grpc.CallOption) (*adminservice.DescribeVisibilityBackfillResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVisibilityBackfill", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityBackfillResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityBackfill indicates an expected call of DescribeVisibilityBackfill.
+func (mr *MockAdminServiceClientMockRecorder) DescribeVisibilityBackfill(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityBackfill", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeVisibilityBackfill), varargs...)
+}
+
+// DescribeWorker mocks base method.
+func (m *MockAdminServiceClient) DescribeWorker(ctx context.Context, in *adminservice.DescribeWorkerRequest, opts ...grpc.CallOption) (*adminservice.DescribeWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorker", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorker indicates an expected call of DescribeWorker.
+func (mr *MockAdminServiceClientMockRecorder) DescribeWorker(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorker", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeWorker), varargs...)
+}
+
+// DescribeWorkerDeploymentRollout mocks base method.
+func (m *MockAdminServiceClient) DescribeWorkerDeploymentRollout(ctx context.Context, in *adminservice.DescribeWorkerDeploymentRolloutRequest, opts ...grpc.CallOption) (*adminservice.DescribeWorkerDeploymentRolloutResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorkerDeploymentRollout", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkerDeploymentRolloutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkerDeploymentRollout indicates an expected call of DescribeWorkerDeploymentRollout.
+func (mr *MockAdminServiceClientMockRecorder) DescribeWorkerDeploymentRollout(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkerDeploymentRollout", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeWorkerDeploymentRollout), varargs...)
+}
+
+// DescribeWorkflowRule mocks base method.
+func (m *MockAdminServiceClient) DescribeWorkflowRule(ctx context.Context, in *adminservice.DescribeWorkflowRuleRequest, opts ...grpc.CallOption) (*adminservice.DescribeWorkflowRuleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorkflowRule", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkflowRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowRule indicates an expected call of DescribeWorkflowRule.
+func (mr *MockAdminServiceClientMockRecorder) DescribeWorkflowRule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowRule", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeWorkflowRule), varargs...)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context, in *adminservice.ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -383,6 +603,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetDLQTasks(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQTasks), varargs...)
 }
 
+// GetDeadLetteredCallback mocks base method.
+func (m *MockAdminServiceClient) GetDeadLetteredCallback(ctx context.Context, in *adminservice.GetDeadLetteredCallbackRequest, opts ...grpc.CallOption) (*adminservice.GetDeadLetteredCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeadLetteredCallback", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDeadLetteredCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetteredCallback indicates an expected call of GetDeadLetteredCallback.
+func (mr *MockAdminServiceClientMockRecorder) GetDeadLetteredCallback(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetteredCallback", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDeadLetteredCallback), varargs...)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceClient) GetNamespace(ctx context.Context, in *adminservice.GetNamespaceRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -523,6 +763,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetTaskQueueUserData(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueUserData", reflect.TypeOf((*MockAdminServiceClient)(nil).GetTaskQueueUserData), varargs...)
 }
 
+// GetWorkerScalingRecommendation mocks base method.
+func (m *MockAdminServiceClient) GetWorkerScalingRecommendation(ctx context.Context, in *adminservice.GetWorkerScalingRecommendationRequest, opts ...grpc.CallOption) (*adminservice.GetWorkerScalingRecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkerScalingRecommendation", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetWorkerScalingRecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerScalingRecommendation indicates an expected call of GetWorkerScalingRecommendation.
+func (mr *MockAdminServiceClientMockRecorder) GetWorkerScalingRecommendation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerScalingRecommendation", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkerScalingRecommendation), varargs...)
+}
+
 // GetWorkflowExecutionRawHistory mocks base method.
 func (m *MockAdminServiceClient) GetWorkflowExecutionRawHistory(ctx context.Context, in *adminservice.GetWorkflowExecutionRawHistoryRequest, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -583,6 +843,26 @@ func (mr *MockAdminServiceClientMockRecorder) ImportWorkflowExecution(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ImportWorkflowExecution), varargs...)
 }
 
+// ListCallbackSigningKeys mocks base method.
+func (m *MockAdminServiceClient) ListCallbackSigningKeys(ctx context.Context, in *adminservice.ListCallbackSigningKeysRequest, opts ...grpc.CallOption) (*adminservice.ListCallbackSigningKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCallbackSigningKeys", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListCallbackSigningKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCallbackSigningKeys indicates an expected call of ListCallbackSigningKeys.
+func (mr *MockAdminServiceClientMockRecorder) ListCallbackSigningKeys(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCallbackSigningKeys", reflect.TypeOf((*MockAdminServiceClient)(nil).ListCallbackSigningKeys), varargs...)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -623,6 +903,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListClusters(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusters), varargs...)
 }
 
+// ListDeadLetteredCallbacks mocks base method.
+func (m *MockAdminServiceClient) ListDeadLetteredCallbacks(ctx context.Context, in *adminservice.ListDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*adminservice.ListDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeadLetteredCallbacks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetteredCallbacks indicates an expected call of ListDeadLetteredCallbacks.
+func (mr *MockAdminServiceClientMockRecorder) ListDeadLetteredCallbacks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetteredCallbacks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDeadLetteredCallbacks), varargs...)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceClient) ListHistoryTasks(ctx context.Context, in *adminservice.ListHistoryTasksRequest, opts ...grpc.CallOption) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -723,6 +1023,26 @@ func (mr *MockAdminServiceClientMockRecorder) MigrateSchedule(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).MigrateSchedule), varargs...)
 }
 
+// MigrateSearchAttributeType mocks base method.
+func (m *MockAdminServiceClient) MigrateSearchAttributeType(ctx context.Context, in *adminservice.MigrateSearchAttributeTypeRequest, opts ...grpc.CallOption) (*adminservice.MigrateSearchAttributeTypeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateSearchAttributeType", varargs...)
+	ret0, _ := ret[0].(*adminservice.MigrateSearchAttributeTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSearchAttributeType indicates an expected call of MigrateSearchAttributeType.
+func (mr *MockAdminServiceClientMockRecorder) MigrateSearchAttributeType(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSearchAttributeType", reflect.TypeOf((*MockAdminServiceClient)(nil).MigrateSearchAttributeType), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -763,6 +1083,26 @@ func (mr *MockAdminServiceClientMockRecorder) PurgeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQTasks), varargs...)
 }
 
+// PurgeDeadLetteredCallbacks mocks base method.
+func (m *MockAdminServiceClient) PurgeDeadLetteredCallbacks(ctx context.Context, in *adminservice.PurgeDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*adminservice.PurgeDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeDeadLetteredCallbacks", varargs...)
+	ret0, _ := ret[0].(*adminservice.PurgeDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetteredCallbacks indicates an expected call of PurgeDeadLetteredCallbacks.
+func (mr *MockAdminServiceClientMockRecorder) PurgeDeadLetteredCallbacks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetteredCallbacks", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDeadLetteredCallbacks), varargs...)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceClient) ReapplyEvents(ctx context.Context, in *adminservice.ReapplyEventsRequest, opts ...grpc.CallOption) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -863,104 +1203,284 @@ func (mr *MockAdminServiceClientMockRecorder) RemoveSearchAttributes(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveSearchAttributes), varargs...)
 }
 
-// RemoveTask mocks base method.
-func (m *MockAdminServiceClient) RemoveTask(ctx context.Context, in *adminservice.RemoveTaskRequest, opts ...grpc.CallOption) (*adminservice.RemoveTaskResponse, error) {
+// RemoveTask mocks base method.
+func (m *MockAdminServiceClient) RemoveTask(ctx context.Context, in *adminservice.RemoveTaskRequest, opts ...grpc.CallOption) (*adminservice.RemoveTaskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveTask", varargs...)
+	ret0, _ := ret[0].(*adminservice.RemoveTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTask indicates an expected call of RemoveTask.
+func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
+}
+
+// RenameSearchAttributeAlias mocks base method.
+func (m *MockAdminServiceClient) RenameSearchAttributeAlias(ctx context.Context, in *adminservice.RenameSearchAttributeAliasRequest, opts ...grpc.CallOption) (*adminservice.RenameSearchAttributeAliasResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameSearchAttributeAlias", varargs...)
+	ret0, _ := ret[0].(*adminservice.RenameSearchAttributeAliasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameSearchAttributeAlias indicates an expected call of RenameSearchAttributeAlias.
+func (mr *MockAdminServiceClientMockRecorder) RenameSearchAttributeAlias(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameSearchAttributeAlias", reflect.TypeOf((*MockAdminServiceClient)(nil).RenameSearchAttributeAlias), varargs...)
+}
+
+// ReplayDeadLetteredCallbacks mocks base method.
+func (m *MockAdminServiceClient) ReplayDeadLetteredCallbacks(ctx context.Context, in *adminservice.ReplayDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*adminservice.ReplayDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplayDeadLetteredCallbacks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ReplayDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetteredCallbacks indicates an expected call of ReplayDeadLetteredCallbacks.
+func (mr *MockAdminServiceClientMockRecorder) ReplayDeadLetteredCallbacks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetteredCallbacks", reflect.TypeOf((*MockAdminServiceClient)(nil).ReplayDeadLetteredCallbacks), varargs...)
+}
+
+// ResendReplicationTasks mocks base method.
+func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResendReplicationTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
+func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
+}
+
+// RotateCallbackSigningKey mocks base method.
+func (m *MockAdminServiceClient) RotateCallbackSigningKey(ctx context.Context, in *adminservice.RotateCallbackSigningKeyRequest, opts ...grpc.CallOption) (*adminservice.RotateCallbackSigningKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateCallbackSigningKey", varargs...)
+	ret0, _ := ret[0].(*adminservice.RotateCallbackSigningKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateCallbackSigningKey indicates an expected call of RotateCallbackSigningKey.
+func (mr *MockAdminServiceClientMockRecorder) RotateCallbackSigningKey(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCallbackSigningKey", reflect.TypeOf((*MockAdminServiceClient)(nil).RotateCallbackSigningKey), varargs...)
+}
+
+// StartAdminBatchOperation mocks base method.
+func (m *MockAdminServiceClient) StartAdminBatchOperation(ctx context.Context, in *adminservice.StartAdminBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartAdminBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartAdminBatchOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartAdminBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartAdminBatchOperation indicates an expected call of StartAdminBatchOperation.
+func (mr *MockAdminServiceClientMockRecorder) StartAdminBatchOperation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAdminBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartAdminBatchOperation), varargs...)
+}
+
+// StartVisibilityBackfill mocks base method.
+func (m *MockAdminServiceClient) StartVisibilityBackfill(ctx context.Context, in *adminservice.StartVisibilityBackfillRequest, opts ...grpc.CallOption) (*adminservice.StartVisibilityBackfillResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartVisibilityBackfill", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityBackfillResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityBackfill indicates an expected call of StartVisibilityBackfill.
+func (mr *MockAdminServiceClientMockRecorder) StartVisibilityBackfill(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityBackfill", reflect.TypeOf((*MockAdminServiceClient)(nil).StartVisibilityBackfill), varargs...)
+}
+
+// StartWorkerDeploymentRollout mocks base method.
+func (m *MockAdminServiceClient) StartWorkerDeploymentRollout(ctx context.Context, in *adminservice.StartWorkerDeploymentRolloutRequest, opts ...grpc.CallOption) (*adminservice.StartWorkerDeploymentRolloutResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartWorkerDeploymentRollout", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartWorkerDeploymentRolloutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartWorkerDeploymentRollout indicates an expected call of StartWorkerDeploymentRollout.
+func (mr *MockAdminServiceClientMockRecorder) StartWorkerDeploymentRollout(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorkerDeploymentRollout", reflect.TypeOf((*MockAdminServiceClient)(nil).StartWorkerDeploymentRollout), varargs...)
+}
+
+// StreamWorkflowReplicationMessages mocks base method.
+func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamWorkflowReplicationMessages", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamWorkflowReplicationMessagesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWorkflowReplicationMessages indicates an expected call of StreamWorkflowReplicationMessages.
+func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowReplicationMessages(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowReplicationMessages), varargs...)
+}
+
+// SyncWorkflowState mocks base method.
+func (m *MockAdminServiceClient) SyncWorkflowState(ctx context.Context, in *adminservice.SyncWorkflowStateRequest, opts ...grpc.CallOption) (*adminservice.SyncWorkflowStateResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RemoveTask", varargs...)
-	ret0, _ := ret[0].(*adminservice.RemoveTaskResponse)
+	ret := m.ctrl.Call(m, "SyncWorkflowState", varargs...)
+	ret0, _ := ret[0].(*adminservice.SyncWorkflowStateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RemoveTask indicates an expected call of RemoveTask.
-func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in any, opts ...any) *gomock.Call {
+// SyncWorkflowState indicates an expected call of SyncWorkflowState.
+func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
-// ResendReplicationTasks mocks base method.
-func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
+// UpdateFaultInjection mocks base method.
+func (m *MockAdminServiceClient) UpdateFaultInjection(ctx context.Context, in *adminservice.UpdateFaultInjectionRequest, opts ...grpc.CallOption) (*adminservice.UpdateFaultInjectionResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ResendReplicationTasks", varargs...)
-	ret0, _ := ret[0].(*adminservice.ResendReplicationTasksResponse)
+	ret := m.ctrl.Call(m, "UpdateFaultInjection", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateFaultInjectionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ResendReplicationTasks indicates an expected call of ResendReplicationTasks.
-func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any, opts ...any) *gomock.Call {
+// UpdateFaultInjection indicates an expected call of UpdateFaultInjection.
+func (mr *MockAdminServiceClientMockRecorder) UpdateFaultInjection(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFaultInjection", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateFaultInjection), varargs...)
 }
 
-// StartAdminBatchOperation mocks base method.
-func (m *MockAdminServiceClient) StartAdminBatchOperation(ctx context.Context, in *adminservice.StartAdminBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartAdminBatchOperationResponse, error) {
+// UpdateNexusEndpointHttpTarget mocks base method.
+func (m *MockAdminServiceClient) UpdateNexusEndpointHttpTarget(ctx context.Context, in *adminservice.UpdateNexusEndpointHttpTargetRequest, opts ...grpc.CallOption) (*adminservice.UpdateNexusEndpointHttpTargetResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "StartAdminBatchOperation", varargs...)
-	ret0, _ := ret[0].(*adminservice.StartAdminBatchOperationResponse)
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointHttpTarget", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointHttpTargetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StartAdminBatchOperation indicates an expected call of StartAdminBatchOperation.
-func (mr *MockAdminServiceClientMockRecorder) StartAdminBatchOperation(ctx, in any, opts ...any) *gomock.Call {
+// UpdateNexusEndpointHttpTarget indicates an expected call of UpdateNexusEndpointHttpTarget.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNexusEndpointHttpTarget(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAdminBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartAdminBatchOperation), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointHttpTarget", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNexusEndpointHttpTarget), varargs...)
 }
 
-// StreamWorkflowReplicationMessages mocks base method.
-func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
+// UpdateOutboundCircuitBreaker mocks base method.
+func (m *MockAdminServiceClient) UpdateOutboundCircuitBreaker(ctx context.Context, in *adminservice.UpdateOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*adminservice.UpdateOutboundCircuitBreakerResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
+	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "StreamWorkflowReplicationMessages", varargs...)
-	ret0, _ := ret[0].(adminservice.AdminService_StreamWorkflowReplicationMessagesClient)
+	ret := m.ctrl.Call(m, "UpdateOutboundCircuitBreaker", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateOutboundCircuitBreakerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StreamWorkflowReplicationMessages indicates an expected call of StreamWorkflowReplicationMessages.
-func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowReplicationMessages(ctx any, opts ...any) *gomock.Call {
+// UpdateOutboundCircuitBreaker indicates an expected call of UpdateOutboundCircuitBreaker.
+func (mr *MockAdminServiceClientMockRecorder) UpdateOutboundCircuitBreaker(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowReplicationMessages), varargs...)
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboundCircuitBreaker", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateOutboundCircuitBreaker), varargs...)
 }
 
-// SyncWorkflowState mocks base method.
-func (m *MockAdminServiceClient) SyncWorkflowState(ctx context.Context, in *adminservice.SyncWorkflowStateRequest, opts ...grpc.CallOption) (*adminservice.SyncWorkflowStateResponse, error) {
+// UpdateWorkerDeploymentRollout mocks base method.
+func (m *MockAdminServiceClient) UpdateWorkerDeploymentRollout(ctx context.Context, in *adminservice.UpdateWorkerDeploymentRolloutRequest, opts ...grpc.CallOption) (*adminservice.UpdateWorkerDeploymentRolloutResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SyncWorkflowState", varargs...)
-	ret0, _ := ret[0].(*adminservice.SyncWorkflowStateResponse)
+	ret := m.ctrl.Call(m, "UpdateWorkerDeploymentRollout", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkerDeploymentRolloutResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SyncWorkflowState indicates an expected call of SyncWorkflowState.
-func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opts ...any) *gomock.Call {
+// UpdateWorkerDeploymentRollout indicates an expected call of UpdateWorkerDeploymentRollout.
+func (mr *MockAdminServiceClientMockRecorder) UpdateWorkerDeploymentRollout(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerDeploymentRollout", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateWorkerDeploymentRollout), varargs...)
 }
 
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
@@ -1200,6 +1720,21 @@ func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// CreateWorkflowRule mocks base method.
+func (m *MockAdminServiceServer) CreateWorkflowRule(arg0 context.Context, arg1 *adminservice.CreateWorkflowRuleRequest) (*adminservice.CreateWorkflowRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkflowRule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CreateWorkflowRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkflowRule indicates an expected call of CreateWorkflowRule.
+func (mr *MockAdminServiceServerMockRecorder) CreateWorkflowRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkflowRule", reflect.TypeOf((*MockAdminServiceServer)(nil).CreateWorkflowRule), arg0, arg1)
+}
+
 // DeepHealthCheck mocks base method.
 func (m *MockAdminServiceServer) DeepHealthCheck(arg0 context.Context, arg1 *adminservice.DeepHealthCheckRequest) (*adminservice.DeepHealthCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -1215,6 +1750,21 @@ func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
 }
 
+// DeleteCallbackSigningKey mocks base method.
+func (m *MockAdminServiceServer) DeleteCallbackSigningKey(arg0 context.Context, arg1 *adminservice.DeleteCallbackSigningKeyRequest) (*adminservice.DeleteCallbackSigningKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCallbackSigningKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteCallbackSigningKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCallbackSigningKey indicates an expected call of DeleteCallbackSigningKey.
+func (mr *MockAdminServiceServerMockRecorder) DeleteCallbackSigningKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCallbackSigningKey", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteCallbackSigningKey), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1260,6 +1810,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeDLQJob(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDLQJob", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeDLQJob), arg0, arg1)
 }
 
+// DescribeFaultInjection mocks base method.
+func (m *MockAdminServiceServer) DescribeFaultInjection(arg0 context.Context, arg1 *adminservice.DescribeFaultInjectionRequest) (*adminservice.DescribeFaultInjectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeFaultInjection", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeFaultInjectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeFaultInjection indicates an expected call of DescribeFaultInjection.
+func (mr *MockAdminServiceServerMockRecorder) DescribeFaultInjection(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeFaultInjection", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeFaultInjection), arg0, arg1)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockAdminServiceServer) DescribeHistoryHost(arg0 context.Context, arg1 *adminservice.DescribeHistoryHostRequest) (*adminservice.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -1290,6 +1855,66 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeNexusEndpoint mocks base method.
+func (m *MockAdminServiceServer) DescribeNexusEndpoint(arg0 context.Context, arg1 *adminservice.DescribeNexusEndpointRequest) (*adminservice.DescribeNexusEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNexusEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeNexusEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNexusEndpoint indicates an expected call of DescribeNexusEndpoint.
+func (mr *MockAdminServiceServerMockRecorder) DescribeNexusEndpoint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNexusEndpoint", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeNexusEndpoint), arg0, arg1)
+}
+
+// DescribeOutboundDestinationHealth mocks base method.
+func (m *MockAdminServiceServer) DescribeOutboundDestinationHealth(arg0 context.Context, arg1 *adminservice.DescribeOutboundDestinationHealthRequest) (*adminservice.DescribeOutboundDestinationHealthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOutboundDestinationHealth", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeOutboundDestinationHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundDestinationHealth indicates an expected call of DescribeOutboundDestinationHealth.
+func (mr *MockAdminServiceServerMockRecorder) DescribeOutboundDestinationHealth(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundDestinationHealth", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeOutboundDestinationHealth), arg0, arg1)
+}
+
+// DescribePersistenceConcurrencyLimiter mocks base method.
+func (m *MockAdminServiceServer) DescribePersistenceConcurrencyLimiter(arg0 context.Context, arg1 *adminservice.DescribePersistenceConcurrencyLimiterRequest) (*adminservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePersistenceConcurrencyLimiter", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribePersistenceConcurrencyLimiterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePersistenceConcurrencyLimiter indicates an expected call of DescribePersistenceConcurrencyLimiter.
+func (mr *MockAdminServiceServerMockRecorder) DescribePersistenceConcurrencyLimiter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePersistenceConcurrencyLimiter", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribePersistenceConcurrencyLimiter), arg0, arg1)
+}
+
+// DescribeReplicationLag mocks base method.
+func (m *MockAdminServiceServer) DescribeReplicationLag(arg0 context.Context, arg1 *adminservice.DescribeReplicationLagRequest) (*adminservice.DescribeReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplicationLag", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationLag indicates an expected call of DescribeReplicationLag.
+func (mr *MockAdminServiceServerMockRecorder) DescribeReplicationLag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationLag", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeReplicationLag), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1305,6 +1930,66 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
 }
 
+// DescribeVisibilityBackfill mocks base method.
+func (m *MockAdminServiceServer) DescribeVisibilityBackfill(arg0 context.Context, arg1 *adminservice.DescribeVisibilityBackfillRequest) (*adminservice.DescribeVisibilityBackfillResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVisibilityBackfill", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityBackfillResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityBackfill indicates an expected call of DescribeVisibilityBackfill.
+func (mr *MockAdminServiceServerMockRecorder) DescribeVisibilityBackfill(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityBackfill", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeVisibilityBackfill), arg0, arg1)
+}
+
+// DescribeWorker mocks base method.
+func (m *MockAdminServiceServer) DescribeWorker(arg0 context.Context, arg1 *adminservice.DescribeWorkerRequest) (*adminservice.DescribeWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorker", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorker indicates an expected call of DescribeWorker.
+func (mr *MockAdminServiceServerMockRecorder) DescribeWorker(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorker", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeWorker), arg0, arg1)
+}
+
+// DescribeWorkerDeploymentRollout mocks base method.
+func (m *MockAdminServiceServer) DescribeWorkerDeploymentRollout(arg0 context.Context, arg1 *adminservice.DescribeWorkerDeploymentRolloutRequest) (*adminservice.DescribeWorkerDeploymentRolloutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkerDeploymentRollout", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkerDeploymentRolloutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkerDeploymentRollout indicates an expected call of DescribeWorkerDeploymentRollout.
+func (mr *MockAdminServiceServerMockRecorder) DescribeWorkerDeploymentRollout(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkerDeploymentRollout", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeWorkerDeploymentRollout), arg0, arg1)
+}
+
+// DescribeWorkflowRule mocks base method.
+func (m *MockAdminServiceServer) DescribeWorkflowRule(arg0 context.Context, arg1 *adminservice.DescribeWorkflowRuleRequest) (*adminservice.DescribeWorkflowRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkflowRule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkflowRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowRule indicates an expected call of DescribeWorkflowRule.
+func (mr *MockAdminServiceServerMockRecorder) DescribeWorkflowRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowRule", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeWorkflowRule), arg0, arg1)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) ForceUnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1380,6 +2065,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetDLQTasks(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQTasks), arg0, arg1)
 }
 
+// GetDeadLetteredCallback mocks base method.
+func (m *MockAdminServiceServer) GetDeadLetteredCallback(arg0 context.Context, arg1 *adminservice.GetDeadLetteredCallbackRequest) (*adminservice.GetDeadLetteredCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetteredCallback", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDeadLetteredCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetteredCallback indicates an expected call of GetDeadLetteredCallback.
+func (mr *MockAdminServiceServerMockRecorder) GetDeadLetteredCallback(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetteredCallback", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDeadLetteredCallback), arg0, arg1)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceServer) GetNamespace(arg0 context.Context, arg1 *adminservice.GetNamespaceRequest) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -1485,6 +2185,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetTaskQueueUserData(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueUserData", reflect.TypeOf((*MockAdminServiceServer)(nil).GetTaskQueueUserData), arg0, arg1)
 }
 
+// GetWorkerScalingRecommendation mocks base method.
+func (m *MockAdminServiceServer) GetWorkerScalingRecommendation(arg0 context.Context, arg1 *adminservice.GetWorkerScalingRecommendationRequest) (*adminservice.GetWorkerScalingRecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkerScalingRecommendation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetWorkerScalingRecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerScalingRecommendation indicates an expected call of GetWorkerScalingRecommendation.
+func (mr *MockAdminServiceServerMockRecorder) GetWorkerScalingRecommendation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerScalingRecommendation", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkerScalingRecommendation), arg0, arg1)
+}
+
 // GetWorkflowExecutionRawHistory mocks base method.
 func (m *MockAdminServiceServer) GetWorkflowExecutionRawHistory(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionRawHistoryRequest) (*adminservice.GetWorkflowExecutionRawHistoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -1530,6 +2245,21 @@ func (mr *MockAdminServiceServerMockRecorder) ImportWorkflowExecution(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ImportWorkflowExecution), arg0, arg1)
 }
 
+// ListCallbackSigningKeys mocks base method.
+func (m *MockAdminServiceServer) ListCallbackSigningKeys(arg0 context.Context, arg1 *adminservice.ListCallbackSigningKeysRequest) (*adminservice.ListCallbackSigningKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCallbackSigningKeys", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListCallbackSigningKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCallbackSigningKeys indicates an expected call of ListCallbackSigningKeys.
+func (mr *MockAdminServiceServerMockRecorder) ListCallbackSigningKeys(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCallbackSigningKeys", reflect.TypeOf((*MockAdminServiceServer)(nil).ListCallbackSigningKeys), arg0, arg1)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -1560,6 +2290,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListClusters(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusters), arg0, arg1)
 }
 
+// ListDeadLetteredCallbacks mocks base method.
+func (m *MockAdminServiceServer) ListDeadLetteredCallbacks(arg0 context.Context, arg1 *adminservice.ListDeadLetteredCallbacksRequest) (*adminservice.ListDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetteredCallbacks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetteredCallbacks indicates an expected call of ListDeadLetteredCallbacks.
+func (mr *MockAdminServiceServerMockRecorder) ListDeadLetteredCallbacks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetteredCallbacks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDeadLetteredCallbacks), arg0, arg1)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceServer) ListHistoryTasks(arg0 context.Context, arg1 *adminservice.ListHistoryTasksRequest) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1635,6 +2380,21 @@ func (mr *MockAdminServiceServerMockRecorder) MigrateSchedule(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).MigrateSchedule), arg0, arg1)
 }
 
+// MigrateSearchAttributeType mocks base method.
+func (m *MockAdminServiceServer) MigrateSearchAttributeType(arg0 context.Context, arg1 *adminservice.MigrateSearchAttributeTypeRequest) (*adminservice.MigrateSearchAttributeTypeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSearchAttributeType", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MigrateSearchAttributeTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSearchAttributeType indicates an expected call of MigrateSearchAttributeType.
+func (mr *MockAdminServiceServerMockRecorder) MigrateSearchAttributeType(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSearchAttributeType", reflect.TypeOf((*MockAdminServiceServer)(nil).MigrateSearchAttributeType), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1665,6 +2425,21 @@ func (mr *MockAdminServiceServerMockRecorder) PurgeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeDLQTasks), arg0, arg1)
 }
 
+// PurgeDeadLetteredCallbacks mocks base method.
+func (m *MockAdminServiceServer) PurgeDeadLetteredCallbacks(arg0 context.Context, arg1 *adminservice.PurgeDeadLetteredCallbacksRequest) (*adminservice.PurgeDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetteredCallbacks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PurgeDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetteredCallbacks indicates an expected call of PurgeDeadLetteredCallbacks.
+func (mr *MockAdminServiceServerMockRecorder) PurgeDeadLetteredCallbacks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetteredCallbacks", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeDeadLetteredCallbacks), arg0, arg1)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceServer) ReapplyEvents(arg0 context.Context, arg1 *adminservice.ReapplyEventsRequest) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1755,6 +2530,36 @@ func (mr *MockAdminServiceServerMockRecorder) RemoveTask(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveTask), arg0, arg1)
 }
 
+// RenameSearchAttributeAlias mocks base method.
+func (m *MockAdminServiceServer) RenameSearchAttributeAlias(arg0 context.Context, arg1 *adminservice.RenameSearchAttributeAliasRequest) (*adminservice.RenameSearchAttributeAliasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameSearchAttributeAlias", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RenameSearchAttributeAliasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameSearchAttributeAlias indicates an expected call of RenameSearchAttributeAlias.
+func (mr *MockAdminServiceServerMockRecorder) RenameSearchAttributeAlias(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameSearchAttributeAlias", reflect.TypeOf((*MockAdminServiceServer)(nil).RenameSearchAttributeAlias), arg0, arg1)
+}
+
+// ReplayDeadLetteredCallbacks mocks base method.
+func (m *MockAdminServiceServer) ReplayDeadLetteredCallbacks(arg0 context.Context, arg1 *adminservice.ReplayDeadLetteredCallbacksRequest) (*adminservice.ReplayDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetteredCallbacks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ReplayDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetteredCallbacks indicates an expected call of ReplayDeadLetteredCallbacks.
+func (mr *MockAdminServiceServerMockRecorder) ReplayDeadLetteredCallbacks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetteredCallbacks", reflect.TypeOf((*MockAdminServiceServer)(nil).ReplayDeadLetteredCallbacks), arg0, arg1)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminServiceServer) ResendReplicationTasks(arg0 context.Context, arg1 *adminservice.ResendReplicationTasksRequest) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1770,6 +2575,21 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// RotateCallbackSigningKey mocks base method.
+func (m *MockAdminServiceServer) RotateCallbackSigningKey(arg0 context.Context, arg1 *adminservice.RotateCallbackSigningKeyRequest) (*adminservice.RotateCallbackSigningKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCallbackSigningKey", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RotateCallbackSigningKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateCallbackSigningKey indicates an expected call of RotateCallbackSigningKey.
+func (mr *MockAdminServiceServerMockRecorder) RotateCallbackSigningKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCallbackSigningKey", reflect.TypeOf((*MockAdminServiceServer)(nil).RotateCallbackSigningKey), arg0, arg1)
+}
+
 // StartAdminBatchOperation mocks base method.
 func (m *MockAdminServiceServer) StartAdminBatchOperation(arg0 context.Context, arg1 *adminservice.StartAdminBatchOperationRequest) (*adminservice.StartAdminBatchOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1785,6 +2605,36 @@ func (mr *MockAdminServiceServerMockRecorder) StartAdminBatchOperation(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAdminBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartAdminBatchOperation), arg0, arg1)
 }
 
+// StartVisibilityBackfill mocks base method.
+func (m *MockAdminServiceServer) StartVisibilityBackfill(arg0 context.Context, arg1 *adminservice.StartVisibilityBackfillRequest) (*adminservice.StartVisibilityBackfillResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVisibilityBackfill", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityBackfillResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityBackfill indicates an expected call of StartVisibilityBackfill.
+func (mr *MockAdminServiceServerMockRecorder) StartVisibilityBackfill(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityBackfill", reflect.TypeOf((*MockAdminServiceServer)(nil).StartVisibilityBackfill), arg0, arg1)
+}
+
+// StartWorkerDeploymentRollout mocks base method.
+func (m *MockAdminServiceServer) StartWorkerDeploymentRollout(arg0 context.Context, arg1 *adminservice.StartWorkerDeploymentRolloutRequest) (*adminservice.StartWorkerDeploymentRolloutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartWorkerDeploymentRollout", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartWorkerDeploymentRolloutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartWorkerDeploymentRollout indicates an expected call of StartWorkerDeploymentRollout.
+func (mr *MockAdminServiceServerMockRecorder) StartWorkerDeploymentRollout(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorkerDeploymentRollout", reflect.TypeOf((*MockAdminServiceServer)(nil).StartWorkerDeploymentRollout), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1814,6 +2664,66 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UpdateFaultInjection mocks base method.
+func (m *MockAdminServiceServer) UpdateFaultInjection(arg0 context.Context, arg1 *adminservice.UpdateFaultInjectionRequest) (*adminservice.UpdateFaultInjectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFaultInjection", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateFaultInjectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFaultInjection indicates an expected call of UpdateFaultInjection.
+func (mr *MockAdminServiceServerMockRecorder) UpdateFaultInjection(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFaultInjection", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateFaultInjection), arg0, arg1)
+}
+
+// UpdateNexusEndpointHttpTarget mocks base method.
+func (m *MockAdminServiceServer) UpdateNexusEndpointHttpTarget(arg0 context.Context, arg1 *adminservice.UpdateNexusEndpointHttpTargetRequest) (*adminservice.UpdateNexusEndpointHttpTargetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNexusEndpointHttpTarget", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNexusEndpointHttpTargetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNexusEndpointHttpTarget indicates an expected call of UpdateNexusEndpointHttpTarget.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNexusEndpointHttpTarget(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNexusEndpointHttpTarget", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNexusEndpointHttpTarget), arg0, arg1)
+}
+
+// UpdateOutboundCircuitBreaker mocks base method.
+func (m *MockAdminServiceServer) UpdateOutboundCircuitBreaker(arg0 context.Context, arg1 *adminservice.UpdateOutboundCircuitBreakerRequest) (*adminservice.UpdateOutboundCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOutboundCircuitBreaker", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateOutboundCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOutboundCircuitBreaker indicates an expected call of UpdateOutboundCircuitBreaker.
+func (mr *MockAdminServiceServerMockRecorder) UpdateOutboundCircuitBreaker(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboundCircuitBreaker", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateOutboundCircuitBreaker), arg0, arg1)
+}
+
+// UpdateWorkerDeploymentRollout mocks base method.
+func (m *MockAdminServiceServer) UpdateWorkerDeploymentRollout(arg0 context.Context, arg1 *adminservice.UpdateWorkerDeploymentRolloutRequest) (*adminservice.UpdateWorkerDeploymentRolloutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkerDeploymentRollout", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkerDeploymentRolloutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkerDeploymentRollout indicates an expected call of UpdateWorkerDeploymentRollout.
+func (mr *MockAdminServiceServerMockRecorder) UpdateWorkerDeploymentRollout(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerDeploymentRollout", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateWorkerDeploymentRollout), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
@@ -1633,6 +1633,413 @@ func (this *SetRampingVersionArgs) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type WorkerDeploymentRolloutPlan to the protobuf v3 wire format
+func (val *WorkerDeploymentRolloutPlan) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerDeploymentRolloutPlan from the protobuf v3 wire format
+func (val *WorkerDeploymentRolloutPlan) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerDeploymentRolloutPlan) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerDeploymentRolloutPlan values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerDeploymentRolloutPlan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerDeploymentRolloutPlan
+	switch t := that.(type) {
+	case *WorkerDeploymentRolloutPlan:
+		that1 = t
+	case WorkerDeploymentRolloutPlan:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkerDeploymentRolloutStep to the protobuf v3 wire format
+func (val *WorkerDeploymentRolloutStep) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerDeploymentRolloutStep from the protobuf v3 wire format
+func (val *WorkerDeploymentRolloutStep) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerDeploymentRolloutStep) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerDeploymentRolloutStep values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerDeploymentRolloutStep) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerDeploymentRolloutStep
+	switch t := that.(type) {
+	case *WorkerDeploymentRolloutStep:
+		that1 = t
+	case WorkerDeploymentRolloutStep:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkerDeploymentRolloutHealthGates to the protobuf v3 wire format
+func (val *WorkerDeploymentRolloutHealthGates) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerDeploymentRolloutHealthGates from the protobuf v3 wire format
+func (val *WorkerDeploymentRolloutHealthGates) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerDeploymentRolloutHealthGates) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerDeploymentRolloutHealthGates values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerDeploymentRolloutHealthGates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerDeploymentRolloutHealthGates
+	switch t := that.(type) {
+	case *WorkerDeploymentRolloutHealthGates:
+		that1 = t
+	case WorkerDeploymentRolloutHealthGates:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkerDeploymentVersionHealth to the protobuf v3 wire format
+func (val *WorkerDeploymentVersionHealth) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerDeploymentVersionHealth from the protobuf v3 wire format
+func (val *WorkerDeploymentVersionHealth) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerDeploymentVersionHealth) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerDeploymentVersionHealth values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerDeploymentVersionHealth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerDeploymentVersionHealth
+	switch t := that.(type) {
+	case *WorkerDeploymentVersionHealth:
+		that1 = t
+	case WorkerDeploymentVersionHealth:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkerDeploymentRollout to the protobuf v3 wire format
+func (val *WorkerDeploymentRollout) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerDeploymentRollout from the protobuf v3 wire format
+func (val *WorkerDeploymentRollout) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerDeploymentRollout) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerDeploymentRollout values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerDeploymentRollout) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerDeploymentRollout
+	switch t := that.(type) {
+	case *WorkerDeploymentRollout:
+		that1 = t
+	case WorkerDeploymentRollout:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkerDeploymentRolloutEvent to the protobuf v3 wire format
+func (val *WorkerDeploymentRolloutEvent) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerDeploymentRolloutEvent from the protobuf v3 wire format
+func (val *WorkerDeploymentRolloutEvent) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerDeploymentRolloutEvent) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerDeploymentRolloutEvent values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerDeploymentRolloutEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerDeploymentRolloutEvent
+	switch t := that.(type) {
+	case *WorkerDeploymentRolloutEvent:
+		that1 = t
+	case WorkerDeploymentRolloutEvent:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartRolloutArgs to the protobuf v3 wire format
+func (val *StartRolloutArgs) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartRolloutArgs from the protobuf v3 wire format
+func (val *StartRolloutArgs) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartRolloutArgs) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartRolloutArgs values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartRolloutArgs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartRolloutArgs
+	switch t := that.(type) {
+	case *StartRolloutArgs:
+		that1 = t
+	case StartRolloutArgs:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateRolloutArgs to the protobuf v3 wire format
+func (val *UpdateRolloutArgs) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateRolloutArgs from the protobuf v3 wire format
+func (val *UpdateRolloutArgs) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateRolloutArgs) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateRolloutArgs values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateRolloutArgs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateRolloutArgs
+	switch t := that.(type) {
+	case *UpdateRolloutArgs:
+		that1 = t
+	case UpdateRolloutArgs:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RolloutResponse to the protobuf v3 wire format
+func (val *RolloutResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RolloutResponse from the protobuf v3 wire format
+func (val *RolloutResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RolloutResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RolloutResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RolloutResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RolloutResponse
+	switch t := that.(type) {
+	case *RolloutResponse:
+		that1 = t
+	case RolloutResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetVersionHealthActivityArgs to the protobuf v3 wire format
+func (val *GetVersionHealthActivityArgs) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetVersionHealthActivityArgs from the protobuf v3 wire format
+func (val *GetVersionHealthActivityArgs) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetVersionHealthActivityArgs) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetVersionHealthActivityArgs values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetVersionHealthActivityArgs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetVersionHealthActivityArgs
+	switch t := that.(type) {
+	case *GetVersionHealthActivityArgs:
+		that1 = t
+	case GetVersionHealthActivityArgs:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetVersionHealthActivityResult to the protobuf v3 wire format
+func (val *GetVersionHealthActivityResult) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetVersionHealthActivityResult from the protobuf v3 wire format
+func (val *GetVersionHealthActivityResult) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetVersionHealthActivityResult) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetVersionHealthActivityResult values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetVersionHealthActivityResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetVersionHealthActivityResult
+	switch t := that.(type) {
+	case *GetVersionHealthActivityResult:
+		that1 = t
+	case GetVersionHealthActivityResult:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type SetManagerIdentityArgs to the protobuf v3 wire format
 func (val *SetManagerIdentityArgs) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -15,8 +15,10 @@ import (
 	v12 "go.temporal.io/api/compute/v1"
 	v11 "go.temporal.io/api/deployment/v1"
 	v1 "go.temporal.io/api/enums/v1"
+	v14 "go.temporal.io/server/api/enums/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -678,8 +680,10 @@ type WorkerDeploymentLocalState struct {
 	PropagatingRevisions map[string]*PropagatingRevisions `protobuf:"bytes,8,rep,name=propagating_revisions,json=propagatingRevisions,proto3" json:"propagating_revisions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Request ID used to create this worker deployment.
 	CreateRequestId string `protobuf:"bytes,9,opt,name=create_request_id,json=createRequestId,proto3" json:"create_request_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// The running managed rollout, or the last one that finished. Nil if no rollout was ever started.
+	Rollout       *WorkerDeploymentRollout `protobuf:"bytes,10,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WorkerDeploymentLocalState) Reset() {
@@ -775,6 +779,13 @@ func (x *WorkerDeploymentLocalState) GetCreateRequestId() string {
 	return ""
 }
 
+func (x *WorkerDeploymentLocalState) GetRollout() *WorkerDeploymentRollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
 // Tracks revision numbers that are currently propagating for a specific build ID
 type PropagatingRevisions struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -2991,32 +3002,33 @@ func (x *SetRampingVersionArgs) GetAllowNoPollers() bool {
 	return false
 }
 
-// used as Worker Deployment workflow update input:
-type SetManagerIdentityArgs struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// identity is the client's identity, as usual
-	Identity string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
-	// manager_identity is the new manager_identity.
-	ManagerIdentity string `protobuf:"bytes,2,opt,name=manager_identity,json=managerIdentity,proto3" json:"manager_identity,omitempty"`
-	ConflictToken   []byte `protobuf:"bytes,5,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Plan of a managed rollout: the ramp steps a version goes through and the health gates it must pass to advance. The
+// version becomes current once the dwell time of the last step passed.
+type WorkerDeploymentRolloutPlan struct {
+	state       protoimpl.MessageState              `protogen:"open.v1"`
+	Steps       []*WorkerDeploymentRolloutStep      `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	HealthGates *WorkerDeploymentRolloutHealthGates `protobuf:"bytes,2,opt,name=health_gates,json=healthGates,proto3" json:"health_gates,omitempty"`
+	// How often the health gates are checked while the rollout dwells on a step. Defaults to one minute.
+	HealthCheckInterval *durationpb.Duration                     `protobuf:"bytes,3,opt,name=health_check_interval,json=healthCheckInterval,proto3" json:"health_check_interval,omitempty"`
+	FailureAction       v14.WorkerDeploymentRolloutFailureAction `protobuf:"varint,4,opt,name=failure_action,json=failureAction,proto3,enum=temporal.server.api.enums.v1.WorkerDeploymentRolloutFailureAction" json:"failure_action,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *SetManagerIdentityArgs) Reset() {
-	*x = SetManagerIdentityArgs{}
+func (x *WorkerDeploymentRolloutPlan) Reset() {
+	*x = WorkerDeploymentRolloutPlan{}
 	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetManagerIdentityArgs) String() string {
+func (x *WorkerDeploymentRolloutPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetManagerIdentityArgs) ProtoMessage() {}
+func (*WorkerDeploymentRolloutPlan) ProtoMessage() {}
 
-func (x *SetManagerIdentityArgs) ProtoReflect() protoreflect.Message {
+func (x *WorkerDeploymentRolloutPlan) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3028,55 +3040,63 @@ func (x *SetManagerIdentityArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetManagerIdentityArgs.ProtoReflect.Descriptor instead.
-func (*SetManagerIdentityArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkerDeploymentRolloutPlan.ProtoReflect.Descriptor instead.
+func (*WorkerDeploymentRolloutPlan) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *SetManagerIdentityArgs) GetIdentity() string {
+func (x *WorkerDeploymentRolloutPlan) GetSteps() []*WorkerDeploymentRolloutStep {
 	if x != nil {
-		return x.Identity
+		return x.Steps
 	}
-	return ""
+	return nil
 }
 
-func (x *SetManagerIdentityArgs) GetManagerIdentity() string {
+func (x *WorkerDeploymentRolloutPlan) GetHealthGates() *WorkerDeploymentRolloutHealthGates {
 	if x != nil {
-		return x.ManagerIdentity
+		return x.HealthGates
 	}
-	return ""
+	return nil
 }
 
-func (x *SetManagerIdentityArgs) GetConflictToken() []byte {
+func (x *WorkerDeploymentRolloutPlan) GetHealthCheckInterval() *durationpb.Duration {
 	if x != nil {
-		return x.ConflictToken
+		return x.HealthCheckInterval
 	}
 	return nil
 }
 
-// used as Worker Deployment update response:
-type SetManagerIdentityResponse struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	PreviousManagerIdentity string                 `protobuf:"bytes,1,opt,name=previous_manager_identity,json=previousManagerIdentity,proto3" json:"previous_manager_identity,omitempty"`
-	ConflictToken           []byte                 `protobuf:"bytes,2,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *WorkerDeploymentRolloutPlan) GetFailureAction() v14.WorkerDeploymentRolloutFailureAction {
+	if x != nil {
+		return x.FailureAction
+	}
+	return v14.WorkerDeploymentRolloutFailureAction(0)
 }
 
-func (x *SetManagerIdentityResponse) Reset() {
-	*x = SetManagerIdentityResponse{}
+type WorkerDeploymentRolloutStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ramp percentage of the version during this step. Must be below 100 and above the percentage of the previous step.
+	RampPercentage float32 `protobuf:"fixed32,1,opt,name=ramp_percentage,json=rampPercentage,proto3" json:"ramp_percentage,omitempty"`
+	// How long the rollout stays on this step before advancing to the next one.
+	DwellTime     *durationpb.Duration `protobuf:"bytes,2,opt,name=dwell_time,json=dwellTime,proto3" json:"dwell_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerDeploymentRolloutStep) Reset() {
+	*x = WorkerDeploymentRolloutStep{}
 	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetManagerIdentityResponse) String() string {
+func (x *WorkerDeploymentRolloutStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetManagerIdentityResponse) ProtoMessage() {}
+func (*WorkerDeploymentRolloutStep) ProtoMessage() {}
 
-func (x *SetManagerIdentityResponse) ProtoReflect() protoreflect.Message {
+func (x *WorkerDeploymentRolloutStep) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3088,51 +3108,56 @@ func (x *SetManagerIdentityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetManagerIdentityResponse.ProtoReflect.Descriptor instead.
-func (*SetManagerIdentityResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkerDeploymentRolloutStep.ProtoReflect.Descriptor instead.
+func (*WorkerDeploymentRolloutStep) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SetManagerIdentityResponse) GetPreviousManagerIdentity() string {
+func (x *WorkerDeploymentRolloutStep) GetRampPercentage() float32 {
 	if x != nil {
-		return x.PreviousManagerIdentity
+		return x.RampPercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *SetManagerIdentityResponse) GetConflictToken() []byte {
+func (x *WorkerDeploymentRolloutStep) GetDwellTime() *durationpb.Duration {
 	if x != nil {
-		return x.ConflictToken
+		return x.DwellTime
 	}
 	return nil
 }
 
-// used as Worker Deployment activity input:
-type SyncVersionStateActivityArgs struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DeploymentName string                 `protobuf:"bytes,1,opt,name=deployment_name,json=deploymentName,proto3" json:"deployment_name,omitempty"`
-	// <deployment_name>.<build_id> or possibly just <version_id> in the future
-	Version       string                      `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	UpdateArgs    *SyncVersionStateUpdateArgs `protobuf:"bytes,3,opt,name=update_args,json=updateArgs,proto3" json:"update_args,omitempty"`
-	RequestId     string                      `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Health gates of a managed rollout. Failure rates are computed from the worker heartbeats of the version since its
+// step started. Only the heartbeats kept in the worker registry history are counted, and nondeterminism errors are
+// only kept in memory by matching, so for long dwell times the health undercounts tasks and errors.
+type WorkerDeploymentRolloutHealthGates struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Max ratio of failed to processed workflow tasks. Zero disables the gate.
+	MaxWorkflowTaskFailureRate float64 `protobuf:"fixed64,1,opt,name=max_workflow_task_failure_rate,json=maxWorkflowTaskFailureRate,proto3" json:"max_workflow_task_failure_rate,omitempty"`
+	// Max ratio of failed to processed activity tasks. Zero disables the gate.
+	MaxActivityFailureRate float64 `protobuf:"fixed64,2,opt,name=max_activity_failure_rate,json=maxActivityFailureRate,proto3" json:"max_activity_failure_rate,omitempty"`
+	// Max number of nondeterminism errors. Negative disables the gate.
+	MaxNondeterminismErrors int64 `protobuf:"varint,3,opt,name=max_nondeterminism_errors,json=maxNondeterminismErrors,proto3" json:"max_nondeterminism_errors,omitempty"`
+	// Failure rates are not checked until this many tasks of the corresponding type were processed.
+	MinProcessedTasks int64 `protobuf:"varint,4,opt,name=min_processed_tasks,json=minProcessedTasks,proto3" json:"min_processed_tasks,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *SyncVersionStateActivityArgs) Reset() {
-	*x = SyncVersionStateActivityArgs{}
+func (x *WorkerDeploymentRolloutHealthGates) Reset() {
+	*x = WorkerDeploymentRolloutHealthGates{}
 	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncVersionStateActivityArgs) String() string {
+func (x *WorkerDeploymentRolloutHealthGates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncVersionStateActivityArgs) ProtoMessage() {}
+func (*WorkerDeploymentRolloutHealthGates) ProtoMessage() {}
 
-func (x *SyncVersionStateActivityArgs) ProtoReflect() protoreflect.Message {
+func (x *WorkerDeploymentRolloutHealthGates) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3144,63 +3169,67 @@ func (x *SyncVersionStateActivityArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncVersionStateActivityArgs.ProtoReflect.Descriptor instead.
-func (*SyncVersionStateActivityArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkerDeploymentRolloutHealthGates.ProtoReflect.Descriptor instead.
+func (*WorkerDeploymentRolloutHealthGates) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SyncVersionStateActivityArgs) GetDeploymentName() string {
+func (x *WorkerDeploymentRolloutHealthGates) GetMaxWorkflowTaskFailureRate() float64 {
 	if x != nil {
-		return x.DeploymentName
+		return x.MaxWorkflowTaskFailureRate
 	}
-	return ""
+	return 0
 }
 
-func (x *SyncVersionStateActivityArgs) GetVersion() string {
+func (x *WorkerDeploymentRolloutHealthGates) GetMaxActivityFailureRate() float64 {
 	if x != nil {
-		return x.Version
+		return x.MaxActivityFailureRate
 	}
-	return ""
+	return 0
 }
 
-func (x *SyncVersionStateActivityArgs) GetUpdateArgs() *SyncVersionStateUpdateArgs {
+func (x *WorkerDeploymentRolloutHealthGates) GetMaxNondeterminismErrors() int64 {
 	if x != nil {
-		return x.UpdateArgs
+		return x.MaxNondeterminismErrors
 	}
-	return nil
+	return 0
 }
 
-func (x *SyncVersionStateActivityArgs) GetRequestId() string {
+func (x *WorkerDeploymentRolloutHealthGates) GetMinProcessedTasks() int64 {
 	if x != nil {
-		return x.RequestId
+		return x.MinProcessedTasks
 	}
-	return ""
+	return 0
 }
 
-// used as Worker Deployment activity result:
-type SyncVersionStateActivityResult struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Deprecated: Marked as deprecated in temporal/server/api/deployment/v1/message.proto.
-	VersionState  *VersionLocalState              `protobuf:"bytes,1,opt,name=version_state,json=versionState,proto3" json:"version_state,omitempty"`
-	Summary       *WorkerDeploymentVersionSummary `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Health of a Worker Deployment Version, aggregated from the heartbeats of its workers. This is a best-effort lower
+// bound: counters are limited to the heartbeat history kept per worker and nondeterminism errors are not persisted.
+type WorkerDeploymentVersionHealth struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	WorkerCount            int32                  `protobuf:"varint,1,opt,name=worker_count,json=workerCount,proto3" json:"worker_count,omitempty"`
+	WorkflowTasksProcessed int64                  `protobuf:"varint,2,opt,name=workflow_tasks_processed,json=workflowTasksProcessed,proto3" json:"workflow_tasks_processed,omitempty"`
+	WorkflowTasksFailed    int64                  `protobuf:"varint,3,opt,name=workflow_tasks_failed,json=workflowTasksFailed,proto3" json:"workflow_tasks_failed,omitempty"`
+	ActivityTasksProcessed int64                  `protobuf:"varint,4,opt,name=activity_tasks_processed,json=activityTasksProcessed,proto3" json:"activity_tasks_processed,omitempty"`
+	ActivityTasksFailed    int64                  `protobuf:"varint,5,opt,name=activity_tasks_failed,json=activityTasksFailed,proto3" json:"activity_tasks_failed,omitempty"`
+	NondeterminismErrors   int64                  `protobuf:"varint,6,opt,name=nondeterminism_errors,json=nondeterminismErrors,proto3" json:"nondeterminism_errors,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *SyncVersionStateActivityResult) Reset() {
-	*x = SyncVersionStateActivityResult{}
+func (x *WorkerDeploymentVersionHealth) Reset() {
+	*x = WorkerDeploymentVersionHealth{}
 	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncVersionStateActivityResult) String() string {
+func (x *WorkerDeploymentVersionHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncVersionStateActivityResult) ProtoMessage() {}
+func (*WorkerDeploymentVersionHealth) ProtoMessage() {}
 
-func (x *SyncVersionStateActivityResult) ProtoReflect() protoreflect.Message {
+func (x *WorkerDeploymentVersionHealth) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3212,49 +3241,91 @@ func (x *SyncVersionStateActivityResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncVersionStateActivityResult.ProtoReflect.Descriptor instead.
-func (*SyncVersionStateActivityResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkerDeploymentVersionHealth.ProtoReflect.Descriptor instead.
+func (*WorkerDeploymentVersionHealth) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{47}
 }
 
-// Deprecated: Marked as deprecated in temporal/server/api/deployment/v1/message.proto.
-func (x *SyncVersionStateActivityResult) GetVersionState() *VersionLocalState {
+func (x *WorkerDeploymentVersionHealth) GetWorkerCount() int32 {
 	if x != nil {
-		return x.VersionState
+		return x.WorkerCount
 	}
-	return nil
+	return 0
 }
 
-func (x *SyncVersionStateActivityResult) GetSummary() *WorkerDeploymentVersionSummary {
+func (x *WorkerDeploymentVersionHealth) GetWorkflowTasksProcessed() int64 {
 	if x != nil {
-		return x.Summary
+		return x.WorkflowTasksProcessed
 	}
-	return nil
+	return 0
 }
 
-// used as Worker Deployment activity input:
-type IsVersionMissingTaskQueuesArgs struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	PrevCurrentVersion string                 `protobuf:"bytes,1,opt,name=prev_current_version,json=prevCurrentVersion,proto3" json:"prev_current_version,omitempty"`
-	NewCurrentVersion  string                 `protobuf:"bytes,2,opt,name=new_current_version,json=newCurrentVersion,proto3" json:"new_current_version,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *WorkerDeploymentVersionHealth) GetWorkflowTasksFailed() int64 {
+	if x != nil {
+		return x.WorkflowTasksFailed
+	}
+	return 0
 }
 
-func (x *IsVersionMissingTaskQueuesArgs) Reset() {
-	*x = IsVersionMissingTaskQueuesArgs{}
+func (x *WorkerDeploymentVersionHealth) GetActivityTasksProcessed() int64 {
+	if x != nil {
+		return x.ActivityTasksProcessed
+	}
+	return 0
+}
+
+func (x *WorkerDeploymentVersionHealth) GetActivityTasksFailed() int64 {
+	if x != nil {
+		return x.ActivityTasksFailed
+	}
+	return 0
+}
+
+func (x *WorkerDeploymentVersionHealth) GetNondeterminismErrors() int64 {
+	if x != nil {
+		return x.NondeterminismErrors
+	}
+	return 0
+}
+
+// A managed rollout of a Worker Deployment Version, executed by the Worker Deployment workflow.
+type WorkerDeploymentRollout struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version being rolled out, in the format of the keys of WorkerDeploymentLocalState.versions.
+	Version string                            `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Plan    *WorkerDeploymentRolloutPlan      `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	Status  v14.WorkerDeploymentRolloutStatus `protobuf:"varint,3,opt,name=status,proto3,enum=temporal.server.api.enums.v1.WorkerDeploymentRolloutStatus" json:"status,omitempty"`
+	// Index of the current step in the plan.
+	StepIndex int32 `protobuf:"varint,4,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	// Time the current step started, or was resumed. Health is measured from this time.
+	StepStartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=step_start_time,json=stepStartTime,proto3" json:"step_start_time,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Identity of the user that started the rollout. Ramp changes of the rollout are made with this identity.
+	Identity string `protobuf:"bytes,7,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Health of the version at the last health check.
+	LastHealth *WorkerDeploymentVersionHealth `protobuf:"bytes,8,opt,name=last_health,json=lastHealth,proto3" json:"last_health,omitempty"`
+	// Transitions of the rollout, oldest first.
+	History                 []*WorkerDeploymentRolloutEvent `protobuf:"bytes,9,rep,name=history,proto3" json:"history,omitempty"`
+	LastHealthCheckTime     *timestamppb.Timestamp          `protobuf:"bytes,10,opt,name=last_health_check_time,json=lastHealthCheckTime,proto3" json:"last_health_check_time,omitempty"`
+	IgnoreMissingTaskQueues bool                            `protobuf:"varint,11,opt,name=ignore_missing_task_queues,json=ignoreMissingTaskQueues,proto3" json:"ignore_missing_task_queues,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *WorkerDeploymentRollout) Reset() {
+	*x = WorkerDeploymentRollout{}
 	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsVersionMissingTaskQueuesArgs) String() string {
+func (x *WorkerDeploymentRollout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsVersionMissingTaskQueuesArgs) ProtoMessage() {}
+func (*WorkerDeploymentRollout) ProtoMessage() {}
 
-func (x *IsVersionMissingTaskQueuesArgs) ProtoReflect() protoreflect.Message {
+func (x *WorkerDeploymentRollout) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3266,98 +3337,116 @@ func (x *IsVersionMissingTaskQueuesArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsVersionMissingTaskQueuesArgs.ProtoReflect.Descriptor instead.
-func (*IsVersionMissingTaskQueuesArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkerDeploymentRollout.ProtoReflect.Descriptor instead.
+func (*WorkerDeploymentRollout) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *IsVersionMissingTaskQueuesArgs) GetPrevCurrentVersion() string {
+func (x *WorkerDeploymentRollout) GetVersion() string {
 	if x != nil {
-		return x.PrevCurrentVersion
+		return x.Version
 	}
 	return ""
 }
 
-func (x *IsVersionMissingTaskQueuesArgs) GetNewCurrentVersion() string {
+func (x *WorkerDeploymentRollout) GetPlan() *WorkerDeploymentRolloutPlan {
 	if x != nil {
-		return x.NewCurrentVersion
+		return x.Plan
 	}
-	return ""
+	return nil
 }
 
-// used as Worker Deployment activity output:
-type IsVersionMissingTaskQueuesResult struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	IsMissingTaskQueues bool                   `protobuf:"varint,1,opt,name=is_missing_task_queues,json=isMissingTaskQueues,proto3" json:"is_missing_task_queues,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *WorkerDeploymentRollout) GetStatus() v14.WorkerDeploymentRolloutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v14.WorkerDeploymentRolloutStatus(0)
 }
 
-func (x *IsVersionMissingTaskQueuesResult) Reset() {
-	*x = IsVersionMissingTaskQueuesResult{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[49]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WorkerDeploymentRollout) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
 }
 
-func (x *IsVersionMissingTaskQueuesResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WorkerDeploymentRollout) GetStepStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StepStartTime
+	}
+	return nil
 }
 
-func (*IsVersionMissingTaskQueuesResult) ProtoMessage() {}
+func (x *WorkerDeploymentRollout) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
 
-func (x *IsVersionMissingTaskQueuesResult) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[49]
+func (x *WorkerDeploymentRollout) GetIdentity() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Identity
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use IsVersionMissingTaskQueuesResult.ProtoReflect.Descriptor instead.
-func (*IsVersionMissingTaskQueuesResult) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{49}
+func (x *WorkerDeploymentRollout) GetLastHealth() *WorkerDeploymentVersionHealth {
+	if x != nil {
+		return x.LastHealth
+	}
+	return nil
 }
 
-func (x *IsVersionMissingTaskQueuesResult) GetIsMissingTaskQueues() bool {
+func (x *WorkerDeploymentRollout) GetHistory() []*WorkerDeploymentRolloutEvent {
 	if x != nil {
-		return x.IsMissingTaskQueues
+		return x.History
+	}
+	return nil
+}
+
+func (x *WorkerDeploymentRollout) GetLastHealthCheckTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHealthCheckTime
+	}
+	return nil
+}
+
+func (x *WorkerDeploymentRollout) GetIgnoreMissingTaskQueues() bool {
+	if x != nil {
+		return x.IgnoreMissingTaskQueues
 	}
 	return false
 }
 
-// used as Worker Deployment workflow memo:
-type WorkerDeploymentWorkflowMemo struct {
-	state                 protoimpl.MessageState                                   `protogen:"open.v1"`
-	DeploymentName        string                                                   `protobuf:"bytes,1,opt,name=deployment_name,json=deploymentName,proto3" json:"deployment_name,omitempty"`
-	CreateTime            *timestamppb.Timestamp                                   `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	RoutingConfig         *v11.RoutingConfig                                       `protobuf:"bytes,3,opt,name=routing_config,json=routingConfig,proto3" json:"routing_config,omitempty"`
-	LatestVersionSummary  *v11.WorkerDeploymentInfo_WorkerDeploymentVersionSummary `protobuf:"bytes,4,opt,name=latest_version_summary,json=latestVersionSummary,proto3" json:"latest_version_summary,omitempty"`
-	CurrentVersionSummary *v11.WorkerDeploymentInfo_WorkerDeploymentVersionSummary `protobuf:"bytes,5,opt,name=current_version_summary,json=currentVersionSummary,proto3" json:"current_version_summary,omitempty"`
-	RampingVersionSummary *v11.WorkerDeploymentInfo_WorkerDeploymentVersionSummary `protobuf:"bytes,6,opt,name=ramping_version_summary,json=rampingVersionSummary,proto3" json:"ramping_version_summary,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type WorkerDeploymentRolloutEvent struct {
+	state          protoimpl.MessageState            `protogen:"open.v1"`
+	EventTime      *timestamppb.Timestamp            `protobuf:"bytes,1,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	Status         v14.WorkerDeploymentRolloutStatus `protobuf:"varint,2,opt,name=status,proto3,enum=temporal.server.api.enums.v1.WorkerDeploymentRolloutStatus" json:"status,omitempty"`
+	StepIndex      int32                             `protobuf:"varint,3,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	RampPercentage float32                           `protobuf:"fixed32,4,opt,name=ramp_percentage,json=rampPercentage,proto3" json:"ramp_percentage,omitempty"`
+	// Why the transition happened, e.g. the health gate that failed.
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity      string `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkerDeploymentWorkflowMemo) Reset() {
-	*x = WorkerDeploymentWorkflowMemo{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[50]
+func (x *WorkerDeploymentRolloutEvent) Reset() {
+	*x = WorkerDeploymentRolloutEvent{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkerDeploymentWorkflowMemo) String() string {
+func (x *WorkerDeploymentRolloutEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkerDeploymentWorkflowMemo) ProtoMessage() {}
+func (*WorkerDeploymentRolloutEvent) ProtoMessage() {}
 
-func (x *WorkerDeploymentWorkflowMemo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[50]
+func (x *WorkerDeploymentRolloutEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3368,16 +3457,713 @@ func (x *WorkerDeploymentWorkflowMemo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkerDeploymentWorkflowMemo.ProtoReflect.Descriptor instead.
-func (*WorkerDeploymentWorkflowMemo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use WorkerDeploymentRolloutEvent.ProtoReflect.Descriptor instead.
+func (*WorkerDeploymentRolloutEvent) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *WorkerDeploymentWorkflowMemo) GetDeploymentName() string {
+func (x *WorkerDeploymentRolloutEvent) GetEventTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DeploymentName
+		return x.EventTime
 	}
-	return ""
+	return nil
+}
+
+func (x *WorkerDeploymentRolloutEvent) GetStatus() v14.WorkerDeploymentRolloutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v14.WorkerDeploymentRolloutStatus(0)
+}
+
+func (x *WorkerDeploymentRolloutEvent) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+func (x *WorkerDeploymentRolloutEvent) GetRampPercentage() float32 {
+	if x != nil {
+		return x.RampPercentage
+	}
+	return 0
+}
+
+func (x *WorkerDeploymentRolloutEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WorkerDeploymentRolloutEvent) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+// used as Worker Deployment workflow update input:
+type StartRolloutArgs struct {
+	state                   protoimpl.MessageState       `protogen:"open.v1"`
+	Identity                string                       `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Version                 string                       `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Plan                    *WorkerDeploymentRolloutPlan `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
+	IgnoreMissingTaskQueues bool                         `protobuf:"varint,4,opt,name=ignore_missing_task_queues,json=ignoreMissingTaskQueues,proto3" json:"ignore_missing_task_queues,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *StartRolloutArgs) Reset() {
+	*x = StartRolloutArgs{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRolloutArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRolloutArgs) ProtoMessage() {}
+
+func (x *StartRolloutArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRolloutArgs.ProtoReflect.Descriptor instead.
+func (*StartRolloutArgs) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *StartRolloutArgs) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *StartRolloutArgs) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *StartRolloutArgs) GetPlan() *WorkerDeploymentRolloutPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *StartRolloutArgs) GetIgnoreMissingTaskQueues() bool {
+	if x != nil {
+		return x.IgnoreMissingTaskQueues
+	}
+	return false
+}
+
+// used as Worker Deployment workflow update input:
+type UpdateRolloutArgs struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Identity      string                            `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Action        v14.WorkerDeploymentRolloutAction `protobuf:"varint,2,opt,name=action,proto3,enum=temporal.server.api.enums.v1.WorkerDeploymentRolloutAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRolloutArgs) Reset() {
+	*x = UpdateRolloutArgs{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRolloutArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRolloutArgs) ProtoMessage() {}
+
+func (x *UpdateRolloutArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRolloutArgs.ProtoReflect.Descriptor instead.
+func (*UpdateRolloutArgs) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateRolloutArgs) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *UpdateRolloutArgs) GetAction() v14.WorkerDeploymentRolloutAction {
+	if x != nil {
+		return x.Action
+	}
+	return v14.WorkerDeploymentRolloutAction(0)
+}
+
+// used as Worker Deployment workflow update response:
+type RolloutResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Rollout       *WorkerDeploymentRollout `protobuf:"bytes,1,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutResponse) Reset() {
+	*x = RolloutResponse{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutResponse) ProtoMessage() {}
+
+func (x *RolloutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutResponse.ProtoReflect.Descriptor instead.
+func (*RolloutResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RolloutResponse) GetRollout() *WorkerDeploymentRollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+// used as Worker Deployment activity input:
+type GetVersionHealthActivityArgs struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeploymentName string                 `protobuf:"bytes,1,opt,name=deployment_name,json=deploymentName,proto3" json:"deployment_name,omitempty"`
+	BuildId        string                 `protobuf:"bytes,2,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	Since          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetVersionHealthActivityArgs) Reset() {
+	*x = GetVersionHealthActivityArgs{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVersionHealthActivityArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionHealthActivityArgs) ProtoMessage() {}
+
+func (x *GetVersionHealthActivityArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionHealthActivityArgs.ProtoReflect.Descriptor instead.
+func (*GetVersionHealthActivityArgs) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetVersionHealthActivityArgs) GetDeploymentName() string {
+	if x != nil {
+		return x.DeploymentName
+	}
+	return ""
+}
+
+func (x *GetVersionHealthActivityArgs) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (x *GetVersionHealthActivityArgs) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// used as Worker Deployment activity output:
+type GetVersionHealthActivityResult struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Health        *WorkerDeploymentVersionHealth `protobuf:"bytes,1,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVersionHealthActivityResult) Reset() {
+	*x = GetVersionHealthActivityResult{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVersionHealthActivityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionHealthActivityResult) ProtoMessage() {}
+
+func (x *GetVersionHealthActivityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionHealthActivityResult.ProtoReflect.Descriptor instead.
+func (*GetVersionHealthActivityResult) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetVersionHealthActivityResult) GetHealth() *WorkerDeploymentVersionHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+// used as Worker Deployment workflow update input:
+type SetManagerIdentityArgs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identity is the client's identity, as usual
+	Identity string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	// manager_identity is the new manager_identity.
+	ManagerIdentity string `protobuf:"bytes,2,opt,name=manager_identity,json=managerIdentity,proto3" json:"manager_identity,omitempty"`
+	ConflictToken   []byte `protobuf:"bytes,5,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetManagerIdentityArgs) Reset() {
+	*x = SetManagerIdentityArgs{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetManagerIdentityArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetManagerIdentityArgs) ProtoMessage() {}
+
+func (x *SetManagerIdentityArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetManagerIdentityArgs.ProtoReflect.Descriptor instead.
+func (*SetManagerIdentityArgs) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetManagerIdentityArgs) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *SetManagerIdentityArgs) GetManagerIdentity() string {
+	if x != nil {
+		return x.ManagerIdentity
+	}
+	return ""
+}
+
+func (x *SetManagerIdentityArgs) GetConflictToken() []byte {
+	if x != nil {
+		return x.ConflictToken
+	}
+	return nil
+}
+
+// used as Worker Deployment update response:
+type SetManagerIdentityResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	PreviousManagerIdentity string                 `protobuf:"bytes,1,opt,name=previous_manager_identity,json=previousManagerIdentity,proto3" json:"previous_manager_identity,omitempty"`
+	ConflictToken           []byte                 `protobuf:"bytes,2,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *SetManagerIdentityResponse) Reset() {
+	*x = SetManagerIdentityResponse{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetManagerIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetManagerIdentityResponse) ProtoMessage() {}
+
+func (x *SetManagerIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetManagerIdentityResponse.ProtoReflect.Descriptor instead.
+func (*SetManagerIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetManagerIdentityResponse) GetPreviousManagerIdentity() string {
+	if x != nil {
+		return x.PreviousManagerIdentity
+	}
+	return ""
+}
+
+func (x *SetManagerIdentityResponse) GetConflictToken() []byte {
+	if x != nil {
+		return x.ConflictToken
+	}
+	return nil
+}
+
+// used as Worker Deployment activity input:
+type SyncVersionStateActivityArgs struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeploymentName string                 `protobuf:"bytes,1,opt,name=deployment_name,json=deploymentName,proto3" json:"deployment_name,omitempty"`
+	// <deployment_name>.<build_id> or possibly just <version_id> in the future
+	Version       string                      `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	UpdateArgs    *SyncVersionStateUpdateArgs `protobuf:"bytes,3,opt,name=update_args,json=updateArgs,proto3" json:"update_args,omitempty"`
+	RequestId     string                      `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncVersionStateActivityArgs) Reset() {
+	*x = SyncVersionStateActivityArgs{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncVersionStateActivityArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncVersionStateActivityArgs) ProtoMessage() {}
+
+func (x *SyncVersionStateActivityArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncVersionStateActivityArgs.ProtoReflect.Descriptor instead.
+func (*SyncVersionStateActivityArgs) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SyncVersionStateActivityArgs) GetDeploymentName() string {
+	if x != nil {
+		return x.DeploymentName
+	}
+	return ""
+}
+
+func (x *SyncVersionStateActivityArgs) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SyncVersionStateActivityArgs) GetUpdateArgs() *SyncVersionStateUpdateArgs {
+	if x != nil {
+		return x.UpdateArgs
+	}
+	return nil
+}
+
+func (x *SyncVersionStateActivityArgs) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// used as Worker Deployment activity result:
+type SyncVersionStateActivityResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in temporal/server/api/deployment/v1/message.proto.
+	VersionState  *VersionLocalState              `protobuf:"bytes,1,opt,name=version_state,json=versionState,proto3" json:"version_state,omitempty"`
+	Summary       *WorkerDeploymentVersionSummary `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncVersionStateActivityResult) Reset() {
+	*x = SyncVersionStateActivityResult{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncVersionStateActivityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncVersionStateActivityResult) ProtoMessage() {}
+
+func (x *SyncVersionStateActivityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncVersionStateActivityResult.ProtoReflect.Descriptor instead.
+func (*SyncVersionStateActivityResult) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{58}
+}
+
+// Deprecated: Marked as deprecated in temporal/server/api/deployment/v1/message.proto.
+func (x *SyncVersionStateActivityResult) GetVersionState() *VersionLocalState {
+	if x != nil {
+		return x.VersionState
+	}
+	return nil
+}
+
+func (x *SyncVersionStateActivityResult) GetSummary() *WorkerDeploymentVersionSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// used as Worker Deployment activity input:
+type IsVersionMissingTaskQueuesArgs struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PrevCurrentVersion string                 `protobuf:"bytes,1,opt,name=prev_current_version,json=prevCurrentVersion,proto3" json:"prev_current_version,omitempty"`
+	NewCurrentVersion  string                 `protobuf:"bytes,2,opt,name=new_current_version,json=newCurrentVersion,proto3" json:"new_current_version,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *IsVersionMissingTaskQueuesArgs) Reset() {
+	*x = IsVersionMissingTaskQueuesArgs{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsVersionMissingTaskQueuesArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsVersionMissingTaskQueuesArgs) ProtoMessage() {}
+
+func (x *IsVersionMissingTaskQueuesArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsVersionMissingTaskQueuesArgs.ProtoReflect.Descriptor instead.
+func (*IsVersionMissingTaskQueuesArgs) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *IsVersionMissingTaskQueuesArgs) GetPrevCurrentVersion() string {
+	if x != nil {
+		return x.PrevCurrentVersion
+	}
+	return ""
+}
+
+func (x *IsVersionMissingTaskQueuesArgs) GetNewCurrentVersion() string {
+	if x != nil {
+		return x.NewCurrentVersion
+	}
+	return ""
+}
+
+// used as Worker Deployment activity output:
+type IsVersionMissingTaskQueuesResult struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	IsMissingTaskQueues bool                   `protobuf:"varint,1,opt,name=is_missing_task_queues,json=isMissingTaskQueues,proto3" json:"is_missing_task_queues,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *IsVersionMissingTaskQueuesResult) Reset() {
+	*x = IsVersionMissingTaskQueuesResult{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsVersionMissingTaskQueuesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsVersionMissingTaskQueuesResult) ProtoMessage() {}
+
+func (x *IsVersionMissingTaskQueuesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsVersionMissingTaskQueuesResult.ProtoReflect.Descriptor instead.
+func (*IsVersionMissingTaskQueuesResult) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *IsVersionMissingTaskQueuesResult) GetIsMissingTaskQueues() bool {
+	if x != nil {
+		return x.IsMissingTaskQueues
+	}
+	return false
+}
+
+// used as Worker Deployment workflow memo:
+type WorkerDeploymentWorkflowMemo struct {
+	state                 protoimpl.MessageState                                   `protogen:"open.v1"`
+	DeploymentName        string                                                   `protobuf:"bytes,1,opt,name=deployment_name,json=deploymentName,proto3" json:"deployment_name,omitempty"`
+	CreateTime            *timestamppb.Timestamp                                   `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	RoutingConfig         *v11.RoutingConfig                                       `protobuf:"bytes,3,opt,name=routing_config,json=routingConfig,proto3" json:"routing_config,omitempty"`
+	LatestVersionSummary  *v11.WorkerDeploymentInfo_WorkerDeploymentVersionSummary `protobuf:"bytes,4,opt,name=latest_version_summary,json=latestVersionSummary,proto3" json:"latest_version_summary,omitempty"`
+	CurrentVersionSummary *v11.WorkerDeploymentInfo_WorkerDeploymentVersionSummary `protobuf:"bytes,5,opt,name=current_version_summary,json=currentVersionSummary,proto3" json:"current_version_summary,omitempty"`
+	RampingVersionSummary *v11.WorkerDeploymentInfo_WorkerDeploymentVersionSummary `protobuf:"bytes,6,opt,name=ramping_version_summary,json=rampingVersionSummary,proto3" json:"ramping_version_summary,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *WorkerDeploymentWorkflowMemo) Reset() {
+	*x = WorkerDeploymentWorkflowMemo{}
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerDeploymentWorkflowMemo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerDeploymentWorkflowMemo) ProtoMessage() {}
+
+func (x *WorkerDeploymentWorkflowMemo) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerDeploymentWorkflowMemo.ProtoReflect.Descriptor instead.
+func (*WorkerDeploymentWorkflowMemo) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *WorkerDeploymentWorkflowMemo) GetDeploymentName() string {
+	if x != nil {
+		return x.DeploymentName
+	}
+	return ""
 }
 
 func (x *WorkerDeploymentWorkflowMemo) GetCreateTime() *timestamppb.Timestamp {
@@ -3430,7 +4216,7 @@ type WorkerDeploymentSummary struct {
 
 func (x *WorkerDeploymentSummary) Reset() {
 	*x = WorkerDeploymentSummary{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[51]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3442,7 +4228,7 @@ func (x *WorkerDeploymentSummary) String() string {
 func (*WorkerDeploymentSummary) ProtoMessage() {}
 
 func (x *WorkerDeploymentSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[51]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3455,7 +4241,7 @@ func (x *WorkerDeploymentSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkerDeploymentSummary.ProtoReflect.Descriptor instead.
 func (*WorkerDeploymentSummary) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{51}
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *WorkerDeploymentSummary) GetName() string {
@@ -3511,7 +4297,7 @@ type ValidateWorkerControllerInstanceSpecInput struct {
 
 func (x *ValidateWorkerControllerInstanceSpecInput) Reset() {
 	*x = ValidateWorkerControllerInstanceSpecInput{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[52]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3523,7 +4309,7 @@ func (x *ValidateWorkerControllerInstanceSpecInput) String() string {
 func (*ValidateWorkerControllerInstanceSpecInput) ProtoMessage() {}
 
 func (x *ValidateWorkerControllerInstanceSpecInput) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[52]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3536,7 +4322,7 @@ func (x *ValidateWorkerControllerInstanceSpecInput) ProtoReflect() protoreflect.
 
 // Deprecated: Use ValidateWorkerControllerInstanceSpecInput.ProtoReflect.Descriptor instead.
 func (*ValidateWorkerControllerInstanceSpecInput) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{52}
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ValidateWorkerControllerInstanceSpecInput) GetScalingGroups() map[string]*v12.ComputeConfigScalingGroup {
@@ -3560,7 +4346,7 @@ type UpdateWorkerControllerInstanceInput struct {
 
 func (x *UpdateWorkerControllerInstanceInput) Reset() {
 	*x = UpdateWorkerControllerInstanceInput{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[53]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3572,7 +4358,7 @@ func (x *UpdateWorkerControllerInstanceInput) String() string {
 func (*UpdateWorkerControllerInstanceInput) ProtoMessage() {}
 
 func (x *UpdateWorkerControllerInstanceInput) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[53]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3585,7 +4371,7 @@ func (x *UpdateWorkerControllerInstanceInput) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UpdateWorkerControllerInstanceInput.ProtoReflect.Descriptor instead.
 func (*UpdateWorkerControllerInstanceInput) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{53}
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *UpdateWorkerControllerInstanceInput) GetVersion() *v11.WorkerDeploymentVersion {
@@ -3628,7 +4414,7 @@ type DeleteWorkerControllerInstanceInput struct {
 
 func (x *DeleteWorkerControllerInstanceInput) Reset() {
 	*x = DeleteWorkerControllerInstanceInput{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[54]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3640,7 +4426,7 @@ func (x *DeleteWorkerControllerInstanceInput) String() string {
 func (*DeleteWorkerControllerInstanceInput) ProtoMessage() {}
 
 func (x *DeleteWorkerControllerInstanceInput) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[54]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3653,7 +4439,7 @@ func (x *DeleteWorkerControllerInstanceInput) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use DeleteWorkerControllerInstanceInput.ProtoReflect.Descriptor instead.
 func (*DeleteWorkerControllerInstanceInput) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{54}
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *DeleteWorkerControllerInstanceInput) GetVersion() *v11.WorkerDeploymentVersion {
@@ -3685,7 +4471,7 @@ type UpdateComputeConfigArgs struct {
 
 func (x *UpdateComputeConfigArgs) Reset() {
 	*x = UpdateComputeConfigArgs{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[55]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3697,7 +4483,7 @@ func (x *UpdateComputeConfigArgs) String() string {
 func (*UpdateComputeConfigArgs) ProtoMessage() {}
 
 func (x *UpdateComputeConfigArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[55]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3710,7 +4496,7 @@ func (x *UpdateComputeConfigArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateComputeConfigArgs.ProtoReflect.Descriptor instead.
 func (*UpdateComputeConfigArgs) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{55}
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *UpdateComputeConfigArgs) GetIdentity() string {
@@ -3750,7 +4536,7 @@ type UpdateComputeConfigResponse struct {
 
 func (x *UpdateComputeConfigResponse) Reset() {
 	*x = UpdateComputeConfigResponse{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[56]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3762,7 +4548,7 @@ func (x *UpdateComputeConfigResponse) String() string {
 func (*UpdateComputeConfigResponse) ProtoMessage() {}
 
 func (x *UpdateComputeConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[56]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3775,7 +4561,7 @@ func (x *UpdateComputeConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateComputeConfigResponse.ProtoReflect.Descriptor instead.
 func (*UpdateComputeConfigResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{56}
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{67}
 }
 
 // Signal input for force-continue-as-new on Deployment workflow
@@ -3790,7 +4576,7 @@ type ForceCANDeploymentSignalArgs struct {
 
 func (x *ForceCANDeploymentSignalArgs) Reset() {
 	*x = ForceCANDeploymentSignalArgs{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[57]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3802,7 +4588,7 @@ func (x *ForceCANDeploymentSignalArgs) String() string {
 func (*ForceCANDeploymentSignalArgs) ProtoMessage() {}
 
 func (x *ForceCANDeploymentSignalArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[57]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3815,7 +4601,7 @@ func (x *ForceCANDeploymentSignalArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForceCANDeploymentSignalArgs.ProtoReflect.Descriptor instead.
 func (*ForceCANDeploymentSignalArgs) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{57}
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ForceCANDeploymentSignalArgs) GetOverrideState() *WorkerDeploymentLocalState {
@@ -3837,7 +4623,7 @@ type ForceCANVersionSignalArgs struct {
 
 func (x *ForceCANVersionSignalArgs) Reset() {
 	*x = ForceCANVersionSignalArgs{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[58]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3849,7 +4635,7 @@ func (x *ForceCANVersionSignalArgs) String() string {
 func (*ForceCANVersionSignalArgs) ProtoMessage() {}
 
 func (x *ForceCANVersionSignalArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[58]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3862,7 +4648,7 @@ func (x *ForceCANVersionSignalArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForceCANVersionSignalArgs.ProtoReflect.Descriptor instead.
 func (*ForceCANVersionSignalArgs) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{58}
+	return file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ForceCANVersionSignalArgs) GetOverrideState() *VersionLocalState {
@@ -3882,7 +4668,7 @@ type VersionLocalState_TaskQueueFamilyData struct {
 
 func (x *VersionLocalState_TaskQueueFamilyData) Reset() {
 	*x = VersionLocalState_TaskQueueFamilyData{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[60]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3894,7 +4680,7 @@ func (x *VersionLocalState_TaskQueueFamilyData) String() string {
 func (*VersionLocalState_TaskQueueFamilyData) ProtoMessage() {}
 
 func (x *VersionLocalState_TaskQueueFamilyData) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[60]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3928,7 +4714,7 @@ type SyncDeploymentVersionUserDataRequest_SyncUserData struct {
 
 func (x *SyncDeploymentVersionUserDataRequest_SyncUserData) Reset() {
 	*x = SyncDeploymentVersionUserDataRequest_SyncUserData{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[64]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3940,7 +4726,7 @@ func (x *SyncDeploymentVersionUserDataRequest_SyncUserData) String() string {
 func (*SyncDeploymentVersionUserDataRequest_SyncUserData) ProtoMessage() {}
 
 func (x *SyncDeploymentVersionUserDataRequest_SyncUserData) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[64]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3986,7 +4772,7 @@ type CheckTaskQueuesHavePollersActivityArgs_TaskQueueTypes struct {
 
 func (x *CheckTaskQueuesHavePollersActivityArgs_TaskQueueTypes) Reset() {
 	*x = CheckTaskQueuesHavePollersActivityArgs_TaskQueueTypes{}
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[70]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3998,7 +4784,7 @@ func (x *CheckTaskQueuesHavePollersActivityArgs_TaskQueueTypes) String() string
 func (*CheckTaskQueuesHavePollersActivityArgs_TaskQueueTypes) ProtoMessage() {}
 
 func (x *CheckTaskQueuesHavePollersActivityArgs_TaskQueueTypes) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[70]
+	mi := &file_temporal_server_api_deployment_v1_message_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4025,7 +4811,7 @@ var File_temporal_server_api_deployment_v1_message_proto protoreflect.FileDescri
 
 const file_temporal_server_api_deployment_v1_message_proto_rawDesc = "" +
 	"\n" +
-	"/temporal/server/api/deployment/v1/message.proto\x12!temporal.server.api.deployment.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a$temporal/api/compute/v1/config.proto\x1a(temporal/api/deployment/v1/message.proto\x1a&temporal/api/enums/v1/deployment.proto\x1a&temporal/api/enums/v1/task_queue.proto\"]\n" +
+	"/temporal/server/api/deployment/v1/message.proto\x12!temporal.server.api.deployment.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a$temporal/api/compute/v1/config.proto\x1a(temporal/api/deployment/v1/message.proto\x1a&temporal/api/enums/v1/deployment.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a-temporal/server/api/enums/v1/deployment.proto\"]\n" +
 	"\x17WorkerDeploymentVersion\x12'\n" +
 	"\x0fdeployment_name\x18\x01 \x01(\tR\x0edeploymentName\x12\x19\n" +
 	"\bbuild_id\x18\x02 \x01(\tR\abuildId\"\xc4\x03\n" +
@@ -4081,7 +4867,7 @@ const file_temporal_server_api_deployment_v1_message_proto_rawDesc = "" +
 	"\x0enamespace_name\x18\x01 \x01(\tR\rnamespaceName\x12!\n" +
 	"\fnamespace_id\x18\x02 \x01(\tR\vnamespaceId\x12'\n" +
 	"\x0fdeployment_name\x18\x03 \x01(\tR\x0edeploymentName\x12S\n" +
-	"\x05state\x18\x04 \x01(\v2=.temporal.server.api.deployment.v1.WorkerDeploymentLocalStateR\x05state\"\x82\a\n" +
+	"\x05state\x18\x04 \x01(\v2=.temporal.server.api.deployment.v1.WorkerDeploymentLocalStateR\x05state\"\xd8\a\n" +
 	"\x1aWorkerDeploymentLocalState\x12;\n" +
 	"\vcreate_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"createTime\x12P\n" +
@@ -4092,7 +4878,9 @@ const file_temporal_server_api_deployment_v1_message_proto_rawDesc = "" +
 	"\x0fsync_batch_size\x18\x06 \x01(\x05R\rsyncBatchSize\x12)\n" +
 	"\x10manager_identity\x18\a \x01(\tR\x0fmanagerIdentity\x12\x8c\x01\n" +
 	"\x15propagating_revisions\x18\b \x03(\v2W.temporal.server.api.deployment.v1.WorkerDeploymentLocalState.PropagatingRevisionsEntryR\x14propagatingRevisions\x12*\n" +
-	"\x11create_request_id\x18\t \x01(\tR\x0fcreateRequestId\x1a~\n" +
+	"\x11create_request_id\x18\t \x01(\tR\x0fcreateRequestId\x12T\n" +
+	"\arollout\x18\n" +
+	" \x01(\v2:.temporal.server.api.deployment.v1.WorkerDeploymentRolloutR\arollout\x1a~\n" +
 	"\rVersionsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12W\n" +
 	"\x05value\x18\x02 \x01(\v2A.temporal.server.api.deployment.v1.WorkerDeploymentVersionSummaryR\x05value:\x028\x01\x1a\x80\x01\n" +
@@ -4269,7 +5057,69 @@ const file_temporal_server_api_deployment_v1_message_proto_rawDesc = "" +
 	"percentage\x12;\n" +
 	"\x1aignore_missing_task_queues\x18\x04 \x01(\bR\x17ignoreMissingTaskQueues\x12%\n" +
 	"\x0econflict_token\x18\x05 \x01(\fR\rconflictToken\x12(\n" +
-	"\x10allow_no_pollers\x18\x06 \x01(\bR\x0eallowNoPollers\"\x86\x01\n" +
+	"\x10allow_no_pollers\x18\x06 \x01(\bR\x0eallowNoPollers\"\x97\x03\n" +
+	"\x1bWorkerDeploymentRolloutPlan\x12T\n" +
+	"\x05steps\x18\x01 \x03(\v2>.temporal.server.api.deployment.v1.WorkerDeploymentRolloutStepR\x05steps\x12h\n" +
+	"\fhealth_gates\x18\x02 \x01(\v2E.temporal.server.api.deployment.v1.WorkerDeploymentRolloutHealthGatesR\vhealthGates\x12M\n" +
+	"\x15health_check_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x13healthCheckInterval\x12i\n" +
+	"\x0efailure_action\x18\x04 \x01(\x0e2B.temporal.server.api.enums.v1.WorkerDeploymentRolloutFailureActionR\rfailureAction\"\x80\x01\n" +
+	"\x1bWorkerDeploymentRolloutStep\x12'\n" +
+	"\x0framp_percentage\x18\x01 \x01(\x02R\x0erampPercentage\x128\n" +
+	"\n" +
+	"dwell_time\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\tdwellTime\"\x8f\x02\n" +
+	"\"WorkerDeploymentRolloutHealthGates\x12B\n" +
+	"\x1emax_workflow_task_failure_rate\x18\x01 \x01(\x01R\x1amaxWorkflowTaskFailureRate\x129\n" +
+	"\x19max_activity_failure_rate\x18\x02 \x01(\x01R\x16maxActivityFailureRate\x12:\n" +
+	"\x19max_nondeterminism_errors\x18\x03 \x01(\x03R\x17maxNondeterminismErrors\x12.\n" +
+	"\x13min_processed_tasks\x18\x04 \x01(\x03R\x11minProcessedTasks\"\xd3\x02\n" +
+	"\x1dWorkerDeploymentVersionHealth\x12!\n" +
+	"\fworker_count\x18\x01 \x01(\x05R\vworkerCount\x128\n" +
+	"\x18workflow_tasks_processed\x18\x02 \x01(\x03R\x16workflowTasksProcessed\x122\n" +
+	"\x15workflow_tasks_failed\x18\x03 \x01(\x03R\x13workflowTasksFailed\x128\n" +
+	"\x18activity_tasks_processed\x18\x04 \x01(\x03R\x16activityTasksProcessed\x122\n" +
+	"\x15activity_tasks_failed\x18\x05 \x01(\x03R\x13activityTasksFailed\x123\n" +
+	"\x15nondeterminism_errors\x18\x06 \x01(\x03R\x14nondeterminismErrors\"\xe2\x05\n" +
+	"\x17WorkerDeploymentRollout\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12R\n" +
+	"\x04plan\x18\x02 \x01(\v2>.temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlanR\x04plan\x12S\n" +
+	"\x06status\x18\x03 \x01(\x0e2;.temporal.server.api.enums.v1.WorkerDeploymentRolloutStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"step_index\x18\x04 \x01(\x05R\tstepIndex\x12B\n" +
+	"\x0fstep_start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rstepStartTime\x129\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x1a\n" +
+	"\bidentity\x18\a \x01(\tR\bidentity\x12a\n" +
+	"\vlast_health\x18\b \x01(\v2@.temporal.server.api.deployment.v1.WorkerDeploymentVersionHealthR\n" +
+	"lastHealth\x12Y\n" +
+	"\ahistory\x18\t \x03(\v2?.temporal.server.api.deployment.v1.WorkerDeploymentRolloutEventR\ahistory\x12O\n" +
+	"\x16last_health_check_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x13lastHealthCheckTime\x12;\n" +
+	"\x1aignore_missing_task_queues\x18\v \x01(\bR\x17ignoreMissingTaskQueues\"\xaa\x02\n" +
+	"\x1cWorkerDeploymentRolloutEvent\x129\n" +
+	"\n" +
+	"event_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\teventTime\x12S\n" +
+	"\x06status\x18\x02 \x01(\x0e2;.temporal.server.api.enums.v1.WorkerDeploymentRolloutStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"step_index\x18\x03 \x01(\x05R\tstepIndex\x12'\n" +
+	"\x0framp_percentage\x18\x04 \x01(\x02R\x0erampPercentage\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bidentity\x18\x06 \x01(\tR\bidentity\"\xd9\x01\n" +
+	"\x10StartRolloutArgs\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12R\n" +
+	"\x04plan\x18\x03 \x01(\v2>.temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlanR\x04plan\x12;\n" +
+	"\x1aignore_missing_task_queues\x18\x04 \x01(\bR\x17ignoreMissingTaskQueues\"\x84\x01\n" +
+	"\x11UpdateRolloutArgs\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12S\n" +
+	"\x06action\x18\x02 \x01(\x0e2;.temporal.server.api.enums.v1.WorkerDeploymentRolloutActionR\x06action\"g\n" +
+	"\x0fRolloutResponse\x12T\n" +
+	"\arollout\x18\x01 \x01(\v2:.temporal.server.api.deployment.v1.WorkerDeploymentRolloutR\arollout\"\x94\x01\n" +
+	"\x1cGetVersionHealthActivityArgs\x12'\n" +
+	"\x0fdeployment_name\x18\x01 \x01(\tR\x0edeploymentName\x12\x19\n" +
+	"\bbuild_id\x18\x02 \x01(\tR\abuildId\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"z\n" +
+	"\x1eGetVersionHealthActivityResult\x12X\n" +
+	"\x06health\x18\x01 \x01(\v2@.temporal.server.api.deployment.v1.WorkerDeploymentVersionHealthR\x06health\"\x86\x01\n" +
 	"\x16SetManagerIdentityArgs\x12\x1a\n" +
 	"\bidentity\x18\x01 \x01(\tR\bidentity\x12)\n" +
 	"\x10manager_identity\x18\x02 \x01(\tR\x0fmanagerIdentity\x12%\n" +
@@ -4351,7 +5201,7 @@ func file_temporal_server_api_deployment_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_deployment_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_deployment_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
+var file_temporal_server_api_deployment_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
 var file_temporal_server_api_deployment_v1_message_proto_goTypes = []any{
 	(*WorkerDeploymentVersion)(nil),                           // 0: temporal.server.api.deployment.v1.WorkerDeploymentVersion
 	(*DeploymentVersionData)(nil),                             // 1: temporal.server.api.deployment.v1.DeploymentVersionData
@@ -4397,158 +5247,193 @@ var file_temporal_server_api_deployment_v1_message_proto_goTypes = []any{
 	(*DeleteDeploymentArgs)(nil),                              // 41: temporal.server.api.deployment.v1.DeleteDeploymentArgs
 	(*SetRampingVersionResponse)(nil),                         // 42: temporal.server.api.deployment.v1.SetRampingVersionResponse
 	(*SetRampingVersionArgs)(nil),                             // 43: temporal.server.api.deployment.v1.SetRampingVersionArgs
-	(*SetManagerIdentityArgs)(nil),                            // 44: temporal.server.api.deployment.v1.SetManagerIdentityArgs
-	(*SetManagerIdentityResponse)(nil),                        // 45: temporal.server.api.deployment.v1.SetManagerIdentityResponse
-	(*SyncVersionStateActivityArgs)(nil),                      // 46: temporal.server.api.deployment.v1.SyncVersionStateActivityArgs
-	(*SyncVersionStateActivityResult)(nil),                    // 47: temporal.server.api.deployment.v1.SyncVersionStateActivityResult
-	(*IsVersionMissingTaskQueuesArgs)(nil),                    // 48: temporal.server.api.deployment.v1.IsVersionMissingTaskQueuesArgs
-	(*IsVersionMissingTaskQueuesResult)(nil),                  // 49: temporal.server.api.deployment.v1.IsVersionMissingTaskQueuesResult
-	(*WorkerDeploymentWorkflowMemo)(nil),                      // 50: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo
-	(*WorkerDeploymentSummary)(nil),                           // 51: temporal.server.api.deployment.v1.WorkerDeploymentSummary
-	(*ValidateWorkerControllerInstanceSpecInput)(nil),         // 52: temporal.server.api.deployment.v1.ValidateWorkerControllerInstanceSpecInput
-	(*UpdateWorkerControllerInstanceInput)(nil),               // 53: temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput
-	(*DeleteWorkerControllerInstanceInput)(nil),               // 54: temporal.server.api.deployment.v1.DeleteWorkerControllerInstanceInput
-	(*UpdateComputeConfigArgs)(nil),                           // 55: temporal.server.api.deployment.v1.UpdateComputeConfigArgs
-	(*UpdateComputeConfigResponse)(nil),                       // 56: temporal.server.api.deployment.v1.UpdateComputeConfigResponse
-	(*ForceCANDeploymentSignalArgs)(nil),                      // 57: temporal.server.api.deployment.v1.ForceCANDeploymentSignalArgs
-	(*ForceCANVersionSignalArgs)(nil),                         // 58: temporal.server.api.deployment.v1.ForceCANVersionSignalArgs
-	nil,                                                       // 59: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamiliesEntry
-	(*VersionLocalState_TaskQueueFamilyData)(nil),             // 60: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData
-	nil, // 61: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData.TaskQueuesEntry
-	nil, // 62: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.VersionsEntry
-	nil, // 63: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.PropagatingRevisionsEntry
-	(*SyncDeploymentVersionUserDataRequest_SyncUserData)(nil), // 64: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.SyncUserData
-	nil, // 65: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataResponse.TaskQueueMaxVersionsEntry
-	nil, // 66: temporal.server.api.deployment.v1.CheckWorkerDeploymentUserDataPropagationRequest.TaskQueueMaxVersionsEntry
-	nil, // 67: temporal.server.api.deployment.v1.SyncUnversionedRampActivityResponse.TaskQueueMaxVersionsEntry
-	nil, // 68: temporal.server.api.deployment.v1.UpdateVersionMetadataArgs.UpsertEntriesEntry
-	nil, // 69: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueuesAndTypesEntry
-	(*CheckTaskQueuesHavePollersActivityArgs_TaskQueueTypes)(nil), // 70: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueueTypes
-	nil,                                   // 71: temporal.server.api.deployment.v1.ValidateWorkerControllerInstanceSpecInput.ScalingGroupsEntry
-	nil,                                   // 72: temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput.UpsertScalingGroupsEntry
-	nil,                                   // 73: temporal.server.api.deployment.v1.UpdateComputeConfigArgs.UpsertScalingGroupsEntry
-	(*timestamppb.Timestamp)(nil),         // 74: google.protobuf.Timestamp
-	(v1.WorkerDeploymentVersionStatus)(0), // 75: temporal.api.enums.v1.WorkerDeploymentVersionStatus
-	(*v11.VersionDrainageInfo)(nil),       // 76: temporal.api.deployment.v1.VersionDrainageInfo
-	(*v11.VersionMetadata)(nil),           // 77: temporal.api.deployment.v1.VersionMetadata
-	(*v12.ComputeConfigSummary)(nil),      // 78: temporal.api.compute.v1.ComputeConfigSummary
-	(*v11.RoutingConfig)(nil),             // 79: temporal.api.deployment.v1.RoutingConfig
-	(v1.VersionDrainageStatus)(0),         // 80: temporal.api.enums.v1.VersionDrainageStatus
-	(v1.TaskQueueType)(0),                 // 81: temporal.api.enums.v1.TaskQueueType
-	(*v11.WorkerDeploymentVersionInfo_VersionTaskQueueInfo)(nil),    // 82: temporal.api.deployment.v1.WorkerDeploymentVersionInfo.VersionTaskQueueInfo
-	(*v12.ComputeConfig)(nil),                                       // 83: temporal.api.compute.v1.ComputeConfig
-	(*v11.WorkerDeploymentInfo_WorkerDeploymentVersionSummary)(nil), // 84: temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
-	(*v11.WorkerDeploymentVersion)(nil),                             // 85: temporal.api.deployment.v1.WorkerDeploymentVersion
-	(*v13.Payload)(nil),                                             // 86: temporal.api.common.v1.Payload
-	(*v12.ComputeConfigScalingGroup)(nil),                           // 87: temporal.api.compute.v1.ComputeConfigScalingGroup
-	(*v12.ComputeConfigScalingGroupUpdate)(nil),                     // 88: temporal.api.compute.v1.ComputeConfigScalingGroupUpdate
+	(*WorkerDeploymentRolloutPlan)(nil),                       // 44: temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlan
+	(*WorkerDeploymentRolloutStep)(nil),                       // 45: temporal.server.api.deployment.v1.WorkerDeploymentRolloutStep
+	(*WorkerDeploymentRolloutHealthGates)(nil),                // 46: temporal.server.api.deployment.v1.WorkerDeploymentRolloutHealthGates
+	(*WorkerDeploymentVersionHealth)(nil),                     // 47: temporal.server.api.deployment.v1.WorkerDeploymentVersionHealth
+	(*WorkerDeploymentRollout)(nil),                           // 48: temporal.server.api.deployment.v1.WorkerDeploymentRollout
+	(*WorkerDeploymentRolloutEvent)(nil),                      // 49: temporal.server.api.deployment.v1.WorkerDeploymentRolloutEvent
+	(*StartRolloutArgs)(nil),                                  // 50: temporal.server.api.deployment.v1.StartRolloutArgs
+	(*UpdateRolloutArgs)(nil),                                 // 51: temporal.server.api.deployment.v1.UpdateRolloutArgs
+	(*RolloutResponse)(nil),                                   // 52: temporal.server.api.deployment.v1.RolloutResponse
+	(*GetVersionHealthActivityArgs)(nil),                      // 53: temporal.server.api.deployment.v1.GetVersionHealthActivityArgs
+	(*GetVersionHealthActivityResult)(nil),                    // 54: temporal.server.api.deployment.v1.GetVersionHealthActivityResult
+	(*SetManagerIdentityArgs)(nil),                            // 55: temporal.server.api.deployment.v1.SetManagerIdentityArgs
+	(*SetManagerIdentityResponse)(nil),                        // 56: temporal.server.api.deployment.v1.SetManagerIdentityResponse
+	(*SyncVersionStateActivityArgs)(nil),                      // 57: temporal.server.api.deployment.v1.SyncVersionStateActivityArgs
+	(*SyncVersionStateActivityResult)(nil),                    // 58: temporal.server.api.deployment.v1.SyncVersionStateActivityResult
+	(*IsVersionMissingTaskQueuesArgs)(nil),                    // 59: temporal.server.api.deployment.v1.IsVersionMissingTaskQueuesArgs
+	(*IsVersionMissingTaskQueuesResult)(nil),                  // 60: temporal.server.api.deployment.v1.IsVersionMissingTaskQueuesResult
+	(*WorkerDeploymentWorkflowMemo)(nil),                      // 61: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo
+	(*WorkerDeploymentSummary)(nil),                           // 62: temporal.server.api.deployment.v1.WorkerDeploymentSummary
+	(*ValidateWorkerControllerInstanceSpecInput)(nil),         // 63: temporal.server.api.deployment.v1.ValidateWorkerControllerInstanceSpecInput
+	(*UpdateWorkerControllerInstanceInput)(nil),               // 64: temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput
+	(*DeleteWorkerControllerInstanceInput)(nil),               // 65: temporal.server.api.deployment.v1.DeleteWorkerControllerInstanceInput
+	(*UpdateComputeConfigArgs)(nil),                           // 66: temporal.server.api.deployment.v1.UpdateComputeConfigArgs
+	(*UpdateComputeConfigResponse)(nil),                       // 67: temporal.server.api.deployment.v1.UpdateComputeConfigResponse
+	(*ForceCANDeploymentSignalArgs)(nil),                      // 68: temporal.server.api.deployment.v1.ForceCANDeploymentSignalArgs
+	(*ForceCANVersionSignalArgs)(nil),                         // 69: temporal.server.api.deployment.v1.ForceCANVersionSignalArgs
+	nil,                                                       // 70: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamiliesEntry
+	(*VersionLocalState_TaskQueueFamilyData)(nil),             // 71: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData
+	nil, // 72: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData.TaskQueuesEntry
+	nil, // 73: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.VersionsEntry
+	nil, // 74: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.PropagatingRevisionsEntry
+	(*SyncDeploymentVersionUserDataRequest_SyncUserData)(nil), // 75: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.SyncUserData
+	nil, // 76: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataResponse.TaskQueueMaxVersionsEntry
+	nil, // 77: temporal.server.api.deployment.v1.CheckWorkerDeploymentUserDataPropagationRequest.TaskQueueMaxVersionsEntry
+	nil, // 78: temporal.server.api.deployment.v1.SyncUnversionedRampActivityResponse.TaskQueueMaxVersionsEntry
+	nil, // 79: temporal.server.api.deployment.v1.UpdateVersionMetadataArgs.UpsertEntriesEntry
+	nil, // 80: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueuesAndTypesEntry
+	(*CheckTaskQueuesHavePollersActivityArgs_TaskQueueTypes)(nil), // 81: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueueTypes
+	nil,                                   // 82: temporal.server.api.deployment.v1.ValidateWorkerControllerInstanceSpecInput.ScalingGroupsEntry
+	nil,                                   // 83: temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput.UpsertScalingGroupsEntry
+	nil,                                   // 84: temporal.server.api.deployment.v1.UpdateComputeConfigArgs.UpsertScalingGroupsEntry
+	(*timestamppb.Timestamp)(nil),         // 85: google.protobuf.Timestamp
+	(v1.WorkerDeploymentVersionStatus)(0), // 86: temporal.api.enums.v1.WorkerDeploymentVersionStatus
+	(*v11.VersionDrainageInfo)(nil),       // 87: temporal.api.deployment.v1.VersionDrainageInfo
+	(*v11.VersionMetadata)(nil),           // 88: temporal.api.deployment.v1.VersionMetadata
+	(*v12.ComputeConfigSummary)(nil),      // 89: temporal.api.compute.v1.ComputeConfigSummary
+	(*v11.RoutingConfig)(nil),             // 90: temporal.api.deployment.v1.RoutingConfig
+	(v1.VersionDrainageStatus)(0),         // 91: temporal.api.enums.v1.VersionDrainageStatus
+	(v1.TaskQueueType)(0),                 // 92: temporal.api.enums.v1.TaskQueueType
+	(*v11.WorkerDeploymentVersionInfo_VersionTaskQueueInfo)(nil),    // 93: temporal.api.deployment.v1.WorkerDeploymentVersionInfo.VersionTaskQueueInfo
+	(*v12.ComputeConfig)(nil),                                       // 94: temporal.api.compute.v1.ComputeConfig
+	(*durationpb.Duration)(nil),                                     // 95: google.protobuf.Duration
+	(v14.WorkerDeploymentRolloutFailureAction)(0),                   // 96: temporal.server.api.enums.v1.WorkerDeploymentRolloutFailureAction
+	(v14.WorkerDeploymentRolloutStatus)(0),                          // 97: temporal.server.api.enums.v1.WorkerDeploymentRolloutStatus
+	(v14.WorkerDeploymentRolloutAction)(0),                          // 98: temporal.server.api.enums.v1.WorkerDeploymentRolloutAction
+	(*v11.WorkerDeploymentInfo_WorkerDeploymentVersionSummary)(nil), // 99: temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
+	(*v11.WorkerDeploymentVersion)(nil),                             // 100: temporal.api.deployment.v1.WorkerDeploymentVersion
+	(*v13.Payload)(nil),                                             // 101: temporal.api.common.v1.Payload
+	(*v12.ComputeConfigScalingGroup)(nil),                           // 102: temporal.api.compute.v1.ComputeConfigScalingGroup
+	(*v12.ComputeConfigScalingGroupUpdate)(nil),                     // 103: temporal.api.compute.v1.ComputeConfigScalingGroupUpdate
 }
 var file_temporal_server_api_deployment_v1_message_proto_depIdxs = []int32{
 	0,   // 0: temporal.server.api.deployment.v1.DeploymentVersionData.version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
-	74,  // 1: temporal.server.api.deployment.v1.DeploymentVersionData.routing_update_time:type_name -> google.protobuf.Timestamp
-	74,  // 2: temporal.server.api.deployment.v1.DeploymentVersionData.current_since_time:type_name -> google.protobuf.Timestamp
-	74,  // 3: temporal.server.api.deployment.v1.DeploymentVersionData.ramping_since_time:type_name -> google.protobuf.Timestamp
-	75,  // 4: temporal.server.api.deployment.v1.DeploymentVersionData.status:type_name -> temporal.api.enums.v1.WorkerDeploymentVersionStatus
-	74,  // 5: temporal.server.api.deployment.v1.WorkerDeploymentVersionData.update_time:type_name -> google.protobuf.Timestamp
-	75,  // 6: temporal.server.api.deployment.v1.WorkerDeploymentVersionData.status:type_name -> temporal.api.enums.v1.WorkerDeploymentVersionStatus
+	85,  // 1: temporal.server.api.deployment.v1.DeploymentVersionData.routing_update_time:type_name -> google.protobuf.Timestamp
+	85,  // 2: temporal.server.api.deployment.v1.DeploymentVersionData.current_since_time:type_name -> google.protobuf.Timestamp
+	85,  // 3: temporal.server.api.deployment.v1.DeploymentVersionData.ramping_since_time:type_name -> google.protobuf.Timestamp
+	86,  // 4: temporal.server.api.deployment.v1.DeploymentVersionData.status:type_name -> temporal.api.enums.v1.WorkerDeploymentVersionStatus
+	85,  // 5: temporal.server.api.deployment.v1.WorkerDeploymentVersionData.update_time:type_name -> google.protobuf.Timestamp
+	86,  // 6: temporal.server.api.deployment.v1.WorkerDeploymentVersionData.status:type_name -> temporal.api.enums.v1.WorkerDeploymentVersionStatus
 	0,   // 7: temporal.server.api.deployment.v1.VersionLocalState.version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
-	74,  // 8: temporal.server.api.deployment.v1.VersionLocalState.create_time:type_name -> google.protobuf.Timestamp
-	74,  // 9: temporal.server.api.deployment.v1.VersionLocalState.routing_update_time:type_name -> google.protobuf.Timestamp
-	74,  // 10: temporal.server.api.deployment.v1.VersionLocalState.current_since_time:type_name -> google.protobuf.Timestamp
-	74,  // 11: temporal.server.api.deployment.v1.VersionLocalState.ramping_since_time:type_name -> google.protobuf.Timestamp
-	74,  // 12: temporal.server.api.deployment.v1.VersionLocalState.first_activation_time:type_name -> google.protobuf.Timestamp
-	74,  // 13: temporal.server.api.deployment.v1.VersionLocalState.last_current_time:type_name -> google.protobuf.Timestamp
-	74,  // 14: temporal.server.api.deployment.v1.VersionLocalState.last_deactivation_time:type_name -> google.protobuf.Timestamp
-	76,  // 15: temporal.server.api.deployment.v1.VersionLocalState.drainage_info:type_name -> temporal.api.deployment.v1.VersionDrainageInfo
-	77,  // 16: temporal.server.api.deployment.v1.VersionLocalState.metadata:type_name -> temporal.api.deployment.v1.VersionMetadata
-	59,  // 17: temporal.server.api.deployment.v1.VersionLocalState.task_queue_families:type_name -> temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamiliesEntry
-	75,  // 18: temporal.server.api.deployment.v1.VersionLocalState.status:type_name -> temporal.api.enums.v1.WorkerDeploymentVersionStatus
-	78,  // 19: temporal.server.api.deployment.v1.VersionLocalState.compute_config:type_name -> temporal.api.compute.v1.ComputeConfigSummary
+	85,  // 8: temporal.server.api.deployment.v1.VersionLocalState.create_time:type_name -> google.protobuf.Timestamp
+	85,  // 9: temporal.server.api.deployment.v1.VersionLocalState.routing_update_time:type_name -> google.protobuf.Timestamp
+	85,  // 10: temporal.server.api.deployment.v1.VersionLocalState.current_since_time:type_name -> google.protobuf.Timestamp
+	85,  // 11: temporal.server.api.deployment.v1.VersionLocalState.ramping_since_time:type_name -> google.protobuf.Timestamp
+	85,  // 12: temporal.server.api.deployment.v1.VersionLocalState.first_activation_time:type_name -> google.protobuf.Timestamp
+	85,  // 13: temporal.server.api.deployment.v1.VersionLocalState.last_current_time:type_name -> google.protobuf.Timestamp
+	85,  // 14: temporal.server.api.deployment.v1.VersionLocalState.last_deactivation_time:type_name -> google.protobuf.Timestamp
+	87,  // 15: temporal.server.api.deployment.v1.VersionLocalState.drainage_info:type_name -> temporal.api.deployment.v1.VersionDrainageInfo
+	88,  // 16: temporal.server.api.deployment.v1.VersionLocalState.metadata:type_name -> temporal.api.deployment.v1.VersionMetadata
+	70,  // 17: temporal.server.api.deployment.v1.VersionLocalState.task_queue_families:type_name -> temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamiliesEntry
+	86,  // 18: temporal.server.api.deployment.v1.VersionLocalState.status:type_name -> temporal.api.enums.v1.WorkerDeploymentVersionStatus
+	89,  // 19: temporal.server.api.deployment.v1.VersionLocalState.compute_config:type_name -> temporal.api.compute.v1.ComputeConfigSummary
 	3,   // 20: temporal.server.api.deployment.v1.WorkerDeploymentVersionWorkflowArgs.version_state:type_name -> temporal.server.api.deployment.v1.VersionLocalState
 	7,   // 21: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowArgs.state:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentLocalState
-	74,  // 22: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.create_time:type_name -> google.protobuf.Timestamp
-	79,  // 23: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
-	62,  // 24: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.versions:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentLocalState.VersionsEntry
-	63,  // 25: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.propagating_revisions:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentLocalState.PropagatingRevisionsEntry
-	74,  // 26: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.create_time:type_name -> google.protobuf.Timestamp
-	80,  // 27: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.drainage_status:type_name -> temporal.api.enums.v1.VersionDrainageStatus
-	76,  // 28: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.drainage_info:type_name -> temporal.api.deployment.v1.VersionDrainageInfo
-	74,  // 29: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.routing_update_time:type_name -> google.protobuf.Timestamp
-	74,  // 30: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.current_since_time:type_name -> google.protobuf.Timestamp
-	74,  // 31: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.ramping_since_time:type_name -> google.protobuf.Timestamp
-	74,  // 32: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.first_activation_time:type_name -> google.protobuf.Timestamp
-	74,  // 33: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.last_current_time:type_name -> google.protobuf.Timestamp
-	74,  // 34: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.last_deactivation_time:type_name -> google.protobuf.Timestamp
-	75,  // 35: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.status:type_name -> temporal.api.enums.v1.WorkerDeploymentVersionStatus
-	78,  // 36: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.compute_config:type_name -> temporal.api.compute.v1.ComputeConfigSummary
-	81,  // 37: temporal.server.api.deployment.v1.RegisterWorkerInVersionArgs.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	79,  // 38: temporal.server.api.deployment.v1.RegisterWorkerInVersionArgs.routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
-	81,  // 39: temporal.server.api.deployment.v1.RegisterWorkerInWorkerDeploymentArgs.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	0,   // 40: temporal.server.api.deployment.v1.RegisterWorkerInWorkerDeploymentArgs.version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
-	82,  // 41: temporal.server.api.deployment.v1.DescribeVersionFromWorkerDeploymentActivityResult.task_queue_infos:type_name -> temporal.api.deployment.v1.WorkerDeploymentVersionInfo.VersionTaskQueueInfo
-	74,  // 42: temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs.routing_update_time:type_name -> google.protobuf.Timestamp
-	74,  // 43: temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs.current_since_time:type_name -> google.protobuf.Timestamp
-	74,  // 44: temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs.ramping_since_time:type_name -> google.protobuf.Timestamp
-	79,  // 45: temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs.routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
-	3,   // 46: temporal.server.api.deployment.v1.SyncVersionStateResponse.version_state:type_name -> temporal.server.api.deployment.v1.VersionLocalState
-	9,   // 47: temporal.server.api.deployment.v1.SyncVersionStateResponse.summary:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary
-	74,  // 48: temporal.server.api.deployment.v1.AddVersionUpdateArgs.create_time:type_name -> google.protobuf.Timestamp
-	76,  // 49: temporal.server.api.deployment.v1.SyncDrainageInfoSignalArgs.drainage_info:type_name -> temporal.api.deployment.v1.VersionDrainageInfo
-	80,  // 50: temporal.server.api.deployment.v1.SyncDrainageStatusSignalArgs.drainage_status:type_name -> temporal.api.enums.v1.VersionDrainageStatus
-	3,   // 51: temporal.server.api.deployment.v1.QueryDescribeVersionResponse.version_state:type_name -> temporal.server.api.deployment.v1.VersionLocalState
-	7,   // 52: temporal.server.api.deployment.v1.QueryDescribeWorkerDeploymentResponse.state:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentLocalState
-	78,  // 53: temporal.server.api.deployment.v1.StartWorkerDeploymentVersionRequest.compute_config:type_name -> temporal.api.compute.v1.ComputeConfigSummary
-	0,   // 54: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
-	64,  // 55: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.sync:type_name -> temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.SyncUserData
-	79,  // 56: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.update_routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
-	2,   // 57: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.upsert_version_data:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersionData
-	65,  // 58: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataResponse.task_queue_max_versions:type_name -> temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataResponse.TaskQueueMaxVersionsEntry
-	66,  // 59: temporal.server.api.deployment.v1.CheckWorkerDeploymentUserDataPropagationRequest.task_queue_max_versions:type_name -> temporal.server.api.deployment.v1.CheckWorkerDeploymentUserDataPropagationRequest.TaskQueueMaxVersionsEntry
-	14,  // 60: temporal.server.api.deployment.v1.SyncUnversionedRampActivityArgs.update_args:type_name -> temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs
-	67,  // 61: temporal.server.api.deployment.v1.SyncUnversionedRampActivityResponse.task_queue_max_versions:type_name -> temporal.server.api.deployment.v1.SyncUnversionedRampActivityResponse.TaskQueueMaxVersionsEntry
-	68,  // 62: temporal.server.api.deployment.v1.UpdateVersionMetadataArgs.upsert_entries:type_name -> temporal.server.api.deployment.v1.UpdateVersionMetadataArgs.UpsertEntriesEntry
-	77,  // 63: temporal.server.api.deployment.v1.UpdateVersionMetadataResponse.metadata:type_name -> temporal.api.deployment.v1.VersionMetadata
-	83,  // 64: temporal.server.api.deployment.v1.CreateWorkerDeploymentVersionArgs.compute_config:type_name -> temporal.api.compute.v1.ComputeConfig
-	69,  // 65: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.task_queues_and_types:type_name -> temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueuesAndTypesEntry
-	0,   // 66: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.worker_deployment_version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
-	14,  // 67: temporal.server.api.deployment.v1.SyncVersionStateActivityArgs.update_args:type_name -> temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs
-	3,   // 68: temporal.server.api.deployment.v1.SyncVersionStateActivityResult.version_state:type_name -> temporal.server.api.deployment.v1.VersionLocalState
-	9,   // 69: temporal.server.api.deployment.v1.SyncVersionStateActivityResult.summary:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary
-	74,  // 70: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo.create_time:type_name -> google.protobuf.Timestamp
-	79,  // 71: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo.routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
-	84,  // 72: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo.latest_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
-	84,  // 73: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo.current_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
-	84,  // 74: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo.ramping_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
-	74,  // 75: temporal.server.api.deployment.v1.WorkerDeploymentSummary.create_time:type_name -> google.protobuf.Timestamp
-	79,  // 76: temporal.server.api.deployment.v1.WorkerDeploymentSummary.routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
-	84,  // 77: temporal.server.api.deployment.v1.WorkerDeploymentSummary.latest_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
-	84,  // 78: temporal.server.api.deployment.v1.WorkerDeploymentSummary.current_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
-	84,  // 79: temporal.server.api.deployment.v1.WorkerDeploymentSummary.ramping_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
-	71,  // 80: temporal.server.api.deployment.v1.ValidateWorkerControllerInstanceSpecInput.scaling_groups:type_name -> temporal.server.api.deployment.v1.ValidateWorkerControllerInstanceSpecInput.ScalingGroupsEntry
-	85,  // 81: temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput.version:type_name -> temporal.api.deployment.v1.WorkerDeploymentVersion
-	72,  // 82: temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput.upsert_scaling_groups:type_name -> temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput.UpsertScalingGroupsEntry
-	85,  // 83: temporal.server.api.deployment.v1.DeleteWorkerControllerInstanceInput.version:type_name -> temporal.api.deployment.v1.WorkerDeploymentVersion
-	73,  // 84: temporal.server.api.deployment.v1.UpdateComputeConfigArgs.upsert_scaling_groups:type_name -> temporal.server.api.deployment.v1.UpdateComputeConfigArgs.UpsertScalingGroupsEntry
-	7,   // 85: temporal.server.api.deployment.v1.ForceCANDeploymentSignalArgs.override_state:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentLocalState
-	3,   // 86: temporal.server.api.deployment.v1.ForceCANVersionSignalArgs.override_state:type_name -> temporal.server.api.deployment.v1.VersionLocalState
-	60,  // 87: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamiliesEntry.value:type_name -> temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData
-	61,  // 88: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData.task_queues:type_name -> temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData.TaskQueuesEntry
-	4,   // 89: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData.TaskQueuesEntry.value:type_name -> temporal.server.api.deployment.v1.TaskQueueVersionData
-	9,   // 90: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.VersionsEntry.value:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary
-	8,   // 91: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.PropagatingRevisionsEntry.value:type_name -> temporal.server.api.deployment.v1.PropagatingRevisions
-	81,  // 92: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.SyncUserData.types:type_name -> temporal.api.enums.v1.TaskQueueType
-	1,   // 93: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.SyncUserData.data:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
-	86,  // 94: temporal.server.api.deployment.v1.UpdateVersionMetadataArgs.UpsertEntriesEntry.value:type_name -> temporal.api.common.v1.Payload
-	70,  // 95: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueuesAndTypesEntry.value:type_name -> temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueueTypes
-	81,  // 96: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueueTypes.types:type_name -> temporal.api.enums.v1.TaskQueueType
-	87,  // 97: temporal.server.api.deployment.v1.ValidateWorkerControllerInstanceSpecInput.ScalingGroupsEntry.value:type_name -> temporal.api.compute.v1.ComputeConfigScalingGroup
-	88,  // 98: temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput.UpsertScalingGroupsEntry.value:type_name -> temporal.api.compute.v1.ComputeConfigScalingGroupUpdate
-	88,  // 99: temporal.server.api.deployment.v1.UpdateComputeConfigArgs.UpsertScalingGroupsEntry.value:type_name -> temporal.api.compute.v1.ComputeConfigScalingGroupUpdate
-	100, // [100:100] is the sub-list for method output_type
-	100, // [100:100] is the sub-list for method input_type
-	100, // [100:100] is the sub-list for extension type_name
-	100, // [100:100] is the sub-list for extension extendee
-	0,   // [0:100] is the sub-list for field type_name
+	85,  // 22: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.create_time:type_name -> google.protobuf.Timestamp
+	90,  // 23: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
+	73,  // 24: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.versions:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentLocalState.VersionsEntry
+	74,  // 25: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.propagating_revisions:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentLocalState.PropagatingRevisionsEntry
+	48,  // 26: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.rollout:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentRollout
+	85,  // 27: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.create_time:type_name -> google.protobuf.Timestamp
+	91,  // 28: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.drainage_status:type_name -> temporal.api.enums.v1.VersionDrainageStatus
+	87,  // 29: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.drainage_info:type_name -> temporal.api.deployment.v1.VersionDrainageInfo
+	85,  // 30: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.routing_update_time:type_name -> google.protobuf.Timestamp
+	85,  // 31: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.current_since_time:type_name -> google.protobuf.Timestamp
+	85,  // 32: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.ramping_since_time:type_name -> google.protobuf.Timestamp
+	85,  // 33: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.first_activation_time:type_name -> google.protobuf.Timestamp
+	85,  // 34: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.last_current_time:type_name -> google.protobuf.Timestamp
+	85,  // 35: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.last_deactivation_time:type_name -> google.protobuf.Timestamp
+	86,  // 36: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.status:type_name -> temporal.api.enums.v1.WorkerDeploymentVersionStatus
+	89,  // 37: temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary.compute_config:type_name -> temporal.api.compute.v1.ComputeConfigSummary
+	92,  // 38: temporal.server.api.deployment.v1.RegisterWorkerInVersionArgs.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	90,  // 39: temporal.server.api.deployment.v1.RegisterWorkerInVersionArgs.routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
+	92,  // 40: temporal.server.api.deployment.v1.RegisterWorkerInWorkerDeploymentArgs.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	0,   // 41: temporal.server.api.deployment.v1.RegisterWorkerInWorkerDeploymentArgs.version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
+	93,  // 42: temporal.server.api.deployment.v1.DescribeVersionFromWorkerDeploymentActivityResult.task_queue_infos:type_name -> temporal.api.deployment.v1.WorkerDeploymentVersionInfo.VersionTaskQueueInfo
+	85,  // 43: temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs.routing_update_time:type_name -> google.protobuf.Timestamp
+	85,  // 44: temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs.current_since_time:type_name -> google.protobuf.Timestamp
+	85,  // 45: temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs.ramping_since_time:type_name -> google.protobuf.Timestamp
+	90,  // 46: temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs.routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
+	3,   // 47: temporal.server.api.deployment.v1.SyncVersionStateResponse.version_state:type_name -> temporal.server.api.deployment.v1.VersionLocalState
+	9,   // 48: temporal.server.api.deployment.v1.SyncVersionStateResponse.summary:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary
+	85,  // 49: temporal.server.api.deployment.v1.AddVersionUpdateArgs.create_time:type_name -> google.protobuf.Timestamp
+	87,  // 50: temporal.server.api.deployment.v1.SyncDrainageInfoSignalArgs.drainage_info:type_name -> temporal.api.deployment.v1.VersionDrainageInfo
+	91,  // 51: temporal.server.api.deployment.v1.SyncDrainageStatusSignalArgs.drainage_status:type_name -> temporal.api.enums.v1.VersionDrainageStatus
+	3,   // 52: temporal.server.api.deployment.v1.QueryDescribeVersionResponse.version_state:type_name -> temporal.server.api.deployment.v1.VersionLocalState
+	7,   // 53: temporal.server.api.deployment.v1.QueryDescribeWorkerDeploymentResponse.state:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentLocalState
+	89,  // 54: temporal.server.api.deployment.v1.StartWorkerDeploymentVersionRequest.compute_config:type_name -> temporal.api.compute.v1.ComputeConfigSummary
+	0,   // 55: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
+	75,  // 56: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.sync:type_name -> temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.SyncUserData
+	90,  // 57: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.update_routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
+	2,   // 58: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.upsert_version_data:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersionData
+	76,  // 59: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataResponse.task_queue_max_versions:type_name -> temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataResponse.TaskQueueMaxVersionsEntry
+	77,  // 60: temporal.server.api.deployment.v1.CheckWorkerDeploymentUserDataPropagationRequest.task_queue_max_versions:type_name -> temporal.server.api.deployment.v1.CheckWorkerDeploymentUserDataPropagationRequest.TaskQueueMaxVersionsEntry
+	14,  // 61: temporal.server.api.deployment.v1.SyncUnversionedRampActivityArgs.update_args:type_name -> temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs
+	78,  // 62: temporal.server.api.deployment.v1.SyncUnversionedRampActivityResponse.task_queue_max_versions:type_name -> temporal.server.api.deployment.v1.SyncUnversionedRampActivityResponse.TaskQueueMaxVersionsEntry
+	79,  // 63: temporal.server.api.deployment.v1.UpdateVersionMetadataArgs.upsert_entries:type_name -> temporal.server.api.deployment.v1.UpdateVersionMetadataArgs.UpsertEntriesEntry
+	88,  // 64: temporal.server.api.deployment.v1.UpdateVersionMetadataResponse.metadata:type_name -> temporal.api.deployment.v1.VersionMetadata
+	94,  // 65: temporal.server.api.deployment.v1.CreateWorkerDeploymentVersionArgs.compute_config:type_name -> temporal.api.compute.v1.ComputeConfig
+	80,  // 66: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.task_queues_and_types:type_name -> temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueuesAndTypesEntry
+	0,   // 67: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.worker_deployment_version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
+	45,  // 68: temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlan.steps:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentRolloutStep
+	46,  // 69: temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlan.health_gates:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentRolloutHealthGates
+	95,  // 70: temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlan.health_check_interval:type_name -> google.protobuf.Duration
+	96,  // 71: temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlan.failure_action:type_name -> temporal.server.api.enums.v1.WorkerDeploymentRolloutFailureAction
+	95,  // 72: temporal.server.api.deployment.v1.WorkerDeploymentRolloutStep.dwell_time:type_name -> google.protobuf.Duration
+	44,  // 73: temporal.server.api.deployment.v1.WorkerDeploymentRollout.plan:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlan
+	97,  // 74: temporal.server.api.deployment.v1.WorkerDeploymentRollout.status:type_name -> temporal.server.api.enums.v1.WorkerDeploymentRolloutStatus
+	85,  // 75: temporal.server.api.deployment.v1.WorkerDeploymentRollout.step_start_time:type_name -> google.protobuf.Timestamp
+	85,  // 76: temporal.server.api.deployment.v1.WorkerDeploymentRollout.start_time:type_name -> google.protobuf.Timestamp
+	47,  // 77: temporal.server.api.deployment.v1.WorkerDeploymentRollout.last_health:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersionHealth
+	49,  // 78: temporal.server.api.deployment.v1.WorkerDeploymentRollout.history:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentRolloutEvent
+	85,  // 79: temporal.server.api.deployment.v1.WorkerDeploymentRollout.last_health_check_time:type_name -> google.protobuf.Timestamp
+	85,  // 80: temporal.server.api.deployment.v1.WorkerDeploymentRolloutEvent.event_time:type_name -> google.protobuf.Timestamp
+	97,  // 81: temporal.server.api.deployment.v1.WorkerDeploymentRolloutEvent.status:type_name -> temporal.server.api.enums.v1.WorkerDeploymentRolloutStatus
+	44,  // 82: temporal.server.api.deployment.v1.StartRolloutArgs.plan:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentRolloutPlan
+	98,  // 83: temporal.server.api.deployment.v1.UpdateRolloutArgs.action:type_name -> temporal.server.api.enums.v1.WorkerDeploymentRolloutAction
+	48,  // 84: temporal.server.api.deployment.v1.RolloutResponse.rollout:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentRollout
+	85,  // 85: temporal.server.api.deployment.v1.GetVersionHealthActivityArgs.since:type_name -> google.protobuf.Timestamp
+	47,  // 86: temporal.server.api.deployment.v1.GetVersionHealthActivityResult.health:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersionHealth
+	14,  // 87: temporal.server.api.deployment.v1.SyncVersionStateActivityArgs.update_args:type_name -> temporal.server.api.deployment.v1.SyncVersionStateUpdateArgs
+	3,   // 88: temporal.server.api.deployment.v1.SyncVersionStateActivityResult.version_state:type_name -> temporal.server.api.deployment.v1.VersionLocalState
+	9,   // 89: temporal.server.api.deployment.v1.SyncVersionStateActivityResult.summary:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary
+	85,  // 90: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo.create_time:type_name -> google.protobuf.Timestamp
+	90,  // 91: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo.routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
+	99,  // 92: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo.latest_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
+	99,  // 93: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo.current_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
+	99,  // 94: temporal.server.api.deployment.v1.WorkerDeploymentWorkflowMemo.ramping_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
+	85,  // 95: temporal.server.api.deployment.v1.WorkerDeploymentSummary.create_time:type_name -> google.protobuf.Timestamp
+	90,  // 96: temporal.server.api.deployment.v1.WorkerDeploymentSummary.routing_config:type_name -> temporal.api.deployment.v1.RoutingConfig
+	99,  // 97: temporal.server.api.deployment.v1.WorkerDeploymentSummary.latest_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
+	99,  // 98: temporal.server.api.deployment.v1.WorkerDeploymentSummary.current_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
+	99,  // 99: temporal.server.api.deployment.v1.WorkerDeploymentSummary.ramping_version_summary:type_name -> temporal.api.deployment.v1.WorkerDeploymentInfo.WorkerDeploymentVersionSummary
+	82,  // 100: temporal.server.api.deployment.v1.ValidateWorkerControllerInstanceSpecInput.scaling_groups:type_name -> temporal.server.api.deployment.v1.ValidateWorkerControllerInstanceSpecInput.ScalingGroupsEntry
+	100, // 101: temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput.version:type_name -> temporal.api.deployment.v1.WorkerDeploymentVersion
+	83,  // 102: temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput.upsert_scaling_groups:type_name -> temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput.UpsertScalingGroupsEntry
+	100, // 103: temporal.server.api.deployment.v1.DeleteWorkerControllerInstanceInput.version:type_name -> temporal.api.deployment.v1.WorkerDeploymentVersion
+	84,  // 104: temporal.server.api.deployment.v1.UpdateComputeConfigArgs.upsert_scaling_groups:type_name -> temporal.server.api.deployment.v1.UpdateComputeConfigArgs.UpsertScalingGroupsEntry
+	7,   // 105: temporal.server.api.deployment.v1.ForceCANDeploymentSignalArgs.override_state:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentLocalState
+	3,   // 106: temporal.server.api.deployment.v1.ForceCANVersionSignalArgs.override_state:type_name -> temporal.server.api.deployment.v1.VersionLocalState
+	71,  // 107: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamiliesEntry.value:type_name -> temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData
+	72,  // 108: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData.task_queues:type_name -> temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData.TaskQueuesEntry
+	4,   // 109: temporal.server.api.deployment.v1.VersionLocalState.TaskQueueFamilyData.TaskQueuesEntry.value:type_name -> temporal.server.api.deployment.v1.TaskQueueVersionData
+	9,   // 110: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.VersionsEntry.value:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersionSummary
+	8,   // 111: temporal.server.api.deployment.v1.WorkerDeploymentLocalState.PropagatingRevisionsEntry.value:type_name -> temporal.server.api.deployment.v1.PropagatingRevisions
+	92,  // 112: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.SyncUserData.types:type_name -> temporal.api.enums.v1.TaskQueueType
+	1,   // 113: temporal.server.api.deployment.v1.SyncDeploymentVersionUserDataRequest.SyncUserData.data:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
+	101, // 114: temporal.server.api.deployment.v1.UpdateVersionMetadataArgs.UpsertEntriesEntry.value:type_name -> temporal.api.common.v1.Payload
+	81,  // 115: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueuesAndTypesEntry.value:type_name -> temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueueTypes
+	92,  // 116: temporal.server.api.deployment.v1.CheckTaskQueuesHavePollersActivityArgs.TaskQueueTypes.types:type_name -> temporal.api.enums.v1.TaskQueueType
+	102, // 117: temporal.server.api.deployment.v1.ValidateWorkerControllerInstanceSpecInput.ScalingGroupsEntry.value:type_name -> temporal.api.compute.v1.ComputeConfigScalingGroup
+	103, // 118: temporal.server.api.deployment.v1.UpdateWorkerControllerInstanceInput.UpsertScalingGroupsEntry.value:type_name -> temporal.api.compute.v1.ComputeConfigScalingGroupUpdate
+	103, // 119: temporal.server.api.deployment.v1.UpdateComputeConfigArgs.UpsertScalingGroupsEntry.value:type_name -> temporal.api.compute.v1.ComputeConfigScalingGroupUpdate
+	120, // [120:120] is the sub-list for method output_type
+	120, // [120:120] is the sub-list for method input_type
+	120, // [120:120] is the sub-list for extension type_name
+	120, // [120:120] is the sub-list for extension extendee
+	0,   // [0:120] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_deployment_v1_message_proto_init() }
@@ -4562,7 +5447,7 @@ func file_temporal_server_api_deployment_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_deployment_v1_message_proto_rawDesc), len(file_temporal_server_api_deployment_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   74,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,68 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package enums
+
+import (
+	"fmt"
+)
+
+var (
+	WorkerDeploymentRolloutStatus_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Running":     1,
+		"Paused":      2,
+		"Completed":   3,
+		"RolledBack":  4,
+		"Cancelled":   5,
+	}
+)
+
+// WorkerDeploymentRolloutStatusFromString parses a WorkerDeploymentRolloutStatus value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to WorkerDeploymentRolloutStatus
+func WorkerDeploymentRolloutStatusFromString(s string) (WorkerDeploymentRolloutStatus, error) {
+	if v, ok := WorkerDeploymentRolloutStatus_value[s]; ok {
+		return WorkerDeploymentRolloutStatus(v), nil
+	} else if v, ok := WorkerDeploymentRolloutStatus_shorthandValue[s]; ok {
+		return WorkerDeploymentRolloutStatus(v), nil
+	}
+	return WorkerDeploymentRolloutStatus(0), fmt.Errorf("%s is not a valid WorkerDeploymentRolloutStatus", s)
+}
+
+var (
+	WorkerDeploymentRolloutFailureAction_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Rollback":    1,
+		"Pause":       2,
+	}
+)
+
+// WorkerDeploymentRolloutFailureActionFromString parses a WorkerDeploymentRolloutFailureAction value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to WorkerDeploymentRolloutFailureAction
+func WorkerDeploymentRolloutFailureActionFromString(s string) (WorkerDeploymentRolloutFailureAction, error) {
+	if v, ok := WorkerDeploymentRolloutFailureAction_value[s]; ok {
+		return WorkerDeploymentRolloutFailureAction(v), nil
+	} else if v, ok := WorkerDeploymentRolloutFailureAction_shorthandValue[s]; ok {
+		return WorkerDeploymentRolloutFailureAction(v), nil
+	}
+	return WorkerDeploymentRolloutFailureAction(0), fmt.Errorf("%s is not a valid WorkerDeploymentRolloutFailureAction", s)
+}
+
+var (
+	WorkerDeploymentRolloutAction_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Pause":       1,
+		"Resume":      2,
+		"Cancel":      3,
+		"Rollback":    4,
+	}
+)
+
+// WorkerDeploymentRolloutActionFromString parses a WorkerDeploymentRolloutAction value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to WorkerDeploymentRolloutAction
+func WorkerDeploymentRolloutActionFromString(s string) (WorkerDeploymentRolloutAction, error) {
+	if v, ok := WorkerDeploymentRolloutAction_value[s]; ok {
+		return WorkerDeploymentRolloutAction(v), nil
+	} else if v, ok := WorkerDeploymentRolloutAction_shorthandValue[s]; ok {
+		return WorkerDeploymentRolloutAction(v), nil
+	}
+	return WorkerDeploymentRolloutAction(0), fmt.Errorf("%s is not a valid WorkerDeploymentRolloutAction", s)
+}
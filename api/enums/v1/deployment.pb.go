@@ -0,0 +1,313 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/enums/v1/deployment.proto
+
+package enums
+
+import (
+	reflect "reflect"
+	"strconv"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Status of a managed rollout of a Worker Deployment Version.
+type WorkerDeploymentRolloutStatus int32
+
+const (
+	WORKER_DEPLOYMENT_ROLLOUT_STATUS_UNSPECIFIED WorkerDeploymentRolloutStatus = 0
+	// The rollout advances through its steps as long as the health gates pass.
+	WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING WorkerDeploymentRolloutStatus = 1
+	// The rollout holds the ramp of its current step until it is resumed.
+	WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED WorkerDeploymentRolloutStatus = 2
+	// The version became current after the last step.
+	WORKER_DEPLOYMENT_ROLLOUT_STATUS_COMPLETED WorkerDeploymentRolloutStatus = 3
+	// A health gate failed and the ramp of the version was removed.
+	WORKER_DEPLOYMENT_ROLLOUT_STATUS_ROLLED_BACK WorkerDeploymentRolloutStatus = 4
+	// The rollout was cancelled by a user, or the routing config was changed outside of the rollout.
+	WORKER_DEPLOYMENT_ROLLOUT_STATUS_CANCELLED WorkerDeploymentRolloutStatus = 5
+)
+
+// Enum value maps for WorkerDeploymentRolloutStatus.
+var (
+	WorkerDeploymentRolloutStatus_name = map[int32]string{
+		0: "WORKER_DEPLOYMENT_ROLLOUT_STATUS_UNSPECIFIED",
+		1: "WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING",
+		2: "WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED",
+		3: "WORKER_DEPLOYMENT_ROLLOUT_STATUS_COMPLETED",
+		4: "WORKER_DEPLOYMENT_ROLLOUT_STATUS_ROLLED_BACK",
+		5: "WORKER_DEPLOYMENT_ROLLOUT_STATUS_CANCELLED",
+	}
+	WorkerDeploymentRolloutStatus_value = map[string]int32{
+		"WORKER_DEPLOYMENT_ROLLOUT_STATUS_UNSPECIFIED": 0,
+		"WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING":     1,
+		"WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED":      2,
+		"WORKER_DEPLOYMENT_ROLLOUT_STATUS_COMPLETED":   3,
+		"WORKER_DEPLOYMENT_ROLLOUT_STATUS_ROLLED_BACK": 4,
+		"WORKER_DEPLOYMENT_ROLLOUT_STATUS_CANCELLED":   5,
+	}
+)
+
+func (x WorkerDeploymentRolloutStatus) Enum() *WorkerDeploymentRolloutStatus {
+	p := new(WorkerDeploymentRolloutStatus)
+	*p = x
+	return p
+}
+
+func (x WorkerDeploymentRolloutStatus) String() string {
+	switch x {
+	case WORKER_DEPLOYMENT_ROLLOUT_STATUS_UNSPECIFIED:
+		return "Unspecified"
+	case WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING:
+		return "Running"
+	case WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED:
+		return "Paused"
+	case WORKER_DEPLOYMENT_ROLLOUT_STATUS_COMPLETED:
+		return "Completed"
+	case WORKER_DEPLOYMENT_ROLLOUT_STATUS_ROLLED_BACK:
+		return "RolledBack"
+	case WORKER_DEPLOYMENT_ROLLOUT_STATUS_CANCELLED:
+		return "Cancelled"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (WorkerDeploymentRolloutStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_deployment_proto_enumTypes[0].Descriptor()
+}
+
+func (WorkerDeploymentRolloutStatus) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_deployment_proto_enumTypes[0]
+}
+
+func (x WorkerDeploymentRolloutStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkerDeploymentRolloutStatus.Descriptor instead.
+func (WorkerDeploymentRolloutStatus) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_deployment_proto_rawDescGZIP(), []int{0}
+}
+
+// What a managed rollout does when a health gate fails.
+type WorkerDeploymentRolloutFailureAction int32
+
+const (
+	// Same as ROLLBACK.
+	WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_UNSPECIFIED WorkerDeploymentRolloutFailureAction = 0
+	// Remove the ramp of the version.
+	WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_ROLLBACK WorkerDeploymentRolloutFailureAction = 1
+	// Hold the ramp of the current step until the rollout is resumed.
+	WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_PAUSE WorkerDeploymentRolloutFailureAction = 2
+)
+
+// Enum value maps for WorkerDeploymentRolloutFailureAction.
+var (
+	WorkerDeploymentRolloutFailureAction_name = map[int32]string{
+		0: "WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_UNSPECIFIED",
+		1: "WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_ROLLBACK",
+		2: "WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_PAUSE",
+	}
+	WorkerDeploymentRolloutFailureAction_value = map[string]int32{
+		"WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_UNSPECIFIED": 0,
+		"WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_ROLLBACK":    1,
+		"WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_PAUSE":       2,
+	}
+)
+
+func (x WorkerDeploymentRolloutFailureAction) Enum() *WorkerDeploymentRolloutFailureAction {
+	p := new(WorkerDeploymentRolloutFailureAction)
+	*p = x
+	return p
+}
+
+func (x WorkerDeploymentRolloutFailureAction) String() string {
+	switch x {
+	case WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_UNSPECIFIED:
+		return "Unspecified"
+	case WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_ROLLBACK:
+		return "Rollback"
+	case WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_PAUSE:
+		return "Pause"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (WorkerDeploymentRolloutFailureAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_deployment_proto_enumTypes[1].Descriptor()
+}
+
+func (WorkerDeploymentRolloutFailureAction) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_deployment_proto_enumTypes[1]
+}
+
+func (x WorkerDeploymentRolloutFailureAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkerDeploymentRolloutFailureAction.Descriptor instead.
+func (WorkerDeploymentRolloutFailureAction) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_deployment_proto_rawDescGZIP(), []int{1}
+}
+
+// User actions on a managed rollout.
+type WorkerDeploymentRolloutAction int32
+
+const (
+	WORKER_DEPLOYMENT_ROLLOUT_ACTION_UNSPECIFIED WorkerDeploymentRolloutAction = 0
+	WORKER_DEPLOYMENT_ROLLOUT_ACTION_PAUSE       WorkerDeploymentRolloutAction = 1
+	// Resumes a paused rollout from its current step. The dwell time of the step starts over.
+	WORKER_DEPLOYMENT_ROLLOUT_ACTION_RESUME WorkerDeploymentRolloutAction = 2
+	// Cancels the rollout, leaving the routing config as it is.
+	WORKER_DEPLOYMENT_ROLLOUT_ACTION_CANCEL WorkerDeploymentRolloutAction = 3
+	// Cancels the rollout and removes the ramp of the version.
+	WORKER_DEPLOYMENT_ROLLOUT_ACTION_ROLLBACK WorkerDeploymentRolloutAction = 4
+)
+
+// Enum value maps for WorkerDeploymentRolloutAction.
+var (
+	WorkerDeploymentRolloutAction_name = map[int32]string{
+		0: "WORKER_DEPLOYMENT_ROLLOUT_ACTION_UNSPECIFIED",
+		1: "WORKER_DEPLOYMENT_ROLLOUT_ACTION_PAUSE",
+		2: "WORKER_DEPLOYMENT_ROLLOUT_ACTION_RESUME",
+		3: "WORKER_DEPLOYMENT_ROLLOUT_ACTION_CANCEL",
+		4: "WORKER_DEPLOYMENT_ROLLOUT_ACTION_ROLLBACK",
+	}
+	WorkerDeploymentRolloutAction_value = map[string]int32{
+		"WORKER_DEPLOYMENT_ROLLOUT_ACTION_UNSPECIFIED": 0,
+		"WORKER_DEPLOYMENT_ROLLOUT_ACTION_PAUSE":       1,
+		"WORKER_DEPLOYMENT_ROLLOUT_ACTION_RESUME":      2,
+		"WORKER_DEPLOYMENT_ROLLOUT_ACTION_CANCEL":      3,
+		"WORKER_DEPLOYMENT_ROLLOUT_ACTION_ROLLBACK":    4,
+	}
+)
+
+func (x WorkerDeploymentRolloutAction) Enum() *WorkerDeploymentRolloutAction {
+	p := new(WorkerDeploymentRolloutAction)
+	*p = x
+	return p
+}
+
+func (x WorkerDeploymentRolloutAction) String() string {
+	switch x {
+	case WORKER_DEPLOYMENT_ROLLOUT_ACTION_UNSPECIFIED:
+		return "Unspecified"
+	case WORKER_DEPLOYMENT_ROLLOUT_ACTION_PAUSE:
+		return "Pause"
+	case WORKER_DEPLOYMENT_ROLLOUT_ACTION_RESUME:
+		return "Resume"
+	case WORKER_DEPLOYMENT_ROLLOUT_ACTION_CANCEL:
+		return "Cancel"
+	case WORKER_DEPLOYMENT_ROLLOUT_ACTION_ROLLBACK:
+		return "Rollback"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (WorkerDeploymentRolloutAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_deployment_proto_enumTypes[2].Descriptor()
+}
+
+func (WorkerDeploymentRolloutAction) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_deployment_proto_enumTypes[2]
+}
+
+func (x WorkerDeploymentRolloutAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkerDeploymentRolloutAction.Descriptor instead.
+func (WorkerDeploymentRolloutAction) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_deployment_proto_rawDescGZIP(), []int{2}
+}
+
+var File_temporal_server_api_enums_v1_deployment_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_enums_v1_deployment_proto_rawDesc = "" +
+	"\n" +
+	"-temporal/server/api/enums/v1/deployment.proto\x12\x1ctemporal.server.api.enums.v1*\xbe\x02\n" +
+	"\x1dWorkerDeploymentRolloutStatus\x120\n" +
+	",WORKER_DEPLOYMENT_ROLLOUT_STATUS_UNSPECIFIED\x10\x00\x12,\n" +
+	"(WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING\x10\x01\x12+\n" +
+	"'WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED\x10\x02\x12.\n" +
+	"*WORKER_DEPLOYMENT_ROLLOUT_STATUS_COMPLETED\x10\x03\x120\n" +
+	",WORKER_DEPLOYMENT_ROLLOUT_STATUS_ROLLED_BACK\x10\x04\x12.\n" +
+	"*WORKER_DEPLOYMENT_ROLLOUT_STATUS_CANCELLED\x10\x05*\xcb\x01\n" +
+	"$WorkerDeploymentRolloutFailureAction\x128\n" +
+	"4WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_UNSPECIFIED\x10\x00\x125\n" +
+	"1WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_ROLLBACK\x10\x01\x122\n" +
+	".WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_PAUSE\x10\x02*\x86\x02\n" +
+	"\x1dWorkerDeploymentRolloutAction\x120\n" +
+	",WORKER_DEPLOYMENT_ROLLOUT_ACTION_UNSPECIFIED\x10\x00\x12*\n" +
+	"&WORKER_DEPLOYMENT_ROLLOUT_ACTION_PAUSE\x10\x01\x12+\n" +
+	"'WORKER_DEPLOYMENT_ROLLOUT_ACTION_RESUME\x10\x02\x12+\n" +
+	"'WORKER_DEPLOYMENT_ROLLOUT_ACTION_CANCEL\x10\x03\x12-\n" +
+	")WORKER_DEPLOYMENT_ROLLOUT_ACTION_ROLLBACK\x10\x04B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
+
+var (
+	file_temporal_server_api_enums_v1_deployment_proto_rawDescOnce sync.Once
+	file_temporal_server_api_enums_v1_deployment_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_enums_v1_deployment_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_enums_v1_deployment_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_enums_v1_deployment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_deployment_proto_rawDesc), len(file_temporal_server_api_enums_v1_deployment_proto_rawDesc)))
+	})
+	return file_temporal_server_api_enums_v1_deployment_proto_rawDescData
+}
+
+var file_temporal_server_api_enums_v1_deployment_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_temporal_server_api_enums_v1_deployment_proto_goTypes = []any{
+	(WorkerDeploymentRolloutStatus)(0),        // 0: temporal.server.api.enums.v1.WorkerDeploymentRolloutStatus
+	(WorkerDeploymentRolloutFailureAction)(0), // 1: temporal.server.api.enums.v1.WorkerDeploymentRolloutFailureAction
+	(WorkerDeploymentRolloutAction)(0),        // 2: temporal.server.api.enums.v1.WorkerDeploymentRolloutAction
+}
+var file_temporal_server_api_enums_v1_deployment_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_enums_v1_deployment_proto_init() }
+func file_temporal_server_api_enums_v1_deployment_proto_init() {
+	if File_temporal_server_api_enums_v1_deployment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_deployment_proto_rawDesc), len(file_temporal_server_api_enums_v1_deployment_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_enums_v1_deployment_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_enums_v1_deployment_proto_depIdxs,
+		EnumInfos:         file_temporal_server_api_enums_v1_deployment_proto_enumTypes,
+	}.Build()
+	File_temporal_server_api_enums_v1_deployment_proto = out.File
+	file_temporal_server_api_enums_v1_deployment_proto_goTypes = nil
+	file_temporal_server_api_enums_v1_deployment_proto_depIdxs = nil
+}
@@ -0,0 +1,80 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package faultinjection
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal an object of type Fault to the protobuf v3 wire format
+func (val *Fault) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type Fault from the protobuf v3 wire format
+func (val *Fault) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *Fault) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two Fault values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *Fault) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *Fault
+	switch t := that.(type) {
+	case *Fault:
+		that1 = t
+	case Fault:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HostFaults to the protobuf v3 wire format
+func (val *HostFaults) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HostFaults from the protobuf v3 wire format
+func (val *HostFaults) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HostFaults) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HostFaults values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HostFaults) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HostFaults
+	switch t := that.(type) {
+	case *HostFaults:
+		that1 = t
+	case HostFaults:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
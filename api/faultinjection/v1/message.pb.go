@@ -0,0 +1,326 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/faultinjection/v1/message.proto
+
+package faultinjection
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A fault injected at runtime into the persistence calls or RPC client calls of the hosts of a cluster.
+// Empty matching fields match everything.
+type Fault struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the fault, used to replace or delete it.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Kind of call the fault is injected into: "persistence" or "rpc".
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Services whose hosts inject the fault, e.g. "frontend", "history" or "matching".
+	Services []string `protobuf:"bytes,3,rep,name=services,proto3" json:"services,omitempty"`
+	// Persistence store name for persistence faults, e.g. "ExecutionStore", or destination service for RPC faults,
+	// i.e. "history" or "matching".
+	Component string `protobuf:"bytes,4,opt,name=component,proto3" json:"component,omitempty"`
+	// Name of the store method or RPC method, e.g. "UpdateWorkflowExecution" or "RecordActivityTaskStarted".
+	Method      string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	NamespaceId string `protobuf:"bytes,6,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Only applies to persistence faults.
+	ShardId int32 `protobuf:"varint,7,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Type of the error to return, e.g. "Unavailable". Empty to only inject latency.
+	// Known values are defined as Go constants in common/chaos.
+	ErrorType string `protobuf:"bytes,8,opt,name=error_type,json=errorType,proto3" json:"error_type,omitempty"`
+	// Chance for a matching call to fail with the error, between 0 and 1.
+	ErrorRate float64 `protobuf:"fixed64,9,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	// Latency to add to matching calls before they are executed.
+	Latency *durationpb.Duration `protobuf:"bytes,10,opt,name=latency,proto3" json:"latency,omitempty"`
+	// Chance for a matching call to be delayed, between 0 and 1.
+	LatencyRate float64 `protobuf:"fixed64,11,opt,name=latency_rate,json=latencyRate,proto3" json:"latency_rate,omitempty"`
+	// The fault is not injected anymore after this time, if set.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fault) Reset() {
+	*x = Fault{}
+	mi := &file_temporal_server_api_faultinjection_v1_message_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fault) ProtoMessage() {}
+
+func (x *Fault) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_faultinjection_v1_message_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fault.ProtoReflect.Descriptor instead.
+func (*Fault) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_faultinjection_v1_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Fault) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Fault) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Fault) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *Fault) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *Fault) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Fault) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *Fault) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *Fault) GetErrorType() string {
+	if x != nil {
+		return x.ErrorType
+	}
+	return ""
+}
+
+func (x *Fault) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *Fault) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *Fault) GetLatencyRate() float64 {
+	if x != nil {
+		return x.LatencyRate
+	}
+	return 0
+}
+
+func (x *Fault) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// Faults injected by a single host.
+type HostFaults struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Service of the host, e.g. "frontend" or "history".
+	Service     string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	HostAddress string `protobuf:"bytes,2,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	// False if runtime fault injection is disabled on the host, in which case faults are not injected.
+	Enabled       bool     `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Faults        []*Fault `protobuf:"bytes,4,rep,name=faults,proto3" json:"faults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostFaults) Reset() {
+	*x = HostFaults{}
+	mi := &file_temporal_server_api_faultinjection_v1_message_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostFaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostFaults) ProtoMessage() {}
+
+func (x *HostFaults) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_faultinjection_v1_message_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostFaults.ProtoReflect.Descriptor instead.
+func (*HostFaults) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_faultinjection_v1_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HostFaults) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *HostFaults) GetHostAddress() string {
+	if x != nil {
+		return x.HostAddress
+	}
+	return ""
+}
+
+func (x *HostFaults) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *HostFaults) GetFaults() []*Fault {
+	if x != nil {
+		return x.Faults
+	}
+	return nil
+}
+
+var File_temporal_server_api_faultinjection_v1_message_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_faultinjection_v1_message_proto_rawDesc = "" +
+	"\n" +
+	"3temporal/server/api/faultinjection/v1/message.proto\x12%temporal.server.api.faultinjection.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x03\n" +
+	"\x05Fault\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x1a\n" +
+	"\bservices\x18\x03 \x03(\tR\bservices\x12\x1c\n" +
+	"\tcomponent\x18\x04 \x01(\tR\tcomponent\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12!\n" +
+	"\fnamespace_id\x18\x06 \x01(\tR\vnamespaceId\x12\x19\n" +
+	"\bshard_id\x18\a \x01(\x05R\ashardId\x12\x1d\n" +
+	"\n" +
+	"error_type\x18\b \x01(\tR\terrorType\x12\x1d\n" +
+	"\n" +
+	"error_rate\x18\t \x01(\x01R\terrorRate\x123\n" +
+	"\alatency\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\alatency\x12!\n" +
+	"\flatency_rate\x18\v \x01(\x01R\vlatencyRate\x12;\n" +
+	"\vexpire_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"\xa9\x01\n" +
+	"\n" +
+	"HostFaults\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12!\n" +
+	"\fhost_address\x18\x02 \x01(\tR\vhostAddress\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12D\n" +
+	"\x06faults\x18\x04 \x03(\v2,.temporal.server.api.faultinjection.v1.FaultR\x06faultsB<Z:go.temporal.io/server/api/faultinjection/v1;faultinjectionb\x06proto3"
+
+var (
+	file_temporal_server_api_faultinjection_v1_message_proto_rawDescOnce sync.Once
+	file_temporal_server_api_faultinjection_v1_message_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_faultinjection_v1_message_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_faultinjection_v1_message_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_faultinjection_v1_message_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_faultinjection_v1_message_proto_rawDesc), len(file_temporal_server_api_faultinjection_v1_message_proto_rawDesc)))
+	})
+	return file_temporal_server_api_faultinjection_v1_message_proto_rawDescData
+}
+
+var file_temporal_server_api_faultinjection_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_temporal_server_api_faultinjection_v1_message_proto_goTypes = []any{
+	(*Fault)(nil),                 // 0: temporal.server.api.faultinjection.v1.Fault
+	(*HostFaults)(nil),            // 1: temporal.server.api.faultinjection.v1.HostFaults
+	(*durationpb.Duration)(nil),   // 2: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_temporal_server_api_faultinjection_v1_message_proto_depIdxs = []int32{
+	2, // 0: temporal.server.api.faultinjection.v1.Fault.latency:type_name -> google.protobuf.Duration
+	3, // 1: temporal.server.api.faultinjection.v1.Fault.expire_time:type_name -> google.protobuf.Timestamp
+	0, // 2: temporal.server.api.faultinjection.v1.HostFaults.faults:type_name -> temporal.server.api.faultinjection.v1.Fault
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_faultinjection_v1_message_proto_init() }
+func file_temporal_server_api_faultinjection_v1_message_proto_init() {
+	if File_temporal_server_api_faultinjection_v1_message_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_faultinjection_v1_message_proto_rawDesc), len(file_temporal_server_api_faultinjection_v1_message_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_faultinjection_v1_message_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_faultinjection_v1_message_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_faultinjection_v1_message_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_faultinjection_v1_message_proto = out.File
+	file_temporal_server_api_faultinjection_v1_message_proto_goTypes = nil
+	file_temporal_server_api_faultinjection_v1_message_proto_depIdxs = nil
+}
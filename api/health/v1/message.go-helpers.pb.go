@@ -115,3 +115,77 @@ func (this *ServiceHealthDetail) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type OutboundDestinationHealth to the protobuf v3 wire format
+func (val *OutboundDestinationHealth) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type OutboundDestinationHealth from the protobuf v3 wire format
+func (val *OutboundDestinationHealth) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *OutboundDestinationHealth) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two OutboundDestinationHealth values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *OutboundDestinationHealth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *OutboundDestinationHealth
+	switch t := that.(type) {
+	case *OutboundDestinationHealth:
+		that1 = t
+	case OutboundDestinationHealth:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PersistenceConcurrencyLimiterState to the protobuf v3 wire format
+func (val *PersistenceConcurrencyLimiterState) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PersistenceConcurrencyLimiterState from the protobuf v3 wire format
+func (val *PersistenceConcurrencyLimiterState) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PersistenceConcurrencyLimiterState) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PersistenceConcurrencyLimiterState values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PersistenceConcurrencyLimiterState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PersistenceConcurrencyLimiterState
+	switch t := that.(type) {
+	case *PersistenceConcurrencyLimiterState:
+		that1 = t
+	case PersistenceConcurrencyLimiterState:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
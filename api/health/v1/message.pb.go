@@ -14,6 +14,8 @@ import (
 	v1 "go.temporal.io/server/api/enums/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -245,11 +247,300 @@ func (x *ServiceHealthDetail) GetMessage() string {
 	return ""
 }
 
+// Health of a single outbound queue destination (e.g. a Nexus endpoint or a callback URL) as observed
+// by the outbound queue circuit breaker of one or more history hosts.
+type OutboundDestinationHealth struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Outbound task group, e.g. "nexus" for CHASM Nexus operations or "nexusoperations.Invocation".
+	TaskGroup string `protobuf:"bytes,2,opt,name=task_group,json=taskGroup,proto3" json:"task_group,omitempty"`
+	// Nexus endpoint name or callback destination.
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Address of the reporting history host. Empty when aggregated across hosts.
+	HostAddress string `protobuf:"bytes,4,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	// Circuit breaker state: "closed", "half-open" or "open".
+	// When aggregated across hosts, the least healthy state is reported.
+	CircuitBreakerState string `protobuf:"bytes,5,opt,name=circuit_breaker_state,json=circuitBreakerState,proto3" json:"circuit_breaker_state,omitempty"`
+	// Whether an operator manually tripped the circuit breaker.
+	ManuallyTripped bool `protobuf:"varint,6,opt,name=manually_tripped,json=manuallyTripped,proto3" json:"manually_tripped,omitempty"`
+	// Circuit breaker counts for the current generation (see gobreaker.Counts).
+	Requests             uint32 `protobuf:"varint,7,opt,name=requests,proto3" json:"requests,omitempty"`
+	TotalSuccesses       uint32 `protobuf:"varint,8,opt,name=total_successes,json=totalSuccesses,proto3" json:"total_successes,omitempty"`
+	TotalFailures        uint32 `protobuf:"varint,9,opt,name=total_failures,json=totalFailures,proto3" json:"total_failures,omitempty"`
+	ConsecutiveSuccesses uint32 `protobuf:"varint,10,opt,name=consecutive_successes,json=consecutiveSuccesses,proto3" json:"consecutive_successes,omitempty"`
+	ConsecutiveFailures  uint32 `protobuf:"varint,11,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// Number of recently sampled requests that latencies and error classes are computed from.
+	SampleCount int64 `protobuf:"varint,12,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	// Occurrences of each error class among the recently sampled requests.
+	RecentErrorClasses map[string]int64 `protobuf:"bytes,13,rep,name=recent_error_classes,json=recentErrorClasses,proto3" json:"recent_error_classes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Latency percentiles of the recently sampled requests.
+	// When aggregated across hosts, the maximum reported by any host is used.
+	LatencyP50         *durationpb.Duration   `protobuf:"bytes,14,opt,name=latency_p50,json=latencyP50,proto3" json:"latency_p50,omitempty"`
+	LatencyP95         *durationpb.Duration   `protobuf:"bytes,15,opt,name=latency_p95,json=latencyP95,proto3" json:"latency_p95,omitempty"`
+	LatencyP99         *durationpb.Duration   `protobuf:"bytes,16,opt,name=latency_p99,json=latencyP99,proto3" json:"latency_p99,omitempty"`
+	LastFailureTime    *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=last_failure_time,json=lastFailureTime,proto3" json:"last_failure_time,omitempty"`
+	LastFailureMessage string                 `protobuf:"bytes,18,opt,name=last_failure_message,json=lastFailureMessage,proto3" json:"last_failure_message,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *OutboundDestinationHealth) Reset() {
+	*x = OutboundDestinationHealth{}
+	mi := &file_temporal_server_api_health_v1_message_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboundDestinationHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboundDestinationHealth) ProtoMessage() {}
+
+func (x *OutboundDestinationHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_health_v1_message_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboundDestinationHealth.ProtoReflect.Descriptor instead.
+func (*OutboundDestinationHealth) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_health_v1_message_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OutboundDestinationHealth) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *OutboundDestinationHealth) GetTaskGroup() string {
+	if x != nil {
+		return x.TaskGroup
+	}
+	return ""
+}
+
+func (x *OutboundDestinationHealth) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *OutboundDestinationHealth) GetHostAddress() string {
+	if x != nil {
+		return x.HostAddress
+	}
+	return ""
+}
+
+func (x *OutboundDestinationHealth) GetCircuitBreakerState() string {
+	if x != nil {
+		return x.CircuitBreakerState
+	}
+	return ""
+}
+
+func (x *OutboundDestinationHealth) GetManuallyTripped() bool {
+	if x != nil {
+		return x.ManuallyTripped
+	}
+	return false
+}
+
+func (x *OutboundDestinationHealth) GetRequests() uint32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *OutboundDestinationHealth) GetTotalSuccesses() uint32 {
+	if x != nil {
+		return x.TotalSuccesses
+	}
+	return 0
+}
+
+func (x *OutboundDestinationHealth) GetTotalFailures() uint32 {
+	if x != nil {
+		return x.TotalFailures
+	}
+	return 0
+}
+
+func (x *OutboundDestinationHealth) GetConsecutiveSuccesses() uint32 {
+	if x != nil {
+		return x.ConsecutiveSuccesses
+	}
+	return 0
+}
+
+func (x *OutboundDestinationHealth) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *OutboundDestinationHealth) GetSampleCount() int64 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
+func (x *OutboundDestinationHealth) GetRecentErrorClasses() map[string]int64 {
+	if x != nil {
+		return x.RecentErrorClasses
+	}
+	return nil
+}
+
+func (x *OutboundDestinationHealth) GetLatencyP50() *durationpb.Duration {
+	if x != nil {
+		return x.LatencyP50
+	}
+	return nil
+}
+
+func (x *OutboundDestinationHealth) GetLatencyP95() *durationpb.Duration {
+	if x != nil {
+		return x.LatencyP95
+	}
+	return nil
+}
+
+func (x *OutboundDestinationHealth) GetLatencyP99() *durationpb.Duration {
+	if x != nil {
+		return x.LatencyP99
+	}
+	return nil
+}
+
+func (x *OutboundDestinationHealth) GetLastFailureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFailureTime
+	}
+	return nil
+}
+
+func (x *OutboundDestinationHealth) GetLastFailureMessage() string {
+	if x != nil {
+		return x.LastFailureMessage
+	}
+	return ""
+}
+
+// State of the adaptive persistence concurrency limiter of a single host.
+type PersistenceConcurrencyLimiterState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Service of the reporting host, e.g. "frontend" or "history".
+	Service     string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	HostAddress string `protobuf:"bytes,2,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	Enabled     bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Current maximum number of in-flight persistence requests of the host.
+	Limit    int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	InFlight int64 `protobuf:"varint,5,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	// Persistence health signals the limit is adjusted on.
+	AverageLatencyMs float64 `protobuf:"fixed64,6,opt,name=average_latency_ms,json=averageLatencyMs,proto3" json:"average_latency_ms,omitempty"`
+	ErrorRatio       float64 `protobuf:"fixed64,7,opt,name=error_ratio,json=errorRatio,proto3" json:"error_ratio,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PersistenceConcurrencyLimiterState) Reset() {
+	*x = PersistenceConcurrencyLimiterState{}
+	mi := &file_temporal_server_api_health_v1_message_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistenceConcurrencyLimiterState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistenceConcurrencyLimiterState) ProtoMessage() {}
+
+func (x *PersistenceConcurrencyLimiterState) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_health_v1_message_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistenceConcurrencyLimiterState.ProtoReflect.Descriptor instead.
+func (*PersistenceConcurrencyLimiterState) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_health_v1_message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PersistenceConcurrencyLimiterState) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *PersistenceConcurrencyLimiterState) GetHostAddress() string {
+	if x != nil {
+		return x.HostAddress
+	}
+	return ""
+}
+
+func (x *PersistenceConcurrencyLimiterState) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PersistenceConcurrencyLimiterState) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PersistenceConcurrencyLimiterState) GetInFlight() int64 {
+	if x != nil {
+		return x.InFlight
+	}
+	return 0
+}
+
+func (x *PersistenceConcurrencyLimiterState) GetAverageLatencyMs() float64 {
+	if x != nil {
+		return x.AverageLatencyMs
+	}
+	return 0
+}
+
+func (x *PersistenceConcurrencyLimiterState) GetErrorRatio() float64 {
+	if x != nil {
+		return x.ErrorRatio
+	}
+	return 0
+}
+
 var File_temporal_server_api_health_v1_message_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_health_v1_message_proto_rawDesc = "" +
 	"\n" +
-	"+temporal/server/api/health/v1/message.proto\x12\x1dtemporal.server.api.health.v1\x1a*temporal/server/api/enums/v1/cluster.proto\"\xbb\x01\n" +
+	"+temporal/server/api/health/v1/message.proto\x12\x1dtemporal.server.api.health.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a*temporal/server/api/enums/v1/cluster.proto\"\xbb\x01\n" +
 	"\vHealthCheck\x12\x1d\n" +
 	"\n" +
 	"check_type\x18\x01 \x01(\tR\tcheckType\x12?\n" +
@@ -265,7 +556,43 @@ const file_temporal_server_api_health_v1_message_proto_rawDesc = "" +
 	"\aservice\x18\x01 \x01(\tR\aservice\x12?\n" +
 	"\x05state\x18\x02 \x01(\x0e2).temporal.server.api.enums.v1.HealthStateR\x05state\x12E\n" +
 	"\x05hosts\x18\x03 \x03(\v2/.temporal.server.api.health.v1.HostHealthDetailR\x05hosts\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessageB,Z*go.temporal.io/server/api/health/v1;healthb\x06proto3"
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xf2\a\n" +
+	"\x19OutboundDestinationHealth\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1d\n" +
+	"\n" +
+	"task_group\x18\x02 \x01(\tR\ttaskGroup\x12 \n" +
+	"\vdestination\x18\x03 \x01(\tR\vdestination\x12!\n" +
+	"\fhost_address\x18\x04 \x01(\tR\vhostAddress\x122\n" +
+	"\x15circuit_breaker_state\x18\x05 \x01(\tR\x13circuitBreakerState\x12)\n" +
+	"\x10manually_tripped\x18\x06 \x01(\bR\x0fmanuallyTripped\x12\x1a\n" +
+	"\brequests\x18\a \x01(\rR\brequests\x12'\n" +
+	"\x0ftotal_successes\x18\b \x01(\rR\x0etotalSuccesses\x12%\n" +
+	"\x0etotal_failures\x18\t \x01(\rR\rtotalFailures\x123\n" +
+	"\x15consecutive_successes\x18\n" +
+	" \x01(\rR\x14consecutiveSuccesses\x121\n" +
+	"\x14consecutive_failures\x18\v \x01(\rR\x13consecutiveFailures\x12!\n" +
+	"\fsample_count\x18\f \x01(\x03R\vsampleCount\x12\x82\x01\n" +
+	"\x14recent_error_classes\x18\r \x03(\v2P.temporal.server.api.health.v1.OutboundDestinationHealth.RecentErrorClassesEntryR\x12recentErrorClasses\x12:\n" +
+	"\vlatency_p50\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"latencyP50\x12:\n" +
+	"\vlatency_p95\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"latencyP95\x12:\n" +
+	"\vlatency_p99\x18\x10 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"latencyP99\x12F\n" +
+	"\x11last_failure_time\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastFailureTime\x120\n" +
+	"\x14last_failure_message\x18\x12 \x01(\tR\x12lastFailureMessage\x1aE\n" +
+	"\x17RecentErrorClassesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xfd\x01\n" +
+	"\"PersistenceConcurrencyLimiterState\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12!\n" +
+	"\fhost_address\x18\x02 \x01(\tR\vhostAddress\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x03R\x05limit\x12\x1b\n" +
+	"\tin_flight\x18\x05 \x01(\x03R\binFlight\x12,\n" +
+	"\x12average_latency_ms\x18\x06 \x01(\x01R\x10averageLatencyMs\x12\x1f\n" +
+	"\verror_ratio\x18\a \x01(\x01R\n" +
+	"errorRatioB,Z*go.temporal.io/server/api/health/v1;healthb\x06proto3"
 
 var (
 	file_temporal_server_api_health_v1_message_proto_rawDescOnce sync.Once
@@ -279,24 +606,34 @@ func file_temporal_server_api_health_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_health_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_health_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_temporal_server_api_health_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_temporal_server_api_health_v1_message_proto_goTypes = []any{
-	(*HealthCheck)(nil),         // 0: temporal.server.api.health.v1.HealthCheck
-	(*HostHealthDetail)(nil),    // 1: temporal.server.api.health.v1.HostHealthDetail
-	(*ServiceHealthDetail)(nil), // 2: temporal.server.api.health.v1.ServiceHealthDetail
-	(v1.HealthState)(0),         // 3: temporal.server.api.enums.v1.HealthState
+	(*HealthCheck)(nil),                        // 0: temporal.server.api.health.v1.HealthCheck
+	(*HostHealthDetail)(nil),                   // 1: temporal.server.api.health.v1.HostHealthDetail
+	(*ServiceHealthDetail)(nil),                // 2: temporal.server.api.health.v1.ServiceHealthDetail
+	(*OutboundDestinationHealth)(nil),          // 3: temporal.server.api.health.v1.OutboundDestinationHealth
+	(*PersistenceConcurrencyLimiterState)(nil), // 4: temporal.server.api.health.v1.PersistenceConcurrencyLimiterState
+	nil,                           // 5: temporal.server.api.health.v1.OutboundDestinationHealth.RecentErrorClassesEntry
+	(v1.HealthState)(0),           // 6: temporal.server.api.enums.v1.HealthState
+	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
 }
 var file_temporal_server_api_health_v1_message_proto_depIdxs = []int32{
-	3, // 0: temporal.server.api.health.v1.HealthCheck.state:type_name -> temporal.server.api.enums.v1.HealthState
-	3, // 1: temporal.server.api.health.v1.HostHealthDetail.state:type_name -> temporal.server.api.enums.v1.HealthState
-	0, // 2: temporal.server.api.health.v1.HostHealthDetail.checks:type_name -> temporal.server.api.health.v1.HealthCheck
-	3, // 3: temporal.server.api.health.v1.ServiceHealthDetail.state:type_name -> temporal.server.api.enums.v1.HealthState
-	1, // 4: temporal.server.api.health.v1.ServiceHealthDetail.hosts:type_name -> temporal.server.api.health.v1.HostHealthDetail
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6,  // 0: temporal.server.api.health.v1.HealthCheck.state:type_name -> temporal.server.api.enums.v1.HealthState
+	6,  // 1: temporal.server.api.health.v1.HostHealthDetail.state:type_name -> temporal.server.api.enums.v1.HealthState
+	0,  // 2: temporal.server.api.health.v1.HostHealthDetail.checks:type_name -> temporal.server.api.health.v1.HealthCheck
+	6,  // 3: temporal.server.api.health.v1.ServiceHealthDetail.state:type_name -> temporal.server.api.enums.v1.HealthState
+	1,  // 4: temporal.server.api.health.v1.ServiceHealthDetail.hosts:type_name -> temporal.server.api.health.v1.HostHealthDetail
+	5,  // 5: temporal.server.api.health.v1.OutboundDestinationHealth.recent_error_classes:type_name -> temporal.server.api.health.v1.OutboundDestinationHealth.RecentErrorClassesEntry
+	7,  // 6: temporal.server.api.health.v1.OutboundDestinationHealth.latency_p50:type_name -> google.protobuf.Duration
+	7,  // 7: temporal.server.api.health.v1.OutboundDestinationHealth.latency_p95:type_name -> google.protobuf.Duration
+	7,  // 8: temporal.server.api.health.v1.OutboundDestinationHealth.latency_p99:type_name -> google.protobuf.Duration
+	8,  // 9: temporal.server.api.health.v1.OutboundDestinationHealth.last_failure_time:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_health_v1_message_proto_init() }
@@ -310,7 +647,7 @@ func file_temporal_server_api_health_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_health_v1_message_proto_rawDesc), len(file_temporal_server_api_health_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
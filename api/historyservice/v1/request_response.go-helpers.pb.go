@@ -5998,3 +5998,706 @@ func (this *CancelNexusOperationResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type DescribeOutboundDestinationHealthRequest to the protobuf v3 wire format
+func (val *DescribeOutboundDestinationHealthRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeOutboundDestinationHealthRequest from the protobuf v3 wire format
+func (val *DescribeOutboundDestinationHealthRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeOutboundDestinationHealthRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeOutboundDestinationHealthRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeOutboundDestinationHealthRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeOutboundDestinationHealthRequest
+	switch t := that.(type) {
+	case *DescribeOutboundDestinationHealthRequest:
+		that1 = t
+	case DescribeOutboundDestinationHealthRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeOutboundDestinationHealthResponse to the protobuf v3 wire format
+func (val *DescribeOutboundDestinationHealthResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeOutboundDestinationHealthResponse from the protobuf v3 wire format
+func (val *DescribeOutboundDestinationHealthResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeOutboundDestinationHealthResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeOutboundDestinationHealthResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeOutboundDestinationHealthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeOutboundDestinationHealthResponse
+	switch t := that.(type) {
+	case *DescribeOutboundDestinationHealthResponse:
+		that1 = t
+	case DescribeOutboundDestinationHealthResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkflowRuleHitsRequest to the protobuf v3 wire format
+func (val *DescribeWorkflowRuleHitsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowRuleHitsRequest from the protobuf v3 wire format
+func (val *DescribeWorkflowRuleHitsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowRuleHitsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowRuleHitsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowRuleHitsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowRuleHitsRequest
+	switch t := that.(type) {
+	case *DescribeWorkflowRuleHitsRequest:
+		that1 = t
+	case DescribeWorkflowRuleHitsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkflowRuleHitsResponse to the protobuf v3 wire format
+func (val *DescribeWorkflowRuleHitsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowRuleHitsResponse from the protobuf v3 wire format
+func (val *DescribeWorkflowRuleHitsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowRuleHitsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowRuleHitsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowRuleHitsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowRuleHitsResponse
+	switch t := that.(type) {
+	case *DescribeWorkflowRuleHitsResponse:
+		that1 = t
+	case DescribeWorkflowRuleHitsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateOutboundCircuitBreakerRequest to the protobuf v3 wire format
+func (val *UpdateOutboundCircuitBreakerRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateOutboundCircuitBreakerRequest from the protobuf v3 wire format
+func (val *UpdateOutboundCircuitBreakerRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateOutboundCircuitBreakerRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateOutboundCircuitBreakerRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateOutboundCircuitBreakerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateOutboundCircuitBreakerRequest
+	switch t := that.(type) {
+	case *UpdateOutboundCircuitBreakerRequest:
+		that1 = t
+	case UpdateOutboundCircuitBreakerRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateOutboundCircuitBreakerResponse to the protobuf v3 wire format
+func (val *UpdateOutboundCircuitBreakerResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateOutboundCircuitBreakerResponse from the protobuf v3 wire format
+func (val *UpdateOutboundCircuitBreakerResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateOutboundCircuitBreakerResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateOutboundCircuitBreakerResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateOutboundCircuitBreakerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateOutboundCircuitBreakerResponse
+	switch t := that.(type) {
+	case *UpdateOutboundCircuitBreakerResponse:
+		that1 = t
+	case UpdateOutboundCircuitBreakerResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeadLetteredCallbackMessage to the protobuf v3 wire format
+func (val *DeadLetteredCallbackMessage) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeadLetteredCallbackMessage from the protobuf v3 wire format
+func (val *DeadLetteredCallbackMessage) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeadLetteredCallbackMessage) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeadLetteredCallbackMessage values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeadLetteredCallbackMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeadLetteredCallbackMessage
+	switch t := that.(type) {
+	case *DeadLetteredCallbackMessage:
+		that1 = t
+	case DeadLetteredCallbackMessage:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDeadLetteredCallbacksRequest to the protobuf v3 wire format
+func (val *ListDeadLetteredCallbacksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDeadLetteredCallbacksRequest from the protobuf v3 wire format
+func (val *ListDeadLetteredCallbacksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDeadLetteredCallbacksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDeadLetteredCallbacksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDeadLetteredCallbacksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDeadLetteredCallbacksRequest
+	switch t := that.(type) {
+	case *ListDeadLetteredCallbacksRequest:
+		that1 = t
+	case ListDeadLetteredCallbacksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDeadLetteredCallbacksResponse to the protobuf v3 wire format
+func (val *ListDeadLetteredCallbacksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDeadLetteredCallbacksResponse from the protobuf v3 wire format
+func (val *ListDeadLetteredCallbacksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDeadLetteredCallbacksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDeadLetteredCallbacksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDeadLetteredCallbacksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDeadLetteredCallbacksResponse
+	switch t := that.(type) {
+	case *ListDeadLetteredCallbacksResponse:
+		that1 = t
+	case ListDeadLetteredCallbacksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReplayDeadLetteredCallbacksRequest to the protobuf v3 wire format
+func (val *ReplayDeadLetteredCallbacksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplayDeadLetteredCallbacksRequest from the protobuf v3 wire format
+func (val *ReplayDeadLetteredCallbacksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplayDeadLetteredCallbacksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplayDeadLetteredCallbacksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplayDeadLetteredCallbacksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplayDeadLetteredCallbacksRequest
+	switch t := that.(type) {
+	case *ReplayDeadLetteredCallbacksRequest:
+		that1 = t
+	case ReplayDeadLetteredCallbacksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReplayDeadLetteredCallbacksResponse to the protobuf v3 wire format
+func (val *ReplayDeadLetteredCallbacksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplayDeadLetteredCallbacksResponse from the protobuf v3 wire format
+func (val *ReplayDeadLetteredCallbacksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplayDeadLetteredCallbacksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplayDeadLetteredCallbacksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplayDeadLetteredCallbacksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplayDeadLetteredCallbacksResponse
+	switch t := that.(type) {
+	case *ReplayDeadLetteredCallbacksResponse:
+		that1 = t
+	case ReplayDeadLetteredCallbacksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeDeadLetteredCallbacksRequest to the protobuf v3 wire format
+func (val *PurgeDeadLetteredCallbacksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeDeadLetteredCallbacksRequest from the protobuf v3 wire format
+func (val *PurgeDeadLetteredCallbacksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeDeadLetteredCallbacksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeDeadLetteredCallbacksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeDeadLetteredCallbacksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeDeadLetteredCallbacksRequest
+	switch t := that.(type) {
+	case *PurgeDeadLetteredCallbacksRequest:
+		that1 = t
+	case PurgeDeadLetteredCallbacksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeDeadLetteredCallbacksResponse to the protobuf v3 wire format
+func (val *PurgeDeadLetteredCallbacksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeDeadLetteredCallbacksResponse from the protobuf v3 wire format
+func (val *PurgeDeadLetteredCallbacksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeDeadLetteredCallbacksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeDeadLetteredCallbacksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeDeadLetteredCallbacksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeDeadLetteredCallbacksResponse
+	switch t := that.(type) {
+	case *PurgeDeadLetteredCallbacksResponse:
+		that1 = t
+	case PurgeDeadLetteredCallbacksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribePersistenceConcurrencyLimiterRequest to the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribePersistenceConcurrencyLimiterRequest from the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribePersistenceConcurrencyLimiterRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribePersistenceConcurrencyLimiterRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribePersistenceConcurrencyLimiterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribePersistenceConcurrencyLimiterRequest
+	switch t := that.(type) {
+	case *DescribePersistenceConcurrencyLimiterRequest:
+		that1 = t
+	case DescribePersistenceConcurrencyLimiterRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribePersistenceConcurrencyLimiterResponse to the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribePersistenceConcurrencyLimiterResponse from the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribePersistenceConcurrencyLimiterResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribePersistenceConcurrencyLimiterResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribePersistenceConcurrencyLimiterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribePersistenceConcurrencyLimiterResponse
+	switch t := that.(type) {
+	case *DescribePersistenceConcurrencyLimiterResponse:
+		that1 = t
+	case DescribePersistenceConcurrencyLimiterResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateFaultInjectionRequest to the protobuf v3 wire format
+func (val *UpdateFaultInjectionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateFaultInjectionRequest from the protobuf v3 wire format
+func (val *UpdateFaultInjectionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateFaultInjectionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateFaultInjectionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateFaultInjectionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateFaultInjectionRequest
+	switch t := that.(type) {
+	case *UpdateFaultInjectionRequest:
+		that1 = t
+	case UpdateFaultInjectionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateFaultInjectionResponse to the protobuf v3 wire format
+func (val *UpdateFaultInjectionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateFaultInjectionResponse from the protobuf v3 wire format
+func (val *UpdateFaultInjectionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateFaultInjectionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateFaultInjectionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateFaultInjectionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateFaultInjectionResponse
+	switch t := that.(type) {
+	case *UpdateFaultInjectionResponse:
+		that1 = t
+	case UpdateFaultInjectionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeReplicationLagRequest to the protobuf v3 wire format
+func (val *DescribeReplicationLagRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeReplicationLagRequest from the protobuf v3 wire format
+func (val *DescribeReplicationLagRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeReplicationLagRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeReplicationLagRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeReplicationLagRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeReplicationLagRequest
+	switch t := that.(type) {
+	case *DescribeReplicationLagRequest:
+		that1 = t
+	case DescribeReplicationLagRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeReplicationLagResponse to the protobuf v3 wire format
+func (val *DescribeReplicationLagResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeReplicationLagResponse from the protobuf v3 wire format
+func (val *DescribeReplicationLagResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeReplicationLagResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeReplicationLagResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeReplicationLagResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeReplicationLagResponse
+	switch t := that.(type) {
+	case *DescribeReplicationLagResponse:
+		that1 = t
+	case DescribeReplicationLagResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -97,6 +97,15 @@ const (
 	HistoryService_UnpauseWorkflowExecution_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution"
 	HistoryService_StartNexusOperation_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/StartNexusOperation"
 	HistoryService_CancelNexusOperation_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/CancelNexusOperation"
+	HistoryService_DescribeOutboundDestinationHealth_FullMethodName      = "/temporal.server.api.historyservice.v1.HistoryService/DescribeOutboundDestinationHealth"
+	HistoryService_DescribeWorkflowRuleHits_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/DescribeWorkflowRuleHits"
+	HistoryService_UpdateOutboundCircuitBreaker_FullMethodName           = "/temporal.server.api.historyservice.v1.HistoryService/UpdateOutboundCircuitBreaker"
+	HistoryService_ListDeadLetteredCallbacks_FullMethodName              = "/temporal.server.api.historyservice.v1.HistoryService/ListDeadLetteredCallbacks"
+	HistoryService_ReplayDeadLetteredCallbacks_FullMethodName            = "/temporal.server.api.historyservice.v1.HistoryService/ReplayDeadLetteredCallbacks"
+	HistoryService_PurgeDeadLetteredCallbacks_FullMethodName             = "/temporal.server.api.historyservice.v1.HistoryService/PurgeDeadLetteredCallbacks"
+	HistoryService_DescribePersistenceConcurrencyLimiter_FullMethodName  = "/temporal.server.api.historyservice.v1.HistoryService/DescribePersistenceConcurrencyLimiter"
+	HistoryService_UpdateFaultInjection_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/UpdateFaultInjection"
+	HistoryService_DescribeReplicationLag_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/DescribeReplicationLag"
 )
 
 // HistoryServiceClient is the client API for HistoryService service.
@@ -389,6 +398,27 @@ type HistoryServiceClient interface {
 	StartNexusOperation(ctx context.Context, in *StartNexusOperationRequest, opts ...grpc.CallOption) (*StartNexusOperationResponse, error)
 	// CancelNexusOperation cancels a Nexus operation on the __temporal_system endpoint.
 	CancelNexusOperation(ctx context.Context, in *CancelNexusOperationRequest, opts ...grpc.CallOption) (*CancelNexusOperationResponse, error)
+	// DescribeOutboundDestinationHealth returns the outbound queue circuit breaker state and recent
+	// request outcomes of each destination known to a history host.
+	DescribeOutboundDestinationHealth(ctx context.Context, in *DescribeOutboundDestinationHealthRequest, opts ...grpc.CallOption) (*DescribeOutboundDestinationHealthResponse, error)
+	// DescribeWorkflowRuleHits returns the number of times a workflow rule matched on the shards owned by a history host.
+	DescribeWorkflowRuleHits(ctx context.Context, in *DescribeWorkflowRuleHitsRequest, opts ...grpc.CallOption) (*DescribeWorkflowRuleHitsResponse, error)
+	// UpdateOutboundCircuitBreaker manually trips or resets outbound queue circuit breakers on a history host.
+	UpdateOutboundCircuitBreaker(ctx context.Context, in *UpdateOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*UpdateOutboundCircuitBreakerResponse, error)
+	// ListDeadLetteredCallbacks returns a page of the callbacks of a namespace whose delivery was abandoned.
+	ListDeadLetteredCallbacks(ctx context.Context, in *ListDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*ListDeadLetteredCallbacksResponse, error)
+	// ReplayDeadLetteredCallbacks re-sends the stored requests of dead-lettered callbacks in message ID order and stops
+	// at the first delivery failure.
+	ReplayDeadLetteredCallbacks(ctx context.Context, in *ReplayDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*ReplayDeadLetteredCallbacksResponse, error)
+	// PurgeDeadLetteredCallbacks deletes dead-lettered callbacks up to and including the given message ID.
+	PurgeDeadLetteredCallbacks(ctx context.Context, in *PurgeDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*PurgeDeadLetteredCallbacksResponse, error)
+	// DescribePersistenceConcurrencyLimiter returns the state of the adaptive persistence concurrency limiter of a
+	// history host.
+	DescribePersistenceConcurrencyLimiter(ctx context.Context, in *DescribePersistenceConcurrencyLimiterRequest, opts ...grpc.CallOption) (*DescribePersistenceConcurrencyLimiterResponse, error)
+	// UpdateFaultInjection adds or deletes the faults injected at runtime by a history host, and returns its faults.
+	UpdateFaultInjection(ctx context.Context, in *UpdateFaultInjectionRequest, opts ...grpc.CallOption) (*UpdateFaultInjectionResponse, error)
+	// DescribeReplicationLag returns how far each remote cluster is behind the shards owned by a history host.
+	DescribeReplicationLag(ctx context.Context, in *DescribeReplicationLagRequest, opts ...grpc.CallOption) (*DescribeReplicationLagResponse, error)
 }
 
 type historyServiceClient struct {
@@ -1114,6 +1144,87 @@ func (c *historyServiceClient) CancelNexusOperation(ctx context.Context, in *Can
 	return out, nil
 }
 
+func (c *historyServiceClient) DescribeOutboundDestinationHealth(ctx context.Context, in *DescribeOutboundDestinationHealthRequest, opts ...grpc.CallOption) (*DescribeOutboundDestinationHealthResponse, error) {
+	out := new(DescribeOutboundDestinationHealthResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeOutboundDestinationHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribeWorkflowRuleHits(ctx context.Context, in *DescribeWorkflowRuleHitsRequest, opts ...grpc.CallOption) (*DescribeWorkflowRuleHitsResponse, error) {
+	out := new(DescribeWorkflowRuleHitsResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeWorkflowRuleHits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpdateOutboundCircuitBreaker(ctx context.Context, in *UpdateOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*UpdateOutboundCircuitBreakerResponse, error) {
+	out := new(UpdateOutboundCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UpdateOutboundCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ListDeadLetteredCallbacks(ctx context.Context, in *ListDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*ListDeadLetteredCallbacksResponse, error) {
+	out := new(ListDeadLetteredCallbacksResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ListDeadLetteredCallbacks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ReplayDeadLetteredCallbacks(ctx context.Context, in *ReplayDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*ReplayDeadLetteredCallbacksResponse, error) {
+	out := new(ReplayDeadLetteredCallbacksResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ReplayDeadLetteredCallbacks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) PurgeDeadLetteredCallbacks(ctx context.Context, in *PurgeDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*PurgeDeadLetteredCallbacksResponse, error) {
+	out := new(PurgeDeadLetteredCallbacksResponse)
+	err := c.cc.Invoke(ctx, HistoryService_PurgeDeadLetteredCallbacks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribePersistenceConcurrencyLimiter(ctx context.Context, in *DescribePersistenceConcurrencyLimiterRequest, opts ...grpc.CallOption) (*DescribePersistenceConcurrencyLimiterResponse, error) {
+	out := new(DescribePersistenceConcurrencyLimiterResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribePersistenceConcurrencyLimiter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpdateFaultInjection(ctx context.Context, in *UpdateFaultInjectionRequest, opts ...grpc.CallOption) (*UpdateFaultInjectionResponse, error) {
+	out := new(UpdateFaultInjectionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UpdateFaultInjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribeReplicationLag(ctx context.Context, in *DescribeReplicationLagRequest, opts ...grpc.CallOption) (*DescribeReplicationLagResponse, error) {
+	out := new(DescribeReplicationLagResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeReplicationLag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility
@@ -1404,6 +1515,27 @@ type HistoryServiceServer interface {
 	StartNexusOperation(context.Context, *StartNexusOperationRequest) (*StartNexusOperationResponse, error)
 	// CancelNexusOperation cancels a Nexus operation on the __temporal_system endpoint.
 	CancelNexusOperation(context.Context, *CancelNexusOperationRequest) (*CancelNexusOperationResponse, error)
+	// DescribeOutboundDestinationHealth returns the outbound queue circuit breaker state and recent
+	// request outcomes of each destination known to a history host.
+	DescribeOutboundDestinationHealth(context.Context, *DescribeOutboundDestinationHealthRequest) (*DescribeOutboundDestinationHealthResponse, error)
+	// DescribeWorkflowRuleHits returns the number of times a workflow rule matched on the shards owned by a history host.
+	DescribeWorkflowRuleHits(context.Context, *DescribeWorkflowRuleHitsRequest) (*DescribeWorkflowRuleHitsResponse, error)
+	// UpdateOutboundCircuitBreaker manually trips or resets outbound queue circuit breakers on a history host.
+	UpdateOutboundCircuitBreaker(context.Context, *UpdateOutboundCircuitBreakerRequest) (*UpdateOutboundCircuitBreakerResponse, error)
+	// ListDeadLetteredCallbacks returns a page of the callbacks of a namespace whose delivery was abandoned.
+	ListDeadLetteredCallbacks(context.Context, *ListDeadLetteredCallbacksRequest) (*ListDeadLetteredCallbacksResponse, error)
+	// ReplayDeadLetteredCallbacks re-sends the stored requests of dead-lettered callbacks in message ID order and stops
+	// at the first delivery failure.
+	ReplayDeadLetteredCallbacks(context.Context, *ReplayDeadLetteredCallbacksRequest) (*ReplayDeadLetteredCallbacksResponse, error)
+	// PurgeDeadLetteredCallbacks deletes dead-lettered callbacks up to and including the given message ID.
+	PurgeDeadLetteredCallbacks(context.Context, *PurgeDeadLetteredCallbacksRequest) (*PurgeDeadLetteredCallbacksResponse, error)
+	// DescribePersistenceConcurrencyLimiter returns the state of the adaptive persistence concurrency limiter of a
+	// history host.
+	DescribePersistenceConcurrencyLimiter(context.Context, *DescribePersistenceConcurrencyLimiterRequest) (*DescribePersistenceConcurrencyLimiterResponse, error)
+	// UpdateFaultInjection adds or deletes the faults injected at runtime by a history host, and returns its faults.
+	UpdateFaultInjection(context.Context, *UpdateFaultInjectionRequest) (*UpdateFaultInjectionResponse, error)
+	// DescribeReplicationLag returns how far each remote cluster is behind the shards owned by a history host.
+	DescribeReplicationLag(context.Context, *DescribeReplicationLagRequest) (*DescribeReplicationLagResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -1642,6 +1774,33 @@ func (UnimplementedHistoryServiceServer) StartNexusOperation(context.Context, *S
 func (UnimplementedHistoryServiceServer) CancelNexusOperation(context.Context, *CancelNexusOperationRequest) (*CancelNexusOperationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelNexusOperation not implemented")
 }
+func (UnimplementedHistoryServiceServer) DescribeOutboundDestinationHealth(context.Context, *DescribeOutboundDestinationHealthRequest) (*DescribeOutboundDestinationHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeOutboundDestinationHealth not implemented")
+}
+func (UnimplementedHistoryServiceServer) DescribeWorkflowRuleHits(context.Context, *DescribeWorkflowRuleHitsRequest) (*DescribeWorkflowRuleHitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkflowRuleHits not implemented")
+}
+func (UnimplementedHistoryServiceServer) UpdateOutboundCircuitBreaker(context.Context, *UpdateOutboundCircuitBreakerRequest) (*UpdateOutboundCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOutboundCircuitBreaker not implemented")
+}
+func (UnimplementedHistoryServiceServer) ListDeadLetteredCallbacks(context.Context, *ListDeadLetteredCallbacksRequest) (*ListDeadLetteredCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetteredCallbacks not implemented")
+}
+func (UnimplementedHistoryServiceServer) ReplayDeadLetteredCallbacks(context.Context, *ReplayDeadLetteredCallbacksRequest) (*ReplayDeadLetteredCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetteredCallbacks not implemented")
+}
+func (UnimplementedHistoryServiceServer) PurgeDeadLetteredCallbacks(context.Context, *PurgeDeadLetteredCallbacksRequest) (*PurgeDeadLetteredCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeadLetteredCallbacks not implemented")
+}
+func (UnimplementedHistoryServiceServer) DescribePersistenceConcurrencyLimiter(context.Context, *DescribePersistenceConcurrencyLimiterRequest) (*DescribePersistenceConcurrencyLimiterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribePersistenceConcurrencyLimiter not implemented")
+}
+func (UnimplementedHistoryServiceServer) UpdateFaultInjection(context.Context, *UpdateFaultInjectionRequest) (*UpdateFaultInjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFaultInjection not implemented")
+}
+func (UnimplementedHistoryServiceServer) DescribeReplicationLag(context.Context, *DescribeReplicationLagRequest) (*DescribeReplicationLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeReplicationLag not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 
 // UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -3049,6 +3208,168 @@ func _HistoryService_CancelNexusOperation_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_DescribeOutboundDestinationHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeOutboundDestinationHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeOutboundDestinationHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeOutboundDestinationHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeOutboundDestinationHealth(ctx, req.(*DescribeOutboundDestinationHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribeWorkflowRuleHits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkflowRuleHitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeWorkflowRuleHits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeWorkflowRuleHits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeWorkflowRuleHits(ctx, req.(*DescribeWorkflowRuleHitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpdateOutboundCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOutboundCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateOutboundCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UpdateOutboundCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateOutboundCircuitBreaker(ctx, req.(*UpdateOutboundCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ListDeadLetteredCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetteredCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ListDeadLetteredCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ListDeadLetteredCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ListDeadLetteredCallbacks(ctx, req.(*ListDeadLetteredCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ReplayDeadLetteredCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetteredCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ReplayDeadLetteredCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ReplayDeadLetteredCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ReplayDeadLetteredCallbacks(ctx, req.(*ReplayDeadLetteredCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_PurgeDeadLetteredCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeadLetteredCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PurgeDeadLetteredCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_PurgeDeadLetteredCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PurgeDeadLetteredCallbacks(ctx, req.(*PurgeDeadLetteredCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribePersistenceConcurrencyLimiter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribePersistenceConcurrencyLimiterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribePersistenceConcurrencyLimiter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribePersistenceConcurrencyLimiter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribePersistenceConcurrencyLimiter(ctx, req.(*DescribePersistenceConcurrencyLimiterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpdateFaultInjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFaultInjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateFaultInjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UpdateFaultInjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateFaultInjection(ctx, req.(*UpdateFaultInjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribeReplicationLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeReplicationLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeReplicationLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeReplicationLag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeReplicationLag(ctx, req.(*DescribeReplicationLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3360,6 +3681,42 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelNexusOperation",
 			Handler:    _HistoryService_CancelNexusOperation_Handler,
 		},
+		{
+			MethodName: "DescribeOutboundDestinationHealth",
+			Handler:    _HistoryService_DescribeOutboundDestinationHealth_Handler,
+		},
+		{
+			MethodName: "DescribeWorkflowRuleHits",
+			Handler:    _HistoryService_DescribeWorkflowRuleHits_Handler,
+		},
+		{
+			MethodName: "UpdateOutboundCircuitBreaker",
+			Handler:    _HistoryService_UpdateOutboundCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "ListDeadLetteredCallbacks",
+			Handler:    _HistoryService_ListDeadLetteredCallbacks_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetteredCallbacks",
+			Handler:    _HistoryService_ReplayDeadLetteredCallbacks_Handler,
+		},
+		{
+			MethodName: "PurgeDeadLetteredCallbacks",
+			Handler:    _HistoryService_PurgeDeadLetteredCallbacks_Handler,
+		},
+		{
+			MethodName: "DescribePersistenceConcurrencyLimiter",
+			Handler:    _HistoryService_DescribePersistenceConcurrencyLimiter_Handler,
+		},
+		{
+			MethodName: "UpdateFaultInjection",
+			Handler:    _HistoryService_UpdateFaultInjection_Handler,
+		},
+		{
+			MethodName: "DescribeReplicationLag",
+			Handler:    _HistoryService_DescribeReplicationLag_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -283,6 +283,66 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeMutableState(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeOutboundDestinationHealth mocks base method.
+func (m *MockHistoryServiceClient) DescribeOutboundDestinationHealth(ctx context.Context, in *historyservice.DescribeOutboundDestinationHealthRequest, opts ...grpc.CallOption) (*historyservice.DescribeOutboundDestinationHealthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOutboundDestinationHealth", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeOutboundDestinationHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundDestinationHealth indicates an expected call of DescribeOutboundDestinationHealth.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeOutboundDestinationHealth(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundDestinationHealth", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeOutboundDestinationHealth), varargs...)
+}
+
+// DescribePersistenceConcurrencyLimiter mocks base method.
+func (m *MockHistoryServiceClient) DescribePersistenceConcurrencyLimiter(ctx context.Context, in *historyservice.DescribePersistenceConcurrencyLimiterRequest, opts ...grpc.CallOption) (*historyservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePersistenceConcurrencyLimiter", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribePersistenceConcurrencyLimiterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePersistenceConcurrencyLimiter indicates an expected call of DescribePersistenceConcurrencyLimiter.
+func (mr *MockHistoryServiceClientMockRecorder) DescribePersistenceConcurrencyLimiter(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePersistenceConcurrencyLimiter", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribePersistenceConcurrencyLimiter), varargs...)
+}
+
+// DescribeReplicationLag mocks base method.
+func (m *MockHistoryServiceClient) DescribeReplicationLag(ctx context.Context, in *historyservice.DescribeReplicationLagRequest, opts ...grpc.CallOption) (*historyservice.DescribeReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeReplicationLag", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationLag indicates an expected call of DescribeReplicationLag.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeReplicationLag(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationLag", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeReplicationLag), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) DescribeWorkflowExecution(ctx context.Context, in *historyservice.DescribeWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +363,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeWorkflowExecution(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeWorkflowExecution), varargs...)
 }
 
+// DescribeWorkflowRuleHits mocks base method.
+func (m *MockHistoryServiceClient) DescribeWorkflowRuleHits(ctx context.Context, in *historyservice.DescribeWorkflowRuleHitsRequest, opts ...grpc.CallOption) (*historyservice.DescribeWorkflowRuleHitsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorkflowRuleHits", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeWorkflowRuleHitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowRuleHits indicates an expected call of DescribeWorkflowRuleHits.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeWorkflowRuleHits(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowRuleHits", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeWorkflowRuleHits), varargs...)
+}
+
 // ExecuteMultiOperation mocks base method.
 func (m *MockHistoryServiceClient) ExecuteMultiOperation(ctx context.Context, in *historyservice.ExecuteMultiOperationRequest, opts ...grpc.CallOption) (*historyservice.ExecuteMultiOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -663,6 +743,26 @@ func (mr *MockHistoryServiceClientMockRecorder) IsWorkflowTaskValid(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockHistoryServiceClient)(nil).IsWorkflowTaskValid), varargs...)
 }
 
+// ListDeadLetteredCallbacks mocks base method.
+func (m *MockHistoryServiceClient) ListDeadLetteredCallbacks(ctx context.Context, in *historyservice.ListDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*historyservice.ListDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeadLetteredCallbacks", varargs...)
+	ret0, _ := ret[0].(*historyservice.ListDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetteredCallbacks indicates an expected call of ListDeadLetteredCallbacks.
+func (mr *MockHistoryServiceClientMockRecorder) ListDeadLetteredCallbacks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetteredCallbacks", reflect.TypeOf((*MockHistoryServiceClient)(nil).ListDeadLetteredCallbacks), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockHistoryServiceClient) ListQueues(ctx context.Context, in *historyservice.ListQueuesRequest, opts ...grpc.CallOption) (*historyservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -823,6 +923,26 @@ func (mr *MockHistoryServiceClientMockRecorder) PurgeDLQMessages(ctx, in any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).PurgeDLQMessages), varargs...)
 }
 
+// PurgeDeadLetteredCallbacks mocks base method.
+func (m *MockHistoryServiceClient) PurgeDeadLetteredCallbacks(ctx context.Context, in *historyservice.PurgeDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*historyservice.PurgeDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeDeadLetteredCallbacks", varargs...)
+	ret0, _ := ret[0].(*historyservice.PurgeDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetteredCallbacks indicates an expected call of PurgeDeadLetteredCallbacks.
+func (mr *MockHistoryServiceClientMockRecorder) PurgeDeadLetteredCallbacks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetteredCallbacks", reflect.TypeOf((*MockHistoryServiceClient)(nil).PurgeDeadLetteredCallbacks), varargs...)
+}
+
 // QueryWorkflow mocks base method.
 func (m *MockHistoryServiceClient) QueryWorkflow(ctx context.Context, in *historyservice.QueryWorkflowRequest, opts ...grpc.CallOption) (*historyservice.QueryWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -1023,6 +1143,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RemoveTask(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockHistoryServiceClient)(nil).RemoveTask), varargs...)
 }
 
+// ReplayDeadLetteredCallbacks mocks base method.
+func (m *MockHistoryServiceClient) ReplayDeadLetteredCallbacks(ctx context.Context, in *historyservice.ReplayDeadLetteredCallbacksRequest, opts ...grpc.CallOption) (*historyservice.ReplayDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplayDeadLetteredCallbacks", varargs...)
+	ret0, _ := ret[0].(*historyservice.ReplayDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetteredCallbacks indicates an expected call of ReplayDeadLetteredCallbacks.
+func (mr *MockHistoryServiceClientMockRecorder) ReplayDeadLetteredCallbacks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetteredCallbacks", reflect.TypeOf((*MockHistoryServiceClient)(nil).ReplayDeadLetteredCallbacks), varargs...)
+}
+
 // ReplicateEventsV2 mocks base method.
 func (m *MockHistoryServiceClient) ReplicateEventsV2(ctx context.Context, in *historyservice.ReplicateEventsV2Request, opts ...grpc.CallOption) (*historyservice.ReplicateEventsV2Response, error) {
 	m.ctrl.T.Helper()
@@ -1503,6 +1643,46 @@ func (mr *MockHistoryServiceClientMockRecorder) UpdateActivityOptions(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateActivityOptions), varargs...)
 }
 
+// UpdateFaultInjection mocks base method.
+func (m *MockHistoryServiceClient) UpdateFaultInjection(ctx context.Context, in *historyservice.UpdateFaultInjectionRequest, opts ...grpc.CallOption) (*historyservice.UpdateFaultInjectionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateFaultInjection", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpdateFaultInjectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFaultInjection indicates an expected call of UpdateFaultInjection.
+func (mr *MockHistoryServiceClientMockRecorder) UpdateFaultInjection(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFaultInjection", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateFaultInjection), varargs...)
+}
+
+// UpdateOutboundCircuitBreaker mocks base method.
+func (m *MockHistoryServiceClient) UpdateOutboundCircuitBreaker(ctx context.Context, in *historyservice.UpdateOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*historyservice.UpdateOutboundCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateOutboundCircuitBreaker", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpdateOutboundCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOutboundCircuitBreaker indicates an expected call of UpdateOutboundCircuitBreaker.
+func (mr *MockHistoryServiceClientMockRecorder) UpdateOutboundCircuitBreaker(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboundCircuitBreaker", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateOutboundCircuitBreaker), varargs...)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) UpdateWorkflowExecution(ctx context.Context, in *historyservice.UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1925,6 +2105,51 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeMutableState(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeOutboundDestinationHealth mocks base method.
+func (m *MockHistoryServiceServer) DescribeOutboundDestinationHealth(arg0 context.Context, arg1 *historyservice.DescribeOutboundDestinationHealthRequest) (*historyservice.DescribeOutboundDestinationHealthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOutboundDestinationHealth", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeOutboundDestinationHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundDestinationHealth indicates an expected call of DescribeOutboundDestinationHealth.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeOutboundDestinationHealth(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundDestinationHealth", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeOutboundDestinationHealth), arg0, arg1)
+}
+
+// DescribePersistenceConcurrencyLimiter mocks base method.
+func (m *MockHistoryServiceServer) DescribePersistenceConcurrencyLimiter(arg0 context.Context, arg1 *historyservice.DescribePersistenceConcurrencyLimiterRequest) (*historyservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePersistenceConcurrencyLimiter", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribePersistenceConcurrencyLimiterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePersistenceConcurrencyLimiter indicates an expected call of DescribePersistenceConcurrencyLimiter.
+func (mr *MockHistoryServiceServerMockRecorder) DescribePersistenceConcurrencyLimiter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePersistenceConcurrencyLimiter", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribePersistenceConcurrencyLimiter), arg0, arg1)
+}
+
+// DescribeReplicationLag mocks base method.
+func (m *MockHistoryServiceServer) DescribeReplicationLag(arg0 context.Context, arg1 *historyservice.DescribeReplicationLagRequest) (*historyservice.DescribeReplicationLagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplicationLag", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeReplicationLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationLag indicates an expected call of DescribeReplicationLag.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeReplicationLag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationLag", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeReplicationLag), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) DescribeWorkflowExecution(arg0 context.Context, arg1 *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1940,6 +2165,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeWorkflowExecution(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// DescribeWorkflowRuleHits mocks base method.
+func (m *MockHistoryServiceServer) DescribeWorkflowRuleHits(arg0 context.Context, arg1 *historyservice.DescribeWorkflowRuleHitsRequest) (*historyservice.DescribeWorkflowRuleHitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkflowRuleHits", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeWorkflowRuleHitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowRuleHits indicates an expected call of DescribeWorkflowRuleHits.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeWorkflowRuleHits(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowRuleHits", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeWorkflowRuleHits), arg0, arg1)
+}
+
 // ExecuteMultiOperation mocks base method.
 func (m *MockHistoryServiceServer) ExecuteMultiOperation(arg0 context.Context, arg1 *historyservice.ExecuteMultiOperationRequest) (*historyservice.ExecuteMultiOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -2210,6 +2450,21 @@ func (mr *MockHistoryServiceServerMockRecorder) IsWorkflowTaskValid(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockHistoryServiceServer)(nil).IsWorkflowTaskValid), arg0, arg1)
 }
 
+// ListDeadLetteredCallbacks mocks base method.
+func (m *MockHistoryServiceServer) ListDeadLetteredCallbacks(arg0 context.Context, arg1 *historyservice.ListDeadLetteredCallbacksRequest) (*historyservice.ListDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetteredCallbacks", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ListDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetteredCallbacks indicates an expected call of ListDeadLetteredCallbacks.
+func (mr *MockHistoryServiceServerMockRecorder) ListDeadLetteredCallbacks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetteredCallbacks", reflect.TypeOf((*MockHistoryServiceServer)(nil).ListDeadLetteredCallbacks), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockHistoryServiceServer) ListQueues(arg0 context.Context, arg1 *historyservice.ListQueuesRequest) (*historyservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -2330,6 +2585,21 @@ func (mr *MockHistoryServiceServerMockRecorder) PurgeDLQMessages(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).PurgeDLQMessages), arg0, arg1)
 }
 
+// PurgeDeadLetteredCallbacks mocks base method.
+func (m *MockHistoryServiceServer) PurgeDeadLetteredCallbacks(arg0 context.Context, arg1 *historyservice.PurgeDeadLetteredCallbacksRequest) (*historyservice.PurgeDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetteredCallbacks", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.PurgeDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetteredCallbacks indicates an expected call of PurgeDeadLetteredCallbacks.
+func (mr *MockHistoryServiceServerMockRecorder) PurgeDeadLetteredCallbacks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetteredCallbacks", reflect.TypeOf((*MockHistoryServiceServer)(nil).PurgeDeadLetteredCallbacks), arg0, arg1)
+}
+
 // QueryWorkflow mocks base method.
 func (m *MockHistoryServiceServer) QueryWorkflow(arg0 context.Context, arg1 *historyservice.QueryWorkflowRequest) (*historyservice.QueryWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -2480,6 +2750,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RemoveTask(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockHistoryServiceServer)(nil).RemoveTask), arg0, arg1)
 }
 
+// ReplayDeadLetteredCallbacks mocks base method.
+func (m *MockHistoryServiceServer) ReplayDeadLetteredCallbacks(arg0 context.Context, arg1 *historyservice.ReplayDeadLetteredCallbacksRequest) (*historyservice.ReplayDeadLetteredCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetteredCallbacks", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ReplayDeadLetteredCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetteredCallbacks indicates an expected call of ReplayDeadLetteredCallbacks.
+func (mr *MockHistoryServiceServerMockRecorder) ReplayDeadLetteredCallbacks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetteredCallbacks", reflect.TypeOf((*MockHistoryServiceServer)(nil).ReplayDeadLetteredCallbacks), arg0, arg1)
+}
+
 // ReplicateEventsV2 mocks base method.
 func (m *MockHistoryServiceServer) ReplicateEventsV2(arg0 context.Context, arg1 *historyservice.ReplicateEventsV2Request) (*historyservice.ReplicateEventsV2Response, error) {
 	m.ctrl.T.Helper()
@@ -2839,6 +3124,36 @@ func (mr *MockHistoryServiceServerMockRecorder) UpdateActivityOptions(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateActivityOptions), arg0, arg1)
 }
 
+// UpdateFaultInjection mocks base method.
+func (m *MockHistoryServiceServer) UpdateFaultInjection(arg0 context.Context, arg1 *historyservice.UpdateFaultInjectionRequest) (*historyservice.UpdateFaultInjectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFaultInjection", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpdateFaultInjectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFaultInjection indicates an expected call of UpdateFaultInjection.
+func (mr *MockHistoryServiceServerMockRecorder) UpdateFaultInjection(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFaultInjection", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateFaultInjection), arg0, arg1)
+}
+
+// UpdateOutboundCircuitBreaker mocks base method.
+func (m *MockHistoryServiceServer) UpdateOutboundCircuitBreaker(arg0 context.Context, arg1 *historyservice.UpdateOutboundCircuitBreakerRequest) (*historyservice.UpdateOutboundCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOutboundCircuitBreaker", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpdateOutboundCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOutboundCircuitBreaker indicates an expected call of UpdateOutboundCircuitBreaker.
+func (mr *MockHistoryServiceServerMockRecorder) UpdateOutboundCircuitBreaker(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboundCircuitBreaker", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateOutboundCircuitBreaker), arg0, arg1)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) UpdateWorkflowExecution(arg0 context.Context, arg1 *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -3038,3 +3038,373 @@ func (this *PollConditions) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type UpdateFaultInjectionRequest to the protobuf v3 wire format
+func (val *UpdateFaultInjectionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateFaultInjectionRequest from the protobuf v3 wire format
+func (val *UpdateFaultInjectionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateFaultInjectionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateFaultInjectionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateFaultInjectionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateFaultInjectionRequest
+	switch t := that.(type) {
+	case *UpdateFaultInjectionRequest:
+		that1 = t
+	case UpdateFaultInjectionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateFaultInjectionResponse to the protobuf v3 wire format
+func (val *UpdateFaultInjectionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateFaultInjectionResponse from the protobuf v3 wire format
+func (val *UpdateFaultInjectionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateFaultInjectionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateFaultInjectionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateFaultInjectionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateFaultInjectionResponse
+	switch t := that.(type) {
+	case *UpdateFaultInjectionResponse:
+		that1 = t
+	case UpdateFaultInjectionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribePersistenceConcurrencyLimiterRequest to the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribePersistenceConcurrencyLimiterRequest from the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribePersistenceConcurrencyLimiterRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribePersistenceConcurrencyLimiterRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribePersistenceConcurrencyLimiterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribePersistenceConcurrencyLimiterRequest
+	switch t := that.(type) {
+	case *DescribePersistenceConcurrencyLimiterRequest:
+		that1 = t
+	case DescribePersistenceConcurrencyLimiterRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribePersistenceConcurrencyLimiterResponse to the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribePersistenceConcurrencyLimiterResponse from the protobuf v3 wire format
+func (val *DescribePersistenceConcurrencyLimiterResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribePersistenceConcurrencyLimiterResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribePersistenceConcurrencyLimiterResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribePersistenceConcurrencyLimiterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribePersistenceConcurrencyLimiterResponse
+	switch t := that.(type) {
+	case *DescribePersistenceConcurrencyLimiterResponse:
+		that1 = t
+	case DescribePersistenceConcurrencyLimiterResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetWorkerScalingRecommendationRequest to the protobuf v3 wire format
+func (val *GetWorkerScalingRecommendationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetWorkerScalingRecommendationRequest from the protobuf v3 wire format
+func (val *GetWorkerScalingRecommendationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetWorkerScalingRecommendationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetWorkerScalingRecommendationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetWorkerScalingRecommendationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetWorkerScalingRecommendationRequest
+	switch t := that.(type) {
+	case *GetWorkerScalingRecommendationRequest:
+		that1 = t
+	case GetWorkerScalingRecommendationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetWorkerScalingRecommendationResponse to the protobuf v3 wire format
+func (val *GetWorkerScalingRecommendationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetWorkerScalingRecommendationResponse from the protobuf v3 wire format
+func (val *GetWorkerScalingRecommendationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetWorkerScalingRecommendationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetWorkerScalingRecommendationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetWorkerScalingRecommendationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetWorkerScalingRecommendationResponse
+	switch t := that.(type) {
+	case *GetWorkerScalingRecommendationResponse:
+		that1 = t
+	case GetWorkerScalingRecommendationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetWorkerDeploymentVersionHealthRequest to the protobuf v3 wire format
+func (val *GetWorkerDeploymentVersionHealthRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetWorkerDeploymentVersionHealthRequest from the protobuf v3 wire format
+func (val *GetWorkerDeploymentVersionHealthRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetWorkerDeploymentVersionHealthRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetWorkerDeploymentVersionHealthRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetWorkerDeploymentVersionHealthRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetWorkerDeploymentVersionHealthRequest
+	switch t := that.(type) {
+	case *GetWorkerDeploymentVersionHealthRequest:
+		that1 = t
+	case GetWorkerDeploymentVersionHealthRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetWorkerDeploymentVersionHealthResponse to the protobuf v3 wire format
+func (val *GetWorkerDeploymentVersionHealthResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetWorkerDeploymentVersionHealthResponse from the protobuf v3 wire format
+func (val *GetWorkerDeploymentVersionHealthResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetWorkerDeploymentVersionHealthResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetWorkerDeploymentVersionHealthResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetWorkerDeploymentVersionHealthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetWorkerDeploymentVersionHealthResponse
+	switch t := that.(type) {
+	case *GetWorkerDeploymentVersionHealthResponse:
+		that1 = t
+	case GetWorkerDeploymentVersionHealthResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RecordNondeterminismErrorRequest to the protobuf v3 wire format
+func (val *RecordNondeterminismErrorRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecordNondeterminismErrorRequest from the protobuf v3 wire format
+func (val *RecordNondeterminismErrorRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecordNondeterminismErrorRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecordNondeterminismErrorRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecordNondeterminismErrorRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecordNondeterminismErrorRequest
+	switch t := that.(type) {
+	case *RecordNondeterminismErrorRequest:
+		that1 = t
+	case RecordNondeterminismErrorRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RecordNondeterminismErrorResponse to the protobuf v3 wire format
+func (val *RecordNondeterminismErrorResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecordNondeterminismErrorResponse from the protobuf v3 wire format
+func (val *RecordNondeterminismErrorResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecordNondeterminismErrorResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecordNondeterminismErrorResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecordNondeterminismErrorResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecordNondeterminismErrorResponse
+	switch t := that.(type) {
+	case *RecordNondeterminismErrorResponse:
+		that1 = t
+	case RecordNondeterminismErrorResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
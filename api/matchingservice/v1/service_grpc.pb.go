@@ -60,6 +60,11 @@ const (
 	MatchingService_DescribeWorker_FullMethodName                         = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeWorker"
 	MatchingService_UpdateFairnessState_FullMethodName                    = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateFairnessState"
 	MatchingService_CheckTaskQueueVersionMembership_FullMethodName        = "/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueVersionMembership"
+	MatchingService_UpdateFaultInjection_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateFaultInjection"
+	MatchingService_DescribePersistenceConcurrencyLimiter_FullMethodName  = "/temporal.server.api.matchingservice.v1.MatchingService/DescribePersistenceConcurrencyLimiter"
+	MatchingService_GetWorkerScalingRecommendation_FullMethodName         = "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerScalingRecommendation"
+	MatchingService_GetWorkerDeploymentVersionHealth_FullMethodName       = "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerDeploymentVersionHealth"
+	MatchingService_RecordNondeterminismError_FullMethodName              = "/temporal.server.api.matchingservice.v1.MatchingService/RecordNondeterminismError"
 )
 
 // MatchingServiceClient is the client API for MatchingService service.
@@ -246,6 +251,21 @@ type MatchingServiceClient interface {
 	UpdateFairnessState(ctx context.Context, in *UpdateFairnessStateRequest, opts ...grpc.CallOption) (*UpdateFairnessStateResponse, error)
 	// CheckTaskQueueVersionMembership checks if a task queue is part of a specific deployment version.
 	CheckTaskQueueVersionMembership(ctx context.Context, in *CheckTaskQueueVersionMembershipRequest, opts ...grpc.CallOption) (*CheckTaskQueueVersionMembershipResponse, error)
+	// UpdateFaultInjection adds or deletes the faults injected at runtime by a matching host, and returns its faults.
+	UpdateFaultInjection(ctx context.Context, in *UpdateFaultInjectionRequest, opts ...grpc.CallOption) (*UpdateFaultInjectionResponse, error)
+	// DescribePersistenceConcurrencyLimiter returns the state of the adaptive persistence concurrency limiter of a
+	// matching host.
+	DescribePersistenceConcurrencyLimiter(ctx context.Context, in *DescribePersistenceConcurrencyLimiterRequest, opts ...grpc.CallOption) (*DescribePersistenceConcurrencyLimiterResponse, error)
+	// GetWorkerScalingRecommendation computes the number of workers and slots a task queue version needs to meet the
+	// configured scaling targets, from the arrival rate, dispatch latency, backlog and slot usage of its workers.
+	// Must be called on the root partition of the task queue.
+	GetWorkerScalingRecommendation(ctx context.Context, in *GetWorkerScalingRecommendationRequest, opts ...grpc.CallOption) (*GetWorkerScalingRecommendationResponse, error)
+	// GetWorkerDeploymentVersionHealth aggregates the task failures and nondeterminism errors of a Worker Deployment
+	// Version from the worker registry of the namespace. Used by the health gates of managed rollouts.
+	GetWorkerDeploymentVersionHealth(ctx context.Context, in *GetWorkerDeploymentVersionHealthRequest, opts ...grpc.CallOption) (*GetWorkerDeploymentVersionHealthResponse, error)
+	// RecordNondeterminismError records a workflow task that failed with a nondeterminism error on a Worker Deployment
+	// Version in the worker registry of the namespace.
+	RecordNondeterminismError(ctx context.Context, in *RecordNondeterminismErrorRequest, opts ...grpc.CallOption) (*RecordNondeterminismErrorResponse, error)
 }
 
 type matchingServiceClient struct {
@@ -616,6 +636,51 @@ func (c *matchingServiceClient) CheckTaskQueueVersionMembership(ctx context.Cont
 	return out, nil
 }
 
+func (c *matchingServiceClient) UpdateFaultInjection(ctx context.Context, in *UpdateFaultInjectionRequest, opts ...grpc.CallOption) (*UpdateFaultInjectionResponse, error) {
+	out := new(UpdateFaultInjectionResponse)
+	err := c.cc.Invoke(ctx, MatchingService_UpdateFaultInjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) DescribePersistenceConcurrencyLimiter(ctx context.Context, in *DescribePersistenceConcurrencyLimiterRequest, opts ...grpc.CallOption) (*DescribePersistenceConcurrencyLimiterResponse, error) {
+	out := new(DescribePersistenceConcurrencyLimiterResponse)
+	err := c.cc.Invoke(ctx, MatchingService_DescribePersistenceConcurrencyLimiter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetWorkerScalingRecommendation(ctx context.Context, in *GetWorkerScalingRecommendationRequest, opts ...grpc.CallOption) (*GetWorkerScalingRecommendationResponse, error) {
+	out := new(GetWorkerScalingRecommendationResponse)
+	err := c.cc.Invoke(ctx, MatchingService_GetWorkerScalingRecommendation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetWorkerDeploymentVersionHealth(ctx context.Context, in *GetWorkerDeploymentVersionHealthRequest, opts ...grpc.CallOption) (*GetWorkerDeploymentVersionHealthResponse, error) {
+	out := new(GetWorkerDeploymentVersionHealthResponse)
+	err := c.cc.Invoke(ctx, MatchingService_GetWorkerDeploymentVersionHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) RecordNondeterminismError(ctx context.Context, in *RecordNondeterminismErrorRequest, opts ...grpc.CallOption) (*RecordNondeterminismErrorResponse, error) {
+	out := new(RecordNondeterminismErrorResponse)
+	err := c.cc.Invoke(ctx, MatchingService_RecordNondeterminismError_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchingServiceServer is the server API for MatchingService service.
 // All implementations must embed UnimplementedMatchingServiceServer
 // for forward compatibility
@@ -800,6 +865,21 @@ type MatchingServiceServer interface {
 	UpdateFairnessState(context.Context, *UpdateFairnessStateRequest) (*UpdateFairnessStateResponse, error)
 	// CheckTaskQueueVersionMembership checks if a task queue is part of a specific deployment version.
 	CheckTaskQueueVersionMembership(context.Context, *CheckTaskQueueVersionMembershipRequest) (*CheckTaskQueueVersionMembershipResponse, error)
+	// UpdateFaultInjection adds or deletes the faults injected at runtime by a matching host, and returns its faults.
+	UpdateFaultInjection(context.Context, *UpdateFaultInjectionRequest) (*UpdateFaultInjectionResponse, error)
+	// DescribePersistenceConcurrencyLimiter returns the state of the adaptive persistence concurrency limiter of a
+	// matching host.
+	DescribePersistenceConcurrencyLimiter(context.Context, *DescribePersistenceConcurrencyLimiterRequest) (*DescribePersistenceConcurrencyLimiterResponse, error)
+	// GetWorkerScalingRecommendation computes the number of workers and slots a task queue version needs to meet the
+	// configured scaling targets, from the arrival rate, dispatch latency, backlog and slot usage of its workers.
+	// Must be called on the root partition of the task queue.
+	GetWorkerScalingRecommendation(context.Context, *GetWorkerScalingRecommendationRequest) (*GetWorkerScalingRecommendationResponse, error)
+	// GetWorkerDeploymentVersionHealth aggregates the task failures and nondeterminism errors of a Worker Deployment
+	// Version from the worker registry of the namespace. Used by the health gates of managed rollouts.
+	GetWorkerDeploymentVersionHealth(context.Context, *GetWorkerDeploymentVersionHealthRequest) (*GetWorkerDeploymentVersionHealthResponse, error)
+	// RecordNondeterminismError records a workflow task that failed with a nondeterminism error on a Worker Deployment
+	// Version in the worker registry of the namespace.
+	RecordNondeterminismError(context.Context, *RecordNondeterminismErrorRequest) (*RecordNondeterminismErrorResponse, error)
 	mustEmbedUnimplementedMatchingServiceServer()
 }
 
@@ -927,6 +1007,21 @@ func (UnimplementedMatchingServiceServer) UpdateFairnessState(context.Context, *
 func (UnimplementedMatchingServiceServer) CheckTaskQueueVersionMembership(context.Context, *CheckTaskQueueVersionMembershipRequest) (*CheckTaskQueueVersionMembershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckTaskQueueVersionMembership not implemented")
 }
+func (UnimplementedMatchingServiceServer) UpdateFaultInjection(context.Context, *UpdateFaultInjectionRequest) (*UpdateFaultInjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFaultInjection not implemented")
+}
+func (UnimplementedMatchingServiceServer) DescribePersistenceConcurrencyLimiter(context.Context, *DescribePersistenceConcurrencyLimiterRequest) (*DescribePersistenceConcurrencyLimiterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribePersistenceConcurrencyLimiter not implemented")
+}
+func (UnimplementedMatchingServiceServer) GetWorkerScalingRecommendation(context.Context, *GetWorkerScalingRecommendationRequest) (*GetWorkerScalingRecommendationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerScalingRecommendation not implemented")
+}
+func (UnimplementedMatchingServiceServer) GetWorkerDeploymentVersionHealth(context.Context, *GetWorkerDeploymentVersionHealthRequest) (*GetWorkerDeploymentVersionHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerDeploymentVersionHealth not implemented")
+}
+func (UnimplementedMatchingServiceServer) RecordNondeterminismError(context.Context, *RecordNondeterminismErrorRequest) (*RecordNondeterminismErrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordNondeterminismError not implemented")
+}
 func (UnimplementedMatchingServiceServer) mustEmbedUnimplementedMatchingServiceServer() {}
 
 // UnsafeMatchingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1660,6 +1755,96 @@ func _MatchingService_CheckTaskQueueVersionMembership_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_UpdateFaultInjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFaultInjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateFaultInjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_UpdateFaultInjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateFaultInjection(ctx, req.(*UpdateFaultInjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_DescribePersistenceConcurrencyLimiter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribePersistenceConcurrencyLimiterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).DescribePersistenceConcurrencyLimiter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_DescribePersistenceConcurrencyLimiter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).DescribePersistenceConcurrencyLimiter(ctx, req.(*DescribePersistenceConcurrencyLimiterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetWorkerScalingRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerScalingRecommendationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetWorkerScalingRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_GetWorkerScalingRecommendation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetWorkerScalingRecommendation(ctx, req.(*GetWorkerScalingRecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetWorkerDeploymentVersionHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerDeploymentVersionHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetWorkerDeploymentVersionHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_GetWorkerDeploymentVersionHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetWorkerDeploymentVersionHealth(ctx, req.(*GetWorkerDeploymentVersionHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_RecordNondeterminismError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordNondeterminismErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).RecordNondeterminismError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_RecordNondeterminismError_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).RecordNondeterminismError(ctx, req.(*RecordNondeterminismErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MatchingService_ServiceDesc is the grpc.ServiceDesc for MatchingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1827,6 +2012,26 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckTaskQueueVersionMembership",
 			Handler:    _MatchingService_CheckTaskQueueVersionMembership_Handler,
 		},
+		{
+			MethodName: "UpdateFaultInjection",
+			Handler:    _MatchingService_UpdateFaultInjection_Handler,
+		},
+		{
+			MethodName: "DescribePersistenceConcurrencyLimiter",
+			Handler:    _MatchingService_DescribePersistenceConcurrencyLimiter_Handler,
+		},
+		{
+			MethodName: "GetWorkerScalingRecommendation",
+			Handler:    _MatchingService_GetWorkerScalingRecommendation_Handler,
+		},
+		{
+			MethodName: "GetWorkerDeploymentVersionHealth",
+			Handler:    _MatchingService_GetWorkerDeploymentVersionHealth_Handler,
+		},
+		{
+			MethodName: "RecordNondeterminismError",
+			Handler:    _MatchingService_RecordNondeterminismError_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/matchingservice/v1/service.proto",
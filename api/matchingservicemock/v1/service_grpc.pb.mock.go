@@ -222,6 +222,26 @@ func (mr *MockMatchingServiceClientMockRecorder) DeleteNexusEndpoint(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNexusEndpoint", reflect.TypeOf((*MockMatchingServiceClient)(nil).DeleteNexusEndpoint), varargs...)
 }
 
+// DescribePersistenceConcurrencyLimiter mocks base method.
+func (m *MockMatchingServiceClient) DescribePersistenceConcurrencyLimiter(ctx context.Context, in *matchingservice.DescribePersistenceConcurrencyLimiterRequest, opts ...grpc.CallOption) (*matchingservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePersistenceConcurrencyLimiter", varargs...)
+	ret0, _ := ret[0].(*matchingservice.DescribePersistenceConcurrencyLimiterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePersistenceConcurrencyLimiter indicates an expected call of DescribePersistenceConcurrencyLimiter.
+func (mr *MockMatchingServiceClientMockRecorder) DescribePersistenceConcurrencyLimiter(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePersistenceConcurrencyLimiter", reflect.TypeOf((*MockMatchingServiceClient)(nil).DescribePersistenceConcurrencyLimiter), varargs...)
+}
+
 // DescribeTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) DescribeTaskQueue(ctx context.Context, in *matchingservice.DescribeTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -442,6 +462,46 @@ func (mr *MockMatchingServiceClientMockRecorder) GetWorkerBuildIdCompatibility(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerBuildIdCompatibility", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetWorkerBuildIdCompatibility), varargs...)
 }
 
+// GetWorkerDeploymentVersionHealth mocks base method.
+func (m *MockMatchingServiceClient) GetWorkerDeploymentVersionHealth(ctx context.Context, in *matchingservice.GetWorkerDeploymentVersionHealthRequest, opts ...grpc.CallOption) (*matchingservice.GetWorkerDeploymentVersionHealthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkerDeploymentVersionHealth", varargs...)
+	ret0, _ := ret[0].(*matchingservice.GetWorkerDeploymentVersionHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerDeploymentVersionHealth indicates an expected call of GetWorkerDeploymentVersionHealth.
+func (mr *MockMatchingServiceClientMockRecorder) GetWorkerDeploymentVersionHealth(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerDeploymentVersionHealth", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetWorkerDeploymentVersionHealth), varargs...)
+}
+
+// GetWorkerScalingRecommendation mocks base method.
+func (m *MockMatchingServiceClient) GetWorkerScalingRecommendation(ctx context.Context, in *matchingservice.GetWorkerScalingRecommendationRequest, opts ...grpc.CallOption) (*matchingservice.GetWorkerScalingRecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkerScalingRecommendation", varargs...)
+	ret0, _ := ret[0].(*matchingservice.GetWorkerScalingRecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerScalingRecommendation indicates an expected call of GetWorkerScalingRecommendation.
+func (mr *MockMatchingServiceClientMockRecorder) GetWorkerScalingRecommendation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerScalingRecommendation", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetWorkerScalingRecommendation), varargs...)
+}
+
 // GetWorkerVersioningRules mocks base method.
 func (m *MockMatchingServiceClient) GetWorkerVersioningRules(ctx context.Context, in *matchingservice.GetWorkerVersioningRulesRequest, opts ...grpc.CallOption) (*matchingservice.GetWorkerVersioningRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -602,6 +662,26 @@ func (mr *MockMatchingServiceClientMockRecorder) QueryWorkflow(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceClient)(nil).QueryWorkflow), varargs...)
 }
 
+// RecordNondeterminismError mocks base method.
+func (m *MockMatchingServiceClient) RecordNondeterminismError(ctx context.Context, in *matchingservice.RecordNondeterminismErrorRequest, opts ...grpc.CallOption) (*matchingservice.RecordNondeterminismErrorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordNondeterminismError", varargs...)
+	ret0, _ := ret[0].(*matchingservice.RecordNondeterminismErrorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordNondeterminismError indicates an expected call of RecordNondeterminismError.
+func (mr *MockMatchingServiceClientMockRecorder) RecordNondeterminismError(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordNondeterminismError", reflect.TypeOf((*MockMatchingServiceClient)(nil).RecordNondeterminismError), varargs...)
+}
+
 // RecordWorkerHeartbeat mocks base method.
 func (m *MockMatchingServiceClient) RecordWorkerHeartbeat(ctx context.Context, in *matchingservice.RecordWorkerHeartbeatRequest, opts ...grpc.CallOption) (*matchingservice.RecordWorkerHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -742,6 +822,26 @@ func (mr *MockMatchingServiceClientMockRecorder) UpdateFairnessState(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFairnessState", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateFairnessState), varargs...)
 }
 
+// UpdateFaultInjection mocks base method.
+func (m *MockMatchingServiceClient) UpdateFaultInjection(ctx context.Context, in *matchingservice.UpdateFaultInjectionRequest, opts ...grpc.CallOption) (*matchingservice.UpdateFaultInjectionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateFaultInjection", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateFaultInjectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFaultInjection indicates an expected call of UpdateFaultInjection.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateFaultInjection(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFaultInjection", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateFaultInjection), varargs...)
+}
+
 // UpdateNexusEndpoint mocks base method.
 func (m *MockMatchingServiceClient) UpdateNexusEndpoint(ctx context.Context, in *matchingservice.UpdateNexusEndpointRequest, opts ...grpc.CallOption) (*matchingservice.UpdateNexusEndpointResponse, error) {
 	m.ctrl.T.Helper()
@@ -1001,6 +1101,21 @@ func (mr *MockMatchingServiceServerMockRecorder) DeleteNexusEndpoint(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNexusEndpoint", reflect.TypeOf((*MockMatchingServiceServer)(nil).DeleteNexusEndpoint), arg0, arg1)
 }
 
+// DescribePersistenceConcurrencyLimiter mocks base method.
+func (m *MockMatchingServiceServer) DescribePersistenceConcurrencyLimiter(arg0 context.Context, arg1 *matchingservice.DescribePersistenceConcurrencyLimiterRequest) (*matchingservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePersistenceConcurrencyLimiter", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.DescribePersistenceConcurrencyLimiterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePersistenceConcurrencyLimiter indicates an expected call of DescribePersistenceConcurrencyLimiter.
+func (mr *MockMatchingServiceServerMockRecorder) DescribePersistenceConcurrencyLimiter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePersistenceConcurrencyLimiter", reflect.TypeOf((*MockMatchingServiceServer)(nil).DescribePersistenceConcurrencyLimiter), arg0, arg1)
+}
+
 // DescribeTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) DescribeTaskQueue(arg0 context.Context, arg1 *matchingservice.DescribeTaskQueueRequest) (*matchingservice.DescribeTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -1166,6 +1281,36 @@ func (mr *MockMatchingServiceServerMockRecorder) GetWorkerBuildIdCompatibility(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerBuildIdCompatibility", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetWorkerBuildIdCompatibility), arg0, arg1)
 }
 
+// GetWorkerDeploymentVersionHealth mocks base method.
+func (m *MockMatchingServiceServer) GetWorkerDeploymentVersionHealth(arg0 context.Context, arg1 *matchingservice.GetWorkerDeploymentVersionHealthRequest) (*matchingservice.GetWorkerDeploymentVersionHealthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkerDeploymentVersionHealth", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.GetWorkerDeploymentVersionHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerDeploymentVersionHealth indicates an expected call of GetWorkerDeploymentVersionHealth.
+func (mr *MockMatchingServiceServerMockRecorder) GetWorkerDeploymentVersionHealth(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerDeploymentVersionHealth", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetWorkerDeploymentVersionHealth), arg0, arg1)
+}
+
+// GetWorkerScalingRecommendation mocks base method.
+func (m *MockMatchingServiceServer) GetWorkerScalingRecommendation(arg0 context.Context, arg1 *matchingservice.GetWorkerScalingRecommendationRequest) (*matchingservice.GetWorkerScalingRecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkerScalingRecommendation", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.GetWorkerScalingRecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerScalingRecommendation indicates an expected call of GetWorkerScalingRecommendation.
+func (mr *MockMatchingServiceServerMockRecorder) GetWorkerScalingRecommendation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerScalingRecommendation", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetWorkerScalingRecommendation), arg0, arg1)
+}
+
 // GetWorkerVersioningRules mocks base method.
 func (m *MockMatchingServiceServer) GetWorkerVersioningRules(arg0 context.Context, arg1 *matchingservice.GetWorkerVersioningRulesRequest) (*matchingservice.GetWorkerVersioningRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1286,6 +1431,21 @@ func (mr *MockMatchingServiceServerMockRecorder) QueryWorkflow(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceServer)(nil).QueryWorkflow), arg0, arg1)
 }
 
+// RecordNondeterminismError mocks base method.
+func (m *MockMatchingServiceServer) RecordNondeterminismError(arg0 context.Context, arg1 *matchingservice.RecordNondeterminismErrorRequest) (*matchingservice.RecordNondeterminismErrorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordNondeterminismError", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.RecordNondeterminismErrorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordNondeterminismError indicates an expected call of RecordNondeterminismError.
+func (mr *MockMatchingServiceServerMockRecorder) RecordNondeterminismError(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordNondeterminismError", reflect.TypeOf((*MockMatchingServiceServer)(nil).RecordNondeterminismError), arg0, arg1)
+}
+
 // RecordWorkerHeartbeat mocks base method.
 func (m *MockMatchingServiceServer) RecordWorkerHeartbeat(arg0 context.Context, arg1 *matchingservice.RecordWorkerHeartbeatRequest) (*matchingservice.RecordWorkerHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -1391,6 +1551,21 @@ func (mr *MockMatchingServiceServerMockRecorder) UpdateFairnessState(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFairnessState", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateFairnessState), arg0, arg1)
 }
 
+// UpdateFaultInjection mocks base method.
+func (m *MockMatchingServiceServer) UpdateFaultInjection(arg0 context.Context, arg1 *matchingservice.UpdateFaultInjectionRequest) (*matchingservice.UpdateFaultInjectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFaultInjection", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateFaultInjectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFaultInjection indicates an expected call of UpdateFaultInjection.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateFaultInjection(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFaultInjection", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateFaultInjection), arg0, arg1)
+}
+
 // UpdateNexusEndpoint mocks base method.
 func (m *MockMatchingServiceServer) UpdateNexusEndpoint(arg0 context.Context, arg1 *matchingservice.UpdateNexusEndpointRequest) (*matchingservice.UpdateNexusEndpointResponse, error) {
 	m.ctrl.T.Helper()
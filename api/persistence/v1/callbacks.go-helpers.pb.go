@@ -0,0 +1,43 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package persistence
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal an object of type DeadLetteredCallback to the protobuf v3 wire format
+func (val *DeadLetteredCallback) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeadLetteredCallback from the protobuf v3 wire format
+func (val *DeadLetteredCallback) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeadLetteredCallback) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeadLetteredCallback values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeadLetteredCallback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeadLetteredCallback
+	switch t := that.(type) {
+	case *DeadLetteredCallback:
+		that1 = t
+	case DeadLetteredCallback:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -0,0 +1,301 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/persistence/v1/callbacks.proto
+
+package persistence
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "go.temporal.io/api/failure/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DeadLetteredCallback is a callback whose delivery was abandoned after a non-retryable failure or after the retry
+// policy was exhausted. It retains the last request sent so that it can be inspected and re-delivered by operators.
+type DeadLetteredCallback struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Business ID (e.g. workflow ID) and run ID of the execution the callback belongs to.
+	BusinessId string `protobuf:"bytes,2,opt,name=business_id,json=businessId,proto3" json:"business_id,omitempty"`
+	RunId      string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// ID of the request that attached the callback to the execution.
+	RequestId string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Outbound queue destination of the callback (the URL's scheme and host).
+	Destination string                            `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+	Request     *DeadLetteredCallback_HttpRequest `protobuf:"bytes,6,opt,name=request,proto3" json:"request,omitempty"`
+	// The attempt on which the callback was dead-lettered.
+	Attempt            int32                  `protobuf:"varint,7,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastAttemptFailure *v1.Failure            `protobuf:"bytes,8,opt,name=last_attempt_failure,json=lastAttemptFailure,proto3" json:"last_attempt_failure,omitempty"`
+	DeadLetteredTime   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=dead_lettered_time,json=deadLetteredTime,proto3" json:"dead_lettered_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeadLetteredCallback) Reset() {
+	*x = DeadLetteredCallback{}
+	mi := &file_temporal_server_api_persistence_v1_callbacks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetteredCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetteredCallback) ProtoMessage() {}
+
+func (x *DeadLetteredCallback) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_callbacks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetteredCallback.ProtoReflect.Descriptor instead.
+func (*DeadLetteredCallback) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_callbacks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeadLetteredCallback) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *DeadLetteredCallback) GetBusinessId() string {
+	if x != nil {
+		return x.BusinessId
+	}
+	return ""
+}
+
+func (x *DeadLetteredCallback) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *DeadLetteredCallback) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *DeadLetteredCallback) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *DeadLetteredCallback) GetRequest() *DeadLetteredCallback_HttpRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *DeadLetteredCallback) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *DeadLetteredCallback) GetLastAttemptFailure() *v1.Failure {
+	if x != nil {
+		return x.LastAttemptFailure
+	}
+	return nil
+}
+
+func (x *DeadLetteredCallback) GetDeadLetteredTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadLetteredTime
+	}
+	return nil
+}
+
+// The HTTP request that was sent to the callback destination.
+type DeadLetteredCallback_HttpRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Method string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url    string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Headers of the request, excluding the redacted ones.
+	Header map[string]string `protobuf:"bytes,3,rep,name=header,proto3" json:"header,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body   []byte            `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// Names of the headers that carried credentials and were not stored. They are not sent on re-delivery.
+	RedactedHeaders []string `protobuf:"bytes,5,rep,name=redacted_headers,json=redactedHeaders,proto3" json:"redacted_headers,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeadLetteredCallback_HttpRequest) Reset() {
+	*x = DeadLetteredCallback_HttpRequest{}
+	mi := &file_temporal_server_api_persistence_v1_callbacks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetteredCallback_HttpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetteredCallback_HttpRequest) ProtoMessage() {}
+
+func (x *DeadLetteredCallback_HttpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_callbacks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetteredCallback_HttpRequest.ProtoReflect.Descriptor instead.
+func (*DeadLetteredCallback_HttpRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_callbacks_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *DeadLetteredCallback_HttpRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *DeadLetteredCallback_HttpRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DeadLetteredCallback_HttpRequest) GetHeader() map[string]string {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *DeadLetteredCallback_HttpRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *DeadLetteredCallback_HttpRequest) GetRedactedHeaders() []string {
+	if x != nil {
+		return x.RedactedHeaders
+	}
+	return nil
+}
+
+var File_temporal_server_api_persistence_v1_callbacks_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_persistence_v1_callbacks_proto_rawDesc = "" +
+	"\n" +
+	"2temporal/server/api/persistence/v1/callbacks.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%temporal/api/failure/v1/message.proto\"\xe8\x05\n" +
+	"\x14DeadLetteredCallback\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
+	"\vbusiness_id\x18\x02 \x01(\tR\n" +
+	"businessId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\x12 \n" +
+	"\vdestination\x18\x05 \x01(\tR\vdestination\x12^\n" +
+	"\arequest\x18\x06 \x01(\v2D.temporal.server.api.persistence.v1.DeadLetteredCallback.HttpRequestR\arequest\x12\x18\n" +
+	"\aattempt\x18\a \x01(\x05R\aattempt\x12R\n" +
+	"\x14last_attempt_failure\x18\b \x01(\v2 .temporal.api.failure.v1.FailureR\x12lastAttemptFailure\x12H\n" +
+	"\x12dead_lettered_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x10deadLetteredTime\x1a\x9b\x02\n" +
+	"\vHttpRequest\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12h\n" +
+	"\x06header\x18\x03 \x03(\v2P.temporal.server.api.persistence.v1.DeadLetteredCallback.HttpRequest.HeaderEntryR\x06header\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\fR\x04body\x12)\n" +
+	"\x10redacted_headers\x18\x05 \x03(\tR\x0fredactedHeaders\x1a9\n" +
+	"\vHeaderEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
+
+var (
+	file_temporal_server_api_persistence_v1_callbacks_proto_rawDescOnce sync.Once
+	file_temporal_server_api_persistence_v1_callbacks_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_persistence_v1_callbacks_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_persistence_v1_callbacks_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_persistence_v1_callbacks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_callbacks_proto_rawDesc), len(file_temporal_server_api_persistence_v1_callbacks_proto_rawDesc)))
+	})
+	return file_temporal_server_api_persistence_v1_callbacks_proto_rawDescData
+}
+
+var file_temporal_server_api_persistence_v1_callbacks_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_temporal_server_api_persistence_v1_callbacks_proto_goTypes = []any{
+	(*DeadLetteredCallback)(nil),             // 0: temporal.server.api.persistence.v1.DeadLetteredCallback
+	(*DeadLetteredCallback_HttpRequest)(nil), // 1: temporal.server.api.persistence.v1.DeadLetteredCallback.HttpRequest
+	nil,                                      // 2: temporal.server.api.persistence.v1.DeadLetteredCallback.HttpRequest.HeaderEntry
+	(*v1.Failure)(nil),                       // 3: temporal.api.failure.v1.Failure
+	(*timestamppb.Timestamp)(nil),            // 4: google.protobuf.Timestamp
+}
+var file_temporal_server_api_persistence_v1_callbacks_proto_depIdxs = []int32{
+	1, // 0: temporal.server.api.persistence.v1.DeadLetteredCallback.request:type_name -> temporal.server.api.persistence.v1.DeadLetteredCallback.HttpRequest
+	3, // 1: temporal.server.api.persistence.v1.DeadLetteredCallback.last_attempt_failure:type_name -> temporal.api.failure.v1.Failure
+	4, // 2: temporal.server.api.persistence.v1.DeadLetteredCallback.dead_lettered_time:type_name -> google.protobuf.Timestamp
+	2, // 3: temporal.server.api.persistence.v1.DeadLetteredCallback.HttpRequest.header:type_name -> temporal.server.api.persistence.v1.DeadLetteredCallback.HttpRequest.HeaderEntry
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_persistence_v1_callbacks_proto_init() }
+func file_temporal_server_api_persistence_v1_callbacks_proto_init() {
+	if File_temporal_server_api_persistence_v1_callbacks_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_callbacks_proto_rawDesc), len(file_temporal_server_api_persistence_v1_callbacks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_persistence_v1_callbacks_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_persistence_v1_callbacks_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_persistence_v1_callbacks_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_persistence_v1_callbacks_proto = out.File
+	file_temporal_server_api_persistence_v1_callbacks_proto_goTypes = nil
+	file_temporal_server_api_persistence_v1_callbacks_proto_depIdxs = nil
+}
@@ -190,6 +190,43 @@ func (this *LastNotifiedTargetVersion) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type CachedQueryResult to the protobuf v3 wire format
+func (val *CachedQueryResult) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CachedQueryResult from the protobuf v3 wire format
+func (val *CachedQueryResult) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CachedQueryResult) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CachedQueryResult values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CachedQueryResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CachedQueryResult
+	switch t := that.(type) {
+	case *CachedQueryResult:
+		that1 = t
+	case CachedQueryResult:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ExecutionStats to the protobuf v3 wire format
 func (val *ExecutionStats) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
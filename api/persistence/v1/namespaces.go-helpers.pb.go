@@ -116,6 +116,228 @@ func (this *NamespaceConfig) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type WorkflowRuleExtension to the protobuf v3 wire format
+func (val *WorkflowRuleExtension) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkflowRuleExtension from the protobuf v3 wire format
+func (val *WorkflowRuleExtension) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkflowRuleExtension) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkflowRuleExtension values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkflowRuleExtension) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkflowRuleExtension
+	switch t := that.(type) {
+	case *WorkflowRuleExtension:
+		that1 = t
+	case WorkflowRuleExtension:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkflowRuleExtensionAction to the protobuf v3 wire format
+func (val *WorkflowRuleExtensionAction) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkflowRuleExtensionAction from the protobuf v3 wire format
+func (val *WorkflowRuleExtensionAction) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkflowRuleExtensionAction) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkflowRuleExtensionAction values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkflowRuleExtensionAction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkflowRuleExtensionAction
+	switch t := that.(type) {
+	case *WorkflowRuleExtensionAction:
+		that1 = t
+	case WorkflowRuleExtensionAction:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CallbackSigningKey to the protobuf v3 wire format
+func (val *CallbackSigningKey) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CallbackSigningKey from the protobuf v3 wire format
+func (val *CallbackSigningKey) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CallbackSigningKey) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CallbackSigningKey values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CallbackSigningKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CallbackSigningKey
+	switch t := that.(type) {
+	case *CallbackSigningKey:
+		that1 = t
+	case CallbackSigningKey:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type OutboundCircuitBreakerTrip to the protobuf v3 wire format
+func (val *OutboundCircuitBreakerTrip) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type OutboundCircuitBreakerTrip from the protobuf v3 wire format
+func (val *OutboundCircuitBreakerTrip) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *OutboundCircuitBreakerTrip) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two OutboundCircuitBreakerTrip values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *OutboundCircuitBreakerTrip) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *OutboundCircuitBreakerTrip
+	switch t := that.(type) {
+	case *OutboundCircuitBreakerTrip:
+		that1 = t
+	case OutboundCircuitBreakerTrip:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SearchAttributeAliasTransition to the protobuf v3 wire format
+func (val *SearchAttributeAliasTransition) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SearchAttributeAliasTransition from the protobuf v3 wire format
+func (val *SearchAttributeAliasTransition) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SearchAttributeAliasTransition) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SearchAttributeAliasTransition values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SearchAttributeAliasTransition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SearchAttributeAliasTransition
+	switch t := that.(type) {
+	case *SearchAttributeAliasTransition:
+		that1 = t
+	case SearchAttributeAliasTransition:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SearchAttributeTypeMigration to the protobuf v3 wire format
+func (val *SearchAttributeTypeMigration) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SearchAttributeTypeMigration from the protobuf v3 wire format
+func (val *SearchAttributeTypeMigration) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SearchAttributeTypeMigration) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SearchAttributeTypeMigration values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SearchAttributeTypeMigration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SearchAttributeTypeMigration
+	switch t := that.(type) {
+	case *SearchAttributeTypeMigration:
+		that1 = t
+	case SearchAttributeTypeMigration:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NamespaceReplicationConfig to the protobuf v3 wire format
 func (val *NamespaceReplicationConfig) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
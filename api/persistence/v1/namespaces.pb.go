@@ -11,6 +11,7 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	v13 "go.temporal.io/api/common/v1"
 	v1 "go.temporal.io/api/enums/v1"
 	v11 "go.temporal.io/api/namespace/v1"
 	v12 "go.temporal.io/api/rules/v1"
@@ -215,8 +216,21 @@ type NamespaceConfig struct {
 	VisibilityArchivalUri        string                       `protobuf:"bytes,7,opt,name=visibility_archival_uri,json=visibilityArchivalUri,proto3" json:"visibility_archival_uri,omitempty"`
 	CustomSearchAttributeAliases map[string]string            `protobuf:"bytes,8,rep,name=custom_search_attribute_aliases,json=customSearchAttributeAliases,proto3" json:"custom_search_attribute_aliases,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	WorkflowRules                map[string]*v12.WorkflowRule `protobuf:"bytes,9,rep,name=workflow_rules,json=workflowRules,proto3" json:"workflow_rules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+	// Keys used to sign outbound completion callbacks. Keys are local to the cluster and are not replicated.
+	CallbackSigningKeys []*CallbackSigningKey `protobuf:"bytes,10,rep,name=callback_signing_keys,json=callbackSigningKeys,proto3" json:"callback_signing_keys,omitempty"`
+	// Previous aliases of renamed custom search attributes, keyed by the previous alias. Queries keep accepting a
+	// previous alias until its transition window expires. Transitions are replicated along with the aliases.
+	SearchAttributeAliasTransitions map[string]*SearchAttributeAliasTransition `protobuf:"bytes,11,rep,name=search_attribute_alias_transitions,json=searchAttributeAliasTransitions,proto3" json:"search_attribute_alias_transitions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Type migrations of custom search attributes, keyed by the field name the search attribute is migrated from.
+	// Migrations are local to the cluster and are not replicated.
+	SearchAttributeTypeMigrations map[string]*SearchAttributeTypeMigration `protobuf:"bytes,12,rep,name=search_attribute_type_migrations,json=searchAttributeTypeMigrations,proto3" json:"search_attribute_type_migrations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Outbound queue circuit breakers tripped by an operator. Trips are local to the cluster and are not replicated.
+	OutboundCircuitBreakerTrips []*OutboundCircuitBreakerTrip `protobuf:"bytes,13,rep,name=outbound_circuit_breaker_trips,json=outboundCircuitBreakerTrips,proto3" json:"outbound_circuit_breaker_trips,omitempty"`
+	// Server side triggers and actions of workflow rules, keyed by rule ID. The spec of a rule with an extension has
+	// neither a trigger nor actions.
+	WorkflowRuleExtensions map[string]*WorkflowRuleExtension `protobuf:"bytes,14,rep,name=workflow_rule_extensions,json=workflowRuleExtensions,proto3" json:"workflow_rule_extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *NamespaceConfig) Reset() {
@@ -312,6 +326,555 @@ func (x *NamespaceConfig) GetWorkflowRules() map[string]*v12.WorkflowRule {
 	return nil
 }
 
+func (x *NamespaceConfig) GetCallbackSigningKeys() []*CallbackSigningKey {
+	if x != nil {
+		return x.CallbackSigningKeys
+	}
+	return nil
+}
+
+func (x *NamespaceConfig) GetSearchAttributeAliasTransitions() map[string]*SearchAttributeAliasTransition {
+	if x != nil {
+		return x.SearchAttributeAliasTransitions
+	}
+	return nil
+}
+
+func (x *NamespaceConfig) GetSearchAttributeTypeMigrations() map[string]*SearchAttributeTypeMigration {
+	if x != nil {
+		return x.SearchAttributeTypeMigrations
+	}
+	return nil
+}
+
+func (x *NamespaceConfig) GetOutboundCircuitBreakerTrips() []*OutboundCircuitBreakerTrip {
+	if x != nil {
+		return x.OutboundCircuitBreakerTrips
+	}
+	return nil
+}
+
+func (x *NamespaceConfig) GetWorkflowRuleExtensions() map[string]*WorkflowRuleExtension {
+	if x != nil {
+		return x.WorkflowRuleExtensions
+	}
+	return nil
+}
+
+// WorkflowRuleExtension holds the triggers and actions of a workflow rule which are evaluated by the history service
+// and are not part of the public rule spec. The visibility query and expiration time of the spec still apply.
+type WorkflowRuleExtension struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Trigger:
+	//
+	//	*WorkflowRuleExtension_WorkflowTaskFailed
+	//	*WorkflowRuleExtension_TimerFired
+	//	*WorkflowRuleExtension_SignalReceived
+	//	*WorkflowRuleExtension_HistorySize
+	Trigger       isWorkflowRuleExtension_Trigger `protobuf_oneof:"trigger"`
+	Actions       []*WorkflowRuleExtensionAction  `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRuleExtension) Reset() {
+	*x = WorkflowRuleExtension{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRuleExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRuleExtension) ProtoMessage() {}
+
+func (x *WorkflowRuleExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRuleExtension.ProtoReflect.Descriptor instead.
+func (*WorkflowRuleExtension) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WorkflowRuleExtension) GetTrigger() isWorkflowRuleExtension_Trigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+func (x *WorkflowRuleExtension) GetWorkflowTaskFailed() *WorkflowRuleExtension_WorkflowTaskFailedTrigger {
+	if x != nil {
+		if x, ok := x.Trigger.(*WorkflowRuleExtension_WorkflowTaskFailed); ok {
+			return x.WorkflowTaskFailed
+		}
+	}
+	return nil
+}
+
+func (x *WorkflowRuleExtension) GetTimerFired() *WorkflowRuleExtension_TimerFiredTrigger {
+	if x != nil {
+		if x, ok := x.Trigger.(*WorkflowRuleExtension_TimerFired); ok {
+			return x.TimerFired
+		}
+	}
+	return nil
+}
+
+func (x *WorkflowRuleExtension) GetSignalReceived() *WorkflowRuleExtension_SignalReceivedTrigger {
+	if x != nil {
+		if x, ok := x.Trigger.(*WorkflowRuleExtension_SignalReceived); ok {
+			return x.SignalReceived
+		}
+	}
+	return nil
+}
+
+func (x *WorkflowRuleExtension) GetHistorySize() *WorkflowRuleExtension_HistorySizeTrigger {
+	if x != nil {
+		if x, ok := x.Trigger.(*WorkflowRuleExtension_HistorySize); ok {
+			return x.HistorySize
+		}
+	}
+	return nil
+}
+
+func (x *WorkflowRuleExtension) GetActions() []*WorkflowRuleExtensionAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type isWorkflowRuleExtension_Trigger interface {
+	isWorkflowRuleExtension_Trigger()
+}
+
+type WorkflowRuleExtension_WorkflowTaskFailed struct {
+	WorkflowTaskFailed *WorkflowRuleExtension_WorkflowTaskFailedTrigger `protobuf:"bytes,1,opt,name=workflow_task_failed,json=workflowTaskFailed,proto3,oneof"`
+}
+
+type WorkflowRuleExtension_TimerFired struct {
+	TimerFired *WorkflowRuleExtension_TimerFiredTrigger `protobuf:"bytes,2,opt,name=timer_fired,json=timerFired,proto3,oneof"`
+}
+
+type WorkflowRuleExtension_SignalReceived struct {
+	SignalReceived *WorkflowRuleExtension_SignalReceivedTrigger `protobuf:"bytes,3,opt,name=signal_received,json=signalReceived,proto3,oneof"`
+}
+
+type WorkflowRuleExtension_HistorySize struct {
+	HistorySize *WorkflowRuleExtension_HistorySizeTrigger `protobuf:"bytes,4,opt,name=history_size,json=historySize,proto3,oneof"`
+}
+
+func (*WorkflowRuleExtension_WorkflowTaskFailed) isWorkflowRuleExtension_Trigger() {}
+
+func (*WorkflowRuleExtension_TimerFired) isWorkflowRuleExtension_Trigger() {}
+
+func (*WorkflowRuleExtension_SignalReceived) isWorkflowRuleExtension_Trigger() {}
+
+func (*WorkflowRuleExtension_HistorySize) isWorkflowRuleExtension_Trigger() {}
+
+type WorkflowRuleExtensionAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Variant:
+	//
+	//	*WorkflowRuleExtensionAction_PauseWorkflow
+	//	*WorkflowRuleExtensionAction_Signal
+	//	*WorkflowRuleExtensionAction_Metric
+	//	*WorkflowRuleExtensionAction_MemoNote
+	Variant       isWorkflowRuleExtensionAction_Variant `protobuf_oneof:"variant"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRuleExtensionAction) Reset() {
+	*x = WorkflowRuleExtensionAction{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRuleExtensionAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRuleExtensionAction) ProtoMessage() {}
+
+func (x *WorkflowRuleExtensionAction) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRuleExtensionAction.ProtoReflect.Descriptor instead.
+func (*WorkflowRuleExtensionAction) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WorkflowRuleExtensionAction) GetVariant() isWorkflowRuleExtensionAction_Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+func (x *WorkflowRuleExtensionAction) GetPauseWorkflow() *WorkflowRuleExtensionAction_PauseWorkflowAction {
+	if x != nil {
+		if x, ok := x.Variant.(*WorkflowRuleExtensionAction_PauseWorkflow); ok {
+			return x.PauseWorkflow
+		}
+	}
+	return nil
+}
+
+func (x *WorkflowRuleExtensionAction) GetSignal() *WorkflowRuleExtensionAction_SignalAction {
+	if x != nil {
+		if x, ok := x.Variant.(*WorkflowRuleExtensionAction_Signal); ok {
+			return x.Signal
+		}
+	}
+	return nil
+}
+
+func (x *WorkflowRuleExtensionAction) GetMetric() *WorkflowRuleExtensionAction_MetricAction {
+	if x != nil {
+		if x, ok := x.Variant.(*WorkflowRuleExtensionAction_Metric); ok {
+			return x.Metric
+		}
+	}
+	return nil
+}
+
+func (x *WorkflowRuleExtensionAction) GetMemoNote() *WorkflowRuleExtensionAction_MemoNoteAction {
+	if x != nil {
+		if x, ok := x.Variant.(*WorkflowRuleExtensionAction_MemoNote); ok {
+			return x.MemoNote
+		}
+	}
+	return nil
+}
+
+type isWorkflowRuleExtensionAction_Variant interface {
+	isWorkflowRuleExtensionAction_Variant()
+}
+
+type WorkflowRuleExtensionAction_PauseWorkflow struct {
+	PauseWorkflow *WorkflowRuleExtensionAction_PauseWorkflowAction `protobuf:"bytes,1,opt,name=pause_workflow,json=pauseWorkflow,proto3,oneof"`
+}
+
+type WorkflowRuleExtensionAction_Signal struct {
+	Signal *WorkflowRuleExtensionAction_SignalAction `protobuf:"bytes,2,opt,name=signal,proto3,oneof"`
+}
+
+type WorkflowRuleExtensionAction_Metric struct {
+	Metric *WorkflowRuleExtensionAction_MetricAction `protobuf:"bytes,3,opt,name=metric,proto3,oneof"`
+}
+
+type WorkflowRuleExtensionAction_MemoNote struct {
+	MemoNote *WorkflowRuleExtensionAction_MemoNoteAction `protobuf:"bytes,4,opt,name=memo_note,json=memoNote,proto3,oneof"`
+}
+
+func (*WorkflowRuleExtensionAction_PauseWorkflow) isWorkflowRuleExtensionAction_Variant() {}
+
+func (*WorkflowRuleExtensionAction_Signal) isWorkflowRuleExtensionAction_Variant() {}
+
+func (*WorkflowRuleExtensionAction_Metric) isWorkflowRuleExtensionAction_Variant() {}
+
+func (*WorkflowRuleExtensionAction_MemoNote) isWorkflowRuleExtensionAction_Variant() {}
+
+// CallbackSigningKey is an HMAC key used to sign the outbound completion callbacks of a namespace.
+type CallbackSigningKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the key in the signature header so that receivers can select the matching secret.
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Secret     []byte                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Time after which the key no longer signs callbacks. Unset for the current key. Previous keys keep signing
+	// alongside the current key until they expire so that receivers can switch over without rejecting callbacks.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallbackSigningKey) Reset() {
+	*x = CallbackSigningKey{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallbackSigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallbackSigningKey) ProtoMessage() {}
+
+func (x *CallbackSigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallbackSigningKey.ProtoReflect.Descriptor instead.
+func (*CallbackSigningKey) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CallbackSigningKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CallbackSigningKey) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *CallbackSigningKey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *CallbackSigningKey) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// OutboundCircuitBreakerTrip keeps the outbound queue circuit breakers of a destination open until it is removed.
+type OutboundCircuitBreakerTrip struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Task group of the circuit breakers. Empty matches all task groups.
+	TaskGroup     string                 `protobuf:"bytes,1,opt,name=task_group,json=taskGroup,proto3" json:"task_group,omitempty"`
+	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutboundCircuitBreakerTrip) Reset() {
+	*x = OutboundCircuitBreakerTrip{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboundCircuitBreakerTrip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboundCircuitBreakerTrip) ProtoMessage() {}
+
+func (x *OutboundCircuitBreakerTrip) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboundCircuitBreakerTrip.ProtoReflect.Descriptor instead.
+func (*OutboundCircuitBreakerTrip) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OutboundCircuitBreakerTrip) GetTaskGroup() string {
+	if x != nil {
+		return x.TaskGroup
+	}
+	return ""
+}
+
+func (x *OutboundCircuitBreakerTrip) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *OutboundCircuitBreakerTrip) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type SearchAttributeAliasTransition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Field name the previous alias is mapped to.
+	FieldName     string                 `protobuf:"bytes,1,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAttributeAliasTransition) Reset() {
+	*x = SearchAttributeAliasTransition{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAttributeAliasTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAttributeAliasTransition) ProtoMessage() {}
+
+func (x *SearchAttributeAliasTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAttributeAliasTransition.ProtoReflect.Descriptor instead.
+func (*SearchAttributeAliasTransition) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchAttributeAliasTransition) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *SearchAttributeAliasTransition) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// SearchAttributeTypeMigration moves the values of a custom search attribute to a field of another type. While the
+// visibility records are rewritten, the alias stays mapped to the source field and visibility records are written with
+// both fields. Once all records are rewritten, the alias is mapped to the target field. Executions keep the source
+// field in their mutable state, so the migration is kept after it completes to keep converting their values.
+type SearchAttributeTypeMigration struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Alias           string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	SourceType      v1.IndexedValueType    `protobuf:"varint,2,opt,name=source_type,json=sourceType,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"source_type,omitempty"`
+	TargetFieldName string                 `protobuf:"bytes,3,opt,name=target_field_name,json=targetFieldName,proto3" json:"target_field_name,omitempty"`
+	TargetType      v1.IndexedValueType    `protobuf:"varint,4,opt,name=target_type,json=targetType,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"target_type,omitempty"`
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Set once the alias is mapped to the target field.
+	CompleteTime  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=complete_time,json=completeTime,proto3" json:"complete_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAttributeTypeMigration) Reset() {
+	*x = SearchAttributeTypeMigration{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAttributeTypeMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAttributeTypeMigration) ProtoMessage() {}
+
+func (x *SearchAttributeTypeMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAttributeTypeMigration.ProtoReflect.Descriptor instead.
+func (*SearchAttributeTypeMigration) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchAttributeTypeMigration) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *SearchAttributeTypeMigration) GetSourceType() v1.IndexedValueType {
+	if x != nil {
+		return x.SourceType
+	}
+	return v1.IndexedValueType(0)
+}
+
+func (x *SearchAttributeTypeMigration) GetTargetFieldName() string {
+	if x != nil {
+		return x.TargetFieldName
+	}
+	return ""
+}
+
+func (x *SearchAttributeTypeMigration) GetTargetType() v1.IndexedValueType {
+	if x != nil {
+		return x.TargetType
+	}
+	return v1.IndexedValueType(0)
+}
+
+func (x *SearchAttributeTypeMigration) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SearchAttributeTypeMigration) GetCompleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompleteTime
+	}
+	return nil
+}
+
 type NamespaceReplicationConfig struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	ActiveClusterName string                 `protobuf:"bytes,1,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
@@ -324,7 +887,7 @@ type NamespaceReplicationConfig struct {
 
 func (x *NamespaceReplicationConfig) Reset() {
 	*x = NamespaceReplicationConfig{}
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -336,7 +899,7 @@ func (x *NamespaceReplicationConfig) String() string {
 func (*NamespaceReplicationConfig) ProtoMessage() {}
 
 func (x *NamespaceReplicationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +912,7 @@ func (x *NamespaceReplicationConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespaceReplicationConfig.ProtoReflect.Descriptor instead.
 func (*NamespaceReplicationConfig) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{3}
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NamespaceReplicationConfig) GetActiveClusterName() string {
@@ -391,7 +954,7 @@ type FailoverStatus struct {
 
 func (x *FailoverStatus) Reset() {
 	*x = FailoverStatus{}
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +966,7 @@ func (x *FailoverStatus) String() string {
 func (*FailoverStatus) ProtoMessage() {}
 
 func (x *FailoverStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +979,7 @@ func (x *FailoverStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FailoverStatus.ProtoReflect.Descriptor instead.
 func (*FailoverStatus) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{4}
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *FailoverStatus) GetFailoverTime() *timestamppb.Timestamp {
@@ -433,11 +996,387 @@ func (x *FailoverStatus) GetFailoverVersion() int64 {
 	return 0
 }
 
+// Matches a failed workflow task. Unspecified cause matches all causes.
+type WorkflowRuleExtension_WorkflowTaskFailedTrigger struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Cause         v1.WorkflowTaskFailedCause `protobuf:"varint,1,opt,name=cause,proto3,enum=temporal.api.enums.v1.WorkflowTaskFailedCause" json:"cause,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRuleExtension_WorkflowTaskFailedTrigger) Reset() {
+	*x = WorkflowRuleExtension_WorkflowTaskFailedTrigger{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRuleExtension_WorkflowTaskFailedTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRuleExtension_WorkflowTaskFailedTrigger) ProtoMessage() {}
+
+func (x *WorkflowRuleExtension_WorkflowTaskFailedTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRuleExtension_WorkflowTaskFailedTrigger.ProtoReflect.Descriptor instead.
+func (*WorkflowRuleExtension_WorkflowTaskFailedTrigger) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *WorkflowRuleExtension_WorkflowTaskFailedTrigger) GetCause() v1.WorkflowTaskFailedCause {
+	if x != nil {
+		return x.Cause
+	}
+	return v1.WorkflowTaskFailedCause(0)
+}
+
+// Matches a fired timer. Empty timer ID matches all timers.
+type WorkflowRuleExtension_TimerFiredTrigger struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimerId       string                 `protobuf:"bytes,1,opt,name=timer_id,json=timerId,proto3" json:"timer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRuleExtension_TimerFiredTrigger) Reset() {
+	*x = WorkflowRuleExtension_TimerFiredTrigger{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRuleExtension_TimerFiredTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRuleExtension_TimerFiredTrigger) ProtoMessage() {}
+
+func (x *WorkflowRuleExtension_TimerFiredTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRuleExtension_TimerFiredTrigger.ProtoReflect.Descriptor instead.
+func (*WorkflowRuleExtension_TimerFiredTrigger) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *WorkflowRuleExtension_TimerFiredTrigger) GetTimerId() string {
+	if x != nil {
+		return x.TimerId
+	}
+	return ""
+}
+
+// Matches a received signal.
+type WorkflowRuleExtension_SignalReceivedTrigger struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SignalName    string                 `protobuf:"bytes,1,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRuleExtension_SignalReceivedTrigger) Reset() {
+	*x = WorkflowRuleExtension_SignalReceivedTrigger{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRuleExtension_SignalReceivedTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRuleExtension_SignalReceivedTrigger) ProtoMessage() {}
+
+func (x *WorkflowRuleExtension_SignalReceivedTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRuleExtension_SignalReceivedTrigger.ProtoReflect.Descriptor instead.
+func (*WorkflowRuleExtension_SignalReceivedTrigger) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *WorkflowRuleExtension_SignalReceivedTrigger) GetSignalName() string {
+	if x != nil {
+		return x.SignalName
+	}
+	return ""
+}
+
+// Matches once when the history size of the workflow reaches the threshold.
+type WorkflowRuleExtension_HistorySizeTrigger struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ThresholdBytes int64                  `protobuf:"varint,1,opt,name=threshold_bytes,json=thresholdBytes,proto3" json:"threshold_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkflowRuleExtension_HistorySizeTrigger) Reset() {
+	*x = WorkflowRuleExtension_HistorySizeTrigger{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRuleExtension_HistorySizeTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRuleExtension_HistorySizeTrigger) ProtoMessage() {}
+
+func (x *WorkflowRuleExtension_HistorySizeTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRuleExtension_HistorySizeTrigger.ProtoReflect.Descriptor instead.
+func (*WorkflowRuleExtension_HistorySizeTrigger) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{3, 3}
+}
+
+func (x *WorkflowRuleExtension_HistorySizeTrigger) GetThresholdBytes() int64 {
+	if x != nil {
+		return x.ThresholdBytes
+	}
+	return 0
+}
+
+// Pauses the matched workflow.
+type WorkflowRuleExtensionAction_PauseWorkflowAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRuleExtensionAction_PauseWorkflowAction) Reset() {
+	*x = WorkflowRuleExtensionAction_PauseWorkflowAction{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRuleExtensionAction_PauseWorkflowAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRuleExtensionAction_PauseWorkflowAction) ProtoMessage() {}
+
+func (x *WorkflowRuleExtensionAction_PauseWorkflowAction) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRuleExtensionAction_PauseWorkflowAction.ProtoReflect.Descriptor instead.
+func (*WorkflowRuleExtensionAction_PauseWorkflowAction) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *WorkflowRuleExtensionAction_PauseWorkflowAction) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Sends a signal to the matched workflow.
+type WorkflowRuleExtensionAction_SignalAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SignalName    string                 `protobuf:"bytes,1,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	Input         *v13.Payloads          `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRuleExtensionAction_SignalAction) Reset() {
+	*x = WorkflowRuleExtensionAction_SignalAction{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRuleExtensionAction_SignalAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRuleExtensionAction_SignalAction) ProtoMessage() {}
+
+func (x *WorkflowRuleExtensionAction_SignalAction) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRuleExtensionAction_SignalAction.ProtoReflect.Descriptor instead.
+func (*WorkflowRuleExtensionAction_SignalAction) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *WorkflowRuleExtensionAction_SignalAction) GetSignalName() string {
+	if x != nil {
+		return x.SignalName
+	}
+	return ""
+}
+
+func (x *WorkflowRuleExtensionAction_SignalAction) GetInput() *v13.Payloads {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Increments the workflow_rule_action_metric counter, tagged with the metric name.
+type WorkflowRuleExtensionAction_MetricAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRuleExtensionAction_MetricAction) Reset() {
+	*x = WorkflowRuleExtensionAction_MetricAction{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRuleExtensionAction_MetricAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRuleExtensionAction_MetricAction) ProtoMessage() {}
+
+func (x *WorkflowRuleExtensionAction_MetricAction) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRuleExtensionAction_MetricAction.ProtoReflect.Descriptor instead.
+func (*WorkflowRuleExtensionAction_MetricAction) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *WorkflowRuleExtensionAction_MetricAction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Sets a memo field of the matched workflow to the note.
+type WorkflowRuleExtensionAction_MemoNoteAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRuleExtensionAction_MemoNoteAction) Reset() {
+	*x = WorkflowRuleExtensionAction_MemoNoteAction{}
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRuleExtensionAction_MemoNoteAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRuleExtensionAction_MemoNoteAction) ProtoMessage() {}
+
+func (x *WorkflowRuleExtensionAction_MemoNoteAction) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRuleExtensionAction_MemoNoteAction.ProtoReflect.Descriptor instead.
+func (*WorkflowRuleExtensionAction_MemoNoteAction) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *WorkflowRuleExtensionAction_MemoNoteAction) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *WorkflowRuleExtensionAction_MemoNoteAction) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
 var File_temporal_server_api_persistence_v1_namespaces_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc = "" +
 	"\n" +
-	"3temporal/server/api/persistence/v1/namespaces.proto\x12\"temporal.server.api.persistence.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%temporal/api/enums/v1/namespace.proto\x1a'temporal/api/namespace/v1/message.proto\x1a#temporal/api/rules/v1/message.proto\"\xf2\x03\n" +
+	"3temporal/server/api/persistence/v1/namespaces.proto\x12\"temporal.server.api.persistence.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a\"temporal/api/enums/v1/common.proto\x1a(temporal/api/enums/v1/failed_cause.proto\x1a%temporal/api/enums/v1/namespace.proto\x1a'temporal/api/namespace/v1/message.proto\x1a#temporal/api/rules/v1/message.proto\"\xf2\x03\n" +
 	"\x0fNamespaceDetail\x12E\n" +
 	"\x04info\x18\x01 \x01(\v21.temporal.server.api.persistence.v1.NamespaceInfoR\x04info\x12K\n" +
 	"\x06config\x18\x02 \x01(\v23.temporal.server.api.persistence.v1.NamespaceConfigR\x06config\x12m\n" +
@@ -455,7 +1394,7 @@ const file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc = "" +
 	"\x04data\x18\x06 \x03(\v2;.temporal.server.api.persistence.v1.NamespaceInfo.DataEntryR\x04data\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xac\a\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa9\x10\n" +
 	"\x0fNamespaceConfig\x127\n" +
 	"\tretention\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\tretention\x12'\n" +
 	"\x0farchival_bucket\x18\x02 \x01(\tR\x0earchivalBucket\x12I\n" +
@@ -465,13 +1404,90 @@ const file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc = "" +
 	"\x19visibility_archival_state\x18\x06 \x01(\x0e2$.temporal.api.enums.v1.ArchivalStateR\x17visibilityArchivalState\x126\n" +
 	"\x17visibility_archival_uri\x18\a \x01(\tR\x15visibilityArchivalUri\x12\x9c\x01\n" +
 	"\x1fcustom_search_attribute_aliases\x18\b \x03(\v2U.temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntryR\x1ccustomSearchAttributeAliases\x12m\n" +
-	"\x0eworkflow_rules\x18\t \x03(\v2F.temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntryR\rworkflowRules\x1aO\n" +
+	"\x0eworkflow_rules\x18\t \x03(\v2F.temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntryR\rworkflowRules\x12j\n" +
+	"\x15callback_signing_keys\x18\n" +
+	" \x03(\v26.temporal.server.api.persistence.v1.CallbackSigningKeyR\x13callbackSigningKeys\x12\xa5\x01\n" +
+	"\"search_attribute_alias_transitions\x18\v \x03(\v2X.temporal.server.api.persistence.v1.NamespaceConfig.SearchAttributeAliasTransitionsEntryR\x1fsearchAttributeAliasTransitions\x12\x9f\x01\n" +
+	" search_attribute_type_migrations\x18\f \x03(\v2V.temporal.server.api.persistence.v1.NamespaceConfig.SearchAttributeTypeMigrationsEntryR\x1dsearchAttributeTypeMigrations\x12\x83\x01\n" +
+	"\x1eoutbound_circuit_breaker_trips\x18\r \x03(\v2>.temporal.server.api.persistence.v1.OutboundCircuitBreakerTripR\x1boutboundCircuitBreakerTrips\x12\x89\x01\n" +
+	"\x18workflow_rule_extensions\x18\x0e \x03(\v2O.temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRuleExtensionsEntryR\x16workflowRuleExtensions\x1aO\n" +
 	"!CustomSearchAttributeAliasesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1ae\n" +
 	"\x12WorkflowRulesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
-	"\x05value\x18\x02 \x01(\v2#.temporal.api.rules.v1.WorkflowRuleR\x05value:\x028\x01\"\x86\x02\n" +
+	"\x05value\x18\x02 \x01(\v2#.temporal.api.rules.v1.WorkflowRuleR\x05value:\x028\x01\x1a\x96\x01\n" +
+	"$SearchAttributeAliasTransitionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12X\n" +
+	"\x05value\x18\x02 \x01(\v2B.temporal.server.api.persistence.v1.SearchAttributeAliasTransitionR\x05value:\x028\x01\x1a\x92\x01\n" +
+	"\"SearchAttributeTypeMigrationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12V\n" +
+	"\x05value\x18\x02 \x01(\v2@.temporal.server.api.persistence.v1.SearchAttributeTypeMigrationR\x05value:\x028\x01\x1a\x84\x01\n" +
+	"\x1bWorkflowRuleExtensionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12O\n" +
+	"\x05value\x18\x02 \x01(\v29.temporal.server.api.persistence.v1.WorkflowRuleExtensionR\x05value:\x028\x01\"\xf2\x06\n" +
+	"\x15WorkflowRuleExtension\x12\x87\x01\n" +
+	"\x14workflow_task_failed\x18\x01 \x01(\v2S.temporal.server.api.persistence.v1.WorkflowRuleExtension.WorkflowTaskFailedTriggerH\x00R\x12workflowTaskFailed\x12n\n" +
+	"\vtimer_fired\x18\x02 \x01(\v2K.temporal.server.api.persistence.v1.WorkflowRuleExtension.TimerFiredTriggerH\x00R\n" +
+	"timerFired\x12z\n" +
+	"\x0fsignal_received\x18\x03 \x01(\v2O.temporal.server.api.persistence.v1.WorkflowRuleExtension.SignalReceivedTriggerH\x00R\x0esignalReceived\x12q\n" +
+	"\fhistory_size\x18\x04 \x01(\v2L.temporal.server.api.persistence.v1.WorkflowRuleExtension.HistorySizeTriggerH\x00R\vhistorySize\x12Y\n" +
+	"\aactions\x18\x05 \x03(\v2?.temporal.server.api.persistence.v1.WorkflowRuleExtensionActionR\aactions\x1aa\n" +
+	"\x19WorkflowTaskFailedTrigger\x12D\n" +
+	"\x05cause\x18\x01 \x01(\x0e2..temporal.api.enums.v1.WorkflowTaskFailedCauseR\x05cause\x1a.\n" +
+	"\x11TimerFiredTrigger\x12\x19\n" +
+	"\btimer_id\x18\x01 \x01(\tR\atimerId\x1a8\n" +
+	"\x15SignalReceivedTrigger\x12\x1f\n" +
+	"\vsignal_name\x18\x01 \x01(\tR\n" +
+	"signalName\x1a=\n" +
+	"\x12HistorySizeTrigger\x12'\n" +
+	"\x0fthreshold_bytes\x18\x01 \x01(\x03R\x0ethresholdBytesB\t\n" +
+	"\atrigger\"\xd9\x05\n" +
+	"\x1bWorkflowRuleExtensionAction\x12|\n" +
+	"\x0epause_workflow\x18\x01 \x01(\v2S.temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.PauseWorkflowActionH\x00R\rpauseWorkflow\x12f\n" +
+	"\x06signal\x18\x02 \x01(\v2L.temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.SignalActionH\x00R\x06signal\x12f\n" +
+	"\x06metric\x18\x03 \x01(\v2L.temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.MetricActionH\x00R\x06metric\x12m\n" +
+	"\tmemo_note\x18\x04 \x01(\v2N.temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.MemoNoteActionH\x00R\bmemoNote\x1a-\n" +
+	"\x13PauseWorkflowAction\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x1ag\n" +
+	"\fSignalAction\x12\x1f\n" +
+	"\vsignal_name\x18\x01 \x01(\tR\n" +
+	"signalName\x126\n" +
+	"\x05input\x18\x02 \x01(\v2 .temporal.api.common.v1.PayloadsR\x05input\x1a\"\n" +
+	"\fMetricAction\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x1a6\n" +
+	"\x0eMemoNoteAction\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04noteB\t\n" +
+	"\avariant\"\xb6\x01\n" +
+	"\x12CallbackSigningKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\fR\x06secret\x12;\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vexpire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"\x9a\x01\n" +
+	"\x1aOutboundCircuitBreakerTrip\x12\x1d\n" +
+	"\n" +
+	"task_group\x18\x01 \x01(\tR\ttaskGroup\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12;\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"|\n" +
+	"\x1eSearchAttributeAliasTransition\x12\x1d\n" +
+	"\n" +
+	"field_name\x18\x01 \x01(\tR\tfieldName\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"\xf0\x02\n" +
+	"\x1cSearchAttributeTypeMigration\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\x12H\n" +
+	"\vsource_type\x18\x02 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\n" +
+	"sourceType\x12*\n" +
+	"\x11target_field_name\x18\x03 \x01(\tR\x0ftargetFieldName\x12H\n" +
+	"\vtarget_type\x18\x04 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\n" +
+	"targetType\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12?\n" +
+	"\rcomplete_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fcompleteTime\"\x86\x02\n" +
 	"\x1aNamespaceReplicationConfig\x12.\n" +
 	"\x13active_cluster_name\x18\x01 \x01(\tR\x11activeClusterName\x12\x1a\n" +
 	"\bclusters\x18\x02 \x03(\tR\bclusters\x12=\n" +
@@ -493,46 +1509,93 @@ func file_temporal_server_api_persistence_v1_namespaces_proto_rawDescGZIP() []by
 	return file_temporal_server_api_persistence_v1_namespaces_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_temporal_server_api_persistence_v1_namespaces_proto_goTypes = []any{
-	(*NamespaceDetail)(nil),            // 0: temporal.server.api.persistence.v1.NamespaceDetail
-	(*NamespaceInfo)(nil),              // 1: temporal.server.api.persistence.v1.NamespaceInfo
-	(*NamespaceConfig)(nil),            // 2: temporal.server.api.persistence.v1.NamespaceConfig
-	(*NamespaceReplicationConfig)(nil), // 3: temporal.server.api.persistence.v1.NamespaceReplicationConfig
-	(*FailoverStatus)(nil),             // 4: temporal.server.api.persistence.v1.FailoverStatus
-	nil,                                // 5: temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
-	nil,                                // 6: temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
-	nil,                                // 7: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
-	(v1.NamespaceState)(0),             // 9: temporal.api.enums.v1.NamespaceState
-	(*durationpb.Duration)(nil),        // 10: google.protobuf.Duration
-	(*v11.BadBinaries)(nil),            // 11: temporal.api.namespace.v1.BadBinaries
-	(v1.ArchivalState)(0),              // 12: temporal.api.enums.v1.ArchivalState
-	(v1.ReplicationState)(0),           // 13: temporal.api.enums.v1.ReplicationState
-	(*v12.WorkflowRule)(nil),           // 14: temporal.api.rules.v1.WorkflowRule
+	(*NamespaceDetail)(nil),                // 0: temporal.server.api.persistence.v1.NamespaceDetail
+	(*NamespaceInfo)(nil),                  // 1: temporal.server.api.persistence.v1.NamespaceInfo
+	(*NamespaceConfig)(nil),                // 2: temporal.server.api.persistence.v1.NamespaceConfig
+	(*WorkflowRuleExtension)(nil),          // 3: temporal.server.api.persistence.v1.WorkflowRuleExtension
+	(*WorkflowRuleExtensionAction)(nil),    // 4: temporal.server.api.persistence.v1.WorkflowRuleExtensionAction
+	(*CallbackSigningKey)(nil),             // 5: temporal.server.api.persistence.v1.CallbackSigningKey
+	(*OutboundCircuitBreakerTrip)(nil),     // 6: temporal.server.api.persistence.v1.OutboundCircuitBreakerTrip
+	(*SearchAttributeAliasTransition)(nil), // 7: temporal.server.api.persistence.v1.SearchAttributeAliasTransition
+	(*SearchAttributeTypeMigration)(nil),   // 8: temporal.server.api.persistence.v1.SearchAttributeTypeMigration
+	(*NamespaceReplicationConfig)(nil),     // 9: temporal.server.api.persistence.v1.NamespaceReplicationConfig
+	(*FailoverStatus)(nil),                 // 10: temporal.server.api.persistence.v1.FailoverStatus
+	nil,                                    // 11: temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
+	nil,                                    // 12: temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
+	nil,                                    // 13: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
+	nil,                                    // 14: temporal.server.api.persistence.v1.NamespaceConfig.SearchAttributeAliasTransitionsEntry
+	nil,                                    // 15: temporal.server.api.persistence.v1.NamespaceConfig.SearchAttributeTypeMigrationsEntry
+	nil,                                    // 16: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRuleExtensionsEntry
+	(*WorkflowRuleExtension_WorkflowTaskFailedTrigger)(nil), // 17: temporal.server.api.persistence.v1.WorkflowRuleExtension.WorkflowTaskFailedTrigger
+	(*WorkflowRuleExtension_TimerFiredTrigger)(nil),         // 18: temporal.server.api.persistence.v1.WorkflowRuleExtension.TimerFiredTrigger
+	(*WorkflowRuleExtension_SignalReceivedTrigger)(nil),     // 19: temporal.server.api.persistence.v1.WorkflowRuleExtension.SignalReceivedTrigger
+	(*WorkflowRuleExtension_HistorySizeTrigger)(nil),        // 20: temporal.server.api.persistence.v1.WorkflowRuleExtension.HistorySizeTrigger
+	(*WorkflowRuleExtensionAction_PauseWorkflowAction)(nil), // 21: temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.PauseWorkflowAction
+	(*WorkflowRuleExtensionAction_SignalAction)(nil),        // 22: temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.SignalAction
+	(*WorkflowRuleExtensionAction_MetricAction)(nil),        // 23: temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.MetricAction
+	(*WorkflowRuleExtensionAction_MemoNoteAction)(nil),      // 24: temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.MemoNoteAction
+	(*timestamppb.Timestamp)(nil),                           // 25: google.protobuf.Timestamp
+	(v1.NamespaceState)(0),                                  // 26: temporal.api.enums.v1.NamespaceState
+	(*durationpb.Duration)(nil),                             // 27: google.protobuf.Duration
+	(*v11.BadBinaries)(nil),                                 // 28: temporal.api.namespace.v1.BadBinaries
+	(v1.ArchivalState)(0),                                   // 29: temporal.api.enums.v1.ArchivalState
+	(v1.IndexedValueType)(0),                                // 30: temporal.api.enums.v1.IndexedValueType
+	(v1.ReplicationState)(0),                                // 31: temporal.api.enums.v1.ReplicationState
+	(*v12.WorkflowRule)(nil),                                // 32: temporal.api.rules.v1.WorkflowRule
+	(v1.WorkflowTaskFailedCause)(0),                         // 33: temporal.api.enums.v1.WorkflowTaskFailedCause
+	(*v13.Payloads)(nil),                                    // 34: temporal.api.common.v1.Payloads
 }
 var file_temporal_server_api_persistence_v1_namespaces_proto_depIdxs = []int32{
 	1,  // 0: temporal.server.api.persistence.v1.NamespaceDetail.info:type_name -> temporal.server.api.persistence.v1.NamespaceInfo
 	2,  // 1: temporal.server.api.persistence.v1.NamespaceDetail.config:type_name -> temporal.server.api.persistence.v1.NamespaceConfig
-	3,  // 2: temporal.server.api.persistence.v1.NamespaceDetail.replication_config:type_name -> temporal.server.api.persistence.v1.NamespaceReplicationConfig
-	8,  // 3: temporal.server.api.persistence.v1.NamespaceDetail.failover_end_time:type_name -> google.protobuf.Timestamp
-	9,  // 4: temporal.server.api.persistence.v1.NamespaceInfo.state:type_name -> temporal.api.enums.v1.NamespaceState
-	5,  // 5: temporal.server.api.persistence.v1.NamespaceInfo.data:type_name -> temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
-	10, // 6: temporal.server.api.persistence.v1.NamespaceConfig.retention:type_name -> google.protobuf.Duration
-	11, // 7: temporal.server.api.persistence.v1.NamespaceConfig.bad_binaries:type_name -> temporal.api.namespace.v1.BadBinaries
-	12, // 8: temporal.server.api.persistence.v1.NamespaceConfig.history_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
-	12, // 9: temporal.server.api.persistence.v1.NamespaceConfig.visibility_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
-	6,  // 10: temporal.server.api.persistence.v1.NamespaceConfig.custom_search_attribute_aliases:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
-	7,  // 11: temporal.server.api.persistence.v1.NamespaceConfig.workflow_rules:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
-	13, // 12: temporal.server.api.persistence.v1.NamespaceReplicationConfig.state:type_name -> temporal.api.enums.v1.ReplicationState
-	4,  // 13: temporal.server.api.persistence.v1.NamespaceReplicationConfig.failover_history:type_name -> temporal.server.api.persistence.v1.FailoverStatus
-	8,  // 14: temporal.server.api.persistence.v1.FailoverStatus.failover_time:type_name -> google.protobuf.Timestamp
-	14, // 15: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry.value:type_name -> temporal.api.rules.v1.WorkflowRule
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	9,  // 2: temporal.server.api.persistence.v1.NamespaceDetail.replication_config:type_name -> temporal.server.api.persistence.v1.NamespaceReplicationConfig
+	25, // 3: temporal.server.api.persistence.v1.NamespaceDetail.failover_end_time:type_name -> google.protobuf.Timestamp
+	26, // 4: temporal.server.api.persistence.v1.NamespaceInfo.state:type_name -> temporal.api.enums.v1.NamespaceState
+	11, // 5: temporal.server.api.persistence.v1.NamespaceInfo.data:type_name -> temporal.server.api.persistence.v1.NamespaceInfo.DataEntry
+	27, // 6: temporal.server.api.persistence.v1.NamespaceConfig.retention:type_name -> google.protobuf.Duration
+	28, // 7: temporal.server.api.persistence.v1.NamespaceConfig.bad_binaries:type_name -> temporal.api.namespace.v1.BadBinaries
+	29, // 8: temporal.server.api.persistence.v1.NamespaceConfig.history_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
+	29, // 9: temporal.server.api.persistence.v1.NamespaceConfig.visibility_archival_state:type_name -> temporal.api.enums.v1.ArchivalState
+	12, // 10: temporal.server.api.persistence.v1.NamespaceConfig.custom_search_attribute_aliases:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry
+	13, // 11: temporal.server.api.persistence.v1.NamespaceConfig.workflow_rules:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry
+	5,  // 12: temporal.server.api.persistence.v1.NamespaceConfig.callback_signing_keys:type_name -> temporal.server.api.persistence.v1.CallbackSigningKey
+	14, // 13: temporal.server.api.persistence.v1.NamespaceConfig.search_attribute_alias_transitions:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.SearchAttributeAliasTransitionsEntry
+	15, // 14: temporal.server.api.persistence.v1.NamespaceConfig.search_attribute_type_migrations:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.SearchAttributeTypeMigrationsEntry
+	6,  // 15: temporal.server.api.persistence.v1.NamespaceConfig.outbound_circuit_breaker_trips:type_name -> temporal.server.api.persistence.v1.OutboundCircuitBreakerTrip
+	16, // 16: temporal.server.api.persistence.v1.NamespaceConfig.workflow_rule_extensions:type_name -> temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRuleExtensionsEntry
+	17, // 17: temporal.server.api.persistence.v1.WorkflowRuleExtension.workflow_task_failed:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtension.WorkflowTaskFailedTrigger
+	18, // 18: temporal.server.api.persistence.v1.WorkflowRuleExtension.timer_fired:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtension.TimerFiredTrigger
+	19, // 19: temporal.server.api.persistence.v1.WorkflowRuleExtension.signal_received:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtension.SignalReceivedTrigger
+	20, // 20: temporal.server.api.persistence.v1.WorkflowRuleExtension.history_size:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtension.HistorySizeTrigger
+	4,  // 21: temporal.server.api.persistence.v1.WorkflowRuleExtension.actions:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtensionAction
+	21, // 22: temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.pause_workflow:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.PauseWorkflowAction
+	22, // 23: temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.signal:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.SignalAction
+	23, // 24: temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.metric:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.MetricAction
+	24, // 25: temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.memo_note:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.MemoNoteAction
+	25, // 26: temporal.server.api.persistence.v1.CallbackSigningKey.create_time:type_name -> google.protobuf.Timestamp
+	25, // 27: temporal.server.api.persistence.v1.CallbackSigningKey.expire_time:type_name -> google.protobuf.Timestamp
+	25, // 28: temporal.server.api.persistence.v1.OutboundCircuitBreakerTrip.create_time:type_name -> google.protobuf.Timestamp
+	25, // 29: temporal.server.api.persistence.v1.SearchAttributeAliasTransition.expire_time:type_name -> google.protobuf.Timestamp
+	30, // 30: temporal.server.api.persistence.v1.SearchAttributeTypeMigration.source_type:type_name -> temporal.api.enums.v1.IndexedValueType
+	30, // 31: temporal.server.api.persistence.v1.SearchAttributeTypeMigration.target_type:type_name -> temporal.api.enums.v1.IndexedValueType
+	25, // 32: temporal.server.api.persistence.v1.SearchAttributeTypeMigration.start_time:type_name -> google.protobuf.Timestamp
+	25, // 33: temporal.server.api.persistence.v1.SearchAttributeTypeMigration.complete_time:type_name -> google.protobuf.Timestamp
+	31, // 34: temporal.server.api.persistence.v1.NamespaceReplicationConfig.state:type_name -> temporal.api.enums.v1.ReplicationState
+	10, // 35: temporal.server.api.persistence.v1.NamespaceReplicationConfig.failover_history:type_name -> temporal.server.api.persistence.v1.FailoverStatus
+	25, // 36: temporal.server.api.persistence.v1.FailoverStatus.failover_time:type_name -> google.protobuf.Timestamp
+	32, // 37: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRulesEntry.value:type_name -> temporal.api.rules.v1.WorkflowRule
+	7,  // 38: temporal.server.api.persistence.v1.NamespaceConfig.SearchAttributeAliasTransitionsEntry.value:type_name -> temporal.server.api.persistence.v1.SearchAttributeAliasTransition
+	8,  // 39: temporal.server.api.persistence.v1.NamespaceConfig.SearchAttributeTypeMigrationsEntry.value:type_name -> temporal.server.api.persistence.v1.SearchAttributeTypeMigration
+	3,  // 40: temporal.server.api.persistence.v1.NamespaceConfig.WorkflowRuleExtensionsEntry.value:type_name -> temporal.server.api.persistence.v1.WorkflowRuleExtension
+	33, // 41: temporal.server.api.persistence.v1.WorkflowRuleExtension.WorkflowTaskFailedTrigger.cause:type_name -> temporal.api.enums.v1.WorkflowTaskFailedCause
+	34, // 42: temporal.server.api.persistence.v1.WorkflowRuleExtensionAction.SignalAction.input:type_name -> temporal.api.common.v1.Payloads
+	43, // [43:43] is the sub-list for method output_type
+	43, // [43:43] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_namespaces_proto_init() }
@@ -540,13 +1603,25 @@ func file_temporal_server_api_persistence_v1_namespaces_proto_init() {
 	if File_temporal_server_api_persistence_v1_namespaces_proto != nil {
 		return
 	}
+	file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[3].OneofWrappers = []any{
+		(*WorkflowRuleExtension_WorkflowTaskFailed)(nil),
+		(*WorkflowRuleExtension_TimerFired)(nil),
+		(*WorkflowRuleExtension_SignalReceived)(nil),
+		(*WorkflowRuleExtension_HistorySize)(nil),
+	}
+	file_temporal_server_api_persistence_v1_namespaces_proto_msgTypes[4].OneofWrappers = []any{
+		(*WorkflowRuleExtensionAction_PauseWorkflow)(nil),
+		(*WorkflowRuleExtensionAction_Signal)(nil),
+		(*WorkflowRuleExtensionAction_Metric)(nil),
+		(*WorkflowRuleExtensionAction_MemoNote)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc), len(file_temporal_server_api_persistence_v1_namespaces_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -100,6 +100,7 @@ type NexusEndpointTarget struct {
 	//
 	//	*NexusEndpointTarget_Worker_
 	//	*NexusEndpointTarget_External_
+	//	*NexusEndpointTarget_Http_
 	Variant       isNexusEndpointTarget_Variant `protobuf_oneof:"variant"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -160,6 +161,15 @@ func (x *NexusEndpointTarget) GetExternal() *NexusEndpointTarget_External {
 	return nil
 }
 
+func (x *NexusEndpointTarget) GetHttp() *NexusEndpointTarget_Http {
+	if x != nil {
+		if x, ok := x.Variant.(*NexusEndpointTarget_Http_); ok {
+			return x.Http
+		}
+	}
+	return nil
+}
+
 type isNexusEndpointTarget_Variant interface {
 	isNexusEndpointTarget_Variant()
 }
@@ -172,10 +182,16 @@ type NexusEndpointTarget_External_ struct {
 	External *NexusEndpointTarget_External `protobuf:"bytes,2,opt,name=external,proto3,oneof"`
 }
 
+type NexusEndpointTarget_Http_ struct {
+	Http *NexusEndpointTarget_Http `protobuf:"bytes,3,opt,name=http,proto3,oneof"`
+}
+
 func (*NexusEndpointTarget_Worker_) isNexusEndpointTarget_Variant() {}
 
 func (*NexusEndpointTarget_External_) isNexusEndpointTarget_Variant() {}
 
+func (*NexusEndpointTarget_Http_) isNexusEndpointTarget_Variant() {}
+
 type NexusEndpoint struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The last recorded cluster-local Hybrid Logical Clock timestamp for _this_ endpoint.
@@ -412,6 +428,173 @@ func (x *NexusEndpointTarget_External) GetUrl() string {
 	return ""
 }
 
+// Target a plain HTTP/JSON service that does not implement the Nexus protocol. The server translates Nexus
+// requests into calls to the service and the service's responses into Nexus responses.
+type NexusEndpointTarget_Http struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base URL of the service.
+	// (-- api-linter: core::0140::uri=disabled
+	//     aip.dev/not-precedent: Not following linter rules. --)
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// HTTP method used to start operations. Defaults to POST.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Go text/template rendered into the path used to start operations, relative to url.
+	// Available fields: .Service, .Operation and .RequestID. Defaults to "{{.Service}}/{{.Operation}}".
+	PathTemplate string `protobuf:"bytes,3,opt,name=path_template,json=pathTemplate,proto3" json:"path_template,omitempty"`
+	// Headers set on every request sent to the service.
+	Headers map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Go text/template rendered into the body of start requests.
+	// Available fields: .Input (the decoded JSON input), .InputJSON (the raw JSON input), .Service, .Operation,
+	// .RequestID, .CallbackURL and .CallbackToken. The json function encodes a value as JSON.
+	// Defaults to sending the input as is.
+	RequestBodyTemplate string `protobuf:"bytes,5,opt,name=request_body_template,json=requestBodyTemplate,proto3" json:"request_body_template,omitempty"`
+	// Dot separated path of the operation result in the JSON body of a successful start response.
+	// Defaults to the whole response body.
+	ResultPath string `protobuf:"bytes,6,opt,name=result_path,json=resultPath,proto3" json:"result_path,omitempty"`
+	// When set, a 202 Accepted start response indicates that the service will complete the operation asynchronously
+	// by calling the callback URL. Otherwise, any successful response completes the operation synchronously.
+	Async         *NexusEndpointTarget_Http_Async `protobuf:"bytes,7,opt,name=async,proto3" json:"async,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NexusEndpointTarget_Http) Reset() {
+	*x = NexusEndpointTarget_Http{}
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusEndpointTarget_Http) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusEndpointTarget_Http) ProtoMessage() {}
+
+func (x *NexusEndpointTarget_Http) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusEndpointTarget_Http.ProtoReflect.Descriptor instead.
+func (*NexusEndpointTarget_Http) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *NexusEndpointTarget_Http) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NexusEndpointTarget_Http) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *NexusEndpointTarget_Http) GetPathTemplate() string {
+	if x != nil {
+		return x.PathTemplate
+	}
+	return ""
+}
+
+func (x *NexusEndpointTarget_Http) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *NexusEndpointTarget_Http) GetRequestBodyTemplate() string {
+	if x != nil {
+		return x.RequestBodyTemplate
+	}
+	return ""
+}
+
+func (x *NexusEndpointTarget_Http) GetResultPath() string {
+	if x != nil {
+		return x.ResultPath
+	}
+	return ""
+}
+
+func (x *NexusEndpointTarget_Http) GetAsync() *NexusEndpointTarget_Http_Async {
+	if x != nil {
+		return x.Async
+	}
+	return nil
+}
+
+// Settings for services that complete operations asynchronously.
+type NexusEndpointTarget_Http_Async struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Dot separated path of the operation token in the JSON body of a 202 Accepted start response.
+	// Defaults to the request ID.
+	OperationTokenPath string `protobuf:"bytes,1,opt,name=operation_token_path,json=operationTokenPath,proto3" json:"operation_token_path,omitempty"`
+	// Go text/template rendered into the path used to cancel operations, relative to url.
+	// Available fields: .Service, .Operation and .OperationToken.
+	// Cancellation is not supported if unset.
+	CancelPathTemplate string `protobuf:"bytes,2,opt,name=cancel_path_template,json=cancelPathTemplate,proto3" json:"cancel_path_template,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NexusEndpointTarget_Http_Async) Reset() {
+	*x = NexusEndpointTarget_Http_Async{}
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusEndpointTarget_Http_Async) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusEndpointTarget_Http_Async) ProtoMessage() {}
+
+func (x *NexusEndpointTarget_Http_Async) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusEndpointTarget_Http_Async.ProtoReflect.Descriptor instead.
+func (*NexusEndpointTarget_Http_Async) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{1, 2, 0}
+}
+
+func (x *NexusEndpointTarget_Http_Async) GetOperationTokenPath() string {
+	if x != nil {
+		return x.OperationTokenPath
+	}
+	return ""
+}
+
+func (x *NexusEndpointTarget_Http_Async) GetCancelPathTemplate() string {
+	if x != nil {
+		return x.CancelPathTemplate
+	}
+	return ""
+}
+
 var File_temporal_server_api_persistence_v1_nexus_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_persistence_v1_nexus_proto_rawDesc = "" +
@@ -420,16 +603,32 @@ const file_temporal_server_api_persistence_v1_nexus_proto_rawDesc = "" +
 	"\x11NexusEndpointSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12A\n" +
 	"\vdescription\x18\x02 \x01(\v2\x1f.temporal.api.common.v1.PayloadR\vdescription\x12O\n" +
-	"\x06target\x18\x03 \x01(\v27.temporal.server.api.persistence.v1.NexusEndpointTargetR\x06target\"\xc4\x02\n" +
+	"\x06target\x18\x03 \x01(\v27.temporal.server.api.persistence.v1.NexusEndpointTargetR\x06target\"\xad\a\n" +
 	"\x13NexusEndpointTarget\x12X\n" +
 	"\x06worker\x18\x01 \x01(\v2>.temporal.server.api.persistence.v1.NexusEndpointTarget.WorkerH\x00R\x06worker\x12^\n" +
-	"\bexternal\x18\x02 \x01(\v2@.temporal.server.api.persistence.v1.NexusEndpointTarget.ExternalH\x00R\bexternal\x1aJ\n" +
+	"\bexternal\x18\x02 \x01(\v2@.temporal.server.api.persistence.v1.NexusEndpointTarget.ExternalH\x00R\bexternal\x12R\n" +
+	"\x04http\x18\x03 \x01(\v2<.temporal.server.api.persistence.v1.NexusEndpointTarget.HttpH\x00R\x04http\x1aJ\n" +
 	"\x06Worker\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1d\n" +
 	"\n" +
 	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x1a\x1c\n" +
 	"\bExternal\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03urlB\t\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x1a\x92\x04\n" +
+	"\x04Http\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12#\n" +
+	"\rpath_template\x18\x03 \x01(\tR\fpathTemplate\x12c\n" +
+	"\aheaders\x18\x04 \x03(\v2I.temporal.server.api.persistence.v1.NexusEndpointTarget.Http.HeadersEntryR\aheaders\x122\n" +
+	"\x15request_body_template\x18\x05 \x01(\tR\x13requestBodyTemplate\x12\x1f\n" +
+	"\vresult_path\x18\x06 \x01(\tR\n" +
+	"resultPath\x12X\n" +
+	"\x05async\x18\a \x01(\v2B.temporal.server.api.persistence.v1.NexusEndpointTarget.Http.AsyncR\x05async\x1ak\n" +
+	"\x05Async\x120\n" +
+	"\x14operation_token_path\x18\x01 \x01(\tR\x12operationTokenPath\x120\n" +
+	"\x14cancel_path_template\x18\x02 \x01(\tR\x12cancelPathTemplate\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
 	"\avariant\"\xe1\x01\n" +
 	"\rNexusEndpoint\x12F\n" +
 	"\x05clock\x18\x01 \x01(\v20.temporal.server.api.clock.v1.HybridLogicalClockR\x05clock\x12I\n" +
@@ -452,32 +651,38 @@ func file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_nexus_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_temporal_server_api_persistence_v1_nexus_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_temporal_server_api_persistence_v1_nexus_proto_goTypes = []any{
-	(*NexusEndpointSpec)(nil),            // 0: temporal.server.api.persistence.v1.NexusEndpointSpec
-	(*NexusEndpointTarget)(nil),          // 1: temporal.server.api.persistence.v1.NexusEndpointTarget
-	(*NexusEndpoint)(nil),                // 2: temporal.server.api.persistence.v1.NexusEndpoint
-	(*NexusEndpointEntry)(nil),           // 3: temporal.server.api.persistence.v1.NexusEndpointEntry
-	(*NexusEndpointTarget_Worker)(nil),   // 4: temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
-	(*NexusEndpointTarget_External)(nil), // 5: temporal.server.api.persistence.v1.NexusEndpointTarget.External
-	(*v1.Payload)(nil),                   // 6: temporal.api.common.v1.Payload
-	(*v11.HybridLogicalClock)(nil),       // 7: temporal.server.api.clock.v1.HybridLogicalClock
-	(*timestamppb.Timestamp)(nil),        // 8: google.protobuf.Timestamp
+	(*NexusEndpointSpec)(nil),              // 0: temporal.server.api.persistence.v1.NexusEndpointSpec
+	(*NexusEndpointTarget)(nil),            // 1: temporal.server.api.persistence.v1.NexusEndpointTarget
+	(*NexusEndpoint)(nil),                  // 2: temporal.server.api.persistence.v1.NexusEndpoint
+	(*NexusEndpointEntry)(nil),             // 3: temporal.server.api.persistence.v1.NexusEndpointEntry
+	(*NexusEndpointTarget_Worker)(nil),     // 4: temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
+	(*NexusEndpointTarget_External)(nil),   // 5: temporal.server.api.persistence.v1.NexusEndpointTarget.External
+	(*NexusEndpointTarget_Http)(nil),       // 6: temporal.server.api.persistence.v1.NexusEndpointTarget.Http
+	(*NexusEndpointTarget_Http_Async)(nil), // 7: temporal.server.api.persistence.v1.NexusEndpointTarget.Http.Async
+	nil,                                    // 8: temporal.server.api.persistence.v1.NexusEndpointTarget.Http.HeadersEntry
+	(*v1.Payload)(nil),                     // 9: temporal.api.common.v1.Payload
+	(*v11.HybridLogicalClock)(nil),         // 10: temporal.server.api.clock.v1.HybridLogicalClock
+	(*timestamppb.Timestamp)(nil),          // 11: google.protobuf.Timestamp
 }
 var file_temporal_server_api_persistence_v1_nexus_proto_depIdxs = []int32{
-	6, // 0: temporal.server.api.persistence.v1.NexusEndpointSpec.description:type_name -> temporal.api.common.v1.Payload
-	1, // 1: temporal.server.api.persistence.v1.NexusEndpointSpec.target:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget
-	4, // 2: temporal.server.api.persistence.v1.NexusEndpointTarget.worker:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
-	5, // 3: temporal.server.api.persistence.v1.NexusEndpointTarget.external:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.External
-	7, // 4: temporal.server.api.persistence.v1.NexusEndpoint.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	0, // 5: temporal.server.api.persistence.v1.NexusEndpoint.spec:type_name -> temporal.server.api.persistence.v1.NexusEndpointSpec
-	8, // 6: temporal.server.api.persistence.v1.NexusEndpoint.created_time:type_name -> google.protobuf.Timestamp
-	2, // 7: temporal.server.api.persistence.v1.NexusEndpointEntry.endpoint:type_name -> temporal.server.api.persistence.v1.NexusEndpoint
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	9,  // 0: temporal.server.api.persistence.v1.NexusEndpointSpec.description:type_name -> temporal.api.common.v1.Payload
+	1,  // 1: temporal.server.api.persistence.v1.NexusEndpointSpec.target:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget
+	4,  // 2: temporal.server.api.persistence.v1.NexusEndpointTarget.worker:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
+	5,  // 3: temporal.server.api.persistence.v1.NexusEndpointTarget.external:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.External
+	6,  // 4: temporal.server.api.persistence.v1.NexusEndpointTarget.http:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Http
+	10, // 5: temporal.server.api.persistence.v1.NexusEndpoint.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	0,  // 6: temporal.server.api.persistence.v1.NexusEndpoint.spec:type_name -> temporal.server.api.persistence.v1.NexusEndpointSpec
+	11, // 7: temporal.server.api.persistence.v1.NexusEndpoint.created_time:type_name -> google.protobuf.Timestamp
+	2,  // 8: temporal.server.api.persistence.v1.NexusEndpointEntry.endpoint:type_name -> temporal.server.api.persistence.v1.NexusEndpoint
+	8,  // 9: temporal.server.api.persistence.v1.NexusEndpointTarget.Http.headers:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Http.HeadersEntry
+	7,  // 10: temporal.server.api.persistence.v1.NexusEndpointTarget.Http.async:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Http.Async
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_nexus_proto_init() }
@@ -488,6 +693,7 @@ func file_temporal_server_api_persistence_v1_nexus_proto_init() {
 	file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[1].OneofWrappers = []any{
 		(*NexusEndpointTarget_Worker_)(nil),
 		(*NexusEndpointTarget_External_)(nil),
+		(*NexusEndpointTarget_Http_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -495,7 +701,7 @@ func file_temporal_server_api_persistence_v1_nexus_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_nexus_proto_rawDesc), len(file_temporal_server_api_persistence_v1_nexus_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -11,8 +11,10 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	v1 "go.temporal.io/api/update/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -145,9 +147,19 @@ type UpdateCompletionInfo struct {
 	// the event ID of the WorkflowExecutionUpdateCompletedEvent
 	EventId int64 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	// the ID of the event batch containing the event_id above
-	EventBatchId  int64 `protobuf:"varint,2,opt,name=event_batch_id,json=eventBatchId,proto3" json:"event_batch_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	EventBatchId int64 `protobuf:"varint,2,opt,name=event_batch_id,json=eventBatchId,proto3" json:"event_batch_id,omitempty"`
+	// true if the WorkflowExecutionUpdateCompletedEvent was written without the
+	// outcome payloads; the outcome is then only available from the fields below.
+	// Derived from the event when it is applied, so it survives rebuilds and
+	// event-based replication.
+	Compacted bool `protobuf:"varint,3,opt,name=compacted,proto3" json:"compacted,omitempty"`
+	// the update outcome retained for a compacted completion; cleared once it
+	// expires or is evicted to keep the number of retained outcomes bounded
+	Outcome *v1.Outcome `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// the time after which the retained outcome is no longer served
+	OutcomeExpirationTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=outcome_expiration_time,json=outcomeExpirationTime,proto3" json:"outcome_expiration_time,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *UpdateCompletionInfo) Reset() {
@@ -194,6 +206,27 @@ func (x *UpdateCompletionInfo) GetEventBatchId() int64 {
 	return 0
 }
 
+func (x *UpdateCompletionInfo) GetCompacted() bool {
+	if x != nil {
+		return x.Compacted
+	}
+	return false
+}
+
+func (x *UpdateCompletionInfo) GetOutcome() *v1.Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *UpdateCompletionInfo) GetOutcomeExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OutcomeExpirationTime
+	}
+	return nil
+}
+
 // UpdateInfo is the persistent state of a single update
 type UpdateInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -362,7 +395,7 @@ var File_temporal_server_api_persistence_v1_update_proto protoreflect.FileDescri
 
 const file_temporal_server_api_persistence_v1_update_proto_rawDesc = "" +
 	"\n" +
-	"/temporal/server/api/persistence/v1/update.proto\x12\"temporal.server.api.persistence.v1\x1a,temporal/server/api/persistence/v1/hsm.proto\"\xe7\x01\n" +
+	"/temporal/server/api/persistence/v1/update.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/update/v1/message.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\"\xe7\x01\n" +
 	"\x13UpdateAdmissionInfo\x12q\n" +
 	"\x0fhistory_pointer\x18\x01 \x01(\v2F.temporal.server.api.persistence.v1.UpdateAdmissionInfo.HistoryPointerH\x00R\x0ehistoryPointer\x1aQ\n" +
 	"\x0eHistoryPointer\x12\x19\n" +
@@ -371,10 +404,13 @@ const file_temporal_server_api_persistence_v1_update_proto_rawDesc = "" +
 	"\n" +
 	"\blocation\"1\n" +
 	"\x14UpdateAcceptanceInfo\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\x03R\aeventId\"W\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\"\x84\x02\n" +
 	"\x14UpdateCompletionInfo\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12$\n" +
-	"\x0eevent_batch_id\x18\x02 \x01(\x03R\feventBatchId\"\xa9\x03\n" +
+	"\x0eevent_batch_id\x18\x02 \x01(\x03R\feventBatchId\x12\x1c\n" +
+	"\tcompacted\x18\x03 \x01(\bR\tcompacted\x129\n" +
+	"\aoutcome\x18\x04 \x01(\v2\x1f.temporal.api.update.v1.OutcomeR\aoutcome\x12R\n" +
+	"\x17outcome_expiration_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x15outcomeExpirationTime\"\xa9\x03\n" +
 	"\n" +
 	"UpdateInfo\x12Z\n" +
 	"\n" +
@@ -406,19 +442,23 @@ var file_temporal_server_api_persistence_v1_update_proto_goTypes = []any{
 	(*UpdateCompletionInfo)(nil),               // 2: temporal.server.api.persistence.v1.UpdateCompletionInfo
 	(*UpdateInfo)(nil),                         // 3: temporal.server.api.persistence.v1.UpdateInfo
 	(*UpdateAdmissionInfo_HistoryPointer)(nil), // 4: temporal.server.api.persistence.v1.UpdateAdmissionInfo.HistoryPointer
-	(*VersionedTransition)(nil),                // 5: temporal.server.api.persistence.v1.VersionedTransition
+	(*v1.Outcome)(nil),                         // 5: temporal.api.update.v1.Outcome
+	(*timestamppb.Timestamp)(nil),              // 6: google.protobuf.Timestamp
+	(*VersionedTransition)(nil),                // 7: temporal.server.api.persistence.v1.VersionedTransition
 }
 var file_temporal_server_api_persistence_v1_update_proto_depIdxs = []int32{
 	4, // 0: temporal.server.api.persistence.v1.UpdateAdmissionInfo.history_pointer:type_name -> temporal.server.api.persistence.v1.UpdateAdmissionInfo.HistoryPointer
-	1, // 1: temporal.server.api.persistence.v1.UpdateInfo.acceptance:type_name -> temporal.server.api.persistence.v1.UpdateAcceptanceInfo
-	2, // 2: temporal.server.api.persistence.v1.UpdateInfo.completion:type_name -> temporal.server.api.persistence.v1.UpdateCompletionInfo
-	0, // 3: temporal.server.api.persistence.v1.UpdateInfo.admission:type_name -> temporal.server.api.persistence.v1.UpdateAdmissionInfo
-	5, // 4: temporal.server.api.persistence.v1.UpdateInfo.last_update_versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 1: temporal.server.api.persistence.v1.UpdateCompletionInfo.outcome:type_name -> temporal.api.update.v1.Outcome
+	6, // 2: temporal.server.api.persistence.v1.UpdateCompletionInfo.outcome_expiration_time:type_name -> google.protobuf.Timestamp
+	1, // 3: temporal.server.api.persistence.v1.UpdateInfo.acceptance:type_name -> temporal.server.api.persistence.v1.UpdateAcceptanceInfo
+	2, // 4: temporal.server.api.persistence.v1.UpdateInfo.completion:type_name -> temporal.server.api.persistence.v1.UpdateCompletionInfo
+	0, // 5: temporal.server.api.persistence.v1.UpdateInfo.admission:type_name -> temporal.server.api.persistence.v1.UpdateAdmissionInfo
+	7, // 6: temporal.server.api.persistence.v1.UpdateInfo.last_update_versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_update_proto_init() }
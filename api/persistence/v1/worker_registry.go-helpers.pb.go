@@ -0,0 +1,80 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package persistence
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal an object of type WorkerRegistrySnapshot to the protobuf v3 wire format
+func (val *WorkerRegistrySnapshot) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerRegistrySnapshot from the protobuf v3 wire format
+func (val *WorkerRegistrySnapshot) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerRegistrySnapshot) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerRegistrySnapshot values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerRegistrySnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerRegistrySnapshot
+	switch t := that.(type) {
+	case *WorkerRegistrySnapshot:
+		that1 = t
+	case WorkerRegistrySnapshot:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkerRegistryEntry to the protobuf v3 wire format
+func (val *WorkerRegistryEntry) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerRegistryEntry from the protobuf v3 wire format
+func (val *WorkerRegistryEntry) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerRegistryEntry) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerRegistryEntry values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerRegistryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerRegistryEntry
+	switch t := that.(type) {
+	case *WorkerRegistryEntry:
+		that1 = t
+	case WorkerRegistryEntry:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -0,0 +1,210 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/persistence/v1/worker_registry.proto
+
+package persistence
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "go.temporal.io/api/worker/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WorkerRegistrySnapshot holds the workers of a namespace known to the worker registry of the matching host that owns
+// the namespace. It is persisted so that the registry survives restarts and the namespace moving to another host.
+type WorkerRegistrySnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workers       []*WorkerRegistryEntry `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerRegistrySnapshot) Reset() {
+	*x = WorkerRegistrySnapshot{}
+	mi := &file_temporal_server_api_persistence_v1_worker_registry_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerRegistrySnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerRegistrySnapshot) ProtoMessage() {}
+
+func (x *WorkerRegistrySnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_worker_registry_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerRegistrySnapshot.ProtoReflect.Descriptor instead.
+func (*WorkerRegistrySnapshot) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_worker_registry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorkerRegistrySnapshot) GetWorkers() []*WorkerRegistryEntry {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
+type WorkerRegistryEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The latest heartbeat of the worker.
+	Heartbeat *v1.WorkerHeartbeat `protobuf:"bytes,1,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	// Recent heartbeats of the worker, oldest first, trimmed to the fields that change between heartbeats.
+	History []*v1.WorkerHeartbeat `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	// Time the latest heartbeat was received by the server.
+	LastSeenTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_seen_time,json=lastSeenTime,proto3" json:"last_seen_time,omitempty"`
+	IsSystemWorker bool                   `protobuf:"varint,4,opt,name=is_system_worker,json=isSystemWorker,proto3" json:"is_system_worker,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkerRegistryEntry) Reset() {
+	*x = WorkerRegistryEntry{}
+	mi := &file_temporal_server_api_persistence_v1_worker_registry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerRegistryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerRegistryEntry) ProtoMessage() {}
+
+func (x *WorkerRegistryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_worker_registry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerRegistryEntry.ProtoReflect.Descriptor instead.
+func (*WorkerRegistryEntry) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_worker_registry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorkerRegistryEntry) GetHeartbeat() *v1.WorkerHeartbeat {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *WorkerRegistryEntry) GetHistory() []*v1.WorkerHeartbeat {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *WorkerRegistryEntry) GetLastSeenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenTime
+	}
+	return nil
+}
+
+func (x *WorkerRegistryEntry) GetIsSystemWorker() bool {
+	if x != nil {
+		return x.IsSystemWorker
+	}
+	return false
+}
+
+var File_temporal_server_api_persistence_v1_worker_registry_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_persistence_v1_worker_registry_proto_rawDesc = "" +
+	"\n" +
+	"8temporal/server/api/persistence/v1/worker_registry.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/worker/v1/message.proto\"k\n" +
+	"\x16WorkerRegistrySnapshot\x12Q\n" +
+	"\aworkers\x18\x01 \x03(\v27.temporal.server.api.persistence.v1.WorkerRegistryEntryR\aworkers\"\x8b\x02\n" +
+	"\x13WorkerRegistryEntry\x12E\n" +
+	"\theartbeat\x18\x01 \x01(\v2'.temporal.api.worker.v1.WorkerHeartbeatR\theartbeat\x12A\n" +
+	"\ahistory\x18\x02 \x03(\v2'.temporal.api.worker.v1.WorkerHeartbeatR\ahistory\x12@\n" +
+	"\x0elast_seen_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\flastSeenTime\x12(\n" +
+	"\x10is_system_worker\x18\x04 \x01(\bR\x0eisSystemWorkerB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
+
+var (
+	file_temporal_server_api_persistence_v1_worker_registry_proto_rawDescOnce sync.Once
+	file_temporal_server_api_persistence_v1_worker_registry_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_persistence_v1_worker_registry_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_persistence_v1_worker_registry_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_persistence_v1_worker_registry_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_worker_registry_proto_rawDesc), len(file_temporal_server_api_persistence_v1_worker_registry_proto_rawDesc)))
+	})
+	return file_temporal_server_api_persistence_v1_worker_registry_proto_rawDescData
+}
+
+var file_temporal_server_api_persistence_v1_worker_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_temporal_server_api_persistence_v1_worker_registry_proto_goTypes = []any{
+	(*WorkerRegistrySnapshot)(nil), // 0: temporal.server.api.persistence.v1.WorkerRegistrySnapshot
+	(*WorkerRegistryEntry)(nil),    // 1: temporal.server.api.persistence.v1.WorkerRegistryEntry
+	(*v1.WorkerHeartbeat)(nil),     // 2: temporal.api.worker.v1.WorkerHeartbeat
+	(*timestamppb.Timestamp)(nil),  // 3: google.protobuf.Timestamp
+}
+var file_temporal_server_api_persistence_v1_worker_registry_proto_depIdxs = []int32{
+	1, // 0: temporal.server.api.persistence.v1.WorkerRegistrySnapshot.workers:type_name -> temporal.server.api.persistence.v1.WorkerRegistryEntry
+	2, // 1: temporal.server.api.persistence.v1.WorkerRegistryEntry.heartbeat:type_name -> temporal.api.worker.v1.WorkerHeartbeat
+	2, // 2: temporal.server.api.persistence.v1.WorkerRegistryEntry.history:type_name -> temporal.api.worker.v1.WorkerHeartbeat
+	3, // 3: temporal.server.api.persistence.v1.WorkerRegistryEntry.last_seen_time:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_persistence_v1_worker_registry_proto_init() }
+func file_temporal_server_api_persistence_v1_worker_registry_proto_init() {
+	if File_temporal_server_api_persistence_v1_worker_registry_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_worker_registry_proto_rawDesc), len(file_temporal_server_api_persistence_v1_worker_registry_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_persistence_v1_worker_registry_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_persistence_v1_worker_registry_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_persistence_v1_worker_registry_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_persistence_v1_worker_registry_proto = out.File
+	file_temporal_server_api_persistence_v1_worker_registry_proto_goTypes = nil
+	file_temporal_server_api_persistence_v1_worker_registry_proto_depIdxs = nil
+}
@@ -855,3 +855,114 @@ func (this *MigrationExecutionInfo) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ShardReplicationLag to the protobuf v3 wire format
+func (val *ShardReplicationLag) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ShardReplicationLag from the protobuf v3 wire format
+func (val *ShardReplicationLag) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ShardReplicationLag) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ShardReplicationLag values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ShardReplicationLag) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ShardReplicationLag
+	switch t := that.(type) {
+	case *ShardReplicationLag:
+		that1 = t
+	case ShardReplicationLag:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReplicationStreamSenderStatus to the protobuf v3 wire format
+func (val *ReplicationStreamSenderStatus) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplicationStreamSenderStatus from the protobuf v3 wire format
+func (val *ReplicationStreamSenderStatus) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplicationStreamSenderStatus) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplicationStreamSenderStatus values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplicationStreamSenderStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplicationStreamSenderStatus
+	switch t := that.(type) {
+	case *ReplicationStreamSenderStatus:
+		that1 = t
+	case ReplicationStreamSenderStatus:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReplicationFlowControlState to the protobuf v3 wire format
+func (val *ReplicationFlowControlState) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplicationFlowControlState from the protobuf v3 wire format
+func (val *ReplicationFlowControlState) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplicationFlowControlState) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplicationFlowControlState values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplicationFlowControlState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplicationFlowControlState
+	switch t := that.(type) {
+	case *ReplicationFlowControlState:
+		that1 = t
+	case ReplicationFlowControlState:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -485,3 +485,40 @@ func (this *ClientPartitionCounts) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type WorkerScalingRecommendation to the protobuf v3 wire format
+func (val *WorkerScalingRecommendation) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerScalingRecommendation from the protobuf v3 wire format
+func (val *WorkerScalingRecommendation) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerScalingRecommendation) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerScalingRecommendation values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerScalingRecommendation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerScalingRecommendation
+	switch t := that.(type) {
+	case *WorkerScalingRecommendation:
+		that1 = t
+	case WorkerScalingRecommendation:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
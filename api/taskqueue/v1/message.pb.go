@@ -18,6 +18,7 @@ import (
 	v14 "go.temporal.io/server/api/enums/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -415,8 +416,11 @@ type PhysicalTaskQueueInfo struct {
 	//
 	//	aip.dev/not-precedent: "by" is used to clarify the keys. --)
 	TaskQueueStatsByPriorityKey map[int32]*v13.TaskQueueStats `protobuf:"bytes,4,rep,name=task_queue_stats_by_priority_key,json=taskQueueStatsByPriorityKey,proto3" json:"task_queue_stats_by_priority_key,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+	// Average time from task creation until dispatch to a poller, over the same window as the task rates in
+	// task_queue_stats. Only set when stats are reported and tasks were dispatched in that window.
+	AverageDispatchLatency *durationpb.Duration `protobuf:"bytes,5,opt,name=average_dispatch_latency,json=averageDispatchLatency,proto3" json:"average_dispatch_latency,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *PhysicalTaskQueueInfo) Reset() {
@@ -477,6 +481,13 @@ func (x *PhysicalTaskQueueInfo) GetTaskQueueStatsByPriorityKey() map[int32]*v13.
 	return nil
 }
 
+func (x *PhysicalTaskQueueInfo) GetAverageDispatchLatency() *durationpb.Duration {
+	if x != nil {
+		return x.AverageDispatchLatency
+	}
+	return nil
+}
+
 // Internal representation of a task queue partition, used for server-to-server RPCs.
 // This is the internal equivalent of temporal.api.taskqueue.v1.TaskQueue.
 type TaskQueuePartition struct {
@@ -1019,6 +1030,138 @@ func (x *ClientPartitionCounts) GetWrite() int32 {
 	return 0
 }
 
+// WorkerScalingRecommendation is the number of workers and slots a task queue version needs to meet its scaling
+// targets, along with the signals it was computed from.
+type WorkerScalingRecommendation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of workers that heartbeat or polled recently.
+	CurrentWorkers int32 `protobuf:"varint,1,opt,name=current_workers,json=currentWorkers,proto3" json:"current_workers,omitempty"`
+	DesiredWorkers int32 `protobuf:"varint,2,opt,name=desired_workers,json=desiredWorkers,proto3" json:"desired_workers,omitempty"`
+	// Number of task slots of the current workers, as reported by worker heartbeats. Zero if no worker reports slots.
+	CurrentSlots int32 `protobuf:"varint,3,opt,name=current_slots,json=currentSlots,proto3" json:"current_slots,omitempty"`
+	DesiredSlots int32 `protobuf:"varint,4,opt,name=desired_slots,json=desiredSlots,proto3" json:"desired_slots,omitempty"`
+	// Tasks per second added to the task queue version.
+	ArrivalRate float32 `protobuf:"fixed32,5,opt,name=arrival_rate,json=arrivalRate,proto3" json:"arrival_rate,omitempty"`
+	// Tasks per second dispatched to workers of the task queue version.
+	DispatchRate           float32              `protobuf:"fixed32,6,opt,name=dispatch_rate,json=dispatchRate,proto3" json:"dispatch_rate,omitempty"`
+	AverageDispatchLatency *durationpb.Duration `protobuf:"bytes,7,opt,name=average_dispatch_latency,json=averageDispatchLatency,proto3" json:"average_dispatch_latency,omitempty"`
+	BacklogCount           int64                `protobuf:"varint,8,opt,name=backlog_count,json=backlogCount,proto3" json:"backlog_count,omitempty"`
+	BacklogAge             *durationpb.Duration `protobuf:"bytes,9,opt,name=backlog_age,json=backlogAge,proto3" json:"backlog_age,omitempty"`
+	// Fraction of the task slots of the current workers that are in use.
+	SlotUtilization float32 `protobuf:"fixed32,10,opt,name=slot_utilization,json=slotUtilization,proto3" json:"slot_utilization,omitempty"`
+	// Human-readable explanation of the signal which determined the recommendation.
+	Reason        string `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerScalingRecommendation) Reset() {
+	*x = WorkerScalingRecommendation{}
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerScalingRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerScalingRecommendation) ProtoMessage() {}
+
+func (x *WorkerScalingRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerScalingRecommendation.ProtoReflect.Descriptor instead.
+func (*WorkerScalingRecommendation) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WorkerScalingRecommendation) GetCurrentWorkers() int32 {
+	if x != nil {
+		return x.CurrentWorkers
+	}
+	return 0
+}
+
+func (x *WorkerScalingRecommendation) GetDesiredWorkers() int32 {
+	if x != nil {
+		return x.DesiredWorkers
+	}
+	return 0
+}
+
+func (x *WorkerScalingRecommendation) GetCurrentSlots() int32 {
+	if x != nil {
+		return x.CurrentSlots
+	}
+	return 0
+}
+
+func (x *WorkerScalingRecommendation) GetDesiredSlots() int32 {
+	if x != nil {
+		return x.DesiredSlots
+	}
+	return 0
+}
+
+func (x *WorkerScalingRecommendation) GetArrivalRate() float32 {
+	if x != nil {
+		return x.ArrivalRate
+	}
+	return 0
+}
+
+func (x *WorkerScalingRecommendation) GetDispatchRate() float32 {
+	if x != nil {
+		return x.DispatchRate
+	}
+	return 0
+}
+
+func (x *WorkerScalingRecommendation) GetAverageDispatchLatency() *durationpb.Duration {
+	if x != nil {
+		return x.AverageDispatchLatency
+	}
+	return nil
+}
+
+func (x *WorkerScalingRecommendation) GetBacklogCount() int64 {
+	if x != nil {
+		return x.BacklogCount
+	}
+	return 0
+}
+
+func (x *WorkerScalingRecommendation) GetBacklogAge() *durationpb.Duration {
+	if x != nil {
+		return x.BacklogAge
+	}
+	return nil
+}
+
+func (x *WorkerScalingRecommendation) GetSlotUtilization() float32 {
+	if x != nil {
+		return x.SlotUtilization
+	}
+	return 0
+}
+
+func (x *WorkerScalingRecommendation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type EphemeralData_ByVersion struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Key for this data. Data for the unversioned queue has no version field present.
@@ -1033,7 +1176,7 @@ type EphemeralData_ByVersion struct {
 
 func (x *EphemeralData_ByVersion) Reset() {
 	*x = EphemeralData_ByVersion{}
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1045,7 +1188,7 @@ func (x *EphemeralData_ByVersion) String() string {
 func (*EphemeralData_ByVersion) ProtoMessage() {}
 
 func (x *EphemeralData_ByVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,7 +1228,7 @@ type EphemeralData_ByPartition struct {
 
 func (x *EphemeralData_ByPartition) Reset() {
 	*x = EphemeralData_ByPartition{}
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1097,7 +1240,7 @@ func (x *EphemeralData_ByPartition) String() string {
 func (*EphemeralData_ByPartition) ProtoMessage() {}
 
 func (x *EphemeralData_ByPartition) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_taskqueue_v1_message_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1274,7 @@ var File_temporal_server_api_taskqueue_v1_message_proto protoreflect.FileDescrip
 
 const file_temporal_server_api_taskqueue_v1_message_proto_rawDesc = "" +
 	"\n" +
-	".temporal/server/api/taskqueue/v1/message.proto\x12 temporal.server.api.taskqueue.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(temporal/api/deployment/v1/message.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a/temporal/server/api/deployment/v1/message.proto\x1a'temporal/server/api/enums/v1/task.proto\"\xef\x03\n" +
+	".temporal/server/api/taskqueue/v1/message.proto\x12 temporal.server.api.taskqueue.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(temporal/api/deployment/v1/message.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a/temporal/server/api/deployment/v1/message.proto\x1a'temporal/server/api/enums/v1/task.proto\"\xef\x03\n" +
 	"\x14TaskVersionDirective\x12J\n" +
 	"\x14use_assignment_rules\x18\x01 \x01(\v2\x16.google.protobuf.EmptyH\x00R\x12useAssignmentRules\x12,\n" +
 	"\x11assigned_build_id\x18\x02 \x01(\tH\x00R\x0fassignedBuildId\x12E\n" +
@@ -1162,12 +1305,13 @@ const file_temporal_server_api_taskqueue_v1_message_proto_rawDesc = "" +
 	" \x01(\bR\bdraining\x12'\n" +
 	"\x0fbacklog_drained\x18\v \x01(\bR\x0ebacklogDrained\"\x90\x01\n" +
 	"\x1cTaskQueueVersionInfoInternal\x12p\n" +
-	"\x18physical_task_queue_info\x18\x02 \x01(\v27.temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfoR\x15physicalTaskQueueInfo\"\xc2\x04\n" +
+	"\x18physical_task_queue_info\x18\x02 \x01(\v27.temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfoR\x15physicalTaskQueueInfo\"\x97\x05\n" +
 	"\x15PhysicalTaskQueueInfo\x12?\n" +
 	"\apollers\x18\x01 \x03(\v2%.temporal.api.taskqueue.v1.PollerInfoR\apollers\x12v\n" +
 	"\x1ainternal_task_queue_status\x18\x03 \x03(\v29.temporal.server.api.taskqueue.v1.InternalTaskQueueStatusR\x17internalTaskQueueStatus\x12S\n" +
 	"\x10task_queue_stats\x18\x02 \x01(\v2).temporal.api.taskqueue.v1.TaskQueueStatsR\x0etaskQueueStats\x12\x9f\x01\n" +
-	" task_queue_stats_by_priority_key\x18\x04 \x03(\v2X.temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntryR\x1btaskQueueStatsByPriorityKey\x1ay\n" +
+	" task_queue_stats_by_priority_key\x18\x04 \x03(\v2X.temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntryR\x1btaskQueueStatsByPriorityKey\x12S\n" +
+	"\x18average_dispatch_latency\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x16averageDispatchLatency\x1ay\n" +
 	" TaskQueueStatsByPriorityKeyEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12?\n" +
 	"\x05value\x18\x02 \x01(\v2).temporal.api.taskqueue.v1.TaskQueueStatsR\x05value:\x028\x01\"\xce\x02\n" +
@@ -1212,7 +1356,21 @@ const file_temporal_server_api_taskqueue_v1_message_proto_rawDesc = "" +
 	" \x01(\x10R\aversion\"A\n" +
 	"\x15ClientPartitionCounts\x12\x12\n" +
 	"\x04read\x18\x01 \x01(\x05R\x04read\x12\x14\n" +
-	"\x05write\x18\x02 \x01(\x05R\x05writeB2Z0go.temporal.io/server/api/taskqueue/v1;taskqueueb\x06proto3"
+	"\x05write\x18\x02 \x01(\x05R\x05write\"\xfa\x03\n" +
+	"\x1bWorkerScalingRecommendation\x12'\n" +
+	"\x0fcurrent_workers\x18\x01 \x01(\x05R\x0ecurrentWorkers\x12'\n" +
+	"\x0fdesired_workers\x18\x02 \x01(\x05R\x0edesiredWorkers\x12#\n" +
+	"\rcurrent_slots\x18\x03 \x01(\x05R\fcurrentSlots\x12#\n" +
+	"\rdesired_slots\x18\x04 \x01(\x05R\fdesiredSlots\x12!\n" +
+	"\farrival_rate\x18\x05 \x01(\x02R\varrivalRate\x12#\n" +
+	"\rdispatch_rate\x18\x06 \x01(\x02R\fdispatchRate\x12S\n" +
+	"\x18average_dispatch_latency\x18\a \x01(\v2\x19.google.protobuf.DurationR\x16averageDispatchLatency\x12#\n" +
+	"\rbacklog_count\x18\b \x01(\x03R\fbacklogCount\x12:\n" +
+	"\vbacklog_age\x18\t \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"backlogAge\x12)\n" +
+	"\x10slot_utilization\x18\n" +
+	" \x01(\x02R\x0fslotUtilization\x12\x16\n" +
+	"\x06reason\x18\v \x01(\tR\x06reasonB2Z0go.temporal.io/server/api/taskqueue/v1;taskqueueb\x06proto3"
 
 var (
 	file_temporal_server_api_taskqueue_v1_message_proto_rawDescOnce sync.Once
@@ -1226,7 +1384,7 @@ func file_temporal_server_api_taskqueue_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_taskqueue_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_taskqueue_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_temporal_server_api_taskqueue_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_temporal_server_api_taskqueue_v1_message_proto_goTypes = []any{
 	(*TaskVersionDirective)(nil),         // 0: temporal.server.api.taskqueue.v1.TaskVersionDirective
 	(*FairLevel)(nil),                    // 1: temporal.server.api.taskqueue.v1.FairLevel
@@ -1241,50 +1399,55 @@ var file_temporal_server_api_taskqueue_v1_message_proto_goTypes = []any{
 	(*VersionedEphemeralData)(nil),       // 10: temporal.server.api.taskqueue.v1.VersionedEphemeralData
 	(*PartitionScaleInfo)(nil),           // 11: temporal.server.api.taskqueue.v1.PartitionScaleInfo
 	(*ClientPartitionCounts)(nil),        // 12: temporal.server.api.taskqueue.v1.ClientPartitionCounts
-	nil,                                  // 13: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry
-	(*EphemeralData_ByVersion)(nil),      // 14: temporal.server.api.taskqueue.v1.EphemeralData.ByVersion
-	(*EphemeralData_ByPartition)(nil),    // 15: temporal.server.api.taskqueue.v1.EphemeralData.ByPartition
-	(*emptypb.Empty)(nil),                // 16: google.protobuf.Empty
-	(v1.VersioningBehavior)(0),           // 17: temporal.api.enums.v1.VersioningBehavior
-	(*v11.Deployment)(nil),               // 18: temporal.api.deployment.v1.Deployment
-	(*v12.WorkerDeploymentVersion)(nil),  // 19: temporal.server.api.deployment.v1.WorkerDeploymentVersion
-	(*v13.TaskIdBlock)(nil),              // 20: temporal.api.taskqueue.v1.TaskIdBlock
-	(*v13.PollerInfo)(nil),               // 21: temporal.api.taskqueue.v1.PollerInfo
-	(*v13.TaskQueueStats)(nil),           // 22: temporal.api.taskqueue.v1.TaskQueueStats
-	(v1.TaskQueueType)(0),                // 23: temporal.api.enums.v1.TaskQueueType
-	(v14.TaskSource)(0),                  // 24: temporal.server.api.enums.v1.TaskSource
-	(*timestamppb.Timestamp)(nil),        // 25: google.protobuf.Timestamp
+	(*WorkerScalingRecommendation)(nil),  // 13: temporal.server.api.taskqueue.v1.WorkerScalingRecommendation
+	nil,                                  // 14: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry
+	(*EphemeralData_ByVersion)(nil),      // 15: temporal.server.api.taskqueue.v1.EphemeralData.ByVersion
+	(*EphemeralData_ByPartition)(nil),    // 16: temporal.server.api.taskqueue.v1.EphemeralData.ByPartition
+	(*emptypb.Empty)(nil),                // 17: google.protobuf.Empty
+	(v1.VersioningBehavior)(0),           // 18: temporal.api.enums.v1.VersioningBehavior
+	(*v11.Deployment)(nil),               // 19: temporal.api.deployment.v1.Deployment
+	(*v12.WorkerDeploymentVersion)(nil),  // 20: temporal.server.api.deployment.v1.WorkerDeploymentVersion
+	(*v13.TaskIdBlock)(nil),              // 21: temporal.api.taskqueue.v1.TaskIdBlock
+	(*v13.PollerInfo)(nil),               // 22: temporal.api.taskqueue.v1.PollerInfo
+	(*v13.TaskQueueStats)(nil),           // 23: temporal.api.taskqueue.v1.TaskQueueStats
+	(*durationpb.Duration)(nil),          // 24: google.protobuf.Duration
+	(v1.TaskQueueType)(0),                // 25: temporal.api.enums.v1.TaskQueueType
+	(v14.TaskSource)(0),                  // 26: temporal.server.api.enums.v1.TaskSource
+	(*timestamppb.Timestamp)(nil),        // 27: google.protobuf.Timestamp
 }
 var file_temporal_server_api_taskqueue_v1_message_proto_depIdxs = []int32{
-	16, // 0: temporal.server.api.taskqueue.v1.TaskVersionDirective.use_assignment_rules:type_name -> google.protobuf.Empty
-	17, // 1: temporal.server.api.taskqueue.v1.TaskVersionDirective.behavior:type_name -> temporal.api.enums.v1.VersioningBehavior
-	18, // 2: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment:type_name -> temporal.api.deployment.v1.Deployment
-	19, // 3: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment_version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
+	17, // 0: temporal.server.api.taskqueue.v1.TaskVersionDirective.use_assignment_rules:type_name -> google.protobuf.Empty
+	18, // 1: temporal.server.api.taskqueue.v1.TaskVersionDirective.behavior:type_name -> temporal.api.enums.v1.VersioningBehavior
+	19, // 2: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment:type_name -> temporal.api.deployment.v1.Deployment
+	20, // 3: temporal.server.api.taskqueue.v1.TaskVersionDirective.deployment_version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
 	1,  // 4: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.fair_read_level:type_name -> temporal.server.api.taskqueue.v1.FairLevel
 	1,  // 5: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.fair_ack_level:type_name -> temporal.server.api.taskqueue.v1.FairLevel
-	20, // 6: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
+	21, // 6: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
 	1,  // 7: temporal.server.api.taskqueue.v1.InternalTaskQueueStatus.fair_max_read_level:type_name -> temporal.server.api.taskqueue.v1.FairLevel
 	4,  // 8: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal.physical_task_queue_info:type_name -> temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo
-	21, // 9: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.pollers:type_name -> temporal.api.taskqueue.v1.PollerInfo
+	22, // 9: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.pollers:type_name -> temporal.api.taskqueue.v1.PollerInfo
 	2,  // 10: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.internal_task_queue_status:type_name -> temporal.server.api.taskqueue.v1.InternalTaskQueueStatus
-	22, // 11: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.task_queue_stats:type_name -> temporal.api.taskqueue.v1.TaskQueueStats
-	13, // 12: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.task_queue_stats_by_priority_key:type_name -> temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry
-	23, // 13: temporal.server.api.taskqueue.v1.TaskQueuePartition.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	6,  // 14: temporal.server.api.taskqueue.v1.TaskQueuePartition.worker_commands:type_name -> temporal.server.api.taskqueue.v1.WorkerCommandsPartitionId
-	24, // 15: temporal.server.api.taskqueue.v1.TaskForwardInfo.task_source:type_name -> temporal.server.api.enums.v1.TaskSource
-	25, // 16: temporal.server.api.taskqueue.v1.TaskForwardInfo.create_time:type_name -> google.protobuf.Timestamp
-	7,  // 17: temporal.server.api.taskqueue.v1.TaskForwardInfo.redirect_info:type_name -> temporal.server.api.taskqueue.v1.BuildIdRedirectInfo
-	15, // 18: temporal.server.api.taskqueue.v1.EphemeralData.partition:type_name -> temporal.server.api.taskqueue.v1.EphemeralData.ByPartition
-	11, // 19: temporal.server.api.taskqueue.v1.EphemeralData.scale:type_name -> temporal.server.api.taskqueue.v1.PartitionScaleInfo
-	9,  // 20: temporal.server.api.taskqueue.v1.VersionedEphemeralData.data:type_name -> temporal.server.api.taskqueue.v1.EphemeralData
-	22, // 21: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry.value:type_name -> temporal.api.taskqueue.v1.TaskQueueStats
-	19, // 22: temporal.server.api.taskqueue.v1.EphemeralData.ByVersion.version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
-	14, // 23: temporal.server.api.taskqueue.v1.EphemeralData.ByPartition.version:type_name -> temporal.server.api.taskqueue.v1.EphemeralData.ByVersion
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	23, // 11: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.task_queue_stats:type_name -> temporal.api.taskqueue.v1.TaskQueueStats
+	14, // 12: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.task_queue_stats_by_priority_key:type_name -> temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry
+	24, // 13: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.average_dispatch_latency:type_name -> google.protobuf.Duration
+	25, // 14: temporal.server.api.taskqueue.v1.TaskQueuePartition.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	6,  // 15: temporal.server.api.taskqueue.v1.TaskQueuePartition.worker_commands:type_name -> temporal.server.api.taskqueue.v1.WorkerCommandsPartitionId
+	26, // 16: temporal.server.api.taskqueue.v1.TaskForwardInfo.task_source:type_name -> temporal.server.api.enums.v1.TaskSource
+	27, // 17: temporal.server.api.taskqueue.v1.TaskForwardInfo.create_time:type_name -> google.protobuf.Timestamp
+	7,  // 18: temporal.server.api.taskqueue.v1.TaskForwardInfo.redirect_info:type_name -> temporal.server.api.taskqueue.v1.BuildIdRedirectInfo
+	16, // 19: temporal.server.api.taskqueue.v1.EphemeralData.partition:type_name -> temporal.server.api.taskqueue.v1.EphemeralData.ByPartition
+	11, // 20: temporal.server.api.taskqueue.v1.EphemeralData.scale:type_name -> temporal.server.api.taskqueue.v1.PartitionScaleInfo
+	9,  // 21: temporal.server.api.taskqueue.v1.VersionedEphemeralData.data:type_name -> temporal.server.api.taskqueue.v1.EphemeralData
+	24, // 22: temporal.server.api.taskqueue.v1.WorkerScalingRecommendation.average_dispatch_latency:type_name -> google.protobuf.Duration
+	24, // 23: temporal.server.api.taskqueue.v1.WorkerScalingRecommendation.backlog_age:type_name -> google.protobuf.Duration
+	23, // 24: temporal.server.api.taskqueue.v1.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKeyEntry.value:type_name -> temporal.api.taskqueue.v1.TaskQueueStats
+	20, // 25: temporal.server.api.taskqueue.v1.EphemeralData.ByVersion.version:type_name -> temporal.server.api.deployment.v1.WorkerDeploymentVersion
+	15, // 26: temporal.server.api.taskqueue.v1.EphemeralData.ByPartition.version:type_name -> temporal.server.api.taskqueue.v1.EphemeralData.ByVersion
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_taskqueue_v1_message_proto_init() }
@@ -1307,7 +1470,7 @@ func file_temporal_server_api_taskqueue_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_taskqueue_v1_message_proto_rawDesc), len(file_temporal_server_api_taskqueue_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
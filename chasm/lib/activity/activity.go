@@ -3,6 +3,7 @@ package activity
 import (
 	"errors"
 	"fmt"
+	"math/rand"
 	"slices"
 	"time"
 
@@ -575,6 +576,76 @@ func (a *Activity) handleCancellationRequested(ctx chasm.MutableContext, request
 	return &activitypb.RequestCancelActivityExecutionResponse{}, nil
 }
 
+// handleReset resets the attempt count of the activity to 1. An activity backing off between attempts is dispatched
+// again right away. For a running activity the reset is recorded and applied when the current attempt fails, so the
+// worker can still complete it.
+func (a *Activity) handleReset(ctx chasm.MutableContext, request *activitypb.ResetActivityExecutionRequest) (
+	*activitypb.ResetActivityExecutionResponse, error,
+) {
+	req := request.GetFrontendRequest()
+	if req.GetRestoreOriginalOptions() {
+		return nil, serviceerror.NewInvalidArgument("restoring original options is not supported for standalone activities")
+	}
+
+	attempt := a.LastAttempt.Get(ctx)
+	if requestID := request.GetRequestId(); requestID != "" && requestID == attempt.GetResetRequestId() {
+		// A retry of a reset that was already applied.
+		return &activitypb.ResetActivityExecutionResponse{}, nil
+	}
+	switch a.GetStatus() {
+	case activitypb.ACTIVITY_EXECUTION_STATUS_SCHEDULED:
+		if req.GetResetHeartbeat() {
+			a.clearHeartbeatDetails(ctx)
+		}
+		if attempt.GetCount() <= 1 {
+			// The first attempt has not been dispatched yet, nothing to reset.
+			return &activitypb.ResetActivityExecutionResponse{}, nil
+		}
+		attempt.Count = 1
+		// Invalidate the tasks of the attempt being replaced.
+		attempt.Stamp++
+		attempt.CurrentRetryInterval = nil
+
+		dispatchTime := ctx.Now(a)
+		if jitter := req.GetJitter().AsDuration(); jitter > 0 {
+			dispatchTime = dispatchTime.Add(time.Duration(rand.Int63n(int64(jitter))))
+		}
+		if timeout := a.GetScheduleToStartTimeout().AsDuration(); timeout > 0 {
+			ctx.AddTask(
+				a,
+				chasm.TaskAttributes{
+					ScheduledTime: dispatchTime.Add(timeout),
+				},
+				&activitypb.ScheduleToStartTimeoutTask{
+					Stamp: attempt.GetStamp(),
+				})
+		}
+		ctx.AddTask(
+			a,
+			chasm.TaskAttributes{
+				ScheduledTime: dispatchTime,
+			},
+			&activitypb.ActivityDispatchTask{
+				Stamp: attempt.GetStamp(),
+			})
+	case activitypb.ACTIVITY_EXECUTION_STATUS_STARTED:
+		attempt.ResetRequested = true
+		attempt.ResetHeartbeatRequested = req.GetResetHeartbeat()
+	default:
+		return nil, serviceerror.NewFailedPreconditionf("cannot reset activity in %s status", a.GetStatus())
+	}
+	attempt.ResetRequestId = request.GetRequestId()
+
+	return &activitypb.ResetActivityExecutionResponse{}, nil
+}
+
+// clearHeartbeatDetails drops the details of the last recorded heartbeat, if any.
+func (a *Activity) clearHeartbeatDetails(ctx chasm.MutableContext) {
+	if heartbeat, ok := a.LastHeartbeat.TryGet(ctx); ok {
+		heartbeat.Details = nil
+	}
+}
+
 // recordScheduleToStartOrCloseTimeoutFailure records schedule-to-start or schedule-to-close timeouts. Such timeouts are not retried so we
 // set the outcome failure directly and leave the attempt failure as is.
 func (a *Activity) recordScheduleToStartOrCloseTimeoutFailure(ctx chasm.MutableContext, timeoutType enumspb.TimeoutType) error {
@@ -648,7 +719,9 @@ func (a *Activity) shouldRetry(ctx chasm.Context, overridingRetryInterval time.D
 	attempt := a.LastAttempt.Get(ctx)
 	retryPolicy := a.RetryPolicy
 
-	enoughAttempts := retryPolicy.GetMaximumAttempts() == 0 || attempt.GetCount() < retryPolicy.GetMaximumAttempts()
+	enoughAttempts := retryPolicy.GetMaximumAttempts() == 0 ||
+		attempt.GetCount() < retryPolicy.GetMaximumAttempts() ||
+		attempt.GetResetRequested()
 	enoughTime, retryInterval := a.hasEnoughTimeForRetry(ctx, overridingRetryInterval)
 	return enoughAttempts && enoughTime, retryInterval
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/chasm/lib/activity/gen/activitypb/v1"
@@ -250,6 +251,139 @@ func TestActivityTerminate(t *testing.T) {
 	}
 }
 
+func TestActivityReset(t *testing.T) {
+	testCases := []struct {
+		name              string
+		activityStatus    activitypb.ActivityExecutionStatus
+		attemptCount      int32
+		expectErr         string
+		expectCount       int32
+		expectStamp       int32
+		expectRequested   bool
+		expectTaskCount   int
+		expectNoHeartbeat bool
+	}{
+		{
+			name:              "scheduled activity backing off is dispatched again as attempt 1",
+			activityStatus:    activitypb.ACTIVITY_EXECUTION_STATUS_SCHEDULED,
+			attemptCount:      3,
+			expectCount:       1,
+			expectStamp:       4,
+			expectTaskCount:   2,
+			expectNoHeartbeat: true,
+		},
+		{
+			name:              "scheduled first attempt is left as is",
+			activityStatus:    activitypb.ACTIVITY_EXECUTION_STATUS_SCHEDULED,
+			attemptCount:      1,
+			expectCount:       1,
+			expectStamp:       3,
+			expectNoHeartbeat: true,
+		},
+		{
+			name:            "started activity is reset on the next retry",
+			activityStatus:  activitypb.ACTIVITY_EXECUTION_STATUS_STARTED,
+			attemptCount:    3,
+			expectCount:     3,
+			expectStamp:     3,
+			expectRequested: true,
+		},
+		{
+			name:           "error on cancel-requested activity",
+			activityStatus: activitypb.ACTIVITY_EXECUTION_STATUS_CANCEL_REQUESTED,
+			attemptCount:   3,
+			expectErr:      "cannot reset activity in CancelRequested status",
+		},
+		{
+			name:           "error on completed activity",
+			activityStatus: activitypb.ACTIVITY_EXECUTION_STATUS_COMPLETED,
+			attemptCount:   3,
+			expectErr:      "cannot reset activity in Completed status",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &chasm.MockMutableContext{
+				MockContext: chasm.MockContext{
+					HandleNow: func(chasm.Component) time.Time { return defaultTime },
+				},
+			}
+
+			attempt := &activitypb.ActivityAttemptState{Count: tc.attemptCount, Stamp: 3}
+			heartbeat := &activitypb.ActivityHeartbeatState{
+				Details: &commonpb.Payloads{Payloads: []*commonpb.Payload{{Data: []byte("progress")}}},
+			}
+			activity := &Activity{
+				ActivityState: &activitypb.ActivityState{
+					ActivityType:           &commonpb.ActivityType{Name: "test-activity-type"},
+					Status:                 tc.activityStatus,
+					TaskQueue:              &taskqueuepb.TaskQueue{Name: "test-task-queue"},
+					ScheduleToStartTimeout: durationpb.New(2 * time.Minute),
+				},
+				LastAttempt:   chasm.NewDataField(ctx, attempt),
+				LastHeartbeat: chasm.NewDataField(ctx, heartbeat),
+			}
+
+			_, err := activity.handleReset(ctx, &activitypb.ResetActivityExecutionRequest{
+				FrontendRequest: &workflowservice.ResetActivityExecutionRequest{ResetHeartbeat: true},
+			})
+
+			if tc.expectErr != "" {
+				require.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectCount, attempt.GetCount())
+			require.Equal(t, tc.expectStamp, attempt.GetStamp())
+			require.Equal(t, tc.expectRequested, attempt.GetResetRequested())
+			require.Equal(t, tc.expectRequested, attempt.GetResetHeartbeatRequested())
+			require.Len(t, ctx.Tasks, tc.expectTaskCount)
+			if tc.expectNoHeartbeat {
+				require.Nil(t, heartbeat.GetDetails())
+			} else {
+				require.NotNil(t, heartbeat.GetDetails())
+			}
+		})
+	}
+}
+
+func TestHandleReset_RetriedRequest(t *testing.T) {
+	ctx := &chasm.MockMutableContext{
+		MockContext: chasm.MockContext{
+			HandleNow: func(chasm.Component) time.Time { return defaultTime },
+		},
+	}
+	attempt := &activitypb.ActivityAttemptState{Count: 3, Stamp: 3}
+	activity := &Activity{
+		ActivityState: &activitypb.ActivityState{
+			ActivityType: &commonpb.ActivityType{Name: "test-activity-type"},
+			Status:       activitypb.ACTIVITY_EXECUTION_STATUS_SCHEDULED,
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "test-task-queue"},
+		},
+		LastAttempt: chasm.NewDataField(ctx, attempt),
+	}
+	request := &activitypb.ResetActivityExecutionRequest{
+		FrontendRequest: &workflowservice.ResetActivityExecutionRequest{},
+		RequestId:       "reset-request-id",
+	}
+
+	_, err := activity.handleReset(ctx, request)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), attempt.GetCount())
+	require.Equal(t, int32(4), attempt.GetStamp())
+	require.Equal(t, "reset-request-id", attempt.GetResetRequestId())
+	require.Len(t, ctx.Tasks, 1)
+
+	// The attempt moved on before the retry arrives, the retry must not reset it again.
+	attempt.Count = 2
+	_, err = activity.handleReset(ctx, request)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), attempt.GetCount())
+	require.Equal(t, int32(4), attempt.GetStamp())
+	require.Len(t, ctx.Tasks, 1)
+}
+
 func TestContextMetadata(t *testing.T) {
 	t.Run("returns activity type and task queue", func(t *testing.T) {
 		ctx := &chasm.MockMutableContext{}
@@ -462,9 +462,17 @@ type ActivityAttemptState struct {
 	SdkName string `protobuf:"bytes,10,opt,name=sdk_name,json=sdkName,proto3" json:"sdk_name,omitempty"`
 	// The version of the SDK of the worker that most recently picked up an attempt of this activity (from the gRPC
 	// `client-version` header on PollActivityTaskQueue). Same overwrite semantics as sdk_name.
-	SdkVersion    string `protobuf:"bytes,11,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	SdkVersion string `protobuf:"bytes,11,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
+	// Set when an operator resets the attempts of a running activity. The next retry is then scheduled as attempt 1
+	// regardless of the retry policy's maximum attempts, and the flag is cleared.
+	ResetRequested bool `protobuf:"varint,12,opt,name=reset_requested,json=resetRequested,proto3" json:"reset_requested,omitempty"`
+	// Set together with reset_requested when the heartbeat details should be dropped before the next attempt.
+	ResetHeartbeatRequested bool `protobuf:"varint,13,opt,name=reset_heartbeat_requested,json=resetHeartbeatRequested,proto3" json:"reset_heartbeat_requested,omitempty"`
+	// The request ID of the last reset of the attempts. Used to make ResetActivityExecution idempotent in case of
+	// implicit retries.
+	ResetRequestId string `protobuf:"bytes,14,opt,name=reset_request_id,json=resetRequestId,proto3" json:"reset_request_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ActivityAttemptState) Reset() {
@@ -574,6 +582,27 @@ func (x *ActivityAttemptState) GetSdkVersion() string {
 	return ""
 }
 
+func (x *ActivityAttemptState) GetResetRequested() bool {
+	if x != nil {
+		return x.ResetRequested
+	}
+	return false
+}
+
+func (x *ActivityAttemptState) GetResetHeartbeatRequested() bool {
+	if x != nil {
+		return x.ResetHeartbeatRequested
+	}
+	return false
+}
+
+func (x *ActivityAttemptState) GetResetRequestId() string {
+	if x != nil {
+		return x.ResetRequestId
+	}
+	return ""
+}
+
 type ActivityHeartbeatState struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Details provided in the last recorded activity heartbeat.
@@ -955,7 +984,7 @@ const file_temporal_server_chasm_lib_activity_proto_v1_activity_state_proto_rawD
 	"\x06reason\x18\x04 \x01(\tR\x06reason\"7\n" +
 	"\x16ActivityTerminateState\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\"\xa4\x06\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"\xb3\a\n" +
 	"\x14ActivityAttemptState\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\x05R\x05count\x12O\n" +
 	"\x16current_retry_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x14currentRetryInterval\x12=\n" +
@@ -969,7 +998,10 @@ const file_temporal_server_chasm_lib_activity_proto_v1_activity_state_proto_rawD
 	"\bsdk_name\x18\n" +
 	" \x01(\tR\asdkName\x12\x1f\n" +
 	"\vsdk_version\x18\v \x01(\tR\n" +
-	"sdkVersion\x1a\x80\x01\n" +
+	"sdkVersion\x12'\n" +
+	"\x0freset_requested\x18\f \x01(\bR\x0eresetRequested\x12:\n" +
+	"\x19reset_heartbeat_requested\x18\r \x01(\bR\x17resetHeartbeatRequested\x12(\n" +
+	"\x10reset_request_id\x18\x0e \x01(\tR\x0eresetRequestId\x1a\x80\x01\n" +
 	"\x12LastFailureDetails\x12.\n" +
 	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12:\n" +
 	"\afailure\x18\x02 \x01(\v2 .temporal.api.failure.v1.FailureR\afailure\"\xc9\x01\n" +
@@ -375,6 +375,80 @@ func (this *RequestCancelActivityExecutionResponse) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ResetActivityExecutionRequest to the protobuf v3 wire format
+func (val *ResetActivityExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResetActivityExecutionRequest from the protobuf v3 wire format
+func (val *ResetActivityExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResetActivityExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResetActivityExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResetActivityExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResetActivityExecutionRequest
+	switch t := that.(type) {
+	case *ResetActivityExecutionRequest:
+		that1 = t
+	case ResetActivityExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResetActivityExecutionResponse to the protobuf v3 wire format
+func (val *ResetActivityExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResetActivityExecutionResponse from the protobuf v3 wire format
+func (val *ResetActivityExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResetActivityExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResetActivityExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResetActivityExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResetActivityExecutionResponse
+	switch t := that.(type) {
+	case *ResetActivityExecutionResponse:
+		that1 = t
+	case ResetActivityExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DeleteActivityExecutionRequest to the protobuf v3 wire format
 func (val *DeleteActivityExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
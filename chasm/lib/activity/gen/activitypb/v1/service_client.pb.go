@@ -273,6 +273,49 @@ func (c *ActivityServiceLayeredClient) RequestCancelActivityExecution(
 	}
 	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
 }
+func (c *ActivityServiceLayeredClient) callResetActivityExecutionNoRetry(
+	ctx context.Context,
+	request *ResetActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*ResetActivityExecutionResponse, error) {
+	var response *ResetActivityExecutionResponse
+	var err error
+	startTime := time.Now().UTC()
+	// the caller is a namespace, hence the tag below.
+	caller := headers.GetCallerInfo(ctx).CallerName
+	metricsHandler := c.metricsHandler.WithTags(
+		metrics.OperationTag("ActivityService.ResetActivityExecution"),
+		metrics.NamespaceTag(caller),
+		metrics.ServiceRoleTag(metrics.HistoryRoleTagValue),
+	)
+	metrics.ClientRequests.With(metricsHandler).Record(1)
+	defer func() {
+		if err != nil {
+			metrics.ClientFailures.With(metricsHandler).Record(1, metrics.ServiceErrorTypeTag(err))
+		}
+		metrics.ClientLatency.With(metricsHandler).Record(time.Since(startTime))
+	}()
+	shardID := common.WorkflowIDToHistoryShard(request.GetNamespaceId(), request.GetFrontendRequest().GetActivityId(), c.numShards)
+	op := func(ctx context.Context, client ActivityServiceClient) error {
+		var err error
+		ctx, cancel := context.WithTimeout(ctx, history.DefaultTimeout)
+		defer cancel()
+		response, err = client.ResetActivityExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.redirector.Execute(ctx, shardID, op)
+	return response, err
+}
+func (c *ActivityServiceLayeredClient) ResetActivityExecution(
+	ctx context.Context,
+	request *ResetActivityExecutionRequest,
+	opts ...grpc.CallOption,
+) (*ResetActivityExecutionResponse, error) {
+	call := func(ctx context.Context) (*ResetActivityExecutionResponse, error) {
+		return c.callResetActivityExecutionNoRetry(ctx, request, opts...)
+	}
+	return backoff.ThrottleRetryContextWithReturn(ctx, call, c.retryPolicy, common.IsServiceClientTransientError)
+}
 func (c *ActivityServiceLayeredClient) callDeleteActivityExecutionNoRetry(
 	ctx context.Context,
 	request *DeleteActivityExecutionRequest,
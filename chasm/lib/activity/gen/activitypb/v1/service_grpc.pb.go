@@ -25,6 +25,7 @@ const (
 	ActivityService_PollActivityExecution_FullMethodName          = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/PollActivityExecution"
 	ActivityService_TerminateActivityExecution_FullMethodName     = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/TerminateActivityExecution"
 	ActivityService_RequestCancelActivityExecution_FullMethodName = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/RequestCancelActivityExecution"
+	ActivityService_ResetActivityExecution_FullMethodName         = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/ResetActivityExecution"
 	ActivityService_DeleteActivityExecution_FullMethodName        = "/temporal.server.chasm.lib.activity.proto.v1.ActivityService/DeleteActivityExecution"
 )
 
@@ -37,6 +38,7 @@ type ActivityServiceClient interface {
 	PollActivityExecution(ctx context.Context, in *PollActivityExecutionRequest, opts ...grpc.CallOption) (*PollActivityExecutionResponse, error)
 	TerminateActivityExecution(ctx context.Context, in *TerminateActivityExecutionRequest, opts ...grpc.CallOption) (*TerminateActivityExecutionResponse, error)
 	RequestCancelActivityExecution(ctx context.Context, in *RequestCancelActivityExecutionRequest, opts ...grpc.CallOption) (*RequestCancelActivityExecutionResponse, error)
+	ResetActivityExecution(ctx context.Context, in *ResetActivityExecutionRequest, opts ...grpc.CallOption) (*ResetActivityExecutionResponse, error)
 	DeleteActivityExecution(ctx context.Context, in *DeleteActivityExecutionRequest, opts ...grpc.CallOption) (*DeleteActivityExecutionResponse, error)
 }
 
@@ -93,6 +95,15 @@ func (c *activityServiceClient) RequestCancelActivityExecution(ctx context.Conte
 	return out, nil
 }
 
+func (c *activityServiceClient) ResetActivityExecution(ctx context.Context, in *ResetActivityExecutionRequest, opts ...grpc.CallOption) (*ResetActivityExecutionResponse, error) {
+	out := new(ResetActivityExecutionResponse)
+	err := c.cc.Invoke(ctx, ActivityService_ResetActivityExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *activityServiceClient) DeleteActivityExecution(ctx context.Context, in *DeleteActivityExecutionRequest, opts ...grpc.CallOption) (*DeleteActivityExecutionResponse, error) {
 	out := new(DeleteActivityExecutionResponse)
 	err := c.cc.Invoke(ctx, ActivityService_DeleteActivityExecution_FullMethodName, in, out, opts...)
@@ -111,6 +122,7 @@ type ActivityServiceServer interface {
 	PollActivityExecution(context.Context, *PollActivityExecutionRequest) (*PollActivityExecutionResponse, error)
 	TerminateActivityExecution(context.Context, *TerminateActivityExecutionRequest) (*TerminateActivityExecutionResponse, error)
 	RequestCancelActivityExecution(context.Context, *RequestCancelActivityExecutionRequest) (*RequestCancelActivityExecutionResponse, error)
+	ResetActivityExecution(context.Context, *ResetActivityExecutionRequest) (*ResetActivityExecutionResponse, error)
 	DeleteActivityExecution(context.Context, *DeleteActivityExecutionRequest) (*DeleteActivityExecutionResponse, error)
 	mustEmbedUnimplementedActivityServiceServer()
 }
@@ -134,6 +146,9 @@ func (UnimplementedActivityServiceServer) TerminateActivityExecution(context.Con
 func (UnimplementedActivityServiceServer) RequestCancelActivityExecution(context.Context, *RequestCancelActivityExecutionRequest) (*RequestCancelActivityExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestCancelActivityExecution not implemented")
 }
+func (UnimplementedActivityServiceServer) ResetActivityExecution(context.Context, *ResetActivityExecutionRequest) (*ResetActivityExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetActivityExecution not implemented")
+}
 func (UnimplementedActivityServiceServer) DeleteActivityExecution(context.Context, *DeleteActivityExecutionRequest) (*DeleteActivityExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteActivityExecution not implemented")
 }
@@ -240,6 +255,24 @@ func _ActivityService_RequestCancelActivityExecution_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ActivityService_ResetActivityExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetActivityExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ResetActivityExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ResetActivityExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ResetActivityExecution(ctx, req.(*ResetActivityExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ActivityService_DeleteActivityExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteActivityExecutionRequest)
 	if err := dec(in); err != nil {
@@ -285,6 +318,10 @@ var ActivityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestCancelActivityExecution",
 			Handler:    _ActivityService_RequestCancelActivityExecution_Handler,
 		},
+		{
+			MethodName: "ResetActivityExecution",
+			Handler:    _ActivityService_ResetActivityExecution_Handler,
+		},
 		{
 			MethodName: "DeleteActivityExecution",
 			Handler:    _ActivityService_DeleteActivityExecution_Handler,
@@ -307,6 +307,32 @@ func (h *handler) TerminateActivityExecution(
 	return &activitypb.TerminateActivityExecutionResponse{}, nil
 }
 
+// ResetActivityExecution resets the attempts of an activity execution.
+func (h *handler) ResetActivityExecution(
+	ctx context.Context,
+	req *activitypb.ResetActivityExecutionRequest,
+) (response *activitypb.ResetActivityExecutionResponse, err error) {
+	frontendReq := req.GetFrontendRequest()
+
+	ref := chasm.NewComponentRef[*Activity](chasm.ExecutionKey{
+		NamespaceID: req.GetNamespaceId(),
+		BusinessID:  frontendReq.GetActivityId(),
+		RunID:       frontendReq.GetRunId(),
+	})
+
+	response, _, err = chasm.UpdateComponent(
+		ctx,
+		ref,
+		(*Activity).handleReset,
+		req,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 // RequestCancelActivityExecution requests cancellation of an activity execution.
 func (h *handler) RequestCancelActivityExecution(
 	ctx context.Context,
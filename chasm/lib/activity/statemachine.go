@@ -102,7 +102,16 @@ var TransitionRescheduled = chasm.NewTransition(
 	func(a *Activity, ctx chasm.MutableContext, event rescheduleEvent) error {
 		attempt := a.LastAttempt.Get(ctx)
 		currentTime := ctx.Now(a)
-		attempt.Count++
+		if attempt.GetResetRequested() {
+			if attempt.GetResetHeartbeatRequested() {
+				a.clearHeartbeatDetails(ctx)
+			}
+			attempt.Count = 1
+			attempt.ResetRequested = false
+			attempt.ResetHeartbeatRequested = false
+		} else {
+			attempt.Count++
+		}
 		attempt.Stamp++
 
 		err := a.recordFailedAttempt(ctx, event.retryInterval, event.failure, currentTime, false)
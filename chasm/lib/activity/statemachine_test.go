@@ -281,6 +281,46 @@ func TestTransitionRescheduled(t *testing.T) {
 	}
 }
 
+func TestTransitionRescheduled_ResetRequested(t *testing.T) {
+	ctx := &chasm.MockMutableContext{}
+	ctx.HandleNow = func(chasm.Component) time.Time { return defaultTime }
+	attemptState := &activitypb.ActivityAttemptState{
+		Count:                   5,
+		Stamp:                   5,
+		ResetRequested:          true,
+		ResetHeartbeatRequested: true,
+	}
+	heartbeat := &activitypb.ActivityHeartbeatState{
+		Details: &commonpb.Payloads{Payloads: []*commonpb.Payload{{Data: []byte("progress")}}},
+	}
+
+	activity := &Activity{
+		ActivityState: &activitypb.ActivityState{
+			ActivityType:           &commonpb.ActivityType{Name: "test-activity-type"},
+			RetryPolicy:            defaultRetryPolicy,
+			ScheduleToCloseTimeout: durationpb.New(defaultScheduleToCloseTimeout),
+			StartToCloseTimeout:    durationpb.New(defaultStartToCloseTimeout),
+			Status:                 activitypb.ACTIVITY_EXECUTION_STATUS_STARTED,
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: "test-task-queue"},
+		},
+		LastAttempt:   chasm.NewDataField(ctx, attemptState),
+		LastHeartbeat: chasm.NewDataField(ctx, heartbeat),
+		Outcome:       chasm.NewDataField(ctx, &activitypb.ActivityOutcome{}),
+	}
+
+	err := TransitionRescheduled.Apply(activity, ctx, rescheduleEvent{
+		retryInterval: 2 * time.Second,
+		failure:       createStartToCloseTimeoutFailure(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, activitypb.ACTIVITY_EXECUTION_STATUS_SCHEDULED, activity.Status)
+	require.Equal(t, int32(1), attemptState.GetCount())
+	require.Equal(t, int32(6), attemptState.GetStamp())
+	require.False(t, attemptState.GetResetRequested())
+	require.False(t, attemptState.GetResetHeartbeatRequested())
+	require.Nil(t, heartbeat.GetDetails())
+}
+
 func TestTransitionStarted(t *testing.T) {
 	ctx := &chasm.MockMutableContext{}
 	ctx.HandleNow = func(chasm.Component) time.Time { return defaultTime }
@@ -444,6 +444,42 @@ func validateAndNormalizeDeleteRequest(
 	return nil
 }
 
+func validateResetRequest(
+	req *workflowservice.ResetActivityExecutionRequest,
+	maxIDLengthLimit int,
+) error {
+	if req.GetWorkflowId() != "" {
+		return serviceerror.NewInvalidArgument("workflow ID must not be set for standalone activities")
+	}
+
+	if req.GetActivityId() == "" {
+		return serviceerror.NewInvalidArgument("activity ID is required")
+	}
+
+	if len(req.GetActivityId()) > maxIDLengthLimit {
+		return serviceerror.NewInvalidArgumentf("activity ID exceeds length limit. Length=%d Limit=%d",
+			len(req.GetActivityId()), maxIDLengthLimit)
+	}
+
+	if len(req.GetIdentity()) > maxIDLengthLimit {
+		return serviceerror.NewInvalidArgumentf("identity exceeds length limit. Length=%d Limit=%d",
+			len(req.GetIdentity()), maxIDLengthLimit)
+	}
+
+	if runID := req.GetRunId(); runID != "" {
+		_, err := uuid.Parse(runID)
+		if err != nil {
+			return serviceerror.NewInvalidArgument("invalid run id: must be a valid UUID")
+		}
+	}
+
+	if req.GetJitter().AsDuration() < 0 {
+		return serviceerror.NewInvalidArgument("jitter must not be negative")
+	}
+
+	return nil
+}
+
 func validateAndNormalizeTerminateRequest(
 	req *workflowservice.TerminateActivityExecutionRequest,
 	maxIDLengthLimit int,
@@ -104,7 +104,9 @@ func (c *Callback) loadInvocationArgs(
 		completion: completion,
 		workflowID: ctx.ExecutionKey().BusinessID,
 		runID:      ctx.ExecutionKey().RunID,
+		requestID:  c.RequestId,
 		attempt:    c.Attempt,
+		recorder:   &RequestRecorder{},
 	}, nil
 }
 
@@ -122,6 +124,13 @@ func (c *Callback) saveResult(
 		err := TransitionSucceeded.Apply(c, ctx, EventSucceeded{Time: ctx.Now(c)})
 		return nil, err
 	case invocationResultRetry:
+		if retriesExhausted(input.retryPolicy, c.Attempt, r.err) {
+			err := TransitionFailed.Apply(c, ctx, EventFailed{
+				Time: ctx.Now(c),
+				Err:  r.err,
+			})
+			return nil, err
+		}
 		err := TransitionAttemptFailed.Apply(c, ctx, EventAttemptFailed{
 			Time:        ctx.Now(c),
 			Err:         r.err,
@@ -26,35 +26,65 @@ var RequestTimeout = dynamicconfig.NewDestinationDurationSetting(
 	`RequestTimeout is the timeout for executing a single callback request.`,
 )
 
-var RetryPolicyInitialInterval = dynamicconfig.NewGlobalDurationSetting(
+var RetryPolicyInitialInterval = dynamicconfig.NewNamespaceDurationSetting(
 	"callback.retryPolicy.initialInterval",
 	time.Second,
 	`The initial backoff interval between every callback request attempt for a given callback.`,
 )
 
-var RetryPolicyMaximumInterval = dynamicconfig.NewGlobalDurationSetting(
+var RetryPolicyMaximumInterval = dynamicconfig.NewNamespaceDurationSetting(
 	"callback.retryPolicy.maxInterval",
 	time.Hour,
 	`The maximum backoff interval between every callback request attempt for a given callback.`,
 )
 
+var RetryPolicyBackoffCoefficient = dynamicconfig.NewNamespaceFloatSetting(
+	"callback.retryPolicy.backoffCoefficient",
+	2.0,
+	`The rate at which the backoff interval increases between callback request attempts for a given callback.`,
+)
+
+var RetryPolicyMaximumAttempts = dynamicconfig.NewNamespaceIntSetting(
+	"callback.retryPolicy.maximumAttempts",
+	0,
+	`The maximum number of request attempts for a given callback before it is marked as failed and moved to the
+callback dead-letter queue. Zero means unlimited attempts.`,
+)
+
+var DeadLetterQueueEnabled = dynamicconfig.NewNamespaceBoolSetting(
+	"callback.deadLetterQueue.enabled",
+	true,
+	`Whether callbacks whose delivery failed permanently or exhausted their retry policy are stored in the callback
+dead-letter queue so that they can be inspected and re-delivered by operators.`,
+)
+
 type Config struct {
-	RequestTimeout dynamicconfig.DurationPropertyFnWithDestinationFilter
-	RetryPolicy    func() backoff.RetryPolicy
+	RequestTimeout         dynamicconfig.DurationPropertyFnWithDestinationFilter
+	RetryPolicy            func(namespaceName string) backoff.RetryPolicy
+	DeadLetterQueueEnabled dynamicconfig.BoolPropertyFnWithNamespaceFilter
 }
 
 func configProvider(dc *dynamicconfig.Collection) *Config {
+	initialInterval := RetryPolicyInitialInterval.Get(dc)
+	maximumInterval := RetryPolicyMaximumInterval.Get(dc)
+	backoffCoefficient := RetryPolicyBackoffCoefficient.Get(dc)
+	maximumAttempts := RetryPolicyMaximumAttempts.Get(dc)
 	return &Config{
 		RequestTimeout: RequestTimeout.Get(dc),
-		RetryPolicy: func() backoff.RetryPolicy {
+		RetryPolicy: func(namespaceName string) backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(
-				RetryPolicyInitialInterval.Get(dc)(),
+				initialInterval(namespaceName),
 			).WithMaximumInterval(
-				RetryPolicyMaximumInterval.Get(dc)(),
+				maximumInterval(namespaceName),
+			).WithBackoffCoefficient(
+				backoffCoefficient(namespaceName),
+			).WithMaximumAttempts(
+				maximumAttempts(namespaceName),
 			).WithExpirationInterval(
 				backoff.NoInterval,
 			)
 		},
+		DeadLetterQueueEnabled: DeadLetterQueueEnabled.Get(dc),
 	}
 }
 
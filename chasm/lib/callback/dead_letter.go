@@ -0,0 +1,198 @@
+package callback
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	failurepb "go.temporal.io/api/failure/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	callbackspb "go.temporal.io/server/chasm/lib/callback/gen/callbackpb/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	queuescommon "go.temporal.io/server/service/history/queues/common"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// deadLetterable is implemented by invocables whose abandoned requests can be stored in the callback dead-letter queue
+// and re-delivered later.
+type deadLetterable interface {
+	// deadLetteredCallback returns the callback to store, or nil if no request was sent.
+	deadLetteredCallback() *persistencespb.DeadLetteredCallback
+}
+
+// redactedHeaders are the (canonical) names of the headers whose values are never stored in the dead-letter queue since
+// they carry credentials.
+var redactedHeaders = map[string]struct{}{
+	"Authorization":       {},
+	"Proxy-Authorization": {},
+	"Cookie":              {},
+	"X-Api-Key":           {},
+}
+
+// RequestRecorder keeps a copy of the last HTTP request sent for a callback. Credential headers are redacted.
+type RequestRecorder struct {
+	request *persistencespb.DeadLetteredCallback_HttpRequest
+}
+
+// Request returns the last recorded request, or nil if no request was sent.
+func (r *RequestRecorder) Request() *persistencespb.DeadLetteredCallback_HttpRequest {
+	return r.request
+}
+
+// Wrap returns an HTTPCaller that records each request before delegating to caller.
+func (r *RequestRecorder) Wrap(caller HTTPCaller) HTTPCaller {
+	return func(req *http.Request) (*http.Response, error) {
+		recorded := &persistencespb.DeadLetteredCallback_HttpRequest{
+			Method: req.Method,
+			Url:    req.URL.String(),
+			Header: make(map[string]string, len(req.Header)),
+		}
+		for k := range req.Header {
+			if _, ok := redactedHeaders[http.CanonicalHeaderKey(k)]; ok {
+				recorded.RedactedHeaders = append(recorded.RedactedHeaders, k)
+				continue
+			}
+			recorded.Header[k] = req.Header.Get(k)
+		}
+		slices.Sort(recorded.RedactedHeaders)
+		if req.Body != nil {
+			body, err := io.ReadAll(req.Body)
+			_ = req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			recorded.Body = body
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		r.request = recorded
+		return caller(req)
+	}
+}
+
+// retriesExhausted returns true if the retry policy does not allow another attempt after the given (zero based) attempt
+// failed.
+func retriesExhausted(policy backoff.RetryPolicy, attempt int32, err error) bool {
+	// Use 0 for elapsed time as we don't limit the retry by time (for now).
+	return policy.ComputeNextDelay(0, int(attempt)+1, err) < 0
+}
+
+// shouldDeadLetter returns true if the invocation result is final and not successful.
+func shouldDeadLetter(result invocationResult, policy backoff.RetryPolicy, attempt int32) bool {
+	switch r := result.(type) {
+	case invocationResultFail:
+		return true
+	case invocationResultRetry:
+		return retriesExhausted(policy, attempt, r.err)
+	default:
+		return false
+	}
+}
+
+// deadLetter stores the callback of a task whose failure was committed.
+func (h *invocationTaskHandler) deadLetter(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	ref chasm.ComponentRef,
+	task *callbackspb.InvocationTask,
+	taskAttr chasm.TaskAttributes,
+	invokable invocable,
+	result invocationResult,
+) {
+	dl, ok := invokable.(deadLetterable)
+	if !ok || h.dlqManager == nil || !h.config.DeadLetterQueueEnabled(ns.Name().String()) {
+		return
+	}
+	cb := dl.deadLetteredCallback()
+	if cb == nil {
+		return
+	}
+	cb.NamespaceId = ref.NamespaceID
+	cb.Destination = taskAttr.Destination
+	cb.Attempt = task.Attempt + 1
+	cb.LastAttemptFailure = &failurepb.Failure{Message: result.error().Error()}
+	EnqueueDeadLetteredCallback(ctx, h.dlqManager, h.metricsHandler, h.logger, ns.Name(), cb)
+}
+
+// EnqueueDeadLetteredCallback adds a callback whose failure was committed to the dead-letter queue of its namespace.
+// The task that failed the callback is no longer valid once the failure is committed, so the enqueue is retried in
+// place and an enqueue that still fails is logged rather than returned.
+func EnqueueDeadLetteredCallback(
+	ctx context.Context,
+	dlqManager persistence.CallbackDLQManager,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+	nsName namespace.Name,
+	cb *persistencespb.DeadLetteredCallback,
+) {
+	cb.DeadLetteredTime = timestamppb.New(time.Now().UTC())
+	logger = log.With(logger,
+		tag.WorkflowNamespace(nsName.String()),
+		tag.WorkflowID(cb.GetBusinessId()),
+		tag.WorkflowRunID(cb.GetRunId()),
+		tag.String("destination", cb.GetDestination()),
+	)
+
+	var resp *persistence.EnqueueCallbackResponse
+	err := backoff.ThrottleRetryContext(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = dlqManager.EnqueueCallback(ctx, &persistence.EnqueueCallbackRequest{Callback: cb})
+		return err
+	}, common.CreatePersistenceClientRetryPolicy(), common.IsPersistenceTransientError)
+	if err != nil {
+		logger.Error("Failed to add callback to dead-letter queue", tag.Error(err))
+		return
+	}
+	metricsHandler.Counter(DeadLetteredCounter.Name()).Record(
+		1,
+		metrics.NamespaceTag(nsName.String()),
+		metrics.DestinationTag(cb.GetDestination()),
+	)
+	logger.Warn("Callback moved to dead-letter queue", tag.NewInt64("message-id", resp.Metadata.ID))
+}
+
+// ReplayDeadLetteredCallback re-sends the stored request of a dead-lettered callback. The state of the callback in its
+// execution is not modified. Redacted headers are not sent; destinations that require them must be authorized by the
+// HTTP caller (e.g. through the outbound TLS configuration).
+func ReplayDeadLetteredCallback(
+	ctx context.Context,
+	callerProvider HTTPCallerProvider,
+	cb *persistencespb.DeadLetteredCallback,
+) error {
+	stored := cb.GetRequest()
+	if stored == nil {
+		return errors.New("dead-lettered callback has no stored request")
+	}
+	req, err := http.NewRequestWithContext(ctx, stored.GetMethod(), stored.GetUrl(), bytes.NewReader(stored.GetBody()))
+	if err != nil {
+		return fmt.Errorf("invalid stored request: %w", err)
+	}
+	for k, v := range stored.GetHeader() {
+		req.Header.Set(k, v)
+	}
+	caller := callerProvider(queuescommon.NamespaceIDAndDestination{
+		NamespaceID: cb.GetNamespaceId(),
+		Destination: cb.GetDestination(),
+	})
+	resp, err := caller(req)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	return nil
+}
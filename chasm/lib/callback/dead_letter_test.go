@@ -0,0 +1,73 @@
+package callback
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/service/history/queues/common"
+)
+
+func TestReplayDeadLetteredCallback(t *testing.T) {
+	cb := &persistencespb.DeadLetteredCallback{
+		NamespaceId: "namespace-id",
+		Destination: "http://localhost",
+		Request: &persistencespb.DeadLetteredCallback_HttpRequest{
+			Method: http.MethodPost,
+			Url:    "http://localhost/callback",
+			Header: map[string]string{"Nexus-Callback-Token": "token"},
+			Body:   []byte("completion"),
+		},
+	}
+
+	var gotKey common.NamespaceIDAndDestination
+	provider := func(key common.NamespaceIDAndDestination) HTTPCaller {
+		gotKey = key
+		return func(r *http.Request) (*http.Response, error) {
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, "http://localhost/callback", r.URL.String())
+			require.Equal(t, "token", r.Header.Get("Nexus-Callback-Token"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, "completion", string(body))
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}
+	}
+	require.NoError(t, ReplayDeadLetteredCallback(context.Background(), provider, cb))
+	require.Equal(t, common.NamespaceIDAndDestination{NamespaceID: "namespace-id", Destination: "http://localhost"}, gotKey)
+
+	failingProvider := func(common.NamespaceIDAndDestination) HTTPCaller {
+		return func(r *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusBadRequest, Status: "400 Bad Request", Body: http.NoBody}, nil
+		}
+	}
+	require.ErrorContains(t, ReplayDeadLetteredCallback(context.Background(), failingProvider, cb), "400 Bad Request")
+
+	require.Error(t, ReplayDeadLetteredCallback(context.Background(), provider, &persistencespb.DeadLetteredCallback{}))
+}
+
+func TestRequestRecorder_RedactsCredentials(t *testing.T) {
+	var recorder RequestRecorder
+	caller := recorder.Wrap(func(r *http.Request) (*http.Response, error) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "completion", string(body))
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+	req, err := http.NewRequest(http.MethodPost, "http://localhost/callback", strings.NewReader("completion"))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("Cookie", "session=secret")
+	req.Header.Set("Nexus-Callback-Token", "token")
+	_, err = caller(req)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{"Nexus-Callback-Token": "token"}, recorder.Request().GetHeader())
+	require.Equal(t, []string{"Authorization", "Cookie"}, recorder.Request().GetRedactedHeaders())
+	require.Equal(t, "completion", string(recorder.Request().GetBody()))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
@@ -34,17 +35,26 @@ func httpCallerProviderProvider(
 	if err != nil {
 		return nil, fmt.Errorf("cannot create local frontend HTTP client: %w", err)
 	}
-	defaultClient := &http.Client{}
 	callbackTokenGenerator := commonnexus.NewCallbackTokenGenerator()
 
-	m := collection.NewOnceMap(func(queuescommon.NamespaceIDAndDestination) HTTPCaller {
+	m := collection.NewOnceMap(func(key queuescommon.NamespaceIDAndDestination) HTTPCaller {
+		// Only requests to external destinations go through this client and get signed.
+		signingClient := &http.Client{
+			Transport: commonnexus.NewCallbackSigningTransport(http.DefaultTransport, func() ([]*persistencespb.CallbackSigningKey, error) {
+				ns, err := namespaceRegistry.GetNamespaceByID(namespace.ID(key.NamespaceID))
+				if err != nil {
+					return nil, err
+				}
+				return ns.Config().GetCallbackSigningKeys(), nil
+			}),
+		}
 		return func(r *http.Request) (*http.Response, error) {
 			return routeRequest(r,
 				clusterMetadata,
 				namespaceRegistry,
 				httpClientCache,
 				callbackTokenGenerator,
-				defaultClient,
+				signingClient,
 				localClient,
 				logger,
 			)
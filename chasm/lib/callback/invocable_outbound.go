@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/nexus-rpc/sdk-go/nexus"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/chasm"
 	callbackspb "go.temporal.io/server/chasm/lib/callback/gen/callbackpb/v1"
 	"go.temporal.io/server/common/log"
@@ -25,7 +26,22 @@ type invocableOutbound struct {
 	callback          *callbackspb.Callback_Nexus
 	completion        nexusrpc.CompleteOperationOptions
 	workflowID, runID string
+	requestID         string
 	attempt           int32
+	// recorder keeps the last request sent so that it can be dead-lettered. May be nil.
+	recorder *RequestRecorder
+}
+
+func (n invocableOutbound) deadLetteredCallback() *persistencespb.DeadLetteredCallback {
+	if n.recorder == nil || n.recorder.Request() == nil {
+		return nil
+	}
+	return &persistencespb.DeadLetteredCallback{
+		BusinessId: n.workflowID,
+		RunId:      n.runID,
+		RequestId:  n.requestID,
+		Request:    n.recorder.Request(),
+	}
 }
 
 func (n invocableOutbound) WrapError(result invocationResult, err error) error {
@@ -57,11 +73,15 @@ func (n invocableOutbound) Invoke(
 		}
 	}
 
+	caller := h.httpCallerProvider(queuescommon.NamespaceIDAndDestination{
+		NamespaceID: ns.ID().String(),
+		Destination: taskAttr.Destination,
+	})
+	if n.recorder != nil {
+		caller = n.recorder.Wrap(caller)
+	}
 	client := nexusrpc.NewCompletionHTTPClient(nexusrpc.CompletionHTTPClientOptions{
-		HTTPCaller: h.httpCallerProvider(queuescommon.NamespaceIDAndDestination{
-			NamespaceID: ns.ID().String(),
-			Destination: taskAttr.Destination,
-		}),
+		HTTPCaller: caller,
 		Serializer: commonnexus.PayloadSerializer,
 	})
 	// Make the call and record metrics.
@@ -13,4 +13,8 @@ var (
 		"callback_outbound_latency",
 		metrics.WithDescription("Latency histogram of outbound callback requests made by the history service."),
 	)
+	DeadLetteredCounter = metrics.NewCounterDef(
+		"callback_dead_lettered",
+		metrics.WithDescription("The number of callbacks moved to the callback dead-letter queue."),
+	)
 )
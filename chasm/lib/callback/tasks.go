@@ -11,6 +11,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/service/history/queues/common"
 	"go.uber.org/fx"
@@ -79,6 +80,7 @@ type invocationTaskHandlerOptions struct {
 	HTTPCallerProvider HTTPCallerProvider
 	HTTPTraceProvider  commonnexus.HTTPClientTraceProvider
 	HistoryClient      resource.HistoryClient
+	DLQManager         persistence.CallbackDLQManager
 }
 
 type invocationTaskHandler struct {
@@ -90,6 +92,7 @@ type invocationTaskHandler struct {
 	httpCallerProvider HTTPCallerProvider
 	httpTraceProvider  commonnexus.HTTPClientTraceProvider
 	historyClient      resource.HistoryClient
+	dlqManager         persistence.CallbackDLQManager
 }
 
 func newInvocationTaskHandler(opts invocationTaskHandlerOptions) *invocationTaskHandler {
@@ -101,6 +104,7 @@ func newInvocationTaskHandler(opts invocationTaskHandlerOptions) *invocationTask
 		httpCallerProvider: opts.HTTPCallerProvider,
 		httpTraceProvider:  opts.HTTPTraceProvider,
 		historyClient:      opts.HistoryClient,
+		dlqManager:         opts.DLQManager,
 	}
 }
 
@@ -136,15 +140,21 @@ func (h *invocationTaskHandler) Execute(
 	defer cancel()
 
 	result := invokable.Invoke(callCtx, ns, h, task, taskAttr)
+	retryPolicy := h.config.RetryPolicy(ns.Name().String())
 	_, _, saveErr := chasm.UpdateComponent(
 		ctx,
 		ref,
 		(*Callback).saveResult,
 		saveResultInput{
 			result:      result,
-			retryPolicy: h.config.RetryPolicy(),
+			retryPolicy: retryPolicy,
 		},
 	)
+	// Dead-letter only once the failure is committed: a failed save retries the task, which would otherwise enqueue
+	// the same callback again.
+	if saveErr == nil && shouldDeadLetter(result, retryPolicy, task.Attempt) {
+		h.deadLetter(ctx, ns, ref, task, taskAttr, invokable, result)
+	}
 	return invokable.WrapError(result, saveErr)
 }
 
@@ -25,6 +25,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/nexus/nexusrpc"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/service/history/queues/common"
 	queueserrors "go.temporal.io/server/service/history/queues/errors"
@@ -85,6 +86,8 @@ func TestExecuteInvocationTaskNexus_Outcomes(t *testing.T) {
 	cases := []struct {
 		name                  string
 		caller                HTTPCaller
+		maxAttempts           int
+		expectDeadLettered    bool
 		expectedMetricOutcome string
 		assertOutcome         func(*testing.T, *Callback, error)
 	}{
@@ -134,6 +137,33 @@ func TestExecuteInvocationTaskNexus_Outcomes(t *testing.T) {
 				require.Equal(t, callbackspb.CALLBACK_STATUS_FAILED, cb.Status)
 			},
 		},
+		{
+			name: "non-retryable-http-error-dead-lettered",
+			caller: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: 400, Body: http.NoBody}, nil
+			},
+			expectDeadLettered:    true,
+			expectedMetricOutcome: "handler-error:BAD_REQUEST",
+			assertOutcome: func(t *testing.T, cb *Callback, err error) {
+				require.NoError(t, err)
+				require.Equal(t, callbackspb.CALLBACK_STATUS_FAILED, cb.Status)
+			},
+		},
+		{
+			name: "retries-exhausted-dead-lettered",
+			caller: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: 500, Body: http.NoBody}, nil
+			},
+			maxAttempts:           1,
+			expectDeadLettered:    true,
+			expectedMetricOutcome: "handler-error:INTERNAL",
+			assertOutcome: func(t *testing.T, cb *Callback, err error) {
+				var destDownErr *queueserrors.DestinationDownError
+				require.ErrorAs(t, err, &destDownErr)
+				require.Equal(t, callbackspb.CALLBACK_STATUS_FAILED, cb.Status)
+				require.Equal(t, int32(1), cb.Attempt)
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -179,9 +209,10 @@ func TestExecuteInvocationTaskNexus_Outcomes(t *testing.T) {
 			handler := &invocationTaskHandler{
 				config: &Config{
 					RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
-					RetryPolicy: func() backoff.RetryPolicy {
-						return backoff.NewExponentialRetryPolicy(time.Second)
+					RetryPolicy: func(string) backoff.RetryPolicy {
+						return backoff.NewExponentialRetryPolicy(time.Second).WithMaximumAttempts(tc.maxAttempts)
 					},
+					DeadLetterQueueEnabled: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
 				},
 				namespaceRegistry: nsRegistry,
 				metricsHandler:    metricsHandler,
@@ -190,6 +221,28 @@ func TestExecuteInvocationTaskNexus_Outcomes(t *testing.T) {
 					return tc.caller
 				},
 			}
+			if tc.expectDeadLettered {
+				dlqManager := persistence.NewMockCallbackDLQManager(ctrl)
+				dlqManager.EXPECT().EnqueueCallback(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *persistence.EnqueueCallbackRequest) (*persistence.EnqueueCallbackResponse, error) {
+						require.Equal(t, "namespace-id", request.Callback.GetNamespaceId())
+						require.Equal(t, "workflow-id", request.Callback.GetBusinessId())
+						require.Equal(t, "run-id", request.Callback.GetRunId())
+						require.Equal(t, "request-id", request.Callback.GetRequestId())
+						require.Equal(t, "http://localhost", request.Callback.GetDestination())
+						require.Equal(t, int32(1), request.Callback.GetAttempt())
+						require.Equal(t, http.MethodPost, request.Callback.GetRequest().GetMethod())
+						require.Equal(t, "http://localhost", request.Callback.GetRequest().GetUrl())
+						require.NotEmpty(t, request.Callback.GetLastAttemptFailure().GetMessage())
+						return &persistence.EnqueueCallbackResponse{}, nil
+					})
+				handler.dlqManager = dlqManager
+				dlqCounter := metrics.NewMockCounterIface(ctrl)
+				metricsHandler.EXPECT().Counter(DeadLetteredCounter.Name()).Return(dlqCounter)
+				dlqCounter.EXPECT().Record(int64(1),
+					metrics.NamespaceTag("namespace-name"),
+					metrics.DestinationTag("http://localhost"))
+			}
 
 			chasmRegistry := chasm.NewRegistry(logger)
 			err = chasmRegistry.Register(&Library{
@@ -536,7 +589,7 @@ func TestExecuteInvocationTaskChasm_Outcomes(t *testing.T) {
 			handler := &invocationTaskHandler{
 				config: &Config{
 					RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
-					RetryPolicy: func() backoff.RetryPolicy {
+					RetryPolicy: func(string) backoff.RetryPolicy {
 						return backoff.NewExponentialRetryPolicy(time.Second)
 					},
 				},
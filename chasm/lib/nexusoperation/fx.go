@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -23,7 +24,13 @@ import (
 	"go.uber.org/fx"
 )
 
-const nexusCallbackSourceHeader = "Nexus-Callback-Source"
+const (
+	nexusCallbackSourceHeader = "Nexus-Callback-Source"
+
+	// Compiled HTTP targets are cheap to rebuild, the bound only keeps deleted endpoints from piling up.
+	httpTargetCacheMaxSize = 1000
+	httpTargetCacheTTL     = time.Hour
+)
 
 var Module = fx.Module(
 	"chasm.lib.nexusoperation",
@@ -128,6 +135,7 @@ func clientProviderFactory(
 	if clusterInfo, ok := clusterMetadata.GetAllClusterInfo()[clusterMetadata.GetCurrentClusterName()]; ok {
 		clusterID = clusterInfo.ClusterID
 	}
+	httpTargets := commonnexus.NewHTTPTargetCache(httpTargetCacheMaxSize, httpTargetCacheTTL)
 	m := collection.NewFallibleOnceMap(func(key clientProviderCacheKey) (*http.Client, error) {
 		transport := httpTransportProvider(key.namespaceID, key.endpointID)
 		return &http.Client{
@@ -154,6 +162,17 @@ func clientProviderFactory(
 					return resp, callErr
 				}
 			}
+		case *persistencespb.NexusEndpointTarget_Http_:
+			target, err := httpTargets.Get(entry.Id, entry.Version, variant.Http)
+			if err != nil {
+				return nil, serviceerror.NewInternalf("invalid HTTP endpoint target: %v", err)
+			}
+			url = target.URL()
+			httpClient, err = m.Get(clientProviderCacheKey{namespaceID, entry.Id, url})
+			if err != nil {
+				return nil, err
+			}
+			httpCaller = target.Caller(httpClient.Do)
 		case *persistencespb.NexusEndpointTarget_Worker_:
 			url = cl.BaseURL() + "/" + commonnexus.RouteDispatchNexusTaskByEndpoint.Path(entry.Id)
 			httpClient = &cl.Client
@@ -0,0 +1,71 @@
+package nexusoperation
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nexus-rpc/sdk-go/nexus"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cluster"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClientProvider_HTTPTarget(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/orders/create", r.URL.Path)
+		require.Equal(t, "secret", r.Header.Get("Authorization"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"item":"book"}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"o-1"}`))
+	}))
+	defer srv.Close()
+
+	ctrl := gomock.NewController(t)
+	rpcFactory := common.NewMockRPCFactory(ctrl)
+	rpcFactory.EXPECT().CreateLocalFrontendHTTPClient().Return(&common.FrontendHTTPClient{}, nil)
+	clusterMetadata := cluster.NewMockMetadata(ctrl)
+	clusterMetadata.EXPECT().GetAllClusterInfo().Return(nil)
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return("active")
+
+	provider, err := clientProviderFactory(defaultNexusTransportProvider(), clusterMetadata, rpcFactory)
+	require.NoError(t, err)
+
+	entry := &persistencespb.NexusEndpointEntry{
+		Id:      "endpoint-id",
+		Version: 1,
+		Endpoint: &persistencespb.NexusEndpoint{
+			Spec: &persistencespb.NexusEndpointSpec{
+				Name: "endpoint",
+				Target: &persistencespb.NexusEndpointTarget{
+					Variant: &persistencespb.NexusEndpointTarget_Http_{
+						Http: &persistencespb.NexusEndpointTarget_Http{
+							Url:     srv.URL + "/api",
+							Headers: map[string]string{"Authorization": "secret"},
+						},
+					},
+				},
+			},
+		},
+	}
+	client, err := provider(context.Background(), "ns-id", entry, "orders")
+	require.NoError(t, err)
+
+	result, err := client.StartOperation(context.Background(), "create", &commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte("json/plain")},
+		Data:     []byte(`{"item":"book"}`),
+	}, nexus.StartOperationOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, result.Successful)
+	var output *commonpb.Payload
+	require.NoError(t, result.Successful.Consume(&output))
+	require.JSONEq(t, `{"id":"o-1"}`, string(output.Data))
+}
@@ -214,6 +214,12 @@ func (rc *RegistrableComponent) hasBusinessIDAlias() bool {
 	return ok
 }
 
+// HasVisibility returns true if the component has a Field[*Visibility], i.e. if executions of the archetype have a
+// visibility record.
+func (rc *RegistrableComponent) HasVisibility() bool {
+	return hasVisibilityField(rc.goType)
+}
+
 // GoType returns the reflect.Type of the component's Go struct.
 func (rc *RegistrableComponent) GoType() reflect.Type {
 	return rc.goType
@@ -59,6 +59,16 @@ func (c *clientImpl) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) CreateWorkflowRule(
+	ctx context.Context,
+	request *adminservice.CreateWorkflowRuleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateWorkflowRuleResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CreateWorkflowRule(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeepHealthCheck(
 	ctx context.Context,
 	request *adminservice.DeepHealthCheckRequest,
@@ -69,6 +79,16 @@ func (c *clientImpl) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteCallbackSigningKey(
+	ctx context.Context,
+	request *adminservice.DeleteCallbackSigningKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteCallbackSigningKeyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteCallbackSigningKey(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -99,6 +119,16 @@ func (c *clientImpl) DescribeDLQJob(
 	return c.client.DescribeDLQJob(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeFaultInjection(
+	ctx context.Context,
+	request *adminservice.DescribeFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeFaultInjectionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeFaultInjection(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *adminservice.DescribeHistoryHostRequest,
@@ -119,6 +149,46 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.DescribeNexusEndpointRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNexusEndpointResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeNexusEndpoint(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeOutboundDestinationHealth(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundDestinationHealthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeOutboundDestinationHealthResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeOutboundDestinationHealth(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribePersistenceConcurrencyLimiter(
+	ctx context.Context,
+	request *adminservice.DescribePersistenceConcurrencyLimiterRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribePersistenceConcurrencyLimiter(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeReplicationLag(
+	ctx context.Context,
+	request *adminservice.DescribeReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeReplicationLagResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeReplicationLag(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -129,6 +199,46 @@ func (c *clientImpl) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeVisibilityBackfill(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityBackfillRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityBackfillResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeVisibilityBackfill(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeWorker(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkerResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeWorker(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerDeploymentRolloutRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkerDeploymentRolloutResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeWorkerDeploymentRollout(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeWorkflowRule(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowRuleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkflowRuleResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeWorkflowRule(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -179,6 +289,16 @@ func (c *clientImpl) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetDeadLetteredCallback(
+	ctx context.Context,
+	request *adminservice.GetDeadLetteredCallbackRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDeadLetteredCallbackResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDeadLetteredCallback(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -249,6 +369,16 @@ func (c *clientImpl) GetTaskQueueUserData(
 	return c.client.GetTaskQueueUserData(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetWorkerScalingRecommendation(
+	ctx context.Context,
+	request *adminservice.GetWorkerScalingRecommendationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkerScalingRecommendationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetWorkerScalingRecommendation(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	request *adminservice.GetWorkflowExecutionRawHistoryRequest,
@@ -279,6 +409,16 @@ func (c *clientImpl) ImportWorkflowExecution(
 	return c.client.ImportWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListCallbackSigningKeys(
+	ctx context.Context,
+	request *adminservice.ListCallbackSigningKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListCallbackSigningKeysResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListCallbackSigningKeys(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -299,6 +439,16 @@ func (c *clientImpl) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.ListDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDeadLetteredCallbacksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDeadLetteredCallbacks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -349,6 +499,16 @@ func (c *clientImpl) MigrateSchedule(
 	return c.client.MigrateSchedule(ctx, request, opts...)
 }
 
+func (c *clientImpl) MigrateSearchAttributeType(
+	ctx context.Context,
+	request *adminservice.MigrateSearchAttributeTypeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MigrateSearchAttributeTypeResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MigrateSearchAttributeType(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -369,6 +529,16 @@ func (c *clientImpl) PurgeDLQTasks(
 	return c.client.PurgeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) PurgeDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.PurgeDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PurgeDeadLetteredCallbacksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PurgeDeadLetteredCallbacks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -429,6 +599,26 @@ func (c *clientImpl) RemoveTask(
 	return c.client.RemoveTask(ctx, request, opts...)
 }
 
+func (c *clientImpl) RenameSearchAttributeAlias(
+	ctx context.Context,
+	request *adminservice.RenameSearchAttributeAliasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameSearchAttributeAliasResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RenameSearchAttributeAlias(ctx, request, opts...)
+}
+
+func (c *clientImpl) ReplayDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.ReplayDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReplayDeadLetteredCallbacksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ReplayDeadLetteredCallbacks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -439,6 +629,16 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) RotateCallbackSigningKey(
+	ctx context.Context,
+	request *adminservice.RotateCallbackSigningKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateCallbackSigningKeyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RotateCallbackSigningKey(ctx, request, opts...)
+}
+
 func (c *clientImpl) StartAdminBatchOperation(
 	ctx context.Context,
 	request *adminservice.StartAdminBatchOperationRequest,
@@ -449,6 +649,26 @@ func (c *clientImpl) StartAdminBatchOperation(
 	return c.client.StartAdminBatchOperation(ctx, request, opts...)
 }
 
+func (c *clientImpl) StartVisibilityBackfill(
+	ctx context.Context,
+	request *adminservice.StartVisibilityBackfillRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityBackfillResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartVisibilityBackfill(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.StartWorkerDeploymentRolloutRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartWorkerDeploymentRolloutResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartWorkerDeploymentRollout(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -458,3 +678,43 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UpdateFaultInjection(
+	ctx context.Context,
+	request *adminservice.UpdateFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateFaultInjectionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateFaultInjection(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNexusEndpointHttpTarget(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointHttpTargetRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointHttpTargetResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNexusEndpointHttpTarget(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateOutboundCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.UpdateOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateOutboundCircuitBreakerResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateOutboundCircuitBreaker(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerDeploymentRolloutRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerDeploymentRolloutResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateWorkerDeploymentRollout(ctx, request, opts...)
+}
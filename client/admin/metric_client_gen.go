@@ -79,6 +79,20 @@ func (c *metricClient) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *metricClient) CreateWorkflowRule(
+	ctx context.Context,
+	request *adminservice.CreateWorkflowRuleRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CreateWorkflowRuleResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCreateWorkflowRule")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CreateWorkflowRule(ctx, request, opts...)
+}
+
 func (c *metricClient) DeepHealthCheck(
 	ctx context.Context,
 	request *adminservice.DeepHealthCheckRequest,
@@ -93,6 +107,20 @@ func (c *metricClient) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteCallbackSigningKey(
+	ctx context.Context,
+	request *adminservice.DeleteCallbackSigningKeyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteCallbackSigningKeyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteCallbackSigningKey")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteCallbackSigningKey(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -135,6 +163,20 @@ func (c *metricClient) DescribeDLQJob(
 	return c.client.DescribeDLQJob(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeFaultInjection(
+	ctx context.Context,
+	request *adminservice.DescribeFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeFaultInjectionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeFaultInjection")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeFaultInjection(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *adminservice.DescribeHistoryHostRequest,
@@ -163,6 +205,62 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.DescribeNexusEndpointRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeNexusEndpointResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeNexusEndpoint")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeNexusEndpoint(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeOutboundDestinationHealth(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundDestinationHealthRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeOutboundDestinationHealthResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeOutboundDestinationHealth")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeOutboundDestinationHealth(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribePersistenceConcurrencyLimiter(
+	ctx context.Context,
+	request *adminservice.DescribePersistenceConcurrencyLimiterRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribePersistenceConcurrencyLimiterResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribePersistenceConcurrencyLimiter")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribePersistenceConcurrencyLimiter(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeReplicationLag(
+	ctx context.Context,
+	request *adminservice.DescribeReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeReplicationLagResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeReplicationLag")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeReplicationLag(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -177,6 +275,62 @@ func (c *metricClient) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeVisibilityBackfill(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityBackfillRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeVisibilityBackfillResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeVisibilityBackfill")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeVisibilityBackfill(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeWorker(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeWorkerResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeWorker")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeWorker(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerDeploymentRolloutRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeWorkerDeploymentRolloutResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeWorkerDeploymentRollout")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeWorkerDeploymentRollout(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeWorkflowRule(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowRuleRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeWorkflowRuleResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeWorkflowRule")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeWorkflowRule(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -247,6 +401,20 @@ func (c *metricClient) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) GetDeadLetteredCallback(
+	ctx context.Context,
+	request *adminservice.GetDeadLetteredCallbackRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetDeadLetteredCallbackResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetDeadLetteredCallback")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetDeadLetteredCallback(ctx, request, opts...)
+}
+
 func (c *metricClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -345,6 +513,20 @@ func (c *metricClient) GetTaskQueueUserData(
 	return c.client.GetTaskQueueUserData(ctx, request, opts...)
 }
 
+func (c *metricClient) GetWorkerScalingRecommendation(
+	ctx context.Context,
+	request *adminservice.GetWorkerScalingRecommendationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetWorkerScalingRecommendationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetWorkerScalingRecommendation")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetWorkerScalingRecommendation(ctx, request, opts...)
+}
+
 func (c *metricClient) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	request *adminservice.GetWorkflowExecutionRawHistoryRequest,
@@ -387,6 +569,20 @@ func (c *metricClient) ImportWorkflowExecution(
 	return c.client.ImportWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) ListCallbackSigningKeys(
+	ctx context.Context,
+	request *adminservice.ListCallbackSigningKeysRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListCallbackSigningKeysResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListCallbackSigningKeys")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListCallbackSigningKeys(ctx, request, opts...)
+}
+
 func (c *metricClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -415,6 +611,20 @@ func (c *metricClient) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *metricClient) ListDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.ListDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListDeadLetteredCallbacksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListDeadLetteredCallbacks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListDeadLetteredCallbacks(ctx, request, opts...)
+}
+
 func (c *metricClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -485,6 +695,20 @@ func (c *metricClient) MigrateSchedule(
 	return c.client.MigrateSchedule(ctx, request, opts...)
 }
 
+func (c *metricClient) MigrateSearchAttributeType(
+	ctx context.Context,
+	request *adminservice.MigrateSearchAttributeTypeRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.MigrateSearchAttributeTypeResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientMigrateSearchAttributeType")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MigrateSearchAttributeType(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -513,6 +737,20 @@ func (c *metricClient) PurgeDLQTasks(
 	return c.client.PurgeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) PurgeDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.PurgeDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PurgeDeadLetteredCallbacksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientPurgeDeadLetteredCallbacks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PurgeDeadLetteredCallbacks(ctx, request, opts...)
+}
+
 func (c *metricClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -597,6 +835,34 @@ func (c *metricClient) RemoveTask(
 	return c.client.RemoveTask(ctx, request, opts...)
 }
 
+func (c *metricClient) RenameSearchAttributeAlias(
+	ctx context.Context,
+	request *adminservice.RenameSearchAttributeAliasRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RenameSearchAttributeAliasResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRenameSearchAttributeAlias")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RenameSearchAttributeAlias(ctx, request, opts...)
+}
+
+func (c *metricClient) ReplayDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.ReplayDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ReplayDeadLetteredCallbacksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientReplayDeadLetteredCallbacks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReplayDeadLetteredCallbacks(ctx, request, opts...)
+}
+
 func (c *metricClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -611,6 +877,20 @@ func (c *metricClient) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) RotateCallbackSigningKey(
+	ctx context.Context,
+	request *adminservice.RotateCallbackSigningKeyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RotateCallbackSigningKeyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRotateCallbackSigningKey")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RotateCallbackSigningKey(ctx, request, opts...)
+}
+
 func (c *metricClient) StartAdminBatchOperation(
 	ctx context.Context,
 	request *adminservice.StartAdminBatchOperationRequest,
@@ -625,6 +905,34 @@ func (c *metricClient) StartAdminBatchOperation(
 	return c.client.StartAdminBatchOperation(ctx, request, opts...)
 }
 
+func (c *metricClient) StartVisibilityBackfill(
+	ctx context.Context,
+	request *adminservice.StartVisibilityBackfillRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartVisibilityBackfillResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartVisibilityBackfill")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartVisibilityBackfill(ctx, request, opts...)
+}
+
+func (c *metricClient) StartWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.StartWorkerDeploymentRolloutRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartWorkerDeploymentRolloutResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartWorkerDeploymentRollout")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartWorkerDeploymentRollout(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -638,3 +946,59 @@ func (c *metricClient) SyncWorkflowState(
 
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *metricClient) UpdateFaultInjection(
+	ctx context.Context,
+	request *adminservice.UpdateFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateFaultInjectionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateFaultInjection")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateFaultInjection(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateNexusEndpointHttpTarget(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointHttpTargetRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNexusEndpointHttpTargetResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateNexusEndpointHttpTarget")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNexusEndpointHttpTarget(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateOutboundCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.UpdateOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateOutboundCircuitBreakerResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateOutboundCircuitBreaker")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateOutboundCircuitBreaker(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerDeploymentRolloutRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateWorkerDeploymentRolloutResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateWorkerDeploymentRollout")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateWorkerDeploymentRollout(ctx, request, opts...)
+}
@@ -86,6 +86,21 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) CreateWorkflowRule(
+	ctx context.Context,
+	request *adminservice.CreateWorkflowRuleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateWorkflowRuleResponse, error) {
+	var resp *adminservice.CreateWorkflowRuleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CreateWorkflowRule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeepHealthCheck(
 	ctx context.Context,
 	request *adminservice.DeepHealthCheckRequest,
@@ -101,6 +116,21 @@ func (c *retryableClient) DeepHealthCheck(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteCallbackSigningKey(
+	ctx context.Context,
+	request *adminservice.DeleteCallbackSigningKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteCallbackSigningKeyResponse, error) {
+	var resp *adminservice.DeleteCallbackSigningKeyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteCallbackSigningKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -146,6 +176,21 @@ func (c *retryableClient) DescribeDLQJob(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeFaultInjection(
+	ctx context.Context,
+	request *adminservice.DescribeFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeFaultInjectionResponse, error) {
+	var resp *adminservice.DescribeFaultInjectionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeFaultInjection(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *adminservice.DescribeHistoryHostRequest,
@@ -176,6 +221,66 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.DescribeNexusEndpointRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNexusEndpointResponse, error) {
+	var resp *adminservice.DescribeNexusEndpointResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeNexusEndpoint(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeOutboundDestinationHealth(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundDestinationHealthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeOutboundDestinationHealthResponse, error) {
+	var resp *adminservice.DescribeOutboundDestinationHealthResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeOutboundDestinationHealth(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribePersistenceConcurrencyLimiter(
+	ctx context.Context,
+	request *adminservice.DescribePersistenceConcurrencyLimiterRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	var resp *adminservice.DescribePersistenceConcurrencyLimiterResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribePersistenceConcurrencyLimiter(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeReplicationLag(
+	ctx context.Context,
+	request *adminservice.DescribeReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeReplicationLagResponse, error) {
+	var resp *adminservice.DescribeReplicationLagResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeReplicationLag(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -191,6 +296,66 @@ func (c *retryableClient) DescribeTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeVisibilityBackfill(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityBackfillRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityBackfillResponse, error) {
+	var resp *adminservice.DescribeVisibilityBackfillResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeVisibilityBackfill(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeWorker(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkerResponse, error) {
+	var resp *adminservice.DescribeWorkerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerDeploymentRolloutRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkerDeploymentRolloutResponse, error) {
+	var resp *adminservice.DescribeWorkerDeploymentRolloutResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorkerDeploymentRollout(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeWorkflowRule(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowRuleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkflowRuleResponse, error) {
+	var resp *adminservice.DescribeWorkflowRuleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorkflowRule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -266,6 +431,21 @@ func (c *retryableClient) GetDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) GetDeadLetteredCallback(
+	ctx context.Context,
+	request *adminservice.GetDeadLetteredCallbackRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDeadLetteredCallbackResponse, error) {
+	var resp *adminservice.GetDeadLetteredCallbackResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDeadLetteredCallback(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -371,6 +551,21 @@ func (c *retryableClient) GetTaskQueueUserData(
 	return resp, err
 }
 
+func (c *retryableClient) GetWorkerScalingRecommendation(
+	ctx context.Context,
+	request *adminservice.GetWorkerScalingRecommendationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkerScalingRecommendationResponse, error) {
+	var resp *adminservice.GetWorkerScalingRecommendationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetWorkerScalingRecommendation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	request *adminservice.GetWorkflowExecutionRawHistoryRequest,
@@ -416,6 +611,21 @@ func (c *retryableClient) ImportWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ListCallbackSigningKeys(
+	ctx context.Context,
+	request *adminservice.ListCallbackSigningKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListCallbackSigningKeysResponse, error) {
+	var resp *adminservice.ListCallbackSigningKeysResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListCallbackSigningKeys(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -446,6 +656,21 @@ func (c *retryableClient) ListClusters(
 	return resp, err
 }
 
+func (c *retryableClient) ListDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.ListDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDeadLetteredCallbacksResponse, error) {
+	var resp *adminservice.ListDeadLetteredCallbacksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDeadLetteredCallbacks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -521,6 +746,21 @@ func (c *retryableClient) MigrateSchedule(
 	return resp, err
 }
 
+func (c *retryableClient) MigrateSearchAttributeType(
+	ctx context.Context,
+	request *adminservice.MigrateSearchAttributeTypeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MigrateSearchAttributeTypeResponse, error) {
+	var resp *adminservice.MigrateSearchAttributeTypeResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MigrateSearchAttributeType(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -551,6 +791,21 @@ func (c *retryableClient) PurgeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) PurgeDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.PurgeDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PurgeDeadLetteredCallbacksResponse, error) {
+	var resp *adminservice.PurgeDeadLetteredCallbacksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PurgeDeadLetteredCallbacks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -641,6 +896,36 @@ func (c *retryableClient) RemoveTask(
 	return resp, err
 }
 
+func (c *retryableClient) RenameSearchAttributeAlias(
+	ctx context.Context,
+	request *adminservice.RenameSearchAttributeAliasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameSearchAttributeAliasResponse, error) {
+	var resp *adminservice.RenameSearchAttributeAliasResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RenameSearchAttributeAlias(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ReplayDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.ReplayDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReplayDeadLetteredCallbacksResponse, error) {
+	var resp *adminservice.ReplayDeadLetteredCallbacksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReplayDeadLetteredCallbacks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -656,6 +941,21 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) RotateCallbackSigningKey(
+	ctx context.Context,
+	request *adminservice.RotateCallbackSigningKeyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateCallbackSigningKeyResponse, error) {
+	var resp *adminservice.RotateCallbackSigningKeyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RotateCallbackSigningKey(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) StartAdminBatchOperation(
 	ctx context.Context,
 	request *adminservice.StartAdminBatchOperationRequest,
@@ -671,6 +971,36 @@ func (c *retryableClient) StartAdminBatchOperation(
 	return resp, err
 }
 
+func (c *retryableClient) StartVisibilityBackfill(
+	ctx context.Context,
+	request *adminservice.StartVisibilityBackfillRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityBackfillResponse, error) {
+	var resp *adminservice.StartVisibilityBackfillResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartVisibilityBackfill(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.StartWorkerDeploymentRolloutRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartWorkerDeploymentRolloutResponse, error) {
+	var resp *adminservice.StartWorkerDeploymentRolloutResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartWorkerDeploymentRollout(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -685,3 +1015,63 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateFaultInjection(
+	ctx context.Context,
+	request *adminservice.UpdateFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateFaultInjectionResponse, error) {
+	var resp *adminservice.UpdateFaultInjectionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateFaultInjection(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNexusEndpointHttpTarget(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointHttpTargetRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNexusEndpointHttpTargetResponse, error) {
+	var resp *adminservice.UpdateNexusEndpointHttpTargetResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNexusEndpointHttpTarget(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateOutboundCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.UpdateOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateOutboundCircuitBreakerResponse, error) {
+	var resp *adminservice.UpdateOutboundCircuitBreakerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateOutboundCircuitBreaker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerDeploymentRolloutRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerDeploymentRolloutResponse, error) {
+	var resp *adminservice.UpdateWorkerDeploymentRolloutResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateWorkerDeploymentRollout(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
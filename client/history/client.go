@@ -81,6 +81,66 @@ func (c *clientImpl) DeepHealthCheck(ctx context.Context, request *historyservic
 	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeOutboundDestinationHealth(
+	ctx context.Context,
+	request *historyservice.DescribeOutboundDestinationHealthRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeOutboundDestinationHealthResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.DescribeOutboundDestinationHealth(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeWorkflowRuleHits(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowRuleHitsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeWorkflowRuleHitsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.DescribeWorkflowRuleHits(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateOutboundCircuitBreaker(
+	ctx context.Context,
+	request *historyservice.UpdateOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateOutboundCircuitBreakerResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.UpdateOutboundCircuitBreaker(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribePersistenceConcurrencyLimiter(
+	ctx context.Context,
+	request *historyservice.DescribePersistenceConcurrencyLimiterRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.DescribePersistenceConcurrencyLimiter(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateFaultInjection(
+	ctx context.Context,
+	request *historyservice.UpdateFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateFaultInjectionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.UpdateFaultInjection(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeReplicationLag(
+	ctx context.Context,
+	request *historyservice.DescribeReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeReplicationLagResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).grpcClient.DescribeReplicationLag(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *historyservice.DescribeHistoryHostRequest,
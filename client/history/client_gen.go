@@ -559,6 +559,26 @@ func (c *clientImpl) IsWorkflowTaskValid(
 	return response, nil
 }
 
+func (c *clientImpl) ListDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.ListDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListDeadLetteredCallbacksResponse, error) {
+	shardID := c.getRandomShard()
+	var response *historyservice.ListDeadLetteredCallbacksResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ListDeadLetteredCallbacks(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -719,6 +739,26 @@ func (c *clientImpl) PurgeDLQMessages(
 	return response, nil
 }
 
+func (c *clientImpl) PurgeDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.PurgeDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PurgeDeadLetteredCallbacksResponse, error) {
+	shardID := c.getRandomShard()
+	var response *historyservice.PurgeDeadLetteredCallbacksResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.PurgeDeadLetteredCallbacks(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) QueryWorkflow(
 	ctx context.Context,
 	request *historyservice.QueryWorkflowRequest,
@@ -917,6 +957,26 @@ func (c *clientImpl) RemoveTask(
 	return response, nil
 }
 
+func (c *clientImpl) ReplayDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.ReplayDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ReplayDeadLetteredCallbacksResponse, error) {
+	shardID := c.getRandomShard()
+	var response *historyservice.ReplayDeadLetteredCallbacksResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ReplayDeadLetteredCallbacks(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ReplicateEventsV2(
 	ctx context.Context,
 	request *historyservice.ReplicateEventsV2Request,
@@ -163,6 +163,48 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeOutboundDestinationHealth(
+	ctx context.Context,
+	request *historyservice.DescribeOutboundDestinationHealthRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeOutboundDestinationHealthResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeOutboundDestinationHealth")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeOutboundDestinationHealth(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribePersistenceConcurrencyLimiter(
+	ctx context.Context,
+	request *historyservice.DescribePersistenceConcurrencyLimiterRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribePersistenceConcurrencyLimiterResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribePersistenceConcurrencyLimiter")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribePersistenceConcurrencyLimiter(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeReplicationLag(
+	ctx context.Context,
+	request *historyservice.DescribeReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeReplicationLagResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeReplicationLag")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeReplicationLag(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -177,6 +219,20 @@ func (c *metricClient) DescribeWorkflowExecution(
 	return c.client.DescribeWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeWorkflowRuleHits(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowRuleHitsRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeWorkflowRuleHitsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeWorkflowRuleHits")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeWorkflowRuleHits(ctx, request, opts...)
+}
+
 func (c *metricClient) ExecuteMultiOperation(
 	ctx context.Context,
 	request *historyservice.ExecuteMultiOperationRequest,
@@ -429,6 +485,20 @@ func (c *metricClient) IsWorkflowTaskValid(
 	return c.client.IsWorkflowTaskValid(ctx, request, opts...)
 }
 
+func (c *metricClient) ListDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.ListDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ListDeadLetteredCallbacksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientListDeadLetteredCallbacks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListDeadLetteredCallbacks(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -541,6 +611,20 @@ func (c *metricClient) PurgeDLQMessages(
 	return c.client.PurgeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) PurgeDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.PurgeDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.PurgeDeadLetteredCallbacksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientPurgeDeadLetteredCallbacks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PurgeDeadLetteredCallbacks(ctx, request, opts...)
+}
+
 func (c *metricClient) QueryWorkflow(
 	ctx context.Context,
 	request *historyservice.QueryWorkflowRequest,
@@ -681,6 +765,20 @@ func (c *metricClient) RemoveTask(
 	return c.client.RemoveTask(ctx, request, opts...)
 }
 
+func (c *metricClient) ReplayDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.ReplayDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ReplayDeadLetteredCallbacksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientReplayDeadLetteredCallbacks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReplayDeadLetteredCallbacks(ctx, request, opts...)
+}
+
 func (c *metricClient) ReplicateEventsV2(
 	ctx context.Context,
 	request *historyservice.ReplicateEventsV2Request,
@@ -989,6 +1087,34 @@ func (c *metricClient) UpdateActivityOptions(
 	return c.client.UpdateActivityOptions(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateFaultInjection(
+	ctx context.Context,
+	request *historyservice.UpdateFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpdateFaultInjectionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientUpdateFaultInjection")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateFaultInjection(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateOutboundCircuitBreaker(
+	ctx context.Context,
+	request *historyservice.UpdateOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpdateOutboundCircuitBreakerResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientUpdateOutboundCircuitBreaker")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateOutboundCircuitBreaker(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
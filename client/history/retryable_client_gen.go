@@ -191,6 +191,51 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeOutboundDestinationHealth(
+	ctx context.Context,
+	request *historyservice.DescribeOutboundDestinationHealthRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeOutboundDestinationHealthResponse, error) {
+	var resp *historyservice.DescribeOutboundDestinationHealthResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeOutboundDestinationHealth(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribePersistenceConcurrencyLimiter(
+	ctx context.Context,
+	request *historyservice.DescribePersistenceConcurrencyLimiterRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	var resp *historyservice.DescribePersistenceConcurrencyLimiterResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribePersistenceConcurrencyLimiter(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeReplicationLag(
+	ctx context.Context,
+	request *historyservice.DescribeReplicationLagRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeReplicationLagResponse, error) {
+	var resp *historyservice.DescribeReplicationLagResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeReplicationLag(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -206,6 +251,21 @@ func (c *retryableClient) DescribeWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeWorkflowRuleHits(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowRuleHitsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeWorkflowRuleHitsResponse, error) {
+	var resp *historyservice.DescribeWorkflowRuleHitsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorkflowRuleHits(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ExecuteMultiOperation(
 	ctx context.Context,
 	request *historyservice.ExecuteMultiOperationRequest,
@@ -476,6 +536,21 @@ func (c *retryableClient) IsWorkflowTaskValid(
 	return resp, err
 }
 
+func (c *retryableClient) ListDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.ListDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListDeadLetteredCallbacksResponse, error) {
+	var resp *historyservice.ListDeadLetteredCallbacksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDeadLetteredCallbacks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -596,6 +671,21 @@ func (c *retryableClient) PurgeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) PurgeDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.PurgeDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PurgeDeadLetteredCallbacksResponse, error) {
+	var resp *historyservice.PurgeDeadLetteredCallbacksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PurgeDeadLetteredCallbacks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) QueryWorkflow(
 	ctx context.Context,
 	request *historyservice.QueryWorkflowRequest,
@@ -746,6 +836,21 @@ func (c *retryableClient) RemoveTask(
 	return resp, err
 }
 
+func (c *retryableClient) ReplayDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.ReplayDeadLetteredCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ReplayDeadLetteredCallbacksResponse, error) {
+	var resp *historyservice.ReplayDeadLetteredCallbacksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReplayDeadLetteredCallbacks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReplicateEventsV2(
 	ctx context.Context,
 	request *historyservice.ReplicateEventsV2Request,
@@ -1091,6 +1196,36 @@ func (c *retryableClient) UpdateActivityOptions(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateFaultInjection(
+	ctx context.Context,
+	request *historyservice.UpdateFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateFaultInjectionResponse, error) {
+	var resp *historyservice.UpdateFaultInjectionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateFaultInjection(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateOutboundCircuitBreaker(
+	ctx context.Context,
+	request *historyservice.UpdateOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateOutboundCircuitBreakerResponse, error) {
+	var resp *historyservice.UpdateOutboundCircuitBreakerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateOutboundCircuitBreaker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
@@ -447,6 +447,16 @@ func (c *clientImpl) getClientForTaskQueuePartition(
 	return client.(matchingservice.MatchingServiceClient), nil
 }
 
+func (c *clientImpl) getClientForHostAddress(
+	hostAddress string,
+) (matchingservice.MatchingServiceClient, error) {
+	client, err := c.clients.GetClientForClientKey(hostAddress)
+	if err != nil {
+		return nil, err
+	}
+	return client.(matchingservice.MatchingServiceClient), nil
+}
+
 func isPartitionAwareKind(kind enumspb.TaskQueueKind) bool {
 	// only normal partitions participate in scaling
 	return kind == enumspb.TASK_QUEUE_KIND_NORMAL
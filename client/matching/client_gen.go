@@ -153,6 +153,21 @@ func (c *clientImpl) DeleteNexusEndpoint(
 	return client.DeleteNexusEndpoint(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribePersistenceConcurrencyLimiter(
+	ctx context.Context,
+	request *matchingservice.DescribePersistenceConcurrencyLimiterRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+
+	client, err := c.getClientForHostAddress(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribePersistenceConcurrencyLimiter(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -344,6 +359,46 @@ func (c *clientImpl) GetWorkerBuildIdCompatibility(
 	return client.GetWorkerBuildIdCompatibility(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetWorkerDeploymentVersionHealth(
+	ctx context.Context,
+	request *matchingservice.GetWorkerDeploymentVersionHealthRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetWorkerDeploymentVersionHealthResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName("not-applicable", request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetWorkerDeploymentVersionHealth(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetWorkerScalingRecommendation(
+	ctx context.Context,
+	request *matchingservice.GetWorkerScalingRecommendationRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetWorkerScalingRecommendationResponse, error) {
+
+	p, err := tqid.PartitionFromProto(request.GetTaskQueue(), request.GetNamespaceId(), request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetWorkerScalingRecommendation(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetWorkerVersioningRules(
 	ctx context.Context,
 	request *matchingservice.GetWorkerVersioningRulesRequest,
@@ -424,6 +479,26 @@ func (c *clientImpl) ListWorkers(
 	return client.ListWorkers(ctx, request, opts...)
 }
 
+func (c *clientImpl) RecordNondeterminismError(
+	ctx context.Context,
+	request *matchingservice.RecordNondeterminismErrorRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.RecordNondeterminismErrorResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName("not-applicable", request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RecordNondeterminismError(ctx, request, opts...)
+}
+
 func (c *clientImpl) RecordWorkerHeartbeat(
 	ctx context.Context,
 	request *matchingservice.RecordWorkerHeartbeatRequest,
@@ -564,6 +639,21 @@ func (c *clientImpl) UpdateFairnessState(
 	return client.UpdateFairnessState(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateFaultInjection(
+	ctx context.Context,
+	request *matchingservice.UpdateFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateFaultInjectionResponse, error) {
+
+	client, err := c.getClientForHostAddress(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateFaultInjection(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.UpdateNexusEndpointRequest,
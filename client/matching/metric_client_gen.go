@@ -107,6 +107,20 @@ func (c *metricClient) DeleteNexusEndpoint(
 	return c.client.DeleteNexusEndpoint(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribePersistenceConcurrencyLimiter(
+	ctx context.Context,
+	request *matchingservice.DescribePersistenceConcurrencyLimiterRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.DescribePersistenceConcurrencyLimiterResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientDescribePersistenceConcurrencyLimiter")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribePersistenceConcurrencyLimiter(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -247,6 +261,34 @@ func (c *metricClient) GetWorkerBuildIdCompatibility(
 	return c.client.GetWorkerBuildIdCompatibility(ctx, request, opts...)
 }
 
+func (c *metricClient) GetWorkerDeploymentVersionHealth(
+	ctx context.Context,
+	request *matchingservice.GetWorkerDeploymentVersionHealthRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.GetWorkerDeploymentVersionHealthResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientGetWorkerDeploymentVersionHealth")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetWorkerDeploymentVersionHealth(ctx, request, opts...)
+}
+
+func (c *metricClient) GetWorkerScalingRecommendation(
+	ctx context.Context,
+	request *matchingservice.GetWorkerScalingRecommendationRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.GetWorkerScalingRecommendationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientGetWorkerScalingRecommendation")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetWorkerScalingRecommendation(ctx, request, opts...)
+}
+
 func (c *metricClient) GetWorkerVersioningRules(
 	ctx context.Context,
 	request *matchingservice.GetWorkerVersioningRulesRequest,
@@ -303,6 +345,20 @@ func (c *metricClient) ListWorkers(
 	return c.client.ListWorkers(ctx, request, opts...)
 }
 
+func (c *metricClient) RecordNondeterminismError(
+	ctx context.Context,
+	request *matchingservice.RecordNondeterminismErrorRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.RecordNondeterminismErrorResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientRecordNondeterminismError")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RecordNondeterminismError(ctx, request, opts...)
+}
+
 func (c *metricClient) RecordWorkerHeartbeat(
 	ctx context.Context,
 	request *matchingservice.RecordWorkerHeartbeatRequest,
@@ -401,6 +457,20 @@ func (c *metricClient) UpdateFairnessState(
 	return c.client.UpdateFairnessState(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateFaultInjection(
+	ctx context.Context,
+	request *matchingservice.UpdateFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateFaultInjectionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientUpdateFaultInjection")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateFaultInjection(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.UpdateNexusEndpointRequest,
@@ -146,6 +146,21 @@ func (c *retryableClient) DeleteNexusEndpoint(
 	return resp, err
 }
 
+func (c *retryableClient) DescribePersistenceConcurrencyLimiter(
+	ctx context.Context,
+	request *matchingservice.DescribePersistenceConcurrencyLimiterRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	var resp *matchingservice.DescribePersistenceConcurrencyLimiterResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribePersistenceConcurrencyLimiter(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -311,6 +326,36 @@ func (c *retryableClient) GetWorkerBuildIdCompatibility(
 	return resp, err
 }
 
+func (c *retryableClient) GetWorkerDeploymentVersionHealth(
+	ctx context.Context,
+	request *matchingservice.GetWorkerDeploymentVersionHealthRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetWorkerDeploymentVersionHealthResponse, error) {
+	var resp *matchingservice.GetWorkerDeploymentVersionHealthResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetWorkerDeploymentVersionHealth(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetWorkerScalingRecommendation(
+	ctx context.Context,
+	request *matchingservice.GetWorkerScalingRecommendationRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetWorkerScalingRecommendationResponse, error) {
+	var resp *matchingservice.GetWorkerScalingRecommendationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetWorkerScalingRecommendation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetWorkerVersioningRules(
 	ctx context.Context,
 	request *matchingservice.GetWorkerVersioningRulesRequest,
@@ -431,6 +476,21 @@ func (c *retryableClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) RecordNondeterminismError(
+	ctx context.Context,
+	request *matchingservice.RecordNondeterminismErrorRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.RecordNondeterminismErrorResponse, error) {
+	var resp *matchingservice.RecordNondeterminismErrorResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RecordNondeterminismError(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RecordWorkerHeartbeat(
 	ctx context.Context,
 	request *matchingservice.RecordWorkerHeartbeatRequest,
@@ -536,6 +596,21 @@ func (c *retryableClient) UpdateFairnessState(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateFaultInjection(
+	ctx context.Context,
+	request *matchingservice.UpdateFaultInjectionRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateFaultInjectionResponse, error) {
+	var resp *matchingservice.UpdateFaultInjectionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateFaultInjection(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.UpdateNexusEndpointRequest,
@@ -329,7 +329,9 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 		"ReplicateTaskQueueUserDataRequest",
 		"RecordWorkerHeartbeatRequest",
 		"ListWorkersRequest",
-		"DescribeWorkerRequest":
+		"DescribeWorkerRequest",
+		"GetWorkerDeploymentVersionHealthRequest",
+		"RecordNondeterminismErrorRequest":
 		// Always route these requests to the same matching node by namespace.
 		tq = fieldWithPath{path: "\"not-applicable\""}
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_UNSPECIFIED"}
@@ -363,6 +365,10 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 		tq = fieldWithPath{path: `"not-applicable"`}
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_UNSPECIFIED"}
 		nsID = fieldWithPath{path: `"not-applicable"`}
+	case "UpdateFaultInjectionRequest",
+		"DescribePersistenceConcurrencyLimiterRequest":
+		// Sent to every matching node, not associated with a task queue.
+		return "client, err := c.getClientForHostAddress(request.GetHostAddress())"
 	default:
 		tqp = tryFindOneNestedField(t, "TaskQueuePartition", "request", 1)
 		tq = findOneNestedField(t, "TaskQueue", "request", 2)
@@ -0,0 +1,163 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/goro"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// Budget splits a total size between multiple caches. The caches start with an equal share of the budget, which
+	// is periodically rebalanced based on their hit rates: every cache keeps at least minShare of the budget, and the
+	// rest is split in proportion to the number of hits served by each cache since the last rebalance.
+	Budget struct {
+		totalSize         dynamicconfig.IntPropertyFn
+		minShare          dynamicconfig.FloatPropertyFn
+		rebalanceInterval dynamicconfig.DurationPropertyFn
+		timeSource        clock.TimeSource
+		metricsHandler    metrics.Handler
+
+		mu      sync.Mutex
+		members []*budgetMember
+		loops   goro.Group
+	}
+
+	budgetMember struct {
+		cache          ResizableCache
+		maxSize        int
+		metricsHandler metrics.Handler
+	}
+)
+
+// NewBudget creates a new Budget. Caches are added with Register and the budget is rebalanced every
+// rebalanceInterval once Start is called.
+func NewBudget(
+	totalSize dynamicconfig.IntPropertyFn,
+	minShare dynamicconfig.FloatPropertyFn,
+	rebalanceInterval dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+	metricsHandler metrics.Handler,
+) *Budget {
+	if timeSource == nil {
+		timeSource = clock.NewRealTimeSource()
+	}
+	return &Budget{
+		totalSize:         totalSize,
+		minShare:          minShare,
+		rebalanceInterval: rebalanceInterval,
+		timeSource:        timeSource,
+		metricsHandler:    metricsHandler,
+	}
+}
+
+// Register adds a cache to the budget and splits the budget equally between all registered caches. cacheType is used
+// to tag the metrics of the cache. The cache must have been created by New or NewWithMetrics.
+func (b *Budget) Register(cacheType string, c Cache) error {
+	resizable, ok := c.(ResizableCache)
+	if !ok {
+		return fmt.Errorf("cache %q is not resizable", cacheType)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.members = append(b.members, &budgetMember{
+		cache: resizable,
+		// The initial max size of the cache is unknown: shrink it before growing the other caches.
+		maxSize:        math.MaxInt,
+		metricsHandler: b.metricsHandler.WithTags(metrics.CacheTypeTag(cacheType)),
+	})
+	sizes := make([]int, len(b.members))
+	for i := range sizes {
+		sizes[i] = b.totalSize() / len(b.members)
+	}
+	b.resizeLocked(sizes)
+	return nil
+}
+
+// Start starts rebalancing the budget periodically.
+func (b *Budget) Start() {
+	b.loops.Go(b.rebalanceLoop)
+}
+
+// Stop stops rebalancing the budget.
+func (b *Budget) Stop() {
+	b.loops.Cancel()
+	b.loops.Wait()
+}
+
+func (b *Budget) rebalanceLoop(ctx context.Context) error {
+	ch, t := b.timeSource.NewTimer(b.rebalanceInterval())
+	for {
+		select {
+		case <-ch:
+			b.rebalance()
+			t.Reset(b.rebalanceInterval())
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+func (b *Budget) rebalance() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.members) == 0 {
+		return
+	}
+
+	totalSize := b.totalSize()
+	minShare := min(max(b.minShare(), 0), 1/float64(len(b.members)))
+	hits := make([]int64, len(b.members))
+	var totalHits int64
+	currentTotalSize := 0
+	for i, m := range b.members {
+		var misses int64
+		hits[i], misses = m.cache.CollectStats()
+		totalHits += hits[i]
+		currentTotalSize += m.maxSize
+		if hits[i]+misses > 0 {
+			metrics.CacheHitRatio.With(m.metricsHandler).Record(float64(hits[i]) / float64(hits[i]+misses))
+		}
+	}
+
+	sizes := make([]int, len(b.members))
+	for i, m := range b.members {
+		if totalHits == 0 {
+			// No signal: keep the current split, but scale it to the current total size.
+			sizes[i] = int(float64(m.maxSize) / float64(max(currentTotalSize, 1)) * float64(totalSize))
+			continue
+		}
+		share := minShare + (1-minShare*float64(len(b.members)))*float64(hits[i])/float64(totalHits)
+		// Move halfway towards the target share, so that a single interval with unusual traffic doesn't thrash the
+		// caches.
+		currentShare := float64(m.maxSize) / float64(max(currentTotalSize, 1))
+		sizes[i] = int((currentShare + share) / 2 * float64(totalSize))
+	}
+	b.resizeLocked(sizes)
+}
+
+// resizeLocked applies the new max sizes, shrinking caches before growing the others so that the total size is not
+// exceeded in between.
+func (b *Budget) resizeLocked(sizes []int) {
+	for i, m := range b.members {
+		if sizes[i] < m.maxSize {
+			m.maxSize = sizes[i]
+			m.cache.SetMaxSize(sizes[i])
+		}
+	}
+	for i, m := range b.members {
+		if sizes[i] > m.maxSize {
+			m.maxSize = sizes[i]
+			m.cache.SetMaxSize(sizes[i])
+		}
+	}
+}
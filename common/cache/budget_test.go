@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+)
+
+func TestBudget_Register(t *testing.T) {
+	t.Parallel()
+
+	budget := NewBudget(
+		dynamicconfig.GetIntPropertyFn(100),
+		dynamicconfig.GetFloatPropertyFn(0.1),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		nil,
+		metrics.NoopMetricsHandler,
+	)
+	first := New(1000, nil)
+	second := New(1000, nil)
+	for i := range 80 {
+		first.Put(i, i)
+	}
+
+	require.NoError(t, budget.Register("first", first))
+	require.Equal(t, 80, first.Size())
+	require.NoError(t, budget.Register("second", second))
+	require.Equal(t, 50, first.Size())
+
+	require.Error(t, budget.Register("simple", NewSimple(nil)))
+}
+
+func TestBudget_Rebalance(t *testing.T) {
+	t.Parallel()
+
+	totalSize := 100
+	budget := NewBudget(
+		func() int { return totalSize },
+		dynamicconfig.GetFloatPropertyFn(0.1),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		nil,
+		metrics.NoopMetricsHandler,
+	)
+	hot := New(1000, nil)
+	cold := New(1000, nil)
+	require.NoError(t, budget.Register("hot", hot))
+	require.NoError(t, budget.Register("cold", cold))
+
+	// no hits: the split is unchanged
+	budget.rebalance()
+	require.Equal(t, []int{50, 50}, budgetSizes(budget))
+
+	hot.Put("key", "value")
+	for range 10 {
+		hot.Get("key")
+		cold.Get("key")
+	}
+	// the target split is 90/10, move halfway towards it
+	budget.rebalance()
+	require.Equal(t, []int{70, 30}, budgetSizes(budget))
+
+	for range 10 {
+		hot.Get("key")
+	}
+	budget.rebalance()
+	require.Equal(t, []int{80, 20}, budgetSizes(budget))
+
+	// changes of the total size are applied, keeping the current split
+	totalSize = 200
+	budget.rebalance()
+	require.Equal(t, []int{160, 40}, budgetSizes(budget))
+}
+
+func budgetSizes(budget *Budget) []int {
+	sizes := make([]int, 0, len(budget.members))
+	for _, m := range budget.members {
+		sizes = append(sizes, m.maxSize)
+	}
+	return sizes
+}
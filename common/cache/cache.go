@@ -42,6 +42,18 @@ type StoppableCache interface {
 	Stop()
 }
 
+// ResizableCache is a cache whose max size can be changed at runtime, e.g. by a [Budget].
+type ResizableCache interface {
+	StoppableCache
+
+	// SetMaxSize changes the max size of the cache. Unpinned entries are evicted if the cache is larger than the new
+	// max size.
+	SetMaxSize(maxSize int)
+
+	// CollectStats returns the number of hits and misses of Get since the last call.
+	CollectStats() (hits int64, misses int64)
+}
+
 // Options control the behavior of the cache.
 type Options struct {
 	// TTL controls the time-to-live for a given cache entry.  Cache entries that
@@ -60,6 +72,15 @@ type Options struct {
 
 	// BackgroundEvict configures background scanning for expired entries.
 	BackgroundEvict func() dynamicconfig.CacheBackgroundEvictSettings
+
+	// NamespaceKey returns the namespace ID of a cache key. Together with NamespaceMaxShare, it enables per-namespace
+	// fair share: when the cache is full, entries of namespaces that use more than NamespaceMaxShare of the cache
+	// max size are evicted first.
+	NamespaceKey func(key any) string
+
+	// NamespaceMaxShare is the fraction of the cache max size a single namespace can use before its entries are
+	// evicted first.
+	NamespaceMaxShare func() float64
 }
 
 // SimpleOptions provides options that can be used to configure SimpleCache.
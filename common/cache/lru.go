@@ -44,6 +44,13 @@ type (
 		metricsHandler  metrics.Handler
 		backgroundEvict dynamicconfig.TypedPropertyFn[dynamicconfig.CacheBackgroundEvictSettings]
 		loops           goro.Group
+
+		namespaceKey      func(key any) string
+		namespaceMaxShare func() float64
+		namespaceSizes    map[string]int
+
+		hits   int64
+		misses int64
 	}
 
 	iteratorImpl struct {
@@ -58,6 +65,7 @@ type (
 		value      any
 		refCount   int
 		size       int
+		namespace  string
 	}
 )
 
@@ -174,6 +182,11 @@ func NewWithMetrics(maxSize int, opts *Options, handler metrics.Handler) Stoppab
 		metricsHandler:  handler,
 		backgroundEvict: backgroundEvict,
 	}
+	if opts.NamespaceKey != nil && opts.NamespaceMaxShare != nil {
+		c.namespaceKey = opts.NamespaceKey
+		c.namespaceMaxShare = opts.NamespaceMaxShare
+		c.namespaceSizes = make(map[string]int)
+	}
 	if c.backgroundEvict().Enabled {
 		c.loops.Go(c.bgEvictLoop)
 	}
@@ -188,14 +201,16 @@ func NewLRU(maxSize int, handler metrics.Handler) StoppableCache {
 
 // Get retrieves the value stored under the given key
 func (c *lru) Get(key any) any {
-	if c.maxSize == 0 { //
-		return nil
-	}
 	c.mut.Lock()
 	defer c.mut.Unlock()
 
+	if c.maxSize == 0 {
+		return nil
+	}
+
 	element := c.byKey[key]
 	if element == nil {
+		c.misses++
 		return nil
 	}
 
@@ -204,8 +219,10 @@ func (c *lru) Get(key any) any {
 	if c.isEntryExpired(entry, c.timeSource.Now().UTC()) {
 		// Entry has expired
 		c.deleteInternal(element)
+		c.misses++
 		return nil
 	}
+	c.hits++
 
 	metrics.CacheEntryAgeOnGet.With(c.metricsHandler).Record(c.timeSource.Now().UTC().Sub(entry.createTime))
 
@@ -240,11 +257,12 @@ func (c *lru) PutIfNotExist(key any, value any) (any, error) {
 
 // Delete deletes a key, value pair associated with a key
 func (c *lru) Delete(key any) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
 	if c.maxSize == 0 {
 		return
 	}
-	c.mut.Lock()
-	defer c.mut.Unlock()
 
 	element := c.byKey[key]
 	if element != nil {
@@ -254,12 +272,16 @@ func (c *lru) Delete(key any) {
 
 // Release decrements the ref count of a pinned element.
 func (c *lru) Release(key any) {
-	if c.maxSize == 0 || !c.pin {
+	if !c.pin {
 		return
 	}
 	c.mut.Lock()
 	defer c.mut.Unlock()
 
+	if c.maxSize == 0 {
+		return
+	}
+
 	elt, ok := c.byKey[key]
 	if !ok {
 		return
@@ -273,6 +295,7 @@ func (c *lru) Release(key any) {
 	// Entry size might have changed. Recalculate size and evict entries if necessary.
 	newEntrySize := getSize(entry.value)
 	c.currSize = c.calculateNewCacheSize(newEntrySize, entry.Size())
+	c.updateNamespaceSize(entry.namespace, newEntrySize-entry.Size())
 	entry.size = newEntrySize
 	if c.currSize > c.maxSize {
 		c.tryEvictUntilCacheSizeUnderLimit()
@@ -291,20 +314,44 @@ func (c *lru) Size() int {
 	return c.currSize
 }
 
+// SetMaxSize changes the max size of the cache. Unpinned entries are evicted if the cache is larger than the new max
+// size.
+func (c *lru) SetMaxSize(maxSize int) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	c.maxSize = maxSize
+	metrics.CacheSize.With(c.metricsHandler).Record(float64(c.maxSize))
+	if c.currSize > c.maxSize {
+		c.tryEvictUntilCacheSizeUnderLimit()
+	}
+}
+
+// CollectStats returns the number of hits and misses of Get since the last call.
+func (c *lru) CollectStats() (hits int64, misses int64) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	hits, misses = c.hits, c.misses
+	c.hits, c.misses = 0, 0
+	return hits, misses
+}
+
 // Put puts a new value associated with a given key, returning the existing value (if present)
 // allowUpdate flag is used to control overwrite behavior if the value exists.
 func (c *lru) putInternal(key any, value any, allowUpdate bool) (any, error) {
+	newEntrySize := getSize(value)
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
 	if c.maxSize == 0 {
 		return nil, nil
 	}
-	newEntrySize := getSize(value)
 	if newEntrySize > c.maxSize {
 		return nil, ErrCacheItemTooLarge
 	}
 
-	c.mut.Lock()
-	defer c.mut.Unlock()
-
 	elt := c.byKey[key]
 	// If the entry exists, check if it has expired or update the value
 	if elt != nil {
@@ -326,6 +373,7 @@ func (c *lru) putInternal(key any, value any, allowUpdate bool) (any, error) {
 						return nil, ErrCacheFull
 					}
 				}
+				c.updateNamespaceSize(existingEntry.namespace, newEntrySize-existingEntry.Size())
 				existingEntry.value = value
 				existingEntry.size = newEntrySize
 				c.currSize = newCacheSize
@@ -359,6 +407,10 @@ func (c *lru) putInternal(key any, value any, allowUpdate bool) (any, error) {
 		value: value,
 		size:  newEntrySize,
 	}
+	if c.namespaceKey != nil {
+		entry.namespace = c.namespaceKey(key)
+		c.updateNamespaceSize(entry.namespace, newEntrySize)
+	}
 	c.updateEntryTTL(entry)
 	c.updateEntryRefCount(entry)
 	element := c.byAccess.PushFront(entry)
@@ -380,6 +432,7 @@ func (c *lru) calculateNewCacheSize(newEntrySize int, existingEntrySize int) int
 func (c *lru) deleteInternal(element *list.Element) {
 	entry := c.byAccess.Remove(element).(*entryImpl)
 	c.currSize -= entry.Size()
+	c.updateNamespaceSize(entry.namespace, -entry.Size())
 	metrics.CacheUsage.With(c.metricsHandler).Record(float64(c.currSize))
 	metrics.CacheEntryAgeOnEviction.With(c.metricsHandler).Record(c.timeSource.Now().UTC().Sub(entry.createTime))
 	delete(c.byKey, entry.key)
@@ -403,6 +456,10 @@ func (c *lru) tryEvictUntilEnoughSpaceWithSkipEntry(newEntrySize int, existingEn
 		existingEntrySize = existingEntry.Size()
 	}
 
+	if c.calculateNewCacheSize(newEntrySize, existingEntrySize) > c.maxSize && c.hasNamespaceOverShare() {
+		c.tryEvictNamespacesOverShare(newEntrySize, existingEntry)
+	}
+
 	for c.calculateNewCacheSize(newEntrySize, existingEntrySize) > c.maxSize && element != nil {
 		entry := element.Value.(*entryImpl)
 		if existingEntry != nil && entry.key == existingEntry.key {
@@ -413,6 +470,59 @@ func (c *lru) tryEvictUntilEnoughSpaceWithSkipEntry(newEntrySize int, existingEn
 	}
 }
 
+// tryEvictNamespacesOverShare evicts the least recently used entries of the namespaces that use more than their
+// share of the cache, until there is enough space for the new entry or no namespace is over its share. This keeps a
+// single namespace from evicting the entries of all other namespaces when the cache is full.
+func (c *lru) tryEvictNamespacesOverShare(newEntrySize int, existingEntry *entryImpl) {
+	existingEntrySize := 0
+	if existingEntry != nil {
+		existingEntrySize = existingEntry.Size()
+	}
+	namespaceMaxSize := c.namespaceMaxSize()
+
+	element := c.byAccess.Back()
+	for c.calculateNewCacheSize(newEntrySize, existingEntrySize) > c.maxSize && element != nil {
+		entry := element.Value.(*entryImpl)
+		if (existingEntry != nil && entry.key == existingEntry.key) || c.namespaceSizes[entry.namespace] <= namespaceMaxSize {
+			element = element.Prev()
+			continue
+		}
+		if entry.refCount == 0 {
+			metrics.CacheNamespaceShareEvictions.With(c.metricsHandler).Record(1, metrics.NamespaceIDTag(entry.namespace))
+		}
+		element = c.tryEvictAndGetPreviousElement(entry, element)
+	}
+}
+
+func (c *lru) hasNamespaceOverShare() bool {
+	if c.namespaceSizes == nil {
+		return false
+	}
+	namespaceMaxSize := c.namespaceMaxSize()
+	for _, size := range c.namespaceSizes {
+		if size > namespaceMaxSize {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *lru) namespaceMaxSize() int {
+	return int(float64(c.maxSize) * c.namespaceMaxShare())
+}
+
+func (c *lru) updateNamespaceSize(namespace string, delta int) {
+	if c.namespaceSizes == nil {
+		return
+	}
+	size := c.namespaceSizes[namespace] + delta
+	if size <= 0 {
+		delete(c.namespaceSizes, namespace)
+		return
+	}
+	c.namespaceSizes[namespace] = size
+}
+
 func (c *lru) tryEvictAndGetPreviousElement(entry *entryImpl, element *list.Element) *list.Element {
 	if entry.refCount == 0 {
 		elementPrev := element.Prev()
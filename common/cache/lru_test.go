@@ -849,3 +849,80 @@ func TestCache_UnusedExpiryPin(t *testing.T) {
 		return cache.Size() == 0
 	}, 1*time.Second, 100*time.Millisecond)
 }
+
+func TestCache_SetMaxSize(t *testing.T) {
+	t.Parallel()
+
+	cache := New(4, nil).(ResizableCache)
+	cache.Put("A", "Foo")
+	cache.Put("B", "Bar")
+	cache.Put("C", "Cid")
+	cache.Get("A")
+
+	cache.SetMaxSize(2)
+	assert.Equal(t, 2, cache.Size())
+	assert.Nil(t, cache.Get("B")) // least recently used, evicted
+	assert.Equal(t, "Foo", cache.Get("A"))
+	assert.Equal(t, "Cid", cache.Get("C"))
+
+	cache.SetMaxSize(3)
+	cache.Put("D", "Delt")
+	assert.Equal(t, 3, cache.Size())
+}
+
+func TestCache_CollectStats(t *testing.T) {
+	t.Parallel()
+
+	cache := New(4, nil).(ResizableCache)
+	cache.Put("A", "Foo")
+	cache.Get("A")
+	cache.Get("A")
+	cache.Get("B")
+
+	hits, misses := cache.CollectStats()
+	assert.Equal(t, int64(2), hits)
+	assert.Equal(t, int64(1), misses)
+	hits, misses = cache.CollectStats()
+	assert.Zero(t, hits)
+	assert.Zero(t, misses)
+}
+
+func TestCache_NamespaceMaxShare(t *testing.T) {
+	t.Parallel()
+
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	cache := NewWithMetrics(10, &Options{
+		NamespaceKey:      func(key any) string { return key.(keyType).dummyString },
+		NamespaceMaxShare: func() float64 { return 0.5 },
+	}, metricsHandler)
+
+	// namespace "a" fills the cache while it is not full
+	cache.Put(keyType{dummyString: "b", dummyInt: 0}, &testEntryWithCacheSize{2})
+	for i := range 4 {
+		cache.Put(keyType{dummyString: "a", dummyInt: i}, &testEntryWithCacheSize{2})
+	}
+	assert.Equal(t, 10, cache.Size())
+
+	// namespace "b" is under its share: the entries of namespace "a" are evicted before the older entry of "b"
+	cache.Put(keyType{dummyString: "b", dummyInt: 1}, &testEntryWithCacheSize{2})
+	assert.Equal(t, 10, cache.Size())
+	assert.NotNil(t, cache.Get(keyType{dummyString: "b", dummyInt: 0}))
+	assert.Nil(t, cache.Get(keyType{dummyString: "a", dummyInt: 0}))
+	assert.NotNil(t, cache.Get(keyType{dummyString: "a", dummyInt: 1}))
+
+	cache.Put(keyType{dummyString: "b", dummyInt: 2}, &testEntryWithCacheSize{2})
+	assert.Nil(t, cache.Get(keyType{dummyString: "a", dummyInt: 2}))
+
+	// namespace "b" is now over its share: its own least recently used entry is evicted
+	cache.Put(keyType{dummyString: "b", dummyInt: 3}, &testEntryWithCacheSize{2})
+	assert.Nil(t, cache.Get(keyType{dummyString: "b", dummyInt: 1}))
+	assert.NotNil(t, cache.Get(keyType{dummyString: "a", dummyInt: 3}))
+	assert.NotNil(t, cache.Get(keyType{dummyString: "b", dummyInt: 0}))
+
+	snapshot := capture.Snapshot()
+	evictions := snapshot[metrics.CacheNamespaceShareEvictions.Name()]
+	require.Len(t, evictions, 3)
+	assert.Equal(t, "a", evictions[0].Tags[metrics.NamespaceIDTag("").Key])
+	assert.Equal(t, "b", evictions[2].Tags[metrics.NamespaceIDTag("").Key])
+}
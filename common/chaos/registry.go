@@ -0,0 +1,303 @@
+package chaos
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	faultinjectionspb "go.temporal.io/server/api/faultinjection/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/primitives"
+	"google.golang.org/grpc"
+)
+
+const (
+	TargetPersistence = "persistence"
+	TargetRPC         = "rpc"
+
+	ErrorTypeUnavailable       = "Unavailable"
+	ErrorTypeResourceExhausted = "ResourceExhausted"
+	ErrorTypeDeadlineExceeded  = "DeadlineExceeded"
+	// The following error types only apply to persistence faults.
+	ErrorTypeTimeout            = "Timeout"
+	ErrorTypeExecuteAndTimeout  = "ExecuteAndTimeout"
+	ErrorTypeShardOwnershipLost = "ShardOwnershipLost"
+)
+
+var (
+	rpcErrorTypes         = []string{ErrorTypeUnavailable, ErrorTypeResourceExhausted, ErrorTypeDeadlineExceeded}
+	persistenceErrorTypes = append(
+		slices.Clone(rpcErrorTypes),
+		ErrorTypeTimeout,
+		ErrorTypeExecuteAndTimeout,
+		ErrorTypeShardOwnershipLost,
+	)
+)
+
+type (
+	// Registry holds the faults injected at runtime into the persistence and RPC client calls of a host. Faults are
+	// only injected while runtime fault injection is enabled in dynamic config, so disabling it stops a chaos
+	// experiment on all hosts at once. The clients of a host are only instrumented if it was enabled when the host
+	// started.
+	Registry struct {
+		serviceName  primitives.ServiceName
+		enabled      dynamicconfig.BoolPropertyFn
+		instrumented bool
+		timeSource   clock.TimeSource
+
+		mu     sync.RWMutex
+		faults []*faultinjectionspb.Fault // sorted by ID, replaced on update
+		count  atomic.Int32
+
+		rndMu sync.Mutex
+		rnd   *rand.Rand
+	}
+
+	// Target identifies a call faults can be injected into.
+	Target struct {
+		// TargetPersistence or TargetRPC
+		Kind string
+		// Persistence store name or destination service
+		Component   string
+		Method      string
+		NamespaceID string
+		ShardID     int32
+	}
+)
+
+func NewRegistry(
+	serviceName primitives.ServiceName,
+	enabled dynamicconfig.BoolPropertyFn,
+	timeSource clock.TimeSource,
+) *Registry {
+	return &Registry{
+		serviceName:  serviceName,
+		enabled:      enabled,
+		instrumented: enabled(),
+		timeSource:   timeSource,
+		rnd:          rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Instrumented returns whether runtime fault injection was enabled when the registry was created. Persistence and RPC
+// clients are only wrapped to inject faults in that case, so that hosts which never run chaos experiments don't pay
+// for it. Enabling runtime fault injection later takes effect on the next restart.
+func (r *Registry) Instrumented() bool {
+	return r != nil && r.instrumented
+}
+
+// ValidateFault returns an InvalidArgument error if the fault can't be injected.
+func ValidateFault(fault *faultinjectionspb.Fault) error {
+	if fault.GetId() == "" {
+		return serviceerror.NewInvalidArgument("fault ID is not set")
+	}
+	var errorTypes []string
+	switch fault.GetTarget() {
+	case TargetPersistence:
+		errorTypes = persistenceErrorTypes
+	case TargetRPC:
+		errorTypes = rpcErrorTypes
+		if fault.GetShardId() != 0 {
+			return serviceerror.NewInvalidArgumentf("fault %v: shard ID only applies to persistence faults", fault.GetId())
+		}
+	default:
+		return serviceerror.NewInvalidArgumentf("fault %v: unknown target %q, expected %q or %q",
+			fault.GetId(), fault.GetTarget(), TargetPersistence, TargetRPC)
+	}
+	if fault.GetErrorType() != "" && !slices.Contains(errorTypes, fault.GetErrorType()) {
+		return serviceerror.NewInvalidArgumentf("fault %v: unknown error type %q for %v faults, expected one of %v",
+			fault.GetId(), fault.GetErrorType(), fault.GetTarget(), errorTypes)
+	}
+	if fault.GetErrorRate() < 0 || fault.GetErrorRate() > 1 || fault.GetLatencyRate() < 0 || fault.GetLatencyRate() > 1 {
+		return serviceerror.NewInvalidArgumentf("fault %v: rates must be between 0 and 1", fault.GetId())
+	}
+	if fault.GetLatency().AsDuration() < 0 {
+		return serviceerror.NewInvalidArgumentf("fault %v: latency must not be negative", fault.GetId())
+	}
+	if (fault.GetErrorType() == "" || fault.GetErrorRate() == 0) &&
+		(fault.GetLatency().AsDuration() == 0 || fault.GetLatencyRate() == 0) {
+		return serviceerror.NewInvalidArgumentf("fault %v: neither an error nor latency would be injected", fault.GetId())
+	}
+	return nil
+}
+
+// Update deletes faults, then adds or replaces the given ones. Faults can only be added while runtime fault
+// injection is enabled.
+func (r *Registry) Update(
+	upsertFaults []*faultinjectionspb.Fault,
+	deleteFaultIDs []string,
+	deleteAllFaults bool,
+) error {
+	if len(upsertFaults) > 0 && !r.enabled() {
+		return serviceerror.NewFailedPreconditionf("runtime fault injection is disabled, see %v dynamic config",
+			dynamicconfig.EnableRuntimeFaultInjection.Key())
+	}
+	if len(upsertFaults) > 0 && !r.instrumented {
+		return serviceerror.NewFailedPreconditionf("runtime fault injection was disabled when the %v host started, "+
+			"restart it with %v enabled", r.serviceName, dynamicconfig.EnableRuntimeFaultInjection.Key())
+	}
+	for _, fault := range upsertFaults {
+		if err := ValidateFault(fault); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.timeSource.Now()
+	faults := make([]*faultinjectionspb.Fault, 0, len(r.faults)+len(upsertFaults))
+	for _, fault := range r.faults {
+		if deleteAllFaults || slices.Contains(deleteFaultIDs, fault.GetId()) || expired(fault, now) ||
+			slices.ContainsFunc(upsertFaults, func(f *faultinjectionspb.Fault) bool { return f.GetId() == fault.GetId() }) {
+			continue
+		}
+		faults = append(faults, fault)
+	}
+	faults = append(faults, upsertFaults...)
+	slices.SortFunc(faults, func(a, b *faultinjectionspb.Fault) int {
+		return cmp.Compare(a.GetId(), b.GetId())
+	})
+	r.faults = faults
+	r.count.Store(int32(len(faults)))
+	return nil
+}
+
+// Describe returns the faults of the host. The host address is left for the caller to fill.
+func (r *Registry) Describe() *faultinjectionspb.HostFaults {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := r.timeSource.Now()
+	var faults []*faultinjectionspb.Fault
+	for _, fault := range r.faults {
+		if !expired(fault, now) {
+			faults = append(faults, fault)
+		}
+	}
+	return &faultinjectionspb.HostFaults{
+		Service: string(r.serviceName),
+		Enabled: r.instrumented && r.enabled(),
+		Faults:  faults,
+	}
+}
+
+// Active returns whether any fault may be injected. Callers use it to skip building targets.
+func (r *Registry) Active() bool {
+	return r.Instrumented() && r.count.Load() > 0 && r.enabled()
+}
+
+// Inject delays the call to the target by the latency of the matching faults, and returns the type of the error
+// the call must fail with. It returns an empty string if the call must proceed.
+func (r *Registry) Inject(ctx context.Context, target Target) string {
+	if !r.Active() {
+		return ""
+	}
+
+	r.mu.RLock()
+	faults := r.faults
+	r.mu.RUnlock()
+
+	now := r.timeSource.Now()
+	var latency time.Duration
+	var errorType string
+	for _, fault := range faults {
+		if !r.matches(fault, target, now) {
+			continue
+		}
+		if fault.GetLatency().AsDuration() > latency && r.roll() < fault.GetLatencyRate() {
+			latency = fault.GetLatency().AsDuration()
+		}
+		if errorType == "" && fault.GetErrorType() != "" && r.roll() < fault.GetErrorRate() {
+			errorType = fault.GetErrorType()
+		}
+	}
+
+	if latency > 0 {
+		timerC, timer := r.timeSource.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-timerC:
+		case <-ctx.Done():
+			return ErrorTypeDeadlineExceeded
+		}
+	}
+	return errorType
+}
+
+// UnaryClientInterceptor injects RPC faults into the calls to the destination service.
+func (r *Registry) UnaryClientInterceptor(destination primitives.ServiceName) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if !r.Active() {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		target := Target{
+			Kind:      TargetRPC,
+			Component: string(destination),
+			Method:    api.MethodName(method),
+		}
+		if request, ok := req.(interface{ GetNamespaceId() string }); ok {
+			target.NamespaceID = request.GetNamespaceId()
+		}
+		if errorType := r.Inject(ctx, target); errorType != "" {
+			return newRPCError(errorType, target)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func (r *Registry) matches(
+	fault *faultinjectionspb.Fault,
+	target Target,
+	now time.Time,
+) bool {
+	return fault.GetTarget() == target.Kind &&
+		(len(fault.GetServices()) == 0 || slices.Contains(fault.GetServices(), string(r.serviceName))) &&
+		(fault.GetComponent() == "" || fault.GetComponent() == target.Component) &&
+		(fault.GetMethod() == "" || fault.GetMethod() == target.Method) &&
+		(fault.GetNamespaceId() == "" || fault.GetNamespaceId() == target.NamespaceID) &&
+		(fault.GetShardId() == 0 || fault.GetShardId() == target.ShardID) &&
+		!expired(fault, now)
+}
+
+func (r *Registry) roll() float64 {
+	r.rndMu.Lock()
+	defer r.rndMu.Unlock()
+	return r.rnd.Float64()
+}
+
+func expired(fault *faultinjectionspb.Fault, now time.Time) bool {
+	return fault.GetExpireTime() != nil && !now.Before(fault.GetExpireTime().AsTime())
+}
+
+func newRPCError(errorType string, target Target) error {
+	msg := fmt.Sprintf("fault injection error at %s.%s", target.Component, target.Method)
+	switch errorType {
+	case ErrorTypeResourceExhausted:
+		return &serviceerror.ResourceExhausted{
+			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_SYSTEM_OVERLOADED,
+			Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_SYSTEM,
+			Message: msg + ": serviceerror.ResourceExhausted",
+		}
+	case ErrorTypeDeadlineExceeded:
+		return serviceerror.NewDeadlineExceeded(msg + ": serviceerror.DeadlineExceeded")
+	default:
+		return serviceerror.NewUnavailable(msg + ": serviceerror.Unavailable")
+	}
+}
@@ -0,0 +1,222 @@
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	faultinjectionspb "go.temporal.io/server/api/faultinjection/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/primitives"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestRegistry(timeSource clock.TimeSource) *Registry {
+	return NewRegistry(primitives.HistoryService, dynamicconfig.GetBoolPropertyFn(true), timeSource)
+}
+
+func TestValidateFault(t *testing.T) {
+	valid := &faultinjectionspb.Fault{
+		Id:        "f",
+		Target:    TargetPersistence,
+		ErrorType: ErrorTypeShardOwnershipLost,
+		ErrorRate: 1,
+	}
+	require.NoError(t, ValidateFault(valid))
+
+	for name, fault := range map[string]*faultinjectionspb.Fault{
+		"no ID":                  {Target: TargetPersistence, ErrorType: ErrorTypeUnavailable, ErrorRate: 1},
+		"unknown target":         {Id: "f", Target: "disk", ErrorType: ErrorTypeUnavailable, ErrorRate: 1},
+		"persistence-only error": {Id: "f", Target: TargetRPC, ErrorType: ErrorTypeShardOwnershipLost, ErrorRate: 1},
+		"shard ID for RPC":       {Id: "f", Target: TargetRPC, ShardId: 1, ErrorType: ErrorTypeUnavailable, ErrorRate: 1},
+		"rate out of range":      {Id: "f", Target: TargetRPC, ErrorType: ErrorTypeUnavailable, ErrorRate: 2},
+		"no effect":              {Id: "f", Target: TargetRPC, ErrorType: ErrorTypeUnavailable},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var invalidArgument *serviceerror.InvalidArgument
+			require.ErrorAs(t, ValidateFault(fault), &invalidArgument)
+		})
+	}
+}
+
+func TestRegistry_Update(t *testing.T) {
+	enabled := true
+	registry := NewRegistry(
+		primitives.HistoryService,
+		func() bool { return enabled },
+		clock.NewRealTimeSource(),
+	)
+	newFault := func(id string) *faultinjectionspb.Fault {
+		return &faultinjectionspb.Fault{Id: id, Target: TargetRPC, ErrorType: ErrorTypeUnavailable, ErrorRate: 1}
+	}
+
+	require.NoError(t, registry.Update([]*faultinjectionspb.Fault{newFault("b"), newFault("a"), newFault("c")}, nil, false))
+	require.True(t, registry.Active())
+	host := registry.Describe()
+	require.Equal(t, string(primitives.HistoryService), host.GetService())
+	require.True(t, host.GetEnabled())
+	require.Len(t, host.GetFaults(), 3)
+	require.Equal(t, "a", host.GetFaults()[0].GetId())
+
+	replaced := newFault("a")
+	replaced.ErrorRate = 0.5
+	require.NoError(t, registry.Update([]*faultinjectionspb.Fault{replaced}, []string{"b"}, false))
+	host = registry.Describe()
+	require.Len(t, host.GetFaults(), 2)
+	require.InDelta(t, 0.5, host.GetFaults()[0].GetErrorRate(), 1e-9)
+	require.Equal(t, "c", host.GetFaults()[1].GetId())
+
+	// faults can't be added while disabled, but can still be removed
+	enabled = false
+	require.False(t, registry.Active())
+	var failedPrecondition *serviceerror.FailedPrecondition
+	require.ErrorAs(t, registry.Update([]*faultinjectionspb.Fault{newFault("d")}, nil, false), &failedPrecondition)
+	require.NoError(t, registry.Update(nil, nil, true))
+	require.Empty(t, registry.Describe().GetFaults())
+}
+
+func TestRegistry_Inject(t *testing.T) {
+	registry := newTestRegistry(clock.NewRealTimeSource())
+	require.NoError(t, registry.Update([]*faultinjectionspb.Fault{
+		{
+			Id:          "f",
+			Target:      TargetPersistence,
+			Component:   "ExecutionStore",
+			Method:      "UpdateWorkflowExecution",
+			ShardId:     3,
+			ErrorType:   ErrorTypeTimeout,
+			ErrorRate:   1,
+			Latency:     durationpb.New(time.Millisecond),
+			LatencyRate: 1,
+		},
+		{
+			Id:        "other-service",
+			Target:    TargetPersistence,
+			Services:  []string{string(primitives.MatchingService)},
+			ErrorType: ErrorTypeUnavailable,
+			ErrorRate: 1,
+		},
+	}, nil, false))
+
+	target := Target{
+		Kind:      TargetPersistence,
+		Component: "ExecutionStore",
+		Method:    "UpdateWorkflowExecution",
+		ShardID:   3,
+	}
+	require.Equal(t, ErrorTypeTimeout, registry.Inject(context.Background(), target))
+
+	for _, other := range []Target{
+		{Kind: TargetRPC, Component: target.Component, Method: target.Method},
+		{Kind: TargetPersistence, Component: "ShardStore", Method: target.Method, ShardID: 3},
+		{Kind: TargetPersistence, Component: target.Component, Method: "GetWorkflowExecution", ShardID: 3},
+		{Kind: TargetPersistence, Component: target.Component, Method: target.Method, ShardID: 4},
+	} {
+		require.Empty(t, registry.Inject(context.Background(), other))
+	}
+}
+
+func TestRegistry_InjectLatency(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	registry := newTestRegistry(timeSource)
+	require.NoError(t, registry.Update([]*faultinjectionspb.Fault{{
+		Id:          "slow",
+		Target:      TargetRPC,
+		Latency:     durationpb.New(time.Minute),
+		LatencyRate: 1,
+		ExpireTime:  timestamppb.New(timeSource.Now().Add(time.Hour)),
+	}}, nil, false))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Equal(t, ErrorTypeDeadlineExceeded, registry.Inject(ctx, Target{Kind: TargetRPC}))
+
+	done := make(chan string)
+	go func() {
+		done <- registry.Inject(context.Background(), Target{Kind: TargetRPC})
+	}()
+	require.Eventually(t, func() bool {
+		timeSource.Advance(time.Minute)
+		select {
+		case errorType := <-done:
+			require.Empty(t, errorType)
+			return true
+		default:
+			return false
+		}
+	}, time.Second, time.Millisecond)
+
+	// expired faults are not injected anymore
+	timeSource.Advance(time.Hour)
+	require.Empty(t, registry.Inject(context.Background(), Target{Kind: TargetRPC}))
+	require.Empty(t, registry.Describe().GetFaults())
+}
+
+func TestRegistry_UnaryClientInterceptor(t *testing.T) {
+	registry := newTestRegistry(clock.NewRealTimeSource())
+	require.NoError(t, registry.Update([]*faultinjectionspb.Fault{{
+		Id:          "f",
+		Target:      TargetRPC,
+		Component:   string(primitives.MatchingService),
+		Method:      "AddWorkflowTask",
+		NamespaceId: "ns-id",
+		ErrorType:   ErrorTypeResourceExhausted,
+		ErrorRate:   1,
+	}}, nil, false))
+
+	interceptor := registry.UnaryClientInterceptor(primitives.MatchingService)
+	invoked := 0
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		invoked++
+		return nil
+	}
+	call := func(method string, namespaceID string) error {
+		return interceptor(
+			context.Background(),
+			"/temporal.server.api.matchingservice.v1.MatchingService/"+method,
+			&matchingservice.AddWorkflowTaskRequest{NamespaceId: namespaceID},
+			nil,
+			nil,
+			invoker,
+		)
+	}
+
+	var resourceExhausted *serviceerror.ResourceExhausted
+	require.ErrorAs(t, call("AddWorkflowTask", "ns-id"), &resourceExhausted)
+	require.Zero(t, invoked)
+	require.NoError(t, call("AddWorkflowTask", "other-ns-id"))
+	require.NoError(t, call("AddActivityTask", "ns-id"))
+	require.Equal(t, 2, invoked)
+
+	require.NoError(t, registry.UnaryClientInterceptor(primitives.HistoryService)(
+		context.Background(), "/temporal.server.api.historyservice.v1.HistoryService/AddWorkflowTask",
+		&matchingservice.AddWorkflowTaskRequest{NamespaceId: "ns-id"}, nil, nil, invoker))
+}
+
+func TestRegistry_NotInstrumented(t *testing.T) {
+	enabled := false
+	registry := NewRegistry(
+		primitives.HistoryService,
+		func() bool { return enabled },
+		clock.NewRealTimeSource(),
+	)
+	require.False(t, registry.Instrumented())
+
+	// enabling runtime fault injection after startup doesn't instrument the clients
+	enabled = true
+	var failedPrecondition *serviceerror.FailedPrecondition
+	require.ErrorAs(t, registry.Update([]*faultinjectionspb.Fault{
+		{Id: "a", Target: TargetRPC, ErrorType: ErrorTypeUnavailable, ErrorRate: 1},
+	}, nil, false), &failedPrecondition)
+	require.False(t, registry.Active())
+	require.False(t, registry.Describe().GetEnabled())
+
+	var nilRegistry *Registry
+	require.False(t, nilRegistry.Instrumented())
+}
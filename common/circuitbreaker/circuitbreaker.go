@@ -14,20 +14,28 @@ type (
 		State() gobreaker.State
 		Counts() gobreaker.Counts
 		Allow() (done func(success bool), err error)
+		// Trip forces the circuit breaker open until Reset is called.
+		Trip()
+		// Reset clears a manual trip and restarts the circuit breaker in the closed state.
+		Reset()
+		// Tripped returns true if the circuit breaker was manually tripped or is forced open.
+		Tripped() bool
 	}
 
 	// TwoStepCircuitBreakerWithDynamicSettings is a wrapper of gobreaker.TwoStepCircuitBreaker
 	// that calls the settingsFn everytime the Allow function is called and replaces the circuit
 	// breaker if there is a change in the settings object. Note that in this case, the previous
-	// state of the circuit breaker is lost.
+	// state of the circuit breaker is lost. A manual trip is kept across settings changes.
 	TwoStepCircuitBreakerWithDynamicSettings struct {
 		name          string
 		readyToTrip   func(counts gobreaker.Counts) bool
 		onStateChange func(name string, from gobreaker.State, to gobreaker.State)
+		forceOpen     func() bool
 
 		cb       atomic.Pointer[gobreaker.TwoStepCircuitBreaker]
 		cbLock   sync.Mutex
 		settings dynamicconfig.CircuitBreakerSettings
+		tripped  atomic.Bool
 	}
 
 	Settings struct {
@@ -35,6 +43,9 @@ type (
 		Name          string
 		ReadyToTrip   func(counts gobreaker.Counts) bool
 		OnStateChange func(name string, from gobreaker.State, to gobreaker.State)
+		// ForceOpen is optional. The circuit breaker stays open while it returns true. It allows manual trips to be
+		// kept outside of the circuit breaker, e.g. in persistence, so that they survive restarts.
+		ForceOpen func() bool
 	}
 )
 
@@ -48,6 +59,7 @@ func NewTwoStepCircuitBreakerWithDynamicSettings(
 		name:          settings.Name,
 		readyToTrip:   settings.ReadyToTrip,
 		onStateChange: settings.OnStateChange,
+		forceOpen:     settings.ForceOpen,
 	}
 }
 
@@ -60,11 +72,15 @@ func (c *TwoStepCircuitBreakerWithDynamicSettings) UpdateSettings(
 		return // no change
 	}
 	c.settings = ds
+	c.resetLocked()
+}
+
+func (c *TwoStepCircuitBreakerWithDynamicSettings) resetLocked() {
 	c.cb.Store(gobreaker.NewTwoStepCircuitBreaker(gobreaker.Settings{
 		Name:          c.name,
-		MaxRequests:   uint32(ds.MaxRequests),
-		Interval:      ds.Interval,
-		Timeout:       ds.Timeout,
+		MaxRequests:   uint32(c.settings.MaxRequests),
+		Interval:      c.settings.Interval,
+		Timeout:       c.settings.Timeout,
 		ReadyToTrip:   c.readyToTrip,
 		OnStateChange: c.onStateChange,
 	}))
@@ -75,6 +91,9 @@ func (c *TwoStepCircuitBreakerWithDynamicSettings) Name() string {
 }
 
 func (c *TwoStepCircuitBreakerWithDynamicSettings) State() gobreaker.State {
+	if c.Tripped() {
+		return gobreaker.StateOpen
+	}
 	return c.cb.Load().State()
 }
 
@@ -83,5 +102,33 @@ func (c *TwoStepCircuitBreakerWithDynamicSettings) Counts() gobreaker.Counts {
 }
 
 func (c *TwoStepCircuitBreakerWithDynamicSettings) Allow() (done func(success bool), err error) {
+	if c.Tripped() {
+		return nil, gobreaker.ErrOpenState
+	}
 	return c.cb.Load().Allow()
 }
+
+func (c *TwoStepCircuitBreakerWithDynamicSettings) Trip() {
+	c.cbLock.Lock()
+	defer c.cbLock.Unlock()
+	from := c.State()
+	c.tripped.Store(true)
+	if from != gobreaker.StateOpen && c.onStateChange != nil {
+		c.onStateChange(c.name, from, gobreaker.StateOpen)
+	}
+}
+
+func (c *TwoStepCircuitBreakerWithDynamicSettings) Reset() {
+	c.cbLock.Lock()
+	defer c.cbLock.Unlock()
+	from := c.State()
+	c.resetLocked()
+	c.tripped.Store(false)
+	if to := c.State(); from != to && c.onStateChange != nil {
+		c.onStateChange(c.name, from, to)
+	}
+}
+
+func (c *TwoStepCircuitBreakerWithDynamicSettings) Tripped() bool {
+	return c.tripped.Load() || (c.forceOpen != nil && c.forceOpen())
+}
@@ -1,9 +1,11 @@
 package circuitbreaker
 
 import (
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/sony/gobreaker"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -51,3 +53,59 @@ func TestDynamicSettings(t *testing.T) {
 	cb3 := tscb.cb.Load()
 	s.NotEqual(cb3, cb2)
 }
+
+func TestTripAndReset(t *testing.T) {
+	s := assert.New(t)
+
+	var transitions []gobreaker.State
+	tscb := NewTwoStepCircuitBreakerWithDynamicSettings(Settings{
+		OnStateChange: func(_ string, _ gobreaker.State, to gobreaker.State) {
+			transitions = append(transitions, to)
+		},
+	})
+	tscb.UpdateSettings(dynamicconfig.CircuitBreakerSettings{})
+
+	tscb.Trip()
+	s.True(tscb.Tripped())
+	s.Equal(gobreaker.StateOpen, tscb.State())
+	_, err := tscb.Allow()
+	s.ErrorIs(err, gobreaker.ErrOpenState)
+
+	// A manual trip survives settings changes.
+	tscb.UpdateSettings(dynamicconfig.CircuitBreakerSettings{MaxRequests: 2})
+	s.Equal(gobreaker.StateOpen, tscb.State())
+
+	tscb.Reset()
+	s.False(tscb.Tripped())
+	s.Equal(gobreaker.StateClosed, tscb.State())
+	doneFn, err := tscb.Allow()
+	s.NoError(err)
+	doneFn(true)
+	s.Equal([]gobreaker.State{gobreaker.StateOpen, gobreaker.StateClosed}, transitions)
+}
+
+func TestForceOpen(t *testing.T) {
+	s := assert.New(t)
+
+	var forceOpen atomic.Bool
+	tscb := NewTwoStepCircuitBreakerWithDynamicSettings(Settings{
+		ForceOpen: forceOpen.Load,
+	})
+	tscb.UpdateSettings(dynamicconfig.CircuitBreakerSettings{})
+
+	forceOpen.Store(true)
+	s.True(tscb.Tripped())
+	s.Equal(gobreaker.StateOpen, tscb.State())
+	_, err := tscb.Allow()
+	s.ErrorIs(err, gobreaker.ErrOpenState)
+
+	// Reset does not clear a trip kept outside of the circuit breaker.
+	tscb.Reset()
+	s.True(tscb.Tripped())
+
+	forceOpen.Store(false)
+	s.False(tscb.Tripped())
+	doneFn, err := tscb.Allow()
+	s.NoError(err)
+	doneFn(true)
+}
@@ -35,6 +35,32 @@ func (m *OnceMap[K, T]) Get(key K) T {
 	return value
 }
 
+// Peek returns the value for the given key without constructing it if it is absent.
+func (m *OnceMap[K, T]) Peek(key K) (T, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	value, ok := m.inner[key]
+	return value, ok
+}
+
+// Range calls fn for every constructed key and value until fn returns false.
+// fn is called on a snapshot of the map and may safely call other methods of the map.
+func (m *OnceMap[K, T]) Range(fn func(key K, value T) bool) {
+	m.mu.RLock()
+	keys := make([]K, 0, len(m.inner))
+	values := make([]T, 0, len(m.inner))
+	for k, v := range m.inner {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	m.mu.RUnlock()
+	for i := range keys {
+		if !fn(keys[i], values[i]) {
+			return
+		}
+	}
+}
+
 // FallibleOnceMap is a concurrent map which lazily constructs its values. Map values are initialized on-the-fly, using
 // a provided construction function only when a key is accessed for the first time.
 // If the construct function returns an error, the value is not cached.
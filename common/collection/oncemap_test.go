@@ -25,3 +25,33 @@ func TestOnceMap(t *testing.T) {
 	require.Equal(t, 2, bar1)
 	require.Equal(t, 2, bar2)
 }
+
+func TestOnceMap_PeekAndRange(t *testing.T) {
+	m := NewOnceMap(func(k string) int {
+		return len(k)
+	})
+
+	_, ok := m.Peek("foo")
+	require.False(t, ok)
+
+	m.Get("foo")
+	m.Get("quux")
+
+	v, ok := m.Peek("foo")
+	require.True(t, ok)
+	require.Equal(t, 3, v)
+
+	seen := make(map[string]int)
+	m.Range(func(k string, v int) bool {
+		seen[k] = v
+		return true
+	})
+	require.Equal(t, map[string]int{"foo": 3, "quux": 4}, seen)
+
+	count := 0
+	m.Range(func(string, int) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 1, count)
+}
@@ -225,6 +225,16 @@ in the consistent hash ring used by ringpop. Changing it may cause service disru
 		true,
 		`Toggles "eager workflow start" - returning the first workflow task inline in the
 response to a StartWorkflowExecution request and skipping the trip through matching.`,
+	)
+	NamespaceReplicationFilter = NewNamespaceTypedSetting(
+		"system.namespaceReplicationFilter",
+		ReplicationFilter{},
+		`NamespaceReplicationFilter selects the workflows of a global namespace which are replicated to the other
+clusters of the namespace, e.g. {"WorkflowTypes": ["OrderWorkflow"], "SearchAttribute": "Replicate"}. A workflow is
+replicated if its type is listed or if the named Bool search attribute is true when it starts. The filter is evaluated
+once when a workflow chain starts and is inherited by its continue-as-new, retry and cron runs: excluded workflows
+are marked local-only and are never replicated, even if the filter changes later. Namespace failover is rejected
+while local-only workflows are running. An empty filter replicates all workflows.`,
 	)
 	NamespaceCacheRefreshInterval = NewGlobalDurationSetting(
 		"system.namespaceCacheRefreshInterval",
@@ -273,6 +283,14 @@ operator API calls (highest priority). Should be >0.0 and <= 1.0 (defaults to 20
 		false,
 		`enableInternodeClientKeepAlive is the config to enable keep alive for inter-node connections on client side.`,
 	)
+	EnableRuntimeFaultInjection = NewGlobalBoolSetting(
+		"system.enableRuntimeFaultInjection",
+		false,
+		`EnableRuntimeFaultInjection allows faults to be injected into persistence and RPC client calls at runtime through
+the UpdateFaultInjection admin API, for chaos testing. Clients are only instrumented on hosts started while it is
+enabled, so enabling it takes effect after a restart. Setting it to false stops injecting the faults already added.
+Only meant for test and staging clusters.`,
+	)
 
 	PersistenceQPSBurstRatio = NewGlobalFloatSetting(
 		"system.persistenceQPSBurstRatio",
@@ -644,6 +662,15 @@ Concrete type should be list of strings.`,
 		`FrontendPersistenceDynamicRateLimitingParams is a struct that contains all adjustable dynamic rate limiting params.
 Fields: Enabled, RefreshInterval, LatencyThreshold, ErrorThreshold, RateBackoffStepSize, RateIncreaseStepSize, RateMultiMin, RateMultiMax.
 See DynamicRateLimitingParams comments for more details.`,
+	)
+	FrontendPersistenceConcurrencyLimitingParams = NewGlobalTypedSetting(
+		"frontend.persistenceConcurrencyLimitingParams",
+		DefaultDynamicConcurrencyLimitingParams,
+		`FrontendPersistenceConcurrencyLimitingParams is a struct that contains all adjustable params of the adaptive
+persistence concurrency limiter, which limits the number of in-flight persistence requests based on persistence health signals.
+Fields: Enabled, RefreshInterval, LatencyThreshold, ErrorThreshold, InitialLimit, MinLimit, MaxLimit, LimitBackoffRatio,
+LimitIncreaseStepSize, PriorityShedRatio.
+See DynamicConcurrencyLimitingParams comments for more details.`,
 	)
 	FrontendVisibilityMaxPageSize = NewNamespaceIntSetting(
 		"frontend.visibilityMaxPageSize",
@@ -1171,6 +1198,15 @@ If value less or equal to 0, will fall back to MatchingRPS`,
 		`MatchingPersistenceDynamicRateLimitingParams is a struct that contains all adjustable dynamic rate limiting params.
 Fields: Enabled, RefreshInterval, LatencyThreshold, ErrorThreshold, RateBackoffStepSize, RateIncreaseStepSize, RateMultiMin, RateMultiMax.
 See DynamicRateLimitingParams comments for more details.`,
+	)
+	MatchingPersistenceConcurrencyLimitingParams = NewGlobalTypedSetting(
+		"matching.persistenceConcurrencyLimitingParams",
+		DefaultDynamicConcurrencyLimitingParams,
+		`MatchingPersistenceConcurrencyLimitingParams is a struct that contains all adjustable params of the adaptive
+persistence concurrency limiter, which limits the number of in-flight persistence requests based on persistence health signals.
+Fields: Enabled, RefreshInterval, LatencyThreshold, ErrorThreshold, InitialLimit, MinLimit, MaxLimit, LimitBackoffRatio,
+LimitIncreaseStepSize, PriorityShedRatio.
+See DynamicConcurrencyLimitingParams comments for more details.`,
 	)
 	MatchingMinTaskThrottlingBurstSize = NewTaskQueueIntSetting(
 		"matching.minTaskThrottlingBurstSize",
@@ -1499,6 +1535,27 @@ second per poller by one physical queue manager`,
 		1000,
 		"Maximum number of fairness key weight overrides that can be configured for a task queue at a time.",
 	)
+	MatchingTaskHookWebhook = NewTaskQueueTypedSetting(
+		"matching.taskHookWebhook",
+		DefaultTaskHookWebhookSettings,
+		`Configuration of the built-in task hook that posts batches of task add and dispatch events of a task queue
+partition to an HTTP webhook as JSON. The hook is disabled when URL is empty. Changes only apply to partitions
+loaded after the change.`,
+	)
+	MatchingDeploymentVersionAddRateInterval = NewTaskQueueDurationSetting(
+		"matching.deploymentVersionAddRateInterval",
+		0,
+		`Interval at which the built-in task hook emits the task_add_rate_per_deployment_version metric, which can be
+used to autoscale versioned workers. The hook is disabled when this is zero. Changes only apply to partitions
+loaded after the change.`,
+	)
+	MatchingWorkerScalingTargets = NewTaskQueueTypedSetting(
+		"matching.workerScalingTargets",
+		DefaultWorkerScalingTargets,
+		`Targets used by GetWorkerScalingRecommendation to compute the number of workers a task queue needs: the
+fraction of task slots in use, the average dispatch latency, the time to drain the backlog in, and the minimum and
+maximum number of workers (zero maximum means unbounded).`,
+	)
 	MatchingEnableWorkerPluginMetrics = NewGlobalBoolSetting(
 		"matching.enableWorkerPluginMetrics",
 		false,
@@ -1559,6 +1616,44 @@ all namespaces. When exceeded, the oldest entries (older than MinEvictAge) are e
 		1*time.Minute,
 		`MatchingWorkerRegistryEvictionInterval is how often the worker registry runs background eviction
 to remove expired entries. Should be shorter than EntryTTL for timely cleanup. Lower values mean faster cleanup but more CPU overhead.`,
+	)
+	MatchingWorkerRegistryHistorySize = NewGlobalIntSetting(
+		"matching.workerRegistryHistorySize",
+		10,
+		`MatchingWorkerRegistryHistorySize is the number of previous heartbeats the worker registry keeps per worker.
+Only the fields that change between heartbeats (slot usage, poller counts and host info) are kept.`,
+	)
+	MatchingWorkerRegistryStaleAfter = NewGlobalDurationSetting(
+		"matching.workerRegistryStaleAfter",
+		2*time.Minute,
+		`MatchingWorkerRegistryStaleAfter is the time without a heartbeat after which a worker is reported as stale.
+Should be longer than the worker heartbeat interval and shorter than EntryTTL, after which the worker is evicted.`,
+	)
+	MatchingWorkerRegistrySaturationThreshold = NewGlobalFloatSetting(
+		"matching.workerRegistrySaturationThreshold",
+		0.9,
+		`MatchingWorkerRegistrySaturationThreshold is the ratio of used to total slots of any task type at or above
+which a worker heartbeat counts as saturated.`,
+	)
+	MatchingWorkerRegistrySaturationHeartbeats = NewGlobalIntSetting(
+		"matching.workerRegistrySaturationHeartbeats",
+		3,
+		`MatchingWorkerRegistrySaturationHeartbeats is the number of consecutive saturated heartbeats after which a
+worker is reported as saturated.`,
+	)
+	MatchingWorkerRegistryPersistenceEnabled = NewGlobalBoolSetting(
+		"matching.workerRegistryPersistenceEnabled",
+		false,
+		`MatchingWorkerRegistryPersistenceEnabled persists the worker registry of each namespace, including the
+heartbeat history, so that it survives matching restarts and namespaces moving between matching hosts.
+A snapshot of all workers of the namespace is written at every persist interval, so this is best suited to
+namespaces with a moderate number of workers.`,
+	)
+	MatchingWorkerRegistryPersistInterval = NewGlobalDurationSetting(
+		"matching.workerRegistryPersistInterval",
+		1*time.Minute,
+		`MatchingWorkerRegistryPersistInterval is how often the worker registry persists the namespaces that
+received heartbeats since they were last persisted, when persistence is enabled.`,
 	)
 	MatchingSpreadRoutingBatchSize = NewGlobalTypedSettingWithConverter(
 		"matching.spreadRoutingBatchSize",
@@ -1656,6 +1751,15 @@ If value less or equal to 0, will fall back to HistoryPersistenceMaxQPS`,
 		`HistoryPersistenceDynamicRateLimitingParams is a struct that contains all adjustable dynamic rate limiting params.
 Fields: Enabled, RefreshInterval, LatencyThreshold, ErrorThreshold, RateBackoffStepSize, RateIncreaseStepSize, RateMultiMin, RateMultiMax.
 See DynamicRateLimitingParams comments for more details.`,
+	)
+	HistoryPersistenceConcurrencyLimitingParams = NewGlobalTypedSetting(
+		"history.persistenceConcurrencyLimitingParams",
+		DefaultDynamicConcurrencyLimitingParams,
+		`HistoryPersistenceConcurrencyLimitingParams is a struct that contains all adjustable params of the adaptive
+persistence concurrency limiter, which limits the number of in-flight persistence requests based on persistence health signals.
+Fields: Enabled, RefreshInterval, LatencyThreshold, ErrorThreshold, InitialLimit, MinLimit, MaxLimit, LimitBackoffRatio,
+LimitIncreaseStepSize, PriorityShedRatio.
+See DynamicConcurrencyLimitingParams comments for more details.`,
 	)
 	EnableBestEffortDeleteTasksOnWorkflowUpdate = NewGlobalBoolSetting(
 		"history.enableBestEffortDeleteTasksOnWorkflowUpdate",
@@ -1763,6 +1867,39 @@ This can help reduce effects of shard movement.`,
 		false,
 		`EnableHostLevelEventsCache controls if the events cache is host level. Requires service restart to take effect.`,
 	)
+	HostLevelCacheBudgetEnabled = NewGlobalBoolSetting(
+		"history.hostLevelCacheBudgetEnabled",
+		false,
+		`HostLevelCacheBudgetEnabled if true, the host level mutable state cache and events cache share a single size
+budget, HostLevelCacheBudgetBytes, which is split between them based on their hit rates. The mutable state cache is
+then limited by size, the events cache is host level, and HistoryCacheHostLevelMaxSize,
+HistoryCacheHostLevelMaxSizeBytes and EventsHostLevelCacheMaxSizeBytes are ignored.
+Requires service restart to take effect.`,
+	)
+	HostLevelCacheBudgetBytes = NewGlobalIntSetting(
+		"history.hostLevelCacheBudgetBytes",
+		1280*1024*1024,
+		`HostLevelCacheBudgetBytes is the total size of the host level mutable state cache and events cache when
+HostLevelCacheBudgetEnabled is true.`,
+	)
+	HostLevelCacheBudgetMinShare = NewGlobalFloatSetting(
+		"history.hostLevelCacheBudgetMinShare",
+		0.1,
+		`HostLevelCacheBudgetMinShare is the minimum fraction of HostLevelCacheBudgetBytes allocated to each of the host
+level caches, regardless of their hit rates.`,
+	)
+	HostLevelCacheBudgetRebalanceInterval = NewGlobalDurationSetting(
+		"history.hostLevelCacheBudgetRebalanceInterval",
+		time.Minute,
+		`HostLevelCacheBudgetRebalanceInterval is the interval at which HostLevelCacheBudgetBytes is split again
+between the host level caches based on their hit rates.`,
+	)
+	HostLevelCacheNamespaceMaxShare = NewGlobalFloatSetting(
+		"history.hostLevelCacheNamespaceMaxShare",
+		0.5,
+		`HostLevelCacheNamespaceMaxShare is the fraction of a host level cache a single namespace can use before its
+entries are evicted first when the cache is full. Only used when HostLevelCacheBudgetEnabled is true.`,
+	)
 	AcquireShardInterval = NewGlobalDurationSetting(
 		"history.acquireShardInterval",
 		time.Minute,
@@ -2384,6 +2521,33 @@ archivalQueueProcessor`,
 		0.9,
 		`WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold is the percentage threshold of total updates that any given workflow execution can receive before suggesting to continue-as-new.`,
 	)
+	EnableUpdateOutcomeCompaction = NewNamespaceBoolSetting(
+		"history.enableUpdateOutcomeCompaction",
+		false,
+		`EnableUpdateOutcomeCompaction writes WorkflowExecutionUpdateCompleted events without outcome payloads and
+retains the outcomes in mutable state instead, bounded by UpdateOutcomeRetention, MaxRetainedUpdateOutcomes and
+MaxRetainedUpdateOutcomesSizeBytes. The compacted events are marked as such, so once a retained outcome expires or is
+evicted, or if mutable state is rebuilt from history (e.g. on reset or on a cluster that replicates events), polling
+that update returns a FailedPrecondition error.`,
+	)
+	UpdateOutcomeRetention = NewNamespaceDurationSetting(
+		"history.updateOutcomeRetention",
+		24*time.Hour,
+		`UpdateOutcomeRetention is how long the outcome of a compacted update is retained in mutable state.`,
+	)
+	MaxRetainedUpdateOutcomes = NewNamespaceIntSetting(
+		"history.maxRetainedUpdateOutcomes",
+		100,
+		`MaxRetainedUpdateOutcomes is the max number of compacted update outcomes retained in mutable state for any given
+workflow execution. When exceeded, the outcomes closest to expiry are evicted first.`,
+	)
+	MaxRetainedUpdateOutcomesSizeBytes = NewNamespaceIntSetting(
+		"history.maxRetainedUpdateOutcomesSizeBytes",
+		256*1024,
+		`MaxRetainedUpdateOutcomesSizeBytes is the max total size in bytes of the compacted update outcomes retained in
+mutable state for any given workflow execution. When exceeded, the outcomes closest to expiry are evicted first. An
+outcome larger than this limit is not retained at all.`,
+	)
 	EnableUpdateWithStartRetryOnClosedWorkflowAbort = NewNamespaceBoolSetting(
 		"history.enableUpdateWithStartRetryOnClosedWorkflowAbort",
 		true,
@@ -2557,6 +2721,40 @@ the number of children greater than or equal to this threshold`,
 		1,
 		`MaxBufferedQueryCount indicates max buffer query count`,
 	)
+	StandbyQueryConsistencyTimeout = NewNamespaceDurationSetting(
+		"history.standbyQueryConsistencyTimeout",
+		10*time.Second,
+		`StandbyQueryConsistencyTimeout is the max time a query on a standby cluster waits for replication to catch up with
+the consistency requested by the caller before it fails.`,
+	)
+	EnableQueryResultCache = NewNamespaceBoolSetting(
+		"history.enableQueryResultCache",
+		false,
+		`EnableQueryResultCache enables caching query results of closed workflows in their mutable state. Only results
+the worker declared cacheable, by setting the "temporal-query-result-cacheable" metadata of the result payloads to
+"true", are cached.`,
+	)
+	QueryResultCacheTTL = NewNamespaceDurationSetting(
+		"history.queryResultCacheTTL",
+		time.Hour,
+		`QueryResultCacheTTL is how long a cached query result of a closed workflow is served.`,
+	)
+	QueryResultCacheMaxEntries = NewNamespaceIntSetting(
+		"history.queryResultCacheMaxEntries",
+		20,
+		`QueryResultCacheMaxEntries is the max number of query results cached for any given closed workflow execution.`,
+	)
+	QueryResultCacheMaxResultSize = NewNamespaceIntSetting(
+		"history.queryResultCacheMaxResultSize",
+		64*1024,
+		`QueryResultCacheMaxResultSize is the max size (in bytes) of a query result that can be cached.`,
+	)
+	QueryResultCacheFillRPS = NewGlobalIntSetting(
+		"history.queryResultCacheFillRPS",
+		10,
+		`QueryResultCacheFillRPS is the max rate per shard of query results stored in the query result cache. Each
+stored result is a mutable state write.`,
+	)
 	MutableStateChecksumGenProbability = NewNamespaceIntSetting(
 		"history.mutableStateChecksumGenProbability",
 		0,
@@ -3076,6 +3274,15 @@ but existing callbacks will still be processed and fired.`,
 		`WorkerPersistenceDynamicRateLimitingParams is a struct that contains all adjustable dynamic rate limiting params.
 Fields: Enabled, RefreshInterval, LatencyThreshold, ErrorThreshold, RateBackoffStepSize, RateIncreaseStepSize, RateMultiMin, RateMultiMax.
 See DynamicRateLimitingParams comments for more details.`,
+	)
+	WorkerPersistenceConcurrencyLimitingParams = NewGlobalTypedSetting(
+		"worker.persistenceConcurrencyLimitingParams",
+		DefaultDynamicConcurrencyLimitingParams,
+		`WorkerPersistenceConcurrencyLimitingParams is a struct that contains all adjustable params of the adaptive
+persistence concurrency limiter, which limits the number of in-flight persistence requests based on persistence health signals.
+Fields: Enabled, RefreshInterval, LatencyThreshold, ErrorThreshold, InitialLimit, MinLimit, MaxLimit, LimitBackoffRatio,
+LimitIncreaseStepSize, PriorityShedRatio.
+See DynamicConcurrencyLimitingParams comments for more details.`,
 	)
 	WorkerIndexerConcurrency = NewGlobalIntSetting(
 		"worker.indexerConcurrency",
@@ -3194,6 +3401,48 @@ because executions scanner support for SQL is not yet implemented.`,
 		true,
 		`HistoryScannerVerifyRetention indicates if the history scavenger should verify data retention.
 When enabled, the scavenger will delete completed workflow execution data that are older than the namespace retention period plus worker.executionDataDurationBuffer.`,
+	)
+	HistoryScannerDryRun = NewGlobalBoolSetting(
+		"worker.historyScannerDryRun",
+		false,
+		`HistoryScannerDryRun indicates if the history scavenger should only log the history branches of existing
+executions that are not referenced by their mutable state, without deleting them. History branches of executions
+that no longer exist are always deleted.`,
+	)
+	HistoryScannerReportReclaimableBytes = NewGlobalBoolSetting(
+		"worker.historyScannerReportReclaimableBytes",
+		false,
+		`HistoryScannerReportReclaimableBytes indicates if the history scavenger should read the garbage history
+branches it finds to report their size. This reads every garbage branch in full before it is deleted.`,
+	)
+	VisibilityScannerEnabled = NewGlobalBoolSetting(
+		"worker.visibilityScannerEnabled",
+		false,
+		`VisibilityScannerEnabled indicates if visibility scanner should be started as part of worker.Scanner.
+The visibility scanner compares the visibility records of workflow executions with their mutable state.`,
+	)
+	VisibilityScannerSampleRate = NewGlobalFloatSetting(
+		"worker.visibilityScannerSampleRate",
+		0.01,
+		`VisibilityScannerSampleRate is the fraction of workflow executions checked by the visibility scanner.
+Set to 1 to check all workflow executions.`,
+	)
+	VisibilityScannerRPS = NewGlobalIntSetting(
+		"worker.visibilityScannerRPS",
+		10,
+		`VisibilityScannerRPS is the maximum rate of visibility records read by the visibility scanner per host`,
+	)
+	VisibilityScannerDataMinAge = NewGlobalDurationSetting(
+		"worker.visibilityScannerDataMinAge",
+		10*time.Minute,
+		`VisibilityScannerDataMinAge is the minimum time since the last update of a workflow execution for it to be checked
+by the visibility scanner. Visibility is eventually consistent, so recently updated executions might not be visible yet.`,
+	)
+	VisibilityScannerRepairEnabled = NewGlobalBoolSetting(
+		"worker.visibilityScannerRepairEnabled",
+		false,
+		`VisibilityScannerRepairEnabled indicates if the visibility scanner should refresh the tasks of workflow executions
+with a mismatching visibility record, which regenerates their visibility tasks.`,
 	)
 	EnableBatcherNamespace = NewNamespaceBoolSetting(
 		"worker.enableNamespaceBatcher",
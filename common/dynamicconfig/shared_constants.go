@@ -90,6 +90,50 @@ var DefaultDynamicRateLimitingParams = DynamicRateLimitingParams{
 	RateMultiMax:         1.0,
 }
 
+// params for controlling the adaptive persistence concurrency limiter
+type DynamicConcurrencyLimitingParams struct {
+	// Enabled toggles whether the number of in-flight persistence requests is limited.
+	Enabled bool
+	// RefreshInterval is how often the concurrency limit and dynamic properties are refreshed.
+	RefreshInterval time.Duration
+	// LatencyThreshold is the maximum average latency in ms before the concurrency limit is
+	// decreased.
+	LatencyThreshold float64
+	// ErrorThreshold is the maximum ratio of errors:total_requests before the concurrency limit
+	// is decreased. Should be between 0 and 1.
+	ErrorThreshold float64
+	// InitialLimit is the concurrency limit used until the first refresh.
+	InitialLimit int
+	// MinLimit is the minimum the concurrency limit can be decreased to.
+	MinLimit int
+	// MaxLimit is the maximum the concurrency limit can be increased to.
+	MaxLimit int
+	// LimitBackoffRatio is the ratio the concurrency limit is multiplied by when backing off.
+	// Should be between 0 and 1.
+	LimitBackoffRatio float64
+	// LimitIncreaseStepSize is the number of requests the concurrency limit is increased by when
+	// the system is healthy and current limit < max limit.
+	LimitIncreaseStepSize int
+	// PriorityShedRatio is the fraction of the concurrency limit that is not available to the
+	// lowest priority requests. Requests of higher priorities can use a proportionally larger
+	// fraction of the limit, and requests of the highest priority can use all of it, so that
+	// lower priority requests are shed first. Should be between 0 and 1.
+	PriorityShedRatio float64
+}
+
+var DefaultDynamicConcurrencyLimitingParams = DynamicConcurrencyLimitingParams{
+	Enabled:               false,
+	RefreshInterval:       10 * time.Second,
+	LatencyThreshold:      0.0, // will not do backoff based on latency
+	ErrorThreshold:        0.0, // will not do backoff based on errors
+	InitialLimit:          1000,
+	MinLimit:              50,
+	MaxLimit:              1000,
+	LimitBackoffRatio:     0.7,
+	LimitIncreaseStepSize: 10,
+	PriorityShedRatio:     0.5,
+}
+
 type CircuitBreakerSettings struct {
 	// MaxRequests: Maximum number of requests allowed to pass through when
 	// it is in half-open state (default 1).
@@ -101,6 +145,57 @@ type CircuitBreakerSettings struct {
 	Timeout time.Duration
 }
 
+// ReplicationFilter selects the workflows of a global namespace which are replicated to the other clusters of the
+// namespace. A workflow is replicated if its type is one of WorkflowTypes, or if the Bool search attribute named
+// SearchAttribute is true when the workflow starts. An empty filter replicates all workflows.
+type ReplicationFilter struct {
+	WorkflowTypes   []string
+	SearchAttribute string
+}
+
+// IsEmpty returns whether the filter replicates all workflows.
+func (f ReplicationFilter) IsEmpty() bool {
+	return len(f.WorkflowTypes) == 0 && f.SearchAttribute == ""
+}
+
+// TaskHookWebhookSettings configures the matching task hook that posts task lifecycle events to an HTTP webhook.
+type TaskHookWebhookSettings struct {
+	// URL is the endpoint events are posted to. The hook is disabled when it is empty.
+	URL string
+	// BatchSize is the maximum number of events posted in one request.
+	BatchSize int
+	// FlushInterval is the maximum time an event is buffered before it is posted.
+	FlushInterval time.Duration
+	// Timeout is the timeout of each post request.
+	Timeout time.Duration
+}
+
+var DefaultTaskHookWebhookSettings = TaskHookWebhookSettings{
+	BatchSize:     100,
+	FlushInterval: 5 * time.Second,
+	Timeout:       5 * time.Second,
+}
+
+// WorkerScalingTargets are the targets matching uses to compute how many workers a task queue needs.
+type WorkerScalingTargets struct {
+	// SlotUtilization is the fraction of the task slots of the workers that should be in use.
+	SlotUtilization float64
+	// DispatchLatency is the maximum average time tasks should wait before they are dispatched to a worker.
+	DispatchLatency time.Duration
+	// BacklogDrainTime is the time in which the backlog should be drained by additional workers.
+	BacklogDrainTime time.Duration
+	// MinWorkers and MaxWorkers bound the recommended number of workers. MaxWorkers is unbounded when zero.
+	MinWorkers int
+	MaxWorkers int
+}
+
+var DefaultWorkerScalingTargets = WorkerScalingTargets{
+	SlotUtilization:  0.8,
+	DispatchLatency:  time.Second,
+	BacklogDrainTime: time.Minute,
+	MinWorkers:       1,
+}
+
 type CacheBackgroundEvictSettings struct {
 	// Enabled controls whether background purging of expired entries is active. To enable,
 	// this must be set to true at process start, but can be dynamically set to false to
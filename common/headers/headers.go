@@ -25,6 +25,13 @@ const (
 	PrincipalNameHeaderName = "temporal-principal-name"
 
 	ExperimentHeaderName = "temporal-experiment"
+
+	// QueryMinEventIDHeaderName makes a query on a standby cluster wait until replication has applied the given event ID.
+	QueryMinEventIDHeaderName = "temporal-query-min-event-id"
+	// QueryConsistencyHeaderName set to QueryConsistencyActiveLastWrite makes a query on a standby cluster wait until
+	// replication has caught up with the last write of the workflow in the active cluster.
+	QueryConsistencyHeaderName      = "temporal-query-consistency"
+	QueryConsistencyActiveLastWrite = "active-last-write"
 )
 
 var (
@@ -506,6 +506,8 @@ const (
 	VisibilityArchiverScope = "VisibilityArchiver"
 	// HistoryScavengerScope is scope used by all metrics emitted by worker.history.Scavenger module
 	HistoryScavengerScope = "HistoryScavenger"
+	// VisibilityScavengerScope is scope used by all metrics emitted by worker.visibility.Scavenger module
+	VisibilityScavengerScope = "VisibilityScavenger"
 	// ArchiverDeleteHistoryActivityScope is scope used by all metrics emitted by archiver.DeleteHistoryActivity
 	ArchiverDeleteHistoryActivityScope = "ArchiverDeleteHistoryActivity"
 	// ArchiverUploadHistoryActivityScope is scope used by all metrics emitted by archiver.UploadHistoryActivity
@@ -783,6 +785,8 @@ var (
 	CacheTtl                                     = NewTimerDef("cache_ttl")
 	CacheEntryAgeOnGet                           = NewTimerDef("cache_entry_age_on_get")
 	CacheEntryAgeOnEviction                      = NewTimerDef("cache_entry_age_on_eviction")
+	CacheHitRatio                                = NewGaugeDef("cache_hit_ratio")
+	CacheNamespaceShareEvictions                 = NewCounterDef("cache_namespace_share_evictions")
 	HistoryEventNotificationQueueingLatency      = NewTimerDef("history_event_notification_queueing_latency")
 	HistoryEventNotificationFanoutLatency        = NewTimerDef("history_event_notification_fanout_latency")
 	HistoryEventNotificationInFlightMessageGauge = NewGaugeDef("history_event_notification_inflight_message_gauge")
@@ -1109,6 +1113,10 @@ var (
 	ConsistentQueryTimeoutCount                    = NewCounterDef("consistent_query_timeout")
 	QueryBufferExceededCount                       = NewCounterDef("query_buffer_exceeded")
 	QueryRegistryInvalidStateCount                 = NewCounterDef("query_registry_invalid_state")
+	QueryResultCacheHitCount                       = NewCounterDef("query_result_cache_hit")
+	QueryResultCacheMissCount                      = NewCounterDef("query_result_cache_miss")
+	StandbyQueryReplicationWaitLatency             = NewTimerDef("standby_query_replication_wait_latency")
+	StandbyQueryReplicationTimeoutCount            = NewCounterDef("standby_query_replication_timeout")
 	WorkflowTaskTimeoutOverrideCount               = NewCounterDef("workflow_task_timeout_overrides")
 	WorkflowRunTimeoutOverrideCount                = NewCounterDef("workflow_run_timeout_overrides")
 	ReplicationTaskCleanupCount                    = NewCounterDef("replication_task_cleanup_count")
@@ -1121,6 +1129,9 @@ var (
 	ShardLingerSuccess                             = NewTimerDef("shard_linger_success")
 	ShardLingerTimeouts                            = NewCounterDef("shard_linger_timeouts")
 	DynamicRateLimiterMultiplier                   = NewGaugeDef("dynamic_rate_limit_multiplier")
+	DynamicConcurrencyLimit                        = NewGaugeDef("dynamic_concurrency_limit")
+	DynamicConcurrencyLimitInFlight                = NewGaugeDef("dynamic_concurrency_limit_in_flight")
+	DynamicConcurrencyLimitShedRequests            = NewCounterDef("dynamic_concurrency_limit_shed_requests")
 	DLQWrites                                      = NewCounterDef(
 		"dlq_writes",
 		WithDescription("The number of times a message is enqueued to DLQ. DLQ can be inspected using tdbg dlq command."),
@@ -1134,6 +1145,10 @@ var (
 		WithDescription("Total number of data loss errors encountered. This is a high cardinality metrics that has namespace, workflowID and runID tags."+
 			"It is only emitted when system.enableDataLossMetrics is enabled. Only enable this if metrics system can handle it's cardinality"),
 	)
+	CircuitBreakerStateChange = NewCounterDef(
+		"circuit_breaker_state_change",
+		WithDescription("The number of outbound queue circuit breaker state transitions, tagged by the new state."),
+	)
 	ReadNamespaceErrors                     = NewCounterDef("read_namespace_errors")
 	RateLimitedTaskRunnableWaitTime         = NewTimerDef("rate_limited_task_runnable_wait_time")
 	CircuitBreakerExecutableBlocked         = NewCounterDef("circuit_breaker_executable_blocked")
@@ -1157,6 +1172,8 @@ var (
 	ActivityUnpauseRequests       = NewCounterDef("activity_unpause_requests")
 	ActivityResetRequests         = NewCounterDef("activity_reset_requests")
 	ActivityUpdateOptionsRequests = NewCounterDef("activity_update_options_requests")
+	WorkflowRuleMatched           = NewCounterDef("workflow_rule_matched")
+	WorkflowRuleActionMetric      = NewCounterDef("workflow_rule_action_metric")
 	ExternalPayloadUploadSize     = NewBytesHistogramDef("external_payload_upload_size", WithDescription("The histogram of sizes in bytes of uploaded external payloads."))
 
 	// Deadlock detector metrics
@@ -1247,6 +1264,14 @@ var (
 		"task_retry_transient",
 		WithDescription("Count of tasks that hit a transient error during match or forward and are retried immediately"),
 	)
+	TaskAddRatePerDeploymentVersion = NewGaugeDef(
+		"task_add_rate_per_deployment_version",
+		WithDescription("Rate of tasks added to a task queue partition per second, broken down by worker deployment version"),
+	)
+	TaskHookWebhookDroppedEvents = NewCounterDef(
+		"task_hook_webhook_dropped_events",
+		WithDescription("Number of task lifecycle events the webhook task hook failed to deliver"),
+	)
 
 	// ----------------------------------------------------------------------------------------------------------------
 	// Matching service: Metrics to track the health of worker registry.
@@ -1273,6 +1298,18 @@ var (
 		"worker_registry_activity_slots_used",
 		WithDescription("Number of activity slots in use per worker."),
 	)
+	WorkerRegistryStaleWorkers = NewGaugeDef(
+		"worker_registry_stale_workers",
+		WithDescription("Number of workers of a namespace that stopped heartbeating but are not evicted yet."),
+	)
+	WorkerRegistrySaturatedWorkers = NewGaugeDef(
+		"worker_registry_saturated_workers",
+		WithDescription("Number of workers of a namespace whose slots have been persistently used up."),
+	)
+	WorkerRegistryPersistFailures = NewCounterDef(
+		"worker_registry_persist_failures",
+		WithDescription("Count of failures to load or save the persisted worker registry of a namespace."),
+	)
 	// ----------------------------------------------------------------------------------------------------------------
 	// Matching service: Metrics to understand plugin adoption.
 	WorkerPluginNameMetric = NewGaugeDef(
@@ -1334,11 +1371,21 @@ var (
 	HistoryScavengerSuccessCount                    = NewCounterDef("scavenger_success")
 	HistoryScavengerErrorCount                      = NewCounterDef("scavenger_errors")
 	HistoryScavengerSkipCount                       = NewCounterDef("scavenger_skips")
+	HistoryScavengerUnreferencedBranchCount         = NewCounterDef("scavenger_unreferenced_branches")
+	HistoryScavengerReclaimableBytes                = NewCounterDef("scavenger_reclaimable_bytes")
 	ExecutionsOutstandingCount                      = NewGaugeDef("executions_outstanding")
 	ScavengerValidationRequestsCount                = NewCounterDef("scavenger_validation_requests")
 	ScavengerValidationFailuresCount                = NewCounterDef("scavenger_validation_failures")
 	ScavengerValidationSkipsCount                   = NewCounterDef("scavenger_validation_skips")
 	AddSearchAttributesFailuresCount                = NewCounterDef("add_search_attributes_failures")
+	VisibilityScavengerCheckedCount                 = NewCounterDef("visibility_scavenger_checked")
+	VisibilityScavengerMismatchCount                = NewCounterDef(
+		"visibility_scavenger_mismatches",
+		WithDescription("The number of visibility records that don't match the mutable state of their execution, tagged by mismatch type"),
+	)
+	VisibilityScavengerRepairCount = NewCounterDef("visibility_scavenger_repairs")
+	VisibilityScavengerErrorCount  = NewCounterDef("visibility_scavenger_errors")
+	VisibilityScavengerSkipCount   = NewCounterDef("visibility_scavenger_skips")
 
 	// Delete Namespace metrics.
 	ReclaimResourcesNamespaceDeleteSuccessCount = NewCounterDef(
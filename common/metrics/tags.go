@@ -64,6 +64,8 @@ const (
 	clientName                                     = "client_name"
 	isInternal                                     = "is_internal"
 	activityTargetingMethod                        = "activity_targeting_method"
+	workflowRuleID                                 = "workflow_rule_id"
+	workflowRuleMetricName                         = "workflow_rule_metric_name"
 	unknownValue                                   = "_unknown_"
 	totalMetricSuffix                              = "_total"
 	tagExcludedValue                               = "_tag_excluded_"
@@ -230,6 +232,16 @@ func ActivityTargetingMethodTag(value string) Tag {
 	return Tag{Key: activityTargetingMethod, Value: value}
 }
 
+// WorkflowRuleIDTag returns a new workflow rule ID tag. The number of rules is bounded per namespace.
+func WorkflowRuleIDTag(value string) Tag {
+	return Tag{Key: workflowRuleID, Value: value}
+}
+
+// WorkflowRuleMetricNameTag returns a new tag with the name of the metric action of a workflow rule.
+func WorkflowRuleMetricNameTag(value string) Tag {
+	return Tag{Key: workflowRuleMetricName, Value: value}
+}
+
 // CommandTypeTag returns a new command type tag.
 func CommandTypeTag(value string) Tag {
 	if len(value) == 0 {
@@ -52,6 +52,8 @@ type (
 	CustomSearchAttributesMapper struct {
 		fieldToAlias map[string]string
 		aliasToField map[string]string
+		// previous aliases of renamed search attributes, accepted by GetFieldName until they expire
+		aliasTransitions map[string]*persistencespb.SearchAttributeAliasTransition
 	}
 
 	// ReplicationPolicy is the namespace's replication policy,
@@ -88,8 +90,9 @@ func FromPersistentState(
 		config:        detail.Config,
 		configVersion: detail.ConfigVersion,
 		customSearchAttributesMapper: CustomSearchAttributesMapper{
-			fieldToAlias: detail.Config.CustomSearchAttributeAliases,
-			aliasToField: util.InverseMap(detail.Config.CustomSearchAttributeAliases),
+			fieldToAlias:     detail.Config.CustomSearchAttributeAliases,
+			aliasToField:     util.InverseMap(detail.Config.CustomSearchAttributeAliases),
+			aliasTransitions: detail.Config.SearchAttributeAliasTransitions,
 		},
 		replicationResolver: resolver,
 	}
@@ -110,8 +113,9 @@ func (ns *Namespace) Clone(mutations ...Mutation) *Namespace {
 		config:        common.CloneProto(ns.config),
 		configVersion: ns.configVersion,
 		customSearchAttributesMapper: CustomSearchAttributesMapper{
-			fieldToAlias: ns.customSearchAttributesMapper.fieldToAlias,
-			aliasToField: ns.customSearchAttributesMapper.aliasToField,
+			fieldToAlias:     ns.customSearchAttributesMapper.fieldToAlias,
+			aliasToField:     ns.customSearchAttributesMapper.aliasToField,
+			aliasTransitions: ns.customSearchAttributesMapper.aliasTransitions,
 		},
 		notificationVersion: ns.notificationVersion,
 		replicationResolver: clonedResolver,
@@ -300,6 +304,27 @@ func (ns *Namespace) CustomSearchAttributesMapper() CustomSearchAttributesMapper
 	return ns.customSearchAttributesMapper
 }
 
+// SearchAttributeTypeMigrations returns the type migrations of custom search attributes, keyed by source field name.
+func (ns *Namespace) SearchAttributeTypeMigrations() map[string]*persistencespb.SearchAttributeTypeMigration {
+	return ns.config.GetSearchAttributeTypeMigrations()
+}
+
+// OutboundCircuitBreakerTrips returns the outbound queue circuit breaker trips made by operators.
+func (ns *Namespace) OutboundCircuitBreakerTrips() []*persistencespb.OutboundCircuitBreakerTrip {
+	return ns.config.GetOutboundCircuitBreakerTrips()
+}
+
+// OutboundCircuitBreakerTripped returns true if an operator tripped the outbound queue circuit breakers of the
+// destination for the task group.
+func (ns *Namespace) OutboundCircuitBreakerTripped(taskGroup string, destination string) bool {
+	for _, trip := range ns.OutboundCircuitBreakerTrips() {
+		if trip.GetDestination() == destination && (trip.GetTaskGroup() == "" || trip.GetTaskGroup() == taskGroup) {
+			return true
+		}
+	}
+	return false
+}
+
 func (ns *Namespace) GetWorkflowRules() []*rulespb.WorkflowRule {
 	if ns.config.WorkflowRules == nil {
 		return nil
@@ -315,6 +340,12 @@ func (ns *Namespace) GetWorkflowRule(ruleID string) (*rulespb.WorkflowRule, bool
 	return result, ok
 }
 
+// GetWorkflowRuleExtension returns the server side triggers and actions of a workflow rule.
+func (ns *Namespace) GetWorkflowRuleExtension(ruleID string) (*persistencespb.WorkflowRuleExtension, bool) {
+	result, ok := ns.config.GetWorkflowRuleExtensions()[ruleID]
+	return result, ok
+}
+
 // Error returns the reason associated with this bad binary.
 func (e BadBinaryError) Error() string {
 	return e.info.Reason
@@ -369,6 +400,12 @@ func (m *CustomSearchAttributesMapper) GetAlias(fieldName string, namespace stri
 func (m *CustomSearchAttributesMapper) GetFieldName(alias string, namespace string) (string, error) {
 	fieldName, ok := m.aliasToField[alias]
 	if !ok {
+		// Accept the previous alias of a renamed search attribute during its transition window.
+		if transition, ok := m.aliasTransitions[alias]; ok && time.Now().Before(transition.GetExpireTime().AsTime()) {
+			if _, mapped := m.fieldToAlias[transition.GetFieldName()]; mapped {
+				return transition.GetFieldName(), nil
+			}
+		}
 		return "", serviceerror.NewInvalidArgument(
 			fmt.Sprintf("Namespace %s has no mapping defined for search attribute %s", namespace, alias),
 		)
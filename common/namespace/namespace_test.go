@@ -11,6 +11,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func base(t *testing.T) *namespace.Namespace {
@@ -122,3 +123,51 @@ func TestNamespace_GetCustomData(t *testing.T) {
 	data2 := ns.GetCustomData("fake")
 	assert.Equal(t, "", data2)
 }
+
+func TestCustomSearchAttributesMapper_AliasTransitions(t *testing.T) {
+	detail := &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{
+			Id:   namespace.NewID().String(),
+			Name: t.Name(),
+		},
+		Config: &persistencespb.NamespaceConfig{
+			CustomSearchAttributeAliases: map[string]string{
+				"Keyword01": "NewName",
+				"Keyword02": "Other",
+			},
+			SearchAttributeAliasTransitions: map[string]*persistencespb.SearchAttributeAliasTransition{
+				"OldName": {
+					FieldName:  "Keyword01",
+					ExpireTime: timestamppb.New(time.Now().Add(time.Hour)),
+				},
+				"ExpiredName": {
+					FieldName:  "Keyword02",
+					ExpireTime: timestamppb.New(time.Now().Add(-time.Hour)),
+				},
+				"RemovedName": {
+					FieldName:  "Keyword03",
+					ExpireTime: timestamppb.New(time.Now().Add(time.Hour)),
+				},
+			},
+		},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+	}
+	ns, err := namespace.FromPersistentState(detail, namespace.NewDefaultReplicationResolverFactory()(detail))
+	require.NoError(t, err)
+	mapper := ns.CustomSearchAttributesMapper()
+
+	fieldName, err := mapper.GetFieldName("NewName", t.Name())
+	require.NoError(t, err)
+	require.Equal(t, "Keyword01", fieldName)
+	fieldName, err = mapper.GetFieldName("OldName", t.Name())
+	require.NoError(t, err)
+	require.Equal(t, "Keyword01", fieldName)
+	_, err = mapper.GetFieldName("ExpiredName", t.Name())
+	require.Error(t, err)
+	_, err = mapper.GetFieldName("RemovedName", t.Name())
+	require.Error(t, err)
+
+	alias, err := mapper.GetAlias("Keyword01", t.Name())
+	require.NoError(t, err)
+	require.Equal(t, "NewName", alias)
+}
@@ -27,10 +27,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/channel"
@@ -45,6 +47,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/pingable"
 	expmaps "golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -186,7 +189,11 @@ func DefaultNamespaceStateChanged(currentClusterName string, oldNS *namespace.Na
 		oldNS.Name() != newNS.Name() ||
 		oldNS.IsGlobalNamespace() != newNS.IsGlobalNamespace() ||
 		oldNS.ActiveInCluster(currentClusterName) != newNS.ActiveInCluster(currentClusterName) ||
-		oldNS.ReplicationState("") != newNS.ReplicationState("")
+		oldNS.ReplicationState("") != newNS.ReplicationState("") ||
+		// Outbound circuit breakers are forced open by the trips of the namespace.
+		!slices.EqualFunc(oldNS.OutboundCircuitBreakerTrips(), newNS.OutboundCircuitBreakerTrips(), func(a, b *persistencespb.OutboundCircuitBreakerTrip) bool {
+			return proto.Equal(a, b)
+		})
 }
 
 // GetRegistrySize observes the size of the by-name and by-ID maps.
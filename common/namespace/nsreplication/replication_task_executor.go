@@ -162,12 +162,13 @@ func (h *taskExecutorImpl) handleNamespaceCreationReplicationTask(
 				Data:        task.Info.Data,
 			},
 			Config: &persistencespb.NamespaceConfig{
-				Retention:                    task.Config.GetWorkflowExecutionRetentionTtl(),
-				HistoryArchivalState:         task.Config.GetHistoryArchivalState(),
-				HistoryArchivalUri:           task.Config.GetHistoryArchivalUri(),
-				VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
-				VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
-				CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+				Retention:                       task.Config.GetWorkflowExecutionRetentionTtl(),
+				HistoryArchivalState:            task.Config.GetHistoryArchivalState(),
+				HistoryArchivalUri:              task.Config.GetHistoryArchivalUri(),
+				VisibilityArchivalState:         task.Config.GetVisibilityArchivalState(),
+				VisibilityArchivalUri:           task.Config.GetVisibilityArchivalUri(),
+				CustomSearchAttributeAliases:    task.Config.GetCustomSearchAttributeAliases(),
+				SearchAttributeAliasTransitions: task.GetSearchAttributeAliasTransitions(),
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
@@ -306,12 +307,18 @@ func (h *taskExecutorImpl) handleNamespaceUpdateReplicationTask(
 			Data:        data,
 		}
 		request.Namespace.Config = &persistencespb.NamespaceConfig{
-			Retention:                    task.Config.GetWorkflowExecutionRetentionTtl(),
-			HistoryArchivalState:         task.Config.GetHistoryArchivalState(),
-			HistoryArchivalUri:           task.Config.GetHistoryArchivalUri(),
-			VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
-			VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
-			CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+			Retention:                       task.Config.GetWorkflowExecutionRetentionTtl(),
+			HistoryArchivalState:            task.Config.GetHistoryArchivalState(),
+			HistoryArchivalUri:              task.Config.GetHistoryArchivalUri(),
+			VisibilityArchivalState:         task.Config.GetVisibilityArchivalState(),
+			VisibilityArchivalUri:           task.Config.GetVisibilityArchivalUri(),
+			CustomSearchAttributeAliases:    task.Config.GetCustomSearchAttributeAliases(),
+			SearchAttributeAliasTransitions: task.GetSearchAttributeAliasTransitions(),
+			// Callback signing keys, search attribute type migrations and outbound circuit breaker trips are local to
+			// each cluster.
+			CallbackSigningKeys:           resp.Namespace.Config.GetCallbackSigningKeys(),
+			SearchAttributeTypeMigrations: resp.Namespace.Config.GetSearchAttributeTypeMigrations(),
+			OutboundCircuitBreakerTrips:   resp.Namespace.Config.GetOutboundCircuitBreakerTrips(),
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
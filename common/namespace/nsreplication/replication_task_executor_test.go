@@ -538,6 +538,9 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 		},
 		ConfigVersion:   updateConfigVersion,
 		FailoverVersion: updateFailoverVersion,
+		SearchAttributeAliasTransitions: map[string]*persistencespb.SearchAttributeAliasTransition{
+			"PreviousAlias": {FieldName: "Keyword01", ExpireTime: timestamppb.New(time.Now().Add(time.Hour))},
+		},
 	}
 
 	s.namespaceReplicator.currentCluster = updateClusterStandby
@@ -569,6 +572,8 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 				HistoryArchivalUri:      updateTask.Config.HistoryArchivalUri,
 				VisibilityArchivalState: updateTask.Config.VisibilityArchivalState,
 				VisibilityArchivalUri:   updateTask.Config.VisibilityArchivalUri,
+				// previous aliases are replicated with the aliases
+				SearchAttributeAliasTransitions: updateTask.SearchAttributeAliasTransitions,
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				Clusters: []string{updateClusterActive, updateClusterStandby},
@@ -106,6 +106,8 @@ func (r *replicator) HandleTransmissionTask(
 			ConfigVersion:   configVersion,
 			FailoverVersion: failoverVersion,
 			FailoverHistory: convertFailoverHistoryToReplicationProto(failoverHistoy),
+			// Previous aliases are replicated with the aliases, so queries keep accepting them in every cluster.
+			SearchAttributeAliasTransitions: config.SearchAttributeAliasTransitions,
 		},
 	}
 
@@ -0,0 +1,188 @@
+package nexus
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+const (
+	// Header key for the signatures of an outbound completion callback request.
+	//
+	// The value is a comma separated list of "<key ID>=<hex encoded HMAC-SHA256>" entries, one per signing key of
+	// the namespace. More than one entry is sent while a key rotation is in progress.
+	CallbackSignatureHeader = "Temporal-Callback-Signature"
+	// Header key for the time an outbound completion callback request was signed, in Unix seconds.
+	CallbackTimestampHeader = "Temporal-Callback-Timestamp"
+	// DefaultCallbackSignatureTolerance is the recommended maximum age of a callback signature.
+	DefaultCallbackSignatureTolerance = 5 * time.Minute
+)
+
+var (
+	// ErrCallbackSignatureMissing is returned when a callback request carries no signature or timestamp header.
+	ErrCallbackSignatureMissing = errors.New("callback request is not signed")
+	// ErrCallbackSignatureMismatch is returned when none of the signatures of a callback request match a known key.
+	ErrCallbackSignatureMismatch = errors.New("callback signature does not match any known key")
+	// ErrCallbackSignatureExpired is returned when the timestamp of a callback request is outside of the tolerance.
+	ErrCallbackSignatureExpired = errors.New("callback signature timestamp is outside of the tolerance")
+)
+
+// ActiveCallbackSigningKeys returns the keys that sign callbacks at the given time.
+func ActiveCallbackSigningKeys(keys []*persistencespb.CallbackSigningKey, now time.Time) []*persistencespb.CallbackSigningKey {
+	var active []*persistencespb.CallbackSigningKey
+	for _, key := range keys {
+		if key.GetExpireTime() != nil && !now.Before(key.GetExpireTime().AsTime()) {
+			continue
+		}
+		active = append(active, key)
+	}
+	return active
+}
+
+// ComputeCallbackSignature returns the hex encoded HMAC-SHA256 of the timestamp and body of a callback request.
+// The signed payload is the timestamp header value followed by a "." and the raw request body.
+func ComputeCallbackSignature(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(timestamp))
+	_, _ = mac.Write([]byte("."))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignCallbackRequest sets the signature and timestamp headers of a callback request using all the given keys.
+// The request body is read and replaced so that it can still be sent.
+func SignCallbackRequest(r *http.Request, keys []*persistencespb.CallbackSigningKey, now time.Time) error {
+	body, err := readAndRestoreBody(r)
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	signatures := make([]string, len(keys))
+	for i, key := range keys {
+		signatures[i] = key.GetId() + "=" + ComputeCallbackSignature(key.GetSecret(), timestamp, body)
+	}
+	r.Header.Set(CallbackTimestampHeader, timestamp)
+	r.Header.Set(CallbackSignatureHeader, strings.Join(signatures, ","))
+	return nil
+}
+
+// VerifyCallbackSignature verifies the signature and timestamp header values of a callback request against a set of
+// secrets keyed by key ID. The request is accepted if any signature made with a known key matches and the timestamp
+// is no further than tolerance away from now.
+//
+// Signatures only prove that a request was sent by a cluster holding one of the keys. To protect against replayed
+// requests, receivers should use a tolerance no larger than needed (see [DefaultCallbackSignatureTolerance]) and
+// remember the signatures of accepted requests for at least the tolerance, rejecting any signature seen before.
+// Completion callbacks are also safe to deliver more than once, since only the first completion of an operation is
+// applied.
+//
+// During a key rotation, receivers should configure the new secret alongside the old one. Callbacks are signed with
+// both keys until the old key expires, so either key verifies them.
+func VerifyCallbackSignature(
+	signatureHeader string,
+	timestampHeader string,
+	body []byte,
+	secrets map[string][]byte,
+	tolerance time.Duration,
+	now time.Time,
+) error {
+	if signatureHeader == "" || timestampHeader == "" {
+		return ErrCallbackSignatureMissing
+	}
+	seconds, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid callback timestamp %q: %w", timestampHeader, err)
+	}
+	signedAt := time.Unix(seconds, 0)
+	if now.Sub(signedAt) > tolerance || signedAt.Sub(now) > tolerance {
+		return ErrCallbackSignatureExpired
+	}
+	for _, entry := range strings.Split(signatureHeader, ",") {
+		keyID, signature, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			continue
+		}
+		secret, ok := secrets[keyID]
+		if !ok {
+			continue
+		}
+		expected := ComputeCallbackSignature(secret, timestampHeader, body)
+		if hmac.Equal([]byte(expected), []byte(signature)) {
+			return nil
+		}
+	}
+	return ErrCallbackSignatureMismatch
+}
+
+// VerifyCallbackRequest verifies the signature of an incoming callback request, see [VerifyCallbackSignature]. The
+// request body is read and replaced so that it can still be handled after verification.
+func VerifyCallbackRequest(r *http.Request, secrets map[string][]byte, tolerance time.Duration) error {
+	body, err := readAndRestoreBody(r)
+	if err != nil {
+		return err
+	}
+	return VerifyCallbackSignature(
+		r.Header.Get(CallbackSignatureHeader),
+		r.Header.Get(CallbackTimestampHeader),
+		body,
+		secrets,
+		tolerance,
+		time.Now(),
+	)
+}
+
+// NewCallbackSigningTransport returns an [http.RoundTripper] that signs each request with the keys returned by
+// getKeys before delegating to base. Requests are sent unsigned when there are no keys. Signing happens on every
+// attempt, so retried callbacks always carry a fresh timestamp and are signed with the current keys.
+func NewCallbackSigningTransport(
+	base http.RoundTripper,
+	getKeys func() ([]*persistencespb.CallbackSigningKey, error),
+) http.RoundTripper {
+	return &callbackSigningTransport{base: base, getKeys: getKeys}
+}
+
+type callbackSigningTransport struct {
+	base    http.RoundTripper
+	getKeys func() ([]*persistencespb.CallbackSigningKey, error)
+}
+
+func (t *callbackSigningTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	keys, err := t.getKeys()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get callback signing keys: %w", err)
+	}
+	now := time.Now()
+	keys = ActiveCallbackSigningKeys(keys, now)
+	if len(keys) == 0 {
+		return t.base.RoundTrip(r)
+	}
+	// RoundTrippers must not modify the given request.
+	signed := r.Clone(r.Context())
+	if err := SignCallbackRequest(signed, keys, now); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(signed)
+}
+
+func readAndRestoreBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
@@ -0,0 +1,84 @@
+package nexus
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCallbackSignature_SignAndVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	keys := []*persistencespb.CallbackSigningKey{
+		{Id: "old", Secret: []byte("old-secret")},
+		{Id: "new", Secret: []byte("new-secret")},
+	}
+	r, err := http.NewRequest(http.MethodPost, "http://localhost/callback", strings.NewReader("payload"))
+	require.NoError(t, err)
+	require.NoError(t, SignCallbackRequest(r, keys, now))
+
+	body, err := io.ReadAll(r.Body)
+	require.NoError(t, err)
+	require.Equal(t, "payload", string(body))
+	require.Equal(t, strconv.FormatInt(now.Unix(), 10), r.Header.Get(CallbackTimestampHeader))
+
+	signature := r.Header.Get(CallbackSignatureHeader)
+	timestamp := r.Header.Get(CallbackTimestampHeader)
+	// Receivers that only know either key accept the request during a rotation.
+	require.NoError(t, VerifyCallbackSignature(signature, timestamp, body, map[string][]byte{"old": []byte("old-secret")}, time.Minute, now))
+	require.NoError(t, VerifyCallbackSignature(signature, timestamp, body, map[string][]byte{"new": []byte("new-secret")}, time.Minute, now))
+
+	err = VerifyCallbackSignature(signature, timestamp, body, map[string][]byte{"new": []byte("wrong")}, time.Minute, now)
+	require.ErrorIs(t, err, ErrCallbackSignatureMismatch)
+	err = VerifyCallbackSignature(signature, timestamp, []byte("tampered"), map[string][]byte{"new": []byte("new-secret")}, time.Minute, now)
+	require.ErrorIs(t, err, ErrCallbackSignatureMismatch)
+	err = VerifyCallbackSignature(signature, timestamp, body, map[string][]byte{"new": []byte("new-secret")}, time.Minute, now.Add(2*time.Minute))
+	require.ErrorIs(t, err, ErrCallbackSignatureExpired)
+	err = VerifyCallbackSignature("", timestamp, body, map[string][]byte{"new": []byte("new-secret")}, time.Minute, now)
+	require.ErrorIs(t, err, ErrCallbackSignatureMissing)
+}
+
+func TestActiveCallbackSigningKeys(t *testing.T) {
+	now := time.Now()
+	keys := []*persistencespb.CallbackSigningKey{
+		{Id: "expired", ExpireTime: timestamppb.New(now.Add(-time.Second))},
+		{Id: "expiring", ExpireTime: timestamppb.New(now.Add(time.Hour))},
+		{Id: "current"},
+	}
+	active := ActiveCallbackSigningKeys(keys, now)
+	require.Len(t, active, 2)
+	require.Equal(t, "expiring", active[0].GetId())
+	require.Equal(t, "current", active[1].GetId())
+}
+
+type recordingRoundTripper struct {
+	requests []*http.Request
+}
+
+func (rt *recordingRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	rt.requests = append(rt.requests, r)
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestCallbackSigningTransport(t *testing.T) {
+	base := &recordingRoundTripper{}
+	var keys []*persistencespb.CallbackSigningKey
+	client := &http.Client{Transport: NewCallbackSigningTransport(base, func() ([]*persistencespb.CallbackSigningKey, error) {
+		return keys, nil
+	})}
+
+	_, err := client.Post("http://localhost/callback", "application/json", strings.NewReader("payload"))
+	require.NoError(t, err)
+	require.Empty(t, base.requests[0].Header.Get(CallbackSignatureHeader))
+
+	keys = []*persistencespb.CallbackSigningKey{{Id: "k1", Secret: []byte("secret")}}
+	_, err = client.Post("http://localhost/callback", "application/json", strings.NewReader("payload"))
+	require.NoError(t, err)
+	require.NoError(t, VerifyCallbackRequest(base.requests[1], map[string][]byte{"k1": []byte("secret")}, DefaultCallbackSignatureTolerance))
+}
@@ -0,0 +1,462 @@
+package nexus
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/nexus-rpc/sdk-go/nexus"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+)
+
+const (
+	// HTTPTargetCallbackURLHeader is the header used to pass the callback URL to HTTP endpoint targets that complete
+	// operations asynchronously.
+	HTTPTargetCallbackURLHeader = "Nexus-Callback-Url"
+
+	defaultHTTPTargetPathTemplate = "{{.Service}}/{{.Operation}}"
+	maxHTTPTargetFailureBodyBytes = 1024
+)
+
+var errHTTPTargetCancelNotSupported = errors.New("cancelation is not supported by this HTTP endpoint target")
+
+// HTTPTarget translates Nexus requests into calls to a plain HTTP/JSON service and the service's responses back into
+// Nexus responses. See [persistencespb.NexusEndpointTarget_Http] for the meaning of each setting.
+type HTTPTarget struct {
+	baseURL            *url.URL
+	method             string
+	pathTemplate       *template.Template
+	headers            map[string]string
+	bodyTemplate       *template.Template
+	resultPath         []string
+	async              bool
+	operationTokenPath []string
+	cancelPathTemplate *template.Template
+}
+
+type httpTargetStartData struct {
+	Input         any
+	InputJSON     string
+	Service       string
+	Operation     string
+	RequestID     string
+	CallbackURL   string
+	CallbackToken string
+}
+
+type httpTargetCancelData struct {
+	Service        string
+	Operation      string
+	OperationToken string
+}
+
+// NewHTTPTarget validates the given HTTP endpoint target and compiles its templates.
+func NewHTTPTarget(target *persistencespb.NexusEndpointTarget_Http) (*HTTPTarget, error) {
+	baseURL, err := url.Parse(target.GetUrl())
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid URL scheme: %q, expected http or https", baseURL.Scheme)
+	}
+	if baseURL.Host == "" {
+		return nil, errors.New("URL host not set")
+	}
+
+	method := strings.ToUpper(target.GetMethod())
+	switch method {
+	case "":
+		method = http.MethodPost
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return nil, fmt.Errorf("unsupported method: %q", target.GetMethod())
+	}
+
+	pathTemplate := target.GetPathTemplate()
+	if pathTemplate == "" {
+		pathTemplate = defaultHTTPTargetPathTemplate
+	}
+	t := &HTTPTarget{
+		baseURL:            baseURL,
+		method:             method,
+		headers:            target.GetHeaders(),
+		resultPath:         splitJSONPath(target.GetResultPath()),
+		async:              target.GetAsync() != nil,
+		operationTokenPath: splitJSONPath(target.GetAsync().GetOperationTokenPath()),
+	}
+	if t.pathTemplate, err = parseHTTPTargetTemplate("path", pathTemplate); err != nil {
+		return nil, err
+	}
+	if target.GetRequestBodyTemplate() != "" {
+		if t.bodyTemplate, err = parseHTTPTargetTemplate("request body", target.GetRequestBodyTemplate()); err != nil {
+			return nil, err
+		}
+	}
+	if target.GetAsync().GetCancelPathTemplate() != "" {
+		if t.cancelPathTemplate, err = parseHTTPTargetTemplate("cancel path", target.GetAsync().GetCancelPathTemplate()); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// HTTPTargetCache keeps the compiled HTTP target of each endpoint so that templates are only parsed when the endpoint
+// changes. The cache is bounded and entries expire, so targets of deleted endpoints do not stay around.
+type HTTPTargetCache struct {
+	targets cache.Cache // endpoint ID -> httpTargetCacheEntry
+}
+
+type httpTargetCacheEntry struct {
+	version int64
+	target  *HTTPTarget
+}
+
+// NewHTTPTargetCache creates a cache that holds up to maxSize compiled targets, each for at most ttl.
+func NewHTTPTargetCache(maxSize int, ttl time.Duration) *HTTPTargetCache {
+	return &HTTPTargetCache{
+		targets: cache.New(maxSize, &cache.Options{TTL: ttl}),
+	}
+}
+
+// Get returns the compiled HTTP target of the given version of an endpoint.
+func (c *HTTPTargetCache) Get(
+	endpointID string,
+	version int64,
+	target *persistencespb.NexusEndpointTarget_Http,
+) (*HTTPTarget, error) {
+	if cached, ok := c.targets.Get(endpointID).(httpTargetCacheEntry); ok && cached.version == version {
+		return cached.target, nil
+	}
+	compiled, err := NewHTTPTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	c.targets.Put(endpointID, httpTargetCacheEntry{version: version, target: compiled})
+	return compiled, nil
+}
+
+// URL returns the base URL of the target.
+func (t *HTTPTarget) URL() string {
+	return t.baseURL.String()
+}
+
+// Caller returns an HTTP caller for a Nexus client that has the target URL as its base URL. Requests made by the
+// client are translated and sent to the service with do.
+func (t *HTTPTarget) Caller(do func(*http.Request) (*http.Response, error)) func(*http.Request) (*http.Response, error) {
+	return func(r *http.Request) (*http.Response, error) {
+		service, operation, isCancel, err := t.parseNexusPath(r.URL)
+		if err != nil {
+			return nil, err
+		}
+		if isCancel {
+			return t.cancel(r, do, service, operation)
+		}
+		return t.start(r, do, service, operation)
+	}
+}
+
+func (t *HTTPTarget) start(
+	r *http.Request,
+	do func(*http.Request) (*http.Response, error),
+	service, operation string,
+) (*http.Response, error) {
+	input, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	data := httpTargetStartData{
+		InputJSON:     string(input),
+		Service:       service,
+		Operation:     operation,
+		RequestID:     r.Header.Get("Nexus-Request-Id"),
+		CallbackURL:   r.URL.Query().Get("callback"),
+		CallbackToken: r.Header.Get("Nexus-Callback-" + CallbackTokenHeader),
+	}
+	path, err := executeHTTPTargetTemplate(t.pathTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+
+	body := input
+	contentType := r.Header.Get("Content-Type")
+	if t.bodyTemplate != nil {
+		if len(input) > 0 {
+			if err := json.Unmarshal(input, &data.Input); err != nil {
+				return badRequestResponse(r, fmt.Sprintf("input is not valid JSON: %v", err))
+			}
+		}
+		rendered, err := executeHTTPTargetTemplate(t.bodyTemplate, data)
+		if err != nil {
+			return badRequestResponse(r, err.Error())
+		}
+		body = []byte(rendered)
+		contentType = "application/json"
+	}
+
+	request, err := http.NewRequestWithContext(r.Context(), t.method, t.baseURL.JoinPath(path).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	t.copyHeaders(r.Header, request.Header)
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	if data.CallbackURL != "" {
+		request.Header.Set(HTTPTargetCallbackURLHeader, data.CallbackURL)
+	}
+
+	response, err := do(request)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return failureResponse(r, response, responseBody)
+	}
+
+	if t.async && response.StatusCode == http.StatusAccepted {
+		token := data.RequestID
+		if len(t.operationTokenPath) > 0 {
+			value, err := lookupJSONPath(responseBody, t.operationTokenPath)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read operation token from response: %w", err)
+			}
+			token = jsonValueToString(value)
+		}
+		info, err := json.Marshal(nexus.OperationInfo{Token: token, State: nexus.OperationStateRunning})
+		if err != nil {
+			return nil, err
+		}
+		return newHTTPTargetResponse(r, http.StatusCreated, "application/json", info), nil
+	}
+
+	contentType = response.Header.Get("Content-Type")
+	if len(t.resultPath) > 0 {
+		value, err := lookupJSONPath(responseBody, t.resultPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read result from response: %w", err)
+		}
+		if responseBody, err = json.Marshal(value); err != nil {
+			return nil, err
+		}
+		contentType = "application/json"
+	}
+	return newHTTPTargetResponse(r, http.StatusOK, contentType, responseBody), nil
+}
+
+func (t *HTTPTarget) cancel(
+	r *http.Request,
+	do func(*http.Request) (*http.Response, error),
+	service, operation string,
+) (*http.Response, error) {
+	if t.cancelPathTemplate == nil {
+		return newFailureResponse(r, http.StatusNotImplemented, errHTTPTargetCancelNotSupported.Error())
+	}
+	path, err := executeHTTPTargetTemplate(t.cancelPathTemplate, httpTargetCancelData{
+		Service:        service,
+		Operation:      operation,
+		OperationToken: r.Header.Get(nexus.HeaderOperationToken),
+	})
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(r.Context(), http.MethodPost, t.baseURL.JoinPath(path).String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	t.copyHeaders(r.Header, request.Header)
+
+	response, err := do(request)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return failureResponse(r, response, responseBody)
+	}
+	return newHTTPTargetResponse(r, http.StatusAccepted, "", nil), nil
+}
+
+// parseNexusPath extracts the service and operation from the URL of a request made by a Nexus client.
+func (t *HTTPTarget) parseNexusPath(u *url.URL) (service string, operation string, isCancel bool, err error) {
+	rest, ok := strings.CutPrefix(u.EscapedPath(), strings.TrimSuffix(t.baseURL.EscapedPath(), "/")+"/")
+	if !ok {
+		return "", "", false, fmt.Errorf("unexpected request path for HTTP endpoint target: %q", u.Path)
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) < 2 || len(parts) > 3 || (len(parts) == 3 && parts[2] != "cancel") {
+		return "", "", false, fmt.Errorf("unexpected request path for HTTP endpoint target: %q", u.Path)
+	}
+	if service, err = url.PathUnescape(parts[0]); err != nil {
+		return "", "", false, err
+	}
+	if operation, err = url.PathUnescape(parts[1]); err != nil {
+		return "", "", false, err
+	}
+	return service, operation, len(parts) == 3, nil
+}
+
+// copyHeaders copies the Nexus request headers, except for content headers which describe the original body, and
+// then applies the headers configured on the target.
+func (t *HTTPTarget) copyHeaders(from, to http.Header) {
+	for k, v := range from {
+		if strings.HasPrefix(strings.ToLower(k), "content-") {
+			continue
+		}
+		to[k] = v
+	}
+	for k, v := range t.headers {
+		to.Set(k, v)
+	}
+}
+
+func parseHTTPTargetTemplate(name, text string) (*template.Template, error) {
+	t, err := template.New(name).Option("missingkey=error").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+	return t, nil
+}
+
+func executeHTTPTargetTemplate(t *template.Template, data any) (string, error) {
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render %s template: %w", t.Name(), err)
+	}
+	return b.String(), nil
+}
+
+func splitJSONPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, ".")
+}
+
+// lookupJSONPath returns the value at the given path of a JSON document. Path elements are object keys or array
+// indexes.
+func lookupJSONPath(document []byte, path []string) (any, error) {
+	var value any
+	if err := json.Unmarshal(document, &value); err != nil {
+		return nil, err
+	}
+	for i, key := range path {
+		switch v := value.(type) {
+		case map[string]any:
+			next, ok := v[key]
+			if !ok {
+				return nil, fmt.Errorf("field %q not found", strings.Join(path[:i+1], "."))
+			}
+			value = next
+		case []any:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, fmt.Errorf("index %q out of range", strings.Join(path[:i+1], "."))
+			}
+			value = v[idx]
+		default:
+			return nil, fmt.Errorf("field %q not found", strings.Join(path[:i+1], "."))
+		}
+	}
+	return value, nil
+}
+
+func jsonValueToString(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	b, _ := json.Marshal(value)
+	return string(b)
+}
+
+func newHTTPTargetResponse(r *http.Request, status int, contentType string, body []byte) *http.Response {
+	header := make(http.Header)
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       r,
+	}
+}
+
+func newFailureResponse(r *http.Request, status int, message string) (*http.Response, error) {
+	body, err := json.Marshal(nexus.Failure{Message: message})
+	if err != nil {
+		return nil, err
+	}
+	return newHTTPTargetResponse(r, status, "application/json", body), nil
+}
+
+func badRequestResponse(r *http.Request, message string) (*http.Response, error) {
+	return newFailureResponse(r, http.StatusBadRequest, message)
+}
+
+// failureResponse converts an unsuccessful response from the service into a Nexus failure response with a status
+// code that maps to the closest handler error type.
+func failureResponse(r *http.Request, response *http.Response, body []byte) (*http.Response, error) {
+	if len(body) > maxHTTPTargetFailureBodyBytes {
+		body = body[:maxHTTPTargetFailureBodyBytes]
+	}
+	message := "HTTP service responded with " + response.Status
+	if len(body) > 0 {
+		message += ": " + string(body)
+	}
+	return newFailureResponse(r, httpTargetFailureStatus(response.StatusCode), message)
+}
+
+func httpTargetFailureStatus(status int) int {
+	switch status {
+	case http.StatusBadRequest,
+		http.StatusRequestTimeout,
+		http.StatusConflict,
+		http.StatusUnauthorized,
+		http.StatusForbidden,
+		http.StatusNotFound,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusNotImplemented,
+		http.StatusServiceUnavailable:
+		return status
+	case http.StatusBadGateway:
+		return http.StatusServiceUnavailable
+	case http.StatusGatewayTimeout:
+		return nexus.StatusUpstreamTimeout
+	}
+	if status >= 400 && status < 500 {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,179 @@
+package nexus
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nexus-rpc/sdk-go/nexus"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/nexus/nexusrpc"
+)
+
+func newHTTPTargetClient(t *testing.T, target *persistencespb.NexusEndpointTarget_Http) *nexusrpc.HTTPClient {
+	httpTarget, err := NewHTTPTarget(target)
+	require.NoError(t, err)
+	client, err := nexusrpc.NewHTTPClient(nexusrpc.HTTPClientOptions{
+		BaseURL:    httpTarget.URL(),
+		Service:    "orders",
+		HTTPCaller: httpTarget.Caller(http.DefaultClient.Do),
+		Serializer: PayloadSerializer,
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func jsonPayload(data string) *commonpb.Payload {
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte("json/plain")},
+		Data:     []byte(data),
+	}
+}
+
+func TestHTTPTarget_Sync(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "/api/v1/orders/create/req-id", r.URL.Path)
+		require.Equal(t, "secret", r.Header.Get("Authorization"))
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"item":"book","quantity":2}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"order":{"id":"o-1"}}}`))
+	}))
+	defer srv.Close()
+
+	client := newHTTPTargetClient(t, &persistencespb.NexusEndpointTarget_Http{
+		Url:                 srv.URL + "/api/v1",
+		Method:              "put",
+		PathTemplate:        "{{.Service}}/{{.Operation}}/{{.RequestID}}",
+		Headers:             map[string]string{"Authorization": "secret"},
+		RequestBodyTemplate: `{"item":{{json .Input.name}},"quantity":{{.Input.count}}}`,
+		ResultPath:          "data.order",
+	})
+	result, err := client.StartOperation(context.Background(), "create", jsonPayload(`{"name":"book","count":2}`), nexus.StartOperationOptions{
+		RequestID: "req-id",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Successful)
+	var payload *commonpb.Payload
+	require.NoError(t, result.Successful.Consume(&payload))
+	require.Equal(t, "json/plain", string(payload.Metadata["encoding"]))
+	require.JSONEq(t, `{"id":"o-1"}`, string(payload.Data))
+}
+
+func TestHTTPTarget_Async(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orders/create":
+			require.Equal(t, "http://callback", r.Header.Get(HTTPTargetCallbackURLHeader))
+			require.Equal(t, "token", r.Header.Get("Nexus-Callback-"+CallbackTokenHeader))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"name":"book"}`, string(body))
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"job":{"id":"job-1"}}`))
+		case "/jobs/job-1/cancel":
+			require.Equal(t, http.MethodPost, r.Method)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := newHTTPTargetClient(t, &persistencespb.NexusEndpointTarget_Http{
+		Url: srv.URL,
+		Async: &persistencespb.NexusEndpointTarget_Http_Async{
+			OperationTokenPath: "job.id",
+			CancelPathTemplate: "jobs/{{.OperationToken}}/cancel",
+		},
+	})
+	result, err := client.StartOperation(context.Background(), "create", jsonPayload(`{"name":"book"}`), nexus.StartOperationOptions{
+		CallbackURL:    "http://callback",
+		CallbackHeader: nexus.Header{CallbackTokenHeader: "token"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Pending)
+	require.Equal(t, "job-1", result.Pending.Token)
+	require.NoError(t, result.Pending.Cancel(context.Background(), nexus.CancelOperationOptions{}))
+}
+
+func TestHTTPTarget_Failure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("upstream down"))
+	}))
+	defer srv.Close()
+
+	client := newHTTPTargetClient(t, &persistencespb.NexusEndpointTarget_Http{Url: srv.URL})
+	_, err := client.StartOperation(context.Background(), "create", jsonPayload(`{}`), nexus.StartOperationOptions{})
+	var handlerErr *nexus.HandlerError
+	require.ErrorAs(t, err, &handlerErr)
+	require.Equal(t, nexus.HandlerErrorTypeUnavailable, handlerErr.Type)
+
+	handle, err := client.NewOperationHandle("create", "token")
+	require.NoError(t, err)
+	err = handle.Cancel(context.Background(), nexus.CancelOperationOptions{})
+	require.ErrorAs(t, err, &handlerErr)
+	require.Equal(t, nexus.HandlerErrorTypeNotImplemented, handlerErr.Type)
+}
+
+func TestNewHTTPTarget_Invalid(t *testing.T) {
+	for _, target := range []*persistencespb.NexusEndpointTarget_Http{
+		{Url: "ftp://host"},
+		{Url: "http://"},
+		{Url: "http://host", Method: "TRACE"},
+		{Url: "http://host", PathTemplate: "{{.Service"},
+		{Url: "http://host", RequestBodyTemplate: "{{json}"},
+	} {
+		_, err := NewHTTPTarget(target)
+		require.Error(t, err, target.String())
+	}
+}
+
+func TestLookupJSONPath(t *testing.T) {
+	doc, err := json.Marshal(map[string]any{"a": []any{map[string]any{"b": "c"}}})
+	require.NoError(t, err)
+	value, err := lookupJSONPath(doc, splitJSONPath("a.0.b"))
+	require.NoError(t, err)
+	require.Equal(t, "c", value)
+	_, err = lookupJSONPath(doc, splitJSONPath("a.1.b"))
+	require.Error(t, err)
+	_, err = lookupJSONPath(doc, splitJSONPath("x"))
+	require.Error(t, err)
+}
+
+func TestHTTPTargetCache(t *testing.T) {
+	cache := NewHTTPTargetCache(1, time.Hour)
+	target := &persistencespb.NexusEndpointTarget_Http{Url: "http://localhost/v1"}
+
+	first, err := cache.Get("endpoint", 1, target)
+	require.NoError(t, err)
+	second, err := cache.Get("endpoint", 1, target)
+	require.NoError(t, err)
+	require.Same(t, first, second)
+
+	// A new version of the endpoint is compiled again.
+	updated, err := cache.Get("endpoint", 2, &persistencespb.NexusEndpointTarget_Http{Url: "http://localhost/v2"})
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost/v2", updated.URL())
+
+	_, err = cache.Get("endpoint", 3, &persistencespb.NexusEndpointTarget_Http{Url: "ftp://localhost"})
+	require.Error(t, err)
+
+	// The cache is bounded, another endpoint evicts the target of the first one.
+	_, err = cache.Get("other-endpoint", 1, target)
+	require.NoError(t, err)
+	evicted, err := cache.Get("endpoint", 2, &persistencespb.NexusEndpointTarget_Http{Url: "http://localhost/v2"})
+	require.NoError(t, err)
+	require.NotSame(t, updated, evicted)
+}
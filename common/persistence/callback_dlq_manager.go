@@ -0,0 +1,151 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	ErrMsgSerializeCallbackToEnqueue = "failed to serialize dead-lettered callback"
+	ErrMsgDeserializeCallback        = "failed to deserialize dead-lettered callback"
+)
+
+var (
+	ErrEnqueueCallbackRequestCallbackIsNil = errors.New("enqueue callback request callback is nil")
+	ErrCallbackNamespaceIDNotSet           = errors.New("dead-lettered callback namespace ID is not set")
+)
+
+type CallbackDLQManagerImpl struct {
+	queue QueueV2
+	// enqueueMutex is a map[string]*sync.Mutex keyed by queue name. Enqueues to the same queue are serialized within
+	// the process to avoid conflicting writes of the queue metadata.
+	enqueueMutex sync.Map
+}
+
+func NewCallbackDLQManager(queue QueueV2) *CallbackDLQManagerImpl {
+	return &CallbackDLQManagerImpl{
+		queue: queue,
+	}
+}
+
+// GetCallbackDLQName returns the name of the queue that holds the dead-lettered callbacks of a namespace.
+func GetCallbackDLQName(namespaceID string) string {
+	return namespaceID
+}
+
+func (m *CallbackDLQManagerImpl) EnqueueCallback(
+	ctx context.Context,
+	request *EnqueueCallbackRequest,
+) (*EnqueueCallbackResponse, error) {
+	if request.Callback == nil {
+		return nil, ErrEnqueueCallbackRequestCallbackIsNil
+	}
+	if request.Callback.GetNamespaceId() == "" {
+		return nil, ErrCallbackNamespaceIDNotSet
+	}
+	data, err := request.Callback.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", ErrMsgSerializeCallbackToEnqueue, err)
+	}
+	queueName := GetCallbackDLQName(request.Callback.GetNamespaceId())
+
+	mu, _ := m.enqueueMutex.LoadOrStore(queueName, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	defer mu.(*sync.Mutex).Unlock()
+
+	_, err = m.queue.CreateQueue(ctx, &InternalCreateQueueRequest{
+		QueueType: QueueTypeCallbackDLQ,
+		QueueName: queueName,
+	})
+	if err != nil && !errors.Is(err, ErrQueueAlreadyExists) {
+		return nil, err
+	}
+	resp, err := m.queue.EnqueueMessage(ctx, &InternalEnqueueMessageRequest{
+		QueueType: QueueTypeCallbackDLQ,
+		QueueName: queueName,
+		Blob: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         data,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EnqueueCallbackResponse{Metadata: resp.Metadata}, nil
+}
+
+func (m *CallbackDLQManagerImpl) ReadCallbacks(
+	ctx context.Context,
+	request *ReadCallbacksRequest,
+) (*ReadCallbacksResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, ErrNonPositiveReadQueueMessagesPageSize
+	}
+	nextPageToken := request.NextPageToken
+	if len(nextPageToken) == 0 && request.MinMessageID > FirstQueueMessageID {
+		// Resume reading right after the message that precedes the requested one.
+		nextPageToken = GetNextPageTokenForReadMessages([]QueueV2Message{
+			{MetaData: MessageMetadata{ID: request.MinMessageID - 1}},
+		})
+	}
+
+	response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+		QueueType:     QueueTypeCallbackDLQ,
+		QueueName:     GetCallbackDLQName(request.NamespaceID),
+		PageSize:      request.PageSize,
+		NextPageToken: nextPageToken,
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return &ReadCallbacksResponse{}, nil
+		}
+		return nil, err
+	}
+
+	callbacks := make([]DeadLetteredCallback, len(response.Messages))
+	for i, message := range response.Messages {
+		callback := &persistencespb.DeadLetteredCallback{}
+		if err := serialization.Decode(message.Data, callback); err != nil {
+			return nil, fmt.Errorf("%v: %w", ErrMsgDeserializeCallback, err)
+		}
+		callbacks[i] = DeadLetteredCallback{
+			MessageMetadata: message.MetaData,
+			Callback:        callback,
+		}
+	}
+	return &ReadCallbacksResponse{
+		Callbacks:     callbacks,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+func (m *CallbackDLQManagerImpl) DeleteCallbacks(
+	ctx context.Context,
+	request *DeleteCallbacksRequest,
+) (*DeleteCallbacksResponse, error) {
+	resp, err := m.queue.RangeDeleteMessages(ctx, &InternalRangeDeleteMessagesRequest{
+		QueueType:                   QueueTypeCallbackDLQ,
+		QueueName:                   GetCallbackDLQName(request.NamespaceID),
+		InclusiveMaxMessageMetadata: request.InclusiveMaxMessageMetadata,
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return &DeleteCallbacksResponse{}, nil
+		}
+		return nil, err
+	}
+	return &DeleteCallbacksResponse{MessagesDeleted: resp.MessagesDeleted}, nil
+}
+
+func (m *CallbackDLQManagerImpl) Close() {
+}
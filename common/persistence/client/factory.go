@@ -43,6 +43,10 @@ type (
 		NewClusterMetadataManager() (persistence.ClusterMetadataManager, error)
 		// NewHistoryTaskQueueManager returns a new manager for history task queues
 		NewHistoryTaskQueueManager() (persistence.HistoryTaskQueueManager, error)
+		// NewCallbackDLQManager returns a new manager for dead-lettered callbacks
+		NewCallbackDLQManager() (persistence.CallbackDLQManager, error)
+		// NewWorkerRegistryManager returns a new manager for worker registry snapshots
+		NewWorkerRegistryManager() (persistence.WorkerRegistryManager, error)
 		// NewNexusEndpointManager returns a new manager for nexus endpoints
 		NewNexusEndpointManager() (persistence.NexusEndpointManager, error)
 	}
@@ -58,6 +62,7 @@ type (
 		systemRateLimiter                           quotas.RequestRateLimiter
 		namespaceRateLimiter                        quotas.RequestRateLimiter
 		shardRateLimiter                            quotas.RequestRateLimiter
+		concurrencyLimiter                          quotas.ConcurrencyLimiter
 		healthSignals                               persistence.HealthSignalAggregator
 		enableDataLossMetrics                       dynamicconfig.BoolPropertyFn
 		enableBestEffortDeleteTasksOnWorkflowUpdate dynamicconfig.BoolPropertyFn
@@ -77,6 +82,7 @@ func NewFactory(
 	systemRateLimiter quotas.RequestRateLimiter,
 	namespaceRateLimiter quotas.RequestRateLimiter,
 	shardRateLimiter quotas.RequestRateLimiter,
+	concurrencyLimiter quotas.ConcurrencyLimiter,
 	serializer serialization.Serializer,
 	eventBlobCache persistence.XDCCache,
 	clusterName string,
@@ -97,6 +103,7 @@ func NewFactory(
 		systemRateLimiter:     systemRateLimiter,
 		namespaceRateLimiter:  namespaceRateLimiter,
 		shardRateLimiter:      shardRateLimiter,
+		concurrencyLimiter:    concurrencyLimiter,
 		healthSignals:         healthSignals,
 		enableDataLossMetrics: dynamicconfig.BoolPropertyFn(enableDataLossMetrics),
 		enableBestEffortDeleteTasksOnWorkflowUpdate: dynamicconfig.BoolPropertyFn(enableBestEffortDeleteTasksOnWorkflowUpdate),
@@ -113,7 +120,7 @@ func (f *factoryImpl) NewTaskManager() (persistence.TaskManager, error) {
 	}
 	result := persistence.NewTaskManager(taskStore, f.serializer)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
-		result = persistence.NewTaskPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
+		result = persistence.NewTaskPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.concurrencyLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
 		result = persistence.NewTaskPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger, f.enableDataLossMetrics)
@@ -130,7 +137,7 @@ func (f *factoryImpl) NewFairTaskManager() (persistence.FairTaskManager, error)
 	}
 	result := persistence.NewTaskManager(taskStore, f.serializer)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
-		result = persistence.NewTaskPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
+		result = persistence.NewTaskPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.concurrencyLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
 		result = persistence.NewTaskPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger, f.enableDataLossMetrics)
@@ -148,7 +155,7 @@ func (f *factoryImpl) NewShardManager() (persistence.ShardManager, error) {
 
 	result := persistence.NewShardManager(shardStore, f.serializer)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
-		result = persistence.NewShardPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
+		result = persistence.NewShardPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.concurrencyLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
 		result = persistence.NewShardPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger, f.enableDataLossMetrics)
@@ -166,7 +173,7 @@ func (f *factoryImpl) NewMetadataManager() (persistence.MetadataManager, error)
 
 	result := persistence.NewMetadataManagerImpl(store, f.serializer, f.logger, f.clusterName)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
-		result = persistence.NewMetadataPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
+		result = persistence.NewMetadataPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.concurrencyLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
 		result = persistence.NewMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger, f.enableDataLossMetrics)
@@ -184,7 +191,7 @@ func (f *factoryImpl) NewClusterMetadataManager() (persistence.ClusterMetadataMa
 
 	result := persistence.NewClusterMetadataManagerImpl(store, f.serializer, f.clusterName, f.logger)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
-		result = persistence.NewClusterMetadataPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
+		result = persistence.NewClusterMetadataPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.concurrencyLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
 		result = persistence.NewClusterMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger, f.enableDataLossMetrics)
@@ -209,7 +216,7 @@ func (f *factoryImpl) NewExecutionManager() (persistence.ExecutionManager, error
 		f.enableBestEffortDeleteTasksOnWorkflowUpdate,
 	)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
-		result = persistence.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
+		result = persistence.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.concurrencyLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
 		result = persistence.NewExecutionPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger, f.enableDataLossMetrics)
@@ -225,7 +232,7 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (persistence.NamespaceRepli
 	}
 
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
-		result = persistence.NewQueuePersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
+		result = persistence.NewQueuePersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.concurrencyLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
 		result = persistence.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger, f.enableDataLossMetrics)
@@ -242,6 +249,22 @@ func (f *factoryImpl) NewHistoryTaskQueueManager() (persistence.HistoryTaskQueue
 	return persistence.NewHistoryTaskQueueManager(q, f.serializer), nil
 }
 
+func (f *factoryImpl) NewCallbackDLQManager() (persistence.CallbackDLQManager, error) {
+	q, err := f.dataStoreFactory.NewQueueV2()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewCallbackDLQManager(q), nil
+}
+
+func (f *factoryImpl) NewWorkerRegistryManager() (persistence.WorkerRegistryManager, error) {
+	q, err := f.dataStoreFactory.NewQueueV2()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewWorkerRegistryManager(q), nil
+}
+
 func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManager, error) {
 	store, err := f.dataStoreFactory.NewNexusEndpointStore()
 	if err != nil {
@@ -250,7 +273,7 @@ func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManage
 
 	result := persistence.NewNexusEndpointManager(store, f.serializer, f.logger)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
-		result = persistence.NewNexusEndpointPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
+		result = persistence.NewNexusEndpointPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.concurrencyLimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
 		result = persistence.NewNexusEndpointPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger, f.enableDataLossMetrics)
@@ -287,6 +310,10 @@ func IsNamespaceQueueTransientError(err error) bool {
 }
 
 func (f *factoryImpl) initDependencies() {
+	if f.concurrencyLimiter == nil {
+		f.concurrencyLimiter = quotas.NoopConcurrencyLimiter
+	}
+
 	if f.metricsHandler == nil && f.healthSignals == nil {
 		return
 	}
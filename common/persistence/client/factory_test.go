@@ -44,6 +44,7 @@ func TestFactoryImpl_NewHistoryTaskQueueManager(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				"",
 				nil,
 				nil,
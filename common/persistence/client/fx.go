@@ -6,6 +6,7 @@ import (
 
 	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/chaos"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -32,6 +33,7 @@ type (
 	PersistenceBurstRatio              dynamicconfig.FloatPropertyFn
 
 	DynamicRateLimitingParams dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicRateLimitingParams]
+	ConcurrencyLimitingParams dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicConcurrencyLimitingParams]
 
 	EnableDataLossMetrics                       dynamicconfig.BoolPropertyFn
 	EnableBestEffortDeleteTasksOnWorkflowUpdate dynamicconfig.BoolPropertyFn
@@ -55,6 +57,8 @@ type (
 		Logger                                      log.Logger
 		HealthSignals                               persistence.HealthSignalAggregator
 		DynamicRateLimitingParams                   DynamicRateLimitingParams
+		ConcurrencyLimitingParams                   ConcurrencyLimitingParams
+		ConcurrencyLimiter                          *HealthConcurrencyLimiterImpl `optional:"true"`
 		EnableDataLossMetrics                       EnableDataLossMetrics
 		EnableBestEffortDeleteTasksOnWorkflowUpdate EnableBestEffortDeleteTasksOnWorkflowUpdate
 		Serializer                                  serialization.Serializer
@@ -74,10 +78,13 @@ var Module = fx.Options(
 	fx.Provide(managerProvider(Factory.NewShardManager)),
 	fx.Provide(managerProvider(Factory.NewExecutionManager)),
 	fx.Provide(managerProvider(Factory.NewHistoryTaskQueueManager)),
+	fx.Provide(managerProvider(Factory.NewCallbackDLQManager)),
+	fx.Provide(managerProvider(Factory.NewWorkerRegistryManager)),
 	fx.Provide(managerProvider(Factory.NewNexusEndpointManager)),
 
 	fx.Provide(ClusterNameProvider),
 	fx.Provide(HealthSignalAggregatorProvider),
+	fx.Provide(ConcurrencyLimiterProvider),
 	fx.Provide(persistence.NewDLQMetricsEmitter),
 	fx.Provide(EventBlobCacheProvider),
 	fx.Provide(EnableDataLossMetricsProvider),
@@ -116,6 +123,7 @@ func FactoryProvider(
 	params NewFactoryParams,
 ) Factory {
 	var systemRequestRateLimiter, namespaceRequestRateLimiter, shardRequestRateLimiter quotas.RequestRateLimiter
+	var concurrencyLimiter quotas.ConcurrencyLimiter
 	if params.PersistenceMaxQPS != nil && params.PersistenceMaxQPS() > 0 {
 		systemRequestRateLimiter = NewPriorityRateLimiter(
 			params.PersistenceMaxQPS,
@@ -141,6 +149,9 @@ func FactoryProvider(
 			params.OperatorRPSRatio,
 			params.PersistenceBurstRatio,
 		)
+		if params.ConcurrencyLimiter != nil {
+			concurrencyLimiter = params.ConcurrencyLimiter
+		}
 	}
 
 	return NewFactory(
@@ -149,6 +160,7 @@ func FactoryProvider(
 		systemRequestRateLimiter,
 		namespaceRequestRateLimiter,
 		shardRequestRateLimiter,
+		concurrencyLimiter,
 		params.Serializer,
 		params.EventBlobCache,
 		string(params.ClusterName),
@@ -178,6 +190,24 @@ func HealthSignalAggregatorProvider(
 	return persistence.NoopHealthSignalAggregator
 }
 
+func ConcurrencyLimiterProvider(
+	lc fx.Lifecycle,
+	healthSignals persistence.HealthSignalAggregator,
+	params ConcurrencyLimitingParams,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *HealthConcurrencyLimiterImpl {
+	limiter := NewHealthConcurrencyLimiterImpl(
+		healthSignals,
+		RequestPriorityFn,
+		params,
+		metricsHandler,
+		logger,
+	)
+	lc.Append(fx.StopHook(limiter.Stop))
+	return limiter
+}
+
 func DataStoreFactoryProvider(
 	clusterName ClusterName,
 	r resolver.ServiceResolver,
@@ -187,6 +217,7 @@ func DataStoreFactoryProvider(
 	metricsHandler metrics.Handler,
 	tracerProvider trace.TracerProvider,
 	serializer serialization.Serializer,
+	faultRegistry *chaos.Registry,
 ) persistence.DataStoreFactory {
 	var dataStoreFactory persistence.DataStoreFactory
 	defaultStoreCfg := cfg.DataStores[cfg.DefaultStore]
@@ -201,8 +232,11 @@ func DataStoreFactoryProvider(
 		logger.Fatal("invalid config: one of cassandra, sql, or custom datastore params must be specified")
 	}
 
-	if defaultStoreCfg.FaultInjection != nil {
-		dataStoreFactory = faultinjection.NewFaultInjectionDatastoreFactory(defaultStoreCfg.FaultInjection, dataStoreFactory)
+	if !faultRegistry.Instrumented() {
+		faultRegistry = nil
+	}
+	if defaultStoreCfg.FaultInjection != nil || faultRegistry != nil {
+		dataStoreFactory = faultinjection.NewFaultInjectionDatastoreFactory(defaultStoreCfg.FaultInjection, faultRegistry, dataStoreFactory)
 	}
 
 	tracer := tracerProvider.Tracer(otel.ComponentPersistence)
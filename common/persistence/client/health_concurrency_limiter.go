@@ -0,0 +1,190 @@
+package client
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	healthspb "go.temporal.io/server/api/health/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+)
+
+type (
+	// HealthConcurrencyLimiterImpl limits the number of in-flight persistence requests of a host. The limit is
+	// adjusted based on persistence health signals: it is decreased multiplicatively when latency or error ratio
+	// exceed their thresholds, and increased additively when the persistence is healthy (AIMD). Lower priority
+	// requests may only use a fraction of the limit, so that they are shed first when the limit is decreased.
+	HealthConcurrencyLimiterImpl struct {
+		enabled    atomic.Bool
+		params     ConcurrencyLimitingParams                                      // dynamic config struct
+		curOptions atomic.Pointer[dynamicconfig.DynamicConcurrencyLimitingParams] // current dynamic config values (updated on refresh)
+
+		healthSignals     persistence.HealthSignalAggregator
+		requestPriorityFn quotas.RequestPriorityFn
+		maxPriority       int
+
+		refreshTimer *time.Ticker
+
+		limit    atomic.Int64
+		inFlight atomic.Int64
+
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+)
+
+var _ quotas.ConcurrencyLimiter = (*HealthConcurrencyLimiterImpl)(nil)
+
+func NewHealthConcurrencyLimiterImpl(
+	healthSignals persistence.HealthSignalAggregator,
+	requestPriorityFn quotas.RequestPriorityFn,
+	params ConcurrencyLimitingParams,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *HealthConcurrencyLimiterImpl {
+	if healthSignals == nil {
+		healthSignals = persistence.NoopHealthSignalAggregator
+	}
+	if params == nil {
+		params = func() dynamicconfig.DynamicConcurrencyLimitingParams {
+			return dynamicconfig.DefaultDynamicConcurrencyLimitingParams
+		}
+	}
+	limiter := &HealthConcurrencyLimiterImpl{
+		params:            params,
+		healthSignals:     healthSignals,
+		requestPriorityFn: requestPriorityFn,
+		maxPriority:       slices.Max(RequestPrioritiesOrdered),
+		refreshTimer:      time.NewTicker(DefaultRefreshInterval),
+		metricsHandler:    metricsHandler,
+		logger:            logger,
+	}
+	limiter.refreshDynamicParams()
+	limiter.limit.Store(int64(limiter.curOptions.Load().InitialLimit))
+	return limiter
+}
+
+func (l *HealthConcurrencyLimiterImpl) TryAcquire(request quotas.Request) (func(), bool) {
+	l.maybeRefresh()
+	if !l.enabled.Load() {
+		return func() {}, true
+	}
+
+	limit := l.priorityLimit(request)
+	for {
+		inFlight := l.inFlight.Load()
+		if inFlight >= limit {
+			metrics.DynamicConcurrencyLimitShedRequests.With(l.metricsHandler).Record(1, metrics.OperationTag(request.API))
+			return nil, false
+		}
+		if l.inFlight.CompareAndSwap(inFlight, inFlight+1) {
+			break
+		}
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() { l.inFlight.Add(-1) })
+	}, true
+}
+
+// Stop stops refreshing the limit. The limiter keeps admitting requests with its last limit.
+func (l *HealthConcurrencyLimiterImpl) Stop() {
+	l.refreshTimer.Stop()
+}
+
+// Describe returns the current state of the limiter. The service and host address are left for the caller to fill.
+func (l *HealthConcurrencyLimiterImpl) Describe() *healthspb.PersistenceConcurrencyLimiterState {
+	return &healthspb.PersistenceConcurrencyLimiterState{
+		Enabled:          l.enabled.Load(),
+		Limit:            l.limit.Load(),
+		InFlight:         l.inFlight.Load(),
+		AverageLatencyMs: l.healthSignals.AverageLatency(),
+		ErrorRatio:       l.healthSignals.ErrorRatio(),
+	}
+}
+
+// priorityLimit returns the part of the current limit available to the request: the highest priority can use all of
+// it, and each lower priority level can use proportionally less, down to 1-PriorityShedRatio for the lowest priority.
+func (l *HealthConcurrencyLimiterImpl) priorityLimit(request quotas.Request) int64 {
+	limit := l.limit.Load()
+	if l.maxPriority <= 0 {
+		return limit
+	}
+	shedRatio := min(max(l.curOptions.Load().PriorityShedRatio, 0), 1)
+	priority := min(max(l.requestPriorityFn(request), 0), l.maxPriority)
+	return max(1, int64(float64(limit)*(1-shedRatio*float64(priority)/float64(l.maxPriority))))
+}
+
+func (l *HealthConcurrencyLimiterImpl) maybeRefresh() {
+	select {
+	case <-l.refreshTimer.C:
+		l.refreshDynamicParams()
+		if l.enabled.Load() {
+			l.refreshLimit()
+		}
+		l.updateRefreshTimer()
+
+	default:
+		// no-op
+	}
+}
+
+func (l *HealthConcurrencyLimiterImpl) refreshLimit() {
+	curOptions := *l.curOptions.Load()
+	curLimit := l.limit.Load()
+	newLimit := curLimit
+	if l.latencyThresholdExceeded() || l.errorThresholdExceeded() {
+		// limit exceeded, do backoff
+		newLimit = int64(math.Floor(float64(curLimit) * curOptions.LimitBackoffRatio))
+		newLimit = max(int64(curOptions.MinLimit), newLimit)
+		l.logger.Info(
+			"Health threshold exceeded, reducing concurrency limit.",
+			tag.NewInt64("newLimit", newLimit),
+			tag.NewInt64("inFlight", l.inFlight.Load()),
+			tag.Float64("latencyAvg", l.healthSignals.AverageLatency()),
+			tag.Float64("errorRatio", l.healthSignals.ErrorRatio()),
+		)
+	} else if curLimit < int64(curOptions.MaxLimit) {
+		// already doing backoff and under thresholds, increase limit
+		newLimit = min(int64(curOptions.MaxLimit), curLimit+int64(curOptions.LimitIncreaseStepSize))
+		l.logger.Info(
+			"System healthy, increasing concurrency limit.",
+			tag.NewInt64("newLimit", newLimit),
+			tag.NewInt64("inFlight", l.inFlight.Load()),
+			tag.Float64("latencyAvg", l.healthSignals.AverageLatency()),
+			tag.Float64("errorRatio", l.healthSignals.ErrorRatio()),
+		)
+	}
+	// Always clamp the limit to pickup changes to the min and max limit dynamic config
+	newLimit = min(max(newLimit, int64(curOptions.MinLimit)), int64(curOptions.MaxLimit))
+	l.limit.Store(newLimit)
+	metrics.DynamicConcurrencyLimit.With(l.metricsHandler).Record(float64(newLimit))
+	metrics.DynamicConcurrencyLimitInFlight.With(l.metricsHandler).Record(float64(l.inFlight.Load()))
+}
+
+func (l *HealthConcurrencyLimiterImpl) refreshDynamicParams() {
+	options := l.params()
+	l.enabled.Store(options.Enabled)
+	l.curOptions.Store(&options)
+}
+
+func (l *HealthConcurrencyLimiterImpl) updateRefreshTimer() {
+	l.refreshTimer.Reset(l.curOptions.Load().RefreshInterval)
+}
+
+func (l *HealthConcurrencyLimiterImpl) latencyThresholdExceeded() bool {
+	curOptions := *l.curOptions.Load()
+	return curOptions.LatencyThreshold > 0 && l.healthSignals.AverageLatency() > curOptions.LatencyThreshold
+}
+
+func (l *HealthConcurrencyLimiterImpl) errorThresholdExceeded() bool {
+	curOptions := *l.curOptions.Load()
+	return curOptions.ErrorThreshold > 0 && l.healthSignals.ErrorRatio() > curOptions.ErrorThreshold
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/quotas"
+)
+
+type testHealthSignals struct {
+	latency    float64
+	errorRatio float64
+}
+
+func (h *testHealthSignals) Record(int32, time.Duration, error) {}
+func (h *testHealthSignals) AverageLatency() float64            { return h.latency }
+func (h *testHealthSignals) ErrorRatio() float64                { return h.errorRatio }
+func (h *testHealthSignals) Start()                             {}
+func (h *testHealthSignals) Stop()                              {}
+
+func newTestConcurrencyLimiter(
+	healthSignals *testHealthSignals,
+	params dynamicconfig.DynamicConcurrencyLimitingParams,
+) *HealthConcurrencyLimiterImpl {
+	return NewHealthConcurrencyLimiterImpl(
+		healthSignals,
+		RequestPriorityFn,
+		func() dynamicconfig.DynamicConcurrencyLimitingParams { return params },
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+}
+
+func newTestConcurrencyLimiterParams() dynamicconfig.DynamicConcurrencyLimitingParams {
+	params := dynamicconfig.DefaultDynamicConcurrencyLimitingParams
+	params.Enabled = true
+	params.LatencyThreshold = 100
+	params.ErrorThreshold = 0.1
+	params.InitialLimit = 10
+	params.MinLimit = 2
+	params.MaxLimit = 20
+	params.LimitBackoffRatio = 0.5
+	params.LimitIncreaseStepSize = 5
+	params.PriorityShedRatio = 0.5
+	return params
+}
+
+func TestHealthConcurrencyLimiter_Disabled(t *testing.T) {
+	params := newTestConcurrencyLimiterParams()
+	params.Enabled = false
+	params.InitialLimit = 1
+	limiter := newTestConcurrencyLimiter(&testHealthSignals{}, params)
+
+	request := quotas.NewRequest("GetWorkflowExecution", 1, "", headers.CallerTypeAPI, 0, "")
+	for range 10 {
+		_, ok := limiter.TryAcquire(request)
+		require.True(t, ok)
+	}
+	require.Zero(t, limiter.Describe().GetInFlight())
+}
+
+func TestHealthConcurrencyLimiter_ShedsLowerPriorityFirst(t *testing.T) {
+	limiter := newTestConcurrencyLimiter(&testHealthSignals{}, newTestConcurrencyLimiterParams())
+
+	operatorRequest := quotas.NewRequest("GetWorkflowExecution", 1, "", headers.CallerTypeOperator, 0, "")
+	preemptableRequest := quotas.NewRequest("GetWorkflowExecution", 1, "", headers.CallerTypePreemptable, 0, "")
+
+	// Preemptable requests can only use half of the limit.
+	var releases []func()
+	for range 5 {
+		release, ok := limiter.TryAcquire(preemptableRequest)
+		require.True(t, ok)
+		releases = append(releases, release)
+	}
+	_, ok := limiter.TryAcquire(preemptableRequest)
+	require.False(t, ok)
+
+	// Operator requests can use all of it.
+	for range 5 {
+		release, ok := limiter.TryAcquire(operatorRequest)
+		require.True(t, ok)
+		releases = append(releases, release)
+	}
+	_, ok = limiter.TryAcquire(operatorRequest)
+	require.False(t, ok)
+	require.Equal(t, int64(10), limiter.Describe().GetInFlight())
+
+	for _, release := range releases {
+		release()
+		release() // releasing twice is a no-op
+	}
+	require.Zero(t, limiter.Describe().GetInFlight())
+	_, ok = limiter.TryAcquire(preemptableRequest)
+	require.True(t, ok)
+}
+
+func TestHealthConcurrencyLimiter_RefreshLimit(t *testing.T) {
+	healthSignals := &testHealthSignals{}
+	limiter := newTestConcurrencyLimiter(healthSignals, newTestConcurrencyLimiterParams())
+	require.Equal(t, int64(10), limiter.Describe().GetLimit())
+
+	// Multiplicative decrease when latency is above the threshold, down to the min limit.
+	healthSignals.latency = 200
+	limiter.refreshLimit()
+	require.Equal(t, int64(5), limiter.Describe().GetLimit())
+	limiter.refreshLimit()
+	require.Equal(t, int64(2), limiter.Describe().GetLimit())
+	limiter.refreshLimit()
+	require.Equal(t, int64(2), limiter.Describe().GetLimit())
+
+	// Additive increase when healthy, up to the max limit.
+	healthSignals.latency = 50
+	limiter.refreshLimit()
+	require.Equal(t, int64(7), limiter.Describe().GetLimit())
+	for range 5 {
+		limiter.refreshLimit()
+	}
+	require.Equal(t, int64(20), limiter.Describe().GetLimit())
+
+	// Multiplicative decrease when the error ratio is above the threshold.
+	healthSignals.errorRatio = 0.5
+	limiter.refreshLimit()
+	require.Equal(t, int64(10), limiter.Describe().GetLimit())
+}
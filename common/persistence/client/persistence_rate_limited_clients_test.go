@@ -165,6 +165,7 @@ func TestRateLimitedPersistenceClients(t *testing.T) {
 				systemRequestRateLimiter,
 				namespaceRequestRateLimiter,
 				shardRequestRateLimiter,
+				nil,
 				serializer,
 				nil,
 				"",
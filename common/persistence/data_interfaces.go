@@ -1269,6 +1269,31 @@ type (
 		ListQueues(ctx context.Context, request *ListQueuesRequest) (*ListQueuesResponse, error)
 	}
 
+	// CallbackDLQManager manages the per-namespace queues of callbacks whose delivery was abandoned. Queues are
+	// created lazily when the first callback of a namespace is dead-lettered.
+	CallbackDLQManager interface {
+		Closeable
+		EnqueueCallback(ctx context.Context, request *EnqueueCallbackRequest) (*EnqueueCallbackResponse, error)
+		// ReadCallbacks returns an empty page if no callback was ever dead-lettered in the namespace.
+		ReadCallbacks(ctx context.Context, request *ReadCallbacksRequest) (*ReadCallbacksResponse, error)
+		DeleteCallbacks(ctx context.Context, request *DeleteCallbacksRequest) (*DeleteCallbacksResponse, error)
+	}
+
+	// WorkerRegistryManager persists the worker registry snapshots of namespaces, so that the worker registry of
+	// matching survives restarts and namespaces moving between matching hosts.
+	WorkerRegistryManager interface {
+		Closeable
+		SaveWorkerRegistrySnapshot(
+			ctx context.Context,
+			request *SaveWorkerRegistrySnapshotRequest,
+		) (*SaveWorkerRegistrySnapshotResponse, error)
+		// LoadWorkerRegistrySnapshot returns a nil snapshot if none was saved for the namespace.
+		LoadWorkerRegistrySnapshot(
+			ctx context.Context,
+			request *LoadWorkerRegistrySnapshotRequest,
+		) (*LoadWorkerRegistrySnapshotResponse, error)
+	}
+
 	HistoryTaskQueueManagerImpl struct {
 		queue      QueueV2
 		serializer serialization.Serializer
@@ -1353,6 +1378,57 @@ type (
 		Queues        []QueueInfo
 		NextPageToken []byte
 	}
+
+	EnqueueCallbackRequest struct {
+		Callback *persistencespb.DeadLetteredCallback
+	}
+
+	EnqueueCallbackResponse struct {
+		Metadata MessageMetadata
+	}
+
+	ReadCallbacksRequest struct {
+		NamespaceID string
+		PageSize    int
+		// MinMessageID is the ID of the first message to read. It is ignored when NextPageToken is set.
+		MinMessageID  int64
+		NextPageToken []byte
+	}
+
+	DeadLetteredCallback struct {
+		MessageMetadata MessageMetadata
+		Callback        *persistencespb.DeadLetteredCallback
+	}
+
+	ReadCallbacksResponse struct {
+		Callbacks     []DeadLetteredCallback
+		NextPageToken []byte
+	}
+
+	DeleteCallbacksRequest struct {
+		NamespaceID                 string
+		InclusiveMaxMessageMetadata MessageMetadata
+	}
+
+	DeleteCallbacksResponse struct {
+		MessagesDeleted int64
+	}
+
+	SaveWorkerRegistrySnapshotRequest struct {
+		NamespaceID string
+		Snapshot    *persistencespb.WorkerRegistrySnapshot
+	}
+
+	SaveWorkerRegistrySnapshotResponse struct {
+	}
+
+	LoadWorkerRegistrySnapshotRequest struct {
+		NamespaceID string
+	}
+
+	LoadWorkerRegistrySnapshotResponse struct {
+		Snapshot *persistencespb.WorkerRegistrySnapshot
+	}
 )
 
 func (e *InvalidPersistenceRequestError) Error() string {
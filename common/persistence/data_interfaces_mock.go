@@ -1460,3 +1460,150 @@ func (mr *MockHistoryTaskQueueManagerMockRecorder) ReadTasks(ctx, request any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTasks", reflect.TypeOf((*MockHistoryTaskQueueManager)(nil).ReadTasks), ctx, request)
 }
+
+// MockCallbackDLQManager is a mock of CallbackDLQManager interface.
+type MockCallbackDLQManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockCallbackDLQManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockCallbackDLQManagerMockRecorder is the mock recorder for MockCallbackDLQManager.
+type MockCallbackDLQManagerMockRecorder struct {
+	mock *MockCallbackDLQManager
+}
+
+// NewMockCallbackDLQManager creates a new mock instance.
+func NewMockCallbackDLQManager(ctrl *gomock.Controller) *MockCallbackDLQManager {
+	mock := &MockCallbackDLQManager{ctrl: ctrl}
+	mock.recorder = &MockCallbackDLQManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCallbackDLQManager) EXPECT() *MockCallbackDLQManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockCallbackDLQManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockCallbackDLQManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockCallbackDLQManager)(nil).Close))
+}
+
+// DeleteCallbacks mocks base method.
+func (m *MockCallbackDLQManager) DeleteCallbacks(ctx context.Context, request *DeleteCallbacksRequest) (*DeleteCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCallbacks", ctx, request)
+	ret0, _ := ret[0].(*DeleteCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCallbacks indicates an expected call of DeleteCallbacks.
+func (mr *MockCallbackDLQManagerMockRecorder) DeleteCallbacks(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCallbacks", reflect.TypeOf((*MockCallbackDLQManager)(nil).DeleteCallbacks), ctx, request)
+}
+
+// EnqueueCallback mocks base method.
+func (m *MockCallbackDLQManager) EnqueueCallback(ctx context.Context, request *EnqueueCallbackRequest) (*EnqueueCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueCallback", ctx, request)
+	ret0, _ := ret[0].(*EnqueueCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueCallback indicates an expected call of EnqueueCallback.
+func (mr *MockCallbackDLQManagerMockRecorder) EnqueueCallback(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueCallback", reflect.TypeOf((*MockCallbackDLQManager)(nil).EnqueueCallback), ctx, request)
+}
+
+// ReadCallbacks mocks base method.
+func (m *MockCallbackDLQManager) ReadCallbacks(ctx context.Context, request *ReadCallbacksRequest) (*ReadCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCallbacks", ctx, request)
+	ret0, _ := ret[0].(*ReadCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCallbacks indicates an expected call of ReadCallbacks.
+func (mr *MockCallbackDLQManagerMockRecorder) ReadCallbacks(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCallbacks", reflect.TypeOf((*MockCallbackDLQManager)(nil).ReadCallbacks), ctx, request)
+}
+
+// MockWorkerRegistryManager is a mock of WorkerRegistryManager interface.
+type MockWorkerRegistryManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkerRegistryManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkerRegistryManagerMockRecorder is the mock recorder for MockWorkerRegistryManager.
+type MockWorkerRegistryManagerMockRecorder struct {
+	mock *MockWorkerRegistryManager
+}
+
+// NewMockWorkerRegistryManager creates a new mock instance.
+func NewMockWorkerRegistryManager(ctrl *gomock.Controller) *MockWorkerRegistryManager {
+	mock := &MockWorkerRegistryManager{ctrl: ctrl}
+	mock.recorder = &MockWorkerRegistryManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkerRegistryManager) EXPECT() *MockWorkerRegistryManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockWorkerRegistryManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockWorkerRegistryManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockWorkerRegistryManager)(nil).Close))
+}
+
+// LoadWorkerRegistrySnapshot mocks base method.
+func (m *MockWorkerRegistryManager) LoadWorkerRegistrySnapshot(ctx context.Context, request *LoadWorkerRegistrySnapshotRequest) (*LoadWorkerRegistrySnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadWorkerRegistrySnapshot", ctx, request)
+	ret0, _ := ret[0].(*LoadWorkerRegistrySnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadWorkerRegistrySnapshot indicates an expected call of LoadWorkerRegistrySnapshot.
+func (mr *MockWorkerRegistryManagerMockRecorder) LoadWorkerRegistrySnapshot(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadWorkerRegistrySnapshot", reflect.TypeOf((*MockWorkerRegistryManager)(nil).LoadWorkerRegistrySnapshot), ctx, request)
+}
+
+// SaveWorkerRegistrySnapshot mocks base method.
+func (m *MockWorkerRegistryManager) SaveWorkerRegistrySnapshot(ctx context.Context, request *SaveWorkerRegistrySnapshotRequest) (*SaveWorkerRegistrySnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWorkerRegistrySnapshot", ctx, request)
+	ret0, _ := ret[0].(*SaveWorkerRegistrySnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveWorkerRegistrySnapshot indicates an expected call of SaveWorkerRegistrySnapshot.
+func (mr *MockWorkerRegistryManagerMockRecorder) SaveWorkerRegistrySnapshot(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWorkerRegistrySnapshot", reflect.TypeOf((*MockWorkerRegistryManager)(nil).SaveWorkerRegistrySnapshot), ctx, request)
+}
@@ -1,6 +1,7 @@
 package faultinjection
 
 import (
+	"go.temporal.io/server/common/chaos"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/persistence"
 )
@@ -9,6 +10,7 @@ type (
 	FaultInjectionDataStoreFactory struct {
 		baseFactory persistence.DataStoreFactory
 		fiConfig    *config.FaultInjection
+		registry    *chaos.Registry
 
 		taskStore          persistence.TaskStore
 		fairTaskStore      persistence.TaskStore
@@ -22,13 +24,17 @@ type (
 	}
 )
 
+// NewFaultInjectionDatastoreFactory wraps the stores of the base factory to inject the faults configured in fiConfig
+// or added at runtime to the registry. Both are optional.
 func NewFaultInjectionDatastoreFactory(
 	fiConfig *config.FaultInjection,
+	registry *chaos.Registry,
 	baseFactory persistence.DataStoreFactory,
 ) *FaultInjectionDataStoreFactory {
 	return &FaultInjectionDataStoreFactory{
 		baseFactory: baseFactory,
 		fiConfig:    fiConfig,
+		registry:    registry,
 	}
 }
 
@@ -198,9 +204,11 @@ func (d *FaultInjectionDataStoreFactory) NewNexusEndpointStore() (persistence.Ne
 }
 
 func (d *FaultInjectionDataStoreFactory) storeFaultInjector(storeName config.DataStoreName) (faultGenerator, bool) {
-	storeConfig, ok := d.fiConfig.Targets.DataStores[storeName]
-	if !ok {
-		storeConfig = config.FaultInjectionDataStoreConfig{}
+	var storeConfig config.FaultInjectionDataStoreConfig
+	var injector config.FaultInjector
+	if d.fiConfig != nil {
+		storeConfig = d.fiConfig.Targets.DataStores[storeName]
+		injector = d.fiConfig.Injector
 	}
-	return newStoreFaultInjector(storeName, &storeConfig, d.fiConfig.Injector)
+	return newStoreFaultInjector(storeName, &storeConfig, injector, d.registry)
 }
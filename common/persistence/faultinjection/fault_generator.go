@@ -1,7 +1,11 @@
 package faultinjection
 
+import (
+	"context"
+)
+
 type (
 	faultGenerator interface {
-		generate(methodName string) *fault
+		generate(ctx context.Context, methodName string, request any) *fault
 	}
 )
@@ -1,18 +1,22 @@
 package faultinjection
 
 import (
+	"context"
+	"reflect"
+
+	"go.temporal.io/server/common/chaos"
 	"go.temporal.io/server/common/config"
 )
 
 type (
 	// storeFaultInjector is an implementation of faultGenerator that will inject errors into the persistence layer
-	// using runtime injectors or per-method configuration.
+	// using runtime injectors, per-method configuration or the faults added to the chaos registry.
 	storeFaultInjector struct {
 		storeName config.DataStoreName
 		injectors []faultInjector
 	}
 
-	faultInjector func(config.FaultInjectionTarget) *fault
+	faultInjector func(ctx context.Context, target config.FaultInjectionTarget, request any) *fault
 )
 
 // newStoreFaultInjector returns a new instance of a data store fault injector that will inject errors
@@ -21,6 +25,7 @@ func newStoreFaultInjector(
 	storeName config.DataStoreName,
 	cfg *config.FaultInjectionDataStoreConfig,
 	injector config.FaultInjector,
+	registry *chaos.Registry,
 ) (*storeFaultInjector, bool) {
 	var injectors []faultInjector
 	if injector != nil {
@@ -29,6 +34,9 @@ func newStoreFaultInjector(
 	if len(cfg.Methods) > 0 {
 		injectors = append(injectors, configuredFaultInjector(cfg))
 	}
+	if registry != nil {
+		injectors = append(injectors, registryFaultInjector(registry))
+	}
 	if len(injectors) == 0 {
 		return nil, false
 	}
@@ -39,7 +47,7 @@ func newStoreFaultInjector(
 }
 
 func runtimeFaultInjector(injector config.FaultInjector) faultInjector {
-	return func(target config.FaultInjectionTarget) *fault {
+	return func(_ context.Context, target config.FaultInjectionTarget, _ any) *fault {
 		err := injector(target)
 		if err == nil {
 			return nil
@@ -50,7 +58,7 @@ func runtimeFaultInjector(injector config.FaultInjector) faultInjector {
 }
 
 func configuredFaultInjector(cfg *config.FaultInjectionDataStoreConfig) faultInjector {
-	methodFaultGenerators := make(map[string]faultGenerator, len(cfg.Methods))
+	methodFaultGenerators := make(map[string]*methodFaultGenerator, len(cfg.Methods))
 	for methodName, methodConfig := range cfg.Methods {
 		var faults []fault
 		for errName, errRate := range methodConfig.Errors {
@@ -58,7 +66,7 @@ func configuredFaultInjector(cfg *config.FaultInjectionDataStoreConfig) faultInj
 		}
 		methodFaultGenerators[methodName] = newMethodFaultGenerator(faults, methodConfig.Seed)
 	}
-	return func(target config.FaultInjectionTarget) *fault {
+	return func(_ context.Context, target config.FaultInjectionTarget, _ any) *fault {
 		methodGenerator, ok := methodFaultGenerators[target.Method]
 		if !ok {
 			return nil
@@ -67,15 +75,60 @@ func configuredFaultInjector(cfg *config.FaultInjectionDataStoreConfig) faultInj
 	}
 }
 
+func registryFaultInjector(registry *chaos.Registry) faultInjector {
+	return func(ctx context.Context, target config.FaultInjectionTarget, request any) *fault {
+		if !registry.Active() {
+			return nil
+		}
+		namespaceID, shardID := requestScope(request)
+		errorType := registry.Inject(ctx, chaos.Target{
+			Kind:        chaos.TargetPersistence,
+			Component:   string(target.Store),
+			Method:      target.Method,
+			NamespaceID: namespaceID,
+			ShardID:     shardID,
+		})
+		if errorType == "" {
+			return nil
+		}
+		f := newFault(errorType, 1.0, target.Method)
+		return &f
+	}
+}
+
+// requestScope returns the namespace ID and shard ID of a persistence request, if it has NamespaceID or ShardID
+// fields.
+func requestScope(request any) (string, int32) {
+	v := reflect.ValueOf(request)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", 0
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return "", 0
+	}
+	var namespaceID string
+	var shardID int32
+	if f := v.FieldByName("NamespaceID"); f.IsValid() && f.Kind() == reflect.String {
+		namespaceID = f.String()
+	}
+	if f := v.FieldByName("ShardID"); f.IsValid() && f.Kind() == reflect.Int32 {
+		shardID = int32(f.Int())
+	}
+	return namespaceID, shardID
+}
+
 // generate returns a fault from the first injector that chooses to inject one.
 // When this method returns nil, the persistence layer uses the real implementation.
-func (d *storeFaultInjector) generate(methodName string) *fault {
+func (d *storeFaultInjector) generate(ctx context.Context, methodName string, request any) *fault {
 	target := config.FaultInjectionTarget{
 		Store:  d.storeName,
 		Method: methodName,
 	}
 	for _, injector := range d.injectors {
-		if f := injector(target); f != nil {
+		if f := injector(ctx, target, request); f != nil {
 			return f
 		}
 	}
@@ -8,9 +8,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.temporal.io/api/serviceerror"
+	faultinjectionspb "go.temporal.io/server/api/faultinjection/v1"
+	"go.temporal.io/server/common/chaos"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/mock"
+	"go.temporal.io/server/common/primitives"
 	"go.uber.org/mock/gomock"
 )
 
@@ -25,7 +30,7 @@ func TestFaultInjection_DataStoreFactory_CreateErr(t *testing.T) {
 	errCreate := errors.New("error creating QueueV2")
 	dataStoreFactory.EXPECT().NewQueueV2().Return(nil, errCreate)
 
-	factory := NewFaultInjectionDatastoreFactory(&config.FaultInjection{}, dataStoreFactory)
+	factory := NewFaultInjectionDatastoreFactory(&config.FaultInjection{}, nil, dataStoreFactory)
 
 	_, err := factory.NewQueueV2()
 	assert.ErrorIs(t, err, errCreate)
@@ -91,7 +96,7 @@ func TestFaultInjection_Inject(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			baseFactory := mock.NewMockDataStoreFactory(ctrl)
-			factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, baseFactory)
+			factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, nil, baseFactory)
 			baseQueue := mock.NewMockQueueV2(ctrl)
 			baseFactory.EXPECT().NewQueueV2().Return(baseQueue, nil)
 
@@ -144,7 +149,7 @@ func TestFaultInjection_StoreNotConfigured(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	baseFactory := mock.NewMockDataStoreFactory(ctrl)
-	factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, baseFactory)
+	factory := NewFaultInjectionDatastoreFactory(faultInjectionConfig, nil, baseFactory)
 	baseQueue := mock.NewMockQueueV2(ctrl)
 	baseFactory.EXPECT().NewQueueV2().Return(baseQueue, nil)
 
@@ -166,3 +171,39 @@ func TestFaultInjection_StoreNotConfigured(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp2)
 }
+
+func TestFaultInjection_Registry(t *testing.T) {
+	t.Parallel()
+
+	registry := chaos.NewRegistry(primitives.HistoryService, dynamicconfig.GetBoolPropertyFn(true), clock.NewRealTimeSource())
+	require.NoError(t, registry.Update([]*faultinjectionspb.Fault{{
+		Id:        "f",
+		Target:    chaos.TargetPersistence,
+		Component: string(config.ExecutionStoreName),
+		Method:    "UpdateWorkflowExecution",
+		ShardId:   2,
+		ErrorType: chaos.ErrorTypeShardOwnershipLost,
+		ErrorRate: 1,
+	}}, nil, false))
+
+	ctrl := gomock.NewController(t)
+	baseFactory := mock.NewMockDataStoreFactory(ctrl)
+	factory := NewFaultInjectionDatastoreFactory(nil, registry, baseFactory)
+	baseExecutionStore := mock.NewMockExecutionStore(ctrl)
+	baseFactory.EXPECT().NewExecutionStore().Return(baseExecutionStore, nil)
+	e, err := factory.NewExecutionStore()
+	require.NoError(t, err)
+
+	var shardOwnershipLostErr *persistence.ShardOwnershipLostError
+	err = e.UpdateWorkflowExecution(context.Background(), &persistence.InternalUpdateWorkflowExecutionRequest{ShardID: 2})
+	require.ErrorAs(t, err, &shardOwnershipLostErr)
+
+	baseExecutionStore.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err = e.UpdateWorkflowExecution(context.Background(), &persistence.InternalUpdateWorkflowExecutionRequest{ShardID: 3})
+	require.NoError(t, err)
+
+	require.NoError(t, registry.Update(nil, nil, true))
+	baseExecutionStore.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err = e.UpdateWorkflowExecution(context.Background(), &persistence.InternalUpdateWorkflowExecutionRequest{ShardID: 2})
+	require.NoError(t, err)
+}
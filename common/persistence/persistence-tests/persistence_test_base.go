@@ -148,6 +148,8 @@ func NewTestBaseWithSQL(options *TestBaseOptions) *TestBase {
 			options.DBPort = environment.GetMySQLPort()
 		case postgresql.PluginName, postgresql.PluginNamePGX:
 			options.DBPort = environment.GetPostgreSQLPort()
+		case postgresql.PluginNameCockroachDB:
+			options.DBPort = environment.GetCockroachDBPort()
 		case sqlite.PluginName:
 			options.DBPort = 0
 		default:
@@ -160,6 +162,8 @@ func NewTestBaseWithSQL(options *TestBaseOptions) *TestBase {
 			options.DBHost = environment.GetMySQLAddress()
 		case postgresql.PluginName, postgresql.PluginNamePGX:
 			options.DBHost = environment.GetPostgreSQLAddress()
+		case postgresql.PluginNameCockroachDB:
+			options.DBHost = environment.GetCockroachDBAddress()
 		case sqlite.PluginName:
 			options.DBHost = environment.GetLocalhostIP()
 		default:
@@ -216,6 +220,7 @@ func (s *TestBase) Setup(clusterMetadataConfig *cluster.Config) {
 		metrics.NoopMetricsHandler,
 		s.TracerProvider,
 		serializer,
+		nil,
 	)
 	factory := client.NewFactory(
 		dataStoreFactory,
@@ -223,6 +228,7 @@ func (s *TestBase) Setup(clusterMetadataConfig *cluster.Config) {
 		s.PersistenceRateLimiter,
 		quotas.NoopRequestRateLimiter,
 		quotas.NoopRequestRateLimiter,
+		quotas.NoopConcurrencyLimiter,
 		serializer,
 		nil,
 		clusterName,
@@ -23,6 +23,10 @@ const (
 	testPostgreSQLPassword  = "temporal"
 	testPostgreSQLSchemaDir = "schema/postgresql/v12"
 
+	testCockroachDBUser      = "root"
+	testCockroachDBPassword  = ""
+	testCockroachDBSchemaDir = "schema/cockroachdb/v23"
+
 	testSQLiteUser      = ""
 	testSQLitePassword  = ""
 	testSQLiteMode      = "memory"
@@ -41,6 +45,8 @@ func GetTestClusterOption(storeType, driver string) *TestBaseOptions {
 			return GetPostgreSQLTestClusterOption()
 		case postgresql.PluginNamePGX:
 			return GetPostgreSQLPGXTestClusterOption()
+		case postgresql.PluginNameCockroachDB:
+			return GetCockroachDBTestClusterOption()
 		case sqlite.PluginName:
 			return GetSQLiteMemoryTestClusterOption()
 		default:
@@ -106,6 +112,20 @@ func GetPostgreSQLPGXTestClusterOption() *TestBaseOptions {
 	}
 }
 
+// GetCockroachDBTestClusterOption return test options
+func GetCockroachDBTestClusterOption() *TestBaseOptions {
+	return &TestBaseOptions{
+		SQLDBPluginName: postgresql.PluginNameCockroachDB,
+		DBName:          "test_" + GenerateRandomDBName(3),
+		DBUsername:      testCockroachDBUser,
+		DBPassword:      testCockroachDBPassword,
+		DBHost:          environment.GetCockroachDBAddress(),
+		DBPort:          environment.GetCockroachDBPort(),
+		SchemaDir:       testCockroachDBSchemaDir,
+		StoreType:       config.StoreTypeSQL,
+	}
+}
+
 // GetSQLiteFileTestClusterOption return test options
 func GetSQLiteFileTestClusterOption() *TestBaseOptions {
 	return &TestBaseOptions{
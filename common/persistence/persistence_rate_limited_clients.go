@@ -36,6 +36,11 @@ var (
 		Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_NAMESPACE,
 		Message: "Namespace Per-Shard Persistence Max QPS Reached.",
 	}
+	ErrPersistenceSystemConcurrencyLimitExceeded = &serviceerror.ResourceExhausted{
+		Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_PERSISTENCE_LIMIT,
+		Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_SYSTEM,
+		Message: "System Persistence Max Concurrency Reached.",
+	}
 )
 
 type (
@@ -43,6 +48,7 @@ type (
 		systemRateLimiter    quotas.RequestRateLimiter
 		namespaceRateLimiter quotas.RequestRateLimiter
 		shardRateLimiter     quotas.RequestRateLimiter
+		concurrencyLimiter   quotas.ConcurrencyLimiter
 		persistence          ShardManager
 		logger               log.Logger
 	}
@@ -51,6 +57,7 @@ type (
 		systemRateLimiter    quotas.RequestRateLimiter
 		namespaceRateLimiter quotas.RequestRateLimiter
 		shardRateLimiter     quotas.RequestRateLimiter
+		concurrencyLimiter   quotas.ConcurrencyLimiter
 		persistence          ExecutionManager
 		logger               log.Logger
 	}
@@ -59,6 +66,7 @@ type (
 		systemRateLimiter    quotas.RequestRateLimiter
 		namespaceRateLimiter quotas.RequestRateLimiter
 		shardRateLimiter     quotas.RequestRateLimiter
+		concurrencyLimiter   quotas.ConcurrencyLimiter
 		persistence          TaskManager
 		logger               log.Logger
 	}
@@ -67,6 +75,7 @@ type (
 		systemRateLimiter    quotas.RequestRateLimiter
 		namespaceRateLimiter quotas.RequestRateLimiter
 		shardRateLimiter     quotas.RequestRateLimiter
+		concurrencyLimiter   quotas.ConcurrencyLimiter
 		persistence          MetadataManager
 		logger               log.Logger
 	}
@@ -75,6 +84,7 @@ type (
 		systemRateLimiter    quotas.RequestRateLimiter
 		namespaceRateLimiter quotas.RequestRateLimiter
 		shardRateLimiter     quotas.RequestRateLimiter
+		concurrencyLimiter   quotas.ConcurrencyLimiter
 		persistence          ClusterMetadataManager
 		logger               log.Logger
 	}
@@ -83,6 +93,7 @@ type (
 		systemRateLimiter    quotas.RequestRateLimiter
 		namespaceRateLimiter quotas.RequestRateLimiter
 		shardRateLimiter     quotas.RequestRateLimiter
+		concurrencyLimiter   quotas.ConcurrencyLimiter
 		persistence          Queue
 		logger               log.Logger
 	}
@@ -91,6 +102,7 @@ type (
 		systemRateLimiter    quotas.RequestRateLimiter
 		namespaceRateLimiter quotas.RequestRateLimiter
 		shardRateLimiter     quotas.RequestRateLimiter
+		concurrencyLimiter   quotas.ConcurrencyLimiter
 		persistence          NexusEndpointManager
 		logger               log.Logger
 	}
@@ -110,6 +122,7 @@ func NewShardPersistenceRateLimitedClient(
 	rateLimiter quotas.RequestRateLimiter,
 	namespaceRateLimiter quotas.RequestRateLimiter,
 	shardRateLimiter quotas.RequestRateLimiter,
+	concurrencyLimiter quotas.ConcurrencyLimiter,
 	logger log.Logger,
 ) ShardManager {
 	return &shardRateLimitedPersistenceClient{
@@ -117,6 +130,7 @@ func NewShardPersistenceRateLimitedClient(
 		systemRateLimiter:    rateLimiter,
 		namespaceRateLimiter: namespaceRateLimiter,
 		shardRateLimiter:     shardRateLimiter,
+		concurrencyLimiter:   concurrencyLimiter,
 		logger:               logger,
 	}
 }
@@ -127,6 +141,7 @@ func NewExecutionPersistenceRateLimitedClient(
 	systemRateLimiter quotas.RequestRateLimiter,
 	namespaceRateLimiter quotas.RequestRateLimiter,
 	shardRateLimiter quotas.RequestRateLimiter,
+	concurrencyLimiter quotas.ConcurrencyLimiter,
 	logger log.Logger,
 ) ExecutionManager {
 	return &executionRateLimitedPersistenceClient{
@@ -134,6 +149,7 @@ func NewExecutionPersistenceRateLimitedClient(
 		systemRateLimiter:    systemRateLimiter,
 		namespaceRateLimiter: namespaceRateLimiter,
 		shardRateLimiter:     shardRateLimiter,
+		concurrencyLimiter:   concurrencyLimiter,
 		logger:               logger,
 	}
 }
@@ -144,6 +160,7 @@ func NewTaskPersistenceRateLimitedClient(
 	systemRateLimiter quotas.RequestRateLimiter,
 	namespaceRateLimiter quotas.RequestRateLimiter,
 	shardRateLimiter quotas.RequestRateLimiter,
+	concurrencyLimiter quotas.ConcurrencyLimiter,
 	logger log.Logger,
 ) TaskManager {
 	return &taskRateLimitedPersistenceClient{
@@ -151,6 +168,7 @@ func NewTaskPersistenceRateLimitedClient(
 		systemRateLimiter:    systemRateLimiter,
 		namespaceRateLimiter: namespaceRateLimiter,
 		shardRateLimiter:     shardRateLimiter,
+		concurrencyLimiter:   concurrencyLimiter,
 		logger:               logger,
 	}
 }
@@ -161,6 +179,7 @@ func NewMetadataPersistenceRateLimitedClient(
 	systemRateLimiter quotas.RequestRateLimiter,
 	namespaceRateLimiter quotas.RequestRateLimiter,
 	shardRateLimiter quotas.RequestRateLimiter,
+	concurrencyLimiter quotas.ConcurrencyLimiter,
 	logger log.Logger,
 ) MetadataManager {
 	return &metadataRateLimitedPersistenceClient{
@@ -168,6 +187,7 @@ func NewMetadataPersistenceRateLimitedClient(
 		systemRateLimiter:    systemRateLimiter,
 		namespaceRateLimiter: namespaceRateLimiter,
 		shardRateLimiter:     shardRateLimiter,
+		concurrencyLimiter:   concurrencyLimiter,
 		logger:               logger,
 	}
 }
@@ -178,6 +198,7 @@ func NewClusterMetadataPersistenceRateLimitedClient(
 	systemRateLimiter quotas.RequestRateLimiter,
 	namespaceRateLimiter quotas.RequestRateLimiter,
 	shardRateLimiter quotas.RequestRateLimiter,
+	concurrencyLimiter quotas.ConcurrencyLimiter,
 	logger log.Logger,
 ) ClusterMetadataManager {
 	return &clusterMetadataRateLimitedPersistenceClient{
@@ -185,6 +206,7 @@ func NewClusterMetadataPersistenceRateLimitedClient(
 		systemRateLimiter:    systemRateLimiter,
 		namespaceRateLimiter: namespaceRateLimiter,
 		shardRateLimiter:     shardRateLimiter,
+		concurrencyLimiter:   concurrencyLimiter,
 		logger:               logger,
 	}
 }
@@ -195,6 +217,7 @@ func NewQueuePersistenceRateLimitedClient(
 	systemRateLimiter quotas.RequestRateLimiter,
 	namespaceRateLimiter quotas.RequestRateLimiter,
 	shardRateLimiter quotas.RequestRateLimiter,
+	concurrencyLimiter quotas.ConcurrencyLimiter,
 	logger log.Logger,
 ) Queue {
 	return &queueRateLimitedPersistenceClient{
@@ -202,6 +225,7 @@ func NewQueuePersistenceRateLimitedClient(
 		systemRateLimiter:    systemRateLimiter,
 		namespaceRateLimiter: namespaceRateLimiter,
 		shardRateLimiter:     shardRateLimiter,
+		concurrencyLimiter:   concurrencyLimiter,
 		logger:               logger,
 	}
 }
@@ -212,6 +236,7 @@ func NewNexusEndpointPersistenceRateLimitedClient(
 	systemRateLimiter quotas.RequestRateLimiter,
 	namespaceRateLimiter quotas.RequestRateLimiter,
 	shardRateLimiter quotas.RequestRateLimiter,
+	concurrencyLimiter quotas.ConcurrencyLimiter,
 	logger log.Logger,
 ) NexusEndpointManager {
 	return &nexusEndpointRateLimitedPersistenceClient{
@@ -219,6 +244,7 @@ func NewNexusEndpointPersistenceRateLimitedClient(
 		systemRateLimiter:    systemRateLimiter,
 		namespaceRateLimiter: namespaceRateLimiter,
 		shardRateLimiter:     shardRateLimiter,
+		concurrencyLimiter:   concurrencyLimiter,
 		logger:               logger,
 	}
 }
@@ -231,9 +257,11 @@ func (p *shardRateLimitedPersistenceClient) GetOrCreateShard(
 	ctx context.Context,
 	request *GetOrCreateShardRequest,
 ) (*GetOrCreateShardResponse, error) {
-	if err := allow(ctx, "GetOrCreateShard", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetOrCreateShard", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.GetOrCreateShard(ctx, request)
 	return response, err
@@ -243,9 +271,11 @@ func (p *shardRateLimitedPersistenceClient) UpdateShard(
 	ctx context.Context,
 	request *UpdateShardRequest,
 ) error {
-	if err := allow(ctx, "UpdateShard", request.ShardInfo.ShardId, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "UpdateShard", request.ShardInfo.ShardId, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.UpdateShard(ctx, request)
 }
@@ -254,9 +284,11 @@ func (p *shardRateLimitedPersistenceClient) AssertShardOwnership(
 	ctx context.Context,
 	request *AssertShardOwnershipRequest,
 ) error {
-	if err := allow(ctx, "AssertShardOwnership", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "AssertShardOwnership", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.AssertShardOwnership(ctx, request)
 }
@@ -277,9 +309,11 @@ func (p *executionRateLimitedPersistenceClient) CreateWorkflowExecution(
 	ctx context.Context,
 	request *CreateWorkflowExecutionRequest,
 ) (*CreateWorkflowExecutionResponse, error) {
-	if err := allow(ctx, "CreateWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "CreateWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.CreateWorkflowExecution(ctx, request)
 	return response, err
@@ -289,9 +323,11 @@ func (p *executionRateLimitedPersistenceClient) GetWorkflowExecution(
 	ctx context.Context,
 	request *GetWorkflowExecutionRequest,
 ) (*GetWorkflowExecutionResponse, error) {
-	if err := allow(ctx, "GetWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.GetWorkflowExecution(ctx, request)
 	return response, err
@@ -301,9 +337,11 @@ func (p *executionRateLimitedPersistenceClient) SetWorkflowExecution(
 	ctx context.Context,
 	request *SetWorkflowExecutionRequest,
 ) (*SetWorkflowExecutionResponse, error) {
-	if err := allow(ctx, "SetWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "SetWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.SetWorkflowExecution(ctx, request)
 	return response, err
@@ -313,9 +351,11 @@ func (p *executionRateLimitedPersistenceClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *UpdateWorkflowExecutionRequest,
 ) (*UpdateWorkflowExecutionResponse, error) {
-	if err := allow(ctx, "UpdateWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "UpdateWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	resp, err := p.persistence.UpdateWorkflowExecution(ctx, request)
 	return resp, err
@@ -325,9 +365,11 @@ func (p *executionRateLimitedPersistenceClient) ConflictResolveWorkflowExecution
 	ctx context.Context,
 	request *ConflictResolveWorkflowExecutionRequest,
 ) (*ConflictResolveWorkflowExecutionResponse, error) {
-	if err := allow(ctx, "ConflictResolveWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ConflictResolveWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.ConflictResolveWorkflowExecution(ctx, request)
 	return response, err
@@ -337,9 +379,11 @@ func (p *executionRateLimitedPersistenceClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *DeleteWorkflowExecutionRequest,
 ) error {
-	if err := allow(ctx, "DeleteWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "DeleteWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.DeleteWorkflowExecution(ctx, request)
 }
@@ -348,9 +392,11 @@ func (p *executionRateLimitedPersistenceClient) DeleteCurrentWorkflowExecution(
 	ctx context.Context,
 	request *DeleteCurrentWorkflowExecutionRequest,
 ) error {
-	if err := allow(ctx, "DeleteCurrentWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "DeleteCurrentWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
 }
@@ -359,9 +405,11 @@ func (p *executionRateLimitedPersistenceClient) GetCurrentExecution(
 	ctx context.Context,
 	request *GetCurrentExecutionRequest,
 ) (*GetCurrentExecutionResponse, error) {
-	if err := allow(ctx, "GetCurrentExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetCurrentExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.GetCurrentExecution(ctx, request)
 	return response, err
@@ -371,9 +419,11 @@ func (p *executionRateLimitedPersistenceClient) ListConcreteExecutions(
 	ctx context.Context,
 	request *ListConcreteExecutionsRequest,
 ) (*ListConcreteExecutionsResponse, error) {
-	if err := allow(ctx, "ListConcreteExecutions", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ListConcreteExecutions", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.ListConcreteExecutions(ctx, request)
 	return response, err
@@ -383,9 +433,11 @@ func (p *executionRateLimitedPersistenceClient) AddHistoryTasks(
 	ctx context.Context,
 	request *AddHistoryTasksRequest,
 ) error {
-	if err := allow(ctx, "AddHistoryTasks", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "AddHistoryTasks", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.AddHistoryTasks(ctx, request)
 }
@@ -394,16 +446,19 @@ func (p *executionRateLimitedPersistenceClient) GetHistoryTasks(
 	ctx context.Context,
 	request *GetHistoryTasksRequest,
 ) (*GetHistoryTasksResponse, error) {
-	if err := allow(
+	release, err := allow(
 		ctx,
 		ConstructHistoryTaskAPI("GetHistoryTasks", request.TaskCategory),
 		request.ShardID,
 		p.systemRateLimiter,
 		p.namespaceRateLimiter,
 		p.shardRateLimiter,
-	); err != nil {
+		p.concurrencyLimiter,
+	)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.GetHistoryTasks(ctx, request)
 	return response, err
@@ -413,16 +468,19 @@ func (p *executionRateLimitedPersistenceClient) CompleteHistoryTask(
 	ctx context.Context,
 	request *CompleteHistoryTaskRequest,
 ) error {
-	if err := allow(
+	release, err := allow(
 		ctx,
 		ConstructHistoryTaskAPI("CompleteHistoryTask", request.TaskCategory),
 		request.ShardID,
 		p.systemRateLimiter,
 		p.namespaceRateLimiter,
 		p.shardRateLimiter,
-	); err != nil {
+		p.concurrencyLimiter,
+	)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.CompleteHistoryTask(ctx, request)
 }
@@ -431,16 +489,19 @@ func (p *executionRateLimitedPersistenceClient) RangeCompleteHistoryTasks(
 	ctx context.Context,
 	request *RangeCompleteHistoryTasksRequest,
 ) error {
-	if err := allow(
+	release, err := allow(
 		ctx,
 		ConstructHistoryTaskAPI("RangeCompleteHistoryTasks", request.TaskCategory),
 		request.ShardID,
 		p.systemRateLimiter,
 		p.namespaceRateLimiter,
 		p.shardRateLimiter,
-	); err != nil {
+		p.concurrencyLimiter,
+	)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.RangeCompleteHistoryTasks(ctx, request)
 }
@@ -449,9 +510,11 @@ func (p *executionRateLimitedPersistenceClient) PutReplicationTaskToDLQ(
 	ctx context.Context,
 	request *PutReplicationTaskToDLQRequest,
 ) error {
-	if err := allow(ctx, "PutReplicationTaskToDLQ", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "PutReplicationTaskToDLQ", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.PutReplicationTaskToDLQ(ctx, request)
 }
@@ -460,9 +523,11 @@ func (p *executionRateLimitedPersistenceClient) GetReplicationTasksFromDLQ(
 	ctx context.Context,
 	request *GetReplicationTasksFromDLQRequest,
 ) (*GetHistoryTasksResponse, error) {
-	if err := allow(ctx, "GetReplicationTasksFromDLQ", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetReplicationTasksFromDLQ", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	return p.persistence.GetReplicationTasksFromDLQ(ctx, request)
 }
@@ -471,9 +536,11 @@ func (p *executionRateLimitedPersistenceClient) DeleteReplicationTaskFromDLQ(
 	ctx context.Context,
 	request *DeleteReplicationTaskFromDLQRequest,
 ) error {
-	if err := allow(ctx, "DeleteReplicationTaskFromDLQ", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "DeleteReplicationTaskFromDLQ", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
 }
@@ -482,9 +549,11 @@ func (p *executionRateLimitedPersistenceClient) RangeDeleteReplicationTaskFromDL
 	ctx context.Context,
 	request *RangeDeleteReplicationTaskFromDLQRequest,
 ) error {
-	if err := allow(ctx, "RangeDeleteReplicationTaskFromDLQ", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "RangeDeleteReplicationTaskFromDLQ", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
 }
@@ -493,9 +562,11 @@ func (p *executionRateLimitedPersistenceClient) IsReplicationDLQEmpty(
 	ctx context.Context,
 	request *GetReplicationTasksFromDLQRequest,
 ) (bool, error) {
-	if err := allow(ctx, "IsReplicationDLQEmpty", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "IsReplicationDLQEmpty", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return true, err
 	}
+	defer release()
 
 	return p.persistence.IsReplicationDLQEmpty(ctx, request)
 }
@@ -512,9 +583,11 @@ func (p *taskRateLimitedPersistenceClient) CreateTasks(
 	ctx context.Context,
 	request *CreateTasksRequest,
 ) (*CreateTasksResponse, error) {
-	if err := allow(ctx, "CreateTasks", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "CreateTasks", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.CreateTasks(ctx, request)
 	return response, err
@@ -524,9 +597,11 @@ func (p *taskRateLimitedPersistenceClient) GetTasks(
 	ctx context.Context,
 	request *GetTasksRequest,
 ) (*GetTasksResponse, error) {
-	if err := allow(ctx, "GetTasks", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetTasks", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.GetTasks(ctx, request)
 	return response, err
@@ -536,9 +611,11 @@ func (p *taskRateLimitedPersistenceClient) CompleteTasksLessThan(
 	ctx context.Context,
 	request *CompleteTasksLessThanRequest,
 ) (int, error) {
-	if err := allow(ctx, "CompleteTasksLessThan", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "CompleteTasksLessThan", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return 0, err
 	}
+	defer release()
 	return p.persistence.CompleteTasksLessThan(ctx, request)
 }
 
@@ -546,9 +623,11 @@ func (p *taskRateLimitedPersistenceClient) CreateTaskQueue(
 	ctx context.Context,
 	request *CreateTaskQueueRequest,
 ) (*CreateTaskQueueResponse, error) {
-	if err := allow(ctx, "CreateTaskQueue", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "CreateTaskQueue", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.CreateTaskQueue(ctx, request)
 }
 
@@ -556,9 +635,11 @@ func (p *taskRateLimitedPersistenceClient) UpdateTaskQueue(
 	ctx context.Context,
 	request *UpdateTaskQueueRequest,
 ) (*UpdateTaskQueueResponse, error) {
-	if err := allow(ctx, "UpdateTaskQueue", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "UpdateTaskQueue", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.UpdateTaskQueue(ctx, request)
 }
 
@@ -566,9 +647,11 @@ func (p *taskRateLimitedPersistenceClient) GetTaskQueue(
 	ctx context.Context,
 	request *GetTaskQueueRequest,
 ) (*GetTaskQueueResponse, error) {
-	if err := allow(ctx, "GetTaskQueue", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetTaskQueue", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.GetTaskQueue(ctx, request)
 }
 
@@ -576,9 +659,11 @@ func (p *taskRateLimitedPersistenceClient) ListTaskQueue(
 	ctx context.Context,
 	request *ListTaskQueueRequest,
 ) (*ListTaskQueueResponse, error) {
-	if err := allow(ctx, "ListTaskQueue", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ListTaskQueue", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.ListTaskQueue(ctx, request)
 }
 
@@ -586,9 +671,11 @@ func (p *taskRateLimitedPersistenceClient) DeleteTaskQueue(
 	ctx context.Context,
 	request *DeleteTaskQueueRequest,
 ) error {
-	if err := allow(ctx, "DeleteTaskQueue", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "DeleteTaskQueue", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 	return p.persistence.DeleteTaskQueue(ctx, request)
 }
 
@@ -596,9 +683,11 @@ func (p taskRateLimitedPersistenceClient) GetTaskQueueUserData(
 	ctx context.Context,
 	request *GetTaskQueueUserDataRequest,
 ) (*GetTaskQueueUserDataResponse, error) {
-	if err := allow(ctx, "GetTaskQueueUserData", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetTaskQueueUserData", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.GetTaskQueueUserData(ctx, request)
 }
 
@@ -606,9 +695,11 @@ func (p taskRateLimitedPersistenceClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *UpdateTaskQueueUserDataRequest,
 ) error {
-	if err := allow(ctx, "UpdateTaskQueueUserData", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "UpdateTaskQueueUserData", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 	return p.persistence.UpdateTaskQueueUserData(ctx, request)
 }
 
@@ -616,23 +707,29 @@ func (p taskRateLimitedPersistenceClient) ListTaskQueueUserDataEntries(
 	ctx context.Context,
 	request *ListTaskQueueUserDataEntriesRequest,
 ) (*ListTaskQueueUserDataEntriesResponse, error) {
-	if err := allow(ctx, "ListTaskQueueUserDataEntries", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ListTaskQueueUserDataEntries", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.ListTaskQueueUserDataEntries(ctx, request)
 }
 
 func (p taskRateLimitedPersistenceClient) GetTaskQueuesByBuildId(ctx context.Context, request *GetTaskQueuesByBuildIdRequest) ([]string, error) {
-	if err := allow(ctx, "GetTaskQueuesByBuildId", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetTaskQueuesByBuildId", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.GetTaskQueuesByBuildId(ctx, request)
 }
 
 func (p taskRateLimitedPersistenceClient) CountTaskQueuesByBuildId(ctx context.Context, request *CountTaskQueuesByBuildIdRequest) (int, error) {
-	if err := allow(ctx, "CountTaskQueuesByBuildId", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "CountTaskQueuesByBuildId", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return 0, err
 	}
+	defer release()
 	return p.persistence.CountTaskQueuesByBuildId(ctx, request)
 }
 
@@ -648,9 +745,11 @@ func (p *metadataRateLimitedPersistenceClient) CreateNamespace(
 	ctx context.Context,
 	request *CreateNamespaceRequest,
 ) (*CreateNamespaceResponse, error) {
-	if err := allow(ctx, "CreateNamespace", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "CreateNamespace", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.CreateNamespace(ctx, request)
 	return response, err
@@ -660,9 +759,11 @@ func (p *metadataRateLimitedPersistenceClient) GetNamespace(
 	ctx context.Context,
 	request *GetNamespaceRequest,
 ) (*GetNamespaceResponse, error) {
-	if err := allow(ctx, "GetNamespace", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetNamespace", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.GetNamespace(ctx, request)
 	return response, err
@@ -672,9 +773,11 @@ func (p *metadataRateLimitedPersistenceClient) UpdateNamespace(
 	ctx context.Context,
 	request *UpdateNamespaceRequest,
 ) error {
-	if err := allow(ctx, "UpdateNamespace", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "UpdateNamespace", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.UpdateNamespace(ctx, request)
 }
@@ -683,9 +786,11 @@ func (p *metadataRateLimitedPersistenceClient) RenameNamespace(
 	ctx context.Context,
 	request *RenameNamespaceRequest,
 ) error {
-	if err := allow(ctx, "RenameNamespace", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "RenameNamespace", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.RenameNamespace(ctx, request)
 }
@@ -694,9 +799,11 @@ func (p *metadataRateLimitedPersistenceClient) DeleteNamespace(
 	ctx context.Context,
 	request *DeleteNamespaceRequest,
 ) error {
-	if err := allow(ctx, "DeleteNamespace", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "DeleteNamespace", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.DeleteNamespace(ctx, request)
 }
@@ -705,9 +812,11 @@ func (p *metadataRateLimitedPersistenceClient) DeleteNamespaceByName(
 	ctx context.Context,
 	request *DeleteNamespaceByNameRequest,
 ) error {
-	if err := allow(ctx, "DeleteNamespaceByName", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "DeleteNamespaceByName", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.DeleteNamespaceByName(ctx, request)
 }
@@ -716,9 +825,11 @@ func (p *metadataRateLimitedPersistenceClient) ListNamespaces(
 	ctx context.Context,
 	request *ListNamespacesRequest,
 ) (*ListNamespacesResponse, error) {
-	if err := allow(ctx, "ListNamespaces", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ListNamespaces", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.ListNamespaces(ctx, request)
 	return response, err
@@ -727,9 +838,11 @@ func (p *metadataRateLimitedPersistenceClient) ListNamespaces(
 func (p *metadataRateLimitedPersistenceClient) GetMetadata(
 	ctx context.Context,
 ) (*GetMetadataResponse, error) {
-	if err := allow(ctx, "GetMetadata", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetMetadata", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	response, err := p.persistence.GetMetadata(ctx)
 	return response, err
@@ -739,18 +852,22 @@ func (p *metadataRateLimitedPersistenceClient) InitializeSystemNamespaces(
 	ctx context.Context,
 	currentClusterName string,
 ) error {
-	if err := allow(ctx, "InitializeSystemNamespaces", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "InitializeSystemNamespaces", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 	return p.persistence.InitializeSystemNamespaces(ctx, currentClusterName)
 }
 
 func (p *metadataRateLimitedPersistenceClient) WatchNamespaces(
 	ctx context.Context,
 ) (<-chan *NamespaceWatchEvent, error) {
-	if err := allow(ctx, "WatchNamespaces", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "WatchNamespaces", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.WatchNamespaces(ctx)
 }
 
@@ -763,9 +880,11 @@ func (p *executionRateLimitedPersistenceClient) AppendHistoryNodes(
 	ctx context.Context,
 	request *AppendHistoryNodesRequest,
 ) (*AppendHistoryNodesResponse, error) {
-	if err := allow(ctx, "AppendHistoryNodes", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "AppendHistoryNodes", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.AppendHistoryNodes(ctx, request)
 }
 
@@ -774,9 +893,11 @@ func (p *executionRateLimitedPersistenceClient) AppendRawHistoryNodes(
 	ctx context.Context,
 	request *AppendRawHistoryNodesRequest,
 ) (*AppendHistoryNodesResponse, error) {
-	if err := allow(ctx, "AppendRawHistoryNodes", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "AppendRawHistoryNodes", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.AppendRawHistoryNodes(ctx, request)
 }
 
@@ -785,9 +906,11 @@ func (p *executionRateLimitedPersistenceClient) ReadHistoryBranch(
 	ctx context.Context,
 	request *ReadHistoryBranchRequest,
 ) (*ReadHistoryBranchResponse, error) {
-	if err := allow(ctx, "ReadHistoryBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ReadHistoryBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	response, err := p.persistence.ReadHistoryBranch(ctx, request)
 	return response, err
 }
@@ -797,9 +920,11 @@ func (p *executionRateLimitedPersistenceClient) ReadHistoryBranchReverse(
 	ctx context.Context,
 	request *ReadHistoryBranchReverseRequest,
 ) (*ReadHistoryBranchReverseResponse, error) {
-	if err := allow(ctx, "ReadHistoryBranchReverse", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ReadHistoryBranchReverse", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	response, err := p.persistence.ReadHistoryBranchReverse(ctx, request)
 	return response, err
 }
@@ -809,9 +934,11 @@ func (p *executionRateLimitedPersistenceClient) ReadHistoryBranchByBatch(
 	ctx context.Context,
 	request *ReadHistoryBranchRequest,
 ) (*ReadHistoryBranchByBatchResponse, error) {
-	if err := allow(ctx, "ReadHistoryBranchByBatch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ReadHistoryBranchByBatch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	response, err := p.persistence.ReadHistoryBranchByBatch(ctx, request)
 	return response, err
 }
@@ -821,9 +948,11 @@ func (p *executionRateLimitedPersistenceClient) ReadRawHistoryBranch(
 	ctx context.Context,
 	request *ReadHistoryBranchRequest,
 ) (*ReadRawHistoryBranchResponse, error) {
-	if err := allow(ctx, "ReadRawHistoryBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ReadRawHistoryBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	response, err := p.persistence.ReadRawHistoryBranch(ctx, request)
 	return response, err
 }
@@ -833,9 +962,11 @@ func (p *executionRateLimitedPersistenceClient) ForkHistoryBranch(
 	ctx context.Context,
 	request *ForkHistoryBranchRequest,
 ) (*ForkHistoryBranchResponse, error) {
-	if err := allow(ctx, "ForkHistoryBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ForkHistoryBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	response, err := p.persistence.ForkHistoryBranch(ctx, request)
 	return response, err
 }
@@ -845,9 +976,11 @@ func (p *executionRateLimitedPersistenceClient) DeleteHistoryBranch(
 	ctx context.Context,
 	request *DeleteHistoryBranchRequest,
 ) error {
-	if err := allow(ctx, "DeleteHistoryBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "DeleteHistoryBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 	return p.persistence.DeleteHistoryBranch(ctx, request)
 }
 
@@ -856,9 +989,11 @@ func (p *executionRateLimitedPersistenceClient) TrimHistoryBranch(
 	ctx context.Context,
 	request *TrimHistoryBranchRequest,
 ) (*TrimHistoryBranchResponse, error) {
-	if err := allow(ctx, "TrimHistoryBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "TrimHistoryBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	resp, err := p.persistence.TrimHistoryBranch(ctx, request)
 	return resp, err
 }
@@ -867,9 +1002,11 @@ func (p *executionRateLimitedPersistenceClient) GetAllHistoryTreeBranches(
 	ctx context.Context,
 	request *GetAllHistoryTreeBranchesRequest,
 ) (*GetAllHistoryTreeBranchesResponse, error) {
-	if err := allow(ctx, "GetAllHistoryTreeBranches", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetAllHistoryTreeBranches", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	response, err := p.persistence.GetAllHistoryTreeBranches(ctx, request)
 	return response, err
 }
@@ -878,9 +1015,11 @@ func (p *queueRateLimitedPersistenceClient) EnqueueMessage(
 	ctx context.Context,
 	blob *commonpb.DataBlob,
 ) error {
-	if err := allow(ctx, "EnqueueMessage", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "EnqueueMessage", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.EnqueueMessage(ctx, blob)
 }
@@ -890,9 +1029,11 @@ func (p *queueRateLimitedPersistenceClient) ReadMessages(
 	lastMessageID int64,
 	maxCount int,
 ) ([]*QueueMessage, error) {
-	if err := allow(ctx, "ReadMessages", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ReadMessages", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	return p.persistence.ReadMessages(ctx, lastMessageID, maxCount)
 }
@@ -901,9 +1042,11 @@ func (p *queueRateLimitedPersistenceClient) UpdateAckLevel(
 	ctx context.Context,
 	metadata *InternalQueueMetadata,
 ) error {
-	if err := allow(ctx, "UpdateAckLevel", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "UpdateAckLevel", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.UpdateAckLevel(ctx, metadata)
 }
@@ -911,9 +1054,11 @@ func (p *queueRateLimitedPersistenceClient) UpdateAckLevel(
 func (p *queueRateLimitedPersistenceClient) GetAckLevels(
 	ctx context.Context,
 ) (*InternalQueueMetadata, error) {
-	if err := allow(ctx, "GetAckLevels", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetAckLevels", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	return p.persistence.GetAckLevels(ctx)
 }
@@ -922,9 +1067,11 @@ func (p *queueRateLimitedPersistenceClient) DeleteMessagesBefore(
 	ctx context.Context,
 	messageID int64,
 ) error {
-	if err := allow(ctx, "DeleteMessagesBefore", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "DeleteMessagesBefore", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.DeleteMessagesBefore(ctx, messageID)
 }
@@ -933,9 +1080,11 @@ func (p *queueRateLimitedPersistenceClient) EnqueueMessageToDLQ(
 	ctx context.Context,
 	blob *commonpb.DataBlob,
 ) (int64, error) {
-	if err := allow(ctx, "EnqueueMessageToDLQ", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "EnqueueMessageToDLQ", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return EmptyQueueMessageID, err
 	}
+	defer release()
 
 	return p.persistence.EnqueueMessageToDLQ(ctx, blob)
 }
@@ -947,9 +1096,11 @@ func (p *queueRateLimitedPersistenceClient) ReadMessagesFromDLQ(
 	pageSize int,
 	pageToken []byte,
 ) ([]*QueueMessage, []byte, error) {
-	if err := allow(ctx, "ReadMessagesFromDLQ", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ReadMessagesFromDLQ", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, nil, err
 	}
+	defer release()
 
 	return p.persistence.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
 }
@@ -959,9 +1110,11 @@ func (p *queueRateLimitedPersistenceClient) RangeDeleteMessagesFromDLQ(
 	firstMessageID int64,
 	lastMessageID int64,
 ) error {
-	if err := allow(ctx, "RangeDeleteMessagesFromDLQ", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "RangeDeleteMessagesFromDLQ", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
 }
@@ -969,9 +1122,11 @@ func (p *queueRateLimitedPersistenceClient) UpdateDLQAckLevel(
 	ctx context.Context,
 	metadata *InternalQueueMetadata,
 ) error {
-	if err := allow(ctx, "UpdateDLQAckLevel", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "UpdateDLQAckLevel", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.UpdateDLQAckLevel(ctx, metadata)
 }
@@ -979,9 +1134,11 @@ func (p *queueRateLimitedPersistenceClient) UpdateDLQAckLevel(
 func (p *queueRateLimitedPersistenceClient) GetDLQAckLevels(
 	ctx context.Context,
 ) (*InternalQueueMetadata, error) {
-	if err := allow(ctx, "GetDLQAckLevels", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetDLQAckLevels", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	return p.persistence.GetDLQAckLevels(ctx)
 }
@@ -990,9 +1147,11 @@ func (p *queueRateLimitedPersistenceClient) DeleteMessageFromDLQ(
 	ctx context.Context,
 	messageID int64,
 ) error {
-	if err := allow(ctx, "DeleteMessageFromDLQ", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "DeleteMessageFromDLQ", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	return p.persistence.DeleteMessageFromDLQ(ctx, messageID)
 }
@@ -1020,9 +1179,11 @@ func (c *clusterMetadataRateLimitedPersistenceClient) GetClusterMembers(
 	ctx context.Context,
 	request *GetClusterMembersRequest,
 ) (*GetClusterMembersResponse, error) {
-	if err := allow(ctx, "GetClusterMembers", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetClusterMembers", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter, c.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return c.persistence.GetClusterMembers(ctx, request)
 }
 
@@ -1030,9 +1191,11 @@ func (c *clusterMetadataRateLimitedPersistenceClient) UpsertClusterMembership(
 	ctx context.Context,
 	request *UpsertClusterMembershipRequest,
 ) error {
-	if err := allow(ctx, "UpsertClusterMembership", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "UpsertClusterMembership", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter, c.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 	return c.persistence.UpsertClusterMembership(ctx, request)
 }
 
@@ -1040,9 +1203,11 @@ func (c *clusterMetadataRateLimitedPersistenceClient) PruneClusterMembership(
 	ctx context.Context,
 	request *PruneClusterMembershipRequest,
 ) error {
-	if err := allow(ctx, "PruneClusterMembership", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "PruneClusterMembership", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter, c.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 	return c.persistence.PruneClusterMembership(ctx, request)
 }
 
@@ -1050,18 +1215,22 @@ func (c *clusterMetadataRateLimitedPersistenceClient) ListClusterMetadata(
 	ctx context.Context,
 	request *ListClusterMetadataRequest,
 ) (*ListClusterMetadataResponse, error) {
-	if err := allow(ctx, "ListClusterMetadata", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ListClusterMetadata", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter, c.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return c.persistence.ListClusterMetadata(ctx, request)
 }
 
 func (c *clusterMetadataRateLimitedPersistenceClient) GetCurrentClusterMetadata(
 	ctx context.Context,
 ) (*GetClusterMetadataResponse, error) {
-	if err := allow(ctx, "GetCurrentClusterMetadata", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetCurrentClusterMetadata", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter, c.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return c.persistence.GetCurrentClusterMetadata(ctx)
 }
 
@@ -1069,9 +1238,11 @@ func (c *clusterMetadataRateLimitedPersistenceClient) GetClusterMetadata(
 	ctx context.Context,
 	request *GetClusterMetadataRequest,
 ) (*GetClusterMetadataResponse, error) {
-	if err := allow(ctx, "GetClusterMetadata", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetClusterMetadata", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter, c.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return c.persistence.GetClusterMetadata(ctx, request)
 }
 
@@ -1079,9 +1250,11 @@ func (c *clusterMetadataRateLimitedPersistenceClient) SaveClusterMetadata(
 	ctx context.Context,
 	request *SaveClusterMetadataRequest,
 ) (bool, error) {
-	if err := allow(ctx, "SaveClusterMetadata", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "SaveClusterMetadata", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter, c.concurrencyLimiter)
+	if err != nil {
 		return false, err
 	}
+	defer release()
 	return c.persistence.SaveClusterMetadata(ctx, request)
 }
 
@@ -1089,9 +1262,11 @@ func (c *clusterMetadataRateLimitedPersistenceClient) DeleteClusterMetadata(
 	ctx context.Context,
 	request *DeleteClusterMetadataRequest,
 ) error {
-	if err := allow(ctx, "DeleteClusterMetadata", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "DeleteClusterMetadata", CallerSegmentMissing, c.systemRateLimiter, c.namespaceRateLimiter, c.shardRateLimiter, c.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 	return c.persistence.DeleteClusterMetadata(ctx, request)
 }
 
@@ -1107,9 +1282,11 @@ func (p *nexusEndpointRateLimitedPersistenceClient) GetNexusEndpoint(
 	ctx context.Context,
 	request *GetNexusEndpointRequest,
 ) (*persistencespb.NexusEndpointEntry, error) {
-	if err := allow(ctx, "GetNexusEndpoint", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "GetNexusEndpoint", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.GetNexusEndpoint(ctx, request)
 }
 
@@ -1117,9 +1294,11 @@ func (p *nexusEndpointRateLimitedPersistenceClient) ListNexusEndpoints(
 	ctx context.Context,
 	request *ListNexusEndpointsRequest,
 ) (*ListNexusEndpointsResponse, error) {
-	if err := allow(ctx, "ListNexusEndpoints", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "ListNexusEndpoints", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.ListNexusEndpoints(ctx, request)
 }
 
@@ -1127,9 +1306,11 @@ func (p *nexusEndpointRateLimitedPersistenceClient) CreateOrUpdateNexusEndpoint(
 	ctx context.Context,
 	request *CreateOrUpdateNexusEndpointRequest,
 ) (*CreateOrUpdateNexusEndpointResponse, error) {
-	if err := allow(ctx, "CreateOrUpdateNexusEndpoint", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "CreateOrUpdateNexusEndpoint", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return nil, err
 	}
+	defer release()
 	return p.persistence.CreateOrUpdateNexusEndpoint(ctx, request)
 }
 
@@ -1137,9 +1318,11 @@ func (p *nexusEndpointRateLimitedPersistenceClient) DeleteNexusEndpoint(
 	ctx context.Context,
 	request *DeleteNexusEndpointRequest,
 ) error {
-	if err := allow(ctx, "DeleteNexusEndpoint", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+	release, err := allow(ctx, "DeleteNexusEndpoint", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter, p.concurrencyLimiter)
+	if err != nil {
 		return err
 	}
+	defer release()
 	return p.persistence.DeleteNexusEndpoint(ctx, request)
 }
 
@@ -1150,7 +1333,8 @@ func allow(
 	systemRateLimiter quotas.RequestRateLimiter,
 	namespaceRateLimiter quotas.RequestRateLimiter,
 	shardRateLimiter quotas.RequestRateLimiter,
-) error {
+	concurrencyLimiter quotas.ConcurrencyLimiter,
+) (func(), error) {
 	callerInfo := headers.GetCallerInfo(ctx)
 	// namespace-level rate limits has to be applied before system-level rate limits.
 	now := time.Now().UTC()
@@ -1163,15 +1347,20 @@ func allow(
 		callerInfo.CallOrigin,
 	)
 	if ok := shardRateLimiter.Allow(now, quotaRequest); !ok {
-		return ErrPersistenceNamespaceShardLimitExceeded
+		return nil, ErrPersistenceNamespaceShardLimitExceeded
 	}
 	if ok := namespaceRateLimiter.Allow(now, quotaRequest); !ok {
-		return ErrPersistenceNamespaceLimitExceeded
+		return nil, ErrPersistenceNamespaceLimitExceeded
 	}
 	if ok := systemRateLimiter.Allow(now, quotaRequest); !ok {
-		return ErrPersistenceSystemLimitExceeded
+		return nil, ErrPersistenceSystemLimitExceeded
+	}
+	// concurrency limit is applied last, so that requests rejected by the rate limiters don't hold a slot.
+	release, ok := concurrencyLimiter.TryAcquire(quotaRequest)
+	if !ok {
+		return nil, ErrPersistenceSystemConcurrencyLimitExceeded
 	}
-	return nil
+	return release, nil
 }
 
 // TODO: change the value returned so it can also be used by
@@ -5,9 +5,11 @@ import (
 )
 
 const (
-	QueueTypeUnspecified   QueueV2Type = 0
-	QueueTypeHistoryNormal QueueV2Type = 1
-	QueueTypeHistoryDLQ    QueueV2Type = 2
+	QueueTypeUnspecified    QueueV2Type = 0
+	QueueTypeHistoryNormal  QueueV2Type = 1
+	QueueTypeHistoryDLQ     QueueV2Type = 2
+	QueueTypeCallbackDLQ    QueueV2Type = 3
+	QueueTypeWorkerRegistry QueueV2Type = 4
 
 	// FirstQueueMessageID is the ID of the first message written to a queue partition.
 	FirstQueueMessageID = 0
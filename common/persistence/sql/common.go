@@ -17,6 +17,10 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
+// maxSerializationFailureAttempts is the number of times a transaction is attempted when it keeps being aborted
+// because of conflicts with concurrent transactions.
+const maxSerializationFailureAttempts = 5
+
 // TODO: Rename all SQL Managers to Stores
 type SqlStore struct {
 	DB         sqlplugin.DB
@@ -50,9 +54,15 @@ func (m *SqlStore) Close() {
 }
 
 func (m *SqlStore) txExecute(ctx context.Context, operation string, f func(tx sqlplugin.Tx) error) error {
+	return retryOnSerializationFailure(ctx, func() (sqlplugin.Tx, error) {
+		return m.txExecuteOnce(ctx, operation, f)
+	})
+}
+
+func (m *SqlStore) txExecuteOnce(ctx context.Context, operation string, f func(tx sqlplugin.Tx) error) (sqlplugin.Tx, error) {
 	tx, err := m.DB.BeginTx(ctx)
 	if err != nil {
-		return serviceerror.NewUnavailablef("%s failed. Failed to start transaction. Error: %v", operation, err)
+		return nil, serviceerror.NewUnavailablef("%s failed. Failed to start transaction. Error: %v", operation, err)
 	}
 	err = f(tx)
 	if err != nil {
@@ -69,15 +79,30 @@ func (m *SqlStore) txExecute(ctx context.Context, operation string, f func(tx sq
 			*persistence.ShardOwnershipLostError,
 			*serviceerror.Unavailable,
 			*serviceerror.NotFound:
-			return err
+			return tx, err
 		default:
-			return serviceerror.NewUnavailablef("%v: %v", operation, err)
+			return tx, serviceerror.NewUnavailablef("%v: %v", operation, err)
 		}
 	}
 	if err := tx.Commit(); err != nil {
-		return serviceerror.NewUnavailablef("%s operation failed. Failed to commit transaction. Error: %v", operation, err)
+		return tx, serviceerror.NewUnavailablef("%s operation failed. Failed to commit transaction. Error: %v", operation, err)
+	}
+	return tx, nil
+}
+
+// retryOnSerializationFailure runs a transaction until it succeeds, or fails for another reason than a
+// serialization failure. Serialization failures are only reported by databases which run transactions with
+// SERIALIZABLE isolation (e.g. CockroachDB), and require the whole transaction to be retried.
+func retryOnSerializationFailure(ctx context.Context, op func() (sqlplugin.Tx, error)) error {
+	for attempt := 1; ; attempt++ {
+		tx, err := op()
+		if err == nil || attempt >= maxSerializationFailureAttempts || ctx.Err() != nil {
+			return err
+		}
+		if retryableTx, ok := tx.(sqlplugin.RetryableTx); !ok || !retryableTx.SerializationFailed() {
+			return err
+		}
 	}
-	return nil
 }
 
 func gobSerialize(x any) ([]byte, error) {
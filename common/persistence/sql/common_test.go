@@ -0,0 +1,66 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+type testRetryableTx struct {
+	sqlplugin.Tx
+	serializationFailed bool
+}
+
+func (tx *testRetryableTx) SerializationFailed() bool {
+	return tx.serializationFailed
+}
+
+func TestRetryOnSerializationFailure(t *testing.T) {
+	errTx := errors.New("transaction failed")
+
+	t.Run("retries serialization failures until success", func(t *testing.T) {
+		attempts := 0
+		err := retryOnSerializationFailure(context.Background(), func() (sqlplugin.Tx, error) {
+			attempts++
+			if attempts < 3 {
+				return &testRetryableTx{serializationFailed: true}, errTx
+			}
+			return &testRetryableTx{}, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		attempts := 0
+		err := retryOnSerializationFailure(context.Background(), func() (sqlplugin.Tx, error) {
+			attempts++
+			return &testRetryableTx{serializationFailed: true}, errTx
+		})
+		require.ErrorIs(t, err, errTx)
+		require.Equal(t, maxSerializationFailureAttempts, attempts)
+	})
+
+	t.Run("does not retry other failures", func(t *testing.T) {
+		attempts := 0
+		err := retryOnSerializationFailure(context.Background(), func() (sqlplugin.Tx, error) {
+			attempts++
+			return &testRetryableTx{}, errTx
+		})
+		require.ErrorIs(t, err, errTx)
+		require.Equal(t, 1, attempts)
+	})
+
+	t.Run("does not retry transactions which failed to start", func(t *testing.T) {
+		attempts := 0
+		err := retryOnSerializationFailure(context.Background(), func() (sqlplugin.Tx, error) {
+			attempts++
+			return nil, errTx
+		})
+		require.ErrorIs(t, err, errTx)
+		require.Equal(t, 1, attempts)
+	})
+}
@@ -97,6 +97,14 @@ type (
 		Rollback() error
 	}
 
+	// RetryableTx is implemented by transactions which can be aborted because of a conflict with a concurrent
+	// transaction, e.g. by CockroachDB which always runs transactions with SERIALIZABLE isolation. Such
+	// transactions can be retried from the beginning.
+	RetryableTx interface {
+		Tx
+		SerializationFailed() bool
+	}
+
 	// DB defines the API for regular SQL operations of a Temporal server
 	DB interface {
 		TableCRUD
@@ -12,9 +12,26 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql/driver"
 	"go.temporal.io/server/common/resolver"
+	cockroachdbschemaV23 "go.temporal.io/server/schema/cockroachdb/v23"
 	postgresqlschemaV12 "go.temporal.io/server/schema/postgresql/v12"
 )
 
+type schemaVersions struct {
+	main       string
+	visibility string
+}
+
+var (
+	postgresqlSchemaVersions = schemaVersions{
+		main:       postgresqlschemaV12.Version,
+		visibility: postgresqlschemaV12.VisibilityVersion,
+	}
+	cockroachDBSchemaVersions = schemaVersions{
+		main:       cockroachdbschemaV23.Version,
+		visibility: cockroachdbschemaV23.VisibilityVersion,
+	}
+)
+
 func (pdb *db) IsDupEntryError(err error) bool {
 	return pdb.dbDriver.IsDupEntryError(err)
 }
@@ -37,16 +54,19 @@ type db struct {
 
 	handle *sqlplugin.DatabaseHandle
 	tx     *sqlx.Tx
+	// set if a statement of tx failed because of a serialization failure
+	txSerializationFailed bool
 }
 
 var _ sqlplugin.DB = (*db)(nil)
+var _ sqlplugin.RetryableTx = (*db)(nil)
 
 // newDB returns an instance of DB, which is a logical
 // connection to the underlying postgresql database
 func newDB(
 	dbKind sqlplugin.DbKind,
 	dbName string,
-	dbDriver driver.Driver,
+	plugin *plugin,
 	handle *sqlplugin.DatabaseHandle,
 	tx *sqlx.Tx,
 	logger log.Logger,
@@ -54,7 +74,8 @@ func newDB(
 	mdb := &db{
 		dbKind:   dbKind,
 		dbName:   dbName,
-		dbDriver: dbDriver,
+		dbDriver: plugin.driver,
+		plugin:   plugin,
 		handle:   handle,
 		tx:       tx,
 		logger:   logger,
@@ -81,7 +102,7 @@ func (pdb *db) BeginTx(ctx context.Context) (sqlplugin.Tx, error) {
 	if err != nil {
 		return nil, pdb.handle.ConvertError(err)
 	}
-	return newDB(pdb.dbKind, pdb.dbName, pdb.dbDriver, pdb.handle, tx, pdb.logger), nil
+	return newDB(pdb.dbKind, pdb.dbName, pdb.plugin, pdb.handle, tx, pdb.logger), nil
 }
 
 // Close closes the connection to the mysql db
@@ -90,9 +111,9 @@ func (pdb *db) Close() error {
 	return nil
 }
 
-// PluginName returns the name of the mysql plugin
+// PluginName returns the name of the postgresql plugin
 func (pdb *db) PluginName() string {
-	return PluginName
+	return pdb.plugin.name
 }
 
 // DbName returns the name of the database
@@ -104,9 +125,9 @@ func (pdb *db) DbName() string {
 func (pdb *db) ExpectedVersion() string {
 	switch pdb.dbKind {
 	case sqlplugin.DbKindMain:
-		return postgresqlschemaV12.Version
+		return pdb.plugin.schemaVersions.main
 	case sqlplugin.DbKindVisibility:
-		return postgresqlschemaV12.VisibilityVersion
+		return pdb.plugin.schemaVersions.visibility
 	default:
 		panic(fmt.Sprintf("unknown db kind %v", pdb.dbKind))
 	}
@@ -120,7 +141,7 @@ func (pdb *db) VerifyVersion() error {
 
 // Commit commits a previously started transaction
 func (pdb *db) Commit() error {
-	return pdb.tx.Commit()
+	return pdb.convertTxError(pdb.tx.Commit())
 }
 
 // Rollback triggers rollback of a previously started transaction
@@ -128,15 +149,28 @@ func (pdb *db) Rollback() error {
 	return pdb.tx.Rollback()
 }
 
+// SerializationFailed returns true if the transaction was aborted because of a conflict with a concurrent
+// transaction, in which case it can be retried from the beginning
+func (pdb *db) SerializationFailed() bool {
+	return pdb.txSerializationFailed
+}
+
+func (pdb *db) convertTxError(err error) error {
+	if pdb.tx != nil && err != nil && pdb.dbDriver.IsSerializationFailureError(err) {
+		pdb.txSerializationFailed = true
+	}
+	return pdb.handle.ConvertError(err)
+}
+
 // Helper methods to hide common error handling
 func (pdb *db) ExecContext(ctx context.Context, stmt string, args ...any) (sql.Result, error) {
 	res, err := pdb.conn().ExecContext(ctx, stmt, args...)
-	return res, pdb.handle.ConvertError(err)
+	return res, pdb.convertTxError(err)
 }
 
 func (pdb *db) GetContext(ctx context.Context, dest any, query string, args ...any) error {
 	err := pdb.conn().GetContext(ctx, dest, query, args...)
-	return pdb.handle.ConvertError(err)
+	return pdb.convertTxError(err)
 }
 
 func (pdb *db) Select(dest any, query string, args ...any) error {
@@ -150,17 +184,17 @@ func (pdb *db) Select(dest any, query string, args ...any) error {
 
 func (pdb *db) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
 	err := pdb.conn().SelectContext(ctx, dest, query, args...)
-	return pdb.handle.ConvertError(err)
+	return pdb.convertTxError(err)
 }
 
 func (pdb *db) NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error) {
 	res, err := pdb.conn().NamedExecContext(ctx, query, arg)
-	return res, pdb.handle.ConvertError(err)
+	return res, pdb.convertTxError(err)
 }
 
 func (pdb *db) PrepareNamedContext(ctx context.Context, query string) (*sqlx.NamedStmt, error) {
 	stmt, err := pdb.conn().PrepareNamedContext(ctx, query)
-	return stmt, pdb.handle.ConvertError(err)
+	return stmt, pdb.convertTxError(err)
 }
 
 func (pdb *db) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
@@ -11,6 +11,7 @@ const (
 	readOnlyTransactionCode = "25006"
 	cannotConnectNowCode    = "57P03"
 	featureNotSupportedCode = "0A000"
+	serializationErrCode    = "40001"
 
 	// Unsupported "feature" messages to look for
 	cannotSetReadWriteModeDuringRecoveryMsg = "cannot set transaction read-write mode during recovery"
@@ -25,6 +26,9 @@ type Driver interface {
 	IsDupEntryError(error) bool
 	IsDupDatabaseError(error) bool
 	IsConnNeedsRefreshError(error) bool
+	// IsSerializationFailureError returns true if the transaction was aborted because of a conflict with
+	// a concurrent transaction. The whole transaction can be retried from the beginning.
+	IsSerializationFailureError(error) bool
 }
 
 func isConnNeedsRefreshError(code, message string) bool {
@@ -56,3 +56,8 @@ func (p *PGXDriver) IsConnNeedsRefreshError(err error) bool {
 	}
 	return isConnNeedsRefreshError(pqErr.Code, pqErr.Message)
 }
+
+func (p *PGXDriver) IsSerializationFailureError(err error) bool {
+	pgxErr, ok := err.(*pgconn.PgError)
+	return ok && pgxErr.Code == serializationErrCode
+}
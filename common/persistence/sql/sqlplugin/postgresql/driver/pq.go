@@ -50,3 +50,8 @@ func (p *PQDriver) IsConnNeedsRefreshError(err error) bool {
 	}
 	return isConnNeedsRefreshError(string(pqErr.Code), pqErr.Message)
 }
+
+func (p *PQDriver) IsSerializationFailureError(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == serializationErrCode
+}
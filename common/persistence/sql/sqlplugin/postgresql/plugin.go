@@ -21,6 +21,10 @@ const (
 	// PluginName is the name of the plugin
 	PluginName    = "postgres12"
 	PluginNamePGX = "postgres12_pgx"
+	// PluginNameCockroachDB is the name of the CockroachDB plugin. CockroachDB speaks the PostgreSQL wire
+	// protocol, so it shares the queries of the PostgreSQL plugin, but uses its own schema and always runs
+	// transactions with SERIALIZABLE isolation.
+	PluginNameCockroachDB = "cockroachdb"
 )
 
 var defaultDatabaseNames = []string{
@@ -29,20 +33,32 @@ var defaultDatabaseNames = []string{
 }
 
 type plugin struct {
+	name           string
 	driver         driver.Driver
 	queryConverter sqlplugin.VisibilityQueryConverter
+	schemaVersions schemaVersions
 }
 
 var _ sqlplugin.Plugin = (*plugin)(nil)
 
 func init() {
 	sql.RegisterPlugin(PluginName, &plugin{
+		name:           PluginName,
 		driver:         &driver.PQDriver{},
 		queryConverter: &queryConverter{},
+		schemaVersions: postgresqlSchemaVersions,
 	})
 	sql.RegisterPlugin(PluginNamePGX, &plugin{
+		name:           PluginName,
 		driver:         &driver.PGXDriver{},
 		queryConverter: &queryConverter{},
+		schemaVersions: postgresqlSchemaVersions,
+	})
+	sql.RegisterPlugin(PluginNameCockroachDB, &plugin{
+		name:           PluginNameCockroachDB,
+		driver:         &driver.PGXDriver{},
+		queryConverter: &queryConverter{},
+		schemaVersions: cockroachDBSchemaVersions,
 	})
 }
 
@@ -66,7 +82,7 @@ func (p *plugin) CreateDB(
 	}
 	needsRefresh := p.driver.IsConnNeedsRefreshError
 	handle := sqlplugin.NewDatabaseHandle(dbKind, connect, needsRefresh, logger, metricsHandler, clock.NewRealTimeSource())
-	db := newDB(dbKind, cfg.DatabaseName, p.driver, handle, nil, logger)
+	db := newDB(dbKind, cfg.DatabaseName, p, handle, nil, logger)
 	return db, nil
 }
 
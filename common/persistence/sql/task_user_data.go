@@ -155,9 +155,15 @@ func (uds *userDataStore) CountTaskQueuesByBuildId(ctx context.Context, request
 }
 
 func (uds *userDataStore) txExecute(ctx context.Context, operation string, f func(tx sqlplugin.Tx) error) error {
+	return retryOnSerializationFailure(ctx, func() (sqlplugin.Tx, error) {
+		return uds.txExecuteOnce(ctx, operation, f)
+	})
+}
+
+func (uds *userDataStore) txExecuteOnce(ctx context.Context, operation string, f func(tx sqlplugin.Tx) error) (sqlplugin.Tx, error) {
 	tx, err := uds.DB.BeginTx(ctx)
 	if err != nil {
-		return serviceerror.NewUnavailablef("%s failed. Failed to start transaction. Error: %v", operation, err)
+		return nil, serviceerror.NewUnavailablef("%s failed. Failed to start transaction. Error: %v", operation, err)
 	}
 	err = f(tx)
 	if err != nil {
@@ -174,13 +180,13 @@ func (uds *userDataStore) txExecute(ctx context.Context, operation string, f fun
 			*persistence.ShardOwnershipLostError,
 			*serviceerror.Unavailable,
 			*serviceerror.NotFound:
-			return err
+			return tx, err
 		default:
-			return serviceerror.NewUnavailablef("%v: %v", operation, err)
+			return tx, serviceerror.NewUnavailablef("%v: %v", operation, err)
 		}
 	}
 	if err := tx.Commit(); err != nil {
-		return serviceerror.NewUnavailablef("%s operation failed. Failed to commit transaction. Error: %v", operation, err)
+		return tx, serviceerror.NewUnavailablef("%s operation failed. Failed to commit transaction. Error: %v", operation, err)
 	}
-	return nil
+	return tx, nil
 }
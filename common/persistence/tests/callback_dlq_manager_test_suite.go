@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+)
+
+// RunCallbackDLQManagerTestSuite runs all tests for the callback DLQ manager against a given queue provided by a
+// particular database.
+func RunCallbackDLQManagerTestSuite(t *testing.T, queue persistence.QueueV2) {
+	manager := persistence.NewCallbackDLQManager(queue)
+	t.Run("EnqueueReadDelete", func(t *testing.T) {
+		t.Parallel()
+		testCallbackDLQManagerEnqueueReadDelete(t, manager)
+	})
+	t.Run("UnknownNamespace", func(t *testing.T) {
+		t.Parallel()
+		testCallbackDLQManagerUnknownNamespace(t, manager)
+	})
+	t.Run("InvalidRequests", func(t *testing.T) {
+		t.Parallel()
+		testCallbackDLQManagerInvalidRequests(t, manager)
+	})
+}
+
+func testCallbackDLQManagerEnqueueReadDelete(t *testing.T, manager persistence.CallbackDLQManager) {
+	ctx := context.Background()
+	namespaceID := uuid.NewString()
+	otherNamespaceID := uuid.NewString()
+
+	for i, nsID := range []string{namespaceID, namespaceID, otherNamespaceID, namespaceID} {
+		_, err := manager.EnqueueCallback(ctx, &persistence.EnqueueCallbackRequest{
+			Callback: &persistencespb.DeadLetteredCallback{
+				NamespaceId: nsID,
+				BusinessId:  "workflow",
+				Attempt:     int32(i),
+				Request: &persistencespb.DeadLetteredCallback_HttpRequest{
+					Method: "POST",
+					Url:    "http://localhost/callback",
+					Body:   []byte("body"),
+				},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := manager.ReadCallbacks(ctx, &persistence.ReadCallbacksRequest{
+		NamespaceID: namespaceID,
+		PageSize:    2,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Callbacks, 2)
+	require.Equal(t, int64(persistence.FirstQueueMessageID), resp.Callbacks[0].MessageMetadata.ID)
+	require.Equal(t, int32(0), resp.Callbacks[0].Callback.GetAttempt())
+	require.Equal(t, []byte("body"), resp.Callbacks[0].Callback.GetRequest().GetBody())
+
+	resp, err = manager.ReadCallbacks(ctx, &persistence.ReadCallbacksRequest{
+		NamespaceID:   namespaceID,
+		PageSize:      2,
+		NextPageToken: resp.NextPageToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Callbacks, 1)
+	require.Equal(t, int32(3), resp.Callbacks[0].Callback.GetAttempt())
+
+	resp, err = manager.ReadCallbacks(ctx, &persistence.ReadCallbacksRequest{
+		NamespaceID:  namespaceID,
+		PageSize:     1,
+		MinMessageID: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Callbacks, 1)
+	require.Equal(t, int64(1), resp.Callbacks[0].MessageMetadata.ID)
+	require.Equal(t, int32(1), resp.Callbacks[0].Callback.GetAttempt())
+
+	deleted, err := manager.DeleteCallbacks(ctx, &persistence.DeleteCallbacksRequest{
+		NamespaceID:                 namespaceID,
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted.MessagesDeleted)
+
+	resp, err = manager.ReadCallbacks(ctx, &persistence.ReadCallbacksRequest{
+		NamespaceID: namespaceID,
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Callbacks, 1)
+	require.Equal(t, int32(3), resp.Callbacks[0].Callback.GetAttempt())
+
+	resp, err = manager.ReadCallbacks(ctx, &persistence.ReadCallbacksRequest{
+		NamespaceID: otherNamespaceID,
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Callbacks, 1)
+	require.Equal(t, int32(2), resp.Callbacks[0].Callback.GetAttempt())
+}
+
+func testCallbackDLQManagerUnknownNamespace(t *testing.T, manager persistence.CallbackDLQManager) {
+	ctx := context.Background()
+	namespaceID := uuid.NewString()
+
+	resp, err := manager.ReadCallbacks(ctx, &persistence.ReadCallbacksRequest{
+		NamespaceID: namespaceID,
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Callbacks)
+
+	deleted, err := manager.DeleteCallbacks(ctx, &persistence.DeleteCallbacksRequest{
+		NamespaceID:                 namespaceID,
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: 10},
+	})
+	require.NoError(t, err)
+	require.Zero(t, deleted.MessagesDeleted)
+}
+
+func testCallbackDLQManagerInvalidRequests(t *testing.T, manager persistence.CallbackDLQManager) {
+	ctx := context.Background()
+
+	_, err := manager.EnqueueCallback(ctx, &persistence.EnqueueCallbackRequest{})
+	require.ErrorIs(t, err, persistence.ErrEnqueueCallbackRequestCallbackIsNil)
+
+	_, err = manager.EnqueueCallback(ctx, &persistence.EnqueueCallbackRequest{
+		Callback: &persistencespb.DeadLetteredCallback{},
+	})
+	require.ErrorIs(t, err, persistence.ErrCallbackNamespaceIDNotSet)
+
+	_, err = manager.ReadCallbacks(ctx, &persistence.ReadCallbacksRequest{
+		NamespaceID: uuid.NewString(),
+	})
+	require.ErrorIs(t, err, persistence.ErrNonPositiveReadQueueMessagesPageSize)
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"math"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -12,7 +13,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
-	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql" // register plugins
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
 	sqltests "go.temporal.io/server/common/persistence/sql/sqlplugin/tests"
 	"go.temporal.io/server/common/resolver"
 )
@@ -23,6 +24,13 @@ type PostgreSQLSuite struct {
 	connectAttrs map[string]string
 }
 
+func (p *PostgreSQLSuite) testClusterOption() *persistencetests.TestBaseOptions {
+	if p.pluginName == postgresql.PluginNameCockroachDB {
+		return persistencetests.GetCockroachDBTestClusterOption()
+	}
+	return persistencetests.GetPostgreSQLTestClusterOption()
+}
+
 func (p *PostgreSQLSuite) TestPostgreSQLShardStoreSuite() {
 	testData, tearDown := setUpPostgreSQLTest(p.T(), p.pluginName, p.connectAttrs)
 	defer tearDown()
@@ -167,7 +175,7 @@ func (p *PostgreSQLSuite) TestPostgreSQLTaskQueueUserDataSuite() {
 
 func (p *PostgreSQLSuite) TestPostgreSQLVisibilityPersistenceSuite() {
 	s := &VisibilityPersistenceSuite{
-		TestBase: persistencetests.NewTestBaseWithSQL(persistencetests.GetPostgreSQLTestClusterOption()),
+		TestBase: persistencetests.NewTestBaseWithSQL(p.testClusterOption()),
 	}
 	suite.Run(p.T(), s)
 }
@@ -176,28 +184,28 @@ func (p *PostgreSQLSuite) TestPostgreSQLVisibilityPersistenceSuite() {
 
 func (p *PostgreSQLSuite) TestPostgreSQLHistoryV2PersistenceSuite() {
 	s := new(persistencetests.HistoryV2PersistenceSuite)
-	s.TestBase = persistencetests.NewTestBaseWithSQL(persistencetests.GetPostgreSQLTestClusterOption())
+	s.TestBase = persistencetests.NewTestBaseWithSQL(p.testClusterOption())
 	s.TestBase.Setup(nil)
 	suite.Run(p.T(), s)
 }
 
 func (p *PostgreSQLSuite) TestPostgreSQLMetadataPersistenceSuiteV2() {
 	s := new(persistencetests.MetadataPersistenceSuiteV2)
-	s.TestBase = persistencetests.NewTestBaseWithSQL(persistencetests.GetPostgreSQLTestClusterOption())
+	s.TestBase = persistencetests.NewTestBaseWithSQL(p.testClusterOption())
 	s.TestBase.Setup(nil)
 	suite.Run(p.T(), s)
 }
 
 func (p *PostgreSQLSuite) TestPostgreSQLClusterMetadataPersistence() {
 	s := new(persistencetests.ClusterMetadataManagerSuite)
-	s.TestBase = persistencetests.NewTestBaseWithSQL(persistencetests.GetPostgreSQLTestClusterOption())
+	s.TestBase = persistencetests.NewTestBaseWithSQL(p.testClusterOption())
 	s.TestBase.Setup(nil)
 	suite.Run(p.T(), s)
 }
 
 func (p *PostgreSQLSuite) TestPostgreSQLQueuePersistence() {
 	s := new(persistencetests.QueuePersistenceSuite)
-	s.TestBase = persistencetests.NewTestBaseWithSQL(persistencetests.GetPostgreSQLTestClusterOption())
+	s.TestBase = persistencetests.NewTestBaseWithSQL(p.testClusterOption())
 	s.TestBase.Setup(nil)
 	suite.Run(p.T(), s)
 }
@@ -696,6 +704,18 @@ func TestPGX(t *testing.T) {
 	suite.Run(t, s)
 }
 
+// TestCockroachDB runs the PostgreSQL suites against CockroachDB, which shares the queries of the PostgreSQL
+// plugin but has its own schema and transaction retry semantics. It requires a running instance, e.g. the
+// single-node one from develop/docker-compose with COCKROACHDB_SEEDS=127.0.0.1.
+func TestCockroachDB(t *testing.T) {
+	if _, ok := os.LookupEnv("COCKROACHDB_SEEDS"); !ok {
+		t.Skip("COCKROACHDB_SEEDS is not set, skipping CockroachDB persistence tests")
+	}
+	t.Parallel()
+	s := &PostgreSQLSuite{pluginName: postgresql.PluginNameCockroachDB}
+	suite.Run(t, s)
+}
+
 // TestPGXSP exercises the pgx plugin in simple-protocol mode, the
 // configuration users land on when fronting Postgres with PgBouncer in
 // transaction pooling. In that mode pgx text-encodes parameters client-side
@@ -14,6 +14,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/shuffle"
 	"go.temporal.io/server/temporal/environment"
@@ -34,6 +35,10 @@ const (
 	//  need to merge persistence test config / initialization in one place
 	testPostgreSQLExecutionSchema  = "../../../schema/postgresql/v12/temporal/schema.sql"
 	testPostgreSQLVisibilitySchema = "../../../schema/postgresql/v12/visibility/schema.sql"
+
+	testCockroachDBUser             = "root"
+	testCockroachDBExecutionSchema  = "../../../schema/cockroachdb/v23/temporal/schema.sql"
+	testCockroachDBVisibilitySchema = "../../../schema/cockroachdb/v23/visibility/schema.sql"
 )
 
 type (
@@ -72,8 +77,21 @@ func setUpPostgreSQLTest(t *testing.T, pluginName string, connectAttrs map[strin
 	return testData, tearDown
 }
 
-// NewPostgreSQLConfig returns a new MySQL config for test
+// NewPostgreSQLConfig returns a new PostgreSQL config for test
 func NewPostgreSQLConfig(pluginName string, connectAttrs map[string]string) *config.SQL {
+	if pluginName == postgresql.PluginNameCockroachDB {
+		return &config.SQL{
+			User: testCockroachDBUser,
+			ConnectAddr: net.JoinHostPort(
+				environment.GetCockroachDBAddress(),
+				strconv.Itoa(environment.GetCockroachDBPort()),
+			),
+			ConnectProtocol:   testPostgreSQLConnectionProtocol,
+			PluginName:        pluginName,
+			DatabaseName:      testPostgreSQLDatabaseNamePrefix + shuffle.String(testPostgreSQLDatabaseNameSuffix),
+			ConnectAttributes: connectAttrs,
+		}
+	}
 	return &config.SQL{
 		User:     testPostgreSQLUser,
 		Password: testPostgreSQLPassword,
@@ -112,7 +130,12 @@ func SetupPostgreSQLSchema(t *testing.T, cfg *config.SQL) {
 	}
 	defer func() { _ = db.Close() }()
 
-	schemaPath, err := filepath.Abs(testPostgreSQLExecutionSchema)
+	executionSchema, visibilitySchema := testPostgreSQLExecutionSchema, testPostgreSQLVisibilitySchema
+	if cfg.PluginName == postgresql.PluginNameCockroachDB {
+		executionSchema, visibilitySchema = testCockroachDBExecutionSchema, testCockroachDBVisibilitySchema
+	}
+
+	schemaPath, err := filepath.Abs(executionSchema)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -128,7 +151,7 @@ func SetupPostgreSQLSchema(t *testing.T, cfg *config.SQL) {
 		}
 	}
 
-	schemaPath, err = filepath.Abs(testPostgreSQLVisibilitySchema)
+	schemaPath, err = filepath.Abs(visibilitySchema)
 	if err != nil {
 		t.Fatal(err)
 	}
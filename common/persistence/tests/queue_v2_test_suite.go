@@ -130,6 +130,14 @@ func RunQueueV2TestSuite(t *testing.T, q persistence.QueueV2) {
 		t.Parallel()
 		RunHistoryTaskQueueManagerTestSuite(t, q)
 	})
+	t.Run("CallbackDLQManagerImpl", func(t *testing.T) {
+		t.Parallel()
+		RunCallbackDLQManagerTestSuite(t, q)
+	})
+	t.Run("WorkerRegistryManagerImpl", func(t *testing.T) {
+		t.Parallel()
+		RunWorkerRegistryManagerTestSuite(t, q)
+	})
 }
 
 func testHappyPath(
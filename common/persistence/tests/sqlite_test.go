@@ -6,6 +6,7 @@ import (
 	"math"
 	"os"
 	"path"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/uuid"
@@ -1442,6 +1443,7 @@ func TestSQLiteFileVisibilitySuite(t *testing.T) {
 func TestSQLiteQueueV2(t *testing.T) {
 	t.Parallel()
 	cfg := NewSQLiteFileConfig()
+	cfg.DatabaseName = filepath.Join(t.TempDir(), cfg.DatabaseName)
 	SetupSQLiteDatabase(t, cfg)
 	logger := log.NewNoopLogger()
 	factory := sql.NewFactory(
@@ -1454,7 +1456,6 @@ func TestSQLiteQueueV2(t *testing.T) {
 	)
 	t.Cleanup(func() {
 		factory.Close()
-		assert.NoError(t, os.Remove(cfg.DatabaseName))
 	})
 	RunQueueV2TestSuiteForSQL(t, factory)
 }
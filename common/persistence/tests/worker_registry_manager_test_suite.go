@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	workerpb "go.temporal.io/api/worker/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+)
+
+// RunWorkerRegistryManagerTestSuite runs all tests for the worker registry manager against a given queue provided by a
+// particular database.
+func RunWorkerRegistryManagerTestSuite(t *testing.T, queue persistence.QueueV2) {
+	manager := persistence.NewWorkerRegistryManager(queue)
+	t.Run("SaveLoad", func(t *testing.T) {
+		t.Parallel()
+		testWorkerRegistryManagerSaveLoad(t, manager)
+	})
+	t.Run("UnknownNamespace", func(t *testing.T) {
+		t.Parallel()
+		testWorkerRegistryManagerUnknownNamespace(t, manager)
+	})
+	t.Run("InvalidRequests", func(t *testing.T) {
+		t.Parallel()
+		testWorkerRegistryManagerInvalidRequests(t, manager)
+	})
+}
+
+func testWorkerRegistryManagerSaveLoad(t *testing.T, manager persistence.WorkerRegistryManager) {
+	ctx := context.Background()
+	namespaceID := uuid.NewString()
+	otherNamespaceID := uuid.NewString()
+
+	snapshot := func(workerInstanceKeys ...string) *persistencespb.WorkerRegistrySnapshot {
+		s := &persistencespb.WorkerRegistrySnapshot{}
+		for _, key := range workerInstanceKeys {
+			s.Workers = append(s.Workers, &persistencespb.WorkerRegistryEntry{
+				Heartbeat: &workerpb.WorkerHeartbeat{WorkerInstanceKey: key},
+			})
+		}
+		return s
+	}
+
+	for _, s := range []struct {
+		namespaceID string
+		snapshot    *persistencespb.WorkerRegistrySnapshot
+	}{
+		{namespaceID, snapshot("worker1")},
+		{otherNamespaceID, snapshot("worker2")},
+		{namespaceID, snapshot("worker1", "worker3")},
+	} {
+		_, err := manager.SaveWorkerRegistrySnapshot(ctx, &persistence.SaveWorkerRegistrySnapshotRequest{
+			NamespaceID: s.namespaceID,
+			Snapshot:    s.snapshot,
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := manager.LoadWorkerRegistrySnapshot(ctx, &persistence.LoadWorkerRegistrySnapshotRequest{
+		NamespaceID: namespaceID,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Snapshot.GetWorkers(), 2)
+	require.Equal(t, "worker1", resp.Snapshot.GetWorkers()[0].GetHeartbeat().GetWorkerInstanceKey())
+	require.Equal(t, "worker3", resp.Snapshot.GetWorkers()[1].GetHeartbeat().GetWorkerInstanceKey())
+
+	resp, err = manager.LoadWorkerRegistrySnapshot(ctx, &persistence.LoadWorkerRegistrySnapshotRequest{
+		NamespaceID: otherNamespaceID,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Snapshot.GetWorkers(), 1)
+	require.Equal(t, "worker2", resp.Snapshot.GetWorkers()[0].GetHeartbeat().GetWorkerInstanceKey())
+}
+
+func testWorkerRegistryManagerUnknownNamespace(t *testing.T, manager persistence.WorkerRegistryManager) {
+	ctx := context.Background()
+
+	resp, err := manager.LoadWorkerRegistrySnapshot(ctx, &persistence.LoadWorkerRegistrySnapshotRequest{
+		NamespaceID: uuid.NewString(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, resp.Snapshot)
+}
+
+func testWorkerRegistryManagerInvalidRequests(t *testing.T, manager persistence.WorkerRegistryManager) {
+	ctx := context.Background()
+
+	_, err := manager.SaveWorkerRegistrySnapshot(ctx, &persistence.SaveWorkerRegistrySnapshotRequest{
+		NamespaceID: uuid.NewString(),
+	})
+	require.ErrorIs(t, err, persistence.ErrSaveWorkerRegistrySnapshotIsNil)
+
+	_, err = manager.SaveWorkerRegistrySnapshot(ctx, &persistence.SaveWorkerRegistrySnapshotRequest{
+		Snapshot: &persistencespb.WorkerRegistrySnapshot{},
+	})
+	require.ErrorIs(t, err, persistence.ErrWorkerRegistryNamespaceIDNotSet)
+
+	_, err = manager.LoadWorkerRegistrySnapshot(ctx, &persistence.LoadWorkerRegistrySnapshotRequest{})
+	require.ErrorIs(t, err, persistence.ErrWorkerRegistryNamespaceIDNotSet)
+}
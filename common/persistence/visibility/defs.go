@@ -25,7 +25,7 @@ func AllowListForValidation(
 	}
 
 	switch storeNames[0] {
-	case mysql.PluginName, postgresql.PluginName, postgresql.PluginNamePGX, sqlite.PluginName:
+	case mysql.PluginName, postgresql.PluginName, postgresql.PluginNamePGX, postgresql.PluginNameCockroachDB, sqlite.PluginName:
 		// Advanced visibility with SQL DB don't support list of values
 		return dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
 	default:
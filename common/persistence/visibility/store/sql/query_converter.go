@@ -264,9 +264,10 @@ func (c *SQLQueryConverter) ConvertIsExpr(
 
 func (c *SQLQueryConverter) buildValueExpr(name string, value any) (sqlparser.Expr, error) {
 	// ExecutionStatus is stored as an integer in SQL database.
-	if name == sadefs.ExecutionStatus {
+	// Tuples are handled below converting each item.
+	if v, ok := value.(string); ok && name == sadefs.ExecutionStatus {
 		// Query converter already validates the value, so there should not be any errors here.
-		status, _ := enumspb.WorkflowExecutionStatusFromString(value.(string))
+		status, _ := enumspb.WorkflowExecutionStatusFromString(v)
 		return sqlparser.NewIntVal([]byte(strconv.FormatInt(int64(status), 10))), nil
 	}
 
@@ -22,7 +22,7 @@ func NewQueryConverterLegacy(
 	switch pluginName {
 	case mysql.PluginName:
 		return newMySQLQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString, chasmMapper, archetypeID)
-	case postgresql.PluginName, postgresql.PluginNamePGX:
+	case postgresql.PluginName, postgresql.PluginNamePGX, postgresql.PluginNameCockroachDB:
 		return newPostgreSQLQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString, chasmMapper, archetypeID)
 	case sqlite.PluginName:
 		return newSqliteQueryConverter(namespaceName, namespaceID, saTypeMap, saMapper, queryString, chasmMapper, archetypeID)
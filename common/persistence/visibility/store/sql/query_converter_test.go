@@ -11,6 +11,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute/sadefs"
 	"go.uber.org/mock/gomock"
 )
 
@@ -968,6 +969,7 @@ func TestSQLQueryConverter_BuildValueExpr(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
 		name      string
+		fieldName string
 		in        any
 		out       sqlparser.Expr
 		errString string
@@ -1001,6 +1003,21 @@ func TestSQLQueryConverter_BuildValueExpr(t *testing.T) {
 				sqlparser.BoolVal(true),
 			},
 		},
+		{
+			name:      "execution status",
+			fieldName: sadefs.ExecutionStatus,
+			in:        "Running",
+			out:       sqlparser.NewIntVal([]byte("1")),
+		},
+		{
+			name:      "execution status tuple",
+			fieldName: sadefs.ExecutionStatus,
+			in:        []any{"Running", "Completed"},
+			out: sqlparser.ValTuple{
+				sqlparser.NewIntVal([]byte("1")),
+				sqlparser.NewIntVal([]byte("2")),
+			},
+		},
 		{
 			name:      "error int",
 			in:        123,
@@ -1032,7 +1049,11 @@ func TestSQLQueryConverter_BuildValueExpr(t *testing.T) {
 				VisibilityQueryConverter: pluginVisQCMock,
 			}
 
-			out, err := queryConverter.buildValueExpr("CustomField", tc.in)
+			fieldName := tc.fieldName
+			if fieldName == "" {
+				fieldName = "CustomField"
+			}
+			out, err := queryConverter.buildValueExpr(fieldName, tc.in)
 			if tc.errString != "" {
 				r.Error(err)
 				var expectedErr *query.ConverterError
@@ -0,0 +1,145 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	ErrMsgSerializeWorkerRegistrySnapshot   = "failed to serialize worker registry snapshot"
+	ErrMsgDeserializeWorkerRegistrySnapshot = "failed to deserialize worker registry snapshot"
+
+	workerRegistryReadPageSize = 10
+)
+
+var (
+	ErrSaveWorkerRegistrySnapshotIsNil = errors.New("save worker registry snapshot request snapshot is nil")
+	ErrWorkerRegistryNamespaceIDNotSet = errors.New("worker registry namespace ID is not set")
+)
+
+// WorkerRegistryManagerImpl stores the worker registry snapshots of each namespace in a queue of its own. Saving a
+// snapshot enqueues it and deletes the snapshots before it, so that loading only needs the last message.
+type WorkerRegistryManagerImpl struct {
+	queue QueueV2
+	// saveMutex is a map[string]*sync.Mutex keyed by queue name. Saves to the same queue are serialized within the
+	// process to avoid conflicting writes of the queue metadata.
+	saveMutex sync.Map
+}
+
+func NewWorkerRegistryManager(queue QueueV2) *WorkerRegistryManagerImpl {
+	return &WorkerRegistryManagerImpl{
+		queue: queue,
+	}
+}
+
+// GetWorkerRegistryQueueName returns the name of the queue that holds the worker registry snapshots of a namespace.
+func GetWorkerRegistryQueueName(namespaceID string) string {
+	return namespaceID
+}
+
+func (m *WorkerRegistryManagerImpl) SaveWorkerRegistrySnapshot(
+	ctx context.Context,
+	request *SaveWorkerRegistrySnapshotRequest,
+) (*SaveWorkerRegistrySnapshotResponse, error) {
+	if request.Snapshot == nil {
+		return nil, ErrSaveWorkerRegistrySnapshotIsNil
+	}
+	if request.NamespaceID == "" {
+		return nil, ErrWorkerRegistryNamespaceIDNotSet
+	}
+	data, err := request.Snapshot.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", ErrMsgSerializeWorkerRegistrySnapshot, err)
+	}
+	queueName := GetWorkerRegistryQueueName(request.NamespaceID)
+
+	mu, _ := m.saveMutex.LoadOrStore(queueName, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	defer mu.(*sync.Mutex).Unlock()
+
+	_, err = m.queue.CreateQueue(ctx, &InternalCreateQueueRequest{
+		QueueType: QueueTypeWorkerRegistry,
+		QueueName: queueName,
+	})
+	if err != nil && !errors.Is(err, ErrQueueAlreadyExists) {
+		return nil, err
+	}
+	resp, err := m.queue.EnqueueMessage(ctx, &InternalEnqueueMessageRequest{
+		QueueType: QueueTypeWorkerRegistry,
+		QueueName: queueName,
+		Blob: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         data,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Metadata.ID > FirstQueueMessageID {
+		// The new snapshot supersedes all previous ones.
+		_, err = m.queue.RangeDeleteMessages(ctx, &InternalRangeDeleteMessagesRequest{
+			QueueType:                   QueueTypeWorkerRegistry,
+			QueueName:                   queueName,
+			InclusiveMaxMessageMetadata: MessageMetadata{ID: resp.Metadata.ID - 1},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &SaveWorkerRegistrySnapshotResponse{}, nil
+}
+
+func (m *WorkerRegistryManagerImpl) LoadWorkerRegistrySnapshot(
+	ctx context.Context,
+	request *LoadWorkerRegistrySnapshotRequest,
+) (*LoadWorkerRegistrySnapshotResponse, error) {
+	if request.NamespaceID == "" {
+		return nil, ErrWorkerRegistryNamespaceIDNotSet
+	}
+
+	// Older snapshots are left behind only if deleting them failed, so this usually reads a single page.
+	var last *QueueV2Message
+	var nextPageToken []byte
+	for {
+		response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+			QueueType:     QueueTypeWorkerRegistry,
+			QueueName:     GetWorkerRegistryQueueName(request.NamespaceID),
+			PageSize:      workerRegistryReadPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				return &LoadWorkerRegistrySnapshotResponse{}, nil
+			}
+			return nil, err
+		}
+		if len(response.Messages) > 0 {
+			last = &response.Messages[len(response.Messages)-1]
+		}
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	if last == nil {
+		return &LoadWorkerRegistrySnapshotResponse{}, nil
+	}
+
+	snapshot := &persistencespb.WorkerRegistrySnapshot{}
+	if err := serialization.Decode(last.Data, snapshot); err != nil {
+		return nil, fmt.Errorf("%v: %w", ErrMsgDeserializeWorkerRegistrySnapshot, err)
+	}
+	return &LoadWorkerRegistrySnapshotResponse{Snapshot: snapshot}, nil
+}
+
+func (m *WorkerRegistryManagerImpl) Close() {
+}
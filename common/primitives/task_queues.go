@@ -16,10 +16,12 @@ const (
 	internalTaskQueuePrefix              = "temporal-sys-"
 	internalTaskQueuePerNSPrefix         = "temporal-sys-per-ns-"
 
-	MigrationActivityTQ           = "temporal-sys-migration-activity-tq"
-	AddSearchAttributesActivityTQ = "temporal-sys-add-search-attributes-activity-tq"
-	DeleteNamespaceActivityTQ     = "temporal-sys-delete-namespace-activity-tq"
-	DLQActivityTQ                 = "temporal-sys-dlq-activity-tq"
+	MigrationActivityTQ                = "temporal-sys-migration-activity-tq"
+	AddSearchAttributesActivityTQ      = "temporal-sys-add-search-attributes-activity-tq"
+	DeleteNamespaceActivityTQ          = "temporal-sys-delete-namespace-activity-tq"
+	DLQActivityTQ                      = "temporal-sys-dlq-activity-tq"
+	VisibilityBackfillActivityTQ       = "temporal-sys-visibility-backfill-activity-tq"
+	SearchAttributeMigrationActivityTQ = "temporal-sys-search-attribute-migration-activity-tq"
 )
 
 // IsInternalTaskQueueKind returns true if the task queue kind identifies a
@@ -0,0 +1,24 @@
+package quotas
+
+type (
+	// ConcurrencyLimiter limits the number of requests that are processed at the same time.
+	ConcurrencyLimiter interface {
+		// TryAcquire attempts to admit a request. The method returns immediately with
+		// a false if the request can not make progress. Otherwise, the returned release
+		// func must be called exactly once when the request is done.
+		TryAcquire(request Request) (release func(), ok bool)
+	}
+
+	// NoopConcurrencyLimiterImpl is a no-op implementation for ConcurrencyLimiter
+	NoopConcurrencyLimiterImpl struct{}
+)
+
+var NoopConcurrencyLimiter ConcurrencyLimiter = &NoopConcurrencyLimiterImpl{}
+
+func noopRelease() {}
+
+func (l *NoopConcurrencyLimiterImpl) TryAcquire(
+	_ Request,
+) (func(), bool) {
+	return noopRelease, true
+}
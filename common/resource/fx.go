@@ -19,6 +19,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/chaos"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -83,6 +84,7 @@ var Module = fx.Options(
 	serialization.Module,
 	fx.Provide(HostNameProvider),
 	fx.Provide(TimeSourceProvider),
+	fx.Provide(FaultRegistryProvider),
 	cluster.MetadataLifetimeHooksModule,
 	fx.Provide(SearchAttributeMapperProviderProvider),
 	fx.Provide(SearchAttributeProviderProvider),
@@ -399,13 +401,30 @@ func DCRedirectionPolicyProvider(cfg *config.Config) config.DCRedirectionPolicy
 
 func PerServiceDialOptionsProvider(
 	logger log.SnTaggedLogger,
+	faultRegistry *chaos.Registry,
 ) map[primitives.ServiceName][]grpc.DialOption {
 	trailerInterceptor := interceptor.TrailerToContextMetadataInterceptor(logger)
-	dialOpt := grpc.WithChainUnaryInterceptor(trailerInterceptor)
-	return map[primitives.ServiceName][]grpc.DialOption{
-		primitives.HistoryService:  {dialOpt},
-		primitives.MatchingService: {dialOpt},
+	dialOpts := make(map[primitives.ServiceName][]grpc.DialOption)
+	for _, serviceName := range []primitives.ServiceName{primitives.HistoryService, primitives.MatchingService} {
+		interceptors := []grpc.UnaryClientInterceptor{trailerInterceptor}
+		if faultRegistry.Instrumented() {
+			interceptors = append(interceptors, faultRegistry.UnaryClientInterceptor(serviceName))
+		}
+		dialOpts[serviceName] = []grpc.DialOption{grpc.WithChainUnaryInterceptor(interceptors...)}
 	}
+	return dialOpts
+}
+
+func FaultRegistryProvider(
+	serviceName primitives.ServiceName,
+	dynamicCollection *dynamicconfig.Collection,
+	timeSource clock.TimeSource,
+) *chaos.Registry {
+	return chaos.NewRegistry(
+		serviceName,
+		dynamicconfig.EnableRuntimeFaultInjection.Get(dynamicCollection),
+		timeSource,
+	)
 }
 
 func RPCFactoryProvider(
@@ -29,10 +29,18 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.CloseShardResponse:
 		return nil
+	case *adminservice.CreateWorkflowRuleRequest:
+		return nil
+	case *adminservice.CreateWorkflowRuleResponse:
+		return nil
 	case *adminservice.DeepHealthCheckRequest:
 		return nil
 	case *adminservice.DeepHealthCheckResponse:
 		return nil
+	case *adminservice.DeleteCallbackSigningKeyRequest:
+		return nil
+	case *adminservice.DeleteCallbackSigningKeyResponse:
+		return nil
 	case *adminservice.DeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -48,6 +56,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.DescribeDLQJobResponse:
 		return nil
+	case *adminservice.DescribeFaultInjectionRequest:
+		return nil
+	case *adminservice.DescribeFaultInjectionResponse:
+		return nil
 	case *adminservice.DescribeHistoryHostRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
@@ -62,10 +74,45 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.DescribeMutableStateResponse:
 		return nil
+	case *adminservice.DescribeNexusEndpointRequest:
+		return nil
+	case *adminservice.DescribeNexusEndpointResponse:
+		return nil
+	case *adminservice.DescribeOutboundDestinationHealthRequest:
+		return nil
+	case *adminservice.DescribeOutboundDestinationHealthResponse:
+		return nil
+	case *adminservice.DescribePersistenceConcurrencyLimiterRequest:
+		return nil
+	case *adminservice.DescribePersistenceConcurrencyLimiterResponse:
+		return nil
+	case *adminservice.DescribeReplicationLagRequest:
+		return nil
+	case *adminservice.DescribeReplicationLagResponse:
+		return nil
 	case *adminservice.DescribeTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.DescribeTaskQueuePartitionResponse:
 		return nil
+	case *adminservice.DescribeVisibilityBackfillRequest:
+		return nil
+	case *adminservice.DescribeVisibilityBackfillResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
+	case *adminservice.DescribeWorkerRequest:
+		return nil
+	case *adminservice.DescribeWorkerResponse:
+		return nil
+	case *adminservice.DescribeWorkerDeploymentRolloutRequest:
+		return nil
+	case *adminservice.DescribeWorkerDeploymentRolloutResponse:
+		return nil
+	case *adminservice.DescribeWorkflowRuleRequest:
+		return nil
+	case *adminservice.DescribeWorkflowRuleResponse:
+		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionResponse:
@@ -89,6 +136,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetDLQTasksResponse:
 		return nil
+	case *adminservice.GetDeadLetteredCallbackRequest:
+		return nil
+	case *adminservice.GetDeadLetteredCallbackResponse:
+		return nil
 	case *adminservice.GetNamespaceRequest:
 		return nil
 	case *adminservice.GetNamespaceResponse:
@@ -117,6 +168,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetTaskQueueUserDataResponse:
 		return nil
+	case *adminservice.GetWorkerScalingRecommendationRequest:
+		return nil
+	case *adminservice.GetWorkerScalingRecommendationResponse:
+		return nil
 	case *adminservice.GetWorkflowExecutionRawHistoryRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -138,6 +193,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ImportWorkflowExecutionResponse:
 		return nil
+	case *adminservice.ListCallbackSigningKeysRequest:
+		return nil
+	case *adminservice.ListCallbackSigningKeysResponse:
+		return nil
 	case *adminservice.ListClusterMembersRequest:
 		return nil
 	case *adminservice.ListClusterMembersResponse:
@@ -146,6 +205,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ListClustersResponse:
 		return nil
+	case *adminservice.ListDeadLetteredCallbacksRequest:
+		return nil
+	case *adminservice.ListDeadLetteredCallbacksResponse:
+		return nil
 	case *adminservice.ListHistoryTasksRequest:
 		return nil
 	case *adminservice.ListHistoryTasksResponse:
@@ -166,6 +229,13 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.MigrateScheduleResponse:
 		return nil
+	case *adminservice.MigrateSearchAttributeTypeRequest:
+		return nil
+	case *adminservice.MigrateSearchAttributeTypeResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
 	case *adminservice.PurgeDLQMessagesRequest:
 		return nil
 	case *adminservice.PurgeDLQMessagesResponse:
@@ -174,6 +244,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.PurgeDLQTasksResponse:
 		return nil
+	case *adminservice.PurgeDeadLetteredCallbacksRequest:
+		return nil
+	case *adminservice.PurgeDeadLetteredCallbacksResponse:
+		return nil
 	case *adminservice.ReapplyEventsRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
@@ -207,6 +281,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.RemoveTaskResponse:
 		return nil
+	case *adminservice.RenameSearchAttributeAliasRequest:
+		return nil
+	case *adminservice.RenameSearchAttributeAliasResponse:
+		return nil
+	case *adminservice.ReplayDeadLetteredCallbacksRequest:
+		return nil
+	case *adminservice.ReplayDeadLetteredCallbacksResponse:
+		return nil
 	case *adminservice.ResendReplicationTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowId()),
@@ -214,10 +296,25 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ResendReplicationTasksResponse:
 		return nil
+	case *adminservice.RotateCallbackSigningKeyRequest:
+		return nil
+	case *adminservice.RotateCallbackSigningKeyResponse:
+		return nil
 	case *adminservice.StartAdminBatchOperationRequest:
 		return nil
 	case *adminservice.StartAdminBatchOperationResponse:
 		return nil
+	case *adminservice.StartVisibilityBackfillRequest:
+		return nil
+	case *adminservice.StartVisibilityBackfillResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
+	case *adminservice.StartWorkerDeploymentRolloutRequest:
+		return nil
+	case *adminservice.StartWorkerDeploymentRolloutResponse:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -225,6 +322,22 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.SyncWorkflowStateResponse:
 		return nil
+	case *adminservice.UpdateFaultInjectionRequest:
+		return nil
+	case *adminservice.UpdateFaultInjectionResponse:
+		return nil
+	case *adminservice.UpdateNexusEndpointHttpTargetRequest:
+		return nil
+	case *adminservice.UpdateNexusEndpointHttpTargetResponse:
+		return nil
+	case *adminservice.UpdateOutboundCircuitBreakerRequest:
+		return nil
+	case *adminservice.UpdateOutboundCircuitBreakerResponse:
+		return nil
+	case *adminservice.UpdateWorkerDeploymentRolloutRequest:
+		return nil
+	case *adminservice.UpdateWorkerDeploymentRolloutResponse:
+		return nil
 	default:
 		return nil
 	}
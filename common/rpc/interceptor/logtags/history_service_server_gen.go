@@ -80,6 +80,18 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.DescribeMutableStateResponse:
 		return nil
+	case *historyservice.DescribeOutboundDestinationHealthRequest:
+		return nil
+	case *historyservice.DescribeOutboundDestinationHealthResponse:
+		return nil
+	case *historyservice.DescribePersistenceConcurrencyLimiterRequest:
+		return nil
+	case *historyservice.DescribePersistenceConcurrencyLimiterResponse:
+		return nil
+	case *historyservice.DescribeReplicationLagRequest:
+		return nil
+	case *historyservice.DescribeReplicationLagResponse:
+		return nil
 	case *historyservice.DescribeWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetRequest().GetExecution().GetWorkflowId()),
@@ -87,6 +99,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.DescribeWorkflowExecutionResponse:
 		return nil
+	case *historyservice.DescribeWorkflowRuleHitsRequest:
+		return nil
+	case *historyservice.DescribeWorkflowRuleHitsResponse:
+		return nil
 	case *historyservice.ExecuteMultiOperationRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowId()),
@@ -197,6 +213,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.IsWorkflowTaskValidResponse:
 		return nil
+	case *historyservice.ListDeadLetteredCallbacksRequest:
+		return nil
+	case *historyservice.ListDeadLetteredCallbacksResponse:
+		return nil
 	case *historyservice.ListQueuesRequest:
 		return nil
 	case *historyservice.ListQueuesResponse:
@@ -244,6 +264,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		return nil
 	case *historyservice.PurgeDLQMessagesResponse:
 		return nil
+	case *historyservice.PurgeDeadLetteredCallbacksRequest:
+		return nil
+	case *historyservice.PurgeDeadLetteredCallbacksResponse:
+		return nil
 	case *historyservice.QueryWorkflowRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetRequest().GetExecution().GetWorkflowId()),
@@ -308,6 +332,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		return nil
 	case *historyservice.RemoveTaskResponse:
 		return nil
+	case *historyservice.ReplayDeadLetteredCallbacksRequest:
+		return nil
+	case *historyservice.ReplayDeadLetteredCallbacksResponse:
+		return nil
 	case *historyservice.ReplicateEventsV2Request:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
@@ -452,6 +480,14 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.UpdateActivityOptionsResponse:
 		return nil
+	case *historyservice.UpdateFaultInjectionRequest:
+		return nil
+	case *historyservice.UpdateFaultInjectionResponse:
+		return nil
+	case *historyservice.UpdateOutboundCircuitBreakerRequest:
+		return nil
+	case *historyservice.UpdateOutboundCircuitBreakerResponse:
+		return nil
 	case *historyservice.UpdateWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetRequest().GetWorkflowExecution().GetWorkflowId()),
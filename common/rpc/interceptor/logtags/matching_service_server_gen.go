@@ -51,6 +51,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.DeleteNexusEndpointResponse:
 		return nil
+	case *matchingservice.DescribePersistenceConcurrencyLimiterRequest:
+		return nil
+	case *matchingservice.DescribePersistenceConcurrencyLimiterResponse:
+		return nil
 	case *matchingservice.DescribeTaskQueueRequest:
 		return nil
 	case *matchingservice.DescribeTaskQueueResponse:
@@ -95,6 +99,14 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.GetWorkerBuildIdCompatibilityResponse:
 		return nil
+	case *matchingservice.GetWorkerDeploymentVersionHealthRequest:
+		return nil
+	case *matchingservice.GetWorkerDeploymentVersionHealthResponse:
+		return nil
+	case *matchingservice.GetWorkerScalingRecommendationRequest:
+		return nil
+	case *matchingservice.GetWorkerScalingRecommendationResponse:
+		return nil
 	case *matchingservice.GetWorkerVersioningRulesRequest:
 		return nil
 	case *matchingservice.GetWorkerVersioningRulesResponse:
@@ -136,6 +148,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		}
 	case *matchingservice.QueryWorkflowResponse:
 		return nil
+	case *matchingservice.RecordNondeterminismErrorRequest:
+		return nil
+	case *matchingservice.RecordNondeterminismErrorResponse:
+		return nil
 	case *matchingservice.RecordWorkerHeartbeatRequest:
 		return nil
 	case *matchingservice.RecordWorkerHeartbeatResponse:
@@ -164,6 +180,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.UpdateFairnessStateResponse:
 		return nil
+	case *matchingservice.UpdateFaultInjectionRequest:
+		return nil
+	case *matchingservice.UpdateFaultInjectionResponse:
+		return nil
 	case *matchingservice.UpdateNexusEndpointRequest:
 		return nil
 	case *matchingservice.UpdateNexusEndpointResponse:
@@ -37,6 +37,9 @@ var (
 		"UpdateNexusEndpoint": {},
 		"ListNexusEndpoints":  {},
 		"DeleteNexusEndpoint": {},
+		// Host level APIs, not associated with a namespace.
+		"UpdateFaultInjection":                  {},
+		"DescribePersistenceConcurrencyLimiter": {},
 	}
 
 	historyAPIExcluded = map[string]struct{}{
@@ -58,6 +61,10 @@ var (
 		"CompleteNexusOperation":      {}, // NamespaceId is in the completion token for this request.
 		"CompleteNexusOperationChasm": {}, // NamespaceId is in the completion token for this request.
 		"DeepHealthCheck":             {},
+		// Host level APIs, not associated with a namespace.
+		"DescribePersistenceConcurrencyLimiter": {},
+		"DescribeReplicationLag":                {},
+		"UpdateFaultInjection":                  {},
 	}
 )
 
@@ -83,6 +83,9 @@ var (
 		"ListBatchOperations":            {},
 		// Matching
 		"ShutdownWorker": {},
+		// Outbound queue APIs, operators must be able to stop calls to failing destinations at any time
+		"DescribeOutboundDestinationHealth": {},
+		"UpdateOutboundCircuitBreaker":      {},
 	}
 )
 
@@ -300,6 +303,12 @@ func (ni *NamespaceValidatorInterceptor) extractNamespaceFromRequest(req any) (*
 	case *adminservice.DescribeHistoryHostRequest:
 		// Special case for DescribeHistoryHost API which should run regardless of namespace state.
 		return nil, nil
+	case *adminservice.DescribeOutboundDestinationHealthRequest:
+		// Namespace is an optional filter for outbound destination health.
+		if !namespaceName.IsEmpty() {
+			return ni.namespaceRegistry.GetNamespace(namespaceName)
+		}
+		return nil, nil
 	case *adminservice.AddSearchAttributesRequest,
 		*adminservice.RemoveSearchAttributesRequest,
 		*adminservice.GetSearchAttributesRequest,
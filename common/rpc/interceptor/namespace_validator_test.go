@@ -230,6 +230,13 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_StatusFromNamesp
 			req:         &operatorservice.DeleteNamespaceRequest{Namespace: "test-namespace"},
 		},
 		// AdminService APIs
+		{
+			state:            enumspb.NAMESPACE_STATE_REGISTERED,
+			replicationState: enumspb.REPLICATION_STATE_HANDOVER,
+			expectedErr:      nil,
+			method:           api.AdminServicePrefix + "UpdateOutboundCircuitBreaker",
+			req:              &adminservice.UpdateOutboundCircuitBreakerRequest{Namespace: "test-namespace"},
+		},
 		{
 			state:       enumspb.NAMESPACE_STATE_REGISTERED,
 			expectedErr: nil,
@@ -0,0 +1,79 @@
+package searchattribute
+
+import (
+	"fmt"
+	"maps"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+)
+
+type typeMigration struct {
+	source enumspb.IndexedValueType
+	target enumspb.IndexedValueType
+}
+
+// supportedTypeMigrations lists the type migrations of custom search attributes and how values are converted.
+var supportedTypeMigrations = map[typeMigration]func(any) any{
+	{enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST}: func(v any) any { return []string{v.(string)} },
+	{enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT}:         func(v any) any { return v },
+	{enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD}:         func(v any) any { return v },
+	{enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE}:           func(v any) any { return float64(v.(int64)) },
+}
+
+// IsTypeMigrationSupported returns true if values of search attributes of the source type can be converted to the
+// target type.
+func IsTypeMigrationSupported(source enumspb.IndexedValueType, target enumspb.IndexedValueType) bool {
+	_, ok := supportedTypeMigrations[typeMigration{source: source, target: target}]
+	return ok
+}
+
+// ConvertValueType converts a search attribute value from the source type to the target type.
+func ConvertValueType(
+	value *commonpb.Payload,
+	source enumspb.IndexedValueType,
+	target enumspb.IndexedValueType,
+) (*commonpb.Payload, error) {
+	convert, ok := supportedTypeMigrations[typeMigration{source: source, target: target}]
+	if !ok {
+		return nil, fmt.Errorf("%w: unable to convert search attribute value from %s to %s", sadefs.ErrInvalidType, source, target)
+	}
+	decoded, err := sadefs.DecodeValue(value, source, false)
+	if err != nil {
+		return nil, err
+	}
+	return sadefs.EncodeValue(convert(decoded), target)
+}
+
+// ApplyTypeMigrations returns the search attributes with the converted value of every migrated field added under
+// its target field name. Values already set for a target field take precedence, and values that can't be converted
+// are skipped. The input map is not modified.
+func ApplyTypeMigrations(
+	indexedFields map[string]*commonpb.Payload,
+	migrations map[string]*persistencespb.SearchAttributeTypeMigration,
+) map[string]*commonpb.Payload {
+	var result map[string]*commonpb.Payload
+	for sourceFieldName, migration := range migrations {
+		value, ok := indexedFields[sourceFieldName]
+		if !ok {
+			continue
+		}
+		if _, ok := indexedFields[migration.GetTargetFieldName()]; ok {
+			continue
+		}
+		converted, err := ConvertValueType(value, migration.GetSourceType(), migration.GetTargetType())
+		if err != nil {
+			continue
+		}
+		if result == nil {
+			result = maps.Clone(indexedFields)
+		}
+		result[migration.GetTargetFieldName()] = converted
+	}
+	if result == nil {
+		return indexedFields
+	}
+	return result
+}
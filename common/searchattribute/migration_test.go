@@ -0,0 +1,84 @@
+package searchattribute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+)
+
+func Test_ConvertValueType(t *testing.T) {
+	r := require.New(t)
+
+	converted, err := ConvertValueType(
+		sadefs.MustEncodeValue("val1", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+	)
+	r.NoError(err)
+	value, err := sadefs.DecodeValue(converted, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, false)
+	r.NoError(err)
+	r.Equal([]string{"val1"}, value)
+	r.Equal(enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, sadefs.GetMetadataType(converted))
+
+	converted, err = ConvertValueType(
+		sadefs.MustEncodeValue(int64(42), enumspb.INDEXED_VALUE_TYPE_INT),
+		enumspb.INDEXED_VALUE_TYPE_INT,
+		enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+	)
+	r.NoError(err)
+	value, err = sadefs.DecodeValue(converted, enumspb.INDEXED_VALUE_TYPE_DOUBLE, false)
+	r.NoError(err)
+	r.Equal(float64(42), value)
+
+	_, err = ConvertValueType(
+		sadefs.MustEncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL),
+		enumspb.INDEXED_VALUE_TYPE_BOOL,
+		enumspb.INDEXED_VALUE_TYPE_INT,
+	)
+	r.ErrorIs(err, sadefs.ErrInvalidType)
+	r.False(IsTypeMigrationSupported(enumspb.INDEXED_VALUE_TYPE_BOOL, enumspb.INDEXED_VALUE_TYPE_INT))
+	r.True(IsTypeMigrationSupported(enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST))
+}
+
+func Test_ApplyTypeMigrations(t *testing.T) {
+	r := require.New(t)
+
+	migrations := map[string]*persistencespb.SearchAttributeTypeMigration{
+		"Keyword01": {
+			SourceType:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			TargetFieldName: "KeywordList01",
+			TargetType:      enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		},
+		"Int01": {
+			SourceType:      enumspb.INDEXED_VALUE_TYPE_INT,
+			TargetFieldName: "Double01",
+			TargetType:      enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		},
+	}
+
+	// Nothing to migrate.
+	indexedFields := map[string]*commonpb.Payload{
+		"Bool01": sadefs.MustEncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL),
+	}
+	r.Equal(indexedFields, ApplyTypeMigrations(indexedFields, migrations))
+
+	indexedFields = map[string]*commonpb.Payload{
+		"Keyword01": sadefs.MustEncodeValue("val1", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+		"Int01":     sadefs.MustEncodeValue(int64(1), enumspb.INDEXED_VALUE_TYPE_INT),
+		"Double01":  sadefs.MustEncodeValue(2.5, enumspb.INDEXED_VALUE_TYPE_DOUBLE),
+	}
+	result := ApplyTypeMigrations(indexedFields, migrations)
+	r.Len(indexedFields, 3)
+	r.Len(result, 4)
+	value, err := sadefs.DecodeValue(result["KeywordList01"], enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, false)
+	r.NoError(err)
+	r.Equal([]string{"val1"}, value)
+	// The value of the target field is kept.
+	value, err = sadefs.DecodeValue(result["Double01"], enumspb.INDEXED_VALUE_TYPE_DOUBLE, false)
+	r.NoError(err)
+	r.Equal(2.5, value)
+}
@@ -119,6 +119,10 @@ const (
 	// TemporalExternalPayloadSizeBytes is the total size in bytes of all external payloads
 	// referenced in the entire history tree of the execution.
 	TemporalExternalPayloadSizeBytes = "TemporalExternalPayloadSizeBytes"
+
+	// TemporalLocalOnly is set to true on executions which are excluded from replication by the
+	// namespace replication filter and only exist in the cluster they were started in.
+	TemporalLocalOnly = "TemporalLocalOnly"
 )
 
 var (
@@ -187,6 +191,7 @@ var (
 		TemporalUsedWorkerDeploymentVersions: enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
 		TemporalExternalPayloadCount:         enumspb.INDEXED_VALUE_TYPE_INT,
 		TemporalExternalPayloadSizeBytes:     enumspb.INDEXED_VALUE_TYPE_INT,
+		TemporalLocalOnly:                    enumspb.INDEXED_VALUE_TYPE_BOOL,
 	}
 
 	// reserved are internal field names that can't be used as search attribute names.
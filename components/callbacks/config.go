@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"go.temporal.io/server/chasm"
+	chasmcallbacks "go.temporal.io/server/chasm/lib/callback"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/nexus"
@@ -20,35 +21,58 @@ var RequestTimeout = dynamicconfig.NewDestinationDurationSetting(
 	`RequestTimeout is the timeout for executing a single callback request.`,
 )
 
-var RetryPolicyInitialInterval = dynamicconfig.NewGlobalDurationSetting(
+var RetryPolicyInitialInterval = dynamicconfig.NewNamespaceDurationSetting(
 	"component.callbacks.retryPolicy.initialInterval",
 	time.Second,
 	`The initial backoff interval between every callback request attempt for a given callback.`,
 )
 
-var RetryPolicyMaximumInterval = dynamicconfig.NewGlobalDurationSetting(
+var RetryPolicyMaximumInterval = dynamicconfig.NewNamespaceDurationSetting(
 	"component.callbacks.retryPolicy.maxInterval",
 	time.Hour,
 	`The maximum backoff interval between every callback request attempt for a given callback.`,
 )
 
+var RetryPolicyBackoffCoefficient = dynamicconfig.NewNamespaceFloatSetting(
+	"component.callbacks.retryPolicy.backoffCoefficient",
+	2.0,
+	`The rate at which the backoff interval increases between callback request attempts for a given callback.`,
+)
+
+var RetryPolicyMaximumAttempts = dynamicconfig.NewNamespaceIntSetting(
+	"component.callbacks.retryPolicy.maximumAttempts",
+	0,
+	`The maximum number of request attempts for a given callback before it is marked as failed and moved to the
+callback dead-letter queue. Zero means unlimited attempts.`,
+)
+
 type Config struct {
-	RequestTimeout dynamicconfig.DurationPropertyFnWithDestinationFilter
-	RetryPolicy    func() backoff.RetryPolicy
+	RequestTimeout         dynamicconfig.DurationPropertyFnWithDestinationFilter
+	RetryPolicy            func(namespaceName string) backoff.RetryPolicy
+	DeadLetterQueueEnabled dynamicconfig.BoolPropertyFnWithNamespaceFilter
 }
 
 func ConfigProvider(dc *dynamicconfig.Collection) *Config {
+	initialInterval := RetryPolicyInitialInterval.Get(dc)
+	maximumInterval := RetryPolicyMaximumInterval.Get(dc)
+	backoffCoefficient := RetryPolicyBackoffCoefficient.Get(dc)
+	maximumAttempts := RetryPolicyMaximumAttempts.Get(dc)
 	return &Config{
 		RequestTimeout: RequestTimeout.Get(dc),
-		RetryPolicy: func() backoff.RetryPolicy {
+		RetryPolicy: func(namespaceName string) backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(
-				RetryPolicyInitialInterval.Get(dc)(),
+				initialInterval(namespaceName),
 			).WithMaximumInterval(
-				RetryPolicyMaximumInterval.Get(dc)(),
+				maximumInterval(namespaceName),
+			).WithBackoffCoefficient(
+				backoffCoefficient(namespaceName),
+			).WithMaximumAttempts(
+				maximumAttempts(namespaceName),
 			).WithExpirationInterval(
 				backoff.NoInterval,
 			)
 		},
+		DeadLetterQueueEnabled: chasmcallbacks.DeadLetterQueueEnabled.Get(dc),
 	}
 }
 
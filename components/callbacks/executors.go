@@ -5,11 +5,16 @@ import (
 	"fmt"
 	"net/http"
 
+	failurepb "go.temporal.io/api/failure/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/chasm"
+	chasmcallbacks "go.temporal.io/server/chasm/lib/callback"
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/service/history/hsm"
 	queuescommon "go.temporal.io/server/service/history/queues/common"
@@ -49,6 +54,7 @@ type TaskExecutorOptions struct {
 	HTTPTraceProvider  commonnexus.HTTPClientTraceProvider
 	HistoryClient      resource.HistoryClient
 	ChasmEngine        chasm.Engine
+	DLQManager         persistence.CallbackDLQManager
 }
 
 type taskExecutor struct {
@@ -102,6 +108,12 @@ type callbackInvokable interface {
 	WrapError(result invocationResult, err error) error
 }
 
+// deadLetterable is implemented by invokables whose abandoned requests can be stored in the callback dead-letter queue.
+type deadLetterable interface {
+	// deadLetteredCallback returns the callback to store, or nil if no request was sent.
+	deadLetteredCallback() *persistencespb.DeadLetteredCallback
+}
+
 func (e taskExecutor) executeInvocationTask(
 	ctx context.Context,
 	env hsm.Environment,
@@ -125,10 +137,37 @@ func (e taskExecutor) executeInvocationTask(
 	defer cancel()
 
 	result := invokable.Invoke(callCtx, ns, e, task)
-	saveErr := e.saveResult(ctx, env, ref, result)
+	failed, saveErr := e.saveResult(ctx, env, ref, result, e.Config.RetryPolicy(ns.Name().String()))
+	// Dead-letter only once the failure is committed: a failed save retries the task, which would otherwise enqueue
+	// the same callback again.
+	if saveErr == nil && failed {
+		e.deadLetter(ctx, ns, ref, task, invokable, result)
+	}
 	return invokable.WrapError(result, saveErr)
 }
 
+func (e taskExecutor) deadLetter(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	ref hsm.Ref,
+	task InvocationTask,
+	invokable callbackInvokable,
+	result invocationResult,
+) {
+	dl, ok := invokable.(deadLetterable)
+	if !ok || e.DLQManager == nil || !e.Config.DeadLetterQueueEnabled(ns.Name().String()) {
+		return
+	}
+	cb := dl.deadLetteredCallback()
+	if cb == nil {
+		return
+	}
+	cb.NamespaceId = ref.WorkflowKey.NamespaceID
+	cb.Destination = task.Destination()
+	cb.LastAttemptFailure = &failurepb.Failure{Message: result.error().Error()}
+	chasmcallbacks.EnqueueDeadLetteredCallback(ctx, e.DLQManager, e.MetricsHandler, e.Logger, ns.Name(), cb)
+}
+
 func (e taskExecutor) loadInvocationArgs(
 	ctx context.Context,
 	env hsm.Environment,
@@ -171,7 +210,9 @@ func (e taskExecutor) loadInvocationArgs(
 				completion: completion,
 				workflowID: ref.WorkflowKey.WorkflowID,
 				runID:      ref.WorkflowKey.RunID,
+				requestID:  callback.RequestId,
 				attempt:    callback.Attempt,
+				recorder:   &chasmcallbacks.RequestRecorder{},
 			}
 		}
 		return nil
@@ -179,13 +220,15 @@ func (e taskExecutor) loadInvocationArgs(
 	return
 }
 
+// saveResult applies the invocation result to the callback and returns whether the callback failed permanently.
 func (e taskExecutor) saveResult(
 	ctx context.Context,
 	env hsm.Environment,
 	ref hsm.Ref,
 	result invocationResult,
-) error {
-	return env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
+	retryPolicy backoff.RetryPolicy,
+) (failed bool, err error) {
+	err = env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
 		return hsm.MachineTransition(node, func(callback Callback) (hsm.TransitionOutput, error) {
 			switch result.(type) {
 			case invocationResultOK:
@@ -193,12 +236,21 @@ func (e taskExecutor) saveResult(
 					Time: env.Now(),
 				})
 			case invocationResultRetry:
+				// Use 0 for elapsed time as we don't limit the retry by time (for now).
+				if retryPolicy.ComputeNextDelay(0, int(callback.Attempt)+1, result.error()) < 0 {
+					failed = true
+					return TransitionFailed.Apply(callback, EventFailed{
+						Time: env.Now(),
+						Err:  result.error(),
+					})
+				}
 				return TransitionAttemptFailed.Apply(callback, EventAttemptFailed{
 					Time:        env.Now(),
 					Err:         result.error(),
-					RetryPolicy: e.Config.RetryPolicy(),
+					RetryPolicy: retryPolicy,
 				})
 			case invocationResultFail:
+				failed = true
 				return TransitionFailed.Apply(callback, EventFailed{
 					Time: env.Now(),
 					Err:  result.error(),
@@ -208,6 +260,7 @@ func (e taskExecutor) saveResult(
 			}
 		})
 	})
+	return failed, err
 }
 
 func (e taskExecutor) executeBackoffTask(
@@ -26,6 +26,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/nexus/nexusrpc"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/hsm/hsmtest"
@@ -72,6 +73,7 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 		caller                callbacks.HTTPCaller
 		retryable             bool
 		expectedMetricOutcome string
+		expectDeadLettered    bool
 		assertOutcome         func(*testing.T, callbacks.Callback)
 	}{
 		{
@@ -114,6 +116,7 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 			},
 			retryable:             false,
 			expectedMetricOutcome: "handler-error:BAD_REQUEST",
+			expectDeadLettered:    true,
 			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
 				require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
 			},
@@ -149,6 +152,25 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 				metrics.DestinationTag("http://localhost"),
 				metrics.OutcomeTag(tc.expectedMetricOutcome))
 
+			dlqManager := persistence.NewMockCallbackDLQManager(ctrl)
+			if tc.expectDeadLettered {
+				dlqManager.EXPECT().EnqueueCallback(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *persistence.EnqueueCallbackRequest) (*persistence.EnqueueCallbackResponse, error) {
+						require.Equal(t, "namespace-id", request.Callback.GetNamespaceId())
+						require.Equal(t, "request-id", request.Callback.GetRequestId())
+						require.Equal(t, "http://localhost", request.Callback.GetDestination())
+						require.Equal(t, int32(1), request.Callback.GetAttempt())
+						require.Equal(t, http.MethodPost, request.Callback.GetRequest().GetMethod())
+						require.NotEmpty(t, request.Callback.GetLastAttemptFailure().GetMessage())
+						return &persistence.EnqueueCallbackResponse{}, nil
+					})
+				dlqCounter := metrics.NewMockCounterIface(ctrl)
+				metricsHandler.EXPECT().Counter(callbacks.DeadLetteredCounter.Name()).Return(dlqCounter)
+				dlqCounter.EXPECT().Record(int64(1),
+					metrics.NamespaceTag("namespace-name"),
+					metrics.DestinationTag("http://localhost"))
+			}
+
 			root := newRoot(t)
 			cb := callbacks.Callback{
 				CallbackInfo: &persistencespb.CallbackInfo{
@@ -159,7 +181,8 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 							},
 						},
 					},
-					State: enumsspb.CALLBACK_STATE_SCHEDULED,
+					RequestId: "request-id",
+					State:     enumsspb.CALLBACK_STATE_SCHEDULED,
 				},
 			}
 			coll := callbacks.MachineCollection(root)
@@ -177,12 +200,14 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 					HTTPCallerProvider: func(nid queuescommon.NamespaceIDAndDestination) callbacks.HTTPCaller {
 						return tc.caller
 					},
-					Logger: log.NewNoopLogger(),
+					Logger:     log.NewNoopLogger(),
+					DLQManager: dlqManager,
 					Config: &callbacks.Config{
 						RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
-						RetryPolicy: func() backoff.RetryPolicy {
+						RetryPolicy: func(string) backoff.RetryPolicy {
 							return backoff.NewExponentialRetryPolicy(time.Second)
 						},
+						DeadLetterQueueEnabled: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
 					},
 				},
 			))
@@ -247,7 +272,7 @@ func TestProcessBackoffTask(t *testing.T) {
 			Logger: log.NewNoopLogger(),
 			Config: &callbacks.Config{
 				RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
-				RetryPolicy: func() backoff.RetryPolicy {
+				RetryPolicy: func(string) backoff.RetryPolicy {
 					return backoff.NewExponentialRetryPolicy(time.Second)
 				},
 			},
@@ -506,7 +531,7 @@ func TestProcessInvocationTaskChasm_Outcomes(t *testing.T) {
 				Logger:            log.NewNoopLogger(),
 				Config: &callbacks.Config{
 					RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
-					RetryPolicy: func() backoff.RetryPolicy {
+					RetryPolicy: func(string) backoff.RetryPolicy {
 						return backoff.NewExponentialRetryPolicy(time.Second)
 					},
 				},
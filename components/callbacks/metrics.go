@@ -7,4 +7,5 @@ import (
 var (
 	RequestCounter          = chasmcallbacks.RequestCounter
 	RequestLatencyHistogram = chasmcallbacks.RequestLatencyHistogram
+	DeadLetteredCounter     = chasmcallbacks.DeadLetteredCounter
 )
@@ -9,6 +9,7 @@ import (
 
 	"github.com/nexus-rpc/sdk-go/nexus"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	chasmcallbacks "go.temporal.io/server/chasm/lib/callback"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -32,7 +33,23 @@ type nexusInvocation struct {
 	nexus             *persistencespb.Callback_Nexus
 	completion        nexusrpc.CompleteOperationOptions
 	workflowID, runID string
+	requestID         string
 	attempt           int32
+	// recorder keeps the last request sent so that it can be dead-lettered. May be nil.
+	recorder *chasmcallbacks.RequestRecorder
+}
+
+func (n nexusInvocation) deadLetteredCallback() *persistencespb.DeadLetteredCallback {
+	if n.recorder == nil || n.recorder.Request() == nil {
+		return nil
+	}
+	return &persistencespb.DeadLetteredCallback{
+		BusinessId: n.workflowID,
+		RunId:      n.runID,
+		RequestId:  n.requestID,
+		Request:    n.recorder.Request(),
+		Attempt:    n.attempt + 1,
+	}
 }
 
 func (n nexusInvocation) WrapError(result invocationResult, err error) error {
@@ -58,11 +75,15 @@ func (n nexusInvocation) Invoke(ctx context.Context, ns *namespace.Namespace, e
 		}
 	}
 
+	caller := e.HTTPCallerProvider(queuescommon.NamespaceIDAndDestination{
+		NamespaceID: ns.ID().String(),
+		Destination: task.Destination(),
+	})
+	if n.recorder != nil {
+		caller = HTTPCaller(n.recorder.Wrap(chasmcallbacks.HTTPCaller(caller)))
+	}
 	client := nexusrpc.NewCompletionHTTPClient(nexusrpc.CompletionHTTPClientOptions{
-		HTTPCaller: e.HTTPCallerProvider(queuescommon.NamespaceIDAndDestination{
-			NamespaceID: ns.ID().String(),
-			Destination: task.Destination(),
-		}),
+		HTTPCaller: caller,
 		Serializer: commonnexus.PayloadSerializer,
 	})
 	// Make the call and record metrics.
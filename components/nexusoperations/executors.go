@@ -135,7 +135,7 @@ func buildCallbackURL(
 	switch target.(type) {
 	case *persistencespb.NexusEndpointTarget_Worker_:
 		return commonnexus.SystemCallbackURL, nil
-	case *persistencespb.NexusEndpointTarget_External_:
+	case *persistencespb.NexusEndpointTarget_External_, *persistencespb.NexusEndpointTarget_Http_:
 		return buildCallbackFromTemplate(callbackTemplate, ns)
 	default:
 		return "", fmt.Errorf("unknown endpoint target type: %T", target)
@@ -0,0 +1,9 @@
+package v23
+
+// NOTE: whenever there is a new database schema update, plz update the following versions
+
+// Version is the CockroachDB database release version
+const Version = "1.0"
+
+// VisibilityVersion is the CockroachDB visibility database release version
+const VisibilityVersion = "1.0"
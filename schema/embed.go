@@ -4,6 +4,7 @@ import (
 	"embed"
 	"io/fs"
 	"path/filepath"
+	"slices"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -42,7 +43,7 @@ func PathsByDir(dbSubDir string) []string {
 
 func PathsByDB(dbName string) []string {
 	if dbName == "sql" {
-		return append(PathsByDir("mysql"), PathsByDir("postgresql")...)
+		return slices.Concat(PathsByDir("mysql"), PathsByDir("postgresql"), PathsByDir("cockroachdb"))
 	}
 	return PathsByDir(dbName)
 }
@@ -58,7 +59,7 @@ func ElasticsearchClusterSettings() (string, error) {
 
 // ElasticsearchIndexTemplate returns the embedded index template for Elasticsearch v7 (latest version)
 func ElasticsearchIndexTemplate() (string, error) {
-	data, err := assets.ReadFile("elasticsearch/visibility/versioned/v14/index_template_v7.json")
+	data, err := assets.ReadFile("elasticsearch/visibility/versioned/v15/index_template_v7.json")
 	if err != nil {
 		return "", err
 	}
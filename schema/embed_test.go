@@ -25,6 +25,12 @@ func TestSchemaDirs(t *testing.T) {
 		"postgresql/v12/temporal",
 		"postgresql/v12/visibility",
 	}, dirs)
+
+	dirs = PathsByDir("cockroachdb")
+	requireContains(t, []string{
+		"cockroachdb/v23/temporal",
+		"cockroachdb/v23/visibility",
+	}, dirs)
 }
 
 func TestElasticsearchIndexTemplateIsLatest(t *testing.T) {
@@ -53,6 +53,7 @@ CREATE TABLE executions_visibility (
   TemporalUsedWorkerDeploymentVersions JSON GENERATED ALWAYS AS (search_attributes->'$.TemporalUsedWorkerDeploymentVersions'),
   TemporalExternalPayloadSizeBytes BIGINT GENERATED ALWAYS AS (search_attributes->"$.TemporalExternalPayloadSizeBytes"),
   TemporalExternalPayloadCount BIGINT GENERATED ALWAYS AS (search_attributes->"$.TemporalExternalPayloadCount"),
+  TemporalLocalOnly BOOLEAN GENERATED ALWAYS AS (search_attributes->"$.TemporalLocalOnly"),
   PRIMARY KEY (namespace_id, run_id)
 );
 
@@ -88,6 +89,7 @@ CREATE INDEX by_temporal_schedule_paused      ON executions_visibility (namespac
 CREATE INDEX by_temporal_namespace_division   ON executions_visibility (namespace_id, TemporalNamespaceDivision,  (COALESCE(close_time, CAST('9999-12-31 23:59:59' AS DATETIME))) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_external_payload_size_bytes ON executions_visibility (namespace_id, TemporalExternalPayloadSizeBytes, (COALESCE(close_time, CAST('9999-12-31 23:59:59' AS DATETIME))) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_external_payload_count ON executions_visibility (namespace_id, TemporalExternalPayloadCount, (COALESCE(close_time, CAST('9999-12-31 23:59:59' AS DATETIME))) DESC, start_time DESC, run_id); 
+CREATE INDEX by_temporal_local_only ON executions_visibility (namespace_id, TemporalLocalOnly, (COALESCE(close_time, CAST('9999-12-31 23:59:59' AS DATETIME))) DESC, start_time DESC, run_id);
 
 CREATE TABLE custom_search_attributes (
   namespace_id      CHAR(64)  NOT NULL,
@@ -0,0 +1,4 @@
+ALTER TABLE executions_visibility
+ADD COLUMN TemporalLocalOnly BOOLEAN GENERATED ALWAYS AS ((search_attributes->'TemporalLocalOnly')::boolean) STORED;
+
+CREATE INDEX by_temporal_local_only ON executions_visibility (namespace_id, TemporalLocalOnly, (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
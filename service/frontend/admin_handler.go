@@ -1,14 +1,18 @@
 package frontend
 
 import (
+	"cmp"
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"io"
 	"maps"
 	"math"
 	"net"
+	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -16,6 +20,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/operatorservice/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -27,6 +32,7 @@ import (
 	clusterspb "go.temporal.io/server/api/cluster/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	faultinjectionspb "go.temporal.io/server/api/faultinjection/v1"
 	healthspb "go.temporal.io/server/api/health/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -41,6 +47,7 @@ import (
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/channel"
+	"go.temporal.io/server/common/chaos"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -52,9 +59,11 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/namespace/nsreplication"
+	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -66,11 +75,15 @@ import (
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/addsearchattributes"
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/dlq"
 	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/searchattributemigration"
+	"go.temporal.io/server/service/worker/visibilitybackfill"
+	"go.temporal.io/server/service/worker/workerdeployment"
 	"google.golang.org/grpc/health"
 	grpchealthspb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -80,6 +93,11 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	listQueuesPageSize                      = 100
+	callbackSigningKeySecretSize            = 32
+	maxCallbackSigningKeyIDLength           = 64
+	defaultCallbackSigningKeyOverlap        = 24 * time.Hour
+	defaultSearchAttributeTransitionWindow  = 24 * time.Hour
 )
 
 type (
@@ -93,6 +111,7 @@ type (
 		numberOfHistoryShards      int32
 		config                     *Config
 		namespaceDLQHandler        nsreplication.DLQMessageHandler
+		namespaceReplicator        nsreplication.Replicator
 		eventSerializer            serialization.Serializer
 		visibilityMgr              manager.VisibilityManager
 		persistenceExecutionName   string
@@ -118,6 +137,14 @@ type (
 		historyHealthChecker       HealthChecker
 		chasmRegistry              *chasm.Registry
 		schedulerClient            schedulerpb.SchedulerServiceClient
+		nexusEndpointClient        *NexusEndpointClient
+		concurrencyLimiter         *persistenceClient.HealthConcurrencyLimiterImpl
+		faultRegistry              *chaos.Registry
+		workerDeploymentClient     workerdeployment.Client
+		timeSource                 clock.TimeSource
+		// workflowRuleHandler serves the workflow rule APIs, which only need the metadata manager of the namespace
+		// handler.
+		workflowRuleHandler *namespaceHandler
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -155,6 +182,10 @@ type (
 		ChasmRegistry                       *chasm.Registry
 		NamespaceDataMerger                 nsreplication.NamespaceDataMerger
 		SchedulerClient                     schedulerpb.SchedulerServiceClient
+		NexusEndpointClient                 *NexusEndpointClient
+		ConcurrencyLimiter                  *persistenceClient.HealthConcurrencyLimiterImpl
+		FaultRegistry                       *chaos.Registry
+		WorkerDeploymentClient              workerdeployment.Client
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -189,6 +220,7 @@ func NewAdminHandler(
 		numberOfHistoryShards:      args.PersistenceConfig.NumHistoryShards,
 		config:                     args.Config,
 		namespaceDLQHandler:        namespaceDLQHandler,
+		namespaceReplicator:        nsreplication.NewReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger),
 		eventSerializer:            args.EventSerializer,
 		visibilityMgr:              args.visibilityMgr,
 		persistenceExecutionName:   args.PersistenceExecutionManager.GetName(),
@@ -221,13 +253,24 @@ func NewAdminHandler(
 			),
 			args.Config.SuppressErrorSetSystemSearchAttribute,
 		),
-		clusterMetadata:      args.ClusterMetadata,
-		healthServer:         args.HealthServer,
-		historyHealthChecker: historyHealthChecker,
-		taskCategoryRegistry: args.CategoryRegistry,
-		matchingClient:       args.matchingClient,
-		chasmRegistry:        args.ChasmRegistry,
-		schedulerClient:      args.SchedulerClient,
+		clusterMetadata:        args.ClusterMetadata,
+		healthServer:           args.HealthServer,
+		historyHealthChecker:   historyHealthChecker,
+		taskCategoryRegistry:   args.CategoryRegistry,
+		matchingClient:         args.matchingClient,
+		chasmRegistry:          args.ChasmRegistry,
+		schedulerClient:        args.SchedulerClient,
+		nexusEndpointClient:    args.NexusEndpointClient,
+		concurrencyLimiter:     args.ConcurrencyLimiter,
+		faultRegistry:          args.FaultRegistry,
+		workerDeploymentClient: args.WorkerDeploymentClient,
+		timeSource:             args.TimeSource,
+		workflowRuleHandler: &namespaceHandler{
+			logger:      args.Logger,
+			metadataMgr: args.PersistenceMetadataManager,
+			timeSource:  args.TimeSource,
+			config:      args.Config,
+		},
 	}
 }
 
@@ -654,6 +697,324 @@ func (adh *AdminHandler) getSearchAttributesSQL(
 	}, nil
 }
 
+// RenameSearchAttributeAlias atomically renames the alias of a custom search attribute of a namespace. The previous
+// alias keeps resolving to the same field until the transition window expires.
+func (adh *AdminHandler) RenameSearchAttributeAlias(
+	ctx context.Context,
+	request *adminservice.RenameSearchAttributeAliasRequest,
+) (_ *adminservice.RenameSearchAttributeAliasResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	alias := request.GetSearchAttribute()
+	newAlias := request.GetNewAlias()
+	if alias == "" || newAlias == "" {
+		return nil, errSearchAttributesNotSet
+	}
+	if sadefs.IsReserved(newAlias) {
+		return nil, serviceerror.NewInvalidArgumentf(errSearchAttributeIsReservedMessage, newAlias)
+	}
+	currentSearchAttributes, err := adh.saProvider.GetSearchAttributes(adh.visibilityMgr.GetIndexName(), true)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(errUnableToGetSearchAttributesMessage, err)
+	}
+	if currentSearchAttributes.IsDefined(newAlias) {
+		return nil, serviceerror.NewInvalidArgumentf(errSearchAttributeAlreadyExistsMessage, newAlias)
+	}
+	transitionWindow := defaultSearchAttributeTransitionWindow
+	if request.GetTransitionWindow() != nil {
+		transitionWindow = request.GetTransitionWindow().AsDuration()
+		if transitionWindow < 0 {
+			return nil, serviceerror.NewInvalidArgument("transition window must not be negative")
+		}
+	}
+
+	now := adh.timeSource.Now()
+	updateRequest, err := adh.updateNamespaceDetail(ctx, request.GetNamespace(), func(detail *persistencespb.NamespaceDetail) error {
+		config := detail.Config
+		aliasToField := util.InverseMap(config.GetCustomSearchAttributeAliases())
+		fieldName, ok := aliasToField[alias]
+		if !ok {
+			return serviceerror.NewNotFoundf(errSearchAttributeDoesntExistMessage, alias)
+		}
+		if _, ok := aliasToField[newAlias]; ok {
+			return serviceerror.NewAlreadyExistsf(errSearchAttributeAlreadyExistsMessage, newAlias)
+		}
+		for _, migration := range config.GetSearchAttributeTypeMigrations() {
+			if migration.GetAlias() != alias {
+				continue
+			}
+			if migration.GetCompleteTime() == nil {
+				return serviceerror.NewFailedPreconditionf(errSearchAttributeIsMigratingMessage, alias)
+			}
+			migration.Alias = newAlias
+		}
+		config.CustomSearchAttributeAliases[fieldName] = newAlias
+		detail.ConfigVersion++
+
+		// Expired transitions no longer resolve and are dropped on rename.
+		maps.DeleteFunc(config.SearchAttributeAliasTransitions, func(name string, transition *persistencespb.SearchAttributeAliasTransition) bool {
+			return name == newAlias || !transition.GetExpireTime().AsTime().After(now)
+		})
+		if transitionWindow > 0 {
+			if config.SearchAttributeAliasTransitions == nil {
+				config.SearchAttributeAliasTransitions = make(map[string]*persistencespb.SearchAttributeAliasTransition)
+			}
+			config.SearchAttributeAliasTransitions[alias] = &persistencespb.SearchAttributeAliasTransition{
+				FieldName:  fieldName,
+				ExpireTime: timestamppb.New(now.Add(transitionWindow)),
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Aliases and alias transitions are replicated so that other clusters keep resolving the old alias.
+	detail := updateRequest.Namespace
+	if err := adh.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		detail.Info,
+		detail.Config,
+		detail.ReplicationConfig,
+		false,
+		detail.ConfigVersion,
+		detail.FailoverVersion,
+		updateRequest.IsGlobalNamespace,
+		detail.ReplicationConfig.GetFailoverHistory(),
+		false, // forceReplicate
+	); err != nil {
+		return nil, err
+	}
+	return &adminservice.RenameSearchAttributeAliasResponse{}, nil
+}
+
+// MigrateSearchAttributeType starts migrating a custom search attribute of a namespace to another type. The values are
+// moved to a free field of the target type by a system workflow, see [searchattributemigration.WorkflowName]. Calling
+// it again for a running migration to the same type returns the running migration. Migrations are only supported by
+// SQL visibility stores and for namespaces that are not replicated to other clusters, FailedPrecondition is returned
+// otherwise.
+func (adh *AdminHandler) MigrateSearchAttributeType(
+	ctx context.Context,
+	request *adminservice.MigrateSearchAttributeTypeRequest,
+) (_ *adminservice.MigrateSearchAttributeTypeResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	alias := request.GetSearchAttribute()
+	if alias == "" {
+		return nil, errSearchAttributesNotSet
+	}
+	targetType := request.GetType()
+	if _, ok := enumspb.IndexedValueType_name[int32(targetType)]; !ok || targetType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		return nil, serviceerror.NewInvalidArgumentf(errUnknownSearchAttributeTypeMessage, targetType)
+	}
+	if adh.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) {
+		return nil, serviceerror.NewFailedPrecondition("search attribute type migration is only supported by SQL visibility stores")
+	}
+	currentSearchAttributes, err := adh.saProvider.GetSearchAttributes(adh.visibilityMgr.GetIndexName(), true)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(errUnableToGetSearchAttributesMessage, err)
+	}
+	customSearchAttributes := currentSearchAttributes.Custom()
+
+	var namespaceID, sourceFieldName string
+	var migration *persistencespb.SearchAttributeTypeMigration
+	_, err = adh.updateNamespaceDetail(ctx, request.GetNamespace(), func(detail *persistencespb.NamespaceDetail) error {
+		if len(detail.ReplicationConfig.GetClusters()) > 1 {
+			return serviceerror.NewFailedPrecondition("search attribute type migration is not supported for namespaces replicated to other clusters")
+		}
+		namespaceID = detail.Info.GetId()
+		config := detail.Config
+		fieldToAlias := config.GetCustomSearchAttributeAliases()
+		var ok bool
+		if sourceFieldName, ok = util.InverseMap(fieldToAlias)[alias]; !ok {
+			return serviceerror.NewNotFoundf(errSearchAttributeDoesntExistMessage, alias)
+		}
+
+		reservedFields := make(map[string]struct{})
+		for source, existing := range config.GetSearchAttributeTypeMigrations() {
+			if existing.GetAlias() == alias {
+				if existing.GetCompleteTime() != nil {
+					return serviceerror.NewFailedPreconditionf("Search attribute %s has already been migrated to another type.", alias)
+				}
+				if existing.GetTargetType() != targetType {
+					return serviceerror.NewFailedPreconditionf(errSearchAttributeIsMigratingMessage, alias)
+				}
+				migration = existing
+				return nil
+			}
+			reservedFields[source] = struct{}{}
+			reservedFields[existing.GetTargetFieldName()] = struct{}{}
+		}
+
+		sourceType := customSearchAttributes[sourceFieldName]
+		if sourceType == targetType {
+			return serviceerror.NewInvalidArgumentf("Search attribute %s is already of type %s.", alias, targetType)
+		}
+		if !searchattribute.IsTypeMigrationSupported(sourceType, targetType) {
+			return serviceerror.NewInvalidArgumentf("Unable to migrate search attribute %s from type %s to %s.", alias, sourceType, targetType)
+		}
+
+		// find the first available field for the target type
+		targetFieldName := ""
+		cntUsed := 0
+		for fieldName, fieldType := range customSearchAttributes {
+			if fieldType != targetType || !sadefs.IsPreallocatedCSAFieldName(fieldName, fieldType) {
+				continue
+			}
+			_, allocated := fieldToAlias[fieldName]
+			_, reserved := reservedFields[fieldName]
+			if allocated || reserved {
+				cntUsed++
+				continue
+			}
+			targetFieldName = fieldName
+			break
+		}
+		if targetFieldName == "" {
+			return serviceerror.NewInvalidArgumentf(errTooManySearchAttributesMessage, cntUsed, targetType.String())
+		}
+
+		migration = &persistencespb.SearchAttributeTypeMigration{
+			Alias:           alias,
+			SourceType:      sourceType,
+			TargetFieldName: targetFieldName,
+			TargetType:      targetType,
+			StartTime:       timestamppb.New(adh.timeSource.Now()),
+		}
+		if config.SearchAttributeTypeMigrations == nil {
+			config.SearchAttributeTypeMigrations = make(map[string]*persistencespb.SearchAttributeTypeMigration)
+		}
+		config.SearchAttributeTypeMigrations[sourceFieldName] = migration
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdkClient := adh.sdkClientFactory.GetSystemClient()
+	run, err := sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue:                primitives.DefaultWorkerTaskQueue,
+			ID:                       searchattributemigration.WorkflowID(namespaceID, sourceFieldName),
+			WorkflowIDConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+		},
+		searchattributemigration.WorkflowName,
+		searchattributemigration.WorkflowParams{
+			NamespaceID:     namespaceID,
+			SourceFieldName: sourceFieldName,
+		},
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(
+			errUnableToStartWorkflowMessage, searchattributemigration.WorkflowName, err,
+		)
+	}
+	return &adminservice.MigrateSearchAttributeTypeResponse{
+		Migration:  migration,
+		WorkflowId: run.GetID(),
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// StartVisibilityBackfill starts the system workflow backfilling the secondary visibility store, unless it's already
+// running.
+func (adh *AdminHandler) StartVisibilityBackfill(
+	ctx context.Context,
+	request *adminservice.StartVisibilityBackfillRequest,
+) (_ *adminservice.StartVisibilityBackfillResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	sdkClient := adh.sdkClientFactory.GetSystemClient()
+	run, err := sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue:                primitives.DefaultWorkerTaskQueue,
+			ID:                       visibilitybackfill.WorkflowID,
+			WorkflowIDConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+		},
+		visibilitybackfill.WorkflowName,
+		visibilitybackfill.BackfillParams{
+			// Let the workflow validate and set the default values if needed.
+			ConcurrentShardCount: int(request.GetConcurrentShardCount()),
+			RPS:                  request.GetRps(),
+			PageSize:             int(request.GetPageSize()),
+			SkipVerification:     request.GetSkipVerification(),
+		},
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(
+			errUnableToStartWorkflowMessage, visibilitybackfill.WorkflowName, err,
+		)
+	}
+	return &adminservice.StartVisibilityBackfillResponse{
+		WorkflowId: run.GetID(),
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// DescribeVisibilityBackfill returns the status and the progress of the last visibility backfill.
+func (adh *AdminHandler) DescribeVisibilityBackfill(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityBackfillRequest,
+) (_ *adminservice.DescribeVisibilityBackfillResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	sdkClient := adh.sdkClientFactory.GetSystemClient()
+	execution, err := sdkClient.DescribeWorkflowExecution(ctx, visibilitybackfill.WorkflowID, "")
+	if err != nil {
+		return nil, err
+	}
+	runID := execution.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	response, err := sdkClient.QueryWorkflow(ctx, visibilitybackfill.WorkflowID, runID, visibilitybackfill.StatusQueryType)
+	if err != nil {
+		return nil, err
+	}
+	var status visibilitybackfill.BackfillStatus
+	if err := response.Get(&status); err != nil {
+		return nil, err
+	}
+
+	mismatches := make([]*adminservice.VisibilityCountMismatch, 0, len(status.CountMismatches))
+	for _, mismatch := range status.CountMismatches {
+		mismatches = append(mismatches, &adminservice.VisibilityCountMismatch{
+			NamespaceId:    mismatch.NamespaceID,
+			Namespace:      mismatch.Namespace,
+			ArchetypeId:    mismatch.ArchetypeID,
+			Archetype:      mismatch.Archetype,
+			PrimaryCount:   mismatch.PrimaryCount,
+			SecondaryCount: mismatch.SecondaryCount,
+		})
+	}
+	return &adminservice.DescribeVisibilityBackfillResponse{
+		WorkflowId:          visibilitybackfill.WorkflowID,
+		RunId:               runID,
+		Status:              execution.GetWorkflowExecutionInfo().GetStatus(),
+		ShardCount:          status.ShardCount,
+		CompletedShardCount: status.CompletedShardCount,
+		BackfilledCount:     status.BackfilledCount,
+		SkippedCount:        status.SkippedCount,
+		Verifying:           status.Verifying,
+		CountMismatches:     mismatches,
+	}, nil
+}
+
 func (adh *AdminHandler) RebuildMutableState(
 	ctx context.Context,
 	request *adminservice.RebuildMutableStateRequest,
@@ -684,142 +1045,1000 @@ func (adh *AdminHandler) RebuildMutableState(
 
 func (adh *AdminHandler) ImportWorkflowExecution(
 	ctx context.Context,
-	request *adminservice.ImportWorkflowExecutionRequest,
-) (_ *adminservice.ImportWorkflowExecutionResponse, retError error) {
+	request *adminservice.ImportWorkflowExecutionRequest,
+) (_ *adminservice.ImportWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	unaliasedBatches, err := adh.unaliasAndValidateSearchAttributes(request.HistoryBatches, namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+		NamespaceId:    namespaceID.String(),
+		Execution:      request.Execution,
+		HistoryBatches: unaliasedBatches,
+		VersionHistory: request.VersionHistory,
+		Token:          request.Token,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ImportWorkflowExecutionResponse{
+		Token: resp.Token,
+	}, nil
+}
+
+func (adh *AdminHandler) unaliasAndValidateSearchAttributes(historyBatches []*commonpb.DataBlob, nsName namespace.Name) ([]*commonpb.DataBlob, error) {
+	var unaliasedBatches []*commonpb.DataBlob
+	for _, historyBatch := range historyBatches {
+		events, err := adh.eventSerializer.DeserializeEvents(historyBatch)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		hasSas := false
+		for _, event := range events {
+			sas, _ := searchattribute.GetFromEvent(event)
+			if sas == nil {
+				continue
+			}
+			hasSas = true
+
+			unaliasedSas, err := searchattribute.UnaliasFields(adh.saMapperProvider, sas, nsName.String())
+			if err != nil {
+				var invArgErr *serviceerror.InvalidArgument
+				if !errors.As(err, &invArgErr) {
+					return nil, err
+				}
+				// Mapper returns InvalidArgument if alias is not found. It means that history has field names, not aliases.
+				// Ignore the error and proceed with the original search attributes.
+				unaliasedSas = sas
+			}
+			// Now validate that search attributes are valid.
+			err = adh.saValidator.Validate(unaliasedSas, nsName.String())
+			if err != nil {
+				return nil, err
+			}
+
+			_ = searchattribute.SetToEvent(event, unaliasedSas)
+		}
+		// If blob doesn't have search attributes, it can be used as is w/o serialization.
+		if !hasSas {
+			unaliasedBatches = append(unaliasedBatches, historyBatch)
+			continue
+		}
+
+		unaliasedBatch, err := adh.eventSerializer.SerializeEvents(events)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		unaliasedBatches = append(unaliasedBatches, unaliasedBatch)
+	}
+	return unaliasedBatches, nil
+}
+
+// DescribeMutableState returns information about the specified workflow execution.
+func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *adminservice.DescribeMutableStateRequest) (_ *adminservice.DescribeMutableStateResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	archetypeID, err := adh.validateAndResolveArchetypeID(request.GetArchetype(), request.GetArchetypeId())
+	if err != nil {
+		return nil, err
+	}
+
+	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), request.Execution.WorkflowId, adh.numberOfHistoryShards)
+	shardIDStr := convert.Int32ToString(shardID)
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	historyHost, err := resolver.Lookup(shardIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	historyAddr := historyHost.GetAddress()
+
+	historyResponse, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId:     namespaceID.String(),
+		Execution:       request.Execution,
+		SkipForceReload: request.GetSkipForceReload(),
+		ArchetypeId:     archetypeID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeMutableStateResponse{
+		ShardId:              shardIDStr,
+		HistoryAddr:          historyAddr,
+		DatabaseMutableState: historyResponse.GetDatabaseMutableState(),
+		CacheMutableState:    historyResponse.GetCacheMutableState(),
+	}, nil
+}
+
+// DescribeOutboundDestinationHealth returns the outbound queue circuit breaker state and recent request outcomes of
+// Nexus endpoints and callback destinations, aggregated across all history hosts
+func (adh *AdminHandler) DescribeOutboundDestinationHealth(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundDestinationHealthRequest,
+) (_ *adminservice.DescribeOutboundDestinationHealthResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	var namespaceID namespace.ID
+	if request.GetNamespace() != "" {
+		var err error
+		namespaceID, err = adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return describeOutboundDestinationHealth(
+		ctx,
+		adh.membershipMonitor,
+		adh.historyClient,
+		adh.logger,
+		tasks.TaskGroupNamespaceIDAndDestination{
+			TaskGroup:   request.GetTaskGroup(),
+			NamespaceID: namespaceID.String(),
+			Destination: request.GetDestination(),
+		},
+	)
+}
+
+// UpdateOutboundCircuitBreaker manually trips or resets the outbound queue circuit breakers of a destination on all
+// history hosts. The trip is persisted in the namespace config so that it survives restarts and shard movements and
+// applies to circuit breakers created later. Hosts that miss the call pick up the change on their next namespace
+// refresh.
+func (adh *AdminHandler) UpdateOutboundCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.UpdateOutboundCircuitBreakerRequest,
+) (_ *adminservice.UpdateOutboundCircuitBreakerResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetDestination() == "" {
+		return nil, errDestinationNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := adh.updateNamespaceDetail(ctx, request.GetNamespace(), func(detail *persistencespb.NamespaceDetail) error {
+		detail.Config.OutboundCircuitBreakerTrips = updateOutboundCircuitBreakerTrips(
+			detail.Config.GetOutboundCircuitBreakerTrips(),
+			request.GetTaskGroup(),
+			request.GetDestination(),
+			request.GetTrip(),
+			adh.timeSource.Now(),
+		)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var updatedCount atomic.Int32
+	unreachable, err := callAllHistoryHosts(ctx, adh.membershipMonitor, adh.logger, func(ctx context.Context, hostAddress string) error {
+		resp, err := adh.historyClient.UpdateOutboundCircuitBreaker(ctx, &historyservice.UpdateOutboundCircuitBreakerRequest{
+			HostAddress: hostAddress,
+			NamespaceId: namespaceID.String(),
+			TaskGroup:   request.GetTaskGroup(),
+			Destination: request.GetDestination(),
+			Trip:        request.GetTrip(),
+		})
+		if err != nil {
+			return err
+		}
+		updatedCount.Add(resp.GetUpdatedCount())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.UpdateOutboundCircuitBreakerResponse{
+		UpdatedCount:     updatedCount.Load(),
+		UnreachableHosts: unreachable,
+	}, nil
+}
+
+// CreateWorkflowRule creates a workflow rule with triggers and actions which are evaluated by the history service in
+// addition to the ones of the public rule spec
+func (adh *AdminHandler) CreateWorkflowRule(
+	ctx context.Context,
+	request *adminservice.CreateWorkflowRuleRequest,
+) (_ *adminservice.CreateWorkflowRuleResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if !adh.config.WorkflowRulesAPIsEnabled(request.GetNamespace()) {
+		return nil, serviceerror.NewUnimplemented("method CreateWorkflowRule not supported")
+	}
+	if request.GetSpec() == nil {
+		return nil, serviceerror.NewInvalidArgument("Rule Specification is not set.")
+	}
+	if len(request.GetSpec().GetId()) > adh.config.MaxIDLengthLimit() {
+		return nil, errWorkflowRuleIDTooLong
+	}
+
+	rule, err := adh.workflowRuleHandler.CreateWorkflowRule(ctx,
+		request.GetSpec(),
+		request.GetExtension(),
+		request.GetIdentity(),
+		request.GetDescription(),
+		request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CreateWorkflowRuleResponse{Rule: rule}, nil
+}
+
+// DescribeWorkflowRule returns a workflow rule with its server side triggers and actions and the number of times it
+// matched, aggregated across all history hosts
+func (adh *AdminHandler) DescribeWorkflowRule(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowRuleRequest,
+) (_ *adminservice.DescribeWorkflowRuleResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetRuleId() == "" {
+		return nil, serviceerror.NewInvalidArgument("Workflow Rule ID is not set.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	rule, extension, err := adh.workflowRuleHandler.DescribeWorkflowRule(ctx, request.GetRuleId(), request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	hitCount, unreachable, err := describeWorkflowRuleHits(
+		ctx,
+		adh.membershipMonitor,
+		adh.historyClient,
+		adh.logger,
+		namespaceID.String(),
+		rule,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.DescribeWorkflowRuleResponse{
+		Rule:             rule,
+		Extension:        extension,
+		HitCount:         hitCount,
+		UnreachableHosts: unreachable,
+	}, nil
+}
+
+// DescribePersistenceConcurrencyLimiter returns the state of the adaptive persistence concurrency limiter of this
+// frontend host and of all history and matching hosts
+func (adh *AdminHandler) DescribePersistenceConcurrencyLimiter(
+	ctx context.Context,
+	request *adminservice.DescribePersistenceConcurrencyLimiterRequest,
+) (_ *adminservice.DescribePersistenceConcurrencyLimiterResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	var mu sync.Mutex
+	var states []*healthspb.PersistenceConcurrencyLimiterState
+	if adh.concurrencyLimiter != nil {
+		state := adh.concurrencyLimiter.Describe()
+		state.Service = string(primitives.FrontendService)
+		state.HostAddress = adh.hostInfoProvider.HostInfo().GetAddress()
+		states = append(states, state)
+	}
+	addState := func(state *healthspb.PersistenceConcurrencyLimiterState) {
+		mu.Lock()
+		defer mu.Unlock()
+		states = append(states, state)
+	}
+
+	var unreachable []string
+	for service, fn := range map[primitives.ServiceName]func(ctx context.Context, hostAddress string) error{
+		primitives.HistoryService: func(ctx context.Context, hostAddress string) error {
+			resp, err := adh.historyClient.DescribePersistenceConcurrencyLimiter(ctx, &historyservice.DescribePersistenceConcurrencyLimiterRequest{
+				HostAddress: hostAddress,
+			})
+			if err != nil {
+				return err
+			}
+			addState(resp.GetState())
+			return nil
+		},
+		primitives.MatchingService: func(ctx context.Context, hostAddress string) error {
+			resp, err := adh.matchingClient.DescribePersistenceConcurrencyLimiter(ctx, &matchingservice.DescribePersistenceConcurrencyLimiterRequest{
+				HostAddress: hostAddress,
+			})
+			if err != nil {
+				return err
+			}
+			addState(resp.GetState())
+			return nil
+		},
+	} {
+		serviceUnreachable, err := callAllServiceHosts(ctx, adh.membershipMonitor, service, adh.logger, fn)
+		if err != nil {
+			return nil, err
+		}
+		unreachable = append(unreachable, serviceUnreachable...)
+	}
+
+	slices.SortFunc(states, func(a, b *healthspb.PersistenceConcurrencyLimiterState) int {
+		return cmp.Or(cmp.Compare(a.GetService(), b.GetService()), cmp.Compare(a.GetHostAddress(), b.GetHostAddress()))
+	})
+	slices.Sort(unreachable)
+	return &adminservice.DescribePersistenceConcurrencyLimiterResponse{
+		States:           states,
+		UnreachableHosts: unreachable,
+	}, nil
+}
+
+// DescribeReplicationLag returns how far each remote cluster is behind each shard of the current cluster, as reported by
+// all history hosts, and the sizes of the DLQs of replication tasks received from remote clusters.
+func (adh *AdminHandler) DescribeReplicationLag(
+	ctx context.Context,
+	request *adminservice.DescribeReplicationLagRequest,
+) (_ *adminservice.DescribeReplicationLagResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.clusterMetadata.IsGlobalNamespaceEnabled() {
+		return nil, serviceerror.NewUnavailable("The cluster has global namespace disabled. The operation is not supported.")
+	}
+
+	var mu sync.Mutex
+	var shards []*replicationspb.ShardReplicationLag
+	unreachable, err := callAllHistoryHosts(ctx, adh.membershipMonitor, adh.logger, func(ctx context.Context, hostAddress string) error {
+		resp, err := adh.historyClient.DescribeReplicationLag(ctx, &historyservice.DescribeReplicationLagRequest{
+			HostAddress: hostAddress,
+		})
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		shards = append(shards, resp.GetShards()...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(shards, func(a, b *replicationspb.ShardReplicationLag) int {
+		return cmp.Or(
+			cmp.Compare(a.GetShardId(), b.GetShardId()),
+			cmp.Compare(a.GetRemoteCluster(), b.GetRemoteCluster()),
+		)
+	})
+
+	dlqs, err := adh.getReplicationDLQSizes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.DescribeReplicationLagResponse{
+		Shards:           shards,
+		Dlqs:             dlqs,
+		UnreachableHosts: unreachable,
+	}, nil
+}
+
+// getReplicationDLQSizes returns the size of the DLQ of replication tasks received from each remote cluster which has
+// a DLQ.
+func (adh *AdminHandler) getReplicationDLQSizes(ctx context.Context) ([]*adminservice.ReplicationDLQSize, error) {
+	currentClusterName := adh.clusterMetadata.GetCurrentClusterName()
+	sourceClusters := make(map[string]string)
+	for clusterName := range adh.clusterMetadata.GetAllClusterInfo() {
+		if clusterName == currentClusterName {
+			continue
+		}
+		queueName := persistence.GetHistoryTaskQueueName(tasks.CategoryIDReplication, clusterName, currentClusterName)
+		sourceClusters[queueName] = clusterName
+	}
+
+	var dlqs []*adminservice.ReplicationDLQSize
+	var nextPageToken []byte
+	for {
+		resp, err := adh.historyClient.ListQueues(ctx, &historyservice.ListQueuesRequest{
+			QueueType:     int32(persistence.QueueTypeHistoryDLQ),
+			PageSize:      listQueuesPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, queue := range resp.GetQueues() {
+			sourceCluster, ok := sourceClusters[queue.GetQueueName()]
+			if !ok {
+				continue
+			}
+			dlqs = append(dlqs, &adminservice.ReplicationDLQSize{
+				SourceCluster: sourceCluster,
+				QueueName:     queue.GetQueueName(),
+				MessageCount:  queue.GetMessageCount(),
+			})
+		}
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	slices.SortFunc(dlqs, func(a, b *adminservice.ReplicationDLQSize) int {
+		return cmp.Compare(a.GetSourceCluster(), b.GetSourceCluster())
+	})
+	return dlqs, nil
+}
+
+// UpdateFaultInjection updates the faults injected at runtime into persistence and RPC client calls. Unless
+// forwarding is skipped, the update is applied to all frontend, history and matching hosts.
+func (adh *AdminHandler) UpdateFaultInjection(
+	ctx context.Context,
+	request *adminservice.UpdateFaultInjectionRequest,
+) (_ *adminservice.UpdateFaultInjectionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	for _, fault := range request.GetUpsertFaults() {
+		if err := chaos.ValidateFault(fault); err != nil {
+			return nil, err
+		}
+	}
+
+	hosts, unreachable, err := adh.updateFaultInjection(
+		ctx,
+		request.GetUpsertFaults(),
+		request.GetDeleteFaultIds(),
+		request.GetDeleteAllFaults(),
+		request.GetSkipForwarding(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	adh.logger.Info("Updated runtime fault injection",
+		tag.Counter(len(request.GetUpsertFaults())),
+		tag.NewStringsTag("deleted-fault-ids", request.GetDeleteFaultIds()),
+		tag.NewBoolTag("delete-all-faults", request.GetDeleteAllFaults()),
+		tag.NewStringsTag("unreachable-hosts", unreachable),
+	)
+	return &adminservice.UpdateFaultInjectionResponse{
+		Hosts:            hosts,
+		UnreachableHosts: unreachable,
+	}, nil
+}
+
+// DescribeFaultInjection returns the faults injected at runtime on each frontend, history and matching host.
+func (adh *AdminHandler) DescribeFaultInjection(
+	ctx context.Context,
+	request *adminservice.DescribeFaultInjectionRequest,
+) (_ *adminservice.DescribeFaultInjectionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	hosts, unreachable, err := adh.updateFaultInjection(ctx, nil, nil, false, request.GetSkipForwarding())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeFaultInjectionResponse{
+		Hosts:            hosts,
+		UnreachableHosts: unreachable,
+	}, nil
+}
+
+// updateFaultInjection applies the update to this host and, unless forwarding is skipped, to all other frontend,
+// history and matching hosts. An empty update returns the current faults.
+func (adh *AdminHandler) updateFaultInjection(
+	ctx context.Context,
+	upsertFaults []*faultinjectionspb.Fault,
+	deleteFaultIDs []string,
+	deleteAllFaults bool,
+	skipForwarding bool,
+) ([]*faultinjectionspb.HostFaults, []string, error) {
+	if adh.faultRegistry == nil {
+		return nil, nil, serviceerror.NewUnimplemented("runtime fault injection is not available on this host")
+	}
+	if err := adh.faultRegistry.Update(upsertFaults, deleteFaultIDs, deleteAllFaults); err != nil {
+		return nil, nil, err
+	}
+	localAddress := adh.hostInfoProvider.HostInfo().GetAddress()
+	local := adh.faultRegistry.Describe()
+	local.HostAddress = localAddress
+	hosts := []*faultinjectionspb.HostFaults{local}
+	if skipForwarding {
+		return hosts, nil, nil
+	}
+
+	var mu sync.Mutex
+	addHost := func(host *faultinjectionspb.HostFaults) {
+		mu.Lock()
+		defer mu.Unlock()
+		hosts = append(hosts, host)
+	}
+	var unreachable []string
+	for service, fn := range map[primitives.ServiceName]func(ctx context.Context, hostAddress string) error{
+		primitives.FrontendService: func(ctx context.Context, hostAddress string) error {
+			if hostAddress == localAddress {
+				return nil
+			}
+			adminClient := adh.clientFactory.NewRemoteAdminClientWithTimeout(
+				hostAddress,
+				admin.DefaultTimeout,
+				admin.DefaultLargeTimeout,
+			)
+			resp, err := adminClient.UpdateFaultInjection(ctx, &adminservice.UpdateFaultInjectionRequest{
+				UpsertFaults:    upsertFaults,
+				DeleteFaultIds:  deleteFaultIDs,
+				DeleteAllFaults: deleteAllFaults,
+				SkipForwarding:  true,
+			})
+			if err != nil {
+				return err
+			}
+			for _, host := range resp.GetHosts() {
+				addHost(host)
+			}
+			return nil
+		},
+		primitives.HistoryService: func(ctx context.Context, hostAddress string) error {
+			resp, err := adh.historyClient.UpdateFaultInjection(ctx, &historyservice.UpdateFaultInjectionRequest{
+				HostAddress:     hostAddress,
+				UpsertFaults:    upsertFaults,
+				DeleteFaultIds:  deleteFaultIDs,
+				DeleteAllFaults: deleteAllFaults,
+			})
+			if err != nil {
+				return err
+			}
+			addHost(resp.GetHost())
+			return nil
+		},
+		primitives.MatchingService: func(ctx context.Context, hostAddress string) error {
+			resp, err := adh.matchingClient.UpdateFaultInjection(ctx, &matchingservice.UpdateFaultInjectionRequest{
+				HostAddress:     hostAddress,
+				UpsertFaults:    upsertFaults,
+				DeleteFaultIds:  deleteFaultIDs,
+				DeleteAllFaults: deleteAllFaults,
+			})
+			if err != nil {
+				return err
+			}
+			addHost(resp.GetHost())
+			return nil
+		},
+	} {
+		serviceUnreachable, err := callAllServiceHosts(ctx, adh.membershipMonitor, service, adh.logger, fn)
+		if err != nil {
+			return nil, nil, err
+		}
+		unreachable = append(unreachable, serviceUnreachable...)
+	}
+
+	slices.SortFunc(hosts, func(a, b *faultinjectionspb.HostFaults) int {
+		return cmp.Or(cmp.Compare(a.GetService(), b.GetService()), cmp.Compare(a.GetHostAddress(), b.GetHostAddress()))
+	})
+	slices.Sort(unreachable)
+	return hosts, unreachable, nil
+}
+
+// DescribeNexusEndpoint returns a Nexus endpoint and the health of its outbound destination aggregated across all
+// namespaces and history hosts.
+func (adh *AdminHandler) DescribeNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.DescribeNexusEndpointRequest,
+) (_ *adminservice.DescribeNexusEndpointResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.nexusEndpointClient.Get(ctx, &operatorservice.GetNexusEndpointRequest{Id: request.GetId()})
+	if err != nil {
+		return nil, err
+	}
+	health, err := describeOutboundDestinationHealth(
+		ctx,
+		adh.membershipMonitor,
+		adh.historyClient,
+		adh.logger,
+		tasks.TaskGroupNamespaceIDAndDestination{Destination: resp.GetEndpoint().GetSpec().GetName()},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeNexusEndpointResponse{
+		Endpoint:         resp.GetEndpoint(),
+		Destinations:     health.GetDestinations(),
+		HostDestinations: health.GetHostDestinations(),
+		UnreachableHosts: health.GetUnreachableHosts(),
+	}, nil
+}
+
+// UpdateNexusEndpointHttpTarget replaces the target of an existing Nexus endpoint with a plain HTTP service target.
+func (adh *AdminHandler) UpdateNexusEndpointHttpTarget(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointHttpTargetRequest,
+) (_ *adminservice.UpdateNexusEndpointHttpTargetResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	return adh.nexusEndpointClient.UpdateHTTPTarget(ctx, request)
+}
+
+// ListDeadLetteredCallbacks returns a page of the callbacks of a namespace whose delivery was abandoned
+func (adh *AdminHandler) ListDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.ListDeadLetteredCallbacksRequest,
+) (_ *adminservice.ListDeadLetteredCallbacksResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	namespaceID, err := adh.getCallbackDLQNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adh.historyClient.ListDeadLetteredCallbacks(ctx, &historyservice.ListDeadLetteredCallbacksRequest{
+		NamespaceId:   namespaceID.String(),
+		PageSize:      request.GetPageSize(),
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]*adminservice.DeadLetteredCallbackMessage, len(resp.GetMessages()))
+	for i, message := range resp.GetMessages() {
+		messages[i] = &adminservice.DeadLetteredCallbackMessage{
+			MessageId: message.GetMessageId(),
+			Callback:  message.GetCallback(),
+		}
+	}
+	return &adminservice.ListDeadLetteredCallbacksResponse{
+		Messages:      messages,
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// GetDeadLetteredCallback returns a single dead-lettered callback of a namespace
+func (adh *AdminHandler) GetDeadLetteredCallback(
+	ctx context.Context,
+	request *adminservice.GetDeadLetteredCallbackRequest,
+) (_ *adminservice.GetDeadLetteredCallbackResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	namespaceID, err := adh.getCallbackDLQNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adh.historyClient.ListDeadLetteredCallbacks(ctx, &historyservice.ListDeadLetteredCallbacksRequest{
+		NamespaceId:  namespaceID.String(),
+		PageSize:     1,
+		MinMessageId: request.GetMessageId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetMessages()) == 0 || resp.GetMessages()[0].GetMessageId() != request.GetMessageId() {
+		return nil, serviceerror.NewNotFoundf("dead-lettered callback %d not found", request.GetMessageId())
+	}
+	message := resp.GetMessages()[0]
+	return &adminservice.GetDeadLetteredCallbackResponse{
+		Message: &adminservice.DeadLetteredCallbackMessage{
+			MessageId: message.GetMessageId(),
+			Callback:  message.GetCallback(),
+		},
+	}, nil
+}
+
+// ReplayDeadLetteredCallbacks re-delivers the dead-lettered callbacks of a namespace
+func (adh *AdminHandler) ReplayDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.ReplayDeadLetteredCallbacksRequest,
+) (_ *adminservice.ReplayDeadLetteredCallbacksResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
 
 	if request == nil {
 		return nil, errRequestNotSet
 	}
-
-	if err := validateExecution(request.Execution); err != nil {
+	namespaceID, err := adh.getCallbackDLQNamespaceID(request.GetNamespace())
+	if err != nil {
 		return nil, err
 	}
-
-	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	resp, err := adh.historyClient.ReplayDeadLetteredCallbacks(ctx, &historyservice.ReplayDeadLetteredCallbacksRequest{
+		NamespaceId:           namespaceID.String(),
+		InclusiveMaxMessageId: request.GetInclusiveMaxMessageId(),
+		DeleteDelivered:       request.GetDeleteDelivered(),
+	})
 	if err != nil {
 		return nil, err
 	}
+	return &adminservice.ReplayDeadLetteredCallbacksResponse{
+		DeliveredCount:  resp.GetDeliveredCount(),
+		MessagesDeleted: resp.GetMessagesDeleted(),
+		FailedMessageId: resp.GetFailedMessageId(),
+		FailureMessage:  resp.GetFailureMessage(),
+	}, nil
+}
 
-	unaliasedBatches, err := adh.unaliasAndValidateSearchAttributes(request.HistoryBatches, namespace.Name(request.GetNamespace()))
+// PurgeDeadLetteredCallbacks deletes the dead-lettered callbacks of a namespace up to a message ID
+func (adh *AdminHandler) PurgeDeadLetteredCallbacks(
+	ctx context.Context,
+	request *adminservice.PurgeDeadLetteredCallbacksRequest,
+) (_ *adminservice.PurgeDeadLetteredCallbacksResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	namespaceID, err := adh.getCallbackDLQNamespaceID(request.GetNamespace())
 	if err != nil {
 		return nil, err
 	}
-	resp, err := adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
-		NamespaceId:    namespaceID.String(),
-		Execution:      request.Execution,
-		HistoryBatches: unaliasedBatches,
-		VersionHistory: request.VersionHistory,
-		Token:          request.Token,
+	resp, err := adh.historyClient.PurgeDeadLetteredCallbacks(ctx, &historyservice.PurgeDeadLetteredCallbacksRequest{
+		NamespaceId:           namespaceID.String(),
+		InclusiveMaxMessageId: request.GetInclusiveMaxMessageId(),
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &adminservice.ImportWorkflowExecutionResponse{
-		Token: resp.Token,
+	return &adminservice.PurgeDeadLetteredCallbacksResponse{
+		MessagesDeleted: resp.GetMessagesDeleted(),
 	}, nil
 }
 
-func (adh *AdminHandler) unaliasAndValidateSearchAttributes(historyBatches []*commonpb.DataBlob, nsName namespace.Name) ([]*commonpb.DataBlob, error) {
-	var unaliasedBatches []*commonpb.DataBlob
-	for _, historyBatch := range historyBatches {
-		events, err := adh.eventSerializer.DeserializeEvents(historyBatch)
-		if err != nil {
-			return nil, serviceerror.NewInvalidArgument(err.Error())
-		}
-		hasSas := false
-		for _, event := range events {
-			sas, _ := searchattribute.GetFromEvent(event)
-			if sas == nil {
-				continue
-			}
-			hasSas = true
+func (adh *AdminHandler) getCallbackDLQNamespaceID(namespaceName string) (namespace.ID, error) {
+	if namespaceName == "" {
+		return "", errNamespaceNotSet
+	}
+	return adh.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+}
 
-			unaliasedSas, err := searchattribute.UnaliasFields(adh.saMapperProvider, sas, nsName.String())
-			if err != nil {
-				var invArgErr *serviceerror.InvalidArgument
-				if !errors.As(err, &invArgErr) {
-					return nil, err
-				}
-				// Mapper returns InvalidArgument if alias is not found. It means that history has field names, not aliases.
-				// Ignore the error and proceed with the original search attributes.
-				unaliasedSas = sas
-			}
-			// Now validate that search attributes are valid.
-			err = adh.saValidator.Validate(unaliasedSas, nsName.String())
-			if err != nil {
-				return nil, err
-			}
+// RotateCallbackSigningKey adds a new signing key for the outbound completion callbacks of a namespace
+func (adh *AdminHandler) RotateCallbackSigningKey(
+	ctx context.Context,
+	request *adminservice.RotateCallbackSigningKeyRequest,
+) (_ *adminservice.RotateCallbackSigningKeyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
 
-			_ = searchattribute.SetToEvent(event, unaliasedSas)
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	keyID := request.GetKeyId()
+	if keyID == "" {
+		keyID = uuid.NewString()
+	} else if err := validateCallbackSigningKeyID(keyID); err != nil {
+		return nil, err
+	}
+	secret := request.GetSecret()
+	if len(secret) == 0 {
+		secret = make([]byte, callbackSigningKeySecretSize)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, serviceerror.NewInternalf("failed to generate callback signing key secret: %v", err)
 		}
-		// If blob doesn't have search attributes, it can be used as is w/o serialization.
-		if !hasSas {
-			unaliasedBatches = append(unaliasedBatches, historyBatch)
-			continue
+	}
+	overlap := defaultCallbackSigningKeyOverlap
+	if request.GetPreviousKeyOverlap() != nil {
+		overlap = request.GetPreviousKeyOverlap().AsDuration()
+		if overlap < 0 {
+			return nil, serviceerror.NewInvalidArgument("previous key overlap must not be negative")
 		}
+	}
 
-		unaliasedBatch, err := adh.eventSerializer.SerializeEvents(events)
-		if err != nil {
-			return nil, serviceerror.NewInvalidArgument(err.Error())
+	now := adh.timeSource.Now()
+	key := &persistencespb.CallbackSigningKey{
+		Id:         keyID,
+		Secret:     secret,
+		CreateTime: timestamppb.New(now),
+	}
+	err := adh.updateCallbackSigningKeys(ctx, request.GetNamespace(), func(keys []*persistencespb.CallbackSigningKey) ([]*persistencespb.CallbackSigningKey, error) {
+		expireTime := now.Add(overlap)
+		// Expired keys no longer sign callbacks and are dropped on rotation.
+		remaining := commonnexus.ActiveCallbackSigningKeys(keys, now)
+		for _, existing := range remaining {
+			if existing.GetId() == keyID {
+				return nil, serviceerror.NewAlreadyExistsf("callback signing key %q already exists", keyID)
+			}
+			if existing.GetExpireTime() == nil || existing.GetExpireTime().AsTime().After(expireTime) {
+				existing.ExpireTime = timestamppb.New(expireTime)
+			}
 		}
-		unaliasedBatches = append(unaliasedBatches, unaliasedBatch)
+		return append(remaining, key), nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return unaliasedBatches, nil
+	return &adminservice.RotateCallbackSigningKeyResponse{Key: key}, nil
 }
 
-// DescribeMutableState returns information about the specified workflow execution.
-func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *adminservice.DescribeMutableStateRequest) (_ *adminservice.DescribeMutableStateResponse, retError error) {
+// ListCallbackSigningKeys returns the callback signing keys of a namespace without their secrets
+func (adh *AdminHandler) ListCallbackSigningKeys(
+	ctx context.Context,
+	request *adminservice.ListCallbackSigningKeysRequest,
+) (_ *adminservice.ListCallbackSigningKeysResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
 
 	if request == nil {
 		return nil, errRequestNotSet
 	}
-
-	if err := validateExecution(request.Execution); err != nil {
-		return nil, err
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
 	}
-
-	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: request.GetNamespace()})
 	if err != nil {
 		return nil, err
 	}
+	keys := resp.Namespace.GetConfig().GetCallbackSigningKeys()
+	result := make([]*persistencespb.CallbackSigningKey, len(keys))
+	for i, key := range keys {
+		result[i] = &persistencespb.CallbackSigningKey{
+			Id:         key.GetId(),
+			CreateTime: key.GetCreateTime(),
+			ExpireTime: key.GetExpireTime(),
+		}
+	}
+	return &adminservice.ListCallbackSigningKeysResponse{Keys: result}, nil
+}
 
-	archetypeID, err := adh.validateAndResolveArchetypeID(request.GetArchetype(), request.GetArchetypeId())
+// DeleteCallbackSigningKey removes a callback signing key of a namespace
+func (adh *AdminHandler) DeleteCallbackSigningKey(
+	ctx context.Context,
+	request *adminservice.DeleteCallbackSigningKeyRequest,
+) (_ *adminservice.DeleteCallbackSigningKeyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetKeyId() == "" {
+		return nil, serviceerror.NewInvalidArgument("callback signing key ID is not set")
+	}
+	err := adh.updateCallbackSigningKeys(ctx, request.GetNamespace(), func(keys []*persistencespb.CallbackSigningKey) ([]*persistencespb.CallbackSigningKey, error) {
+		for i, key := range keys {
+			if key.GetId() == request.GetKeyId() {
+				return slices.Delete(keys, i, i+1), nil
+			}
+		}
+		return nil, serviceerror.NewNotFoundf("callback signing key %q not found", request.GetKeyId())
+	})
 	if err != nil {
 		return nil, err
 	}
+	return &adminservice.DeleteCallbackSigningKeyResponse{}, nil
+}
 
-	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), request.Execution.WorkflowId, adh.numberOfHistoryShards)
-	shardIDStr := convert.Int32ToString(shardID)
+func (adh *AdminHandler) updateCallbackSigningKeys(
+	ctx context.Context,
+	namespaceName string,
+	update func([]*persistencespb.CallbackSigningKey) ([]*persistencespb.CallbackSigningKey, error),
+) error {
+	_, err := adh.updateNamespaceDetail(ctx, namespaceName, func(detail *persistencespb.NamespaceDetail) error {
+		keys, err := update(detail.Config.GetCallbackSigningKeys())
+		if err != nil {
+			return err
+		}
+		detail.Config.CallbackSigningKeys = keys
+		return nil
+	})
+	return err
+}
 
-	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+// updateNamespaceDetail applies the update to the persisted namespace and returns the persistence request. The update
+// fails if the namespace metadata changed concurrently. Only the notification version is bumped: the config version
+// gates namespace replication, so updates that are replicated to other clusters must bump it themselves.
+func (adh *AdminHandler) updateNamespaceDetail(
+	ctx context.Context,
+	namespaceName string,
+	update func(*persistencespb.NamespaceDetail) error,
+) (*persistence.UpdateNamespaceRequest, error) {
+	if namespaceName == "" {
+		return nil, errNamespaceNotSet
+	}
+	metadata, err := adh.persistenceMetadataManager.GetMetadata(ctx)
 	if err != nil {
 		return nil, err
 	}
-	historyHost, err := resolver.Lookup(shardIDStr)
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: namespaceName})
 	if err != nil {
 		return nil, err
 	}
-
-	historyAddr := historyHost.GetAddress()
-
-	historyResponse, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
-		NamespaceId:     namespaceID.String(),
-		Execution:       request.Execution,
-		SkipForceReload: request.GetSkipForceReload(),
-		ArchetypeId:     archetypeID,
-	})
-
-	if err != nil {
+	existing := resp.Namespace
+	if err := update(existing); err != nil {
 		return nil, err
 	}
-	return &adminservice.DescribeMutableStateResponse{
-		ShardId:              shardIDStr,
-		HistoryAddr:          historyAddr,
-		DatabaseMutableState: historyResponse.GetDatabaseMutableState(),
-		CacheMutableState:    historyResponse.GetCacheMutableState(),
-	}, nil
+	request := &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        existing.Info,
+			Config:                      existing.Config,
+			ReplicationConfig:           existing.ReplicationConfig,
+			ConfigVersion:               existing.ConfigVersion,
+			FailoverVersion:             existing.FailoverVersion,
+			FailoverNotificationVersion: existing.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   resp.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	}
+	if err := adh.persistenceMetadataManager.UpdateNamespace(ctx, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// validateCallbackSigningKeyID rejects key IDs that would break the format of the signature header.
+func validateCallbackSigningKeyID(keyID string) error {
+	if len(keyID) > maxCallbackSigningKeyIDLength {
+		return serviceerror.NewInvalidArgumentf("callback signing key ID must not exceed %d characters", maxCallbackSigningKeyIDLength)
+	}
+	for _, r := range keyID {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return serviceerror.NewInvalidArgument("callback signing key ID may only contain letters, digits, '-', '_' and '.'")
+		}
+	}
+	return nil
 }
 
 // RemoveTask returns information about the internal states of a history host
@@ -1796,6 +3015,187 @@ func (adh *AdminHandler) DescribeTaskQueuePartition(
 	}, nil
 }
 
+// GetWorkerScalingRecommendation returns the number of workers a task queue needs for a deployment version.
+func (adh *AdminHandler) GetWorkerScalingRecommendation(
+	ctx context.Context,
+	request *adminservice.GetWorkerScalingRecommendationRequest,
+) (_ *adminservice.GetWorkerScalingRecommendationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	// Validates the task queue name.
+	if _, err := tqid.NewTaskQueueFamily(namespaceID.String(), request.GetTaskQueue()); err != nil {
+		return nil, err
+	}
+	taskQueueType := request.GetTaskQueueType()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		taskQueueType = enumspb.TASK_QUEUE_TYPE_WORKFLOW
+	}
+
+	resp, err := adh.matchingClient.GetWorkerScalingRecommendation(ctx, &matchingservice.GetWorkerScalingRecommendationRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name: request.GetTaskQueue(),
+			Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+		},
+		TaskQueueType: taskQueueType,
+		Version: worker_versioning.DeploymentVersionFromDeployment(
+			worker_versioning.DeploymentFromExternalDeploymentVersion(request.GetDeploymentVersion())),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.GetWorkerScalingRecommendationResponse{
+		Recommendation: resp.GetRecommendation(),
+	}, nil
+}
+
+// DescribeWorker returns a worker of a namespace with its recent heartbeats and its liveness.
+func (adh *AdminHandler) DescribeWorker(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerRequest,
+) (_ *adminservice.DescribeWorkerResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetWorkerInstanceKey()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("WorkerInstanceKey is not set on request.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adh.matchingClient.DescribeWorker(ctx, &matchingservice.DescribeWorkerRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.DescribeWorkerRequest{
+			Namespace:         request.GetNamespace(),
+			WorkerInstanceKey: request.GetWorkerInstanceKey(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.DescribeWorkerResponse{
+		WorkerInfo:       resp.GetWorkerInfo(),
+		HeartbeatHistory: resp.GetHeartbeatHistory(),
+		Liveness:         resp.GetLiveness(),
+	}, nil
+}
+
+// StartWorkerDeploymentRollout starts a managed rollout of a Worker Deployment Version. The version is ramped up
+// through the steps of the plan as long as its health gates pass, and becomes current after the last step.
+func (adh *AdminHandler) StartWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.StartWorkerDeploymentRolloutRequest,
+) (_ *adminservice.StartWorkerDeploymentRolloutResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	namespaceEntry, err := adh.getWorkerDeploymentNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	rollout, err := adh.workerDeploymentClient.StartRollout(
+		ctx,
+		namespaceEntry,
+		request.GetDeploymentName(),
+		request.GetBuildId(),
+		request.GetPlan(),
+		request.GetIdentity(),
+		request.GetIgnoreMissingTaskQueues(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartWorkerDeploymentRolloutResponse{Rollout: rollout}, nil
+}
+
+// UpdateWorkerDeploymentRollout pauses, resumes, cancels or rolls back the managed rollout of a Worker Deployment.
+func (adh *AdminHandler) UpdateWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerDeploymentRolloutRequest,
+) (_ *adminservice.UpdateWorkerDeploymentRolloutResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	namespaceEntry, err := adh.getWorkerDeploymentNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	rollout, err := adh.workerDeploymentClient.UpdateRollout(
+		ctx,
+		namespaceEntry,
+		request.GetDeploymentName(),
+		request.GetAction(),
+		request.GetIdentity(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateWorkerDeploymentRolloutResponse{Rollout: rollout}, nil
+}
+
+// DescribeWorkerDeploymentRollout returns the last managed rollout of a Worker Deployment, including its health
+// checks and transitions.
+func (adh *AdminHandler) DescribeWorkerDeploymentRollout(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerDeploymentRolloutRequest,
+) (_ *adminservice.DescribeWorkerDeploymentRolloutResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	namespaceEntry, err := adh.getWorkerDeploymentNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	rollout, err := adh.workerDeploymentClient.DescribeRollout(ctx, namespaceEntry, request.GetDeploymentName())
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeWorkerDeploymentRolloutResponse{Rollout: rollout}, nil
+}
+
+func (adh *AdminHandler) getWorkerDeploymentNamespace(namespaceName string) (*namespace.Namespace, error) {
+	if len(namespaceName) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if !adh.config.EnableDeploymentVersions(namespaceName) {
+		return nil, errDeploymentVersionsNotAllowed
+	}
+	return adh.namespaceRegistry.GetNamespace(namespace.Name(namespaceName))
+}
+
 // ForceUnloadTaskQueuePartition forcefully unloads a given task queue partition
 func (adh *AdminHandler) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
@@ -2100,10 +3500,11 @@ func (adh *AdminHandler) GetNamespace(ctx context.Context, request *adminservice
 			Clusters:          convertClusterReplicationConfigToProto(replicationConfig.Clusters),
 			State:             replicationConfig.GetState(),
 		},
-		ConfigVersion:     resp.Namespace.GetConfigVersion(),
-		FailoverVersion:   resp.Namespace.GetFailoverVersion(),
-		IsGlobalNamespace: resp.IsGlobalNamespace,
-		FailoverHistory:   convertFailoverHistoryToReplicationProto(resp.Namespace.GetReplicationConfig().GetFailoverHistory()),
+		ConfigVersion:                   resp.Namespace.GetConfigVersion(),
+		FailoverVersion:                 resp.Namespace.GetFailoverVersion(),
+		IsGlobalNamespace:               resp.IsGlobalNamespace,
+		FailoverHistory:                 convertFailoverHistoryToReplicationProto(resp.Namespace.GetReplicationConfig().GetFailoverHistory()),
+		SearchAttributeAliasTransitions: nsConfig.GetSearchAttributeAliasTransitions(),
 	}
 	return nsResponse, nil
 }
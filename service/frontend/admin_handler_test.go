@@ -8,23 +8,29 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
+	deploymentpb "go.temporal.io/api/deployment/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workerpb "go.temporal.io/api/worker/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
+	deploymentspb "go.temporal.io/server/api/deployment/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	healthspb "go.temporal.io/server/api/health/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -51,20 +57,26 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/searchattribute/sadefs"
 	serviceerror2 "go.temporal.io/server/common/serviceerror"
 	test "go.temporal.io/server/common/testing"
 	"go.temporal.io/server/common/testing/historyrequire"
 	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/common/testing/protomock"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/common/testing/testvars"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/visibilitybackfill"
+	"go.temporal.io/server/service/worker/workerdeployment"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -185,6 +197,10 @@ func (s *adminHandlerSuite) SetupTest() {
 		chasmRegistry,
 		nsreplication.NewNoopDataMerger(),
 		nil, // schedulerClient - not needed for most admin handler tests
+		nil, // nexusEndpointClient - not needed for most admin handler tests
+		nil, // concurrencyLimiter - not needed for most admin handler tests
+		nil, // faultRegistry - not needed for most admin handler tests
+		nil, // workerDeploymentClient - not needed for most admin handler tests
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
 	}
@@ -1190,6 +1206,282 @@ func (s *adminHandlerSuite) TestGetNamespace_EmptyRequest() {
 	s.Equal(errRequestNotSet, err)
 }
 
+func (s *adminHandlerSuite) TestRotateCallbackSigningKey() {
+	namespaceName := "some name"
+	now := time.Now()
+	nsResponse := &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:          &persistencespb.NamespaceInfo{Id: "some id", Name: namespaceName},
+			ConfigVersion: 3,
+			Config: &persistencespb.NamespaceConfig{
+				CallbackSigningKeys: []*persistencespb.CallbackSigningKey{
+					{Id: "expired", Secret: []byte("a"), ExpireTime: timestamppb.New(now.Add(-time.Minute))},
+					{Id: "current", Secret: []byte("b")},
+				},
+			},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	}
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: namespaceName,
+	}).Return(nsResponse, nil)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(3), request.Namespace.ConfigVersion)
+			s.Equal(int64(7), request.NotificationVersion)
+			keys := request.Namespace.Config.GetCallbackSigningKeys()
+			s.Len(keys, 2)
+			s.Equal("current", keys[0].GetId())
+			s.WithinDuration(now.Add(time.Hour), keys[0].GetExpireTime().AsTime(), time.Minute)
+			s.Equal("new", keys[1].GetId())
+			s.Nil(keys[1].GetExpireTime())
+			return nil
+		})
+
+	resp, err := s.handler.RotateCallbackSigningKey(context.Background(), &adminservice.RotateCallbackSigningKeyRequest{
+		Namespace:          namespaceName,
+		KeyId:              "new",
+		PreviousKeyOverlap: durationpb.New(time.Hour),
+	})
+	s.NoError(err)
+	s.Equal("new", resp.GetKey().GetId())
+	s.Len(resp.GetKey().GetSecret(), callbackSigningKeySecretSize)
+}
+
+func (s *adminHandlerSuite) TestRotateCallbackSigningKey_InvalidKeyID() {
+	_, err := s.handler.RotateCallbackSigningKey(context.Background(), &adminservice.RotateCallbackSigningKeyRequest{
+		Namespace: "some name",
+		KeyId:     "a,b",
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) newSearchAttributeNamespaceResponse() *persistence.GetNamespaceResponse {
+	return &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:          &persistencespb.NamespaceInfo{Id: "some id", Name: "some name"},
+			ConfigVersion: 3,
+			Config: &persistencespb.NamespaceConfig{
+				CustomSearchAttributeAliases: map[string]string{
+					"Keyword01":     "CustomerId",
+					"KeywordList01": "Tags",
+				},
+			},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	}
+}
+
+func (s *adminHandlerSuite) TestRenameSearchAttributeAlias() {
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("").AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", true).Return(searchattribute.TestNameTypeMap(), nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: "some name",
+	}).Return(s.newSearchAttributeNamespaceResponse(), nil)
+	now := time.Now()
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(4), request.Namespace.ConfigVersion)
+			s.Equal(int64(7), request.NotificationVersion)
+			config := request.Namespace.GetConfig()
+			s.Equal("AccountId", config.GetCustomSearchAttributeAliases()["Keyword01"])
+			transition := config.GetSearchAttributeAliasTransitions()["CustomerId"]
+			s.Equal("Keyword01", transition.GetFieldName())
+			s.WithinDuration(now.Add(time.Hour), transition.GetExpireTime().AsTime(), time.Minute)
+			return nil
+		})
+
+	_, err := s.handler.RenameSearchAttributeAlias(context.Background(), &adminservice.RenameSearchAttributeAliasRequest{
+		Namespace:        "some name",
+		SearchAttribute:  "CustomerId",
+		NewAlias:         "AccountId",
+		TransitionWindow: durationpb.New(time.Hour),
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestRenameSearchAttributeAlias_Errors() {
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("").AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", true).Return(searchattribute.TestNameTypeMap(), nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			return s.newSearchAttributeNamespaceResponse(), nil
+		}).AnyTimes()
+
+	testCases := []struct {
+		name     string
+		request  *adminservice.RenameSearchAttributeAliasRequest
+		expected error
+	}{
+		{
+			name:     "missing new alias",
+			request:  &adminservice.RenameSearchAttributeAliasRequest{Namespace: "some name", SearchAttribute: "CustomerId"},
+			expected: &serviceerror.InvalidArgument{},
+		},
+		{
+			name:     "reserved new alias",
+			request:  &adminservice.RenameSearchAttributeAliasRequest{Namespace: "some name", SearchAttribute: "CustomerId", NewAlias: "WorkflowId"},
+			expected: &serviceerror.InvalidArgument{},
+		},
+		{
+			name:     "unknown alias",
+			request:  &adminservice.RenameSearchAttributeAliasRequest{Namespace: "some name", SearchAttribute: "Unknown", NewAlias: "AccountId"},
+			expected: &serviceerror.NotFound{},
+		},
+		{
+			name:     "new alias in use",
+			request:  &adminservice.RenameSearchAttributeAliasRequest{Namespace: "some name", SearchAttribute: "CustomerId", NewAlias: "Tags"},
+			expected: &serviceerror.AlreadyExists{},
+		},
+	}
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			_, err := s.handler.RenameSearchAttributeAlias(context.Background(), testCase.request)
+			s.IsType(testCase.expected, err)
+		})
+	}
+}
+
+func (s *adminHandlerSuite) TestMigrateSearchAttributeType() {
+	s.mockVisibilityMgr.EXPECT().HasStoreName(elasticsearch.PersistenceName).Return(false).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("").AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", true).Return(searchattribute.TestNameTypeMap(), nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(s.newSearchAttributeNamespaceResponse(), nil)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			migration := request.Namespace.GetConfig().GetSearchAttributeTypeMigrations()["Keyword01"]
+			s.Equal("CustomerId", migration.GetAlias())
+			s.Equal(enumspb.INDEXED_VALUE_TYPE_KEYWORD, migration.GetSourceType())
+			s.Equal(enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, migration.GetTargetType())
+			// KeywordList01 is already allocated.
+			s.NotEqual("KeywordList01", migration.GetTargetFieldName())
+			s.True(sadefs.IsPreallocatedCSAFieldName(migration.GetTargetFieldName(), enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST))
+			return nil
+		})
+
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetID().Return("workflow-id")
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(gomock.Any(), gomock.Any(), "temporal-sys-search-attribute-migration-workflow", gomock.Any()).Return(mockRun, nil)
+
+	resp, err := s.handler.MigrateSearchAttributeType(context.Background(), &adminservice.MigrateSearchAttributeTypeRequest{
+		Namespace:       "some name",
+		SearchAttribute: "CustomerId",
+		Type:            enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+	})
+	s.NoError(err)
+	s.Equal("CustomerId", resp.GetMigration().GetAlias())
+	s.Equal("workflow-id", resp.GetWorkflowId())
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) TestStartVisibilityBackfill() {
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetID().Return(visibilitybackfill.WorkflowID)
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		sdkclient.StartWorkflowOptions{
+			TaskQueue:                primitives.DefaultWorkerTaskQueue,
+			ID:                       visibilitybackfill.WorkflowID,
+			WorkflowIDConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+		},
+		visibilitybackfill.WorkflowName,
+		visibilitybackfill.BackfillParams{ConcurrentShardCount: 8, RPS: 500},
+	).Return(mockRun, nil)
+
+	resp, err := s.handler.StartVisibilityBackfill(context.Background(), &adminservice.StartVisibilityBackfillRequest{
+		ConcurrentShardCount: 8,
+		Rps:                  500,
+	})
+	s.NoError(err)
+	s.Equal(visibilitybackfill.WorkflowID, resp.GetWorkflowId())
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) TestDescribeVisibilityBackfill() {
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), visibilitybackfill.WorkflowID, "").Return(
+		&workflowservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: visibilitybackfill.WorkflowID, RunId: "run-id"},
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			},
+		}, nil)
+	mockValue := mocksdk.NewMockEncodedValue(s.controller)
+	mockValue.EXPECT().Get(gomock.Any()).Do(func(result any) {
+		*(result.(*visibilitybackfill.BackfillStatus)) = visibilitybackfill.BackfillStatus{
+			BackfillProgress: visibilitybackfill.BackfillProgress{
+				CompletedShardCount: 4,
+				BackfilledCount:     10,
+				SkippedCount:        2,
+			},
+			ShardCount: 4,
+			CountMismatches: []visibilitybackfill.CountMismatch{{
+				NamespaceID:    "ns-id",
+				Namespace:      "ns",
+				ArchetypeID:    chasm.WorkflowArchetypeID,
+				Archetype:      chasm.WorkflowComponentName,
+				PrimaryCount:   6,
+				SecondaryCount: 5,
+			}},
+		}
+	})
+	mockSdkClient.EXPECT().QueryWorkflow(gomock.Any(), visibilitybackfill.WorkflowID, "run-id", visibilitybackfill.StatusQueryType).
+		Return(mockValue, nil)
+
+	resp, err := s.handler.DescribeVisibilityBackfill(context.Background(), &adminservice.DescribeVisibilityBackfillRequest{})
+	s.NoError(err)
+	s.Equal("run-id", resp.GetRunId())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, resp.GetStatus())
+	s.Equal(int32(4), resp.GetShardCount())
+	s.Equal(int32(4), resp.GetCompletedShardCount())
+	s.Equal(int64(10), resp.GetBackfilledCount())
+	s.Equal(int64(2), resp.GetSkippedCount())
+	s.Len(resp.GetCountMismatches(), 1)
+	s.Equal(chasm.WorkflowArchetypeID, resp.GetCountMismatches()[0].GetArchetypeId())
+	s.Equal(int64(6), resp.GetCountMismatches()[0].GetPrimaryCount())
+	s.Equal(int64(5), resp.GetCountMismatches()[0].GetSecondaryCount())
+}
+
+func (s *adminHandlerSuite) TestMigrateSearchAttributeType_UnsupportedType() {
+	s.mockVisibilityMgr.EXPECT().HasStoreName(elasticsearch.PersistenceName).Return(false).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("").AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", true).Return(searchattribute.TestNameTypeMap(), nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(s.newSearchAttributeNamespaceResponse(), nil)
+
+	_, err := s.handler.MigrateSearchAttributeType(context.Background(), &adminservice.MigrateSearchAttributeTypeRequest{
+		Namespace:       "some name",
+		SearchAttribute: "CustomerId",
+		Type:            enumspb.INDEXED_VALUE_TYPE_BOOL,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestMigrateSearchAttributeType_Elasticsearch() {
+	s.mockVisibilityMgr.EXPECT().HasStoreName(elasticsearch.PersistenceName).Return(true).AnyTimes()
+
+	_, err := s.handler.MigrateSearchAttributeType(context.Background(), &adminservice.MigrateSearchAttributeTypeRequest{
+		Namespace:       "some name",
+		SearchAttribute: "CustomerId",
+		Type:            enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+}
+
 func (s *adminHandlerSuite) TestGetDLQTasks() {
 	for _, tc := range []struct {
 		name string
@@ -1857,6 +2149,185 @@ func (s *adminHandlerSuite) TestDescribeTaskQueuePartition() {
 	s.validatePhysicalTaskQueueInfo(versionedPhysicalTaskQueueInfo, resp.VersionsInfoInternal[buildID].GetPhysicalTaskQueueInfo())
 }
 
+func (s *adminHandlerSuite) TestGetWorkerScalingRecommendation() {
+	handler := s.handler
+	ctx := context.Background()
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+
+	type test struct {
+		Name     string
+		Request  *adminservice.GetWorkerScalingRecommendationRequest
+		Expected error
+	}
+	// request validation tests
+	errorCases := []test{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: &serviceerror.InvalidArgument{Message: "Request is nil."},
+		},
+		{
+			Name:     "empty request",
+			Request:  &adminservice.GetWorkerScalingRecommendationRequest{},
+			Expected: &serviceerror.InvalidArgument{Message: "Namespace is not set on request."},
+		},
+		{
+			Name:     "no task queue",
+			Request:  &adminservice.GetWorkerScalingRecommendationRequest{Namespace: s.namespace.String()},
+			Expected: &serviceerror.InvalidArgument{Message: "TaskQueue is not set on request."},
+		},
+	}
+	for _, test := range errorCases {
+		s.T().Run(test.Name, func(t *testing.T) {
+			resp, err := handler.GetWorkerScalingRecommendation(ctx, test.Request)
+			s.Equal(test.Expected, err)
+			s.Nil(resp)
+		})
+	}
+
+	recommendation := &taskqueuespb.WorkerScalingRecommendation{
+		CurrentWorkers: 2,
+		DesiredWorkers: 4,
+		Reason:         "slot utilization 1.60, target 0.80",
+	}
+	s.mockMatchingClient.EXPECT().GetWorkerScalingRecommendation(ctx, &matchingservice.GetWorkerScalingRecommendationRequest{
+		NamespaceId:   s.namespaceID.String(),
+		TaskQueue:     &taskqueuepb.TaskQueue{Name: "hello-world", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		Version:       &deploymentspb.WorkerDeploymentVersion{DeploymentName: "deployment", BuildId: "build"},
+	}).Return(&matchingservice.GetWorkerScalingRecommendationResponse{Recommendation: recommendation}, nil).Times(1)
+
+	resp, err := handler.GetWorkerScalingRecommendation(ctx, &adminservice.GetWorkerScalingRecommendationRequest{
+		Namespace:         s.namespace.String(),
+		TaskQueue:         "hello-world",
+		DeploymentVersion: &deploymentpb.WorkerDeploymentVersion{DeploymentName: "deployment", BuildId: "build"},
+	})
+	s.NoError(err)
+	s.Equal(recommendation, resp.GetRecommendation())
+}
+
+func (s *adminHandlerSuite) TestDescribeWorker() {
+	handler := s.handler
+	ctx := context.Background()
+
+	_, err := handler.DescribeWorker(ctx, &adminservice.DescribeWorkerRequest{Namespace: s.namespace.String()})
+	s.Equal(&serviceerror.InvalidArgument{Message: "WorkerInstanceKey is not set on request."}, err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	heartbeats := []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker"}, {WorkerInstanceKey: "worker"}}
+	s.mockMatchingClient.EXPECT().DescribeWorker(ctx, &matchingservice.DescribeWorkerRequest{
+		NamespaceId: s.namespaceID.String(),
+		Request: &workflowservice.DescribeWorkerRequest{
+			Namespace:         s.namespace.String(),
+			WorkerInstanceKey: "worker",
+		},
+	}).Return(&matchingservice.DescribeWorkerResponse{
+		WorkerInfo:       &workerpb.WorkerInfo{WorkerHeartbeat: &workerpb.WorkerHeartbeat{WorkerInstanceKey: "worker"}},
+		HeartbeatHistory: heartbeats,
+		Liveness:         "Stale",
+	}, nil)
+
+	resp, err := handler.DescribeWorker(ctx, &adminservice.DescribeWorkerRequest{
+		Namespace:         s.namespace.String(),
+		WorkerInstanceKey: "worker",
+	})
+	s.NoError(err)
+	s.Equal("worker", resp.GetWorkerInfo().GetWorkerHeartbeat().GetWorkerInstanceKey())
+	s.Equal(heartbeats, resp.GetHeartbeatHistory())
+	s.Equal("Stale", resp.GetLiveness())
+}
+
+// rolloutTestClient implements the rollout methods of workerdeployment.Client.
+type rolloutTestClient struct {
+	workerdeployment.Client
+	rollout *deploymentspb.WorkerDeploymentRollout
+	calls   []string
+}
+
+func (c *rolloutTestClient) StartRollout(
+	_ context.Context,
+	_ *namespace.Namespace,
+	deploymentName string,
+	buildID string,
+	_ *deploymentspb.WorkerDeploymentRolloutPlan,
+	identity string,
+	_ bool,
+) (*deploymentspb.WorkerDeploymentRollout, error) {
+	c.calls = append(c.calls, "start "+deploymentName+" "+buildID+" "+identity)
+	return c.rollout, nil
+}
+
+func (c *rolloutTestClient) UpdateRollout(
+	_ context.Context,
+	_ *namespace.Namespace,
+	deploymentName string,
+	action enumsspb.WorkerDeploymentRolloutAction,
+	identity string,
+) (*deploymentspb.WorkerDeploymentRollout, error) {
+	c.calls = append(c.calls, "update "+deploymentName+" "+action.String()+" "+identity)
+	return c.rollout, nil
+}
+
+func (c *rolloutTestClient) DescribeRollout(
+	_ context.Context,
+	_ *namespace.Namespace,
+	deploymentName string,
+) (*deploymentspb.WorkerDeploymentRollout, error) {
+	c.calls = append(c.calls, "describe "+deploymentName)
+	return c.rollout, nil
+}
+
+func (s *adminHandlerSuite) TestWorkerDeploymentRollout() {
+	handler := s.handler
+	ctx := context.Background()
+	client := &rolloutTestClient{
+		rollout: &deploymentspb.WorkerDeploymentRollout{Status: enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING},
+	}
+	handler.workerDeploymentClient = client
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil).AnyTimes()
+
+	handler.config.EnableDeploymentVersions = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	_, err := handler.DescribeWorkerDeploymentRollout(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+	_, err = handler.DescribeWorkerDeploymentRollout(ctx, &adminservice.DescribeWorkerDeploymentRolloutRequest{})
+	s.Equal(errNamespaceNotSet, err)
+	_, err = handler.StartWorkerDeploymentRollout(ctx, &adminservice.StartWorkerDeploymentRolloutRequest{Namespace: s.namespace.String()})
+	s.Equal(errDeploymentVersionsNotAllowed, err)
+
+	handler.config.EnableDeploymentVersions = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	startResp, err := handler.StartWorkerDeploymentRollout(ctx, &adminservice.StartWorkerDeploymentRolloutRequest{
+		Namespace:      s.namespace.String(),
+		DeploymentName: "deployment",
+		BuildId:        "build",
+		Identity:       "user",
+	})
+	s.NoError(err)
+	s.Equal(client.rollout, startResp.GetRollout())
+
+	updateResp, err := handler.UpdateWorkerDeploymentRollout(ctx, &adminservice.UpdateWorkerDeploymentRolloutRequest{
+		Namespace:      s.namespace.String(),
+		DeploymentName: "deployment",
+		Action:         enumsspb.WORKER_DEPLOYMENT_ROLLOUT_ACTION_PAUSE,
+		Identity:       "user",
+	})
+	s.NoError(err)
+	s.Equal(client.rollout, updateResp.GetRollout())
+
+	describeResp, err := handler.DescribeWorkerDeploymentRollout(ctx, &adminservice.DescribeWorkerDeploymentRolloutRequest{
+		Namespace:      s.namespace.String(),
+		DeploymentName: "deployment",
+	})
+	s.NoError(err)
+	s.Equal(client.rollout, describeResp.GetRollout())
+
+	s.Equal([]string{
+		"start deployment build user",
+		"update deployment Pause user",
+		"describe deployment",
+	}, client.calls)
+}
+
 func (s *adminHandlerSuite) TestImportWorkflowExecution_NoSearchAttributes() {
 	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
 
@@ -2343,3 +2814,115 @@ func (s *adminHandlerSuite) TestGetTaskQueueUserData() {
 		s.Nil(resp)
 	})
 }
+
+func (s *adminHandlerSuite) TestGetReplicationDLQSizes() {
+	ctx := context.Background()
+	currentClusterName := s.mockMetadata.GetCurrentClusterName()
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		currentClusterName: {},
+		"remote-b":         {},
+		"remote-a":         {},
+	})
+	remoteAQueue := persistence.GetHistoryTaskQueueName(tasks.CategoryIDReplication, "remote-a", currentClusterName)
+	remoteBQueue := persistence.GetHistoryTaskQueueName(tasks.CategoryIDReplication, "remote-b", currentClusterName)
+	s.mockHistoryClient.EXPECT().ListQueues(ctx, &historyservice.ListQueuesRequest{
+		QueueType: int32(persistence.QueueTypeHistoryDLQ),
+		PageSize:  listQueuesPageSize,
+	}).Return(&historyservice.ListQueuesResponse{
+		Queues: []*historyservice.ListQueuesResponse_QueueInfo{
+			{QueueName: remoteBQueue, MessageCount: 2},
+			{QueueName: persistence.GetHistoryTaskQueueName(tasks.CategoryIDTransfer, "remote-a", currentClusterName), MessageCount: 5},
+		},
+		NextPageToken: []byte("token"),
+	}, nil)
+	s.mockHistoryClient.EXPECT().ListQueues(ctx, &historyservice.ListQueuesRequest{
+		QueueType:     int32(persistence.QueueTypeHistoryDLQ),
+		PageSize:      listQueuesPageSize,
+		NextPageToken: []byte("token"),
+	}).Return(&historyservice.ListQueuesResponse{
+		Queues: []*historyservice.ListQueuesResponse_QueueInfo{
+			{QueueName: remoteAQueue, MessageCount: 3},
+		},
+	}, nil)
+
+	dlqs, err := s.handler.getReplicationDLQSizes(ctx)
+	s.NoError(err)
+	s.Equal([]*adminservice.ReplicationDLQSize{
+		{SourceCluster: "remote-a", QueueName: remoteAQueue, MessageCount: 3},
+		{SourceCluster: "remote-b", QueueName: remoteBQueue, MessageCount: 2},
+	}, dlqs)
+}
+
+func (s *adminHandlerSuite) TestDescribePersistenceConcurrencyLimiter() {
+	s.mockResource.HistoryServiceResolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("history-1"),
+	})
+	s.mockResource.MatchingServiceResolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("matching-1"),
+		membership.NewHostInfoFromAddress("matching-2"),
+	})
+	s.mockHistoryClient.EXPECT().DescribePersistenceConcurrencyLimiter(gomock.Any(), protomock.Eq(&historyservice.DescribePersistenceConcurrencyLimiterRequest{
+		HostAddress: "history-1",
+	})).Return(&historyservice.DescribePersistenceConcurrencyLimiterResponse{
+		State: &healthspb.PersistenceConcurrencyLimiterState{Service: "history", HostAddress: "history-1", Limit: 10},
+	}, nil)
+	s.mockMatchingClient.EXPECT().DescribePersistenceConcurrencyLimiter(gomock.Any(), protomock.Eq(&matchingservice.DescribePersistenceConcurrencyLimiterRequest{
+		HostAddress: "matching-1",
+	})).Return(&matchingservice.DescribePersistenceConcurrencyLimiterResponse{
+		State: &healthspb.PersistenceConcurrencyLimiterState{Service: "matching", HostAddress: "matching-1", Limit: 20},
+	}, nil)
+	s.mockMatchingClient.EXPECT().DescribePersistenceConcurrencyLimiter(gomock.Any(), protomock.Eq(&matchingservice.DescribePersistenceConcurrencyLimiterRequest{
+		HostAddress: "matching-2",
+	})).Return(nil, serviceerror.NewUnavailable("unavailable"))
+
+	resp, err := s.handler.DescribePersistenceConcurrencyLimiter(context.Background(), &adminservice.DescribePersistenceConcurrencyLimiterRequest{})
+	s.NoError(err)
+	s.Len(resp.GetStates(), 2)
+	s.Equal("history-1", resp.GetStates()[0].GetHostAddress())
+	s.Equal("matching-1", resp.GetStates()[1].GetHostAddress())
+	s.Equal(int64(20), resp.GetStates()[1].GetLimit())
+	s.Equal([]string{"matching-2"}, resp.GetUnreachableHosts())
+}
+
+func (s *adminHandlerSuite) TestDescribeNexusEndpoint() {
+	s.handler.nexusEndpointClient = newNexusEndpointClient(
+		newNexusEndpointClientConfig(dynamicconfig.NewNoopCollection()),
+		s.mockResource.NamespaceCache,
+		s.mockResource.MatchingClient,
+		s.mockResource.NexusEndpointManager,
+		s.mockResource.Logger,
+	)
+	endpointID := uuid.NewString()
+	s.mockResource.NexusEndpointManager.EXPECT().GetNexusEndpoint(gomock.Any(), gomock.Any()).Return(&persistencespb.NexusEndpointEntry{
+		Id: endpointID,
+		Endpoint: &persistencespb.NexusEndpoint{
+			Spec: &persistencespb.NexusEndpointSpec{
+				Name: "endpoint",
+				Target: &persistencespb.NexusEndpointTarget{
+					Variant: &persistencespb.NexusEndpointTarget_External_{
+						External: &persistencespb.NexusEndpointTarget_External{Url: "http://localhost"},
+					},
+				},
+			},
+		},
+	}, nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("history-1"),
+	})
+	s.mockHistoryClient.EXPECT().DescribeOutboundDestinationHealth(gomock.Any(), protomock.Eq(&historyservice.DescribeOutboundDestinationHealthRequest{
+		HostAddress: "history-1",
+		Destination: "endpoint",
+	})).Return(&historyservice.DescribeOutboundDestinationHealthResponse{
+		Destinations: []*healthspb.OutboundDestinationHealth{
+			{HostAddress: "history-1", NamespaceId: "ns", Destination: "endpoint", CircuitBreakerState: "open"},
+		},
+	}, nil)
+
+	resp, err := s.handler.DescribeNexusEndpoint(context.Background(), &adminservice.DescribeNexusEndpointRequest{Id: endpointID})
+	s.NoError(err)
+	s.Equal("endpoint", resp.GetEndpoint().GetSpec().GetName())
+	s.Len(resp.GetDestinations(), 1)
+	s.Equal("open", resp.GetDestinations()[0].GetCircuitBreakerState())
+	s.Len(resp.GetHostDestinations(), 1)
+	s.Empty(resp.GetUnreachableHosts())
+}
@@ -15,6 +15,8 @@ var (
 	errInvalidRunID                                       = serviceerror.NewInvalidArgument("Invalid RunId.")
 	errQueryNotSet                                        = serviceerror.NewInvalidArgument("WorkflowQuery is not set on request.")
 	errQueryTypeNotSet                                    = serviceerror.NewInvalidArgument("QueryType is not set on request.")
+	errInvalidQueryMinEventID                             = serviceerror.NewInvalidArgument("Invalid query min event ID header.")
+	errInvalidQueryConsistency                            = serviceerror.NewInvalidArgument("Invalid query consistency header.")
 	errRequestNotSet                                      = serviceerror.NewInvalidArgument("Request is nil.")
 	errRequestIDNotSet                                    = serviceerror.NewInvalidArgument("RequestId is not set on request.")
 	errWorkflowTypeNotSet                                 = serviceerror.NewInvalidArgument("WorkflowType is not set on request.")
@@ -49,6 +51,7 @@ var (
 	errMigrationTargetNotSet                              = serviceerror.NewInvalidArgument("Target is not set on request.")
 	errNamespaceNotSet                                    = serviceerror.NewInvalidArgument("Namespace is not set on request.")
 	errReasonNotSet                                       = serviceerror.NewInvalidArgument("Reason is not set on request.")
+	errDestinationNotSet                                  = serviceerror.NewInvalidArgument("Destination is not set on request.")
 	errRaceConditionAddingSearchAttributes                = serviceerror.NewUnavailable("Generated search attributes mapping unavailable.")
 	errUseVersioningWithoutBuildId                        = serviceerror.NewInvalidArgument("WorkerVersionStamp must be present if UseVersioning is true.")
 	errUseVersioningWithoutNormalName                     = serviceerror.NewInvalidArgument("NormalName must be set on sticky queue when UseVersioning is true or DeploymentOptions are set.")
@@ -57,6 +60,7 @@ var (
 	errUseEnhancedDescribeOnStickyQueue                   = serviceerror.NewInvalidArgument("Enhanced DescribeTaskQueue is not valid for a sticky queue, use api_mode=UNSPECIFIED or a normal queue.")
 	errUseEnhancedDescribeOnNonRootQueue                  = serviceerror.NewInvalidArgument("Enhanced DescribeTaskQueue is not valid for non-root queue partitions, use api_mode=UNSPECIFIED or a normal queue root name.")
 	errTaskQueuePartitionInvalid                          = serviceerror.NewInvalidArgument("Task Queue Partition invalid, use a different Task Queue or Task Queue Type")
+	errTaskQueueNotSet                                    = serviceerror.NewInvalidArgument("TaskQueue is not set on request.")
 	errMultiOpWorkflowIdInconsistent                      = serviceerror.NewInvalidArgument("WorkflowId is not consistent with previous operation(s).")
 	errMultiOpStartCronSchedule                           = serviceerror.NewInvalidArgument("CronSchedule is not allowed.")
 	errMultiOpUpdateFirstExecutionRunId                   = serviceerror.NewInvalidArgument("FirstExecutionRunId is not allowed.")
@@ -81,6 +85,7 @@ var (
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
 	errSearchAttributeAlreadyExistsMessage            = "Search attribute %s already exists."
 	errSearchAttributeDoesntExistMessage              = "Search attribute %s doesn't exist."
+	errSearchAttributeIsMigratingMessage              = "Search attribute %s is being migrated to another type."
 	errUnknownSearchAttributeTypeMessage              = "Unknown search attribute type: %v."
 	errUnableToGetSearchAttributesMessage             = "Unable to get search attributes: %v."
 	errUnableToRemoveNonCustomSearchAttributesMessage = "Unable to remove non-custom search attributes: %v."
@@ -19,6 +19,7 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/chaos"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -30,6 +31,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/namespace/nsreplication"
 	"go.temporal.io/server/common/persistence"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -658,6 +660,7 @@ func PersistenceRateLimitingParamsProvider(
 		serviceConfig.OperatorRPSRatio,
 		serviceConfig.PersistenceQPSBurstRatio,
 		serviceConfig.PersistenceDynamicRateLimitingParams,
+		serviceConfig.PersistenceConcurrencyLimitingParams,
 		persistenceLazyLoadedServiceResolver,
 		logger,
 	)
@@ -751,6 +754,10 @@ func AdminHandlerProvider(
 	chasmRegistry *chasm.Registry,
 	namespaceDataMerger nsreplication.NamespaceDataMerger,
 	schedulerClient schedulerpb.SchedulerServiceClient,
+	nexusEndpointClient *NexusEndpointClient,
+	concurrencyLimiter *persistenceClient.HealthConcurrencyLimiterImpl,
+	faultRegistry *chaos.Registry,
+	workerDeploymentClient workerdeployment.Client,
 	namespaceDLQHandler nsreplication.DLQMessageHandler,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
@@ -783,6 +790,10 @@ func AdminHandlerProvider(
 		chasmRegistry,
 		namespaceDataMerger,
 		schedulerClient,
+		nexusEndpointClient,
+		concurrencyLimiter,
+		faultRegistry,
+		workerDeploymentClient,
 		taskCategoryRegistry,
 		matchingClient,
 	}
@@ -5,9 +5,11 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/temporalio/sqlparser"
 	enumspb "go.temporal.io/api/enums/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
@@ -27,7 +29,9 @@ import (
 	"go.temporal.io/server/common/namespace/nsmanager"
 	"go.temporal.io/server/common/namespace/nsreplication"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute/sadefs"
 	"go.temporal.io/server/common/util"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -47,6 +51,7 @@ type (
 		archiverProvider       provider.ArchiverProvider
 		timeSource             clock.TimeSource
 		config                 *Config
+		visibilityMgr          manager.VisibilityManager
 	}
 )
 
@@ -75,6 +80,7 @@ func newNamespaceHandler(
 	archiverProvider provider.ArchiverProvider,
 	timeSource clock.TimeSource,
 	config *Config,
+	visibilityMgr manager.VisibilityManager,
 ) *namespaceHandler {
 	return &namespaceHandler{
 		logger:                 logger,
@@ -87,6 +93,7 @@ func newNamespaceHandler(
 		archiverProvider:       archiverProvider,
 		timeSource:             timeSource,
 		config:                 config,
+		visibilityMgr:          visibilityMgr,
 	}
 }
 
@@ -398,6 +405,7 @@ func (d *namespaceHandler) UpdateNamespace(
 	info := getResponse.Namespace.Info
 	config := getResponse.Namespace.Config
 	replicationConfig := getResponse.Namespace.ReplicationConfig
+	previousActiveClusterName := replicationConfig.ActiveClusterName
 	failoverHistory := getResponse.Namespace.ReplicationConfig.FailoverHistory
 	configVersion := getResponse.Namespace.ConfigVersion
 	failoverVersion := getResponse.Namespace.FailoverVersion
@@ -507,6 +515,9 @@ func (d *namespaceHandler) UpdateNamespace(
 		}
 		if len(updatedConfig.CustomSearchAttributeAliases) > 0 {
 			configurationChanged = true
+			if err := validateNoMigratingSearchAttributeFieldAllocated(config, updatedConfig.CustomSearchAttributeAliases); err != nil {
+				return nil, err
+			}
 			csaAliases, err := d.upsertCustomSearchAttributesAliases(
 				config.CustomSearchAttributeAliases,
 				updatedConfig.CustomSearchAttributeAliases,
@@ -515,6 +526,7 @@ func (d *namespaceHandler) UpdateNamespace(
 				return nil, err
 			}
 			config.CustomSearchAttributeAliases = csaAliases
+			pruneSearchAttributeAliasState(config)
 		}
 	}
 
@@ -578,7 +590,15 @@ func (d *namespaceHandler) UpdateNamespace(
 
 	if configurationChanged && activeClusterChanged && isGlobalNamespace {
 		return nil, errCannotDoNamespaceFailoverAndUpdate
-	} else if configurationChanged || activeClusterChanged || needsNamespacePromotion {
+	}
+	// Local-only workflows are only visible to the previous active cluster, the check cannot run elsewhere.
+	if activeClusterChanged && isGlobalNamespace && replicationConfig.ActiveClusterName != previousActiveClusterName &&
+		d.clusterMetadata.GetCurrentClusterName() == previousActiveClusterName {
+		if err := d.validateNoLocalOnlyWorkflows(ctx, namespace.ID(info.Id), namespace.Name(info.Name)); err != nil {
+			return nil, err
+		}
+	}
+	if configurationChanged || activeClusterChanged || needsNamespacePromotion {
 		if (needsNamespacePromotion || activeClusterChanged) && isGlobalNamespace {
 			failoverVersion = d.clusterMetadata.GetNextFailoverVersion(
 				replicationConfig.ActiveClusterName,
@@ -699,9 +719,12 @@ func (d *namespaceHandler) DeprecateNamespace(
 	return nil, nil
 }
 
+// CreateWorkflowRule creates a workflow rule. The extension is optional and holds the triggers and actions which are
+// only available through the admin API.
 func (d *namespaceHandler) CreateWorkflowRule(
 	ctx context.Context,
 	ruleSpec *rulespb.WorkflowRuleSpec,
+	extension *persistencespb.WorkflowRuleExtension,
 	createdByIdentity string,
 	description string,
 	nsName string,
@@ -710,6 +733,11 @@ func (d *namespaceHandler) CreateWorkflowRule(
 	if ruleSpec.GetId() == "" {
 		return nil, serviceerror.NewInvalidArgument("Workflow Rule ID is not set.")
 	}
+	if extension != nil {
+		if err := validateWorkflowRuleExtension(ruleSpec, extension); err != nil {
+			return nil, err
+		}
+	}
 
 	metadata, err := d.metadataMgr.GetMetadata(ctx)
 	if err != nil {
@@ -729,6 +757,7 @@ func (d *namespaceHandler) CreateWorkflowRule(
 		maxRules := d.config.MaxWorkflowRulesPerNamespace(nsName)
 		if len(config.WorkflowRules) >= maxRules {
 			d.removeOldestExpiredWorkflowRule(nsName, config.WorkflowRules)
+			removeOrphanedWorkflowRuleExtensions(config)
 		}
 		if len(config.WorkflowRules) >= maxRules {
 			return nil, serviceerror.NewInvalidArgumentf("Workflow Rule limit exceeded. Max: %v", maxRules)
@@ -747,6 +776,12 @@ func (d *namespaceHandler) CreateWorkflowRule(
 		Description:       description,
 	}
 	config.WorkflowRules[ruleSpec.GetId()] = workflowRule
+	if extension != nil {
+		if config.WorkflowRuleExtensions == nil {
+			config.WorkflowRuleExtensions = make(map[string]*persistencespb.WorkflowRuleExtension)
+		}
+		config.WorkflowRuleExtensions[ruleSpec.GetId()] = extension
+	}
 
 	updateReq := &persistence.UpdateNamespaceRequest{
 		Namespace: &persistencespb.NamespaceDetail{
@@ -795,24 +830,25 @@ func (d *namespaceHandler) removeOldestExpiredWorkflowRule(nsName string, rules
 	}
 }
 
+// DescribeWorkflowRule returns a workflow rule and its extension, which is nil when the rule has none.
 func (d *namespaceHandler) DescribeWorkflowRule(
 	ctx context.Context, ruleID string, nsName string,
-) (*rulespb.WorkflowRule, error) {
+) (*rulespb.WorkflowRule, *persistencespb.WorkflowRuleExtension, error) {
 	getNamespaceResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: nsName})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if getNamespaceResponse.Namespace.Config.WorkflowRules == nil {
-		return nil, serviceerror.NewInvalidArgument("Workflow Rule with this ID not Found.")
+		return nil, nil, serviceerror.NewInvalidArgument("Workflow Rule with this ID not Found.")
 	}
 
 	rule, ok := getNamespaceResponse.Namespace.Config.WorkflowRules[ruleID]
 	if !ok {
-		return nil, serviceerror.NewInvalidArgument("Workflow Rule with this ID not Found.")
+		return nil, nil, serviceerror.NewInvalidArgument("Workflow Rule with this ID not Found.")
 	}
 
-	return rule, nil
+	return rule, getNamespaceResponse.Namespace.Config.GetWorkflowRuleExtensions()[ruleID], nil
 }
 
 func (d *namespaceHandler) DeleteWorkflowRule(
@@ -843,6 +879,7 @@ func (d *namespaceHandler) DeleteWorkflowRule(
 	}
 
 	delete(config.WorkflowRules, ruleID)
+	delete(config.WorkflowRuleExtensions, ruleID)
 
 	updateReq := &persistence.UpdateNamespaceRequest{
 		Namespace: &persistencespb.NamespaceDetail{
@@ -1004,6 +1041,40 @@ func (d *namespaceHandler) upsertCustomSearchAttributesAliases(
 	return result, nil
 }
 
+// validateNoMigratingSearchAttributeFieldAllocated rejects allocating a field that is the source or the target of a
+// search attribute type migration to another alias.
+func validateNoMigratingSearchAttributeFieldAllocated(
+	config *persistencespb.NamespaceConfig,
+	upsert map[string]string,
+) error {
+	for sourceFieldName, migration := range config.GetSearchAttributeTypeMigrations() {
+		for _, fieldName := range []string{sourceFieldName, migration.GetTargetFieldName()} {
+			if _, allocated := config.GetCustomSearchAttributeAliases()[fieldName]; allocated {
+				continue
+			}
+			if upsert[fieldName] != "" {
+				return errCustomSearchAttributeFieldAlreadyAllocated
+			}
+		}
+	}
+	return nil
+}
+
+// pruneSearchAttributeAliasState removes the alias transitions and type migrations of search attributes that were
+// removed from the namespace.
+func pruneSearchAttributeAliasState(config *persistencespb.NamespaceConfig) {
+	aliases := config.GetCustomSearchAttributeAliases()
+	maps.DeleteFunc(config.SearchAttributeAliasTransitions, func(_ string, transition *persistencespb.SearchAttributeAliasTransition) bool {
+		_, mapped := aliases[transition.GetFieldName()]
+		return !mapped
+	})
+	maps.DeleteFunc(config.SearchAttributeTypeMigrations, func(sourceFieldName string, migration *persistencespb.SearchAttributeTypeMigration) bool {
+		_, sourceMapped := aliases[sourceFieldName]
+		_, targetMapped := aliases[migration.GetTargetFieldName()]
+		return !sourceMapped && !targetMapped
+	})
+}
+
 func (d *namespaceHandler) toArchivalRegisterEvent(
 	state enumspb.ArchivalState,
 	URI string,
@@ -1107,6 +1178,38 @@ func (d *namespaceHandler) maybeUpdateFailoverHistory(
 	return failoverHistory
 }
 
+// validateNoLocalOnlyWorkflows rejects a failover of a namespace with running workflows which were excluded from
+// replication: they only exist in the current active cluster and would be stranded there once it becomes passive.
+// It must only be called on the current active cluster, other clusters have no visibility records of them.
+func (d *namespaceHandler) validateNoLocalOnlyWorkflows(
+	ctx context.Context,
+	nsID namespace.ID,
+	nsName namespace.Name,
+) error {
+	query := fmt.Sprintf(
+		"%s = %s AND %s = true",
+		sadefs.ExecutionStatus,
+		sqlparser.String(sqlparser.NewStrVal([]byte(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))),
+		sadefs.TemporalLocalOnly,
+	)
+	resp, err := d.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: nsID,
+		Namespace:   nsName,
+		Query:       query,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Count > 0 {
+		return serviceerror.NewFailedPreconditionf(
+			"Cannot fail over namespace %s: %d running workflows are excluded from replication and would be stranded in the current active cluster. Wait for them to complete or terminate them before failing over.",
+			nsName,
+			resp.Count,
+		)
+	}
+	return nil
+}
+
 // validateRetentionDuration ensures that retention duration can't be set below a sane minimum.
 func (d *namespaceHandler) validateRetentionDuration(retention *durationpb.Duration, isGlobalNamespace bool) error {
 	if err := timestamp.ValidateAndCapProtoDuration(retention); err != nil {
@@ -2,6 +2,7 @@ package frontend
 
 import (
 	"context"
+	"errors"
 	"slices"
 	"testing"
 	"time"
@@ -26,6 +27,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/namespace/nsreplication"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/testing/protoassert"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -45,6 +47,7 @@ type (
 		mockNamespaceReplicator nsreplication.Replicator
 		archivalMetadata        archiver.ArchivalMetadata
 		mockArchiverProvider    *provider.MockArchiverProvider
+		mockVisibilityMgr       *manager.MockVisibilityManager
 		fakeClock               *clock.EventTimeSource
 		config                  *Config
 
@@ -83,6 +86,7 @@ func (s *namespaceHandlerCommonSuite) SetupTest() {
 		&config.ArchivalNamespaceDefaults{},
 	)
 	s.mockArchiverProvider = provider.NewMockArchiverProvider(s.controller)
+	s.mockVisibilityMgr = manager.NewMockVisibilityManager(s.controller)
 	s.fakeClock = clock.NewEventTimeSource()
 	s.config = NewConfig(dc.NewNoopCollection(), 1024)
 	s.handler = newNamespaceHandler(
@@ -95,6 +99,7 @@ func (s *namespaceHandlerCommonSuite) SetupTest() {
 		s.mockArchiverProvider,
 		s.fakeClock,
 		s.config,
+		s.mockVisibilityMgr,
 	)
 }
 
@@ -677,6 +682,7 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_UpdateActiveClusterWit
 	}).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(clusterName1).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetNextFailoverVersion(clusterName2, int64(0)).Return(int64(2))
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{}, nil)
 	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
 		Namespace: &persistencespb.NamespaceDetail{
 			Info: &persistencespb.NamespaceInfo{
@@ -756,6 +762,7 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_ChangeActiveClusterWit
 	}).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(clusterName1).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetNextFailoverVersion(clusterName2, int64(0)).Return(int64(2))
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{}, nil)
 	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
 		Namespace: &persistencespb.NamespaceDetail{
 			Info: &persistencespb.NamespaceInfo{
@@ -806,6 +813,94 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_ChangeActiveClusterWit
 	s.NoError(err)
 }
 
+func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_FailoverRejectedWithLocalOnlyWorkflows() {
+	nsName := "global-ns-with-local-only-workflows"
+	nid := uuid.NewString()
+	clusterName1 := "cluster1"
+	clusterName2 := "cluster2"
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: 100,
+	}, nil)
+	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		clusterName1: {Enabled: true, InitialFailoverVersion: 1},
+		clusterName2: {Enabled: true, InitialFailoverVersion: 2},
+	}).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(clusterName1).AnyTimes()
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   nid,
+				Name: nsName,
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: clusterName1,
+				Clusters:          []string{clusterName1, clusterName2},
+			},
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespace.ID(nid),
+		Namespace:   namespace.Name(nsName),
+		Query:       "ExecutionStatus = 'Running' AND TemporalLocalOnly = true",
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: 3}, nil)
+
+	_, err := s.handler.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: nsName,
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: clusterName2,
+		},
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+	s.ErrorContains(err, "3 running workflows are excluded from replication")
+}
+
+func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_FailoverFromPassiveClusterSkipsLocalOnlyCheck() {
+	nsName := "global-ns-failover-from-passive"
+	nid := uuid.NewString()
+	clusterName1 := "cluster1"
+	clusterName2 := "cluster2"
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: 100,
+	}, nil)
+	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		clusterName1: {Enabled: true, InitialFailoverVersion: 1},
+		clusterName2: {Enabled: true, InitialFailoverVersion: 2},
+	}).AnyTimes()
+	// The failover is issued on the cluster that becomes active, which has no visibility records of the
+	// local-only workflows of the previous active cluster.
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(clusterName2).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetNextFailoverVersion(clusterName2, int64(0)).Return(int64(2))
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   nid,
+				Name: nsName,
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: clusterName1,
+				Clusters:          []string{clusterName1, clusterName2},
+			},
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+	updateErr := errors.New("update namespace failed")
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Return(updateErr)
+
+	_, err := s.handler.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: nsName,
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: clusterName2,
+		},
+	})
+	s.ErrorIs(err, updateErr)
+}
+
 // Test that the number of replication statuses is limited
 func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_UpdateActiveCluster_LimitRecordHistory() {
 	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).AnyTimes()
@@ -861,6 +956,7 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_UpdateActiveCluster_Li
 	}).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(clusterName1).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetNextFailoverVersion(clusterName2, int64(0)).Return(int64(32))
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{}, nil)
 	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
 		Namespace: &persistencespb.NamespaceDetail{
 			Info: &persistencespb.NamespaceInfo{
@@ -1671,6 +1767,7 @@ func (s *namespaceHandlerCommonSuite) TestFailoverGlobalNamespace_NotMaster() {
 	}).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(clusterName1).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetNextFailoverVersion(clusterName2, int64(0)).Return(int64(2))
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{}, nil)
 	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
 		Namespace: &persistencespb.NamespaceDetail{
 			Info: &persistencespb.NamespaceInfo{
@@ -1731,7 +1828,7 @@ func (s *namespaceHandlerCommonSuite) TestCreateWorkflowRule_Acceptance() {
 	version := int64(100)
 
 	// first call returns error, because ID is not set
-	_, err := s.handler.CreateWorkflowRule(context.Background(), spec, identity, description, namespaceName)
+	_, err := s.handler.CreateWorkflowRule(context.Background(), spec, nil, identity, description, namespaceName)
 	s.Error(err)
 
 	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
@@ -1750,7 +1847,7 @@ func (s *namespaceHandlerCommonSuite) TestCreateWorkflowRule_Acceptance() {
 	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Return(nil)
 
 	spec.Id = "test-id"
-	rule, err := s.handler.CreateWorkflowRule(context.Background(), spec, identity, description, namespaceName)
+	rule, err := s.handler.CreateWorkflowRule(context.Background(), spec, nil, identity, description, namespaceName)
 	s.NoError(err)
 	s.NotNil(rule)
 	s.NotNil(rule.Spec)
@@ -1789,12 +1886,71 @@ func (s *namespaceHandlerCommonSuite) TestCreateWorkflowRule_Duplicate() {
 		},
 	}, nil)
 
-	_, err := s.handler.CreateWorkflowRule(context.Background(), spec, identity, description, namespaceName)
+	_, err := s.handler.CreateWorkflowRule(context.Background(), spec, nil, identity, description, namespaceName)
 	s.Error(err)
 	var invalidArgument *serviceerror.InvalidArgument
 	s.ErrorAs(err, &invalidArgument)
 }
 
+func (s *namespaceHandlerCommonSuite) TestCreateWorkflowRule_Extension() {
+	namespaceName := "test-namespace"
+	ruleID := "test-id"
+	nsConfig := &persistencespb.NamespaceConfig{}
+	extension := &persistencespb.WorkflowRuleExtension{
+		Trigger: &persistencespb.WorkflowRuleExtension_SignalReceived{
+			SignalReceived: &persistencespb.WorkflowRuleExtension_SignalReceivedTrigger{SignalName: "stop"},
+		},
+		Actions: []*persistencespb.WorkflowRuleExtensionAction{
+			{Variant: &persistencespb.WorkflowRuleExtensionAction_PauseWorkflow{
+				PauseWorkflow: &persistencespb.WorkflowRuleExtensionAction_PauseWorkflowAction{Reason: "stopped"},
+			}},
+		},
+	}
+
+	var invalidArgument *serviceerror.InvalidArgument
+
+	// spec has a trigger
+	_, err := s.handler.CreateWorkflowRule(context.Background(), &rulespb.WorkflowRuleSpec{
+		Id: ruleID,
+		Trigger: &rulespb.WorkflowRuleSpec_ActivityStart{
+			ActivityStart: &rulespb.WorkflowRuleSpec_ActivityStartingTrigger{},
+		},
+	}, extension, "identity", "description", namespaceName)
+	s.ErrorAs(err, &invalidArgument)
+
+	// extension has no actions
+	_, err = s.handler.CreateWorkflowRule(context.Background(), &rulespb.WorkflowRuleSpec{Id: ruleID},
+		&persistencespb.WorkflowRuleExtension{Trigger: extension.Trigger}, "identity", "description", namespaceName)
+	s.ErrorAs(err, &invalidArgument)
+
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: int64(1),
+	}, nil).AnyTimes()
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   "1",
+				Name: namespaceName,
+			},
+			Config:            nsConfig,
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+		},
+	}, nil).AnyTimes()
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	rule, err := s.handler.CreateWorkflowRule(context.Background(), &rulespb.WorkflowRuleSpec{Id: ruleID},
+		extension, "identity", "description", namespaceName)
+	s.NoError(err)
+	s.Equal(ruleID, rule.GetSpec().GetId())
+
+	_, describedExtension, err := s.handler.DescribeWorkflowRule(context.Background(), ruleID, namespaceName)
+	s.NoError(err)
+	protoassert.ProtoEqual(s.T(), extension, describedExtension)
+
+	s.NoError(s.handler.DeleteWorkflowRule(context.Background(), ruleID, namespaceName))
+	s.Empty(nsConfig.GetWorkflowRuleExtensions())
+}
+
 func (s *namespaceHandlerCommonSuite) TestDeleteWorkflowRule() {
 	namespaceName := "test-namespace"
 	ruleId := "test-id"
@@ -1863,21 +2019,21 @@ func (s *namespaceHandlerCommonSuite) TestDescribeWorkflowRule() {
 	}, nil).AnyTimes()
 
 	// happy path
-	rule, err := s.handler.DescribeWorkflowRule(context.Background(), ruleId, namespaceName)
+	rule, _, err := s.handler.DescribeWorkflowRule(context.Background(), ruleId, namespaceName)
 	s.NoError(err)
 	s.NotNil(rule)
 
 	var invalidArgument *serviceerror.InvalidArgument
 
 	// rule with such id doesn't exist
-	rule, err = s.handler.DescribeWorkflowRule(context.Background(), "not existing rule id", namespaceName)
+	rule, _, err = s.handler.DescribeWorkflowRule(context.Background(), "not existing rule id", namespaceName)
 	s.Error(err)
 	s.ErrorAs(err, &invalidArgument)
 	s.Nil(rule)
 
 	// config is nil
 	nsConfig.WorkflowRules = nil
-	rule, err = s.handler.DescribeWorkflowRule(context.Background(), "not existing rule id", namespaceName)
+	rule, _, err = s.handler.DescribeWorkflowRule(context.Background(), "not existing rule id", namespaceName)
 	s.Error(err)
 	s.ErrorAs(err, &invalidArgument)
 	s.Nil(rule)
@@ -11,8 +11,10 @@ import (
 	nexuspb "go.temporal.io/api/nexus/v1"
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -124,6 +126,11 @@ func (c *NexusEndpointClient) Update(
 	if err != nil {
 		return nil, err
 	}
+	if spec.GetTarget().GetExternal() != nil {
+		if spec.Target, err = c.keepHTTPTarget(ctx, request.GetId(), spec.GetTarget()); err != nil {
+			return nil, err
+		}
+	}
 
 	resp, err := c.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
 		Id:      request.Id,
@@ -187,6 +194,70 @@ func (c *NexusEndpointClient) Get(
 	}, nil
 }
 
+// keepHTTPTarget returns the HTTP target of the endpoint if it has one. HTTP targets are exposed as external targets
+// with the same URL in the public API, so updating an endpoint with the external target it was read with must not
+// drop the settings of its HTTP target. Updates that change the URL of an HTTP target are rejected.
+func (c *NexusEndpointClient) keepHTTPTarget(
+	ctx context.Context,
+	endpointID string,
+	target *persistencespb.NexusEndpointTarget,
+) (*persistencespb.NexusEndpointTarget, error) {
+	entry, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: endpointID,
+	})
+	if err != nil {
+		return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", endpointID))
+	}
+	existing := entry.GetEndpoint().GetSpec().GetTarget()
+	if existing.GetHttp() == nil {
+		return target, nil
+	}
+	if existing.GetHttp().GetUrl() != target.GetExternal().GetUrl() {
+		return nil, serviceerror.NewFailedPrecondition(
+			"endpoint has an HTTP service target, use the admin UpdateNexusEndpointHttpTarget API to change its URL")
+	}
+	return existing, nil
+}
+
+// UpdateHTTPTarget replaces the target of an existing endpoint with a plain HTTP service target.
+func (c *NexusEndpointClient) UpdateHTTPTarget(
+	ctx context.Context,
+	request *adminservice.UpdateNexusEndpointHttpTargetRequest,
+) (*adminservice.UpdateNexusEndpointHttpTargetResponse, error) {
+	if err := c.validateHTTPTargetUpdate(request); err != nil {
+		return nil, err
+	}
+
+	entry, err := c.persistence.GetNexusEndpoint(ctx, &p.GetNexusEndpointRequest{
+		ID: request.Id,
+	})
+	if err != nil {
+		return nil, c.transformServiceError(err, fmt.Sprintf("error looking up Nexus endpoint with ID `%v`", request.Id))
+	}
+	if entry.Version != request.Version {
+		return nil, serviceerror.NewFailedPreconditionf("nexus endpoint version mismatch: expected %d, got %d", entry.Version, request.Version)
+	}
+
+	spec := common.CloneProto(entry.GetEndpoint().GetSpec())
+	spec.Target = &persistencespb.NexusEndpointTarget{
+		Variant: &persistencespb.NexusEndpointTarget_Http_{
+			Http: request.GetTarget(),
+		},
+	}
+	resp, err := c.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
+		Id:      request.Id,
+		Version: request.Version,
+		Spec:    spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.UpdateNexusEndpointHttpTargetResponse{
+		Entry: resp.Entry,
+	}, nil
+}
+
 func (c *NexusEndpointClient) List(
 	ctx context.Context,
 	request *operatorservice.ListNexusEndpointsRequest,
@@ -373,6 +444,23 @@ func (c *NexusEndpointClient) validateUpsertSpec(spec *nexuspb.EndpointSpec) err
 	return issues.GetError()
 }
 
+func (c *NexusEndpointClient) validateHTTPTargetUpdate(request *adminservice.UpdateNexusEndpointHttpTargetRequest) error {
+	issues := getEndpointIDIssues(request.GetId())
+	if request.GetVersion() <= 0 {
+		issues.Append("endpoint version is non-positive")
+	}
+	if request.GetTarget() == nil {
+		issues.Append("empty HTTP target")
+		return issues.GetError()
+	}
+	if len(request.GetTarget().GetUrl()) > c.config.maxExternalEndpointURLLength() {
+		issues.Appendf("target URL length exceeds limit of %d", c.config.maxExternalEndpointURLLength())
+	} else if _, err := cnexus.NewHTTPTarget(request.GetTarget()); err != nil {
+		issues.Appendf("invalid HTTP target: %s", err.Error())
+	}
+	return issues.GetError()
+}
+
 func getEndpointIDIssues(ID string) rpc.RequestIssues {
 	var issues rpc.RequestIssues
 	if ID == "" {
@@ -439,6 +527,15 @@ func (c *NexusEndpointClient) endpointPersistedEntryToExternalAPI(entry *persist
 				},
 			},
 		}
+	case *persistencespb.NexusEndpointTarget_Http_:
+		// HTTP targets can't be expressed in the public API, expose them as external targets with the service URL.
+		target = &nexuspb.EndpointTarget{
+			Variant: &nexuspb.EndpointTarget_External_{
+				External: &nexuspb.EndpointTarget_External{
+					Url: v.Http.GetUrl(),
+				},
+			},
+		}
 	case *persistencespb.NexusEndpointTarget_Worker_:
 		name, err := c.namespaceRegistry.GetNamespaceName(namespace.ID(v.Worker.NamespaceId))
 		if err != nil {
@@ -0,0 +1,162 @@
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	nexuspb "go.temporal.io/api/nexus/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newHTTPTargetEndpointEntry(id string) *persistencespb.NexusEndpointEntry {
+	return &persistencespb.NexusEndpointEntry{
+		Id:      id,
+		Version: 2,
+		Endpoint: &persistencespb.NexusEndpoint{
+			Spec: &persistencespb.NexusEndpointSpec{
+				Name: "endpoint",
+				Target: &persistencespb.NexusEndpointTarget{
+					Variant: &persistencespb.NexusEndpointTarget_Http_{
+						Http: &persistencespb.NexusEndpointTarget_Http{
+							Url:     "http://localhost/api",
+							Method:  "PUT",
+							Headers: map[string]string{"Authorization": "secret"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTestNexusEndpointClient(ctrl *gomock.Controller) (
+	*NexusEndpointClient,
+	*matchingservicemock.MockMatchingServiceClient,
+	*persistence.MockNexusEndpointManager,
+) {
+	matchingClient := matchingservicemock.NewMockMatchingServiceClient(ctrl)
+	endpointManager := persistence.NewMockNexusEndpointManager(ctrl)
+	client := newNexusEndpointClient(
+		newNexusEndpointClientConfig(dynamicconfig.NewNoopCollection()),
+		namespace.NewMockRegistry(ctrl),
+		matchingClient,
+		endpointManager,
+		log.NewTestLogger(),
+	)
+	return client, matchingClient, endpointManager
+}
+
+func TestNexusEndpointClient_UpdateHTTPTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client, matchingClient, endpointManager := newTestNexusEndpointClient(ctrl)
+	id := uuid.NewString()
+
+	entry := newHTTPTargetEndpointEntry(id)
+	entry.Endpoint.Spec.Target = &persistencespb.NexusEndpointTarget{
+		Variant: &persistencespb.NexusEndpointTarget_External_{
+			External: &persistencespb.NexusEndpointTarget_External{Url: "http://localhost/old"},
+		},
+	}
+	endpointManager.EXPECT().GetNexusEndpoint(gomock.Any(), &persistence.GetNexusEndpointRequest{ID: id}).Return(entry, nil)
+	httpTarget := newHTTPTargetEndpointEntry(id).GetEndpoint().GetSpec().GetTarget().GetHttp()
+	matchingClient.EXPECT().UpdateNexusEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.UpdateNexusEndpointRequest, _ ...any) (*matchingservice.UpdateNexusEndpointResponse, error) {
+			require.Equal(t, int64(2), request.GetVersion())
+			require.Equal(t, "endpoint", request.GetSpec().GetName())
+			require.Equal(t, "PUT", request.GetSpec().GetTarget().GetHttp().GetMethod())
+			return &matchingservice.UpdateNexusEndpointResponse{Entry: newHTTPTargetEndpointEntry(id)}, nil
+		})
+
+	resp, err := client.UpdateHTTPTarget(context.Background(), &adminservice.UpdateNexusEndpointHttpTargetRequest{
+		Id:      id,
+		Version: 2,
+		Target:  httpTarget,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost/api", resp.GetEntry().GetEndpoint().GetSpec().GetTarget().GetHttp().GetUrl())
+}
+
+func TestNexusEndpointClient_UpdateHTTPTarget_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client, _, endpointManager := newTestNexusEndpointClient(ctrl)
+	id := uuid.NewString()
+
+	_, err := client.UpdateHTTPTarget(context.Background(), &adminservice.UpdateNexusEndpointHttpTargetRequest{
+		Id:      id,
+		Version: 2,
+		Target:  &persistencespb.NexusEndpointTarget_Http{Url: "ftp://localhost"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	endpointManager.EXPECT().GetNexusEndpoint(gomock.Any(), gomock.Any()).Return(newHTTPTargetEndpointEntry(id), nil)
+	_, err = client.UpdateHTTPTarget(context.Background(), &adminservice.UpdateNexusEndpointHttpTargetRequest{
+		Id:      id,
+		Version: 1,
+		Target:  &persistencespb.NexusEndpointTarget_Http{Url: "http://localhost"},
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	require.ErrorAs(t, err, &failedPrecondition)
+}
+
+func TestNexusEndpointClient_Update_KeepsHTTPTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client, matchingClient, endpointManager := newTestNexusEndpointClient(ctrl)
+	id := uuid.NewString()
+
+	endpointManager.EXPECT().GetNexusEndpoint(gomock.Any(), &persistence.GetNexusEndpointRequest{ID: id}).Return(newHTTPTargetEndpointEntry(id), nil).Times(2)
+	matchingClient.EXPECT().UpdateNexusEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.UpdateNexusEndpointRequest, _ ...any) (*matchingservice.UpdateNexusEndpointResponse, error) {
+			require.Equal(t, "renamed", request.GetSpec().GetName())
+			require.Equal(t, "PUT", request.GetSpec().GetTarget().GetHttp().GetMethod())
+			require.Equal(t, "secret", request.GetSpec().GetTarget().GetHttp().GetHeaders()["Authorization"])
+			entry := newHTTPTargetEndpointEntry(id)
+			entry.Endpoint.Spec = request.GetSpec()
+			return &matchingservice.UpdateNexusEndpointResponse{Entry: entry}, nil
+		})
+
+	// The external target returned for an HTTP target keeps the HTTP target when sent back.
+	resp, err := client.Update(context.Background(), &operatorservice.UpdateNexusEndpointRequest{
+		Id:      id,
+		Version: 2,
+		Spec: &nexuspb.EndpointSpec{
+			Name: "renamed",
+			Target: &nexuspb.EndpointTarget{
+				Variant: &nexuspb.EndpointTarget_External_{
+					External: &nexuspb.EndpointTarget_External{Url: "http://localhost/api"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost/api", resp.GetEndpoint().GetSpec().GetTarget().GetExternal().GetUrl())
+
+	// Changing the URL would silently drop the HTTP target settings.
+	_, err = client.Update(context.Background(), &operatorservice.UpdateNexusEndpointRequest{
+		Id:      id,
+		Version: 2,
+		Spec: &nexuspb.EndpointSpec{
+			Name: "renamed",
+			Target: &nexuspb.EndpointTarget{
+				Variant: &nexuspb.EndpointTarget_External_{
+					External: &nexuspb.EndpointTarget_External{Url: "http://localhost/other"},
+				},
+			},
+		},
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	require.ErrorAs(t, err, &failedPrecondition)
+}
@@ -0,0 +1,204 @@
+package frontend
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"go.temporal.io/server/api/adminservice/v1"
+	healthspb "go.temporal.io/server/api/health/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type outboundDestinationKey struct {
+	namespaceID string
+	taskGroup   string
+	destination string
+}
+
+// callAllHistoryHosts calls fn concurrently for every available history host and returns the addresses of the
+// hosts for which fn failed.
+func callAllHistoryHosts(
+	ctx context.Context,
+	membershipMonitor membership.Monitor,
+	logger log.Logger,
+	fn func(ctx context.Context, hostAddress string) error,
+) ([]string, error) {
+	return callAllServiceHosts(ctx, membershipMonitor, primitives.HistoryService, logger, fn)
+}
+
+// callAllServiceHosts calls fn concurrently for every available host of the service and returns the addresses of
+// the hosts for which fn failed.
+func callAllServiceHosts(
+	ctx context.Context,
+	membershipMonitor membership.Monitor,
+	service primitives.ServiceName,
+	logger log.Logger,
+	fn func(ctx context.Context, hostAddress string) error,
+) ([]string, error) {
+	resolver, err := membershipMonitor.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	var unreachable []string
+	var wg sync.WaitGroup
+	for _, host := range resolver.AvailableMembers() {
+		wg.Go(func() {
+			if err := fn(ctx, host.GetAddress()); err != nil {
+				logger.Warn("Failed to call host",
+					tag.Service(service),
+					tag.Address(host.GetAddress()),
+					tag.Error(err),
+				)
+				mu.Lock()
+				unreachable = append(unreachable, host.GetAddress())
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	slices.Sort(unreachable)
+	return unreachable, nil
+}
+
+// describeOutboundDestinationHealth returns the health of the outbound destinations matching the filter, aggregated
+// across all history hosts. Empty filter fields match all values.
+func describeOutboundDestinationHealth(
+	ctx context.Context,
+	membershipMonitor membership.Monitor,
+	historyClient historyservice.HistoryServiceClient,
+	logger log.Logger,
+	filter tasks.TaskGroupNamespaceIDAndDestination,
+) (*adminservice.DescribeOutboundDestinationHealthResponse, error) {
+	var mu sync.Mutex
+	var hostDestinations []*healthspb.OutboundDestinationHealth
+	unreachable, err := callAllHistoryHosts(ctx, membershipMonitor, logger, func(ctx context.Context, hostAddress string) error {
+		resp, err := historyClient.DescribeOutboundDestinationHealth(ctx, &historyservice.DescribeOutboundDestinationHealthRequest{
+			HostAddress: hostAddress,
+			NamespaceId: filter.NamespaceID,
+			TaskGroup:   filter.TaskGroup,
+			Destination: filter.Destination,
+		})
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		hostDestinations = append(hostDestinations, resp.GetDestinations()...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.DescribeOutboundDestinationHealthResponse{
+		Destinations:     aggregateOutboundDestinationHealth(hostDestinations),
+		HostDestinations: hostDestinations,
+		UnreachableHosts: unreachable,
+	}, nil
+}
+
+// aggregateOutboundDestinationHealth merges the health reported by each history host into a single cluster-wide
+// view per destination. Counts are summed, the least healthy circuit breaker state wins and latency percentiles are
+// approximated by the maximum reported by any host.
+func aggregateOutboundDestinationHealth(
+	hostDestinations []*healthspb.OutboundDestinationHealth,
+) []*healthspb.OutboundDestinationHealth {
+	byKey := make(map[outboundDestinationKey]*healthspb.OutboundDestinationHealth)
+	var keys []outboundDestinationKey
+	for _, h := range hostDestinations {
+		key := outboundDestinationKey{
+			namespaceID: h.GetNamespaceId(),
+			taskGroup:   h.GetTaskGroup(),
+			destination: h.GetDestination(),
+		}
+		agg, ok := byKey[key]
+		if !ok {
+			agg = common.CloneProto(h)
+			agg.HostAddress = ""
+			if agg.RecentErrorClasses == nil {
+				agg.RecentErrorClasses = make(map[string]int64)
+			}
+			byKey[key] = agg
+			keys = append(keys, key)
+			continue
+		}
+		if circuitBreakerStateSeverity(h.GetCircuitBreakerState()) > circuitBreakerStateSeverity(agg.GetCircuitBreakerState()) {
+			agg.CircuitBreakerState = h.GetCircuitBreakerState()
+		}
+		agg.ManuallyTripped = agg.GetManuallyTripped() || h.GetManuallyTripped()
+		agg.Requests += h.GetRequests()
+		agg.TotalSuccesses += h.GetTotalSuccesses()
+		agg.TotalFailures += h.GetTotalFailures()
+		agg.ConsecutiveSuccesses = max(agg.GetConsecutiveSuccesses(), h.GetConsecutiveSuccesses())
+		agg.ConsecutiveFailures = max(agg.GetConsecutiveFailures(), h.GetConsecutiveFailures())
+		agg.SampleCount += h.GetSampleCount()
+		for class, count := range h.GetRecentErrorClasses() {
+			agg.RecentErrorClasses[class] += count
+		}
+		if h.GetLatencyP50().AsDuration() > agg.GetLatencyP50().AsDuration() {
+			agg.LatencyP50 = h.GetLatencyP50()
+		}
+		if h.GetLatencyP95().AsDuration() > agg.GetLatencyP95().AsDuration() {
+			agg.LatencyP95 = h.GetLatencyP95()
+		}
+		if h.GetLatencyP99().AsDuration() > agg.GetLatencyP99().AsDuration() {
+			agg.LatencyP99 = h.GetLatencyP99()
+		}
+		if h.GetLastFailureTime().AsTime().After(agg.GetLastFailureTime().AsTime()) {
+			agg.LastFailureTime = h.GetLastFailureTime()
+			agg.LastFailureMessage = h.GetLastFailureMessage()
+		}
+	}
+
+	result := make([]*healthspb.OutboundDestinationHealth, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, byKey[key])
+	}
+	return result
+}
+
+// updateOutboundCircuitBreakerTrips adds or removes the trip of a destination. An empty task group trips the
+// destination for all task groups, and resets all trips of the destination.
+func updateOutboundCircuitBreakerTrips(
+	trips []*persistencespb.OutboundCircuitBreakerTrip,
+	taskGroup string,
+	destination string,
+	trip bool,
+	now time.Time,
+) []*persistencespb.OutboundCircuitBreakerTrip {
+	trips = slices.DeleteFunc(trips, func(existing *persistencespb.OutboundCircuitBreakerTrip) bool {
+		return existing.GetDestination() == destination && (taskGroup == "" || existing.GetTaskGroup() == taskGroup)
+	})
+	if trip {
+		trips = append(trips, &persistencespb.OutboundCircuitBreakerTrip{
+			TaskGroup:   taskGroup,
+			Destination: destination,
+			CreateTime:  timestamppb.New(now),
+		})
+	}
+	return trips
+}
+
+func circuitBreakerStateSeverity(state string) int {
+	switch state {
+	case gobreaker.StateOpen.String():
+		return 2
+	case gobreaker.StateHalfOpen.String():
+		return 1
+	default:
+		return 0
+	}
+}
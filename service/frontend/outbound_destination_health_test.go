@@ -0,0 +1,92 @@
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	healthspb "go.temporal.io/server/api/health/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAggregateOutboundDestinationHealth(t *testing.T) {
+	now := time.Now()
+	hostDestinations := []*healthspb.OutboundDestinationHealth{
+		{
+			HostAddress:         "host1",
+			NamespaceId:         "ns",
+			TaskGroup:           "nexus",
+			Destination:         "endpoint",
+			CircuitBreakerState: "closed",
+			Requests:            3,
+			TotalFailures:       1,
+			RecentErrorClasses:  map[string]int64{"DeadlineExceeded": 1},
+			LatencyP99:          durationpb.New(time.Second),
+			LastFailureTime:     timestamppb.New(now.Add(-time.Minute)),
+			LastFailureMessage:  "old",
+		},
+		{
+			HostAddress:         "host2",
+			NamespaceId:         "ns",
+			TaskGroup:           "nexus",
+			Destination:         "endpoint",
+			CircuitBreakerState: "open",
+			Requests:            2,
+			TotalFailures:       2,
+			RecentErrorClasses:  map[string]int64{"DeadlineExceeded": 2, "Unavailable": 1},
+			LatencyP99:          durationpb.New(2 * time.Second),
+			LastFailureTime:     timestamppb.New(now),
+			LastFailureMessage:  "new",
+		},
+		{
+			HostAddress:         "host1",
+			NamespaceId:         "ns",
+			TaskGroup:           "nexus",
+			Destination:         "other",
+			CircuitBreakerState: "half-open",
+		},
+	}
+
+	result := aggregateOutboundDestinationHealth(hostDestinations)
+	require.Len(t, result, 2)
+
+	endpoint := result[0]
+	require.Equal(t, "endpoint", endpoint.GetDestination())
+	require.Empty(t, endpoint.GetHostAddress())
+	require.Equal(t, "open", endpoint.GetCircuitBreakerState())
+	require.Equal(t, uint32(5), endpoint.GetRequests())
+	require.Equal(t, uint32(3), endpoint.GetTotalFailures())
+	require.Equal(t, map[string]int64{"DeadlineExceeded": 3, "Unavailable": 1}, endpoint.GetRecentErrorClasses())
+	require.Equal(t, 2*time.Second, endpoint.GetLatencyP99().AsDuration())
+	require.Equal(t, "new", endpoint.GetLastFailureMessage())
+
+	require.Equal(t, "other", result[1].GetDestination())
+	require.Equal(t, "half-open", result[1].GetCircuitBreakerState())
+
+	// Aggregation must not mutate the per-host entries.
+	require.Equal(t, "host1", hostDestinations[0].GetHostAddress())
+	require.Equal(t, int64(1), hostDestinations[0].GetRecentErrorClasses()["DeadlineExceeded"])
+}
+
+func TestUpdateOutboundCircuitBreakerTrips(t *testing.T) {
+	now := time.Now()
+
+	trips := updateOutboundCircuitBreakerTrips(nil, "nexus", "endpoint", true, now)
+	trips = updateOutboundCircuitBreakerTrips(trips, "", "other", true, now)
+	// Tripping again replaces the existing trip.
+	trips = updateOutboundCircuitBreakerTrips(trips, "nexus", "endpoint", true, now)
+	require.Len(t, trips, 2)
+	require.Equal(t, "other", trips[0].GetDestination())
+	require.Equal(t, "endpoint", trips[1].GetDestination())
+	require.Equal(t, timestamppb.New(now), trips[1].GetCreateTime())
+
+	// A specific task group does not reset a trip of all task groups.
+	trips = updateOutboundCircuitBreakerTrips(trips, "nexus", "other", false, now)
+	require.Len(t, trips, 2)
+
+	// An empty task group resets all trips of the destination.
+	trips = updateOutboundCircuitBreakerTrips(trips, "", "endpoint", false, now)
+	require.Len(t, trips, 1)
+	require.Equal(t, "other", trips[0].GetDestination())
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -108,6 +109,10 @@ const (
 	maxReasonLength              = 1000 // Maximum length for the reason field in RateLimitUpdate configurations.
 	defaultUserTerminateReason   = "terminated by user via frontend"
 	defaultUserTerminateIdentity = "frontend-service"
+
+	// recordNondeterminismErrorTimeout bounds the best-effort recording of nondeterminism errors, which is done in
+	// the background of RespondWorkflowTaskFailed.
+	recordNondeterminismErrorTimeout = 5 * time.Second
 )
 
 type (
@@ -356,6 +361,7 @@ func NewWorkflowHandler(
 			archiverProvider,
 			timeSource,
 			config,
+			visibilityMgr,
 		),
 		getDefaultWorkflowRetrySettings: config.DefaultWorkflowRetryPolicy,
 		visibilityMgr:                   visibilityMgr,
@@ -1288,6 +1294,26 @@ func (wh *WorkflowHandler) RespondWorkflowTaskFailed(
 			tag.WorkflowRunID(taskToken.GetRunId()),
 		)
 		metrics.ServiceErrNonDeterministicCounter.With(wh.metricsScope(ctx)).Record(1)
+
+		// Best-effort: the errors are only used by the health gates of managed rollouts, so they are recorded in
+		// the background and don't add latency to the response.
+		version := worker_versioning.DeploymentVersionFromOptions(request.GetDeploymentOptions())
+		if version != nil && wh.config.WorkerHeartbeatsEnabled(namespaceEntry.Name().String()) {
+			go func() {
+				disconnectedCtx := headers.SetCallerInfo(context.Background(), headers.NewBackgroundHighCallerInfo(namespaceEntry.Name().String()))
+				disconnectedCtx, cancel := context.WithTimeout(disconnectedCtx, recordNondeterminismErrorTimeout)
+				defer cancel()
+				if _, err := wh.matchingClient.RecordNondeterminismError(disconnectedCtx, &matchingservice.RecordNondeterminismErrorRequest{
+					NamespaceId: namespaceId.String(),
+					Version:     version,
+				}); err != nil {
+					wh.logger.Warn("Failed to record nondeterminism error.",
+						tag.WorkflowNamespaceID(taskToken.GetNamespaceId()),
+						tag.BuildId(version.GetBuildId()),
+						tag.Error(err))
+				}
+			}()
+		}
 	}
 
 	_, err = wh.historyClient.RespondWorkflowTaskFailed(ctx, &historyservice.RespondWorkflowTaskFailedRequest{
@@ -3195,6 +3221,9 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 		NamespaceId: namespaceID.String(),
 		Request:     request,
 	}
+	if err := setQueryStandbyConsistency(ctx, req); err != nil {
+		return nil, err
+	}
 	hResponse, err := wh.historyClient.QueryWorkflow(ctx, req)
 	if err != nil {
 		if common.IsContextDeadlineExceededErr(err) {
@@ -3205,6 +3234,28 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 	return hResponse.GetResponse(), nil
 }
 
+// setQueryStandbyConsistency sets the consistency a query requires when it is served by a standby cluster
+// from the query consistency headers.
+func setQueryStandbyConsistency(ctx context.Context, request *historyservice.QueryWorkflowRequest) error {
+	values := headers.GetValues(ctx, headers.QueryMinEventIDHeaderName, headers.QueryConsistencyHeaderName)
+	minEventID, consistency := values[0], values[1]
+	if minEventID != "" {
+		eventID, err := strconv.ParseInt(minEventID, 10, 64)
+		if err != nil || eventID < common.FirstEventID {
+			return errInvalidQueryMinEventID
+		}
+		request.StandbyMinEventId = eventID
+	}
+	switch consistency {
+	case "":
+	case headers.QueryConsistencyActiveLastWrite:
+		request.StandbyWaitForActiveLastWrite = true
+	default:
+		return errInvalidQueryConsistency
+	}
+	return nil
+}
+
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, request *workflowservice.DescribeWorkflowExecutionRequest) (_ *workflowservice.DescribeWorkflowExecutionResponse, retError error) {
 	defer log.CapturePanic(wh.logger, &retError)
@@ -5620,6 +5671,21 @@ func (wh *WorkflowHandler) StartBatchOperation(
 		}
 	}
 
+	// Queries filtering TemporalNamespaceDivision on the activity archetype target standalone activity executions.
+	query, standaloneActivities, err := batcher.RewriteStandaloneActivityQuery(request.GetVisibilityQuery())
+	if err != nil {
+		return nil, err
+	}
+	if standaloneActivities {
+		if !wh.ActivityHandler.IsStandaloneActivityEnabled(request.GetNamespace()) {
+			return nil, activity.ErrStandaloneActivityDisabled
+		}
+		request.VisibilityQuery = query
+		if err := batcher.ValidateStandaloneActivityBatchOperation(request); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate concurrent batch operation
 	maxConcurrentBatchOperation := wh.config.MaxConcurrentBatchOperation(request.GetNamespace())
 	countResp, err := wh.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
@@ -5647,8 +5713,9 @@ func (wh *WorkflowHandler) StartBatchOperation(
 	}
 
 	input := &batchspb.BatchOperationInput{
-		Request:     request,
-		NamespaceId: namespaceID.String(),
+		Request:              request,
+		NamespaceId:          namespaceID.String(),
+		StandaloneActivities: standaloneActivities,
 	}
 
 	var identity string
@@ -6975,6 +7042,7 @@ func (wh *WorkflowHandler) CreateWorkflowRule(
 
 	rule, err := wh.namespaceHandler.CreateWorkflowRule(ctx,
 		request.GetSpec(),
+		nil,
 		request.GetIdentity(),
 		request.GetDescription(),
 		request.GetNamespace())
@@ -7006,11 +7074,10 @@ func (wh *WorkflowHandler) DescribeWorkflowRule(
 		return nil, serviceerror.NewInvalidArgument("Workflow Rule ID is not set.")
 	}
 
-	rule, err := wh.namespaceHandler.DescribeWorkflowRule(ctx, request.GetRuleId(), request.GetNamespace())
+	rule, _, err := wh.namespaceHandler.DescribeWorkflowRule(ctx, request.GetRuleId(), request.GetNamespace())
 	if err != nil {
 		return nil, err
 	}
-
 	return &workflowservice.DescribeWorkflowRuleResponse{Rule: rule}, nil
 }
 
@@ -7285,7 +7352,11 @@ func (wh *WorkflowHandler) PauseActivityExecution(context.Context, *workflowserv
 	return nil, serviceerror.NewUnimplemented("PauseActivityExecution not implemented")
 }
 
-func (wh *WorkflowHandler) ResetActivityExecution(context.Context, *workflowservice.ResetActivityExecutionRequest) (*workflowservice.ResetActivityExecutionResponse, error) {
+func (wh *WorkflowHandler) ResetActivityExecution(ctx context.Context, request *workflowservice.ResetActivityExecutionRequest) (*workflowservice.ResetActivityExecutionResponse, error) {
+	// Only standalone activities are supported, workflow activities are reset through ResetActivity.
+	if request.GetWorkflowId() == "" {
+		return wh.ActivityHandler.ResetActivityExecution(ctx, request)
+	}
 	return nil, serviceerror.NewUnimplemented("ResetActivityExecution not implemented")
 }
 
@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/chasm/lib/activity"
 	"go.temporal.io/server/chasm/lib/callback"
 	"go.temporal.io/server/chasm/lib/nexusoperation"
 	"go.temporal.io/server/chasm/lib/workflow"
@@ -73,6 +74,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -2501,6 +2503,60 @@ func (s *WorkflowHandlerSuite) TestStartBatchOperation_Terminate() {
 	s.NoError(err)
 }
 
+func (s *WorkflowHandlerSuite) TestStartBatchOperation_StandaloneActivities() {
+	testNamespace := namespace.Name("test-namespace")
+	namespaceID := namespace.ID(uuid.NewString())
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+	wh.ActivityHandler = &standaloneActivityEnabledHandler{}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(namespaceID, nil).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{Count: 0}, nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			request *historyservice.StartWorkflowExecutionRequest,
+			_ ...grpc.CallOption,
+		) (*historyservice.StartWorkflowExecutionResponse, error) {
+			var input batchspb.BatchOperationInput
+			s.NoError(payloads.Decode(request.StartRequest.Input, &input))
+			s.True(input.GetStandaloneActivities())
+			s.Equal(enumspb.BATCH_OPERATION_TYPE_RESET_ACTIVITY, input.GetBatchType())
+			s.Equal(
+				fmt.Sprintf("(TemporalNamespaceDivision = '%d') AND (ActivityType = 'unit-test')", activity.ArchetypeID),
+				input.GetRequest().GetVisibilityQuery(),
+			)
+			return &historyservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+
+	_, err := wh.StartBatchOperation(context.Background(), &workflowservice.StartBatchOperationRequest{
+		Namespace:       testNamespace.String(),
+		JobId:           uuid.NewString(),
+		Reason:          "unit test",
+		VisibilityQuery: "TemporalNamespaceDivision = 'activity.activity'",
+		Operation: &workflowservice.StartBatchOperationRequest_ResetActivitiesOperation{
+			ResetActivitiesOperation: &batchpb.BatchOperationResetActivities{
+				Activity: &batchpb.BatchOperationResetActivities_Type{Type: "unit-test"},
+			},
+		},
+	})
+	s.NoError(err)
+
+	// Workflow only operations are rejected for standalone activities.
+	_, err = wh.StartBatchOperation(context.Background(), &workflowservice.StartBatchOperationRequest{
+		Namespace:       testNamespace.String(),
+		JobId:           uuid.NewString(),
+		Reason:          "unit test",
+		VisibilityQuery: "TemporalNamespaceDivision = 'activity.activity'",
+		Operation: &workflowservice.StartBatchOperationRequest_SignalOperation{
+			SignalOperation: &batchpb.BatchOperationSignal{Signal: "signal"},
+		},
+	})
+	var invalidArg *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArg)
+}
+
 func (s *WorkflowHandlerSuite) TestStartBatchOperation_Cancellation() {
 	testNamespace := namespace.Name("test-namespace")
 	namespaceID := namespace.ID(uuid.NewString())
@@ -3836,6 +3892,29 @@ func TestValidateRequestId(t *testing.T) {
 	assert.Len(t, req.RequestId, 36) // new UUID length
 }
 
+func TestSetQueryStandbyConsistency(t *testing.T) {
+	incomingContext := func(kv ...string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+	}
+
+	req := &historyservice.QueryWorkflowRequest{}
+	assert.NoError(t, setQueryStandbyConsistency(context.Background(), req))
+	assert.Zero(t, req.GetStandbyMinEventId())
+	assert.False(t, req.GetStandbyWaitForActiveLastWrite())
+
+	req = &historyservice.QueryWorkflowRequest{}
+	assert.NoError(t, setQueryStandbyConsistency(incomingContext(
+		headers.QueryMinEventIDHeaderName, "42",
+		headers.QueryConsistencyHeaderName, headers.QueryConsistencyActiveLastWrite,
+	), req))
+	assert.Equal(t, int64(42), req.GetStandbyMinEventId())
+	assert.True(t, req.GetStandbyWaitForActiveLastWrite())
+
+	assert.ErrorIs(t, setQueryStandbyConsistency(incomingContext(headers.QueryMinEventIDHeaderName, "0"), req), errInvalidQueryMinEventID)
+	assert.ErrorIs(t, setQueryStandbyConsistency(incomingContext(headers.QueryMinEventIDHeaderName, "abc"), req), errInvalidQueryMinEventID)
+	assert.ErrorIs(t, setQueryStandbyConsistency(incomingContext(headers.QueryConsistencyHeaderName, "eventual"), req), errInvalidQueryConsistency)
+}
+
 func TestDedupLinksFromCallbacks(t *testing.T) {
 	links := []*commonpb.Link{
 		{
@@ -4813,6 +4892,15 @@ func (s *WorkflowHandlerSuite) TestUpdateActivityOptions_Priority() {
 	// NOTE: only testing a single validation scenario here; the priority validation has its own unit tests
 }
 
+// standaloneActivityEnabledHandler is an activity frontend handler with standalone activities enabled.
+type standaloneActivityEnabledHandler struct {
+	activity.FrontendHandler
+}
+
+func (*standaloneActivityEnabledHandler) IsStandaloneActivityEnabled(string) bool {
+	return true
+}
+
 // routingMatchingClient wraps a mock MatchingServiceClient to also implement matching.RoutingClient,
 // allowing tests to verify host-based deduplication in cancelOutstandingWorkerPolls.
 type routingMatchingClient struct {
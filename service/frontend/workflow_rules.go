@@ -0,0 +1,101 @@
+package frontend
+
+import (
+	"context"
+	"sync/atomic"
+
+	rulespb "go.temporal.io/api/rules/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+)
+
+// validateWorkflowRuleExtension checks that the extension has a trigger and actions, which replace the ones of the
+// rule spec.
+func validateWorkflowRuleExtension(
+	ruleSpec *rulespb.WorkflowRuleSpec,
+	extension *persistencespb.WorkflowRuleExtension,
+) error {
+	if ruleSpec.GetTrigger() != nil || len(ruleSpec.GetActions()) > 0 {
+		return serviceerror.NewInvalidArgument("Workflow Rule spec must not have a trigger or actions when the rule has an extension.")
+	}
+
+	switch trigger := extension.GetTrigger().(type) {
+	case *persistencespb.WorkflowRuleExtension_WorkflowTaskFailed, *persistencespb.WorkflowRuleExtension_TimerFired:
+	case *persistencespb.WorkflowRuleExtension_SignalReceived:
+		if trigger.SignalReceived.GetSignalName() == "" {
+			return serviceerror.NewInvalidArgument("Workflow Rule signal received trigger must have a signal name.")
+		}
+	case *persistencespb.WorkflowRuleExtension_HistorySize:
+		if trigger.HistorySize.GetThresholdBytes() <= 0 {
+			return serviceerror.NewInvalidArgument("Workflow Rule history size trigger must have a positive threshold.")
+		}
+	default:
+		return serviceerror.NewInvalidArgument("Workflow Rule extension trigger is not set.")
+	}
+
+	if len(extension.GetActions()) == 0 {
+		return serviceerror.NewInvalidArgument("Workflow Rule extension actions are not set.")
+	}
+	for _, action := range extension.GetActions() {
+		switch variant := action.GetVariant().(type) {
+		case *persistencespb.WorkflowRuleExtensionAction_PauseWorkflow:
+		case *persistencespb.WorkflowRuleExtensionAction_Signal:
+			if variant.Signal.GetSignalName() == "" {
+				return serviceerror.NewInvalidArgument("Workflow Rule signal action must have a signal name.")
+			}
+		case *persistencespb.WorkflowRuleExtensionAction_Metric:
+			if variant.Metric.GetName() == "" {
+				return serviceerror.NewInvalidArgument("Workflow Rule metric action must have a name.")
+			}
+		case *persistencespb.WorkflowRuleExtensionAction_MemoNote:
+			if variant.MemoNote.GetKey() == "" {
+				return serviceerror.NewInvalidArgument("Workflow Rule memo note action must have a key.")
+			}
+		default:
+			return serviceerror.NewInvalidArgument("Workflow Rule extension action is not set.")
+		}
+	}
+	return nil
+}
+
+// removeOrphanedWorkflowRuleExtensions removes the extensions of rules which no longer exist.
+func removeOrphanedWorkflowRuleExtensions(config *persistencespb.NamespaceConfig) {
+	for ruleID := range config.GetWorkflowRuleExtensions() {
+		if _, ok := config.GetWorkflowRules()[ruleID]; !ok {
+			delete(config.WorkflowRuleExtensions, ruleID)
+		}
+	}
+}
+
+// describeWorkflowRuleHits returns the number of times the rule matched, aggregated across all history hosts, and
+// the addresses of the hosts which could not be reached.
+func describeWorkflowRuleHits(
+	ctx context.Context,
+	membershipMonitor membership.Monitor,
+	historyClient historyservice.HistoryServiceClient,
+	logger log.Logger,
+	namespaceID string,
+	rule *rulespb.WorkflowRule,
+) (int64, []string, error) {
+	var hitCount atomic.Int64
+	unreachable, err := callAllHistoryHosts(ctx, membershipMonitor, logger, func(ctx context.Context, hostAddress string) error {
+		resp, err := historyClient.DescribeWorkflowRuleHits(ctx, &historyservice.DescribeWorkflowRuleHitsRequest{
+			HostAddress:    hostAddress,
+			NamespaceId:    namespaceID,
+			RuleId:         rule.GetSpec().GetId(),
+			RuleCreateTime: rule.GetCreateTime(),
+		})
+		if err != nil {
+			return err
+		}
+		hitCount.Add(resp.GetHitCount())
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return hitCount.Load(), unreachable, nil
+}
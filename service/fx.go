@@ -33,6 +33,7 @@ type (
 		OperatorRPSRatio                   persistenceClient.OperatorRPSRatio
 		PersistenceBurstRatio              persistenceClient.PersistenceBurstRatio
 		DynamicRateLimitingParams          persistenceClient.DynamicRateLimitingParams
+		ConcurrencyLimitingParams          persistenceClient.ConcurrencyLimitingParams
 	}
 
 	GrpcServerOptionsParams struct {
@@ -88,6 +89,7 @@ func NewPersistenceRateLimitingParams(
 	operatorRPSRatio dynamicconfig.FloatPropertyFn,
 	burstRatio dynamicconfig.FloatPropertyFn,
 	dynamicRateLimitingParams dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicRateLimitingParams],
+	concurrencyLimitingParams dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicConcurrencyLimitingParams],
 	lazyLoadedServiceResolver PersistenceLazyLoadedServiceResolver,
 	logger log.Logger,
 ) PersistenceRateLimitingParams {
@@ -118,6 +120,7 @@ func NewPersistenceRateLimitingParams(
 		OperatorRPSRatio:                   persistenceClient.OperatorRPSRatio(operatorRPSRatio),
 		PersistenceBurstRatio:              persistenceClient.PersistenceBurstRatio(burstRatio),
 		DynamicRateLimitingParams:          persistenceClient.DynamicRateLimitingParams(dynamicRateLimitingParams),
+		ConcurrencyLimitingParams:          persistenceClient.ConcurrencyLimitingParams(concurrencyLimitingParams),
 	}
 }
 
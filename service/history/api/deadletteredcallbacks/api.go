@@ -0,0 +1,134 @@
+// Package deadletteredcallbacks contains the logic to implement the [historyservice.HistoryServiceServer] APIs that
+// operate on the callback dead-letter queue.
+package deadletteredcallbacks
+
+import (
+	"context"
+	"errors"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/chasm/lib/callback"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/consts"
+)
+
+// replayPageSize is the number of dead-lettered callbacks read at a time while replaying.
+const replayPageSize = 100
+
+var errCallbackDLQNotSupported = serviceerror.NewUnimplemented("callback dead-letter queue is not supported by the persistence store")
+
+// List invokes the ListDeadLetteredCallbacks API.
+func List(
+	ctx context.Context,
+	manager persistence.CallbackDLQManager,
+	req *historyservice.ListDeadLetteredCallbacksRequest,
+) (*historyservice.ListDeadLetteredCallbacksResponse, error) {
+	if manager == nil {
+		return nil, errCallbackDLQNotSupported
+	}
+	response, err := manager.ReadCallbacks(ctx, &persistence.ReadCallbacksRequest{
+		NamespaceID:   req.GetNamespaceId(),
+		PageSize:      int(req.GetPageSize()),
+		MinMessageID:  req.GetMinMessageId(),
+		NextPageToken: req.GetNextPageToken(),
+	})
+	if err != nil {
+		if errors.Is(err, persistence.ErrNonPositiveReadQueueMessagesPageSize) {
+			return nil, consts.ErrInvalidPageSize
+		}
+		return nil, serviceerror.NewUnavailablef("ListDeadLetteredCallbacks failed. Error: %v", err)
+	}
+
+	messages := make([]*historyservice.DeadLetteredCallbackMessage, len(response.Callbacks))
+	for i, cb := range response.Callbacks {
+		messages[i] = &historyservice.DeadLetteredCallbackMessage{
+			MessageId: cb.MessageMetadata.ID,
+			Callback:  cb.Callback,
+		}
+	}
+	return &historyservice.ListDeadLetteredCallbacksResponse{
+		Messages:      messages,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// Replay invokes the ReplayDeadLetteredCallbacks API. Callbacks are re-delivered in message ID order and replay stops
+// at the first failed delivery so that callbacks are never deleted out of order.
+func Replay(
+	ctx context.Context,
+	manager persistence.CallbackDLQManager,
+	callerProvider callback.HTTPCallerProvider,
+	req *historyservice.ReplayDeadLetteredCallbacksRequest,
+) (*historyservice.ReplayDeadLetteredCallbacksResponse, error) {
+	if manager == nil {
+		return nil, errCallbackDLQNotSupported
+	}
+	response := &historyservice.ReplayDeadLetteredCallbacksResponse{}
+	lastDeliveredID := int64(-1)
+	var nextPageToken []byte
+
+replay:
+	for {
+		page, err := manager.ReadCallbacks(ctx, &persistence.ReadCallbacksRequest{
+			NamespaceID:   req.GetNamespaceId(),
+			PageSize:      replayPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, serviceerror.NewUnavailablef("ReplayDeadLetteredCallbacks failed. Error: %v", err)
+		}
+		for _, cb := range page.Callbacks {
+			if cb.MessageMetadata.ID > req.GetInclusiveMaxMessageId() {
+				break replay
+			}
+			if err := callback.ReplayDeadLetteredCallback(ctx, callerProvider, cb.Callback); err != nil {
+				response.FailedMessageId = cb.MessageMetadata.ID
+				response.FailureMessage = err.Error()
+				break replay
+			}
+			lastDeliveredID = cb.MessageMetadata.ID
+			response.DeliveredCount++
+		}
+		if len(page.NextPageToken) == 0 || len(page.Callbacks) == 0 {
+			break
+		}
+		nextPageToken = page.NextPageToken
+	}
+
+	if req.GetDeleteDelivered() && lastDeliveredID >= persistence.FirstQueueMessageID {
+		deleted, err := manager.DeleteCallbacks(ctx, &persistence.DeleteCallbacksRequest{
+			NamespaceID:                 req.GetNamespaceId(),
+			InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: lastDeliveredID},
+		})
+		if err != nil {
+			return nil, serviceerror.NewUnavailablef("ReplayDeadLetteredCallbacks failed to delete delivered callbacks. Error: %v", err)
+		}
+		response.MessagesDeleted = deleted.MessagesDeleted
+	}
+	return response, nil
+}
+
+// Purge invokes the PurgeDeadLetteredCallbacks API.
+func Purge(
+	ctx context.Context,
+	manager persistence.CallbackDLQManager,
+	req *historyservice.PurgeDeadLetteredCallbacksRequest,
+) (*historyservice.PurgeDeadLetteredCallbacksResponse, error) {
+	if manager == nil {
+		return nil, errCallbackDLQNotSupported
+	}
+	if req.GetInclusiveMaxMessageId() < persistence.FirstQueueMessageID {
+		return nil, serviceerror.NewInvalidArgument("inclusive max message ID must not be negative")
+	}
+	response, err := manager.DeleteCallbacks(ctx, &persistence.DeleteCallbacksRequest{
+		NamespaceID:                 req.GetNamespaceId(),
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: req.GetInclusiveMaxMessageId()},
+	})
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef("PurgeDeadLetteredCallbacks failed. Error: %v", err)
+	}
+	return &historyservice.PurgeDeadLetteredCallbacksResponse{
+		MessagesDeleted: response.MessagesDeleted,
+	}, nil
+}
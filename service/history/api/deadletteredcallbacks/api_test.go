@@ -0,0 +1,112 @@
+package deadletteredcallbacks_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm/lib/callback"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/api/deadletteredcallbacks"
+	"go.temporal.io/server/service/history/queues/common"
+	"go.uber.org/mock/gomock"
+)
+
+func deadLetteredCallback(id int64, url string) persistence.DeadLetteredCallback {
+	return persistence.DeadLetteredCallback{
+		MessageMetadata: persistence.MessageMetadata{ID: id},
+		Callback: &persistencespb.DeadLetteredCallback{
+			NamespaceId: "namespace-id",
+			Request: &persistencespb.DeadLetteredCallback_HttpRequest{
+				Method: http.MethodPost,
+				Url:    url,
+			},
+		},
+	}
+}
+
+func TestReplay(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	manager := persistence.NewMockCallbackDLQManager(ctrl)
+	manager.EXPECT().ReadCallbacks(gomock.Any(), gomock.Any()).Return(&persistence.ReadCallbacksResponse{
+		Callbacks: []persistence.DeadLetteredCallback{
+			deadLetteredCallback(0, "http://localhost/ok"),
+			deadLetteredCallback(1, "http://localhost/ok"),
+			deadLetteredCallback(2, "http://localhost/fail"),
+			deadLetteredCallback(3, "http://localhost/ok"),
+		},
+	}, nil)
+	manager.EXPECT().DeleteCallbacks(gomock.Any(), &persistence.DeleteCallbacksRequest{
+		NamespaceID:                 "namespace-id",
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: 1},
+	}).Return(&persistence.DeleteCallbacksResponse{MessagesDeleted: 2}, nil)
+
+	var delivered []string
+	provider := func(common.NamespaceIDAndDestination) callback.HTTPCaller {
+		return func(r *http.Request) (*http.Response, error) {
+			if r.URL.Path == "/fail" {
+				return &http.Response{StatusCode: http.StatusServiceUnavailable, Status: "503 Service Unavailable", Body: http.NoBody}, nil
+			}
+			delivered = append(delivered, r.URL.Path)
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}
+	}
+
+	resp, err := deadletteredcallbacks.Replay(context.Background(), manager, provider, &historyservice.ReplayDeadLetteredCallbacksRequest{
+		NamespaceId:           "namespace-id",
+		InclusiveMaxMessageId: 10,
+		DeleteDelivered:       true,
+	})
+	require.NoError(t, err)
+	require.Len(t, delivered, 2)
+	require.Equal(t, int32(2), resp.GetDeliveredCount())
+	require.Equal(t, int64(2), resp.GetMessagesDeleted())
+	require.Equal(t, int64(2), resp.GetFailedMessageId())
+	require.Contains(t, resp.GetFailureMessage(), "503")
+}
+
+func TestReplay_StopsAtMaxMessageID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	manager := persistence.NewMockCallbackDLQManager(ctrl)
+	manager.EXPECT().ReadCallbacks(gomock.Any(), gomock.Any()).Return(&persistence.ReadCallbacksResponse{
+		Callbacks: []persistence.DeadLetteredCallback{
+			deadLetteredCallback(0, "http://localhost/ok"),
+			deadLetteredCallback(1, "http://localhost/ok"),
+		},
+		NextPageToken: []byte("token"),
+	}, nil)
+
+	provider := func(common.NamespaceIDAndDestination) callback.HTTPCaller {
+		return func(r *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}
+	}
+
+	resp, err := deadletteredcallbacks.Replay(context.Background(), manager, provider, &historyservice.ReplayDeadLetteredCallbacksRequest{
+		NamespaceId:           "namespace-id",
+		InclusiveMaxMessageId: 0,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), resp.GetDeliveredCount())
+	require.Zero(t, resp.GetMessagesDeleted())
+	require.Empty(t, resp.GetFailureMessage())
+}
+
+func TestPurge_NegativeMessageID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	manager := persistence.NewMockCallbackDLQManager(ctrl)
+	_, err := deadletteredcallbacks.Purge(context.Background(), manager, &historyservice.PurgeDeadLetteredCallbacksRequest{
+		NamespaceId:           "namespace-id",
+		InclusiveMaxMessageId: -1,
+	})
+	require.Error(t, err)
+}
@@ -29,6 +29,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/api"
@@ -256,7 +257,10 @@ func Invoke(
 		Fields: clonePayloadMap(relocatableAttributes.Memo.GetFields()),
 	}
 	result.WorkflowExecutionInfo.SearchAttributes = &commonpb.SearchAttributes{
-		IndexedFields: clonePayloadMap(relocatableAttributes.SearchAttributes.GetIndexedFields()),
+		IndexedFields: clonePayloadMap(searchattribute.ApplyTypeMigrations(
+			relocatableAttributes.SearchAttributes.GetIndexedFields(),
+			mutableState.GetNamespaceEntry().SearchAttributeTypeMigrations(),
+		)),
 	}
 
 	// Check for CHASM callbacks (regardless of feature flag setting)
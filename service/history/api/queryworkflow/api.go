@@ -18,11 +18,13 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/api/resetstickytaskqueue"
 	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/events"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/workflow"
 )
@@ -37,6 +39,8 @@ func Invoke(
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
 	rawMatchingClient matchingservice.MatchingServiceClient,
 	matchingClient matchingservice.MatchingServiceClient,
+	eventNotifier events.Notifier,
+	cacheFillRateLimiter quotas.RateLimiter,
 ) (_ *historyservice.QueryWorkflowResponse, retError error) {
 	scope := shardContext.GetMetricsHandler().WithTags(metrics.OperationTag(metrics.HistoryQueryWorkflowScope))
 	namespaceID := namespace.ID(request.GetNamespaceId())
@@ -49,22 +53,35 @@ func Invoke(
 		return nil, err
 	}
 
-	if len(request.Request.Execution.RunId) == 0 {
-		request.Request.Execution.RunId, err = workflowConsistencyChecker.GetCurrentWorkflowRunID(
-			ctx,
+	workflowKey, err := waitForReplication(
+		ctx,
+		request,
+		nsEntry,
+		definition.NewWorkflowKey(
 			request.NamespaceId,
 			request.Request.Execution.WorkflowId,
+			request.Request.Execution.RunId,
+		),
+		shardContext,
+		workflowConsistencyChecker,
+		eventNotifier,
+		scope,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(workflowKey.RunID) == 0 {
+		workflowKey.RunID, err = workflowConsistencyChecker.GetCurrentWorkflowRunID(
+			ctx,
+			workflowKey.NamespaceID,
+			workflowKey.WorkflowID,
 			locks.PriorityHigh,
 		)
 		if err != nil {
 			return nil, err
 		}
 	}
-	workflowKey := definition.NewWorkflowKey(
-		request.NamespaceId,
-		request.Request.Execution.WorkflowId,
-		request.Request.Execution.RunId,
-	)
+	request.Request.Execution.RunId = workflowKey.RunID
 	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
 		ctx,
 		nil,
@@ -142,6 +159,25 @@ func Invoke(
 		return nil, serviceerror.NewWorkflowNotReady("Unable to query workflow due to Workflow Task in failed state.")
 	}
 
+	// Results of queries on closed workflows can be served from the query result cache, if enabled. Only results
+	// the worker declared cacheable are stored in it.
+	var cacheKey string
+	if !mutableState.IsWorkflowExecutionRunning() &&
+		shardContext.GetConfig().EnableQueryResultCache(nsEntry.Name().String()) {
+		if cacheKey, err = queryResultCacheKey(req.GetQuery()); err != nil {
+			return nil, err
+		}
+		if result := mutableState.GetCachedQueryResult(cacheKey); result != nil {
+			metrics.QueryResultCacheHitCount.With(scope).Record(1)
+			return &historyservice.QueryWorkflowResponse{
+				Response: &workflowservice.QueryWorkflowResponse{
+					QueryResult: result,
+				},
+			}, nil
+		}
+		metrics.QueryResultCacheMissCount.With(scope).Record(1)
+	}
+
 	priority := mutableState.GetExecutionInfo().Priority
 
 	// There are two ways in which queries get dispatched to workflow worker. First, queries can be dispatched on workflow tasks.
@@ -166,7 +202,7 @@ func Invoke(
 			}
 			workflowLease.GetReleaseFn()(nil) // release the lock - no access to mutable state beyond this point!
 			req.Execution.RunId = msResp.Execution.RunId
-			return queryDirectlyThroughMatching(
+			resp, err := queryDirectlyThroughMatching(
 				ctx,
 				msResp,
 				nsEntry,
@@ -179,6 +215,19 @@ func Invoke(
 				scope,
 				priority,
 			)
+			if err == nil && cacheKey != "" {
+				cacheQueryResult(
+					ctx,
+					shardContext,
+					workflowConsistencyChecker,
+					cacheFillRateLimiter,
+					nsEntry,
+					workflowKey,
+					cacheKey,
+					resp.GetResponse().GetQueryResult(),
+				)
+			}
+			return resp, err
 		}
 	}
 
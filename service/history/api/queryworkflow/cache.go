@@ -0,0 +1,99 @@
+package queryworkflow
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	commonpb "go.temporal.io/api/common/v1"
+	querypb "go.temporal.io/api/query/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"google.golang.org/protobuf/proto"
+)
+
+// CacheableQueryResultMetadataKey is the payload metadata field through which a worker declares, by setting it to
+// "true" on every payload of a query result, that the result can be cached for a closed workflow. Cacheability is
+// only taken from the worker's result so that callers cannot opt queries into the cache.
+const CacheableQueryResultMetadataKey = "temporal-query-result-cacheable"
+
+// queryResultCacheKey returns the key of a query in the query result cache, which is made of the query
+// type and a hash of the query args. The query header is not part of the key.
+func queryResultCacheKey(query *querypb.WorkflowQuery) (string, error) {
+	args, err := proto.MarshalOptions{Deterministic: true}.Marshal(query.GetQueryArgs())
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(args)
+	return query.GetQueryType() + "/" + hex.EncodeToString(hash[:]), nil
+}
+
+// isCacheableQueryResult returns whether the worker declared the query result cacheable.
+func isCacheableQueryResult(result *commonpb.Payloads) bool {
+	if len(result.GetPayloads()) == 0 {
+		return false
+	}
+	for _, p := range result.GetPayloads() {
+		if string(p.GetMetadata()[CacheableQueryResultMetadataKey]) != "true" {
+			return false
+		}
+	}
+	return true
+}
+
+// cacheQueryResult stores the result of a cacheable query on a closed workflow in its mutable state.
+// Every fill is a mutable state write, so fills are rate limited per shard. Caching is best-effort, so
+// failures are only logged.
+func cacheQueryResult(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	fillRateLimiter quotas.RateLimiter,
+	nsEntry *namespace.Namespace,
+	workflowKey definition.WorkflowKey,
+	cacheKey string,
+	result *commonpb.Payloads,
+) {
+	config := shardContext.GetConfig()
+	namespaceName := nsEntry.Name().String()
+	if !isCacheableQueryResult(result) ||
+		result.Size() > config.QueryResultCacheMaxResultSize(namespaceName) ||
+		// Mutable state can only be updated in the active cluster.
+		!nsEntry.ActiveInCluster(shardContext.GetClusterMetadata().GetCurrentClusterName()) ||
+		!fillRateLimiter.Allow() {
+		return
+	}
+
+	err := api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		workflowKey,
+		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowLease.GetMutableState()
+			if mutableState.IsWorkflowExecutionRunning() {
+				return &api.UpdateWorkflowAction{Noop: true}, nil
+			}
+			mutableState.CacheQueryResult(
+				cacheKey,
+				result,
+				config.QueryResultCacheTTL(namespaceName),
+				config.QueryResultCacheMaxEntries(namespaceName),
+			)
+			return &api.UpdateWorkflowAction{}, nil
+		},
+		nil,
+		shardContext,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		shardContext.GetLogger().Warn("Failed to cache query result.",
+			tag.WorkflowNamespaceID(workflowKey.NamespaceID),
+			tag.WorkflowID(workflowKey.WorkflowID),
+			tag.WorkflowRunID(workflowKey.RunID),
+			tag.Error(err))
+	}
+}
@@ -0,0 +1,51 @@
+package queryworkflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	querypb "go.temporal.io/api/query/v1"
+	"go.temporal.io/server/common/payloads"
+)
+
+func TestQueryResultCacheKey(t *testing.T) {
+	t.Parallel()
+
+	query := func(queryType string, args *commonpb.Payloads) *querypb.WorkflowQuery {
+		return &querypb.WorkflowQuery{QueryType: queryType, QueryArgs: args}
+	}
+	key := func(q *querypb.WorkflowQuery) string {
+		k, err := queryResultCacheKey(q)
+		require.NoError(t, err)
+		return k
+	}
+
+	require.Equal(t, key(query("state", payloads.EncodeString("a"))), key(query("state", payloads.EncodeString("a"))))
+	require.NotEqual(t, key(query("state", payloads.EncodeString("a"))), key(query("state", payloads.EncodeString("b"))))
+	require.NotEqual(t, key(query("state", payloads.EncodeString("a"))), key(query("status", payloads.EncodeString("a"))))
+
+	withHeader := query("state", payloads.EncodeString("a"))
+	withHeader.Header = &commonpb.Header{Fields: map[string]*commonpb.Payload{"trace": payloads.EncodeString("id").GetPayloads()[0]}}
+	require.Equal(t, key(query("state", payloads.EncodeString("a"))), key(withHeader), "header must not be part of the key")
+}
+
+func TestIsCacheableQueryResult(t *testing.T) {
+	t.Parallel()
+
+	result := func(metadata ...map[string][]byte) *commonpb.Payloads {
+		var ps []*commonpb.Payload
+		for _, m := range metadata {
+			ps = append(ps, &commonpb.Payload{Metadata: m, Data: []byte("{}")})
+		}
+		return &commonpb.Payloads{Payloads: ps}
+	}
+	cacheable := map[string][]byte{CacheableQueryResultMetadataKey: []byte("true")}
+
+	require.True(t, isCacheableQueryResult(result(cacheable)))
+	require.True(t, isCacheableQueryResult(result(cacheable, cacheable)))
+	require.False(t, isCacheableQueryResult(result(cacheable, map[string][]byte{"encoding": []byte("json/plain")})))
+	require.False(t, isCacheableQueryResult(result(map[string][]byte{CacheableQueryResultMetadataKey: []byte("false")})))
+	require.False(t, isCacheableQueryResult(result()))
+	require.False(t, isCacheableQueryResult(nil))
+}
@@ -0,0 +1,183 @@
+package queryworkflow
+
+import (
+	"context"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/events"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+// waitForReplication blocks a query on a standby cluster until local replication has caught up with
+// the consistency requested by the caller: either a min event ID, or the last write of the workflow in
+// the active cluster. The wait is bounded by the namespace's standby query consistency timeout.
+//
+// It returns the workflow key to query. When waiting for the last write of the active cluster without a
+// run ID, the run ID is pinned to the current run in the active cluster, which may not be replicated yet.
+// Otherwise an empty run ID is resolved to the current run of this cluster.
+func waitForReplication(
+	ctx context.Context,
+	request *historyservice.QueryWorkflowRequest,
+	nsEntry *namespace.Namespace,
+	workflowKey definition.WorkflowKey,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	eventNotifier events.Notifier,
+	metricsHandler metrics.Handler,
+) (_ definition.WorkflowKey, retError error) {
+	minEventID := request.GetStandbyMinEventId()
+	if minEventID <= 0 && !request.GetStandbyWaitForActiveLastWrite() {
+		return workflowKey, nil
+	}
+	if nsEntry.ActiveInCluster(shardContext.GetClusterMetadata().GetCurrentClusterName()) {
+		// The active cluster always has the latest state.
+		return workflowKey, nil
+	}
+
+	startTime := time.Now().UTC()
+	defer func() {
+		metrics.StandbyQueryReplicationWaitLatency.With(metricsHandler).Record(time.Since(startTime))
+	}()
+
+	waitCtx, cancel := context.WithTimeout(ctx, shardContext.GetConfig().StandbyQueryConsistencyTimeout(nsEntry.Name().String()))
+	defer cancel()
+	waitErr := func(err error) error {
+		if waitCtx.Err() != nil && ctx.Err() == nil {
+			metrics.StandbyQueryReplicationTimeoutCount.With(metricsHandler).Record(1)
+			return consts.ErrStandbyQueryReplicationTimeout
+		}
+		return err
+	}
+
+	var activeLastWrite *historyspb.VersionHistoryItem
+	// the run may not have been replicated yet if it was pinned from the active cluster
+	pinnedFromActive := false
+	if request.GetStandbyWaitForActiveLastWrite() {
+		var activeRunID string
+		var err error
+		if activeRunID, activeLastWrite, err = getActiveLastWrite(waitCtx, nsEntry, workflowKey, shardContext); err != nil {
+			return workflowKey, waitErr(err)
+		}
+		if len(workflowKey.RunID) == 0 {
+			workflowKey.RunID = activeRunID
+			pinnedFromActive = true
+		}
+	}
+	if len(workflowKey.RunID) == 0 {
+		var err error
+		workflowKey.RunID, err = workflowConsistencyChecker.GetCurrentWorkflowRunID(
+			waitCtx,
+			workflowKey.NamespaceID,
+			workflowKey.WorkflowID,
+			locks.PriorityHigh,
+		)
+		if err != nil {
+			return workflowKey, waitErr(err)
+		}
+	}
+
+	subscriberID, channel, err := eventNotifier.WatchHistoryEvent(workflowKey)
+	if err != nil {
+		return workflowKey, err
+	}
+	defer func() { _ = eventNotifier.UnwatchHistoryEvent(workflowKey, subscriberID) }()
+
+	for {
+		msResp, err := api.GetMutableState(waitCtx, shardContext, workflowKey, workflowConsistencyChecker)
+		switch err.(type) {
+		case nil:
+			caughtUp, err := replicationCaughtUp(msResp, minEventID, activeLastWrite)
+			if err != nil || caughtUp {
+				return workflowKey, err
+			}
+		case *serviceerror.NotFound:
+			if !pinnedFromActive {
+				return workflowKey, err
+			}
+		default:
+			return workflowKey, waitErr(err)
+		}
+		select {
+		case <-channel:
+		case <-waitCtx.Done():
+			return workflowKey, waitErr(waitCtx.Err())
+		}
+	}
+}
+
+// replicationCaughtUp returns true if the local mutable state has applied minEventID (if set) and
+// activeLastWrite (if set). A closed workflow has nothing left to replicate, so it is always caught up.
+func replicationCaughtUp(
+	msResp *historyservice.GetMutableStateResponse,
+	minEventID int64,
+	activeLastWrite *historyspb.VersionHistoryItem,
+) (bool, error) {
+	if msResp.GetWorkflowStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return true, nil
+	}
+	if minEventID > 0 && msResp.GetNextEventId() <= minEventID {
+		return false, nil
+	}
+	if activeLastWrite != nil {
+		currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(msResp.GetVersionHistories())
+		if err != nil {
+			return false, err
+		}
+		if !versionhistory.ContainsVersionHistoryItem(currentVersionHistory, activeLastWrite) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getActiveLastWrite returns the run ID and the last version history item of the workflow in the active
+// cluster. Without a run ID in workflowKey, these are of the current run in the active cluster.
+func getActiveLastWrite(
+	ctx context.Context,
+	nsEntry *namespace.Namespace,
+	workflowKey definition.WorkflowKey,
+	shardContext historyi.ShardContext,
+) (string, *historyspb.VersionHistoryItem, error) {
+	activeClusterName := nsEntry.ActiveClusterName(namespace.RoutingKey{ID: workflowKey.WorkflowID})
+	remoteAdminClient, err := shardContext.GetRemoteAdminClient(activeClusterName)
+	if err != nil {
+		return "", nil, err
+	}
+	resp, err := remoteAdminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace: nsEntry.Name().String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowKey.WorkflowID,
+			RunId:      workflowKey.RunID,
+		},
+		SkipForceReload: true,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	mutableState := resp.GetCacheMutableState()
+	if mutableState == nil {
+		mutableState = resp.GetDatabaseMutableState()
+	}
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(mutableState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return "", nil, err
+	}
+	lastWrite, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return "", nil, err
+	}
+	return mutableState.GetExecutionState().GetRunId(), lastWrite, nil
+}
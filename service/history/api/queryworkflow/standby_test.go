@@ -0,0 +1,128 @@
+package queryworkflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReplicationCaughtUp(t *testing.T) {
+	t.Parallel()
+
+	msResp := func(status enumspb.WorkflowExecutionStatus, items ...*historyspb.VersionHistoryItem) *historyservice.GetMutableStateResponse {
+		return &historyservice.GetMutableStateResponse{
+			WorkflowStatus:   status,
+			NextEventId:      items[len(items)-1].GetEventId() + 1,
+			VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(nil, items)),
+		}
+	}
+	running := enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	item := versionhistory.NewVersionHistoryItem
+
+	tests := []struct {
+		name            string
+		msResp          *historyservice.GetMutableStateResponse
+		minEventID      int64
+		activeLastWrite *historyspb.VersionHistoryItem
+		caughtUp        bool
+	}{
+		{
+			name:       "min event ID applied",
+			msResp:     msResp(running, item(10, 1)),
+			minEventID: 10,
+			caughtUp:   true,
+		},
+		{
+			name:       "min event ID not applied",
+			msResp:     msResp(running, item(10, 1)),
+			minEventID: 11,
+		},
+		{
+			name:            "active last write applied",
+			msResp:          msResp(running, item(10, 1), item(20, 2)),
+			activeLastWrite: item(15, 2),
+			caughtUp:        true,
+		},
+		{
+			name:            "active last write not applied",
+			msResp:          msResp(running, item(10, 1)),
+			activeLastWrite: item(15, 2),
+		},
+		{
+			name:            "both required",
+			msResp:          msResp(running, item(10, 1), item(20, 2)),
+			minEventID:      25,
+			activeLastWrite: item(15, 2),
+		},
+		{
+			name:       "closed workflow",
+			msResp:     msResp(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, item(10, 1)),
+			minEventID: 11,
+			caughtUp:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caughtUp, err := replicationCaughtUp(tt.msResp, tt.minEventID, tt.activeLastWrite)
+			require.NoError(t, err)
+			require.Equal(t, tt.caughtUp, caughtUp)
+		})
+	}
+}
+
+func TestGetActiveLastWrite_PinsCurrentRun(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	shardContext := historyi.NewMockShardContext(ctrl)
+	adminClient := adminservicemock.NewMockAdminServiceClient(ctrl)
+	nsEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "namespace-id", Name: "namespace"},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: "active",
+			Clusters:          []string{"active", "standby"},
+		},
+		1,
+	)
+	shardContext.EXPECT().GetRemoteAdminClient("active").Return(adminClient, nil)
+	adminClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.DescribeMutableStateRequest, _ ...any) (*adminservice.DescribeMutableStateResponse, error) {
+			// without a run ID, the active cluster describes its current run
+			require.Empty(t, request.GetExecution().GetRunId())
+			return &adminservice.DescribeMutableStateResponse{
+				DatabaseMutableState: &persistencespb.WorkflowMutableState{
+					ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+						VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+							nil,
+							[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(7, 1)},
+						)),
+					},
+					ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "active-current-run"},
+				},
+			}, nil
+		},
+	)
+
+	runID, lastWrite, err := getActiveLastWrite(
+		context.Background(),
+		nsEntry,
+		definition.NewWorkflowKey("namespace-id", "workflow-id", ""),
+		shardContext,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "active-current-run", runID)
+	require.Equal(t, int64(7), lastWrite.GetEventId())
+}
@@ -381,7 +381,7 @@ func processActivityWorkflowRules(
 		return rejectCodeUndefined, nil
 	}
 
-	ruleMatched := workflow.ActivityMatchWorkflowRules(ms, shardContext.GetTimeSource(), shardContext.GetLogger(), ai)
+	ruleMatched := workflow.ActivityMatchWorkflowRules(shardContext, ms, shardContext.GetTimeSource(), shardContext.GetLogger(), ai)
 	if !ruleMatched || !ai.Paused {
 		return rejectCodeUndefined, nil
 	}
@@ -1,22 +1,47 @@
 package circuitbreakerpool
 
 import (
+	"time"
+
 	"go.temporal.io/server/common/circuitbreaker"
 	"go.temporal.io/server/common/collection"
 )
 
 type CircuitBreakerPool[K comparable] struct {
-	m *collection.OnceMap[K, circuitbreaker.TwoStepCircuitBreaker]
+	m      *collection.OnceMap[K, circuitbreaker.TwoStepCircuitBreaker]
+	health *collection.OnceMap[K, *healthTracker]
 }
 
 func (p *CircuitBreakerPool[K]) Get(key K) circuitbreaker.TwoStepCircuitBreaker {
 	return p.m.Get(key)
 }
 
+// RecordOutcome records the latency and error of a request guarded by the circuit breaker of the given key.
+func (p *CircuitBreakerPool[K]) RecordOutcome(key K, latency time.Duration, err error) {
+	p.health.Get(key).record(time.Now(), latency, err)
+}
+
+// Health returns a snapshot of the recent request outcomes of the given key.
+func (p *CircuitBreakerPool[K]) Health(key K) HealthSnapshot {
+	tracker, ok := p.health.Peek(key)
+	if !ok {
+		return HealthSnapshot{ErrorClasses: map[string]int64{}}
+	}
+	return tracker.snapshot()
+}
+
+// Range calls fn for every circuit breaker in the pool until fn returns false.
+func (p *CircuitBreakerPool[K]) Range(fn func(key K, cb circuitbreaker.TwoStepCircuitBreaker) bool) {
+	p.m.Range(fn)
+}
+
 func NewCircuitBreakerPool[K comparable](
 	constructor func(key K) circuitbreaker.TwoStepCircuitBreaker,
 ) *CircuitBreakerPool[K] {
 	return &CircuitBreakerPool[K]{
 		m: collection.NewOnceMap(constructor),
+		health: collection.NewOnceMap(func(K) *healthTracker {
+			return &healthTracker{}
+		}),
 	}
 }
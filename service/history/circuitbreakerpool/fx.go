@@ -2,51 +2,191 @@ package circuitbreakerpool
 
 import (
 	"fmt"
+	"sync"
+	"sync/atomic"
 
+	"github.com/sony/gobreaker"
+	healthspb "go.temporal.io/server/api/health/v1"
 	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tasks"
 	"go.uber.org/fx"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var Module = fx.Options(
 	fx.Provide(OutboundQueueCircuitBreakerPoolProvider),
+	fx.Invoke(func(lc fx.Lifecycle, pool *OutboundQueueCircuitBreakerPool) {
+		lc.Append(fx.StartStopHook(pool.Start, pool.Stop))
+	}),
 )
 
 type OutboundQueueCircuitBreakerPool struct {
 	*CircuitBreakerPool[tasks.TaskGroupNamespaceIDAndDestination]
+
+	namespaceRegistry namespace.Registry
+
+	// forcedOpen holds whether an operator persisted a trip of each circuit breaker in the namespace config. It is
+	// resolved when the circuit breaker is created and refreshed on namespace changes, keeping the namespace
+	// registry off the Allow path.
+	forcedOpen sync.Map // tasks.TaskGroupNamespaceIDAndDestination -> *atomic.Bool
 }
 
 func OutboundQueueCircuitBreakerPoolProvider(
 	namespaceRegistry namespace.Registry,
 	config *configs.Config,
+	metricsHandler metrics.Handler,
 ) *OutboundQueueCircuitBreakerPool {
-	return &OutboundQueueCircuitBreakerPool{
-		CircuitBreakerPool: NewCircuitBreakerPool(
-			func(key tasks.TaskGroupNamespaceIDAndDestination) circuitbreaker.TwoStepCircuitBreaker {
-				// This is intentionally not failing the function in case of error. The circuit breaker is
-				// agnostic to Task implementation, and thus the settings function is not expected to return
-				// an error. Also, in this case, if the namespace registry fails to get the name, then the
-				// task itself will fail when it is processed and tries to get the namespace name.
-				nsName, _ := namespaceRegistry.GetNamespaceName(namespace.ID(key.NamespaceID))
-				cb := circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(circuitbreaker.Settings{
-					Name: fmt.Sprintf(
-						"circuit_breaker:%s:%s:%s",
-						key.TaskGroup,
-						key.NamespaceID,
-						key.Destination,
-					),
-				})
-				initial, cancel := config.OutboundQueueCircuitBreakerSettings(
-					nsName.String(),
+	pool := &OutboundQueueCircuitBreakerPool{namespaceRegistry: namespaceRegistry}
+	pool.CircuitBreakerPool = NewCircuitBreakerPool(
+		func(key tasks.TaskGroupNamespaceIDAndDestination) circuitbreaker.TwoStepCircuitBreaker {
+			// This is intentionally not failing the function in case of error. The circuit breaker is
+			// agnostic to Task implementation, and thus the settings function is not expected to return
+			// an error. Also, in this case, if the namespace registry fails to get the name, then the
+			// task itself will fail when it is processed and tries to get the namespace name.
+			nsName, _ := namespaceRegistry.GetNamespaceName(namespace.ID(key.NamespaceID))
+			cb := circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(circuitbreaker.Settings{
+				Name: fmt.Sprintf(
+					"circuit_breaker:%s:%s:%s",
+					key.TaskGroup,
+					key.NamespaceID,
 					key.Destination,
-					cb.UpdateSettings,
-				)
-				cb.UpdateSettings(initial)
-				_ = cancel // OnceMap never deletes anything. use this if we support deletion
-				return cb
-			},
-		),
+				),
+				OnStateChange: func(_ string, _ gobreaker.State, to gobreaker.State) {
+					metrics.CircuitBreakerStateChange.With(metricsHandler).Record(
+						1,
+						metrics.NamespaceTag(nsName.String()),
+						metrics.DestinationTag(key.Destination),
+						metrics.StringTag("circuit_breaker_state", to.String()),
+					)
+				},
+				// Trips persisted by operators keep the circuit breaker open across restarts and shard movements.
+				ForceOpen: pool.forcedOpenFlag(key, namespaceRegistry).Load,
+			})
+			initial, cancel := config.OutboundQueueCircuitBreakerSettings(
+				nsName.String(),
+				key.Destination,
+				cb.UpdateSettings,
+			)
+			cb.UpdateSettings(initial)
+			_ = cancel // OnceMap never deletes anything. use this if we support deletion
+			return cb
+		},
+	)
+	return pool
+}
+
+// Start refreshes the persisted trips of the circuit breakers on namespace changes.
+func (p *OutboundQueueCircuitBreakerPool) Start() {
+	p.namespaceRegistry.RegisterStateChangeCallback(p, func(ns *namespace.Namespace, _ bool) {
+		p.refreshForcedOpen(ns)
+	})
+}
+
+func (p *OutboundQueueCircuitBreakerPool) Stop() {
+	p.namespaceRegistry.UnregisterStateChangeCallback(p)
+}
+
+// forcedOpenFlag returns the flag holding the persisted trip of the circuit breaker of the key, initialized from the
+// namespace registry. The flag is stored before it is initialized so that a concurrent refresh is not lost.
+func (p *OutboundQueueCircuitBreakerPool) forcedOpenFlag(
+	key tasks.TaskGroupNamespaceIDAndDestination,
+	namespaceRegistry namespace.Registry,
+) *atomic.Bool {
+	value, _ := p.forcedOpen.LoadOrStore(key, &atomic.Bool{})
+	flag := value.(*atomic.Bool)
+	if ns, err := namespaceRegistry.GetNamespaceByID(namespace.ID(key.NamespaceID)); err == nil {
+		flag.Store(ns.OutboundCircuitBreakerTripped(key.TaskGroup, key.Destination))
 	}
+	return flag
+}
+
+// refreshForcedOpen updates the persisted trips of the circuit breakers of a namespace after it changed.
+func (p *OutboundQueueCircuitBreakerPool) refreshForcedOpen(ns *namespace.Namespace) {
+	p.forcedOpen.Range(func(k, value any) bool {
+		key := k.(tasks.TaskGroupNamespaceIDAndDestination)
+		if key.NamespaceID == ns.ID().String() {
+			value.(*atomic.Bool).Store(ns.OutboundCircuitBreakerTripped(key.TaskGroup, key.Destination))
+		}
+		return true
+	})
+}
+
+// DescribeHealth returns the health of every destination in the pool that matches the filter.
+// Empty filter fields match all values.
+func (p *OutboundQueueCircuitBreakerPool) DescribeHealth(
+	filter tasks.TaskGroupNamespaceIDAndDestination,
+) []*healthspb.OutboundDestinationHealth {
+	var result []*healthspb.OutboundDestinationHealth
+	p.Range(func(key tasks.TaskGroupNamespaceIDAndDestination, cb circuitbreaker.TwoStepCircuitBreaker) bool {
+		if !matchesFilter(key, filter) {
+			return true
+		}
+		counts := cb.Counts()
+		snapshot := p.Health(key)
+		health := &healthspb.OutboundDestinationHealth{
+			NamespaceId:          key.NamespaceID,
+			TaskGroup:            key.TaskGroup,
+			Destination:          key.Destination,
+			CircuitBreakerState:  cb.State().String(),
+			ManuallyTripped:      cb.Tripped(),
+			Requests:             counts.Requests,
+			TotalSuccesses:       counts.TotalSuccesses,
+			TotalFailures:        counts.TotalFailures,
+			ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
+			ConsecutiveFailures:  counts.ConsecutiveFailures,
+			SampleCount:          int64(snapshot.SampleCount),
+			RecentErrorClasses:   snapshot.ErrorClasses,
+			LatencyP50:           durationpb.New(snapshot.LatencyP50),
+			LatencyP95:           durationpb.New(snapshot.LatencyP95),
+			LatencyP99:           durationpb.New(snapshot.LatencyP99),
+			LastFailureMessage:   snapshot.LastFailureMessage,
+		}
+		if !snapshot.LastFailureTime.IsZero() {
+			health.LastFailureTime = timestamppb.New(snapshot.LastFailureTime)
+		}
+		result = append(result, health)
+		return true
+	})
+	return result
+}
+
+// UpdateCircuitBreakers manually trips or resets the circuit breakers in the pool that match the filter and
+// returns the number of circuit breakers updated. Empty filter fields match all values. If the filter fully
+// specifies a key, its circuit breaker is created if needed so that destinations can be tripped preemptively.
+// Trips made here only apply to this host until it restarts; durable trips are persisted in the namespace config
+// and picked up by every circuit breaker of the destination, including the ones created later.
+func (p *OutboundQueueCircuitBreakerPool) UpdateCircuitBreakers(
+	filter tasks.TaskGroupNamespaceIDAndDestination,
+	trip bool,
+) int {
+	update := func(cb circuitbreaker.TwoStepCircuitBreaker) {
+		if trip {
+			cb.Trip()
+		} else {
+			cb.Reset()
+		}
+	}
+	if filter.TaskGroup != "" && filter.NamespaceID != "" && filter.Destination != "" {
+		update(p.Get(filter))
+		return 1
+	}
+	updated := 0
+	p.Range(func(key tasks.TaskGroupNamespaceIDAndDestination, cb circuitbreaker.TwoStepCircuitBreaker) bool {
+		if matchesFilter(key, filter) {
+			update(cb)
+			updated++
+		}
+		return true
+	})
+	return updated
+}
+
+func matchesFilter(key, filter tasks.TaskGroupNamespaceIDAndDestination) bool {
+	return (filter.TaskGroup == "" || filter.TaskGroup == key.TaskGroup) &&
+		(filter.NamespaceID == "" || filter.NamespaceID == key.NamespaceID) &&
+		(filter.Destination == "" || filter.Destination == key.Destination)
 }
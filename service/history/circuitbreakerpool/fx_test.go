@@ -0,0 +1,64 @@
+package circuitbreakerpool
+
+import (
+	"testing"
+
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/tasks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOutboundQueueCircuitBreakerPool_ForcedOpen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := tasks.TaskGroupNamespaceIDAndDestination{
+		TaskGroup:   "nexus",
+		NamespaceID: "namespace-id",
+		Destination: "endpoint",
+	}
+	newNamespace := func(trips ...*persistencespb.OutboundCircuitBreakerTrip) *namespace.Namespace {
+		return namespace.NewLocalNamespaceForTest(
+			&persistencespb.NamespaceInfo{Id: key.NamespaceID, Name: "namespace"},
+			&persistencespb.NamespaceConfig{OutboundCircuitBreakerTrips: trips},
+			"active",
+		)
+	}
+
+	registry := namespace.NewMockRegistry(ctrl)
+	var callback namespace.StateChangeCallbackFn
+	registry.EXPECT().RegisterStateChangeCallback(gomock.Any(), gomock.Any()).Do(
+		func(_ any, cb namespace.StateChangeCallbackFn) { callback = cb },
+	)
+	registry.EXPECT().GetNamespaceName(namespace.ID(key.NamespaceID)).Return(namespace.Name("namespace"), nil)
+	// The trip is only read from the registry when the circuit breaker is created.
+	registry.EXPECT().GetNamespaceByID(namespace.ID(key.NamespaceID)).Return(newNamespace(
+		&persistencespb.OutboundCircuitBreakerTrip{Destination: key.Destination},
+	), nil).Times(1)
+
+	pool := OutboundQueueCircuitBreakerPoolProvider(
+		registry,
+		configs.NewConfig(dynamicconfig.NewNoopCollection(), 1),
+		metrics.NoopMetricsHandler,
+	)
+	pool.Start()
+	cb := pool.Get(key)
+	require.Equal(t, gobreaker.StateOpen, cb.State())
+	_, err := cb.Allow()
+	require.ErrorIs(t, err, gobreaker.ErrOpenState)
+
+	// Clearing the trip in the namespace config closes the circuit breaker.
+	callback(newNamespace(), false)
+	require.False(t, cb.Tripped())
+	done, err := cb.Allow()
+	require.NoError(t, err)
+	done(true)
+
+	// Trips of other task groups do not apply.
+	callback(newNamespace(&persistencespb.OutboundCircuitBreakerTrip{TaskGroup: "callbacks", Destination: key.Destination}), false)
+	require.False(t, cb.Tripped())
+}
@@ -0,0 +1,113 @@
+package circuitbreakerpool
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/util"
+	queueserrors "go.temporal.io/server/service/history/queues/errors"
+)
+
+const (
+	// healthSampleSize is the number of most recent request outcomes kept per destination.
+	healthSampleSize = 128
+	// maxFailureMessageLength bounds the size of the last failure message kept per destination.
+	maxFailureMessageLength = 256
+
+	// ErrorClassDestinationDown is the error class of requests that failed because the destination is down.
+	ErrorClassDestinationDown = "DestinationDown"
+)
+
+type (
+	// HealthSnapshot is a point-in-time view of the recent request outcomes of a single destination.
+	HealthSnapshot struct {
+		SampleCount        int
+		ErrorClasses       map[string]int64
+		LatencyP50         time.Duration
+		LatencyP95         time.Duration
+		LatencyP99         time.Duration
+		LastFailureTime    time.Time
+		LastFailureMessage string
+	}
+
+	outcome struct {
+		latency    time.Duration
+		errorClass string
+	}
+
+	// healthTracker keeps a bounded window of the most recent request outcomes of a destination.
+	healthTracker struct {
+		sync.Mutex
+		outcomes           [healthSampleSize]outcome
+		next               int
+		count              int
+		lastFailureTime    time.Time
+		lastFailureMessage string
+	}
+)
+
+// ErrorClass returns a short, low cardinality classification of the given error.
+// Returns an empty string for nil errors.
+func ErrorClass(err error) string {
+	if err == nil {
+		return ""
+	}
+	var destinationDownErr *queueserrors.DestinationDownError
+	if errors.As(err, &destinationDownErr) {
+		return ErrorClassDestinationDown
+	}
+	return strings.TrimPrefix(util.ErrorType(err), "*")
+}
+
+func (t *healthTracker) record(now time.Time, latency time.Duration, err error) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.outcomes[t.next] = outcome{latency: latency, errorClass: ErrorClass(err)}
+	t.next = (t.next + 1) % healthSampleSize
+	t.count = min(t.count+1, healthSampleSize)
+	if err != nil {
+		msg := err.Error()
+		if len(msg) > maxFailureMessageLength {
+			msg = msg[:maxFailureMessageLength]
+		}
+		t.lastFailureTime = now
+		t.lastFailureMessage = msg
+	}
+}
+
+func (t *healthTracker) snapshot() HealthSnapshot {
+	t.Lock()
+	defer t.Unlock()
+
+	snapshot := HealthSnapshot{
+		SampleCount:        t.count,
+		ErrorClasses:       make(map[string]int64),
+		LastFailureTime:    t.lastFailureTime,
+		LastFailureMessage: t.lastFailureMessage,
+	}
+	if t.count == 0 {
+		return snapshot
+	}
+	latencies := make([]time.Duration, 0, t.count)
+	for _, o := range t.outcomes[:t.count] {
+		latencies = append(latencies, o.latency)
+		if o.errorClass != "" {
+			snapshot.ErrorClasses[o.errorClass]++
+		}
+	}
+	slices.Sort(latencies)
+	snapshot.LatencyP50 = percentile(latencies, 0.5)
+	snapshot.LatencyP95 = percentile(latencies, 0.95)
+	snapshot.LatencyP99 = percentile(latencies, 0.99)
+	return snapshot
+}
+
+// percentile returns the nearest-rank percentile of the given sorted, non-empty slice.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	idx := int(float64(len(sorted))*p+0.5) - 1
+	return sorted[max(0, min(idx, len(sorted)-1))]
+}
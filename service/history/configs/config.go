@@ -11,11 +11,14 @@ import (
 // Config represents configuration for history service
 type Config struct {
 	NumberOfShards int32
+	// VisibilityIndexName is the index name of the primary visibility store, used to get the search attributes.
+	VisibilityIndexName string
 
 	EnableReplicationStream                       dynamicconfig.BoolPropertyFn
 	EnableCloseInboundReplicationStreamOnShutdown dynamicconfig.BoolPropertyFn
 	EnableSeparateReplicationEnableFlag           dynamicconfig.BoolPropertyFn
 	HistoryReplicationDLQV2                       dynamicconfig.BoolPropertyFn
+	NamespaceReplicationFilter                    dynamicconfig.TypedPropertyFnWithNamespaceFilter[dynamicconfig.ReplicationFilter]
 
 	RPS                                         dynamicconfig.IntPropertyFn
 	NamespaceRPS                                dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -30,6 +33,7 @@ type Config struct {
 	PersistenceGlobalNamespaceMaxQPS            dynamicconfig.IntPropertyFnWithNamespaceFilter
 	PersistencePerShardNamespaceMaxQPS          dynamicconfig.IntPropertyFnWithNamespaceFilter
 	PersistenceDynamicRateLimitingParams        dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicRateLimitingParams]
+	PersistenceConcurrencyLimitingParams        dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicConcurrencyLimitingParams]
 	EnableBestEffortDeleteTasksOnWorkflowUpdate dynamicconfig.BoolPropertyFn
 	PersistenceQPSBurstRatio                    dynamicconfig.FloatPropertyFn
 
@@ -88,6 +92,14 @@ type Config struct {
 	EnableHostLevelEventsCache       dynamicconfig.BoolPropertyFn
 	EventsHostLevelCacheMaxSizeBytes dynamicconfig.IntPropertyFn
 
+	// Host level cache budget settings
+	// HostLevelCacheBudgetEnabled should not change during runtime.
+	HostLevelCacheBudgetEnabled           bool
+	HostLevelCacheBudgetBytes             dynamicconfig.IntPropertyFn
+	HostLevelCacheBudgetMinShare          dynamicconfig.FloatPropertyFn
+	HostLevelCacheBudgetRebalanceInterval dynamicconfig.DurationPropertyFn
+	HostLevelCacheNamespaceMaxShare       dynamicconfig.FloatPropertyFn
+
 	// ShardController settings
 	RangeSizeBits                uint
 	AcquireShardInterval         dynamicconfig.DurationPropertyFn
@@ -328,7 +340,15 @@ type Config struct {
 	EnableDeleteWorkflowExecutionReplication            dynamicconfig.BoolPropertyFn
 
 	// The following are used by consistent query
-	MaxBufferedQueryCount dynamicconfig.IntPropertyFn
+	MaxBufferedQueryCount          dynamicconfig.IntPropertyFn
+	StandbyQueryConsistencyTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
+	// The following are used by query result caching for closed workflows
+	EnableQueryResultCache        dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	QueryResultCacheTTL           dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	QueryResultCacheMaxEntries    dynamicconfig.IntPropertyFnWithNamespaceFilter
+	QueryResultCacheMaxResultSize dynamicconfig.IntPropertyFnWithNamespaceFilter
+	QueryResultCacheFillRPS       dynamicconfig.IntPropertyFn
 
 	// Data integrity check related config knobs
 	MutableStateChecksumGenProbability    dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -397,6 +417,10 @@ type Config struct {
 	WorkflowExecutionMaxInFlightUpdatePayloads                    dynamicconfig.IntPropertyFnWithNamespaceFilter
 	WorkflowExecutionMaxTotalUpdates                              dynamicconfig.IntPropertyFnWithNamespaceFilter
 	WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	EnableUpdateOutcomeCompaction                                 dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	UpdateOutcomeRetention                                        dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	MaxRetainedUpdateOutcomes                                     dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxRetainedUpdateOutcomesSizeBytes                            dynamicconfig.IntPropertyFnWithNamespaceFilter
 	EnableUpdateWithStartRetryOnClosedWorkflowAbort               dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	EnableUpdateWithStartRetryableErrorOnClosedWorkflowAbort      dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
@@ -447,6 +471,7 @@ func NewConfig(
 		EnableCloseInboundReplicationStreamOnShutdown: dynamicconfig.EnableCloseInboundReplicationStreamOnShutdown.Get(dc),
 		EnableSeparateReplicationEnableFlag:           dynamicconfig.EnableSeparateReplicationEnableFlag.Get(dc),
 		HistoryReplicationDLQV2:                       dynamicconfig.EnableHistoryReplicationDLQV2.Get(dc),
+		NamespaceReplicationFilter:                    dynamicconfig.NamespaceReplicationFilter.Get(dc),
 
 		RPS:                                  dynamicconfig.HistoryRPS.Get(dc),
 		NamespaceRPS:                         dynamicconfig.HistoryNamespaceRPS.Get(dc),
@@ -461,6 +486,7 @@ func NewConfig(
 		PersistenceGlobalNamespaceMaxQPS:     dynamicconfig.HistoryPersistenceGlobalNamespaceMaxQPS.Get(dc),
 		PersistencePerShardNamespaceMaxQPS:   dynamicconfig.HistoryPersistencePerShardNamespaceMaxQPS.Get(dc),
 		PersistenceDynamicRateLimitingParams: dynamicconfig.HistoryPersistenceDynamicRateLimitingParams.Get(dc),
+		PersistenceConcurrencyLimitingParams: dynamicconfig.HistoryPersistenceConcurrencyLimitingParams.Get(dc),
 		PersistenceQPSBurstRatio:             dynamicconfig.PersistenceQPSBurstRatio.Get(dc),
 		AlignMembershipChange:                dynamicconfig.HistoryAlignMembershipChange.Get(dc),
 		ShutdownDrainDuration:                dynamicconfig.HistoryShutdownDrainDuration.Get(dc),
@@ -514,6 +540,12 @@ func NewConfig(
 		EventsCacheTTL:                    dynamicconfig.EventsCacheTTL.Get(dc),
 		EnableHostLevelEventsCache:        dynamicconfig.EnableHostLevelEventsCache.Get(dc),
 
+		HostLevelCacheBudgetEnabled:           dynamicconfig.HostLevelCacheBudgetEnabled.Get(dc)(),
+		HostLevelCacheBudgetBytes:             dynamicconfig.HostLevelCacheBudgetBytes.Get(dc),
+		HostLevelCacheBudgetMinShare:          dynamicconfig.HostLevelCacheBudgetMinShare.Get(dc),
+		HostLevelCacheBudgetRebalanceInterval: dynamicconfig.HostLevelCacheBudgetRebalanceInterval.Get(dc),
+		HostLevelCacheNamespaceMaxShare:       dynamicconfig.HostLevelCacheNamespaceMaxShare.Get(dc),
+
 		RangeSizeBits: 20, // 20 bits for sequencer, 2^20 sequence number for any range
 
 		AcquireShardInterval:         dynamicconfig.AcquireShardInterval.Get(dc),
@@ -720,6 +752,12 @@ func NewConfig(
 		ReplicationExecutableTaskErrorRetryExpiration:         dynamicconfig.ReplicationExecutableTaskErrorRetryExpiration.Get(dc),
 
 		MaxBufferedQueryCount:                 dynamicconfig.MaxBufferedQueryCount.Get(dc),
+		StandbyQueryConsistencyTimeout:        dynamicconfig.StandbyQueryConsistencyTimeout.Get(dc),
+		EnableQueryResultCache:                dynamicconfig.EnableQueryResultCache.Get(dc),
+		QueryResultCacheTTL:                   dynamicconfig.QueryResultCacheTTL.Get(dc),
+		QueryResultCacheMaxEntries:            dynamicconfig.QueryResultCacheMaxEntries.Get(dc),
+		QueryResultCacheMaxResultSize:         dynamicconfig.QueryResultCacheMaxResultSize.Get(dc),
+		QueryResultCacheFillRPS:               dynamicconfig.QueryResultCacheFillRPS.Get(dc),
 		MutableStateChecksumGenProbability:    dynamicconfig.MutableStateChecksumGenProbability.Get(dc),
 		MutableStateChecksumVerifyProbability: dynamicconfig.MutableStateChecksumVerifyProbability.Get(dc),
 		MutableStateChecksumInvalidateBefore:  dynamicconfig.MutableStateChecksumInvalidateBefore.Get(dc),
@@ -787,6 +825,10 @@ func NewConfig(
 		WorkflowExecutionMaxInFlightUpdatePayloads:                    dynamicconfig.WorkflowExecutionMaxInFlightUpdatePayloads.Get(dc),
 		WorkflowExecutionMaxTotalUpdates:                              dynamicconfig.WorkflowExecutionMaxTotalUpdates.Get(dc),
 		WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold: dynamicconfig.WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold.Get(dc),
+		EnableUpdateOutcomeCompaction:                                 dynamicconfig.EnableUpdateOutcomeCompaction.Get(dc),
+		UpdateOutcomeRetention:                                        dynamicconfig.UpdateOutcomeRetention.Get(dc),
+		MaxRetainedUpdateOutcomes:                                     dynamicconfig.MaxRetainedUpdateOutcomes.Get(dc),
+		MaxRetainedUpdateOutcomesSizeBytes:                            dynamicconfig.MaxRetainedUpdateOutcomesSizeBytes.Get(dc),
 		EnableUpdateWithStartRetryOnClosedWorkflowAbort:               dynamicconfig.EnableUpdateWithStartRetryOnClosedWorkflowAbort.Get(dc),
 		EnableUpdateWithStartRetryableErrorOnClosedWorkflowAbort:      dynamicconfig.EnableUpdateWithStartRetryableErrorOnClosedWorkflowAbort.Get(dc),
 
@@ -823,6 +865,11 @@ func NewConfig(
 		RoutingInfoCacheMaxSize:              dynamicconfig.RoutingInfoCacheMaxSize.Get(dc),
 	}
 
+	// The host level cache budget is in bytes, so the mutable state cache must be limited by size.
+	if cfg.HostLevelCacheBudgetEnabled {
+		cfg.HistoryCacheLimitSizeBased = true
+	}
+
 	return cfg
 }
 
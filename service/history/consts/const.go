@@ -85,6 +85,8 @@ var (
 	ErrMutableStateSizeExceedsLimit = serviceerror.NewInvalidArgument(common.FailureReasonMutableStateSizeExceedsLimit)
 	// ErrUnknownCluster is error indicating unknown cluster
 	ErrUnknownCluster = serviceerror.NewInvalidArgument("unknown cluster")
+	// ErrStandbyQueryReplicationTimeout is error indicating replication didn't catch up with the consistency requested by a query on a standby cluster in time
+	ErrStandbyQueryReplicationTimeout = serviceerror.NewUnavailable("timed out waiting for replication to catch up before dispatching query, please retry")
 	// ErrBufferedQueryCleared is error indicating mutable state is cleared while buffered query is pending
 	ErrBufferedQueryCleared = serviceerror.NewUnavailable("buffered query cleared, please retry")
 	// ErrChildExecutionNotFound is error indicating pending child execution can't be found in workflow mutable state current branch
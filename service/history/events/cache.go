@@ -60,7 +60,17 @@ func NewHostLevelEventsCache(
 	logger log.Logger,
 	disabled bool,
 ) Cache {
-	return newEventsCache(executionManager, handler, logger, config.EventsHostLevelCacheMaxSizeBytes(), config.EventsCacheTTL(), disabled)
+	if config.HostLevelCacheBudgetEnabled {
+		// The max size is adjusted by the host level cache budget once the cache is registered with it.
+		return newEventsCache(executionManager, handler, logger, config.HostLevelCacheBudgetBytes(), config.EventsCacheTTL(), disabled, &cache.Options{
+			NamespaceKey: func(key any) string {
+				//revive:disable-next-line:unchecked-type-assertion
+				return key.(EventKey).NamespaceID.String()
+			},
+			NamespaceMaxShare: config.HostLevelCacheNamespaceMaxShare,
+		})
+	}
+	return newEventsCache(executionManager, handler, logger, config.EventsHostLevelCacheMaxSizeBytes(), config.EventsCacheTTL(), disabled, nil)
 }
 
 func NewShardLevelEventsCache(
@@ -70,7 +80,7 @@ func NewShardLevelEventsCache(
 	logger log.Logger,
 	disabled bool,
 ) Cache {
-	return newEventsCache(executionManager, handler, logger, config.EventsShardLevelCacheMaxSizeBytes(), config.EventsCacheTTL(), disabled)
+	return newEventsCache(executionManager, handler, logger, config.EventsShardLevelCacheMaxSizeBytes(), config.EventsCacheTTL(), disabled, nil)
 }
 
 func newEventsCache(
@@ -80,8 +90,11 @@ func newEventsCache(
 	maxSize int,
 	ttl time.Duration,
 	disabled bool,
+	opts *cache.Options,
 ) *CacheImpl {
-	opts := &cache.Options{}
+	if opts == nil {
+		opts = &cache.Options{}
+	}
 	opts.TTL = ttl
 
 	taggedMetricHandler := metricsHandler.WithTags(metrics.CacheTypeTag(metrics.EventsCacheTypeTagValue))
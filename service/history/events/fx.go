@@ -1,6 +1,7 @@
 package events
 
 import (
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
@@ -12,4 +13,10 @@ var Module = fx.Options(
 	fx.Provide(func(executionManager persistence.ExecutionManager, config *configs.Config, handler metrics.Handler, logger log.Logger) Cache {
 		return NewHostLevelEventsCache(executionManager, config, handler, logger, false)
 	}),
+	fx.Invoke(func(eventsCache Cache, config *configs.Config, budget *cache.Budget) error {
+		if ci, ok := eventsCache.(*CacheImpl); ok && config.HostLevelCacheBudgetEnabled {
+			return budget.Register(metrics.EventsCacheTypeTagValue, ci.Cache)
+		}
+		return nil
+	}),
 )
@@ -64,6 +64,7 @@ var Module = fx.Options(
 	workflow.Module,
 
 	shard.Module,
+	fx.Provide(HostLevelCacheBudgetProvider),
 	events.Module,
 	cache.Module,
 	archival.Module,
@@ -113,6 +114,26 @@ var Module = fx.Options(
 	chasmworkflow.HistoryHandlerModule,
 )
 
+// HostLevelCacheBudgetProvider provides the budget shared by the host level mutable state cache and events cache. The
+// caches register themselves with it when HostLevelCacheBudgetEnabled is true.
+func HostLevelCacheBudgetProvider(
+	lc fx.Lifecycle,
+	config *configs.Config,
+	metricsHandler metrics.Handler,
+) *commoncache.Budget {
+	budget := commoncache.NewBudget(
+		config.HostLevelCacheBudgetBytes,
+		config.HostLevelCacheBudgetMinShare,
+		config.HostLevelCacheBudgetRebalanceInterval,
+		nil,
+		metricsHandler,
+	)
+	if config.HostLevelCacheBudgetEnabled {
+		lc.Append(fx.StartStopHook(budget.Start, budget.Stop))
+	}
+	return budget
+}
+
 func ServerProvider(grpcServerOptions []grpc.ServerOption) *grpc.Server {
 	return grpc.NewServer(grpcServerOptions...)
 }
@@ -168,6 +189,11 @@ func HandlerProvider(args NewHandlerArgs) (*Handler, error) {
 		dlqMetricsEmitter:            args.DLQMetricsEmitter,
 		chasmEngine:                  args.ChasmEngine,
 		chasmRegistry:                args.ChasmRegistry,
+		outboundQueueCBPool:          args.OutboundQueueCBPool,
+		concurrencyLimiter:           args.ConcurrencyLimiter,
+		faultRegistry:                args.FaultRegistry,
+		callbackDLQManager:           args.CallbackDLQManager,
+		callbackHTTPCallerProvider:   args.CallbackHTTPCallerProvider,
 
 		replicationTaskFetcherFactory:    args.ReplicationTaskFetcherFactory,
 		replicationTaskConverterProvider: args.ReplicationTaskConverterFactory,
@@ -205,10 +231,13 @@ func ConfigProvider(
 	dc *dynamicconfig.Collection,
 	persistenceConfig config.Persistence,
 ) *configs.Config {
-	return configs.NewConfig(
+	cfg := configs.NewConfig(
 		dc,
 		persistenceConfig.NumHistoryShards,
 	)
+	visibilityStoreConfig := persistenceConfig.GetVisibilityStoreConfig()
+	cfg.VisibilityIndexName = visibilityStoreConfig.GetIndexName()
+	return cfg
 }
 
 func ServiceErrorInterceptorProvider(
@@ -482,6 +511,7 @@ func PersistenceRateLimitingParamsProvider(
 		OperatorRPSRatio:                   persistenceClient.OperatorRPSRatio(serviceConfig.OperatorRPSRatio),
 		PersistenceBurstRatio:              persistenceClient.PersistenceBurstRatio(serviceConfig.PersistenceQPSBurstRatio),
 		DynamicRateLimitingParams:          persistenceClient.DynamicRateLimitingParams(serviceConfig.PersistenceDynamicRateLimitingParams),
+		ConcurrencyLimitingParams:          persistenceClient.ConcurrencyLimitingParams(serviceConfig.PersistenceConcurrencyLimitingParams),
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	nexuspb "go.temporal.io/api/nexus/v1"
 	"go.temporal.io/api/serviceerror"
+	healthspb "go.temporal.io/server/api/health/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	namespacespb "go.temporal.io/server/api/namespace/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -26,9 +27,11 @@ import (
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/chasm/lib/activity"
+	"go.temporal.io/server/chasm/lib/callback"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/chaos"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/contextutil"
@@ -44,8 +47,10 @@ import (
 	"go.temporal.io/server/common/nexus/nexusrpc"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/rpc/interceptor"
 	sdkconverter "go.temporal.io/server/common/sdk"
@@ -54,11 +59,13 @@ import (
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/api/deadletteredcallbacks"
 	"go.temporal.io/server/service/history/api/deletedlqtasks"
 	"go.temporal.io/server/service/history/api/deleteexecution"
 	"go.temporal.io/server/service/history/api/forcedeleteworkflowexecution"
 	"go.temporal.io/server/service/history/api/getdlqtasks"
 	"go.temporal.io/server/service/history/api/listqueues"
+	"go.temporal.io/server/service/history/circuitbreakerpool"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
@@ -104,6 +111,11 @@ type (
 		chasmEngine                  chasm.Engine
 		chasmRegistry                *chasm.Registry
 		nexusHandler                 nexus.Handler
+		outboundQueueCBPool          *circuitbreakerpool.OutboundQueueCircuitBreakerPool
+		concurrencyLimiter           *persistenceClient.HealthConcurrencyLimiterImpl
+		faultRegistry                *chaos.Registry
+		callbackDLQManager           persistence.CallbackDLQManager
+		callbackHTTPCallerProvider   callback.HTTPCallerProvider
 
 		replicationTaskFetcherFactory    replication.TaskFetcherFactory
 		replicationTaskConverterProvider replication.SourceTaskConverterProvider
@@ -140,6 +152,11 @@ type (
 		DLQMetricsEmitter            *persistence.DLQMetricsEmitter
 		ChasmEngine                  chasm.Engine
 		ChasmRegistry                *chasm.Registry
+		OutboundQueueCBPool          *circuitbreakerpool.OutboundQueueCircuitBreakerPool
+		ConcurrencyLimiter           *persistenceClient.HealthConcurrencyLimiterImpl
+		FaultRegistry                *chaos.Registry
+		CallbackDLQManager           persistence.CallbackDLQManager
+		CallbackHTTPCallerProvider   callback.HTTPCallerProvider
 
 		ReplicationTaskFetcherFactory   replication.TaskFetcherFactory
 		ReplicationTaskConverterFactory replication.SourceTaskConverterProvider
@@ -158,6 +175,7 @@ var (
 	errNamespaceNotSet         = serviceerror.NewInvalidArgument("Namespace not set on request.")
 	errWorkflowExecutionNotSet = serviceerror.NewInvalidArgument("WorkflowExecution not set on request.")
 	errTaskQueueNotSet         = serviceerror.NewInvalidArgument("Task queue not set.")
+	errDestinationNotSet       = serviceerror.NewInvalidArgument("Destination not set on request.")
 	errWorkflowIDNotSet        = serviceerror.NewInvalidArgument("WorkflowId is not set on request.")
 	errBusinessIDNotSet        = serviceerror.NewInvalidArgument("Business ID is not set on request.")
 	errRunIDNotValid           = serviceerror.NewInvalidArgument("RunId is not valid UUID.")
@@ -724,6 +742,118 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, req *historyservice.Des
 	return resp, nil
 }
 
+// DescribeOutboundDestinationHealth returns the outbound queue circuit breaker state and recent request outcomes
+// of the destinations known to this host
+func (h *Handler) DescribeOutboundDestinationHealth(
+	_ context.Context,
+	request *historyservice.DescribeOutboundDestinationHealthRequest,
+) (*historyservice.DescribeOutboundDestinationHealthResponse, error) {
+	destinations := h.outboundQueueCBPool.DescribeHealth(tasks.TaskGroupNamespaceIDAndDestination{
+		TaskGroup:   request.GetTaskGroup(),
+		NamespaceID: request.GetNamespaceId(),
+		Destination: request.GetDestination(),
+	})
+	address := h.hostInfoProvider.HostInfo().GetAddress()
+	for _, destination := range destinations {
+		destination.HostAddress = address
+	}
+	return &historyservice.DescribeOutboundDestinationHealthResponse{
+		Destinations: destinations,
+	}, nil
+}
+
+// DescribeWorkflowRuleHits returns the number of times a workflow rule matched on the shards owned by this host
+func (h *Handler) DescribeWorkflowRuleHits(
+	_ context.Context,
+	request *historyservice.DescribeWorkflowRuleHitsRequest,
+) (*historyservice.DescribeWorkflowRuleHitsResponse, error) {
+	if request.GetNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+	var hitCount int64
+	for _, shardID := range h.controller.ShardIDs() {
+		shardContext, err := h.controller.GetShardByID(shardID)
+		if err != nil {
+			// The shard moved to another host, which reports its hits.
+			continue
+		}
+		hitCount += shardContext.GetWorkflowRuleHits(
+			namespace.ID(request.GetNamespaceId()),
+			request.GetRuleId(),
+			request.GetRuleCreateTime().AsTime(),
+		)
+	}
+	return &historyservice.DescribeWorkflowRuleHitsResponse{
+		HitCount: hitCount,
+	}, nil
+}
+
+// UpdateOutboundCircuitBreaker manually trips or resets the outbound queue circuit breakers of this host
+func (h *Handler) UpdateOutboundCircuitBreaker(
+	_ context.Context,
+	request *historyservice.UpdateOutboundCircuitBreakerRequest,
+) (*historyservice.UpdateOutboundCircuitBreakerResponse, error) {
+	if request.GetNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+	if request.GetDestination() == "" {
+		return nil, h.convertError(errDestinationNotSet)
+	}
+	updated := h.outboundQueueCBPool.UpdateCircuitBreakers(
+		tasks.TaskGroupNamespaceIDAndDestination{
+			TaskGroup:   request.GetTaskGroup(),
+			NamespaceID: request.GetNamespaceId(),
+			Destination: request.GetDestination(),
+		},
+		request.GetTrip(),
+	)
+	h.logger.Info("Updated outbound queue circuit breakers",
+		tag.WorkflowNamespaceID(request.GetNamespaceId()),
+		tag.NewStringTag("destination", request.GetDestination()),
+		tag.NewStringTag("task-group", request.GetTaskGroup()),
+		tag.NewBoolTag("trip", request.GetTrip()),
+		tag.Counter(updated),
+	)
+	return &historyservice.UpdateOutboundCircuitBreakerResponse{
+		UpdatedCount: int32(updated),
+	}, nil
+}
+
+// DescribePersistenceConcurrencyLimiter returns the state of the adaptive persistence concurrency limiter of this host
+func (h *Handler) DescribePersistenceConcurrencyLimiter(
+	_ context.Context,
+	_ *historyservice.DescribePersistenceConcurrencyLimiterRequest,
+) (*historyservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	state := &healthspb.PersistenceConcurrencyLimiterState{}
+	if h.concurrencyLimiter != nil {
+		state = h.concurrencyLimiter.Describe()
+	}
+	state.Service = string(primitives.HistoryService)
+	state.HostAddress = h.hostInfoProvider.HostInfo().GetAddress()
+	return &historyservice.DescribePersistenceConcurrencyLimiterResponse{
+		State: state,
+	}, nil
+}
+
+// UpdateFaultInjection updates the runtime faults of this host and returns them
+func (h *Handler) UpdateFaultInjection(
+	_ context.Context,
+	request *historyservice.UpdateFaultInjectionRequest,
+) (*historyservice.UpdateFaultInjectionResponse, error) {
+	if err := h.faultRegistry.Update(
+		request.GetUpsertFaults(),
+		request.GetDeleteFaultIds(),
+		request.GetDeleteAllFaults(),
+	); err != nil {
+		return nil, h.convertError(err)
+	}
+	host := h.faultRegistry.Describe()
+	host.HostAddress = h.hostInfoProvider.HostInfo().GetAddress()
+	return &historyservice.UpdateFaultInjectionResponse{
+		Host: host,
+	}, nil
+}
+
 // RemoveTask returns information about the internal states of a history host
 func (h *Handler) RemoveTask(ctx context.Context, request *historyservice.RemoveTaskRequest) (_ *historyservice.RemoveTaskResponse, retError error) {
 	var err error
@@ -1775,6 +1905,25 @@ func (h *Handler) GetReplicationStatus(
 	return resp, nil
 }
 
+// DescribeReplicationLag returns how far each remote cluster is behind the shards owned by this host
+func (h *Handler) DescribeReplicationLag(
+	ctx context.Context,
+	_ *historyservice.DescribeReplicationLagRequest,
+) (*historyservice.DescribeReplicationLagResponse, error) {
+	if err := api.ValidateReplicationConfig(h.clusterMetadata); err != nil {
+		return nil, err
+	}
+
+	shards := h.streamReceiverMonitor.DescribeReplicationLag(ctx)
+	hostAddress := h.hostInfoProvider.HostInfo().GetAddress()
+	for _, shard := range shards {
+		shard.HostAddress = hostAddress
+	}
+	return &historyservice.DescribeReplicationLagResponse{
+		Shards: shards,
+	}, nil
+}
+
 func (h *Handler) DeleteWorkflowVisibilityRecord(
 	ctx context.Context,
 	request *historyservice.DeleteWorkflowVisibilityRecordRequest,
@@ -2066,6 +2215,36 @@ func (h *Handler) AddTasks(
 	return engine.AddTasks(ctx, request)
 }
 
+func (h *Handler) ListDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.ListDeadLetteredCallbacksRequest,
+) (*historyservice.ListDeadLetteredCallbacksResponse, error) {
+	if request.GetNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+	return deadletteredcallbacks.List(ctx, h.callbackDLQManager, request)
+}
+
+func (h *Handler) ReplayDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.ReplayDeadLetteredCallbacksRequest,
+) (*historyservice.ReplayDeadLetteredCallbacksResponse, error) {
+	if request.GetNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+	return deadletteredcallbacks.Replay(ctx, h.callbackDLQManager, h.callbackHTTPCallerProvider, request)
+}
+
+func (h *Handler) PurgeDeadLetteredCallbacks(
+	ctx context.Context,
+	request *historyservice.PurgeDeadLetteredCallbacksRequest,
+) (*historyservice.PurgeDeadLetteredCallbacksResponse, error) {
+	if request.GetNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+	return deadletteredcallbacks.Purge(ctx, h.callbackDLQManager, request)
+}
+
 func (h *Handler) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -148,6 +148,7 @@ type (
 		syncStateRetriever         replication.SyncStateRetriever
 		outboundQueueCBPool        *circuitbreakerpool.OutboundQueueCircuitBreakerPool
 		testHooks                  testhooks.TestHooks
+		queryCacheFillLimiter      quotas.RateLimiter
 	}
 )
 
@@ -236,6 +237,9 @@ func NewEngineWithShardContext(
 		versionCache:               versionCache,
 		workerDeploymentClient:     workerDeploymentClient,
 		routingInfoCache:           routingInfoCache,
+		queryCacheFillLimiter: quotas.NewDefaultOutgoingRateLimiter(
+			func() float64 { return float64(config.QueryResultCacheFillRPS()) },
+		),
 	}
 
 	historyEngImpl.queueProcessors = make(map[tasks.Category]queues.Queue)
@@ -496,7 +500,7 @@ func (e *historyEngineImpl) QueryWorkflow(
 	ctx context.Context,
 	request *historyservice.QueryWorkflowRequest,
 ) (_ *historyservice.QueryWorkflowResponse, retErr error) {
-	return queryworkflow.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker, e.rawMatchingClient, e.matchingClient)
+	return queryworkflow.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker, e.rawMatchingClient, e.matchingClient, e.eventNotifier, e.queryCacheFillLimiter)
 }
 
 func (e *historyEngineImpl) DescribeMutableState(
@@ -199,6 +199,8 @@ type (
 		GetWorkflowType() *commonpb.WorkflowType
 		GetWorkflowStateStatus() (enumsspb.WorkflowExecutionState, enumspb.WorkflowExecutionStatus)
 		GetQueryRegistry() QueryRegistry
+		GetCachedQueryResult(key string) *commonpb.Payloads
+		CacheQueryResult(key string, result *commonpb.Payloads, ttl time.Duration, maxEntries int)
 		GetBaseWorkflowInfo() *workflowspb.BaseExecutionInfo
 		GetAssignedBuildId() string
 		GetInheritedBuildId() string
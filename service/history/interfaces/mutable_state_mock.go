@@ -1708,6 +1708,18 @@ func (mr *MockMutableStateMockRecorder) AttachRequestID(requestID, eventType, ev
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachRequestID", reflect.TypeOf((*MockMutableState)(nil).AttachRequestID), requestID, eventType, eventID)
 }
 
+// CacheQueryResult mocks base method.
+func (m *MockMutableState) CacheQueryResult(key string, result *common.Payloads, ttl time.Duration, maxEntries int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CacheQueryResult", key, result, ttl, maxEntries)
+}
+
+// CacheQueryResult indicates an expected call of CacheQueryResult.
+func (mr *MockMutableStateMockRecorder) CacheQueryResult(key, result, ttl, maxEntries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheQueryResult", reflect.TypeOf((*MockMutableState)(nil).CacheQueryResult), key, result, ttl, maxEntries)
+}
+
 // ChasmEnabled mocks base method.
 func (m *MockMutableState) ChasmEnabled() bool {
 	m.ctrl.T.Helper()
@@ -2149,6 +2161,20 @@ func (mr *MockMutableStateMockRecorder) GetBaseWorkflowInfo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseWorkflowInfo", reflect.TypeOf((*MockMutableState)(nil).GetBaseWorkflowInfo))
 }
 
+// GetCachedQueryResult mocks base method.
+func (m *MockMutableState) GetCachedQueryResult(key string) *common.Payloads {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCachedQueryResult", key)
+	ret0, _ := ret[0].(*common.Payloads)
+	return ret0
+}
+
+// GetCachedQueryResult indicates an expected call of GetCachedQueryResult.
+func (mr *MockMutableStateMockRecorder) GetCachedQueryResult(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCachedQueryResult", reflect.TypeOf((*MockMutableState)(nil).GetCachedQueryResult), key)
+}
+
 // GetChildExecutionInfo mocks base method.
 func (m *MockMutableState) GetChildExecutionInfo(arg0 int64) (*persistence.ChildExecutionInfo, bool) {
 	m.ctrl.T.Helper()
@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	common "go.temporal.io/api/common/v1"
+	rules "go.temporal.io/api/rules/v1"
 	adminservice "go.temporal.io/server/api/adminservice/v1"
 	clock "go.temporal.io/server/api/clock/v1"
 	historyservice "go.temporal.io/server/api/historyservice/v1"
@@ -683,6 +684,20 @@ func (mr *MockShardContextMockRecorder) GetWorkflowExecution(ctx, request any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecution", reflect.TypeOf((*MockShardContext)(nil).GetWorkflowExecution), ctx, request)
 }
 
+// GetWorkflowRuleHits mocks base method.
+func (m *MockShardContext) GetWorkflowRuleHits(namespaceID namespace.ID, ruleID string, ruleCreateTime time.Time) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowRuleHits", namespaceID, ruleID, ruleCreateTime)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetWorkflowRuleHits indicates an expected call of GetWorkflowRuleHits.
+func (mr *MockShardContextMockRecorder) GetWorkflowRuleHits(namespaceID, ruleID, ruleCreateTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowRuleHits", reflect.TypeOf((*MockShardContext)(nil).GetWorkflowRuleHits), namespaceID, ruleID, ruleCreateTime)
+}
+
 // NewVectorClock mocks base method.
 func (m *MockShardContext) NewVectorClock() (*clock.VectorClock, error) {
 	m.ctrl.T.Helper()
@@ -698,6 +713,18 @@ func (mr *MockShardContextMockRecorder) NewVectorClock() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewVectorClock", reflect.TypeOf((*MockShardContext)(nil).NewVectorClock))
 }
 
+// RecordWorkflowRuleHit mocks base method.
+func (m *MockShardContext) RecordWorkflowRuleHit(namespaceID namespace.ID, rule *rules.WorkflowRule) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordWorkflowRuleHit", namespaceID, rule)
+}
+
+// RecordWorkflowRuleHit indicates an expected call of RecordWorkflowRuleHit.
+func (mr *MockShardContextMockRecorder) RecordWorkflowRuleHit(namespaceID, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowRuleHit", reflect.TypeOf((*MockShardContext)(nil).RecordWorkflowRuleHit), namespaceID, rule)
+}
+
 // SetCurrentTime mocks base method.
 func (m *MockShardContext) SetCurrentTime(arg0 string, currentTime time.Time) {
 	m.ctrl.T.Helper()
@@ -1514,6 +1541,20 @@ func (mr *MockControllableContextMockRecorder) GetWorkflowExecution(ctx, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecution", reflect.TypeOf((*MockControllableContext)(nil).GetWorkflowExecution), ctx, request)
 }
 
+// GetWorkflowRuleHits mocks base method.
+func (m *MockControllableContext) GetWorkflowRuleHits(namespaceID namespace.ID, ruleID string, ruleCreateTime time.Time) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowRuleHits", namespaceID, ruleID, ruleCreateTime)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetWorkflowRuleHits indicates an expected call of GetWorkflowRuleHits.
+func (mr *MockControllableContextMockRecorder) GetWorkflowRuleHits(namespaceID, ruleID, ruleCreateTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowRuleHits", reflect.TypeOf((*MockControllableContext)(nil).GetWorkflowRuleHits), namespaceID, ruleID, ruleCreateTime)
+}
+
 // IsValid mocks base method.
 func (m *MockControllableContext) IsValid() bool {
 	m.ctrl.T.Helper()
@@ -1543,6 +1584,18 @@ func (mr *MockControllableContextMockRecorder) NewVectorClock() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewVectorClock", reflect.TypeOf((*MockControllableContext)(nil).NewVectorClock))
 }
 
+// RecordWorkflowRuleHit mocks base method.
+func (m *MockControllableContext) RecordWorkflowRuleHit(namespaceID namespace.ID, rule *rules.WorkflowRule) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordWorkflowRuleHit", namespaceID, rule)
+}
+
+// RecordWorkflowRuleHit indicates an expected call of RecordWorkflowRuleHit.
+func (mr *MockControllableContextMockRecorder) RecordWorkflowRuleHit(namespaceID, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowRuleHit", reflect.TypeOf((*MockControllableContext)(nil).RecordWorkflowRuleHit), namespaceID, rule)
+}
+
 // SetCurrentTime mocks base method.
 func (m *MockControllableContext) SetCurrentTime(arg0 string, currentTime time.Time) {
 	m.ctrl.T.Helper()
@@ -229,6 +229,8 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	defer func() { resetWorkflow.GetReleaseFn()(retError) }()
 
 	resetMS := resetWorkflow.GetMutableState()
+	// The reset run continues the base run, so it must not be replicated if the base run is local-only.
+	resetMS.GetExecutionInfo().LocalOnly = baseWorkflow.GetMutableState().GetExecutionInfo().GetLocalOnly()
 	if err := reapplyEventsFn(ctx, resetMS); err != nil {
 		return err
 	}
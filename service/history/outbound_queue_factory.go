@@ -2,6 +2,7 @@ package history
 
 import (
 	"fmt"
+	"time"
 
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/client"
@@ -143,6 +144,9 @@ func NewOutboundQueueFactory(params outboundQueueFactoryParams) QueueFactory {
 								Task: queues.NewCircuitBreakerExecutable(
 									e,
 									params.CircuitBreakerPool.Get(key),
+									func(latency time.Duration, err error) {
+										params.CircuitBreakerPool.RecordOutcome(key, latency, err)
+									},
 									taggedMetricsHandler,
 								),
 							},
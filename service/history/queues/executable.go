@@ -885,9 +885,12 @@ func getArchetypeTag(task tasks.Task, chasmRegistry *chasm.Registry) metrics.Tag
 // CircuitBreakerExecutable wraps Executable with a circuit breaker.
 // If the executable returns DestinationDownError, it will signal the circuit breaker
 // of failure, and return the inner error.
+// The latency and error of every execution let through by the circuit breaker are reported
+// to recordOutcome, if set.
 type CircuitBreakerExecutable struct {
 	Executable
-	cb circuitbreaker.TwoStepCircuitBreaker
+	cb            circuitbreaker.TwoStepCircuitBreaker
+	recordOutcome func(latency time.Duration, err error)
 
 	metricsHandler metrics.Handler
 }
@@ -895,11 +898,13 @@ type CircuitBreakerExecutable struct {
 func NewCircuitBreakerExecutable(
 	e Executable,
 	cb circuitbreaker.TwoStepCircuitBreaker,
+	recordOutcome func(latency time.Duration, err error),
 	metricsHandler metrics.Handler,
 ) *CircuitBreakerExecutable {
 	return &CircuitBreakerExecutable{
-		Executable: e,
-		cb:         cb,
+		Executable:    e,
+		cb:            cb,
+		recordOutcome: recordOutcome,
 
 		metricsHandler: metricsHandler,
 	}
@@ -931,7 +936,11 @@ func (e *CircuitBreakerExecutable) Execute() error {
 		}
 	}()
 
+	startTime := time.Now()
 	err = e.Executable.Execute()
+	if e.recordOutcome != nil {
+		e.recordOutcome(time.Since(startTime), err)
+	}
 	var destinationDownErr *queueserrors.DestinationDownError
 	if errors.As(err, &destinationDownErr) {
 		err = destinationDownErr.Unwrap()
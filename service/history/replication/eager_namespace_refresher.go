@@ -97,14 +97,15 @@ func (e *eagerNamespaceRefresherImpl) SyncNamespaceFromSourceCluster(
 		return nil, err
 	}
 	task := &replicationspb.NamespaceTaskAttributes{
-		NamespaceOperation: operation,
-		Id:                 resp.GetInfo().Id,
-		Info:               resp.GetInfo(),
-		Config:             resp.GetConfig(),
-		ReplicationConfig:  resp.GetReplicationConfig(),
-		ConfigVersion:      resp.GetConfigVersion(),
-		FailoverVersion:    resp.GetFailoverVersion(),
-		FailoverHistory:    resp.GetFailoverHistory(),
+		NamespaceOperation:              operation,
+		Id:                              resp.GetInfo().Id,
+		Info:                            resp.GetInfo(),
+		Config:                          resp.GetConfig(),
+		ReplicationConfig:               resp.GetReplicationConfig(),
+		ConfigVersion:                   resp.GetConfigVersion(),
+		FailoverVersion:                 resp.GetFailoverVersion(),
+		FailoverHistory:                 resp.GetFailoverHistory(),
+		SearchAttributeAliasTransitions: resp.GetSearchAttributeAliasTransitions(),
 	}
 	err = e.replicationTaskExecutor.Execute(ctx, task)
 	if err != nil {
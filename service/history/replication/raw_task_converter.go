@@ -421,6 +421,10 @@ func generateStateReplicationTask(
 	ms, err := wfContext.LoadMutableState(ctx, shardContext)
 	switch err.(type) {
 	case nil:
+		if ms.GetExecutionInfo().GetLocalOnly() {
+			// Excluded by the namespace replication filter, e.g. a task generated by force replication.
+			return nil, nil
+		}
 		return action(ms, release) // do not access mutable state after this point
 	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
 		return nil, nil
@@ -564,6 +568,10 @@ func getBranchToken(
 	ms, err := wfContext.LoadMutableState(ctx, shardContext)
 	switch err.(type) {
 	case nil:
+		if ms.GetExecutionInfo().GetLocalOnly() {
+			// Excluded by the namespace replication filter.
+			return nil, nil, nil, nil
+		}
 		return persistence.GetXDCCacheValue(ms.GetExecutionInfo(), eventID, eventVersion)
 	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
 		return nil, nil, nil, nil
@@ -218,6 +218,7 @@ func (s *rawTaskConverterSuite) TestConvertActivityStateReplicationTask_Workflow
 		locks.PriorityLow,
 	).Return(s.workflowContext, s.releaseFn, nil)
 	s.workflowContext.EXPECT().LoadMutableState(gomock.Any(), s.shardContext).Return(s.mutableState, nil)
+	s.mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{}).Times(1)
 	s.mutableState.EXPECT().IsWorkflowExecutionRunning().Return(false).AnyTimes()
 
 	result, err := convertActivityStateReplicationTask(ctx, s.shardContext, task, s.workflowCache)
@@ -254,6 +255,7 @@ func (s *rawTaskConverterSuite) TestConvertActivityStateReplicationTask_Activity
 		locks.PriorityLow,
 	).Return(s.workflowContext, s.releaseFn, nil)
 	s.workflowContext.EXPECT().LoadMutableState(gomock.Any(), s.shardContext).Return(s.mutableState, nil)
+	s.mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{}).Times(1)
 	s.mutableState.EXPECT().IsWorkflowExecutionRunning().Return(true).AnyTimes()
 	s.mutableState.EXPECT().GetActivityInfo(scheduledEventID).Return(nil, false).AnyTimes()
 
@@ -263,6 +265,39 @@ func (s *rawTaskConverterSuite) TestConvertActivityStateReplicationTask_Activity
 	s.True(s.lockReleased)
 }
 
+func (s *rawTaskConverterSuite) TestConvertActivityStateReplicationTask_LocalOnly() {
+	ctx := context.Background()
+	task := &tasks.SyncActivityTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID,
+			s.workflowID,
+			s.runID,
+		),
+		VisibilityTimestamp: time.Now().UTC(),
+		TaskID:              1444,
+		Version:             288,
+		ScheduledEventID:    144,
+	}
+	s.workflowCache.EXPECT().GetOrCreateChasmExecution(
+		gomock.Any(),
+		s.shardContext,
+		namespace.ID(s.namespaceID),
+		&commonpb.WorkflowExecution{
+			WorkflowId: s.workflowID,
+			RunId:      s.runID,
+		},
+		chasm.WorkflowArchetypeID,
+		locks.PriorityLow,
+	).Return(s.workflowContext, s.releaseFn, nil)
+	s.workflowContext.EXPECT().LoadMutableState(gomock.Any(), s.shardContext).Return(s.mutableState, nil)
+	s.mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{LocalOnly: true}).Times(1)
+
+	result, err := convertActivityStateReplicationTask(ctx, s.shardContext, task, s.workflowCache)
+	s.NoError(err)
+	s.Nil(result)
+	s.True(s.lockReleased)
+}
+
 func (s *rawTaskConverterSuite) TestConvertActivityStateReplicationTask_ActivityScheduled() {
 	ctx := context.Background()
 	scheduledEventID := int64(144)
@@ -510,6 +545,7 @@ func (s *rawTaskConverterSuite) TestConvertWorkflowStateReplicationTask_Workflow
 		locks.PriorityLow,
 	).Return(s.workflowContext, s.releaseFn, nil)
 	s.workflowContext.EXPECT().LoadMutableState(gomock.Any(), s.shardContext).Return(s.mutableState, nil)
+	s.mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{}).Times(1)
 	s.mutableState.EXPECT().GetWorkflowStateStatus().Return(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING).AnyTimes()
 
 	result, err := convertWorkflowStateReplicationTask(ctx, s.shardContext, task, s.workflowCache)
@@ -1058,6 +1094,7 @@ func (s *rawTaskConverterSuite) TestConvertSyncHSMTask_BufferedEvents() {
 		locks.PriorityLow,
 	).Return(s.workflowContext, s.releaseFn, nil)
 	s.workflowContext.EXPECT().LoadMutableState(gomock.Any(), s.shardContext).Return(s.mutableState, nil)
+	s.mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{}).Times(1)
 
 	s.mutableState.EXPECT().HasBufferedEvents().Return(true).AnyTimes()
 	s.mutableState.EXPECT().GetCurrentVersion().Return(version).AnyTimes()
@@ -1208,7 +1245,7 @@ func (s *rawTaskConverterSuite) TestConvertSyncVersionedTransitionTask_Backfill(
 		TransitionHistory: []*persistencespb.VersionedTransition{
 			{NamespaceFailoverVersion: 1, TransitionCount: 3},
 		},
-	})
+	}).Times(2)
 	s.executionManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
 		BranchToken:   newVersionHistory.BranchToken,
 		MinEventID:    common.FirstEventID,
@@ -1304,7 +1341,7 @@ func (s *rawTaskConverterSuite) TestConvertSyncVersionTransitionTask_ConvertTask
 	s.workflowContext.EXPECT().LoadMutableState(gomock.Any(), s.shardContext).Return(s.mutableState, nil).Times(1)
 	s.mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
 		TransitionHistory: nil,
-	}).Times(1)
+	}).Times(2)
 	s.mutableState.EXPECT().IsWorkflow().Return(true).AnyTimes()
 	expectedReplicationTask := &replicationspb.ReplicationTask{
 		TaskType:     enumsspb.REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK,
@@ -1386,7 +1423,7 @@ func (s *rawTaskConverterSuite) TestConvertSyncVersionTransitionTask_AddTaskEqui
 	s.workflowContext.EXPECT().LoadMutableState(gomock.Any(), s.shardContext).Return(s.mutableState, nil).Times(1)
 	s.mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
 		TransitionHistory: nil,
-	}).Times(1)
+	}).Times(2)
 	s.mutableState.EXPECT().IsWorkflow().Return(true).AnyTimes()
 	mockExecutionManager := s.shardContext.Resource.ExecutionMgr
 	mockExecutionManager.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).DoAndReturn(
@@ -1475,7 +1512,7 @@ func (s *rawTaskConverterSuite) TestConvertSyncVersionedTransitionTask_Mutation(
 		VersionHistories:    versionHistories,
 		TransitionHistory:   transitionHistory,
 		CloseTransferTaskId: 0,
-	}).Times(2)
+	}).Times(3)
 	s.mutableState.EXPECT().HasBufferedEvents().Return(false).Times(1)
 	s.mutableState.EXPECT().GetWorkflowKey().Return(definition.WorkflowKey{
 		NamespaceID: s.namespaceID,
@@ -1610,7 +1647,7 @@ func (s *rawTaskConverterSuite) TestConvertSyncVersionedTransitionTask_FirstTask
 		VersionHistories:    versionHistories,
 		TransitionHistory:   transitionHistory,
 		CloseTransferTaskId: 0,
-	}).Times(2)
+	}).Times(3)
 	s.mutableState.EXPECT().HasBufferedEvents().Return(false).Times(1)
 	s.mutableState.EXPECT().GetWorkflowKey().Return(definition.WorkflowKey{
 		NamespaceID: s.namespaceID,
@@ -1723,7 +1760,7 @@ func (s *rawTaskConverterSuite) TestConvertSyncVersionedTransitionTask_HasBuffer
 	s.workflowContext.EXPECT().LoadMutableState(gomock.Any(), s.shardContext).Return(s.mutableState, nil).Times(1)
 	s.mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
 		TransitionHistory: transitionHistory,
-	}).Times(2)
+	}).Times(3)
 	s.mutableState.EXPECT().HasBufferedEvents().Return(true).Times(1)
 	s.progressCache.EXPECT().Get(
 		s.runID,
@@ -3,12 +3,15 @@ package replication
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/cluster"
@@ -17,6 +20,8 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -26,6 +31,9 @@ const (
 type (
 	StreamReceiverMonitor interface {
 		RegisterInboundStream(streamSender StreamSender)
+		// DescribeReplicationLag returns how far each remote cluster is behind the shards owned by this host,
+		// ordered by shard ID and remote cluster name
+		DescribeReplicationLag(ctx context.Context) []*replicationspb.ShardReplicationLag
 		Start()
 		Stop()
 	}
@@ -41,6 +49,7 @@ type (
 		inboundStreams  map[ClusterShardKeyPair]StreamSender
 		outboundStreams map[ClusterShardKeyPair]StreamReceiver
 		previousStatus  map[ClusterShardKeyPair]*streamStatus
+		stuckStreams    map[ClusterShardKeyPair]struct{}
 	}
 	streamStatus struct {
 		defaultAckLevel      int64
@@ -130,6 +139,86 @@ func (m *StreamReceiverMonitorImpl) RegisterInboundStream(
 	m.inboundStreams[streamKey] = streamSender
 }
 
+func (m *StreamReceiverMonitorImpl) DescribeReplicationLag(
+	ctx context.Context,
+) []*replicationspb.ShardReplicationLag {
+	m.Lock()
+	inboundStreams := maps.Clone(m.inboundStreams)
+	stuckStreams := maps.Clone(m.stuckStreams)
+	m.Unlock()
+
+	allClusterInfo := m.ClusterMetadata.GetAllClusterInfo()
+	currentClusterName := m.ClusterMetadata.GetCurrentClusterName()
+	serverClusterID := int32(m.ClusterMetadata.GetClusterID())
+	shardIDs := m.ShardController.ShardIDs()
+	slices.Sort(shardIDs)
+
+	var shardLags []*replicationspb.ShardReplicationLag
+	for _, shardID := range shardIDs {
+		// shards may be moved while iterating, report the shards which are still owned by this host
+		shardContext, err := m.ShardController.GetShardByID(shardID)
+		if err != nil {
+			m.Logger.Warn("Failed to get shardContext.", tag.ShardID(shardID), tag.Error(err))
+			continue
+		}
+		engine, err := shardContext.GetEngine(ctx)
+		if err != nil {
+			m.Logger.Warn("Failed to get engine.", tag.ShardID(shardID), tag.Error(err))
+			continue
+		}
+		maxTaskID, maxTaskVisibilityTime := engine.GetMaxReplicationTaskInfo()
+		remoteClusters, _, err := shardContext.GetReplicationStatus(nil)
+		if err != nil {
+			m.Logger.Warn("Failed to get replication status.", tag.ShardID(shardID), tag.Error(err))
+			continue
+		}
+
+		for _, clusterName := range slices.Sorted(maps.Keys(remoteClusters)) {
+			clusterInfo, ok := allClusterInfo[clusterName]
+			if !ok || clusterName == currentClusterName {
+				continue
+			}
+			remoteStatus := remoteClusters[clusterName]
+			shardLag := &replicationspb.ShardReplicationLag{
+				ShardId:                          shardID,
+				RemoteCluster:                    clusterName,
+				MaxReplicationTaskId:             maxTaskID,
+				MaxReplicationTaskVisibilityTime: timestamppb.New(maxTaskVisibilityTime),
+				AckedTaskId:                      remoteStatus.GetAckedTaskId(),
+				AckedTaskVisibilityTime:          remoteStatus.GetAckedTaskVisibilityTime(),
+				Lag:                              durationpb.New(0),
+			}
+			if shardLag.AckedTaskId < maxTaskID {
+				shardLag.TaskIdLag = maxTaskID - shardLag.AckedTaskId
+				shardLag.Lag = durationpb.New(max(0, maxTaskVisibilityTime.Sub(shardLag.AckedTaskVisibilityTime.AsTime())))
+			}
+
+			clientClusterID := int32(clusterInfo.InitialFailoverVersion)
+			for _, remoteShardID := range common.MapShardID(
+				allClusterInfo[currentClusterName].ShardCount,
+				clusterInfo.ShardCount,
+				shardID,
+			) {
+				key := ClusterShardKeyPair{
+					Client: NewClusterShardKey(clientClusterID, remoteShardID),
+					Server: NewClusterShardKey(serverClusterID, shardID),
+				}
+				senderStatus := &replicationspb.ReplicationStreamSenderStatus{
+					RemoteShardId: remoteShardID,
+				}
+				if sender, ok := inboundStreams[key]; ok && sender.IsValid() {
+					senderStatus.Connected = true
+					senderStatus.FlowControl = sender.FlowControlState()
+				}
+				_, senderStatus.Stuck = stuckStreams[key]
+				shardLag.Streams = append(shardLag.Streams, senderStatus)
+			}
+			shardLags = append(shardLags, shardLag)
+		}
+	}
+	return shardLags
+}
+
 func (m *StreamReceiverMonitorImpl) eventLoop() {
 	defer m.Stop()
 	ticker := time.NewTicker(streamReceiverMonitorInterval)
@@ -331,9 +420,16 @@ func (m *StreamReceiverMonitorImpl) monitorStreamStatus() {
 }
 
 func (m *StreamReceiverMonitorImpl) evaluateStreamStatus(currentStatusMap map[ClusterShardKeyPair]*streamStatus) {
+	stuckStreams := make(map[ClusterShardKeyPair]struct{})
 	for key, currentStatus := range currentStatusMap {
-		m.evaluateSingleStreamConnection(&key, currentStatus, m.previousStatus[key])
+		if !m.evaluateSingleStreamConnection(&key, currentStatus, m.previousStatus[key]) {
+			stuckStreams[key] = struct{}{}
+		}
 	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.stuckStreams = stuckStreams
 }
 
 func (m *StreamReceiverMonitorImpl) evaluateSingleStreamConnection(key *ClusterShardKeyPair, current *streamStatus, previous *streamStatus) bool {
@@ -1,6 +1,7 @@
 package replication
 
 import (
+	"context"
 	"math"
 	"math/rand"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/client"
@@ -19,11 +21,13 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/service/history/configs"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -736,3 +740,80 @@ func (s *streamReceiverMonitorSuite) TestEvaluateStreamStatus() {
 	),
 	)
 }
+
+func (s *streamReceiverMonitorSuite) TestDescribeReplicationLag() {
+	s.clusterMetadata.EXPECT().GetClusterID().Return(cluster.TestCurrentClusterInitialFailoverVersion).AnyTimes()
+	s.clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.clusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		cluster.TestCurrentClusterName: {
+			Enabled:                true,
+			InitialFailoverVersion: cluster.TestCurrentClusterInitialFailoverVersion,
+			ShardCount:             1,
+		},
+		cluster.TestAlternativeClusterName: {
+			Enabled:                true,
+			InitialFailoverVersion: cluster.TestAlternativeClusterInitialFailoverVersion,
+			ShardCount:             2,
+		},
+	}).AnyTimes()
+	s.shardController.EXPECT().ShardIDs().Return([]int32{1}).AnyTimes()
+
+	serverKey := NewClusterShardKey(int32(cluster.TestCurrentClusterInitialFailoverVersion), 1)
+	connectedKey := ClusterShardKeyPair{
+		Client: NewClusterShardKey(int32(cluster.TestAlternativeClusterInitialFailoverVersion), 1),
+		Server: serverKey,
+	}
+	stuckKey := ClusterShardKeyPair{
+		Client: NewClusterShardKey(int32(cluster.TestAlternativeClusterInitialFailoverVersion), 2),
+		Server: serverKey,
+	}
+	flowControl := []*replicationspb.ReplicationFlowControlState{
+		{Priority: enumsspb.TASK_PRIORITY_HIGH},
+		{Priority: enumsspb.TASK_PRIORITY_LOW, Paused: true, BlockedSenders: 1},
+	}
+	streamSender := NewMockStreamSender(s.controller)
+	streamSender.EXPECT().Key().Return(connectedKey).AnyTimes()
+	streamSender.EXPECT().IsValid().Return(true).AnyTimes()
+	streamSender.EXPECT().FlowControlState().Return(flowControl).AnyTimes()
+	s.streamReceiverMonitor.RegisterInboundStream(streamSender)
+
+	s.streamReceiverMonitor.previousStatus = map[ClusterShardKeyPair]*streamStatus{
+		connectedKey: {defaultAckLevel: 50, maxReplicationTaskId: 500},
+		stuckKey:     {defaultAckLevel: 50, maxReplicationTaskId: 500},
+	}
+	s.streamReceiverMonitor.evaluateStreamStatus(map[ClusterShardKeyPair]*streamStatus{
+		connectedKey: {defaultAckLevel: 100, maxReplicationTaskId: 1000},
+		stuckKey:     {defaultAckLevel: 50, maxReplicationTaskId: 1000},
+	})
+
+	ackedTime := time.Unix(0, 0).UTC()
+	maxTaskTime := ackedTime.Add(time.Minute)
+	shardContext := historyi.NewMockShardContext(s.controller)
+	engine := historyi.NewMockEngine(s.controller)
+	s.shardController.EXPECT().GetShardByID(int32(1)).Return(shardContext, nil)
+	shardContext.EXPECT().GetEngine(gomock.Any()).Return(engine, nil)
+	engine.EXPECT().GetMaxReplicationTaskInfo().Return(int64(1000), maxTaskTime)
+	shardContext.EXPECT().GetReplicationStatus(nil).Return(map[string]*historyservice.ShardReplicationStatusPerCluster{
+		cluster.TestAlternativeClusterName: {
+			AckedTaskId:             100,
+			AckedTaskVisibilityTime: timestamppb.New(ackedTime),
+		},
+	}, nil, nil)
+
+	shardLags := s.streamReceiverMonitor.DescribeReplicationLag(context.Background())
+	s.Len(shardLags, 1)
+	protorequire.ProtoEqual(s.T(), &replicationspb.ShardReplicationLag{
+		ShardId:                          1,
+		RemoteCluster:                    cluster.TestAlternativeClusterName,
+		MaxReplicationTaskId:             1000,
+		MaxReplicationTaskVisibilityTime: timestamppb.New(maxTaskTime),
+		AckedTaskId:                      100,
+		AckedTaskVisibilityTime:          timestamppb.New(ackedTime),
+		TaskIdLag:                        900,
+		Lag:                              durationpb.New(time.Minute),
+		Streams: []*replicationspb.ReplicationStreamSenderStatus{
+			{RemoteShardId: 1, Connected: true, FlowControl: flowControl},
+			{RemoteShardId: 2, Stuck: true},
+		},
+	}, shardLags[0])
+}
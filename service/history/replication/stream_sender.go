@@ -42,6 +42,8 @@ type (
 	StreamSender interface {
 		IsValid() bool
 		Key() ClusterShardKeyPair
+		// FlowControlState returns the flow control state of each priority, empty if tiered processing is disabled
+		FlowControlState() []*replicationspb.ReplicationFlowControlState
 		Stop()
 	}
 	StreamSenderImpl struct {
@@ -169,6 +171,13 @@ func (s *StreamSenderImpl) Key() ClusterShardKeyPair {
 	}
 }
 
+func (s *StreamSenderImpl) FlowControlState() []*replicationspb.ReplicationFlowControlState {
+	if !s.isTieredStackEnabled {
+		return nil
+	}
+	return s.flowController.Describe()
+}
+
 func (s *StreamSenderImpl) recvEventLoop() (retErr error) {
 	var panicErr error
 	defer func() {
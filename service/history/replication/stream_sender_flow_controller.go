@@ -4,6 +4,7 @@ package replication
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 
@@ -27,6 +28,8 @@ type (
 		// Wait will block go routine until the sender is allowed to send a task
 		Wait(ctx context.Context, priority enumsspb.TaskPriority) error
 		RefreshReceiverFlowControlInfo(syncState *replicationspb.SyncReplicationState)
+		// Describe returns the flow control state of each priority, ordered by priority
+		Describe() []*replicationspb.ReplicationFlowControlState
 	}
 	SenderFlowControllerImpl struct {
 		flowControlStates  map[enumsspb.TaskPriority]*flowControlState
@@ -70,6 +73,27 @@ func (s *SenderFlowControllerImpl) RefreshReceiverFlowControlInfo(syncState *rep
 	}
 }
 
+func (s *SenderFlowControllerImpl) Describe() []*replicationspb.ReplicationFlowControlState {
+	priorities := make([]enumsspb.TaskPriority, 0, len(s.flowControlStates))
+	for priority := range s.flowControlStates {
+		priorities = append(priorities, priority)
+	}
+	slices.Sort(priorities)
+
+	states := make([]*replicationspb.ReplicationFlowControlState, 0, len(priorities))
+	for _, priority := range priorities {
+		state := s.flowControlStates[priority]
+		state.mu.Lock()
+		states = append(states, &replicationspb.ReplicationFlowControlState{
+			Priority:       priority,
+			Paused:         !state.resume,
+			BlockedSenders: int32(state.waiters),
+		})
+		state.mu.Unlock()
+	}
+	return states
+}
+
 func (s *SenderFlowControllerImpl) setState(state *flowControlState, flowControlCommand enumsspb.ReplicationFlowControlCommand) {
 	switch flowControlCommand {
 	case enumsspb.REPLICATION_FLOW_CONTROL_COMMAND_RESUME, enumsspb.REPLICATION_FLOW_CONTROL_COMMAND_UNSPECIFIED:
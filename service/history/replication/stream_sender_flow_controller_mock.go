@@ -42,6 +42,20 @@ func (m *MockSenderFlowController) EXPECT() *MockSenderFlowControllerMockRecorde
 	return m.recorder
 }
 
+// Describe mocks base method.
+func (m *MockSenderFlowController) Describe() []*repication.ReplicationFlowControlState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].([]*repication.ReplicationFlowControlState)
+	return ret0
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockSenderFlowControllerMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockSenderFlowController)(nil).Describe))
+}
+
 // RefreshReceiverFlowControlInfo mocks base method.
 func (m *MockSenderFlowController) RefreshReceiverFlowControlInfo(syncState *repication.SyncReplicationState) {
 	m.ctrl.T.Helper()
@@ -134,6 +134,30 @@ func (s *senderFlowControllerSuite) TestRefreshReceiverFlowControlInfo() {
 	s.False(senderFlowCtrlImpl.flowControlStates[enumsspb.TASK_PRIORITY_LOW].resume)
 }
 
+func (s *senderFlowControllerSuite) TestDescribe() {
+	s.senderFlowCtrlImpl.RefreshReceiverFlowControlInfo(&replicationspb.SyncReplicationState{
+		HighPriorityState: &replicationspb.ReplicationState{
+			FlowControlCommand: enumsspb.REPLICATION_FLOW_CONTROL_COMMAND_RESUME,
+		},
+		LowPriorityState: &replicationspb.ReplicationState{
+			FlowControlCommand: enumsspb.REPLICATION_FLOW_CONTROL_COMMAND_PAUSE,
+		},
+	})
+	lowPriorityState := s.senderFlowCtrlImpl.flowControlStates[enumsspb.TASK_PRIORITY_LOW]
+	lowPriorityState.mu.Lock()
+	lowPriorityState.waiters = 2
+	lowPriorityState.mu.Unlock()
+
+	states := s.senderFlowCtrlImpl.Describe()
+	s.Len(states, 2)
+	s.Equal(enumsspb.TASK_PRIORITY_HIGH, states[0].GetPriority())
+	s.False(states[0].GetPaused())
+	s.Equal(int32(0), states[0].GetBlockedSenders())
+	s.Equal(enumsspb.TASK_PRIORITY_LOW, states[1].GetPriority())
+	s.True(states[1].GetPaused())
+	s.Equal(int32(2), states[1].GetBlockedSenders())
+}
+
 func (s *senderFlowControllerSuite) TestPauseToResume() {
 	state := s.senderFlowCtrlImpl.flowControlStates[enumsspb.TASK_PRIORITY_HIGH]
 	state.rateLimiter = s.mockRateLimiter
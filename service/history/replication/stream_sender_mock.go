@@ -12,6 +12,7 @@ package replication
 import (
 	reflect "reflect"
 
+	repication "go.temporal.io/server/api/replication/v1"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -39,6 +40,20 @@ func (m *MockStreamSender) EXPECT() *MockStreamSenderMockRecorder {
 	return m.recorder
 }
 
+// FlowControlState mocks base method.
+func (m *MockStreamSender) FlowControlState() []*repication.ReplicationFlowControlState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlowControlState")
+	ret0, _ := ret[0].([]*repication.ReplicationFlowControlState)
+	return ret0
+}
+
+// FlowControlState indicates an expected call of FlowControlState.
+func (mr *MockStreamSenderMockRecorder) FlowControlState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlowControlState", reflect.TypeOf((*MockStreamSender)(nil).FlowControlState))
+}
+
 // IsValid mocks base method.
 func (m *MockStreamSender) IsValid() bool {
 	m.ctrl.T.Helper()
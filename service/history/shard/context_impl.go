@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"maps"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	commonpb "go.temporal.io/api/common/v1"
+	rulespb "go.temporal.io/api/rules/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/api/adminservice/v1"
 	clockspb "go.temporal.io/server/api/clock/v1"
@@ -151,6 +153,11 @@ type (
 		endpointRegistry chasm.EndpointRegistry
 
 		businessIDRateLimiters cache.Cache
+
+		// workflow rule hits which are not merged into shardInfo yet, protected by workflowRuleHitsLock.
+		// Lock order is rwLock before workflowRuleHitsLock.
+		workflowRuleHitsLock    sync.Mutex
+		pendingWorkflowRuleHits map[namespace.ID]map[string]int64
 	}
 
 	remoteClusterInfo struct {
@@ -471,6 +478,80 @@ func (s *ContextImpl) UpdateReplicatorDLQAckLevel(
 	return nil
 }
 
+// RecordWorkflowRuleHit counts a match of a workflow rule. The count is kept in memory and merged into the shard info
+// with the next shard info update, so that matching a rule doesn't add work to the transaction which matched it.
+func (s *ContextImpl) RecordWorkflowRuleHit(
+	namespaceID namespace.ID,
+	rule *rulespb.WorkflowRule,
+) {
+	key := workflowRuleHitKey(namespaceID, rule.GetSpec().GetId(), rule.GetCreateTime().AsTime())
+
+	s.workflowRuleHitsLock.Lock()
+	defer s.workflowRuleHitsLock.Unlock()
+
+	if s.pendingWorkflowRuleHits == nil {
+		s.pendingWorkflowRuleHits = make(map[namespace.ID]map[string]int64)
+	}
+	if s.pendingWorkflowRuleHits[namespaceID] == nil {
+		s.pendingWorkflowRuleHits[namespaceID] = make(map[string]int64)
+	}
+	s.pendingWorkflowRuleHits[namespaceID][key]++
+}
+
+func (s *ContextImpl) GetWorkflowRuleHits(
+	namespaceID namespace.ID,
+	ruleID string,
+	ruleCreateTime time.Time,
+) int64 {
+	key := workflowRuleHitKey(namespaceID, ruleID, ruleCreateTime)
+
+	s.rLock()
+	defer s.rUnlock()
+	s.workflowRuleHitsLock.Lock()
+	defer s.workflowRuleHitsLock.Unlock()
+
+	return s.shardInfo.WorkflowRuleHits[key] + s.pendingWorkflowRuleHits[namespaceID][key]
+}
+
+// mergeWorkflowRuleHitsLocked moves the pending workflow rule hits into the shard info. When a rule is counted for
+// the first time on the shard, the counts of the rules of its namespace which no longer exist are removed.
+func (s *ContextImpl) mergeWorkflowRuleHitsLocked() {
+	s.workflowRuleHitsLock.Lock()
+	pendingHits := s.pendingWorkflowRuleHits
+	s.pendingWorkflowRuleHits = nil
+	s.workflowRuleHitsLock.Unlock()
+
+	for namespaceID, hits := range pendingHits {
+		if s.shardInfo.WorkflowRuleHits == nil {
+			s.shardInfo.WorkflowRuleHits = make(map[string]int64)
+		}
+		for key, count := range hits {
+			if _, ok := s.shardInfo.WorkflowRuleHits[key]; !ok {
+				s.pruneWorkflowRuleHitsLocked(namespaceID)
+			}
+			s.shardInfo.WorkflowRuleHits[key] += count
+		}
+	}
+}
+
+func (s *ContextImpl) pruneWorkflowRuleHitsLocked(namespaceID namespace.ID) {
+	ns, err := s.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		// Keep the counts if the namespace can't be resolved, they are pruned on a later merge.
+		return
+	}
+	existingKeys := make(map[string]struct{})
+	for _, rule := range ns.GetWorkflowRules() {
+		existingKeys[workflowRuleHitKey(namespaceID, rule.GetSpec().GetId(), rule.GetCreateTime().AsTime())] = struct{}{}
+	}
+	prefix := namespaceID.String() + "/"
+	for key := range s.shardInfo.WorkflowRuleHits {
+		if _, ok := existingKeys[key]; !ok && strings.HasPrefix(key, prefix) {
+			delete(s.shardInfo.WorkflowRuleHits, key)
+		}
+	}
+}
+
 func (s *ContextImpl) UpdateHandoverNamespace(ns *namespace.Namespace, deletedFromDB bool) {
 	s.wLock()
 	s.handoverTracker.UpdateHandoverState(ns, deletedFromDB)
@@ -1225,6 +1306,7 @@ func (s *ContextImpl) updateShardInfo(
 
 	s.tasksCompletedSinceLastUpdate += tasksCompleted
 	updateFnLocked()
+	s.mergeWorkflowRuleHitsLocked()
 	s.shardInfo.StolenSinceRenew = 0
 
 	now := s.timeSource.Now()
@@ -2128,7 +2210,7 @@ func newContext(
 		NamespaceRegistry:       namespaceRegistry,
 		Logger:                  taggedLogger,
 	})
-	if shardContext.GetConfig().EnableHostLevelEventsCache() {
+	if shardContext.GetConfig().EnableHostLevelEventsCache() || shardContext.GetConfig().HostLevelCacheBudgetEnabled {
 		shardContext.eventsCache = eventsCache
 	} else {
 		shardContext.eventsCache = events.NewShardLevelEventsCache(
@@ -2173,6 +2255,7 @@ func (s *ContextImpl) copyShardInfo(shardInfo *persistencespb.ShardInfo) *persis
 		ReplicationDlqAckLevel: maps.Clone(shardInfo.ReplicationDlqAckLevel),
 		UpdateTime:             shardInfo.UpdateTime,
 		QueueStates:            queueStates,
+		WorkflowRuleHits:       maps.Clone(shardInfo.WorkflowRuleHits),
 	}
 }
 
@@ -2332,6 +2415,12 @@ func trimShardInfo(
 	return shardInfo
 }
 
+// workflowRuleHitKey includes the create time of the rule so that a rule which is deleted and created again with the
+// same ID starts counting from zero.
+func workflowRuleHitKey(namespaceID namespace.ID, ruleID string, ruleCreateTime time.Time) string {
+	return fmt.Sprintf("%s/%s/%d", namespaceID, ruleID, ruleCreateTime.UnixNano())
+}
+
 func clusterNameInfoFromClusterID(
 	allClusterInfo map[string]cluster.ClusterInformation,
 	clusterID int64,
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
+	rulespb "go.temporal.io/api/rules/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/chasm"
@@ -842,6 +843,36 @@ func (s *contextSuite) TestUpdateShardInfo_FailsUnlessShardAcquired() {
 	s.True(called)
 }
 
+func (s *contextSuite) TestUpdateShardInfo_MergesWorkflowRuleHits() {
+	s.mockShard.state = contextStateAcquired
+	createTime := time.Unix(0, 0)
+	staleKey := workflowRuleHitKey(tests.NamespaceID, "stale-rule", createTime)
+	s.mockShard.shardInfo.WorkflowRuleHits = map[string]int64{staleKey: 3}
+
+	rule := &rulespb.WorkflowRule{
+		Spec:       &rulespb.WorkflowRuleSpec{Id: "rule"},
+		CreateTime: timestamppb.New(createTime),
+	}
+	s.mockShard.RecordWorkflowRuleHit(tests.NamespaceID, rule)
+	s.mockShard.RecordWorkflowRuleHit(tests.NamespaceID, rule)
+
+	// The hits are counted before they are merged into the shard info.
+	s.Equal(int64(2), s.mockShard.GetWorkflowRuleHits(tests.NamespaceID, "rule", createTime))
+	s.NotContains(s.mockShard.shardInfo.WorkflowRuleHits, workflowRuleHitKey(tests.NamespaceID, "rule", createTime))
+
+	// The counts of the rules which no longer exist in the namespace are removed.
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *persistence.UpdateShardRequest) error {
+			s.Equal(map[string]int64{
+				workflowRuleHitKey(tests.NamespaceID, "rule", createTime): 2,
+			}, request.ShardInfo.WorkflowRuleHits)
+			return nil
+		}).Times(1)
+	s.NoError(s.mockShard.updateShardInfo(0, func() {}))
+	s.Equal(int64(2), s.mockShard.GetWorkflowRuleHits(tests.NamespaceID, "rule", createTime))
+	s.Zero(s.mockShard.GetWorkflowRuleHits(tests.NamespaceID, "stale-rule", createTime))
+}
+
 func (s *contextSuite) TestUpdateShardInfo_FirstUpdate() {
 
 	s.mockShard.config.ShardUpdateMinInterval = func() time.Duration { return 5 * time.Minute }
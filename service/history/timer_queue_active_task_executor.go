@@ -1017,7 +1017,7 @@ func (t *timerQueueActiveTaskExecutor) processActivityWorkflowRules(
 		return nil
 	}
 
-	activityChanged := workflow.ActivityMatchWorkflowRules(ms, t.shardContext.GetTimeSource(), t.logger, ai)
+	activityChanged := workflow.ActivityMatchWorkflowRules(t.shardContext, ms, t.shardContext.GetTimeSource(), t.logger, ai)
 	if !activityChanged {
 		return nil
 	}
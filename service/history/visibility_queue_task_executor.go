@@ -19,6 +19,7 @@ import (
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/searchattribute/sadefs"
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
@@ -507,9 +508,20 @@ func (t *visibilityQueueTaskExecutor) getVisibilityRequestBase(
 		startTime        = timestamp.TimeValue(mutableState.GetExecutionState().GetStartTime())
 		executionTime    = timestamp.TimeValue(executionInfo.GetExecutionTime())
 		visibilityMemo   = getWorkflowMemo(copyMapPayload(memoMap))
-		searchAttributes = getSearchAttributes(copyMapPayload(searchAttributesMap))
+		searchAttributes = getSearchAttributes(copyMapPayload(
+			searchattribute.ApplyTypeMigrations(searchAttributesMap, namespaceEntry.SearchAttributeTypeMigrations()),
+		))
 	)
 
+	if executionInfo.GetLocalOnly() {
+		if searchAttributes == nil {
+			searchAttributes = &commonpb.SearchAttributes{
+				IndexedFields: make(map[string]*commonpb.Payload),
+			}
+		}
+		searchAttributes.IndexedFields[sadefs.TemporalLocalOnly] = sadefs.MustEncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL)
+	}
+
 	var parentExecution *commonpb.WorkflowExecution
 	if executionInfo.ParentWorkflowId != "" && executionInfo.ParentRunId != "" {
 		parentExecution = &commonpb.WorkflowExecution{
@@ -466,6 +466,57 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttrib
 	s.Nil(resp.ExecutionErr)
 }
 
+func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttributes_LocalOnly() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.NewString(),
+	}
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: "some random workflow type"},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: "some random task queue"},
+				WorkflowExecutionTimeout: durationpb.New(2 * time.Second),
+				WorkflowTaskTimeout:      durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.NoError(err)
+	mutableState.GetExecutionInfo().LocalOnly = true
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+
+	visibilityTask := &tasks.UpsertExecutionVisibilityTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		TaskID: int64(59),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, wt.ScheduledEventID, wt.Version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *manager.UpsertWorkflowExecutionRequest) error {
+			var localOnly bool
+			err := payload.Decode(request.SearchAttributes.IndexedFields[sadefs.TemporalLocalOnly], &localOnly)
+			s.NoError(err)
+			s.True(localOnly)
+			return nil
+		},
+	)
+
+	resp := s.visibilityQueueTaskExecutor.Execute(context.Background(), s.newTaskExecutable(visibilityTask))
+	s.Nil(resp.ExecutionErr)
+}
+
 func (s *visibilityQueueTaskExecutorSuite) TestProcessModifyWorkflowProperties() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
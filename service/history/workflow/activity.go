@@ -42,6 +42,8 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/workflow/matcher"
@@ -539,11 +541,14 @@ func MatchWorkflowRule(
 	executionState *persistencespb.WorkflowExecutionState,
 	ai *persistencespb.ActivityInfo,
 	rule *rulespb.WorkflowRuleSpec,
+	nsName namespace.Name,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapper searchattribute.Mapper,
 ) (bool, error) {
 	// match visibility query
 	visibilityQuery := rule.GetVisibilityQuery()
 	if visibilityQuery != "" {
-		match, err := matcher.MatchMutableState(executionInfo, executionState, rule.GetVisibilityQuery())
+		match, err := matcher.MatchMutableState(executionInfo, executionState, visibilityQuery, nsName, saTypeMap, saMapper)
 		if err != nil || !match {
 			return false, err
 		}
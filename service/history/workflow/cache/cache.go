@@ -99,6 +99,10 @@ func NewHostLevelCache(
 	if config.HistoryCacheLimitSizeBased {
 		maxSize = config.HistoryHostLevelCacheMaxSizeBytes()
 	}
+	if config.HostLevelCacheBudgetEnabled {
+		// The max size is adjusted by the host level cache budget once the cache is registered with it.
+		maxSize = config.HostLevelCacheBudgetBytes()
+	}
 	opts := &cache.Options{
 		TTL:             config.HistoryCacheTTL(),
 		Pin:             true,
@@ -140,6 +144,14 @@ func NewHostLevelCache(
 		},
 	}
 
+	if config.HostLevelCacheBudgetEnabled {
+		opts.NamespaceKey = func(key any) string {
+			//revive:disable-next-line:unchecked-type-assertion
+			return key.(Key).WorkflowKey.NamespaceID
+		}
+		opts.NamespaceMaxShare = config.HostLevelCacheNamespaceMaxShare
+	}
+
 	taggedHandler := handler.WithTags(metrics.CacheTypeTag(metrics.MutableStateCacheTypeTagValue))
 	c := cache.NewWithMetrics(maxSize, opts, taggedHandler)
 	return &cacheImpl{
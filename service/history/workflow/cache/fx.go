@@ -3,6 +3,9 @@ package cache
 import (
 	"context"
 
+	commoncache "go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/history/configs"
 	"go.uber.org/fx"
 )
 
@@ -11,7 +14,14 @@ var Module = fx.Options(
 	fx.Invoke(func(
 		lc fx.Lifecycle,
 		cache Cache,
-	) {
+		config *configs.Config,
+		budget *commoncache.Budget,
+	) error {
+		if ci, ok := cache.(*cacheImpl); ok && config.HostLevelCacheBudgetEnabled {
+			if err := budget.Register(metrics.MutableStateCacheTypeTagValue, ci.Cache); err != nil {
+				return err
+			}
+		}
 		lc.Append(fx.Hook{
 			OnStop: func(_ context.Context) error {
 				ci, ok := cache.(*cacheImpl)
@@ -21,5 +31,6 @@ var Module = fx.Options(
 				return nil
 			},
 		})
+		return nil
 	}),
 )
@@ -0,0 +1,227 @@
+package matcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestMutableStateMatcherConformance verifies that MatchMutableState gives the same result as SQL
+// visibility for the same query and workflow execution.
+func TestMutableStateMatcherConformance(t *testing.T) {
+	testBase := persistencetests.NewTestBaseWithSQL(persistencetests.GetSQLiteMemoryTestClusterOption())
+	testBase.DefaultTestCluster.SetupTestDatabase()
+	t.Cleanup(testBase.DefaultTestCluster.TearDownTestDatabase)
+
+	saTypeMap := searchattribute.TestNameTypeMap()
+	saMapper := &searchattribute.TestMapper{}
+	visibilityMgr, err := visibility.NewManager(
+		testBase.DefaultTestCluster.Config(),
+		resolver.NewNoopResolver(),
+		nil,
+		nil,
+		searchattribute.NewTestProvider(),
+		searchattribute.NewTestMapperProvider(saMapper),
+		namespace.NewMockRegistry(gomock.NewController(t)),
+		chasm.NewRegistry(nil),
+		dynamicconfig.GetIntPropertyFn(1000),
+		dynamicconfig.GetIntPropertyFn(1000),
+		dynamicconfig.GetFloatPropertyFn(0.2),
+		dynamicconfig.GetDurationPropertyFn(time.Second),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		dynamicconfig.GetBoolPropertyFn(false),
+		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		dynamicconfig.GetBoolPropertyFn(true),
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger(),
+		serialization.NewSerializer(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(visibilityMgr.Close)
+
+	startTime := time.Date(2023, 10, 26, 14, 30, 0, 0, time.UTC)
+	closeTime := startTime.Add(time.Minute)
+	searchAttributes := map[string]*commonpb.Payload{
+		"Keyword01":     sadefs.MustEncodeValue("foo", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+		"KeywordList01": sadefs.MustEncodeValue([]string{"foo", "bar"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST),
+		"Int01":         sadefs.MustEncodeValue(int64(10), enumspb.INDEXED_VALUE_TYPE_INT),
+		"Double01":      sadefs.MustEncodeValue(1.5, enumspb.INDEXED_VALUE_TYPE_DOUBLE),
+		"Bool01":        sadefs.MustEncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL),
+		"Datetime01":    sadefs.MustEncodeValue(startTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+		"Text01":        sadefs.MustEncodeValue("the quick brown fox", enumspb.INDEXED_VALUE_TYPE_TEXT),
+		sadefs.TemporalChangeVersion: sadefs.MustEncodeValue(
+			[]string{"change-1"},
+			enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		),
+		sadefs.BuildIds: sadefs.MustEncodeValue(
+			[]string{"versioned:build-1"},
+			enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		),
+	}
+
+	type execution struct {
+		namespaceID    namespace.ID
+		executionInfo  *persistencespb.WorkflowExecutionInfo
+		executionState *persistencespb.WorkflowExecutionState
+	}
+	newExecution := func(status enumspb.WorkflowExecutionStatus) execution {
+		e := execution{
+			namespaceID: namespace.ID(uuid.NewString()),
+			executionInfo: &persistencespb.WorkflowExecutionInfo{
+				WorkflowId:       "workflow_id",
+				WorkflowTypeName: "workflow_type",
+				TaskQueue:        "task_queue",
+				ExecutionTime:    timestamppb.New(startTime),
+				SearchAttributes: searchAttributes,
+			},
+			executionState: &persistencespb.WorkflowExecutionState{
+				RunId:     uuid.NewString(),
+				StartTime: timestamppb.New(startTime),
+				Status:    status,
+			},
+		}
+		base := &manager.VisibilityRequestBase{
+			NamespaceID: e.namespaceID,
+			Namespace:   testNamespaceName,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: e.executionInfo.WorkflowId,
+				RunId:      e.executionState.RunId,
+			},
+			WorkflowTypeName: e.executionInfo.WorkflowTypeName,
+			StartTime:        startTime,
+			ExecutionTime:    startTime,
+			Status:           status,
+			TaskQueue:        e.executionInfo.TaskQueue,
+			SearchAttributes: &commonpb.SearchAttributes{IndexedFields: searchAttributes},
+		}
+		if status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			require.NoError(t, visibilityMgr.RecordWorkflowExecutionStarted(
+				context.Background(),
+				&manager.RecordWorkflowExecutionStartedRequest{VisibilityRequestBase: base},
+			))
+			return e
+		}
+
+		e.executionInfo.CloseTime = timestamppb.New(closeTime)
+		e.executionInfo.StateTransitionCount = 5
+		e.executionInfo.ExecutionStats = &persistencespb.ExecutionStats{HistorySize: 1024}
+		e.executionInfo.VersionHistories = &historyspb.VersionHistories{
+			Histories: []*historyspb.VersionHistory{
+				{Items: []*historyspb.VersionHistoryItem{{EventId: 11}}},
+			},
+		}
+		require.NoError(t, visibilityMgr.RecordWorkflowExecutionClosed(
+			context.Background(),
+			&manager.RecordWorkflowExecutionClosedRequest{
+				VisibilityRequestBase: base,
+				CloseTime:             closeTime,
+				ExecutionDuration:     closeTime.Sub(startTime),
+				HistoryLength:         11,
+				HistorySizeBytes:      1024,
+				StateTransitionCount:  5,
+			},
+		))
+		return e
+	}
+	executions := []execution{
+		newExecution(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		newExecution(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED),
+	}
+
+	queries := []string{
+		"WorkflowId = 'workflow_id'",
+		"WorkflowId STARTS_WITH 'workflow'",
+		"WorkflowId NOT STARTS_WITH 'workflow'",
+		"WorkflowType IN ('other', 'workflow_type')",
+		"TaskQueue = 'task_queue'",
+		"TaskQueue != 'task_queue'",
+		"StartTime BETWEEN '2023-10-26T14:00:00Z' AND '2023-10-26T15:00:00Z'",
+		"StartTime NOT BETWEEN '2023-10-26T14:00:00Z' AND '2023-10-26T15:00:00Z'",
+		"ExecutionTime >= '2023-10-26T14:30:00Z'",
+		"ExecutionStatus = 'Running'",
+		"ExecutionStatus != 'Running'",
+		"ExecutionStatus IN ('Completed', 'Failed')",
+		"ExecutionStatus > 'Running'",
+		"CloseTime IS NULL",
+		"CloseTime > '2023-10-26T14:30:00Z'",
+		"ExecutionDuration = '1m'",
+		"ExecutionDuration < '1m'",
+		"HistoryLength = 11",
+		"HistorySizeBytes > 1000",
+		"StateTransitionCount <= 5",
+		"AliasForKeyword01 = 'foo'",
+		"AliasForKeyword01 STARTS_WITH 'fo'",
+		"AliasForKeyword01 IN ('bar', 'baz')",
+		"AliasForKeyword02 IS NULL",
+		"AliasForKeyword02 IS NOT NULL",
+		"AliasForKeyword02 = 'foo'",
+		"AliasForKeyword02 != 'foo'",
+		"NOT (AliasForKeyword02 = 'foo')",
+		"AliasForKeyword02 = 'foo' OR AliasForKeyword01 = 'foo'",
+		"AliasForKeyword02 = 'foo' AND AliasForKeyword01 = 'foo'",
+		"AliasForKeywordList01 = 'foo'",
+		"AliasForKeywordList01 = 'baz'",
+		"AliasForKeywordList01 != 'foo'",
+		"AliasForKeywordList01 IN ('baz', 'bar')",
+		"AliasForKeywordList01 NOT IN ('baz', 'qux')",
+		"AliasForInt01 = 10",
+		"AliasForInt01 != 10",
+		"AliasForInt01 BETWEEN 5 AND 15",
+		"AliasForInt01 IN (1, 2)",
+		"AliasForDouble01 > 1",
+		"AliasForDouble01 <= 1.4",
+		"AliasForBool01 = true",
+		"AliasForBool01 = false",
+		"AliasForDatetime01 < '2023-10-26T14:30:00Z'",
+		"AliasForDatetime01 <= '2023-10-26T15:00:00Z'",
+		"AliasForText01 = 'quick'",
+		"AliasForText01 = 'slow'",
+		"TemporalChangeVersion = 'change-1'",
+		"BuildIds = 'versioned:build-1'",
+		"BuildIds = 'versioned:build-2'",
+		"(WorkflowId = 'workflow_id' OR AliasForInt01 = 1) AND ExecutionStatus = 'Running'",
+	}
+
+	for _, e := range executions {
+		for _, q := range queries {
+			t.Run(e.executionState.Status.String()+"/"+q, func(t *testing.T) {
+				resp, err := visibilityMgr.CountWorkflowExecutions(
+					context.Background(),
+					&manager.CountWorkflowExecutionsRequest{
+						NamespaceID: e.namespaceID,
+						Namespace:   testNamespaceName,
+						Query:       q,
+					},
+				)
+				require.NoError(t, err)
+
+				match, err := MatchMutableState(e.executionInfo, e.executionState, q, testNamespaceName, saTypeMap, saMapper)
+				require.NoError(t, err)
+				require.Equal(t, resp.Count > 0, match)
+			})
+		}
+	}
+}
@@ -1,13 +1,19 @@
 package matcher
 
 import (
-	"fmt"
+	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/searchattribute/sadefs"
-	"go.temporal.io/server/common/sqlquery"
 )
 
 // Supported Fields
@@ -18,220 +24,427 @@ const (
 	workflowExecutionStatusColName = sadefs.ExecutionStatus
 )
 
+// matchResult is the result of evaluating a condition against the mutable state. Conditions on
+// search attributes which are not set evaluate to matchUnknown, which follows the SQL three-valued
+// logic for NULL values: ordering the values as matchFalse < matchUnknown < matchTrue, AND is the
+// minimum, OR is the maximum and NOT is the reverse of its operand.
+type matchResult int
+
+const (
+	// matchNone is the result of an absent condition, e.g. the namespace division condition when
+	// the query filters on TemporalNamespaceDivision explicitly.
+	matchNone matchResult = iota
+	matchFalse
+	matchUnknown
+	matchTrue
+)
+
+// mutableStateMatchEvaluator evaluates visibility queries against a mutable state. It implements
+// query.StoreQueryConverter so that parsing, search attribute resolution and value validation are
+// shared with the visibility query converter, and evaluates each condition in place instead of
+// building a store query.
 type mutableStateMatchEvaluator struct {
 	executionInfo  *persistencespb.WorkflowExecutionInfo
 	executionState *persistencespb.WorkflowExecutionState
+	nsName         namespace.Name
+	saTypeMap      searchattribute.NameTypeMap
+	saMapper       searchattribute.Mapper
 }
 
+var _ query.StoreQueryConverter[matchResult] = (*mutableStateMatchEvaluator)(nil)
+
 func newMutableStateMatchEvaluator(
 	executionInfo *persistencespb.WorkflowExecutionInfo,
-	executionState *persistencespb.WorkflowExecutionState) *mutableStateMatchEvaluator {
+	executionState *persistencespb.WorkflowExecutionState,
+	nsName namespace.Name,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapper searchattribute.Mapper,
+) *mutableStateMatchEvaluator {
+	if saMapper == nil {
+		saMapper = &searchattribute.NoopMapper{}
+	}
 	return &mutableStateMatchEvaluator{
 		executionInfo:  executionInfo,
 		executionState: executionState,
+		nsName:         nsName,
+		saTypeMap:      saTypeMap,
+		saMapper:       saMapper,
 	}
 }
 
-func (m *mutableStateMatchEvaluator) Evaluate(query string) (bool, error) {
-	query, err := prepareQuery(query)
+func (m *mutableStateMatchEvaluator) Evaluate(queryString string) (bool, error) {
+	prepared, err := prepareQuery(queryString)
 	if err != nil {
 		return false, err
 	}
+	if prepared == "" {
+		return false, NewMatcherError("%s: 'where' clause is missing", notSupportedErrMessage)
+	}
 
-	whereCause, err := getWhereCause(query)
+	queryParams, err := query.NewQueryConverter(m, m.nsName, m.saTypeMap, m.saMapper).Convert(queryString)
 	if err != nil {
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return false, converterErr.ToInvalidArgument()
+		}
 		return false, err
 	}
+	if len(queryParams.OrderBy) > 0 || len(queryParams.GroupBy) > 0 {
+		return false, NewMatcherError("%s: 'order by' and 'group by' clauses", notSupportedErrMessage)
+	}
+	return queryParams.QueryExpr == matchTrue, nil
+}
 
-	return m.evaluateExpression(whereCause)
+func (m *mutableStateMatchEvaluator) GetDatetimeFormat() string {
+	return time.RFC3339Nano
 }
 
-func (m *mutableStateMatchEvaluator) evaluateExpression(expr sqlparser.Expr) (bool, error) {
+func (m *mutableStateMatchEvaluator) BuildParenExpr(expr matchResult) (matchResult, error) {
+	return expr, nil
+}
 
-	if expr == nil {
-		return false, NewMatcherError("input expression cannot be nil")
+func (m *mutableStateMatchEvaluator) BuildNotExpr(expr matchResult) (matchResult, error) {
+	if expr == matchNone {
+		return matchNone, nil
 	}
+	return matchTrue + matchFalse - expr, nil
+}
 
-	switch e := (expr).(type) {
-	case *sqlparser.AndExpr:
-		return m.evaluateAnd(e)
-	case *sqlparser.OrExpr:
-		return m.evaluateOr(e)
-	case *sqlparser.ComparisonExpr:
-		return m.evaluateComparison(e)
-	case *sqlparser.RangeCond:
-		return m.evaluateRange(e)
-	case *sqlparser.ParenExpr:
-		return m.evaluateExpression(e.Expr)
-	case *sqlparser.IsExpr:
-		return false, NewMatcherError("%s: 'is' expression", notSupportedErrMessage)
-	case *sqlparser.NotExpr:
-		return false, NewMatcherError("%s: 'not' expression", notSupportedErrMessage)
-	case *sqlparser.FuncExpr:
-		return false, NewMatcherError("%s: function expression", notSupportedErrMessage)
-	case *sqlparser.ColName:
-		return false, NewMatcherError("incomplete expression")
-	default:
-		return false, NewMatcherError("%s: expression of type %T", notSupportedErrMessage, expr)
+func (m *mutableStateMatchEvaluator) BuildAndExpr(exprs ...matchResult) (matchResult, error) {
+	result := matchNone
+	for _, expr := range exprs {
+		if expr != matchNone && (result == matchNone || expr < result) {
+			result = expr
+		}
 	}
+	return result, nil
 }
 
-func (m *mutableStateMatchEvaluator) evaluateAnd(expr *sqlparser.AndExpr) (bool, error) {
-	if expr == nil {
-		return false, NewMatcherError("And expression input expression cannot be nil")
+func (m *mutableStateMatchEvaluator) BuildOrExpr(exprs ...matchResult) (matchResult, error) {
+	return slices.Max(exprs), nil
+}
+
+func (m *mutableStateMatchEvaluator) ConvertComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (matchResult, error) {
+	actual, ok := m.getValue(col)
+	if !ok {
+		return matchUnknown, nil
 	}
-	if leftResult, err := m.evaluateExpression(expr.Left); err != nil || !leftResult {
-		return leftResult, err
+	value = normalizeValue(col, value)
+	switch operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		values, _ := value.([]any)
+		found := false
+		for _, v := range values {
+			cmp, err := compareValues(actual, v)
+			if err != nil {
+				return matchNone, err
+			}
+			found = found || cmp == 0
+		}
+		return toMatchResult(found == (operator == sqlparser.InStr)), nil
+	default:
+		cmp, err := compareValues(actual, value)
+		if err != nil {
+			return matchNone, err
+		}
+		return toMatchResult(evaluateComparison(operator, cmp)), nil
 	}
+}
 
-	// if left is true, then right must be evaluated
-	return m.evaluateExpression(expr.Right)
+func (m *mutableStateMatchEvaluator) ConvertKeywordComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (matchResult, error) {
+	switch operator {
+	case sqlparser.StartsWithStr, sqlparser.NotStartsWithStr:
+		actual, ok := m.getValue(col)
+		if !ok {
+			return matchUnknown, nil
+		}
+		actualStr, isStr := actual.(string)
+		prefix, _ := value.(string)
+		if !isStr {
+			return matchNone, query.NewConverterError(
+				"%s: operator %q is not supported for %s",
+				query.InvalidExpressionErrMessage,
+				operator,
+				col.Alias,
+			)
+		}
+		return toMatchResult(strings.HasPrefix(actualStr, prefix) == (operator == sqlparser.StartsWithStr)), nil
+	default:
+		return m.ConvertComparisonExpr(operator, col, value)
+	}
 }
 
-func (m *mutableStateMatchEvaluator) evaluateOr(expr *sqlparser.OrExpr) (bool, error) {
-	if expr == nil {
-		return false, NewMatcherError("Or expression input expression cannot be nil")
+func (m *mutableStateMatchEvaluator) ConvertKeywordListComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (matchResult, error) {
+	actual, ok := m.getValue(col)
+	if !ok {
+		return matchUnknown, nil
 	}
-	if leftResult, err := m.evaluateExpression(expr.Left); err != nil || leftResult {
-		return leftResult, err
+	list, _ := actual.([]string)
+	var values []any
+	switch v := value.(type) {
+	case []any:
+		values = v
+	default:
+		values = []any{v}
 	}
-	// if left is false, then right must be evaluated
-	return m.evaluateExpression(expr.Right)
+	found := slices.ContainsFunc(values, func(v any) bool {
+		s, _ := v.(string)
+		return slices.Contains(list, s)
+	})
+	positive := operator == sqlparser.EqualStr || operator == sqlparser.InStr
+	return toMatchResult(found == positive), nil
 }
 
-func (m *mutableStateMatchEvaluator) evaluateComparison(expr *sqlparser.ComparisonExpr) (bool, error) {
-	if expr == nil {
-		return false, NewMatcherError("ComparisonExpr input expression cannot be nil")
+func (m *mutableStateMatchEvaluator) ConvertTextComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (matchResult, error) {
+	actual, ok := m.getValue(col)
+	if !ok {
+		return matchUnknown, nil
 	}
+	text, _ := actual.(string)
+	valueStr, _ := value.(string)
+	tokens := query.TokenizeTextQueryString(valueStr)
+	if len(tokens) == 0 {
+		return matchNone, query.NewConverterError(
+			"%s: unexpected value for Text type search attribute (no tokens found)",
+			query.InvalidExpressionErrMessage,
+		)
+	}
+	// Full text search matches if any of the query tokens is one of the text tokens.
+	textTokens := strings.Fields(strings.ToLower(text))
+	found := slices.ContainsFunc(tokens, func(token string) bool {
+		return slices.Contains(textTokens, strings.ToLower(token))
+	})
+	return toMatchResult(found == (operator == sqlparser.EqualStr)), nil
+}
 
-	colName, ok := expr.Left.(*sqlparser.ColName)
+func (m *mutableStateMatchEvaluator) ConvertRangeExpr(
+	operator string,
+	col *query.SAColumn,
+	from, to any,
+) (matchResult, error) {
+	actual, ok := m.getValue(col)
 	if !ok {
-		return false, fmt.Errorf("invalid filter name: %s", sqlparser.String(expr.Left))
+		return matchUnknown, nil
 	}
-	colNameStr := sqlparser.String(colName)
-	valExpr, ok := expr.Right.(*sqlparser.SQLVal)
-	if !ok {
-		return false, fmt.Errorf("invalid value: %s", sqlparser.String(expr.Right))
+	fromCmp, err := compareValues(actual, normalizeValue(col, from))
+	if err != nil {
+		return matchNone, err
 	}
-	valStr := sqlparser.String(valExpr)
+	toCmp, err := compareValues(actual, normalizeValue(col, to))
+	if err != nil {
+		return matchNone, err
+	}
+	between := fromCmp >= 0 && toCmp <= 0
+	return toMatchResult(between == (operator == sqlparser.BetweenStr)), nil
+}
 
-	switch colNameStr {
-	case workflowTypeNameColName:
-		val, err := sqlquery.ExtractStringValue(valStr)
-		if err != nil {
-			return false, err
+func (m *mutableStateMatchEvaluator) ConvertIsExpr(
+	operator string,
+	col *query.SAColumn,
+) (matchResult, error) {
+	_, ok := m.getValue(col)
+	return toMatchResult(ok == (operator == sqlparser.IsNotNullStr)), nil
+}
+
+// getValue returns the value of the search attribute in the mutable state, mirroring the values
+// recorded in visibility. Search attributes which are only recorded when the workflow closes are
+// not set for running workflows.
+func (m *mutableStateMatchEvaluator) getValue(col *query.SAColumn) (any, bool) {
+	executionInfo := m.executionInfo
+	executionState := m.executionState
+	isClosed := executionState.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	nonEmpty := func(s string) (any, bool) {
+		return s, s != ""
+	}
+
+	switch col.FieldName {
+	case sadefs.WorkflowID:
+		return nonEmpty(executionInfo.GetWorkflowId())
+	case sadefs.RunID:
+		return nonEmpty(executionState.GetRunId())
+	case sadefs.WorkflowType:
+		return nonEmpty(executionInfo.GetWorkflowTypeName())
+	case sadefs.TaskQueue:
+		return nonEmpty(executionInfo.GetTaskQueue())
+	case sadefs.ExecutionStatus:
+		return int64(executionState.GetStatus()), true
+	case sadefs.StartTime:
+		return executionState.GetStartTime().AsTime(), executionState.GetStartTime() != nil
+	case sadefs.ExecutionTime:
+		return executionInfo.GetExecutionTime().AsTime(), executionInfo.GetExecutionTime() != nil
+	case sadefs.ParentWorkflowID:
+		return nonEmpty(executionInfo.GetParentWorkflowId())
+	case sadefs.ParentRunID:
+		return nonEmpty(executionInfo.GetParentRunId())
+	case sadefs.RootWorkflowID:
+		return nonEmpty(executionInfo.GetRootWorkflowId())
+	case sadefs.RootRunID:
+		return nonEmpty(executionInfo.GetRootRunId())
+	case sadefs.CloseTime:
+		return executionInfo.GetCloseTime().AsTime(), isClosed && executionInfo.GetCloseTime() != nil
+	case sadefs.ExecutionDuration:
+		if !isClosed || executionInfo.GetCloseTime() == nil {
+			return nil, false
+		}
+		executionTime := executionInfo.GetExecutionTime().AsTime()
+		if executionInfo.GetExecutionTime() == nil {
+			executionTime = executionState.GetStartTime().AsTime()
+		}
+		return executionInfo.GetCloseTime().AsTime().Sub(executionTime).Nanoseconds(), true
+	case sadefs.HistoryLength:
+		if !isClosed {
+			return nil, false
 		}
-		return m.compareWorkflowType(val, expr.Operator)
-	case workflowIDColName:
-		val, err := sqlquery.ExtractStringValue(valStr)
+		currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.GetVersionHistories())
 		if err != nil {
-			return false, err
+			return nil, false
 		}
-		return m.compareWorkflowID(val, expr.Operator)
-	case workflowExecutionStatusColName:
-		val, err := sqlquery.ExtractStringValue(valStr)
+		lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
 		if err != nil {
-			return false, err
+			return nil, false
 		}
-		return m.compareWorkflowStatus(val, expr.Operator)
-	case workflowStartTimeColName:
-		return m.compareStartTime(valStr, expr.Operator)
+		return lastItem.GetEventId(), true
+	case sadefs.HistorySizeBytes:
+		return executionInfo.GetExecutionStats().GetHistorySize(), isClosed
+	case sadefs.StateTransitionCount:
+		return executionInfo.GetStateTransitionCount(), isClosed
+	}
 
-	default:
-		return false, fmt.Errorf("unknown or unsupported search attribute name: %s", colNameStr)
+	payload, ok := executionInfo.GetSearchAttributes()[col.FieldName]
+	if !ok {
+		return nil, false
 	}
+	value, err := sadefs.DecodeValue(payload, col.ValueType, false)
+	if err != nil || value == nil {
+		return nil, false
+	}
+	return value, true
 }
 
-func (m *mutableStateMatchEvaluator) evaluateRange(expr *sqlparser.RangeCond) (bool, error) {
-	if expr == nil {
-		return false, NewMatcherError("RangeCond input expression cannot be nil")
+// normalizeValue converts the ExecutionStatus values parsed by the query converter to the enum
+// values, which are compared as integers in the same way as in SQL visibility.
+func normalizeValue(col *query.SAColumn, value any) any {
+	if col.FieldName != sadefs.ExecutionStatus {
+		return value
 	}
-	colName, ok := expr.Left.(*sqlparser.ColName)
-	if !ok {
-		return false, fmt.Errorf("unknown or unsupported search attribute name: %s", sqlparser.String(expr.Left))
+	switch v := value.(type) {
+	case string:
+		// Query converter already validates the value, so there should not be any errors here.
+		status, _ := enumspb.WorkflowExecutionStatusFromString(v)
+		return int64(status)
+	case []any:
+		res := make([]any, len(v))
+		for i, item := range v {
+			res[i] = normalizeValue(col, item)
+		}
+		return res
+	default:
+		return value
 	}
-	colNameStr := sqlparser.String(colName)
+}
 
-	switch colNameStr {
-	case workflowStartTimeColName:
-		fromValue, err := sqlquery.ConvertToTime(sqlparser.String(expr.From))
-		if err != nil {
-			return false, err
+// compareValues compares the value of a search attribute in the mutable state with a value parsed
+// by the query converter and returns -1, 0 or +1.
+func compareValues(actual any, expected any) (int, error) {
+	switch a := actual.(type) {
+	case string:
+		if e, ok := expected.(string); ok {
+			return strings.Compare(a, e), nil
 		}
-		toValue, err := sqlquery.ConvertToTime(sqlparser.String(expr.To))
-		if err != nil {
-			return false, err
+	case int64:
+		switch e := expected.(type) {
+		case int64:
+			return compareOrdered(a, e), nil
+		case float64:
+			return compareOrdered(float64(a), e), nil
 		}
-
-		switch expr.Operator {
-		case sqlparser.BetweenStr:
-			return m.compareStartTimeBetween(fromValue, toValue)
-		case sqlparser.NotBetweenStr:
-			result, err := m.compareStartTimeBetween(fromValue, toValue)
-			return !result, err
-		default:
-			// should never happen
-			return false, NewMatcherError("%s: range condition operator must be 'between' or 'not between'. Got %s",
-				invalidExpressionErrMessage, expr.Operator)
+	case float64:
+		switch e := expected.(type) {
+		case int64:
+			return compareOrdered(a, float64(e)), nil
+		case float64:
+			return compareOrdered(a, e), nil
+		}
+	case bool:
+		if e, ok := expected.(bool); ok {
+			return compareOrdered(boolToInt(a), boolToInt(e)), nil
+		}
+	case time.Time:
+		if e, ok := expected.(string); ok {
+			expectedTime, err := time.Parse(time.RFC3339Nano, e)
+			if err != nil {
+				return 0, query.NewConverterError("%s: unable to parse datetime '%s'", query.InvalidExpressionErrMessage, e)
+			}
+			return a.Compare(expectedTime), nil
 		}
-
-	default:
-		return false, fmt.Errorf("unknown or unsupported search attribute name: %s", colNameStr)
 	}
+	return 0, query.NewConverterError(
+		"%s: cannot compare value %v (type: %T) with %v (type: %T)",
+		query.InvalidExpressionErrMessage,
+		actual,
+		actual,
+		expected,
+		expected,
+	)
 }
 
-func (m *mutableStateMatchEvaluator) compareWorkflowType(workflowType string, operation string) (bool, error) {
-	existingWorkflowType := m.executionInfo.WorkflowTypeName
-	return compareQueryString(existingWorkflowType, workflowType, operation, workflowTypeNameColName)
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
 }
 
-func (m *mutableStateMatchEvaluator) compareWorkflowID(workflowID string, operation string) (bool, error) {
-	existingWorkflowId := m.executionInfo.WorkflowId
-	return compareQueryString(workflowID, existingWorkflowId, operation, workflowIDColName)
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
 }
 
-func (m *mutableStateMatchEvaluator) compareWorkflowStatus(status string, operation string) (bool, error) {
-	if len(status) == 0 {
-		return false, NewMatcherError("workflow status cannot be empty")
-	}
-	msStatus := m.executionState.Status.String()
-	switch operation {
+func evaluateComparison(operator string, cmp int) bool {
+	switch operator {
 	case sqlparser.EqualStr:
-		return msStatus == status, nil
+		return cmp == 0
 	case sqlparser.NotEqualStr:
-		return msStatus != status, nil
-	default:
-		return false, NewMatcherError("%s: operation %s is not supported for execution status filter", invalidExpressionErrMessage, operation)
-	}
-}
-
-func (m *mutableStateMatchEvaluator) compareStartTime(val string, operation string) (bool, error) {
-	expectedTime, err := sqlquery.ConvertToTime(val)
-	if err != nil {
-		return false, err
-	}
-	startTime := m.executionState.StartTime.AsTime()
-	switch operation {
-	case sqlparser.GreaterEqualStr:
-		return startTime.Compare(expectedTime) >= 0, nil
+		return cmp != 0
+	case sqlparser.LessThanStr:
+		return cmp < 0
 	case sqlparser.LessEqualStr:
-		return startTime.Compare(expectedTime) <= 0, nil
+		return cmp <= 0
 	case sqlparser.GreaterThanStr:
-		return startTime.After(expectedTime), nil
-	case sqlparser.LessThanStr:
-		return startTime.Before(expectedTime), nil
-	case sqlparser.EqualStr:
-		return startTime == expectedTime, nil
-	case sqlparser.NotEqualStr:
-		return startTime != expectedTime, nil
+		return cmp > 0
+	case sqlparser.GreaterEqualStr:
+		return cmp >= 0
 	default:
-		return false, NewMatcherError("%s: operation %s is not supported for StartTime", invalidExpressionErrMessage, operation)
+		// the query converter only allows the operators above
+		return false
 	}
 }
 
-func (m *mutableStateMatchEvaluator) compareStartTimeBetween(fromTime time.Time, toTime time.Time) (bool, error) {
-	startTime := m.executionState.StartTime.AsTime()
-	lc := startTime.Compare(fromTime)
-	rc := startTime.Compare(toTime)
-	return lc >= 0 && rc <= 0, nil
+func toMatchResult(b bool) matchResult {
+	if b {
+		return matchTrue
+	}
+	return matchFalse
 }
@@ -3,10 +3,15 @@ package matcher
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/searchattribute/sadefs"
 	"go.temporal.io/server/common/sqlquery"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -220,7 +225,7 @@ func TestBasicMutableStateMatchEvaluator(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			match, err := MatchMutableState(we, ws, tt.query)
+			match, err := MatchMutableState(we, ws, tt.query, testNamespaceName, searchattribute.TestNameTypeMap(), &searchattribute.TestMapper{})
 			if tt.expectedError {
 				assert.Error(t, err)
 			} else {
@@ -298,7 +303,106 @@ func TestAdvancedMutableStateMatchEvaluator(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			match, err := MatchMutableState(we, ws, tt.query)
+			match, err := MatchMutableState(we, ws, tt.query, testNamespaceName, searchattribute.TestNameTypeMap(), &searchattribute.TestMapper{})
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedMatch, match)
+			}
+		})
+	}
+}
+
+const testNamespaceName = namespace.Name("test-namespace")
+
+func TestSearchAttributesMutableStateMatchEvaluator(t *testing.T) {
+	startTime := time.Date(2023, 10, 26, 14, 30, 0, 0, time.UTC)
+	ws := &persistencespb.WorkflowExecutionState{
+		RunId:     "run_id",
+		StartTime: timestamppb.New(startTime),
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}
+	we := &persistencespb.WorkflowExecutionInfo{
+		WorkflowId:       "workflow_id",
+		WorkflowTypeName: "workflow_type",
+		TaskQueue:        "task_queue",
+		ExecutionTime:    timestamppb.New(startTime),
+		SearchAttributes: map[string]*commonpb.Payload{
+			"Keyword01":     sadefs.MustEncodeValue("foo", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+			"KeywordList01": sadefs.MustEncodeValue([]string{"foo", "bar"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST),
+			"Int01":         sadefs.MustEncodeValue(int64(10), enumspb.INDEXED_VALUE_TYPE_INT),
+			"Double01":      sadefs.MustEncodeValue(1.5, enumspb.INDEXED_VALUE_TYPE_DOUBLE),
+			"Bool01":        sadefs.MustEncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL),
+			"Datetime01":    sadefs.MustEncodeValue(startTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+			"Text01":        sadefs.MustEncodeValue("the quick brown fox", enumspb.INDEXED_VALUE_TYPE_TEXT),
+			sadefs.TemporalChangeVersion: sadefs.MustEncodeValue(
+				[]string{"change-1"},
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+			),
+			sadefs.BuildIds: sadefs.MustEncodeValue(
+				[]string{"versioned:build-1"},
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+			),
+		},
+	}
+
+	tests := []struct {
+		query         string
+		expectedMatch bool
+		expectedError bool
+	}{
+		{query: "RunId = 'run_id'", expectedMatch: true},
+		{query: "TaskQueue = 'task_queue'", expectedMatch: true},
+		{query: "TaskQueue IN ('other', 'task_queue')", expectedMatch: true},
+		{query: "TaskQueue NOT IN ('other', 'task_queue')", expectedMatch: false},
+		{query: "ExecutionTime = '2023-10-26T14:30:00Z'", expectedMatch: true},
+		{query: "ExecutionStatus IN ('Running', 'Completed')", expectedMatch: true},
+		{query: "ExecutionStatus < 'Completed'", expectedMatch: true},
+		{query: "ExecutionStatus > 'Running'", expectedMatch: false},
+		{query: "AliasForKeyword01 = 'foo'", expectedMatch: true},
+		{query: "AliasForKeyword01 STARTS_WITH 'fo'", expectedMatch: true},
+		{query: "AliasForKeyword01 NOT STARTS_WITH 'fo'", expectedMatch: false},
+		{query: "AliasForKeyword01 IS NOT NULL", expectedMatch: true},
+		{query: "AliasForKeyword02 IS NULL", expectedMatch: true},
+		{query: "AliasForKeyword02 = 'foo'", expectedMatch: false},
+		{query: "AliasForKeyword02 != 'foo'", expectedMatch: false},
+		{query: "NOT (AliasForKeyword02 = 'foo')", expectedMatch: false},
+		{query: "AliasForKeyword02 = 'foo' OR AliasForKeyword01 = 'foo'", expectedMatch: true},
+		{query: "AliasForKeywordList01 = 'foo'", expectedMatch: true},
+		{query: "AliasForKeywordList01 = 'baz'", expectedMatch: false},
+		{query: "AliasForKeywordList01 != 'baz'", expectedMatch: true},
+		{query: "AliasForKeywordList01 IN ('baz', 'bar')", expectedMatch: true},
+		{query: "AliasForKeywordList01 NOT IN ('baz', 'bar')", expectedMatch: false},
+		{query: "AliasForInt01 = 10", expectedMatch: true},
+		{query: "AliasForInt01 > 10", expectedMatch: false},
+		{query: "AliasForInt01 BETWEEN 5 AND 15", expectedMatch: true},
+		{query: "AliasForInt01 NOT BETWEEN 5 AND 15", expectedMatch: false},
+		{query: "AliasForInt01 IN (1, 10)", expectedMatch: true},
+		{query: "AliasForDouble01 > 1", expectedMatch: true},
+		{query: "AliasForDouble01 <= 1.4", expectedMatch: false},
+		{query: "AliasForBool01 = true", expectedMatch: true},
+		{query: "AliasForBool01 = false", expectedMatch: false},
+		{query: "AliasForDatetime01 >= '2023-10-26T14:30:00Z'", expectedMatch: true},
+		{query: "AliasForDatetime01 < '2023-10-26T14:30:00Z'", expectedMatch: false},
+		{query: "AliasForText01 = 'quick'", expectedMatch: true},
+		{query: "AliasForText01 = 'slow'", expectedMatch: false},
+		{query: "AliasForText01 != 'slow'", expectedMatch: true},
+		{query: "TemporalChangeVersion = 'change-1'", expectedMatch: true},
+		{query: "BuildIds = 'versioned:build-1'", expectedMatch: true},
+		{query: "BuildIds = 'versioned:build-2'", expectedMatch: false},
+		{query: "CloseTime IS NULL", expectedMatch: true},
+		{query: "ExecutionDuration > '1s'", expectedMatch: false},
+		{query: "AliasForKeyword01 = 'foo' ORDER BY StartTime", expectedError: true},
+		{query: "AliasForUnknown = 'foo'", expectedError: true},
+		{query: "AliasForInt01 = 'foo'", expectedError: true},
+		{query: "AliasForInt01 STARTS_WITH 'foo'", expectedError: true},
+		{query: "ExecutionStatus STARTS_WITH 'Run'", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			match, err := MatchMutableState(we, ws, tt.query, testNamespaceName, searchattribute.TestNameTypeMap(), &searchattribute.TestMapper{})
 			if tt.expectedError {
 				assert.Error(t, err)
 			} else {
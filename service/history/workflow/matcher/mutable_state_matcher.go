@@ -2,31 +2,33 @@ package matcher
 
 import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 /*
 MatchMutableState matches the given query with the given mutable state.
-The query should be a valid SQL query with WHERE clause.
-The supported fields are:
-  - WorkflowId
-  - WorkflowType
-  - StartTime
+The query should be a valid visibility query (the WHERE clause only).
+All system search attributes and the custom search attributes stored in the mutable state
+are supported, e.g.:
+  - WorkflowId, RunId, WorkflowType, TaskQueue
+  - StartTime, ExecutionTime, CloseTime, ExecutionDuration
   - ExecutionStatus
+  - TemporalChangeVersion, BuildIds
+  - custom search attributes of any type (by alias)
 
-Field names are case-sensitive.
+The query is parsed and validated by the same query converter used by visibility, and the
+operators have the same semantics as in SQL visibility:
+  - =, !=, >, >=, <, <=, IN, NOT IN, BETWEEN, NOT BETWEEN
+  - STARTS_WITH, NOT STARTS_WITH for Keyword search attributes
+  - = and != for KeywordList search attributes mean "contains" and "does not contain"
+  - IS NULL, IS NOT NULL
+  - conditions on search attributes which are not set are neither true nor false (NULL)
 
-The query can have multiple conditions combined with AND/OR.
-The query can have conditions on multiple fields.
 Date time fields should be in RFC3339 format.
 Example query:
 
-	"WHERE WorkflowId = 'some_workflow_id' AND StartTime > '2023-10-26T14:30:00Z'"
-
-Different fields can support different operators:
-  - WorkflowId: =, !=, starts_with, not starts_with
-  - WorkflowType: =, !=, starts_with, not starts_with
-  - StartTime: =, !=, >, >=, <, <=
-  - ExecutionStatus: =, !=
+	"WorkflowId = 'some_workflow_id' AND StartTime > '2023-10-26T14:30:00Z' AND CustomKeywordField IN ('a', 'b')"
 
 Returns true if the query matches the mutable state, false otherwise, or error if the query is invalid.
 */
@@ -34,7 +36,10 @@ func MatchMutableState(
 	executionInfo *persistencespb.WorkflowExecutionInfo,
 	executionState *persistencespb.WorkflowExecutionState,
 	query string,
+	nsName namespace.Name,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapper searchattribute.Mapper,
 ) (bool, error) {
-	evaluator := newMutableStateMatchEvaluator(executionInfo, executionState)
+	evaluator := newMutableStateMatchEvaluator(executionInfo, executionState, nsName, saTypeMap, saMapper)
 	return evaluator.Evaluate(query)
 }
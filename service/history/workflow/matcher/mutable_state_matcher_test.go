@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/sqlquery"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -71,7 +72,7 @@ func TestMatchMutableState(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			match, err := MatchMutableState(we, ws, tt.query)
+			match, err := MatchMutableState(we, ws, tt.query, testNamespaceName, searchattribute.TestNameTypeMap(), &searchattribute.TestMapper{})
 			assert.Equal(t, tt.expectedMatch, match)
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -72,6 +72,7 @@ import (
 	"go.temporal.io/server/service/history/hsm"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/workflow/matcher"
 	"go.temporal.io/server/service/history/workflow/update"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -273,6 +274,17 @@ type (
 
 		// Tracks all events added via the AddHistoryEvent method that is used by the state machine framework.
 		currentTransactionAddedStateMachineEventTypes []enumspb.EventType
+		// Tracks the events of the current transaction which may match the triggers of workflow rule extensions.
+		currentTransactionWorkflowRuleTriggers []workflowRuleTrigger
+	}
+
+	// workflowRuleTrigger is an event which may match the trigger of a workflow rule extension. Failed workflow tasks
+	// are tracked by cause because the events of transient workflow tasks are not written to the history.
+	workflowRuleTrigger struct {
+		eventType               enumspb.EventType
+		workflowTaskFailedCause enumspb.WorkflowTaskFailedCause
+		timerID                 string
+		signalName              string
 	}
 
 	lastUpdatedStateTransitionGetter interface {
@@ -763,7 +775,9 @@ func (ms *MutableStateImpl) GetNexusUpdateCompletion(
 		}
 		closeTime = ce.GetEventTime().AsTime()
 	} else {
-		outcome = cevent.GetWorkflowExecutionUpdateCompletedEventAttributes().GetOutcome()
+		if outcome, err = ms.getUpdateOutcomeFromEvent(updateID, cevent); err != nil {
+			return nexusrpc.CompleteOperationOptions{}, err
+		}
 		closeTime = cevent.GetEventTime().AsTime()
 	}
 
@@ -1477,6 +1491,60 @@ func (ms *MutableStateImpl) GetQueryRegistry() historyi.QueryRegistry {
 	return ms.QueryRegistry
 }
 
+// GetCachedQueryResult returns the cached result of a query on a closed workflow, or nil if there
+// is no unexpired result for the key.
+func (ms *MutableStateImpl) GetCachedQueryResult(key string) *commonpb.Payloads {
+	cached, ok := ms.executionInfo.CachedQueryResults[key]
+	if !ok || !cached.GetExpirationTime().AsTime().After(ms.timeSource.Now()) {
+		return nil
+	}
+	return cached.GetResult()
+}
+
+// CacheQueryResult caches the result of a query on a closed workflow. Expired results are dropped
+// and, if there are still more than maxEntries, the ones closest to expiry are evicted. The cache is
+// cluster local, so it does not move mutable state to a new versioned transition.
+func (ms *MutableStateImpl) CacheQueryResult(
+	key string,
+	result *commonpb.Payloads,
+	ttl time.Duration,
+	maxEntries int,
+) {
+	prevExecutionInfoSize := ms.executionInfo.Size()
+	defer func() {
+		ms.approximateSize += ms.executionInfo.Size() - prevExecutionInfoSize
+	}()
+
+	now := ms.timeSource.Now()
+	if ms.executionInfo.CachedQueryResults == nil {
+		ms.executionInfo.CachedQueryResults = make(map[string]*persistencespb.CachedQueryResult, 1)
+	}
+	ms.executionInfo.CachedQueryResults[key] = &persistencespb.CachedQueryResult{
+		Result:         result,
+		ExpirationTime: timestamppb.New(now.Add(ttl)),
+	}
+
+	keys := make([]string, 0, len(ms.executionInfo.CachedQueryResults))
+	for k, cached := range ms.executionInfo.CachedQueryResults {
+		if !cached.GetExpirationTime().AsTime().After(now) {
+			delete(ms.executionInfo.CachedQueryResults, k)
+			continue
+		}
+		keys = append(keys, k)
+	}
+	if len(keys) <= maxEntries {
+		return
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		expirationA := ms.executionInfo.CachedQueryResults[a].GetExpirationTime().AsTime()
+		expirationB := ms.executionInfo.CachedQueryResults[b].GetExpirationTime().AsTime()
+		return cmp.Or(expirationA.Compare(expirationB), strings.Compare(a, b))
+	})
+	for _, k := range keys[:len(keys)-maxEntries] {
+		delete(ms.executionInfo.CachedQueryResults, k)
+	}
+}
+
 // VisitUpdates visits mutable state update entries, ordered by the ID of the history event pointed to by the mutable
 // state entry. Thus, for example, updates entries in Admitted state will be visited in the order that their Admitted
 // events were added to history.
@@ -1512,11 +1580,37 @@ func (ms *MutableStateImpl) GetUpdateOutcome(
 	ctx context.Context,
 	updateID string,
 ) (*updatepb.Outcome, error) {
+	if completion := ms.executionInfo.GetUpdateInfos()[updateID].GetCompletion(); completion.GetCompacted() {
+		return ms.getRetainedUpdateOutcome(completion)
+	}
 	event, err := ms.getUpdateOutcomeEvent(ctx, updateID)
 	if err != nil {
 		return nil, err
 	}
-	return event.GetWorkflowExecutionUpdateCompletedEventAttributes().GetOutcome(), nil
+	return ms.getUpdateOutcomeFromEvent(updateID, event)
+}
+
+// getUpdateOutcomeFromEvent returns the outcome of an update from its completed event, or the
+// retained outcome if the event was compacted.
+func (ms *MutableStateImpl) getUpdateOutcomeFromEvent(
+	updateID string,
+	event *historypb.HistoryEvent,
+) (*updatepb.Outcome, error) {
+	outcome := event.GetWorkflowExecutionUpdateCompletedEventAttributes().GetOutcome()
+	if !update.IsCompactedOutcome(outcome) {
+		return outcome, nil
+	}
+	return ms.getRetainedUpdateOutcome(ms.executionInfo.GetUpdateInfos()[updateID].GetCompletion())
+}
+
+// getRetainedUpdateOutcome returns the outcome of an update whose completed event was compacted.
+func (ms *MutableStateImpl) getRetainedUpdateOutcome(
+	completion *persistencespb.UpdateCompletionInfo,
+) (*updatepb.Outcome, error) {
+	if completion.GetOutcome() == nil || !completion.GetOutcomeExpirationTime().AsTime().After(ms.timeSource.Now()) {
+		return nil, update.OutcomeNotRetainedErr
+	}
+	return completion.GetOutcome(), nil
 }
 
 func (ms *MutableStateImpl) getUpdateOutcomeEvent(
@@ -2794,6 +2888,7 @@ func (ms *MutableStateImpl) addWorkflowExecutionStartedEventForContinueAsNew(
 	if err != nil {
 		return nil, err
 	}
+	ms.executionInfo.LocalOnly = previousExecutionInfo.GetLocalOnly()
 	var parentClock *clockspb.VectorClock
 	if parentExecutionInfo != nil {
 		parentClock = parentExecutionInfo.Clock
@@ -2901,6 +2996,17 @@ func (ms *MutableStateImpl) AddWorkflowExecutionStartedEventWithOptions(
 		return nil, err
 	}
 
+	// Runs continuing a workflow chain inherit the decision of the previous run, see
+	// addWorkflowExecutionStartedEventForContinueAsNew and SetupNewWorkflowForRetryOrCron.
+	if ms.namespaceEntry.IsGlobalNamespace() && prevRunID == "" {
+		ms.executionInfo.LocalOnly = isExcludedFromReplication(
+			ms.config.NamespaceReplicationFilter(ms.namespaceEntry.Name().String()),
+			ms.namespaceEntry,
+			ms.executionInfo.WorkflowTypeName,
+			ms.executionInfo.SearchAttributes,
+		)
+	}
+
 	// TODO merge active & passive task generation
 	var err error
 	ms.executionInfo.WorkflowExecutionTimerTaskStatus, err = ms.taskGenerator.GenerateWorkflowStartTasks(
@@ -4114,7 +4220,9 @@ func (ms *MutableStateImpl) AddWorkflowTaskFailedEvent(
 	if err := ms.checkMutability(opTag); err != nil {
 		return nil, err
 	}
-	return ms.workflowTaskManager.AddWorkflowTaskFailedEvent(
+	// The workflow task manager updates the attempt of the failed workflow task to the next attempt.
+	attempt := workflowTask.Attempt
+	event, err := ms.workflowTaskManager.AddWorkflowTaskFailedEvent(
 		workflowTask,
 		cause,
 		failure,
@@ -4125,6 +4233,18 @@ func (ms *MutableStateImpl) AddWorkflowTaskFailedEvent(
 		newRunID,
 		forkEventVersion,
 	)
+	if err != nil {
+		return nil, err
+	}
+	// Only the first failure of a workflow task triggers workflow rules. Retries of a failing workflow task are
+	// transient and would otherwise take the rule actions, e.g. a signal, on every attempt.
+	if attempt <= 1 {
+		ms.currentTransactionWorkflowRuleTriggers = append(ms.currentTransactionWorkflowRuleTriggers, workflowRuleTrigger{
+			eventType:               enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED,
+			workflowTaskFailedCause: cause,
+		})
+	}
+	return event, nil
 }
 
 // AddWorkflowExecutionTimeSkippingTransitionedEvent adds a workflow execution time skipping transitioned event to the mutable state.
@@ -5447,6 +5567,10 @@ func (ms *MutableStateImpl) AddTimerFiredEvent(
 	if err := ms.ApplyTimerFiredEvent(event); err != nil {
 		return nil, err
 	}
+	ms.currentTransactionWorkflowRuleTriggers = append(ms.currentTransactionWorkflowRuleTriggers, workflowRuleTrigger{
+		eventType: enumspb.EVENT_TYPE_TIMER_FIRED,
+		timerID:   timerID,
+	})
 	return event, nil
 }
 
@@ -5724,16 +5848,85 @@ func (ms *MutableStateImpl) AddWorkflowExecutionUpdateCompletedEvent(
 	if err := ms.checkMutability(tag.WorkflowActionUpdateCompleted); err != nil {
 		return nil, err
 	}
-	event, batchID := ms.hBuilder.AddWorkflowExecutionUpdateCompletedEvent(acceptedEventID, updResp)
-	if err := ms.ApplyWorkflowExecutionUpdateCompletedEvent(event, batchID); err != nil {
+	// With update outcome compaction the event is written without payloads and marked as
+	// compacted, and the full outcome is retained in mutable state instead. The retained outcome
+	// is not part of the event, so it is lost when mutable state is rebuilt from events, e.g. on
+	// reset or on a standby cluster that replicates events.
+	var retainedOutcome *updatepb.Outcome
+	eventResp := updResp
+	if ms.config.EnableUpdateOutcomeCompaction(ms.GetNamespaceEntry().Name().String()) {
+		retainedOutcome = updResp.GetOutcome()
+		eventResp = &updatepb.Response{Meta: updResp.GetMeta(), Outcome: update.CompactOutcome(updResp.GetOutcome())}
+	}
+	event, batchID := ms.hBuilder.AddWorkflowExecutionUpdateCompletedEvent(acceptedEventID, eventResp)
+	if err := ms.applyWorkflowExecutionUpdateCompletedEvent(event, batchID, retainedOutcome); err != nil {
 		return nil, err
 	}
 	return event, nil
 }
 
+// evictRetainedUpdateOutcomes evicts expired outcomes of compacted updates and, while too many
+// remain or they take up too many bytes, the ones closest to expiry.
+func (ms *MutableStateImpl) evictRetainedUpdateOutcomes() {
+	namespaceName := ms.GetNamespaceEntry().Name().String()
+	now := ms.timeSource.Now()
+
+	var retained []string
+	var retainedSize int
+	for id, info := range ms.executionInfo.UpdateInfos {
+		if info.GetCompletion().GetOutcome() == nil {
+			continue
+		}
+		if !info.GetCompletion().GetOutcomeExpirationTime().AsTime().After(now) {
+			ms.evictUpdateOutcome(id)
+			continue
+		}
+		retained = append(retained, id)
+		retainedSize += info.GetCompletion().GetOutcome().Size()
+	}
+	maxRetained := ms.config.MaxRetainedUpdateOutcomes(namespaceName)
+	maxRetainedSize := ms.config.MaxRetainedUpdateOutcomesSizeBytes(namespaceName)
+	if len(retained) <= maxRetained && retainedSize <= maxRetainedSize {
+		return
+	}
+	slices.SortFunc(retained, func(a, b string) int {
+		expirationA := ms.executionInfo.UpdateInfos[a].GetCompletion().GetOutcomeExpirationTime().AsTime()
+		expirationB := ms.executionInfo.UpdateInfos[b].GetCompletion().GetOutcomeExpirationTime().AsTime()
+		return cmp.Or(expirationA.Compare(expirationB), strings.Compare(a, b))
+	})
+	retainedCount := len(retained)
+	for _, id := range retained {
+		if retainedCount <= maxRetained && retainedSize <= maxRetainedSize {
+			return
+		}
+		retainedCount--
+		retainedSize -= ms.executionInfo.UpdateInfos[id].GetCompletion().GetOutcome().Size()
+		ms.evictUpdateOutcome(id)
+	}
+}
+
+func (ms *MutableStateImpl) evictUpdateOutcome(updateID string) {
+	ui := ms.executionInfo.UpdateInfos[updateID]
+	sizeBefore := ui.Size()
+	ui.GetCompletion().Outcome = nil
+	ui.GetCompletion().OutcomeExpirationTime = nil
+	ms.approximateSize += ui.Size() - sizeBefore
+	ms.updateInfoUpdated[updateID] = struct{}{}
+}
+
 func (ms *MutableStateImpl) ApplyWorkflowExecutionUpdateCompletedEvent(
 	event *historypb.HistoryEvent,
 	batchID int64,
+) error {
+	return ms.applyWorkflowExecutionUpdateCompletedEvent(event, batchID, nil)
+}
+
+// applyWorkflowExecutionUpdateCompletedEvent applies the event and, if the event was compacted
+// and the outcome is known, retains the outcome in mutable state.
+func (ms *MutableStateImpl) applyWorkflowExecutionUpdateCompletedEvent(
+	event *historypb.HistoryEvent,
+	batchID int64,
+	retainedOutcome *updatepb.Outcome,
 ) error {
 	attrs := event.GetWorkflowExecutionUpdateCompletedEventAttributes()
 	if attrs == nil {
@@ -5751,15 +5944,26 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionUpdateCompletedEvent(
 		return serviceerror.NewInvalidArgument("WorkflowExecutionUpdateCompletedEvent doesn't have preceding WorkflowExecutionUpdateAcceptedEvent")
 	}
 	sizeBefore := ui.Size()
+	completion := &persistencespb.UpdateCompletionInfo{
+		EventId:      event.EventId,
+		EventBatchId: batchID,
+		Compacted:    update.IsCompactedOutcome(attrs.GetOutcome()),
+	}
+	if completion.Compacted && retainedOutcome != nil {
+		completion.Outcome = retainedOutcome
+		completion.OutcomeExpirationTime = timestamppb.New(
+			ms.timeSource.Now().Add(ms.config.UpdateOutcomeRetention(ms.GetNamespaceEntry().Name().String())),
+		)
+	}
 	ui.Value = &persistencespb.UpdateInfo_Completion{
-		Completion: &persistencespb.UpdateCompletionInfo{
-			EventId:      event.EventId,
-			EventBatchId: batchID,
-		},
+		Completion: completion,
 	}
 	sizeDelta = ui.Size() - sizeBefore
 	ms.approximateSize += sizeDelta
 	ms.updateInfoUpdated[updateID] = struct{}{}
+	if completion.GetOutcome() != nil {
+		ms.evictRetainedUpdateOutcomes()
+	}
 	if ms.ChasmEnabled() {
 		if err := ms.processUpdateCallbacks(updateID); err != nil {
 			return err
@@ -6125,6 +6329,10 @@ func (ms *MutableStateImpl) AddWorkflowExecutionSignaledEvent(
 	if err := ms.ApplyWorkflowExecutionSignaled(event); err != nil {
 		return nil, err
 	}
+	ms.currentTransactionWorkflowRuleTriggers = append(ms.currentTransactionWorkflowRuleTriggers, workflowRuleTrigger{
+		eventType:  enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		signalName: signalName,
+	})
 	return event, nil
 }
 
@@ -6775,7 +6983,7 @@ func (ms *MutableStateImpl) RetryActivity(
 
 	// check workflow rules
 	if !ai.Paused {
-		ActivityMatchWorkflowRules(ms, ms.timeSource, ms.logger, ai)
+		ActivityMatchWorkflowRules(ms.shard, ms, ms.timeSource, ms.logger, ai)
 	}
 
 	// if activity is paused
@@ -7641,6 +7849,14 @@ func (ms *MutableStateImpl) closeTransaction(
 		return closeTransactionResult{}, err
 	}
 
+	// Workflow rule actions may pause the workflow or signal it, so they must be taken before the workflow task is
+	// scheduled.
+	if err := ms.closeTransactionHandleWorkflowRules(
+		transactionPolicy,
+	); err != nil {
+		return closeTransactionResult{}, err
+	}
+
 	if err := ms.closeTransactionHandleWorkflowTask(
 		transactionPolicy,
 	); err != nil {
@@ -7755,6 +7971,174 @@ func (ms *MutableStateImpl) closeTransaction(
 	}, nil
 }
 
+// closeTransactionHandleWorkflowRules takes the actions of the workflow rule extensions whose trigger matches an event
+// of the transaction, or whose history size threshold was reached since the previous transaction.
+func (ms *MutableStateImpl) closeTransactionHandleWorkflowRules(
+	transactionPolicy historyi.TransactionPolicy,
+) error {
+	if transactionPolicy == historyi.TransactionPolicyPassive ||
+		!ms.IsWorkflowExecutionRunning() {
+		return nil
+	}
+	rules := ms.namespaceEntry.GetWorkflowRules()
+	if len(rules) == 0 {
+		return nil
+	}
+
+	// Events added by the actions below don't trigger rules.
+	triggers := ms.currentTransactionWorkflowRuleTriggers
+	ms.currentTransactionWorkflowRuleTriggers = nil
+
+	historySize := ms.GetHistorySize()
+	previousHistorySize := ms.executionInfo.GetWorkflowRulesHistorySize()
+	hasHistorySizeTrigger := false
+	signaled := false
+	now := ms.timeSource.Now()
+
+	for _, rule := range rules {
+		extension, ok := ms.namespaceEntry.GetWorkflowRuleExtension(rule.GetSpec().GetId())
+		if !ok {
+			continue
+		}
+		if threshold := extension.GetHistorySize().GetThresholdBytes(); threshold > 0 {
+			hasHistorySizeTrigger = true
+			if previousHistorySize >= threshold || historySize < threshold {
+				continue
+			}
+		} else if !slices.ContainsFunc(triggers, func(trigger workflowRuleTrigger) bool {
+			return trigger.matches(extension)
+		}) {
+			continue
+		}
+		expirationTime := rule.GetSpec().GetExpirationTime()
+		if expirationTime != nil && expirationTime.AsTime().Before(now) {
+			// the rule is expired
+			continue
+		}
+		if visibilityQuery := rule.GetSpec().GetVisibilityQuery(); visibilityQuery != "" {
+			match, err := ms.matchWorkflowRuleVisibilityQuery(visibilityQuery)
+			if err != nil {
+				logError(ms.logger, "error matching workflow rule", ms.executionInfo, ms.executionState, tag.Error(err))
+				continue
+			}
+			if !match {
+				continue
+			}
+		}
+
+		recordWorkflowRuleHit(ms.shard, ms, rule)
+		ruleSignaled, err := ms.takeWorkflowRuleExtensionActions(rule, extension)
+		if err != nil {
+			return err
+		}
+		signaled = signaled || ruleSignaled
+	}
+
+	if hasHistorySizeTrigger && historySize != previousHistorySize {
+		ms.executionInfo.WorkflowRulesHistorySize = historySize
+	}
+	if signaled && !ms.HasPendingWorkflowTask() && !ms.IsWorkflowExecutionStatusPaused() {
+		if _, err := ms.AddWorkflowTaskScheduledEvent(
+			false,
+			enumsspb.WORKFLOW_TASK_TYPE_NORMAL,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// takeWorkflowRuleExtensionActions takes the actions of a matched workflow rule extension and returns true if one of
+// them signaled the workflow.
+func (ms *MutableStateImpl) takeWorkflowRuleExtensionActions(
+	rule *rulespb.WorkflowRule,
+	extension *persistencespb.WorkflowRuleExtension,
+) (bool, error) {
+	ruleID := rule.GetSpec().GetId()
+	signaled := false
+	for _, action := range extension.GetActions() {
+		switch variant := action.GetVariant().(type) {
+		case *persistencespb.WorkflowRuleExtensionAction_PauseWorkflow:
+			if ms.IsWorkflowExecutionStatusPaused() {
+				continue
+			}
+			reason := variant.PauseWorkflow.GetReason()
+			if reason == "" {
+				reason = fmt.Sprintf("paused by workflow rule %s", ruleID)
+			}
+			if _, err := ms.AddWorkflowExecutionPausedEvent(
+				consts.IdentityHistoryService,
+				reason,
+				uuid.NewString(),
+			); err != nil {
+				return false, err
+			}
+		case *persistencespb.WorkflowRuleExtensionAction_Signal:
+			if _, err := ms.AddWorkflowExecutionSignaled(
+				variant.Signal.GetSignalName(),
+				variant.Signal.GetInput(),
+				consts.IdentityHistoryService,
+				nil,
+				uuid.NewString(),
+				nil,
+			); err != nil {
+				return false, err
+			}
+			signaled = true
+		case *persistencespb.WorkflowRuleExtensionAction_Metric:
+			metrics.WorkflowRuleActionMetric.With(
+				ms.shard.GetMetricsHandler().WithTags(
+					metrics.NamespaceTag(ms.namespaceEntry.Name().String()),
+					metrics.WorkflowRuleIDTag(ruleID),
+					metrics.WorkflowRuleMetricNameTag(variant.Metric.GetName()),
+				),
+			).Record(1)
+		case *persistencespb.WorkflowRuleExtensionAction_MemoNote:
+			if _, err := ms.AddWorkflowPropertiesModifiedEvent(
+				common.EmptyEventID,
+				&commandpb.ModifyWorkflowPropertiesCommandAttributes{
+					UpsertedMemo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+						variant.MemoNote.GetKey(): payload.EncodeString(variant.MemoNote.GetNote()),
+					}},
+				},
+			); err != nil {
+				return false, err
+			}
+		}
+	}
+	return signaled, nil
+}
+
+func (ms *MutableStateImpl) matchWorkflowRuleVisibilityQuery(visibilityQuery string) (bool, error) {
+	nsName := ms.namespaceEntry.Name()
+	saTypeMap, err := ms.shard.GetSearchAttributesProvider().GetSearchAttributes(ms.config.VisibilityIndexName, false)
+	if err != nil {
+		return false, err
+	}
+	saMapper, err := ms.shard.GetSearchAttributesMapperProvider().GetMapper(nsName)
+	if err != nil {
+		return false, err
+	}
+	return matcher.MatchMutableState(ms.executionInfo, ms.executionState, visibilityQuery, nsName, saTypeMap, saMapper)
+}
+
+func (t workflowRuleTrigger) matches(extension *persistencespb.WorkflowRuleExtension) bool {
+	switch trigger := extension.GetTrigger().(type) {
+	case *persistencespb.WorkflowRuleExtension_WorkflowTaskFailed:
+		cause := trigger.WorkflowTaskFailed.GetCause()
+		return t.eventType == enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED &&
+			(cause == enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNSPECIFIED || cause == t.workflowTaskFailedCause)
+	case *persistencespb.WorkflowRuleExtension_TimerFired:
+		timerID := trigger.TimerFired.GetTimerId()
+		return t.eventType == enumspb.EVENT_TYPE_TIMER_FIRED && (timerID == "" || timerID == t.timerID)
+	case *persistencespb.WorkflowRuleExtension_SignalReceived:
+		return t.eventType == enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED &&
+			trigger.SignalReceived.GetSignalName() == t.signalName
+	default:
+		return false
+	}
+}
+
 func (ms *MutableStateImpl) closeTransactionHandleWorkflowTask(
 	transactionPolicy historyi.TransactionPolicy,
 ) error {
@@ -8359,6 +8743,7 @@ func (ms *MutableStateImpl) cleanupTransaction() error {
 	ms.stateMachineNode.ClearTransactionState()
 	// Clear out transient state machine state.
 	ms.currentTransactionAddedStateMachineEventTypes = nil
+	ms.currentTransactionWorkflowRuleTriggers = nil
 
 	return nil
 }
@@ -8971,6 +9356,9 @@ func (ms *MutableStateImpl) closeTransactionCollapseVisibilityTasks() {
 }
 
 func (ms *MutableStateImpl) generateReplicationTask() bool {
+	if ms.executionInfo.GetLocalOnly() {
+		return false
+	}
 	return len(ms.namespaceEntry.ClusterNames(ms.GetWorkflowKey().WorkflowID)) > 1
 }
 
@@ -9885,12 +10273,28 @@ func (ms *MutableStateImpl) SetSuccessorRunID(runID string) {
 // In this case this function return true.
 // If activity was not changed it will return false.
 func ActivityMatchWorkflowRules(
+	shardContext historyi.ShardContext,
 	ms historyi.MutableState,
 	timeSource clock.TimeSource,
 	logger log.Logger,
 	ai *persistencespb.ActivityInfo) bool {
 
 	workflowRules := ms.GetNamespaceEntry().GetWorkflowRules()
+	if len(workflowRules) == 0 {
+		return false
+	}
+
+	nsName := ms.GetNamespaceEntry().Name()
+	saTypeMap, err := shardContext.GetSearchAttributesProvider().GetSearchAttributes(shardContext.GetConfig().VisibilityIndexName, false)
+	if err != nil {
+		logError(logger, "error getting search attributes", ms.GetExecutionInfo(), ms.GetExecutionState(), tag.Error(err))
+		return false
+	}
+	saMapper, err := shardContext.GetSearchAttributesMapperProvider().GetMapper(nsName)
+	if err != nil {
+		logError(logger, "error getting search attributes mapper", ms.GetExecutionInfo(), ms.GetExecutionState(), tag.Error(err))
+		return false
+	}
 
 	activityChanged := false
 	now := timeSource.Now()
@@ -9901,7 +10305,7 @@ func ActivityMatchWorkflowRules(
 			// the rule is expired
 			continue
 		}
-		match, err := MatchWorkflowRule(ms.GetExecutionInfo(), ms.GetExecutionState(), ai, rule.GetSpec())
+		match, err := MatchWorkflowRule(ms.GetExecutionInfo(), ms.GetExecutionState(), ai, rule.GetSpec(), nsName, saTypeMap, saMapper)
 		if err != nil {
 			logError(logger, "error matching workflow rule", ms.GetExecutionInfo(), ms.GetExecutionState(), tag.Error(err))
 			continue
@@ -9909,6 +10313,7 @@ func ActivityMatchWorkflowRules(
 		if !match {
 			continue
 		}
+		recordWorkflowRuleHit(shardContext, ms, rule)
 
 		// activity matched
 		for _, action := range rule.GetSpec().Actions {
@@ -9934,6 +10339,21 @@ func ActivityMatchWorkflowRules(
 	return activityChanged
 }
 
+// recordWorkflowRuleHit counts a match of the rule in the shard, which is returned by the admin DescribeWorkflowRule API.
+func recordWorkflowRuleHit(
+	shardContext historyi.ShardContext,
+	ms historyi.MutableState,
+	rule *rulespb.WorkflowRule,
+) {
+	metrics.WorkflowRuleMatched.With(
+		shardContext.GetMetricsHandler().WithTags(
+			metrics.NamespaceTag(ms.GetNamespaceEntry().Name().String()),
+			metrics.WorkflowRuleIDTag(rule.GetSpec().GetId()),
+		),
+	).Record(1)
+	shardContext.RecordWorkflowRuleHit(ms.GetNamespaceEntry().ID(), rule)
+}
+
 func logError(
 	logger log.Logger,
 	msg string,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"testing"
@@ -20,6 +21,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	failurepb "go.temporal.io/api/failure/v1"
 	historypb "go.temporal.io/api/history/v1"
+	rulespb "go.temporal.io/api/rules/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	updatepb "go.temporal.io/api/update/v1"
@@ -41,11 +43,15 @@ import (
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/transitionhistory"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/searchattribute/sadefs"
 	serviceerror2 "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/testing/fakedata"
@@ -64,6 +70,7 @@ import (
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow/update"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -317,6 +324,53 @@ func (s *mutableStateSuite) TestTransientWorkflowTaskCompletionFirstBatchApplied
 	s.Equal(0, s.mutableState.hBuilder.NumBufferedEvents())
 }
 
+func (s *mutableStateSuite) TestAddWorkflowTaskFailedEvent_WorkflowRuleTrigger() {
+	for _, attempt := range []int32{1, 2} {
+		s.Run(fmt.Sprintf("attempt %d", attempt), func() {
+			version := int64(12)
+			workflowID := "some random workflow ID"
+			runID := uuid.NewString()
+			s.mutableState = TestGlobalMutableState(
+				s.mockShard,
+				s.mockEventsCache,
+				s.logger,
+				version,
+				workflowID,
+				runID,
+			)
+
+			newWorkflowTaskScheduleEvent, _ := s.prepareTransientWorkflowTaskCompletionFirstBatchApplied(version, workflowID, runID)
+
+			newWorkflowTask := s.mutableState.GetWorkflowTaskByID(newWorkflowTaskScheduleEvent.GetEventId())
+			s.NotNil(newWorkflowTask)
+			newWorkflowTask.Attempt = attempt
+
+			_, err := s.mutableState.AddWorkflowTaskFailedEvent(
+				newWorkflowTask,
+				enumspb.WORKFLOW_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE,
+				failure.NewServerFailure("some random workflow task failure details", false),
+				"some random workflow task failure identity",
+				nil,
+				"",
+				"",
+				"",
+				0,
+			)
+			s.NoError(err)
+
+			// Only the first failure of a workflow task triggers workflow rules.
+			if attempt == 1 {
+				s.Equal([]workflowRuleTrigger{{
+					eventType:               enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED,
+					workflowTaskFailedCause: enumspb.WORKFLOW_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE,
+				}}, s.mutableState.currentTransactionWorkflowRuleTriggers)
+			} else {
+				s.Empty(s.mutableState.currentTransactionWorkflowRuleTriggers)
+			}
+		})
+	}
+}
+
 func (s *mutableStateSuite) TestRedirectInfoValidation_Valid() {
 	tq := &taskqueuepb.TaskQueue{Name: "tq"}
 	s.createVersionedMutableStateWithCompletedWFT(tq)
@@ -2596,6 +2650,122 @@ func (s *mutableStateSuite) TestUpdateInfos() {
 	s.IsType((*serviceerror.NotFound)(nil), err)
 }
 
+func (s *mutableStateSuite) TestUpdateInfos_OutcomeCompaction() {
+	ctx := context.Background()
+	s.mockConfig.EnableUpdateOutcomeCompaction = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	s.mockConfig.UpdateOutcomeRetention = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Hour)
+	s.mockConfig.MaxRetainedUpdateOutcomes = dynamicconfig.GetIntPropertyFnFilteredByNamespace(2)
+
+	namespaceEntry := tests.GlobalNamespaceEntry
+	var err error
+	s.mutableState, err = NewMutableStateFromDB(
+		s.mockShard,
+		NewMapEventCache(s.T(), map[events.EventKey]*historypb.HistoryEvent{}),
+		s.logger,
+		namespaceEntry,
+		s.buildWorkflowMutableState(),
+		123,
+	)
+	s.NoError(err)
+	s.NoError(s.mutableState.UpdateCurrentVersion(namespaceEntry.FailoverVersion(tests.WorkflowID), false))
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Now())
+	s.mutableState.timeSource = timeSource
+
+	completeUpdate := func(updateID string) *historypb.HistoryEvent {
+		acceptedEvent, err := s.mutableState.AddWorkflowExecutionUpdateAcceptedEvent(updateID, updateID+"-msg-id", 1, nil)
+		s.NoError(err)
+		completedEvent, err := s.mutableState.AddWorkflowExecutionUpdateCompletedEvent(
+			acceptedEvent.EventId,
+			&updatepb.Response{
+				Meta:    &updatepb.Meta{UpdateId: updateID},
+				Outcome: &updatepb.Outcome{Value: &updatepb.Outcome_Success{Success: testPayloads}},
+			},
+		)
+		s.NoError(err)
+		return completedEvent
+	}
+
+	completedEvent := completeUpdate("update-1")
+	s.True(update.IsCompactedOutcome(completedEvent.GetWorkflowExecutionUpdateCompletedEventAttributes().GetOutcome()),
+		"expected completed event to be compacted")
+	outcome, err := s.mutableState.GetUpdateOutcome(ctx, "update-1")
+	s.NoError(err)
+	s.Equal(testPayloads, outcome.GetSuccess())
+
+	timeSource.Update(timeSource.Now().Add(time.Minute))
+	completeUpdate("update-2")
+	timeSource.Update(timeSource.Now().Add(time.Minute))
+	completeUpdate("update-3")
+
+	_, err = s.mutableState.GetUpdateOutcome(ctx, "update-1")
+	s.ErrorIs(err, update.OutcomeNotRetainedErr, "expected oldest outcome to be evicted")
+	outcome, err = s.mutableState.GetUpdateOutcome(ctx, "update-2")
+	s.NoError(err)
+	s.Equal(testPayloads, outcome.GetSuccess())
+
+	timeSource.Update(timeSource.Now().Add(time.Hour))
+	_, err = s.mutableState.GetUpdateOutcome(ctx, "update-3")
+	s.ErrorIs(err, update.OutcomeNotRetainedErr, "expected outcome to expire")
+
+	s.mockConfig.MaxRetainedUpdateOutcomesSizeBytes = dynamicconfig.GetIntPropertyFnFilteredByNamespace(testPayloads.Size())
+	completeUpdate("update-4")
+	_, err = s.mutableState.GetUpdateOutcome(ctx, "update-4")
+	s.ErrorIs(err, update.OutcomeNotRetainedErr, "expected outcome exceeding the size limit not to be retained")
+
+	// A compacted event applied without the outcome, e.g. when rebuilding mutable state or
+	// replicating events, is recognized as such.
+	acceptedEvent, err := s.mutableState.AddWorkflowExecutionUpdateAcceptedEvent("update-5", "update-5-msg-id", 1, nil)
+	s.NoError(err)
+	s.NoError(s.mutableState.ApplyWorkflowExecutionUpdateCompletedEvent(
+		&historypb.HistoryEvent{
+			EventId: acceptedEvent.EventId + 1,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes{
+				WorkflowExecutionUpdateCompletedEventAttributes: &historypb.WorkflowExecutionUpdateCompletedEventAttributes{
+					Meta:            &updatepb.Meta{UpdateId: "update-5"},
+					AcceptedEventId: acceptedEvent.EventId,
+					Outcome:         completedEvent.GetWorkflowExecutionUpdateCompletedEventAttributes().GetOutcome(),
+				},
+			},
+		},
+		acceptedEvent.EventId+1,
+	))
+	_, err = s.mutableState.GetUpdateOutcome(ctx, "update-5")
+	s.ErrorIs(err, update.OutcomeNotRetainedErr, "expected outcome of applied compacted event not to be retained")
+
+	_, err = s.mutableState.GetUpdateOutcome(ctx, "not_an_update_id")
+	s.IsType((*serviceerror.NotFound)(nil), err)
+}
+
+func (s *mutableStateSuite) TestCacheQueryResult() {
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Now())
+	s.mutableState.timeSource = timeSource
+	result := payloads.EncodeString("result")
+
+	s.Nil(s.mutableState.GetCachedQueryResult("query-1"))
+	sizeBefore := s.mutableState.GetApproximatePersistedSize()
+	s.mutableState.CacheQueryResult("query-1", result, time.Minute, 2)
+	s.Equal(result, s.mutableState.GetCachedQueryResult("query-1"))
+	s.Greater(s.mutableState.GetApproximatePersistedSize(), sizeBefore)
+
+	timeSource.Update(timeSource.Now().Add(time.Second))
+	s.mutableState.CacheQueryResult("query-2", result, time.Minute, 2)
+	timeSource.Update(timeSource.Now().Add(time.Second))
+	s.mutableState.CacheQueryResult("query-3", result, time.Minute, 2)
+	s.Nil(s.mutableState.GetCachedQueryResult("query-1"), "expected the entry closest to expiry to be evicted")
+	s.Equal(result, s.mutableState.GetCachedQueryResult("query-2"))
+
+	timeSource.Update(timeSource.Now().Add(time.Minute))
+	s.Nil(s.mutableState.GetCachedQueryResult("query-3"), "expected the entry to expire")
+	s.mutableState.CacheQueryResult("query-4", result, time.Minute, 2)
+	s.Len(s.mutableState.GetExecutionInfo().GetCachedQueryResults(), 1, "expected expired entries to be dropped")
+
+	replicated := s.mutableState.CloneToProto()
+	SanitizeMutableState(replicated)
+	s.Empty(replicated.GetExecutionInfo().GetCachedQueryResults(), "expected the cache to not be replicated")
+}
+
 func (s *mutableStateSuite) TestApplyActivityTaskStartedEvent() {
 	state := s.buildWorkflowMutableState()
 
@@ -2983,6 +3153,180 @@ func (s *mutableStateSuite) TestRetryActivity_PausedIncrementsStamp() {
 	s.Equal(int32(2), updatedActivityInfo.Attempt)
 }
 
+func (s *mutableStateSuite) TestActivityMatchWorkflowRules() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newRule := func(id string, visibilityQuery string) *rulespb.WorkflowRule {
+		return &rulespb.WorkflowRule{
+			Spec: &rulespb.WorkflowRuleSpec{
+				Id: id,
+				Trigger: &rulespb.WorkflowRuleSpec_ActivityStart{
+					ActivityStart: &rulespb.WorkflowRuleSpec_ActivityStartingTrigger{
+						Predicate: "ActivityType = 'activity-type'",
+					},
+				},
+				VisibilityQuery: visibilityQuery,
+				Actions: []*rulespb.WorkflowRuleAction{
+					{Variant: &rulespb.WorkflowRuleAction_ActivityPause{}},
+				},
+			},
+		}
+	}
+	nsEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: tests.NamespaceID.String(), Name: tests.Namespace.String()},
+		&persistencespb.NamespaceConfig{
+			WorkflowRules: map[string]*rulespb.WorkflowRule{
+				"matching-rule":     newRule("matching-rule", "WorkflowId = '"+tests.WorkflowID+"'"),
+				"not-matching-rule": newRule("not-matching-rule", "AliasForKeyword01 = 'foo'"),
+			},
+		},
+		cluster.TestCurrentClusterName,
+	)
+	s.mockShard.Resource.SearchAttributesProvider.EXPECT().
+		GetSearchAttributes(gomock.Any(), false).
+		Return(searchattribute.TestNameTypeMap(), nil)
+	s.mockShard.Resource.SearchAttributesMapperProvider.EXPECT().
+		GetMapper(tests.Namespace).
+		Return(&searchattribute.TestMapper{Namespace: tests.Namespace.String()}, nil)
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	s.mockShard.SetMetricsHandler(metricsHandler)
+	s.mockShard.Resource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockShard.Resource.ShardMgr.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	ms := NewMutableState(s.mockShard, s.mockEventsCache, s.logger, nsEntry, tests.WorkflowID, tests.RunID, time.Now().UTC())
+	_, activityInfo, err := ms.AddActivityTaskScheduledEvent(
+		int64(4),
+		&commandpb.ScheduleActivityTaskCommandAttributes{
+			ActivityId:   "6",
+			ActivityType: &commonpb.ActivityType{Name: "activity-type"},
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "task-queue"},
+		},
+		false,
+	)
+	s.NoError(err)
+
+	s.True(ActivityMatchWorkflowRules(s.mockShard, ms, s.mockShard.GetTimeSource(), s.logger, activityInfo))
+	updatedActivityInfo, ok := ms.GetActivityByActivityID("6")
+	s.True(ok)
+	s.True(updatedActivityInfo.Paused)
+	s.Equal("matching-rule", updatedActivityInfo.GetPauseInfo().GetRuleId())
+
+	recordings := capture.Snapshot()[metrics.WorkflowRuleMatched.Name()]
+	s.Len(recordings, 1)
+	s.Equal("matching-rule", recordings[0].Tags["workflow_rule_id"])
+	s.Equal(int64(1), s.mockShard.GetWorkflowRuleHits(tests.NamespaceID, "matching-rule", time.Unix(0, 0)))
+	s.Zero(s.mockShard.GetWorkflowRuleHits(tests.NamespaceID, "not-matching-rule", time.Unix(0, 0)))
+}
+
+func (s *mutableStateSuite) TestCloseTransactionHandleWorkflowRules() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+	s.mockShard.Resource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockShard.Resource.ShardMgr.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	createTime := timestamppb.New(time.Unix(1000, 0))
+	newRule := func(id string) *rulespb.WorkflowRule {
+		return &rulespb.WorkflowRule{Spec: &rulespb.WorkflowRuleSpec{Id: id}, CreateTime: createTime}
+	}
+	signalAction := func(signalName string) *persistencespb.WorkflowRuleExtensionAction {
+		return &persistencespb.WorkflowRuleExtensionAction{Variant: &persistencespb.WorkflowRuleExtensionAction_Signal{
+			Signal: &persistencespb.WorkflowRuleExtensionAction_SignalAction{SignalName: signalName},
+		}}
+	}
+	nsEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: tests.NamespaceID.String(), Name: tests.Namespace.String()},
+		&persistencespb.NamespaceConfig{
+			Retention: timestamp.DurationFromDays(1),
+			WorkflowRules: map[string]*rulespb.WorkflowRule{
+				"signal-rule":       newRule("signal-rule"),
+				"timer-rule":        newRule("timer-rule"),
+				"history-size-rule": newRule("history-size-rule"),
+			},
+			WorkflowRuleExtensions: map[string]*persistencespb.WorkflowRuleExtension{
+				"signal-rule": {
+					Trigger: &persistencespb.WorkflowRuleExtension_SignalReceived{
+						SignalReceived: &persistencespb.WorkflowRuleExtension_SignalReceivedTrigger{SignalName: "stop"},
+					},
+					Actions: []*persistencespb.WorkflowRuleExtensionAction{
+						{Variant: &persistencespb.WorkflowRuleExtensionAction_PauseWorkflow{
+							PauseWorkflow: &persistencespb.WorkflowRuleExtensionAction_PauseWorkflowAction{Reason: "stopped"},
+						}},
+						{Variant: &persistencespb.WorkflowRuleExtensionAction_Metric{
+							Metric: &persistencespb.WorkflowRuleExtensionAction_MetricAction{Name: "stopped"},
+						}},
+						{Variant: &persistencespb.WorkflowRuleExtensionAction_MemoNote{
+							MemoNote: &persistencespb.WorkflowRuleExtensionAction_MemoNoteAction{Key: "note", Note: "stopped by rule"},
+						}},
+					},
+				},
+				"timer-rule": {
+					Trigger: &persistencespb.WorkflowRuleExtension_TimerFired{
+						TimerFired: &persistencespb.WorkflowRuleExtension_TimerFiredTrigger{},
+					},
+					Actions: []*persistencespb.WorkflowRuleExtensionAction{signalAction("timer-fired")},
+				},
+				"history-size-rule": {
+					Trigger: &persistencespb.WorkflowRuleExtension_HistorySize{
+						HistorySize: &persistencespb.WorkflowRuleExtension_HistorySizeTrigger{ThresholdBytes: 1024},
+					},
+					Actions: []*persistencespb.WorkflowRuleExtensionAction{signalAction("history-size-reached")},
+				},
+			},
+		},
+		tests.GlobalNamespaceEntry.ReplicationConfig(),
+		tests.Version,
+	)
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	s.mockShard.SetMetricsHandler(metricsHandler)
+
+	dbState := s.buildWorkflowMutableState()
+	dbState.BufferedEvents = nil
+	dbState.ExecutionInfo.ExecutionStats = &persistencespb.ExecutionStats{HistorySize: 2048}
+	ms, err := NewMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, nsEntry, dbState, 123)
+	s.NoError(err)
+	s.NoError(ms.UpdateCurrentVersion(nsEntry.FailoverVersion(tests.WorkflowID), false))
+	s.mockShard.Resource.ClusterMetadata.EXPECT().ClusterNameForFailoverVersion(
+		nsEntry.IsGlobalNamespace(),
+		nsEntry.FailoverVersion(tests.WorkflowID),
+	).Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	_, err = ms.AddWorkflowExecutionSignaled("stop", nil, "identity", nil, "", nil)
+	s.NoError(err)
+	mutation, workflowEvents, err := ms.CloseTransactionAsMutation(context.Background(), historyi.TransactionPolicyActive)
+	s.NoError(err)
+
+	// The signal rule paused the workflow and the history size rule signaled it.
+	s.True(ms.IsWorkflowExecutionStatusPaused())
+	s.Equal("stopped", mutation.ExecutionInfo.GetPauseInfo().GetReason())
+	s.Equal(int64(2), mutation.ExecutionInfo.SignalCount)
+	s.Equal(int64(2048), mutation.ExecutionInfo.WorkflowRulesHistorySize)
+	var note string
+	s.NoError(payload.Decode(mutation.ExecutionInfo.GetMemo()["note"], &note))
+	s.Equal("stopped by rule", note)
+	s.Len(workflowEvents, 1)
+	s.True(slices.ContainsFunc(workflowEvents[0].Events, func(event *historypb.HistoryEvent) bool {
+		return event.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED
+	}))
+
+	s.Equal(int64(1), s.mockShard.GetWorkflowRuleHits(nsEntry.ID(), "signal-rule", createTime.AsTime()))
+	s.Equal(int64(1), s.mockShard.GetWorkflowRuleHits(nsEntry.ID(), "history-size-rule", createTime.AsTime()))
+	s.Zero(s.mockShard.GetWorkflowRuleHits(nsEntry.ID(), "timer-rule", createTime.AsTime()))
+	s.Len(capture.Snapshot()[metrics.WorkflowRuleMatched.Name()], 2)
+	recordings := capture.Snapshot()[metrics.WorkflowRuleActionMetric.Name()]
+	s.Len(recordings, 1)
+	s.Equal("stopped", recordings[0].Tags["workflow_rule_metric_name"])
+
+	// The history size threshold was reached before the next transaction, so the rule doesn't match again.
+	_, err = ms.AddWorkflowExecutionSignaled("other", nil, "identity", nil, "", nil)
+	s.NoError(err)
+	mutation, _, err = ms.CloseTransactionAsMutation(context.Background(), historyi.TransactionPolicyActive)
+	s.NoError(err)
+	s.Equal(int64(3), mutation.ExecutionInfo.SignalCount)
+	s.Equal(int64(1), s.mockShard.GetWorkflowRuleHits(nsEntry.ID(), "history-size-rule", createTime.AsTime()))
+}
+
 func (s *mutableStateSuite) TestupdateBuildIdsAndDeploymentSearchAttributes() {
 	versioned := func(buildId string) *commonpb.WorkerVersionStamp {
 		return &commonpb.WorkerVersionStamp{BuildId: buildId, UseVersioning: true}
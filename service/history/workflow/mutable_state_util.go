@@ -68,6 +68,9 @@ func sanitizeExecutionInfo(
 	// Timer tasks are generated locally, do not sync them.
 	executionInfo.StateMachineTimers = nil
 	executionInfo.TaskGenerationShardClockTimestamp = common.EmptyEventTaskID
+
+	// Cached query results are cluster local.
+	executionInfo.CachedQueryResults = nil
 }
 
 func sanitizeChildExecutionInfo(
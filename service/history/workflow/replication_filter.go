@@ -0,0 +1,47 @@
+package workflow
+
+import (
+	"slices"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+)
+
+// isExcludedFromReplication returns whether a workflow starting in a global namespace is excluded from replication
+// by the namespace replication filter, i.e. whether it must be marked local-only.
+func isExcludedFromReplication(
+	filter dynamicconfig.ReplicationFilter,
+	nsEntry *namespace.Namespace,
+	workflowType string,
+	searchAttributes map[string]*commonpb.Payload,
+) bool {
+	if filter.IsEmpty() {
+		return false
+	}
+	if slices.Contains(filter.WorkflowTypes, workflowType) {
+		return false
+	}
+	if filter.SearchAttribute == "" {
+		return true
+	}
+
+	// Search attributes are persisted under their field name, the filter is configured with the alias.
+	fieldName := filter.SearchAttribute
+	mapper := nsEntry.CustomSearchAttributesMapper()
+	if mapped, err := mapper.GetFieldName(filter.SearchAttribute, nsEntry.Name().String()); err == nil {
+		fieldName = mapped
+	}
+	payload, ok := searchAttributes[fieldName]
+	if !ok {
+		return true
+	}
+	value, err := sadefs.DecodeValue(payload, enumspb.INDEXED_VALUE_TYPE_BOOL, false)
+	if err != nil {
+		return true
+	}
+	replicate, _ := value.(bool)
+	return !replicate
+}
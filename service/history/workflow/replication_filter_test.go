@@ -0,0 +1,59 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+)
+
+func TestIsExcludedFromReplication(t *testing.T) {
+	nsEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"},
+		&persistencespb.NamespaceConfig{
+			CustomSearchAttributeAliases: map[string]string{"Bool01": "Replicate"},
+		},
+		nil,
+		0,
+	)
+	replicate := map[string]*commonpb.Payload{
+		"Bool01": sadefs.MustEncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL),
+	}
+	doNotReplicate := map[string]*commonpb.Payload{
+		"Bool01": sadefs.MustEncodeValue(false, enumspb.INDEXED_VALUE_TYPE_BOOL),
+	}
+	filter := dynamicconfig.ReplicationFilter{
+		WorkflowTypes:   []string{"OrderWorkflow"},
+		SearchAttribute: "Replicate",
+	}
+
+	testCases := []struct {
+		name             string
+		filter           dynamicconfig.ReplicationFilter
+		workflowType     string
+		searchAttributes map[string]*commonpb.Payload
+		excluded         bool
+	}{
+		{name: "empty filter", workflowType: "OtherWorkflow"},
+		{name: "workflow type", filter: filter, workflowType: "OrderWorkflow"},
+		{name: "search attribute true", filter: filter, workflowType: "OtherWorkflow", searchAttributes: replicate},
+		{name: "search attribute false", filter: filter, workflowType: "OtherWorkflow", searchAttributes: doNotReplicate, excluded: true},
+		{name: "search attribute missing", filter: filter, workflowType: "OtherWorkflow", excluded: true},
+		{
+			name:         "workflow types only",
+			filter:       dynamicconfig.ReplicationFilter{WorkflowTypes: []string{"OrderWorkflow"}},
+			workflowType: "OtherWorkflow",
+			excluded:     true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.excluded, isExcludedFromReplication(tc.filter, nsEntry, tc.workflowType, tc.searchAttributes))
+		})
+	}
+}
@@ -359,6 +359,7 @@ func SetupNewWorkflowForRetryOrCron(
 	if err != nil {
 		return serviceerror.NewInternal("Failed to add workflow execution started event.")
 	}
+	newMutableState.GetExecutionInfo().LocalOnly = previousExecutionInfo.GetLocalOnly()
 	var parentClock *clockspb.VectorClock
 	if parentInfo != nil {
 		parentClock = parentInfo.Clock
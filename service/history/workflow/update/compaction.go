@@ -0,0 +1,67 @@
+package update
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	updatepb "go.temporal.io/api/update/v1"
+	"go.temporal.io/server/common"
+)
+
+const (
+	// compactedOutcomeMetadataKey marks the null payload of a compacted successful outcome.
+	compactedOutcomeMetadataKey = "temporal-update-outcome-compacted"
+	// compactedFailureSource marks the failure of a compacted failed outcome.
+	compactedFailureSource = "TemporalServerUpdateOutcomeCompaction"
+)
+
+// CompactOutcome returns a copy of the outcome with all payloads removed, which is what gets
+// written to the WorkflowExecutionUpdateCompleted event when update outcome compaction is
+// enabled. A successful outcome is replaced by a single null payload and a failed outcome keeps
+// the failure messages, types and causes, but none of their details. Either way the result is
+// marked so that IsCompactedOutcome recognizes it wherever the event is read from.
+func CompactOutcome(outcome *updatepb.Outcome) *updatepb.Outcome {
+	switch {
+	case outcome.GetSuccess() != nil:
+		return &updatepb.Outcome{Value: &updatepb.Outcome_Success{Success: &commonpb.Payloads{
+			Payloads: []*commonpb.Payload{{
+				Metadata: map[string][]byte{
+					"encoding":                  []byte("binary/null"),
+					compactedOutcomeMetadataKey: []byte("true"),
+				},
+			}},
+		}}}
+	case outcome.GetFailure() != nil:
+		failure := common.CloneProto(outcome.GetFailure())
+		stripFailurePayloads(failure)
+		failure.Source = compactedFailureSource
+		return &updatepb.Outcome{Value: &updatepb.Outcome_Failure{Failure: failure}}
+	default:
+		return common.CloneProto(outcome)
+	}
+}
+
+// IsCompactedOutcome reports whether the outcome was produced by CompactOutcome, i.e. the
+// original outcome is not part of the event it was read from.
+func IsCompactedOutcome(outcome *updatepb.Outcome) bool {
+	if failure := outcome.GetFailure(); failure != nil {
+		return failure.GetSource() == compactedFailureSource
+	}
+	payloads := outcome.GetSuccess().GetPayloads()
+	return len(payloads) == 1 && string(payloads[0].GetMetadata()[compactedOutcomeMetadataKey]) == "true"
+}
+
+func stripFailurePayloads(failure *failurepb.Failure) {
+	for f := failure; f != nil; f = f.GetCause() {
+		f.EncodedAttributes = nil
+		switch info := f.GetFailureInfo().(type) {
+		case *failurepb.Failure_ApplicationFailureInfo:
+			info.ApplicationFailureInfo.Details = nil
+		case *failurepb.Failure_CanceledFailureInfo:
+			info.CanceledFailureInfo.Details = nil
+		case *failurepb.Failure_TimeoutFailureInfo:
+			info.TimeoutFailureInfo.LastHeartbeatDetails = nil
+		case *failurepb.Failure_ResetWorkflowFailureInfo:
+			info.ResetWorkflowFailureInfo.LastHeartbeatDetails = nil
+		}
+	}
+}
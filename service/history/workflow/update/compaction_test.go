@@ -0,0 +1,61 @@
+package update_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	failurepb "go.temporal.io/api/failure/v1"
+	updatepb "go.temporal.io/api/update/v1"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/service/history/workflow/update"
+)
+
+func TestCompactOutcome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		outcome := &updatepb.Outcome{Value: &updatepb.Outcome_Success{Success: payloads.EncodeString("result")}}
+
+		compacted := update.CompactOutcome(outcome)
+		require.Len(t, compacted.GetSuccess().GetPayloads(), 1)
+		require.Empty(t, compacted.GetSuccess().GetPayloads()[0].GetData())
+		require.Equal(t, "binary/null", string(compacted.GetSuccess().GetPayloads()[0].GetMetadata()["encoding"]))
+		require.True(t, update.IsCompactedOutcome(compacted))
+		require.False(t, update.IsCompactedOutcome(outcome))
+		require.NotEmpty(t, outcome.GetSuccess().GetPayloads()[0].GetData(), "original outcome must not be modified")
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		outcome := &updatepb.Outcome{Value: &updatepb.Outcome_Failure{Failure: &failurepb.Failure{
+			Message:           "update failed",
+			EncodedAttributes: payloads.EncodeString("attributes").GetPayloads()[0],
+			FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+				Type:    "ValidationError",
+				Details: payloads.EncodeString("details"),
+			}},
+			Cause: &failurepb.Failure{
+				Message: "canceled",
+				FailureInfo: &failurepb.Failure_CanceledFailureInfo{CanceledFailureInfo: &failurepb.CanceledFailureInfo{
+					Details: payloads.EncodeString("details"),
+				}},
+			},
+		}}}
+
+		compacted := update.CompactOutcome(outcome)
+		protorequire.ProtoEqual(t, &updatepb.Outcome{Value: &updatepb.Outcome_Failure{Failure: &failurepb.Failure{
+			Message: "update failed",
+			Source:  "TemporalServerUpdateOutcomeCompaction",
+			FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+				Type: "ValidationError",
+			}},
+			Cause: &failurepb.Failure{
+				Message:     "canceled",
+				FailureInfo: &failurepb.Failure_CanceledFailureInfo{CanceledFailureInfo: &failurepb.CanceledFailureInfo{}},
+			},
+		}}}, compacted)
+		require.True(t, update.IsCompactedOutcome(compacted))
+		require.False(t, update.IsCompactedOutcome(outcome))
+		require.NotNil(t, outcome.GetFailure().GetApplicationFailureInfo().GetDetails(), "original outcome must not be modified")
+	})
+}
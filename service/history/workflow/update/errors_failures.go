@@ -12,6 +12,7 @@ var (
 	AbortedByServerErr          = serviceerror.NewUnavailable("workflow update was aborted")
 	AbortedByWorkflowClosingErr = serviceerror.NewNotFound("workflow update was aborted by closing workflow")
 	workflowTaskFailErr         = serviceerror.NewWorkflowNotReady("Unable to perform workflow execution update due to unexpected workflow task failure.")
+	OutcomeNotRetainedErr       = serviceerror.NewFailedPrecondition("workflow update outcome is no longer retained")
 )
 
 var (
@@ -472,7 +472,8 @@ func (r *registry) Find(ctx context.Context, id string) *Update {
 	// because it means that Update exists, was found, but there is something broken in it
 	// (UpdateInfo in mutable state is invalid or Update completion event is not found).
 
-	// The Update is completed and its outcome loaded from the corresponding history event.
+	// The Update is completed and its outcome loaded from the corresponding history event
+	// (or from mutable state if the event was compacted).
 	return newCompleted(
 		id,
 		future.NewReadyFuture(updOutcome, err),
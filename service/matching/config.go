@@ -23,6 +23,7 @@ type (
 		PersistenceGlobalNamespaceMaxQPS     dynamicconfig.IntPropertyFnWithNamespaceFilter
 		PersistencePerShardNamespaceMaxQPS   dynamicconfig.IntPropertyFnWithNamespaceFilter
 		PersistenceDynamicRateLimitingParams dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicRateLimitingParams]
+		PersistenceConcurrencyLimitingParams dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicConcurrencyLimitingParams]
 		PersistenceQPSBurstRatio             dynamicconfig.FloatPropertyFn
 		SyncMatchWaitDuration                dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		RPS                                  dynamicconfig.IntPropertyFn
@@ -65,6 +66,12 @@ type (
 		WorkerRegistryMinEvictAge                dynamicconfig.DurationPropertyFn
 		WorkerRegistryMaxEntries                 dynamicconfig.IntPropertyFn
 		WorkerRegistryEvictionInterval           dynamicconfig.DurationPropertyFn
+		WorkerRegistryHistorySize                dynamicconfig.IntPropertyFn
+		WorkerRegistryStaleAfter                 dynamicconfig.DurationPropertyFn
+		WorkerRegistrySaturationThreshold        dynamicconfig.FloatPropertyFn
+		WorkerRegistrySaturationHeartbeats       dynamicconfig.IntPropertyFn
+		WorkerRegistryPersistenceEnabled         dynamicconfig.BoolPropertyFn
+		WorkerRegistryPersistInterval            dynamicconfig.DurationPropertyFn
 		ForwarderMaxOutstandingPolls             dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		ForwarderMaxOutstandingTasks             dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		ForwarderMaxRatePerSecond                dynamicconfig.FloatPropertyFnWithTaskQueueFilter
@@ -137,6 +144,10 @@ type (
 		FairnessCounter            dynamicconfig.TypedPropertyFnWithTaskQueueFilter[counter.CounterParams]
 		PartitionScaleAllowedDrift dynamicconfig.TypedPropertyFnWithTaskQueueFilter[dynamicconfig.PartitionScaleAllowedDrift]
 
+		TaskHookWebhook                  dynamicconfig.TypedPropertyFnWithTaskQueueFilter[dynamicconfig.TaskHookWebhookSettings]
+		DeploymentVersionAddRateInterval dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		WorkerScalingTargets             dynamicconfig.TypedPropertyFnWithTaskQueueFilter[dynamicconfig.WorkerScalingTargets]
+
 		LogAllReqErrors dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	}
 
@@ -269,6 +280,7 @@ func NewConfig(
 		PersistenceGlobalNamespaceMaxQPS:         dynamicconfig.MatchingPersistenceGlobalNamespaceMaxQPS.Get(dc),
 		PersistencePerShardNamespaceMaxQPS:       dynamicconfig.DefaultPerShardNamespaceRPSMax,
 		PersistenceDynamicRateLimitingParams:     dynamicconfig.MatchingPersistenceDynamicRateLimitingParams.Get(dc),
+		PersistenceConcurrencyLimitingParams:     dynamicconfig.MatchingPersistenceConcurrencyLimitingParams.Get(dc),
 		PersistenceQPSBurstRatio:                 dynamicconfig.PersistenceQPSBurstRatio.Get(dc),
 		SyncMatchWaitDuration:                    dynamicconfig.MatchingSyncMatchWaitDuration.Get(dc),
 		HistoryMaxPageSize:                       dynamicconfig.MatchingHistoryMaxPageSize.Get(dc),
@@ -314,6 +326,12 @@ func NewConfig(
 		WorkerRegistryMinEvictAge:                dynamicconfig.MatchingWorkerRegistryMinEvictAge.Get(dc),
 		WorkerRegistryMaxEntries:                 dynamicconfig.MatchingWorkerRegistryMaxEntries.Get(dc),
 		WorkerRegistryEvictionInterval:           dynamicconfig.MatchingWorkerRegistryEvictionInterval.Get(dc),
+		WorkerRegistryHistorySize:                dynamicconfig.MatchingWorkerRegistryHistorySize.Get(dc),
+		WorkerRegistryStaleAfter:                 dynamicconfig.MatchingWorkerRegistryStaleAfter.Get(dc),
+		WorkerRegistrySaturationThreshold:        dynamicconfig.MatchingWorkerRegistrySaturationThreshold.Get(dc),
+		WorkerRegistrySaturationHeartbeats:       dynamicconfig.MatchingWorkerRegistrySaturationHeartbeats.Get(dc),
+		WorkerRegistryPersistenceEnabled:         dynamicconfig.MatchingWorkerRegistryPersistenceEnabled.Get(dc),
+		WorkerRegistryPersistInterval:            dynamicconfig.MatchingWorkerRegistryPersistInterval.Get(dc),
 		ForwarderMaxOutstandingPolls:             dynamicconfig.MatchingForwarderMaxOutstandingPolls.Get(dc),
 		ForwarderMaxOutstandingTasks:             dynamicconfig.MatchingForwarderMaxOutstandingTasks.Get(dc),
 		ForwarderMaxRatePerSecond:                dynamicconfig.MatchingForwarderMaxRatePerSecond.Get(dc),
@@ -374,6 +392,10 @@ func NewConfig(
 		FairnessCounter:            dynamicconfig.MatchingFairnessCounter.Get(dc),
 		PartitionScaleAllowedDrift: dynamicconfig.MatchingPartitionScaleAllowedDrift.Get(dc),
 
+		TaskHookWebhook:                  dynamicconfig.MatchingTaskHookWebhook.Get(dc),
+		DeploymentVersionAddRateInterval: dynamicconfig.MatchingDeploymentVersionAddRateInterval.Get(dc),
+		WorkerScalingTargets:             dynamicconfig.MatchingWorkerScalingTargets.Get(dc),
+
 		LogAllReqErrors: dynamicconfig.LogAllReqErrors.Get(dc),
 	}
 }
@@ -48,6 +48,11 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeWorker":                         1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateFairnessState":                    1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueVersionMembership":        1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateFaultInjection":                   1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerScalingRecommendation":         1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerDeploymentVersionHealth":       1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/RecordNondeterminismError":              1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/DescribePersistenceConcurrencyLimiter":  1,
 	}
 
 	APIPrioritiesOrdered = []int{0, 1, 2}
@@ -21,6 +21,7 @@ import (
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service"
 	"go.temporal.io/server/service/matching/configs"
+	"go.temporal.io/server/service/matching/hooks"
 	"go.temporal.io/server/service/matching/workers"
 	"go.temporal.io/server/service/worker/workerdeployment"
 	"go.uber.org/fx"
@@ -44,6 +45,8 @@ var Module = fx.Options(
 	fx.Provide(RateLimitInterceptorProvider),
 	fx.Provide(VisibilityManagerProvider),
 	fx.Provide(WorkersRegistryProvider),
+	fx.Provide(fx.Annotate(WebhookTaskHookFactoryProvider, fx.ResultTags(`group:"TaskHookFactories"`))),
+	fx.Provide(fx.Annotate(DeploymentVersionRateTaskHookFactoryProvider, fx.ResultTags(`group:"TaskHookFactories"`))),
 	fx.Provide(NewHandler),
 	fx.Provide(service.GrpcServerOptionsProvider),
 	fx.Provide(NamespaceReplicationQueueProvider),
@@ -163,6 +166,7 @@ func PersistenceRateLimitingParamsProvider(
 		serviceConfig.OperatorRPSRatio,
 		serviceConfig.PersistenceQPSBurstRatio,
 		serviceConfig.PersistenceDynamicRateLimitingParams,
+		serviceConfig.PersistenceConcurrencyLimitingParams,
 		persistenceLazyLoadedServiceResolver,
 		logger,
 	)
@@ -239,6 +243,8 @@ func WorkersRegistryProvider(
 	lc fx.Lifecycle,
 	metricsHandler metrics.Handler,
 	serviceConfig *Config,
+	store persistence.WorkerRegistryManager,
+	logger log.Logger,
 ) workers.Registry {
 	return workers.NewRegistry(lc, workers.RegistryParams{
 		NumBuckets:       serviceConfig.WorkerRegistryNumBuckets,
@@ -253,5 +259,30 @@ func WorkersRegistryProvider(
 			BreakdownMetricsByTaskQueue:    serviceConfig.BreakdownMetricsByTaskQueue,
 			ExternalPayloadsEnabled:        serviceConfig.ExternalPayloadsEnabled,
 		},
+		LivenessConfig: workers.WorkerLivenessConfig{
+			HistorySize:          serviceConfig.WorkerRegistryHistorySize,
+			StaleAfter:           serviceConfig.WorkerRegistryStaleAfter,
+			SaturationThreshold:  serviceConfig.WorkerRegistrySaturationThreshold,
+			SaturationHeartbeats: serviceConfig.WorkerRegistrySaturationHeartbeats,
+		},
+		Store:              store,
+		PersistenceEnabled: serviceConfig.WorkerRegistryPersistenceEnabled,
+		PersistInterval:    serviceConfig.WorkerRegistryPersistInterval,
+		Logger:             logger,
 	})
 }
+
+func WebhookTaskHookFactoryProvider(
+	serviceConfig *Config,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) hooks.TaskHookFactory {
+	return hooks.NewWebhookTaskHookFactory(serviceConfig.TaskHookWebhook, metricsHandler, logger)
+}
+
+func DeploymentVersionRateTaskHookFactoryProvider(
+	serviceConfig *Config,
+	metricsHandler metrics.Handler,
+) hooks.TaskHookFactory {
+	return hooks.NewDeploymentVersionRateTaskHookFactory(serviceConfig.DeploymentVersionAddRateInterval, metricsHandler)
+}
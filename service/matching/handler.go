@@ -6,11 +6,14 @@ import (
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workerpb "go.temporal.io/api/worker/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	healthspb "go.temporal.io/server/api/health/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/chaos"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
@@ -18,6 +21,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives"
@@ -38,14 +42,17 @@ type (
 	Handler struct {
 		matchingservice.UnimplementedMatchingServiceServer
 
-		engine            Engine
-		config            *Config
-		metricsHandler    metrics.Handler
-		logger            log.Logger
-		startWG           sync.WaitGroup
-		throttledLogger   log.Logger
-		namespaceRegistry namespace.Registry
-		workersRegistry   workers.Registry
+		engine             Engine
+		config             *Config
+		metricsHandler     metrics.Handler
+		logger             log.Logger
+		startWG            sync.WaitGroup
+		throttledLogger    log.Logger
+		namespaceRegistry  namespace.Registry
+		workersRegistry    workers.Registry
+		faultRegistry      *chaos.Registry
+		hostInfoProvider   membership.HostInfoProvider
+		concurrencyLimiter *persistenceClient.HealthConcurrencyLimiterImpl
 	}
 
 	HandlerParams struct {
@@ -74,6 +81,8 @@ type (
 		WorkersRegistry               workers.Registry
 		Serializer                    serialization.Serializer
 		TaskHookFactories             []hooks.TaskHookFactory `group:"TaskHookFactories"`
+		FaultRegistry                 *chaos.Registry
+		ConcurrencyLimiter            *persistenceClient.HealthConcurrencyLimiterImpl
 	}
 )
 
@@ -118,8 +127,11 @@ func NewHandler(
 			params.Serializer,
 			params.TaskHookFactories,
 		),
-		namespaceRegistry: params.NamespaceRegistry,
-		workersRegistry:   params.WorkersRegistry,
+		namespaceRegistry:  params.NamespaceRegistry,
+		workersRegistry:    params.WorkersRegistry,
+		faultRegistry:      params.FaultRegistry,
+		hostInfoProvider:   params.HostInfoProvider,
+		concurrencyLimiter: params.ConcurrencyLimiter,
 	}
 
 	// prevent from serving requests before matching engine is started and ready
@@ -509,6 +521,15 @@ func (h *Handler) CheckTaskQueueVersionMembership(
 	return h.engine.CheckTaskQueueVersionMembership(ctx, request)
 }
 
+// GetWorkerScalingRecommendation computes the number of workers a task queue version needs to meet the scaling targets.
+func (h *Handler) GetWorkerScalingRecommendation(
+	ctx context.Context,
+	request *matchingservice.GetWorkerScalingRecommendationRequest,
+) (_ *matchingservice.GetWorkerScalingRecommendationResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.GetWorkerScalingRecommendation(ctx, request)
+}
+
 func (h *Handler) DispatchNexusTask(ctx context.Context, request *matchingservice.DispatchNexusTaskRequest) (_ *matchingservice.DispatchNexusTaskResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
 	return h.engine.DispatchNexusTask(ctx, request)
@@ -689,9 +710,74 @@ func (h *Handler) DescribeWorker(
 	if err != nil {
 		return nil, err
 	}
+	liveness, err := h.workersRegistry.DescribeWorkerLiveness(
+		nsID, request.Request.GetWorkerInstanceKey())
+	if err != nil {
+		return nil, err
+	}
 	return &matchingservice.DescribeWorkerResponse{
 		WorkerInfo: &workerpb.WorkerInfo{
 			WorkerHeartbeat: hb,
 		},
+		HeartbeatHistory: liveness.HeartbeatHistory,
+		Liveness:         liveness.Liveness,
+	}, nil
+}
+
+// GetWorkerDeploymentVersionHealth aggregates the task failures and nondeterminism errors of a deployment version.
+func (h *Handler) GetWorkerDeploymentVersionHealth(
+	_ context.Context, request *matchingservice.GetWorkerDeploymentVersionHealthRequest,
+) (*matchingservice.GetWorkerDeploymentVersionHealthResponse, error) {
+	if request.GetVersion().GetBuildId() == "" {
+		return nil, serviceerror.NewInvalidArgument("deployment version is not set on request")
+	}
+	nsID := namespace.ID(request.GetNamespaceId())
+	return &matchingservice.GetWorkerDeploymentVersionHealthResponse{
+		Health: h.workersRegistry.GetVersionHealth(nsID, request.GetVersion(), request.GetSince().AsTime()),
+	}, nil
+}
+
+// RecordNondeterminismError records a nondeterminism error of a deployment version.
+func (h *Handler) RecordNondeterminismError(
+	_ context.Context, request *matchingservice.RecordNondeterminismErrorRequest,
+) (*matchingservice.RecordNondeterminismErrorResponse, error) {
+	if request.GetVersion().GetBuildId() == "" {
+		return nil, serviceerror.NewInvalidArgument("deployment version is not set on request")
+	}
+	h.workersRegistry.RecordNondeterminismError(namespace.ID(request.GetNamespaceId()), request.GetVersion())
+	return &matchingservice.RecordNondeterminismErrorResponse{}, nil
+}
+
+// UpdateFaultInjection updates the runtime faults of this host and returns them
+func (h *Handler) UpdateFaultInjection(
+	_ context.Context, request *matchingservice.UpdateFaultInjectionRequest,
+) (*matchingservice.UpdateFaultInjectionResponse, error) {
+	if err := h.faultRegistry.Update(
+		request.GetUpsertFaults(),
+		request.GetDeleteFaultIds(),
+		request.GetDeleteAllFaults(),
+	); err != nil {
+		return nil, err
+	}
+	host := h.faultRegistry.Describe()
+	host.HostAddress = h.hostInfoProvider.HostInfo().GetAddress()
+	return &matchingservice.UpdateFaultInjectionResponse{
+		Host: host,
+	}, nil
+}
+
+// DescribePersistenceConcurrencyLimiter returns the state of the adaptive persistence concurrency limiter of this host
+func (h *Handler) DescribePersistenceConcurrencyLimiter(
+	_ context.Context,
+	_ *matchingservice.DescribePersistenceConcurrencyLimiterRequest,
+) (*matchingservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	state := &healthspb.PersistenceConcurrencyLimiterState{}
+	if h.concurrencyLimiter != nil {
+		state = h.concurrencyLimiter.Describe()
+	}
+	state.Service = string(primitives.MatchingService)
+	state.HostAddress = h.hostInfoProvider.HostInfo().GetAddress()
+	return &matchingservice.DescribePersistenceConcurrencyLimiterResponse{
+		State: state,
 	}, nil
 }
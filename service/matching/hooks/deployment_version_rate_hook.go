@@ -0,0 +1,143 @@
+package hooks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	deploymentVersionRateTaskHookFactory struct {
+		interval       dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		metricsHandler metrics.Handler
+	}
+
+	deploymentVersionKey struct {
+		deploymentName string
+		buildID        string
+	}
+
+	// deploymentVersionRateTaskHook counts the tasks added to a partition per worker deployment version and
+	// periodically emits the add rate of each version, which can be used to autoscale the workers of a version.
+	deploymentVersionRateTaskHook struct {
+		interval       time.Duration
+		metricsHandler metrics.Handler
+
+		lock   sync.Mutex
+		counts map[deploymentVersionKey]int64
+
+		stopCh    chan struct{}
+		doneCh    chan struct{}
+		startOnce sync.Once
+		stopOnce  sync.Once
+	}
+)
+
+var (
+	_ TaskHookFactory = (*deploymentVersionRateTaskHookFactory)(nil)
+	_ TaskHook        = (*deploymentVersionRateTaskHook)(nil)
+)
+
+// NewDeploymentVersionRateTaskHookFactory returns a factory of task hooks that emit the rate of tasks added to
+// a task queue partition per worker deployment version. The hook is only created for normal partitions of task
+// queues that have a non-zero interval configured.
+func NewDeploymentVersionRateTaskHookFactory(
+	interval dynamicconfig.DurationPropertyFnWithTaskQueueFilter,
+	metricsHandler metrics.Handler,
+) TaskHookFactory {
+	return &deploymentVersionRateTaskHookFactory{
+		interval:       interval,
+		metricsHandler: metricsHandler,
+	}
+}
+
+func (f *deploymentVersionRateTaskHookFactory) Create(details *TaskHookFactoryCreateDetails) TaskHook {
+	if details == nil || details.Namespace == nil || details.Partition.Kind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil
+	}
+	nsName := details.Namespace.Name().String()
+	taskQueue := details.Partition.TaskQueue()
+	interval := f.interval(nsName, taskQueue.Name(), taskQueue.TaskType())
+	if interval <= 0 {
+		return nil
+	}
+
+	return &deploymentVersionRateTaskHook{
+		interval: interval,
+		metricsHandler: f.metricsHandler.WithTags(
+			metrics.NamespaceTag(nsName),
+			metrics.UnsafeTaskQueueTag(taskQueue.Name()),
+			metrics.TaskQueueTypeTag(taskQueue.TaskType()),
+			// A hook is created per partition, the partition ID keeps partitions from overwriting each other's rate.
+			metrics.PartitionTag(details.Partition.MetricTag(true)),
+		),
+		counts: make(map[deploymentVersionKey]int64),
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+}
+
+func (h *deploymentVersionRateTaskHook) Start() {
+	h.startOnce.Do(func() { go h.emitLoop() })
+}
+
+func (h *deploymentVersionRateTaskHook) Stop() {
+	// if the hook was never started there is nothing to wait for
+	h.startOnce.Do(func() { close(h.doneCh) })
+	h.stopOnce.Do(func() {
+		close(h.stopCh)
+		<-h.doneCh
+	})
+}
+
+func (h *deploymentVersionRateTaskHook) ProcessTaskAdd(_ context.Context, event *TaskAddHookDetails) {
+	if event == nil || event.DeploymentVersion == nil {
+		return
+	}
+	key := deploymentVersionKey{
+		deploymentName: event.DeploymentVersion.GetDeploymentName(),
+		buildID:        event.DeploymentVersion.GetBuildId(),
+	}
+	h.lock.Lock()
+	h.counts[key]++
+	h.lock.Unlock()
+}
+
+func (h *deploymentVersionRateTaskHook) emitLoop() {
+	defer close(h.doneCh)
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.stopCh:
+			return
+		case <-ticker.C:
+			h.emit()
+		}
+	}
+}
+
+// emit records the add rate of each version seen since the previous emit. A version that had no adds in the
+// last interval is reported with a zero rate once and then forgotten.
+func (h *deploymentVersionRateTaskHook) emit() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for key, count := range h.counts {
+		metrics.TaskAddRatePerDeploymentVersion.With(h.metricsHandler).Record(
+			float64(count)/h.interval.Seconds(),
+			metrics.WorkerDeploymentNameTag(key.deploymentName, true),
+			metrics.WorkerDeploymentBuildIDTag(key.buildID, true),
+		)
+		if count == 0 {
+			delete(h.counts, key)
+		} else {
+			h.counts[key] = 0
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package hooks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	deploymentpb "go.temporal.io/api/deployment/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+)
+
+func TestDeploymentVersionRateTaskHookFactory_Disabled(t *testing.T) {
+	factory := NewDeploymentVersionRateTaskHookFactory(
+		dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(0),
+		metrics.NoopMetricsHandler,
+	)
+	require.Nil(t, factory.Create(newTestCreateDetails(t)))
+}
+
+func TestDeploymentVersionRateTaskHook_Emit(t *testing.T) {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+
+	factory := NewDeploymentVersionRateTaskHookFactory(
+		dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(2*time.Second),
+		metricsHandler,
+	)
+	hook := factory.Create(newTestCreateDetails(t)).(*deploymentVersionRateTaskHook)
+
+	v1 := &deploymentpb.WorkerDeploymentVersion{DeploymentName: "deployment", BuildId: "v1"}
+	v2 := &deploymentpb.WorkerDeploymentVersion{DeploymentName: "deployment", BuildId: "v2"}
+	for range 4 {
+		hook.ProcessTaskAdd(context.Background(), &TaskAddHookDetails{DeploymentVersion: v1})
+	}
+	hook.ProcessTaskAdd(context.Background(), &TaskAddHookDetails{DeploymentVersion: v2})
+	// unversioned tasks are not counted
+	hook.ProcessTaskAdd(context.Background(), &TaskAddHookDetails{})
+
+	rates := func() map[string]float64 {
+		result := make(map[string]float64)
+		for _, r := range capture.Snapshot()[metrics.TaskAddRatePerDeploymentVersion.Name()] {
+			require.Equal(t, testNamespaceName, r.Tags["namespace"])
+			require.Equal(t, testTaskQueueName, r.Tags["taskqueue"])
+			require.Equal(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY.String(), r.Tags["task_type"])
+			require.Equal(t, "0", r.Tags["partition"])
+			require.Equal(t, "deployment", r.Tags["worker_deployment_name"])
+			result[r.Tags["worker_build_id"]] = r.Value.(float64)
+		}
+		return result
+	}
+
+	hook.emit()
+	require.Equal(t, map[string]float64{"v1": 2, "v2": 0.5}, rates())
+
+	// versions without adds are reported as zero once, then forgotten
+	hook.ProcessTaskAdd(context.Background(), &TaskAddHookDetails{DeploymentVersion: v1})
+	hook.emit()
+	require.Equal(t, map[string]float64{"v1": 0.5, "v2": 0}, rates())
+	hook.emit()
+	require.Equal(t, map[string]float64{"v1": 0, "v2": 0}, rates())
+	require.Empty(t, hook.counts)
+}
+
+func TestDeploymentVersionRateTaskHook_StartStop(t *testing.T) {
+	factory := NewDeploymentVersionRateTaskHookFactory(
+		dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(time.Millisecond),
+		metrics.NoopMetricsHandler,
+	)
+	hook := factory.Create(newTestCreateDetails(t))
+	hook.Start()
+	hook.ProcessTaskAdd(context.Background(), &TaskAddHookDetails{
+		DeploymentVersion: &deploymentpb.WorkerDeploymentVersion{DeploymentName: "deployment", BuildId: "v1"},
+	})
+	hook.Stop()
+	// stopping again is a no-op
+	hook.Stop()
+}
@@ -31,6 +31,7 @@ type (
 		TaskQueue() *tqid.TaskQueue
 		TaskType() enumspb.TaskQueueType
 		Kind() enumspb.TaskQueueKind
+		MetricTag(partitionIDBreakdown bool) string
 	}
 
 	TaskHookFactoryCreateDetails struct {
@@ -42,6 +43,20 @@ type (
 		IsSyncMatch       bool // Deprecated: use SyncMatchOutcome instead.
 		SyncMatchOutcome  SyncMatchOutcome
 	}
+	TaskDispatchHookDetails struct {
+		// DeploymentVersion is the version of the queue the task was dispatched from, nil for the unversioned queue.
+		DeploymentVersion *deploymentpb.WorkerDeploymentVersion
+		// IsSyncMatch is true if the task was dispatched without being persisted to the backlog.
+		IsSyncMatch bool
+	}
+	TaskExpiryHookDetails struct {
+		// DeploymentVersion is the version of the queue the task expired in, nil for the unversioned queue.
+		DeploymentVersion *deploymentpb.WorkerDeploymentVersion
+	}
+	PollTimeoutHookDetails struct {
+		// DeploymentVersion is the version of the queue the poller polled, nil for the unversioned queue.
+		DeploymentVersion *deploymentpb.WorkerDeploymentVersion
+	}
 
 	TaskHookFactory interface {
 		// Create returns a TaskHook instance that will be leveraged as part
@@ -58,4 +73,24 @@ type (
 		// ProcessTaskAdd is called for each Task addition (whether sync or async matching)
 		ProcessTaskAdd(ctx context.Context, event *TaskAddHookDetails)
 	}
+
+	// TaskDispatchHook can optionally be implemented by a TaskHook to be notified of dispatched Tasks.
+	TaskDispatchHook interface {
+		// ProcessTaskDispatch is called for each Task returned to a poller of the partition.
+		// It's only called on the partition the poll was made to, not on the partitions it was forwarded to.
+		ProcessTaskDispatch(ctx context.Context, event *TaskDispatchHookDetails)
+	}
+
+	// TaskExpiryHook can optionally be implemented by a TaskHook to be notified of expired Tasks.
+	TaskExpiryHook interface {
+		// ProcessTaskExpiry is called for each backlog Task of the partition that is dropped because it expired.
+		ProcessTaskExpiry(ctx context.Context, event *TaskExpiryHookDetails)
+	}
+
+	// PollTimeoutHook can optionally be implemented by a TaskHook to be notified of polls that got no Task.
+	PollTimeoutHook interface {
+		// ProcessPollTimeout is called for each poll of the partition that returns without a task.
+		// It's only called on the partition the poll was made to, not on the partitions it was forwarded to.
+		ProcessPollTimeout(ctx context.Context, event *PollTimeoutHookDetails)
+	}
 )
@@ -0,0 +1,279 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	WebhookEventTypeTaskAdd      = "TaskAdd"
+	WebhookEventTypeTaskDispatch = "TaskDispatch"
+
+	// webhookMaxBufferedBatches bounds the number of buffered events to this many batches, so that an
+	// unavailable webhook can't make a partition buffer events indefinitely.
+	webhookMaxBufferedBatches = 10
+	// webhookStopFlushTimeout bounds the time spent posting the buffered events on stop, so that an
+	// unavailable webhook can't hold up unloading the partition.
+	webhookStopFlushTimeout = 2 * time.Second
+)
+
+type (
+	// WebhookEvent is a task lifecycle event posted by the webhook task hook.
+	WebhookEvent struct {
+		Type           string    `json:"type"`
+		Time           time.Time `json:"time"`
+		Namespace      string    `json:"namespace"`
+		TaskQueue      string    `json:"taskQueue"`
+		TaskQueueType  string    `json:"taskQueueType"`
+		DeploymentName string    `json:"deploymentName,omitempty"`
+		BuildID        string    `json:"buildId,omitempty"`
+		IsSyncMatch    bool      `json:"isSyncMatch"`
+	}
+
+	// WebhookRequest is the body of each request posted by the webhook task hook.
+	WebhookRequest struct {
+		Events []WebhookEvent `json:"events"`
+	}
+
+	webhookTaskHookFactory struct {
+		settings       dynamicconfig.TypedPropertyFnWithTaskQueueFilter[dynamicconfig.TaskHookWebhookSettings]
+		httpClient     *http.Client
+		metricsHandler metrics.Handler
+		logger         log.Logger
+	}
+
+	webhookTaskHook struct {
+		settings       dynamicconfig.TaskHookWebhookSettings
+		httpClient     *http.Client
+		metricsHandler metrics.Handler
+		logger         log.Logger
+
+		namespace     string
+		taskQueue     string
+		taskQueueType enumspb.TaskQueueType
+
+		lock   sync.Mutex
+		events []WebhookEvent
+
+		// ctx is canceled when the final flush on stop runs out of time
+		ctx       context.Context
+		cancel    context.CancelFunc
+		flushCh   chan struct{}
+		stopCh    chan struct{}
+		doneCh    chan struct{}
+		startOnce sync.Once
+		stopOnce  sync.Once
+	}
+)
+
+var (
+	_ TaskHookFactory  = (*webhookTaskHookFactory)(nil)
+	_ TaskHook         = (*webhookTaskHook)(nil)
+	_ TaskDispatchHook = (*webhookTaskHook)(nil)
+)
+
+// NewWebhookTaskHookFactory returns a factory of task hooks that post the task add and dispatch events of a
+// task queue partition to an HTTP webhook in batches. The hook is only created for normal partitions of task
+// queues that have a webhook URL configured.
+func NewWebhookTaskHookFactory(
+	settings dynamicconfig.TypedPropertyFnWithTaskQueueFilter[dynamicconfig.TaskHookWebhookSettings],
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) TaskHookFactory {
+	return &webhookTaskHookFactory{
+		settings:       settings,
+		httpClient:     &http.Client{},
+		metricsHandler: metricsHandler,
+		logger:         logger,
+	}
+}
+
+func (f *webhookTaskHookFactory) Create(details *TaskHookFactoryCreateDetails) TaskHook {
+	if details == nil || details.Namespace == nil || details.Partition.Kind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil
+	}
+	nsName := details.Namespace.Name().String()
+	taskQueue := details.Partition.TaskQueue()
+	settings := f.settings(nsName, taskQueue.Name(), taskQueue.TaskType())
+	if settings.URL == "" {
+		return nil
+	}
+	settings.BatchSize = max(settings.BatchSize, 1)
+	if settings.FlushInterval <= 0 {
+		settings.FlushInterval = dynamicconfig.DefaultTaskHookWebhookSettings.FlushInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &webhookTaskHook{
+		settings:       settings,
+		httpClient:     f.httpClient,
+		metricsHandler: f.metricsHandler.WithTags(metrics.NamespaceTag(nsName)),
+		logger: log.With(f.logger,
+			tag.WorkflowNamespace(nsName),
+			tag.WorkflowTaskQueueName(taskQueue.Name()),
+			tag.WorkflowTaskQueueType(taskQueue.TaskType()),
+		),
+		namespace:     nsName,
+		taskQueue:     taskQueue.Name(),
+		taskQueueType: taskQueue.TaskType(),
+		ctx:           ctx,
+		cancel:        cancel,
+		flushCh:       make(chan struct{}, 1),
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+}
+
+func (h *webhookTaskHook) Start() {
+	h.startOnce.Do(func() { go h.flushLoop() })
+}
+
+// Stop posts the events that are still buffered and waits for the background flushing to finish. Posting is
+// canceled after webhookStopFlushTimeout, events that could not be posted by then are dropped.
+func (h *webhookTaskHook) Stop() {
+	// if the hook was never started there is nothing to wait for
+	h.startOnce.Do(func() { close(h.doneCh) })
+	h.stopOnce.Do(func() {
+		defer h.cancel()
+		close(h.stopCh)
+
+		timer := time.NewTimer(webhookStopFlushTimeout)
+		defer timer.Stop()
+		select {
+		case <-h.doneCh:
+		case <-timer.C:
+			h.cancel()
+			<-h.doneCh
+		}
+	})
+}
+
+func (h *webhookTaskHook) ProcessTaskAdd(_ context.Context, event *TaskAddHookDetails) {
+	if event == nil {
+		return
+	}
+	e := h.newEvent(WebhookEventTypeTaskAdd, event.IsSyncMatch)
+	e.DeploymentName = event.DeploymentVersion.GetDeploymentName()
+	e.BuildID = event.DeploymentVersion.GetBuildId()
+	h.addEvent(e)
+}
+
+func (h *webhookTaskHook) ProcessTaskDispatch(_ context.Context, event *TaskDispatchHookDetails) {
+	if event == nil {
+		return
+	}
+	e := h.newEvent(WebhookEventTypeTaskDispatch, event.IsSyncMatch)
+	e.DeploymentName = event.DeploymentVersion.GetDeploymentName()
+	e.BuildID = event.DeploymentVersion.GetBuildId()
+	h.addEvent(e)
+}
+
+func (h *webhookTaskHook) newEvent(eventType string, isSyncMatch bool) WebhookEvent {
+	return WebhookEvent{
+		Type:          eventType,
+		Time:          time.Now().UTC(),
+		Namespace:     h.namespace,
+		TaskQueue:     h.taskQueue,
+		TaskQueueType: h.taskQueueType.String(),
+		IsSyncMatch:   isSyncMatch,
+	}
+}
+
+func (h *webhookTaskHook) addEvent(event WebhookEvent) {
+	h.lock.Lock()
+	if len(h.events) >= webhookMaxBufferedBatches*h.settings.BatchSize {
+		h.lock.Unlock()
+		metrics.TaskHookWebhookDroppedEvents.With(h.metricsHandler).Record(1)
+		return
+	}
+	h.events = append(h.events, event)
+	full := len(h.events) >= h.settings.BatchSize
+	h.lock.Unlock()
+
+	if full {
+		select {
+		case h.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (h *webhookTaskHook) flushLoop() {
+	defer close(h.doneCh)
+
+	ticker := time.NewTicker(h.settings.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.stopCh:
+			h.flush()
+			return
+		case <-ticker.C:
+			h.flush()
+		case <-h.flushCh:
+			h.flush()
+		}
+	}
+}
+
+// flush posts all buffered events, one batch at a time.
+func (h *webhookTaskHook) flush() {
+	for {
+		h.lock.Lock()
+		n := min(len(h.events), h.settings.BatchSize)
+		batch := h.events[:n:n]
+		h.events = h.events[n:]
+		h.lock.Unlock()
+
+		if n == 0 {
+			return
+		}
+		if err := h.post(batch); err != nil {
+			h.logger.Warn("Failed to post task hook events to webhook", tag.Error(err))
+			metrics.TaskHookWebhookDroppedEvents.With(h.metricsHandler).Record(int64(n))
+		}
+	}
+}
+
+func (h *webhookTaskHook) post(events []WebhookEvent) error {
+	ctx := h.ctx
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	body, err := json.Marshal(&WebhookRequest{Events: events})
+	if err != nil {
+		return err
+	}
+
+	if h.settings.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.settings.Timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.settings.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
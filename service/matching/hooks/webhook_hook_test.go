@@ -0,0 +1,218 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	deploymentpb "go.temporal.io/api/deployment/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/tqid"
+)
+
+const (
+	testNamespaceName = "test-namespace"
+	testTaskQueueName = "test-task-queue"
+)
+
+func newTestCreateDetails(t *testing.T) *TaskHookFactoryCreateDetails {
+	f, err := tqid.NewTaskQueueFamily("test-namespace-id", testTaskQueueName)
+	require.NoError(t, err)
+	return &TaskHookFactoryCreateDetails{
+		Namespace: namespace.NewLocalNamespaceForTest(
+			&persistencespb.NamespaceInfo{Id: "test-namespace-id", Name: testNamespaceName},
+			nil,
+			"active",
+		),
+		Partition: f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY).RootPartition(),
+	}
+}
+
+type webhookServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []WebhookRequest
+}
+
+func newWebhookServer(t *testing.T, status int) *webhookServer {
+	s := &webhookServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req WebhookRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err == nil {
+			s.mu.Lock()
+			s.requests = append(s.requests, req)
+			s.mu.Unlock()
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *webhookServer) getRequests() []WebhookRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]WebhookRequest(nil), s.requests...)
+}
+
+func TestWebhookTaskHookFactory_Disabled(t *testing.T) {
+	factory := NewWebhookTaskHookFactory(
+		dynamicconfig.GetTypedPropertyFnFilteredByTaskQueue(dynamicconfig.DefaultTaskHookWebhookSettings),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	require.Nil(t, factory.Create(newTestCreateDetails(t)))
+}
+
+func TestWebhookTaskHookFactory_StickyPartition(t *testing.T) {
+	settings := dynamicconfig.DefaultTaskHookWebhookSettings
+	settings.URL = "http://localhost"
+	factory := NewWebhookTaskHookFactory(
+		dynamicconfig.GetTypedPropertyFnFilteredByTaskQueue(settings),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	details := newTestCreateDetails(t)
+	details.Partition = details.Partition.TaskQueue().StickyPartition("sticky")
+	require.Nil(t, factory.Create(details))
+}
+
+func TestWebhookTaskHook_PostsBatches(t *testing.T) {
+	server := newWebhookServer(t, http.StatusOK)
+	settings := dynamicconfig.TaskHookWebhookSettings{
+		URL:           server.URL,
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+		Timeout:       time.Second,
+	}
+	factory := NewWebhookTaskHookFactory(
+		dynamicconfig.GetTypedPropertyFnFilteredByTaskQueue(settings),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	hook := factory.Create(newTestCreateDetails(t))
+	require.NotNil(t, hook)
+	hook.Start()
+
+	version := &deploymentpb.WorkerDeploymentVersion{DeploymentName: "deployment", BuildId: "build"}
+	hook.ProcessTaskAdd(context.Background(), &TaskAddHookDetails{DeploymentVersion: version})
+	hook.(TaskDispatchHook).ProcessTaskDispatch(context.Background(), &TaskDispatchHookDetails{
+		DeploymentVersion: version,
+		IsSyncMatch:       true,
+	})
+
+	// a full batch is posted without waiting for the flush interval
+	require.Eventually(t, func() bool { return len(server.getRequests()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	events := server.getRequests()[0].Events
+	require.Len(t, events, 2)
+	require.Equal(t, WebhookEventTypeTaskAdd, events[0].Type)
+	require.Equal(t, testNamespaceName, events[0].Namespace)
+	require.Equal(t, testTaskQueueName, events[0].TaskQueue)
+	require.Equal(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY.String(), events[0].TaskQueueType)
+	require.Equal(t, "deployment", events[0].DeploymentName)
+	require.Equal(t, "build", events[0].BuildID)
+	require.False(t, events[0].IsSyncMatch)
+	require.Equal(t, WebhookEventTypeTaskDispatch, events[1].Type)
+	require.True(t, events[1].IsSyncMatch)
+
+	// the remaining events are posted on stop
+	hook.ProcessTaskAdd(context.Background(), &TaskAddHookDetails{})
+	hook.Stop()
+	requests := server.getRequests()
+	require.Len(t, requests, 2)
+	require.Len(t, requests[1].Events, 1)
+	require.Empty(t, requests[1].Events[0].DeploymentName)
+}
+
+func TestWebhookTaskHook_FlushInterval(t *testing.T) {
+	server := newWebhookServer(t, http.StatusOK)
+	settings := dynamicconfig.TaskHookWebhookSettings{
+		URL:           server.URL,
+		BatchSize:     100,
+		FlushInterval: 10 * time.Millisecond,
+	}
+	factory := NewWebhookTaskHookFactory(
+		dynamicconfig.GetTypedPropertyFnFilteredByTaskQueue(settings),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	hook := factory.Create(newTestCreateDetails(t))
+	hook.Start()
+	defer hook.Stop()
+
+	hook.ProcessTaskAdd(context.Background(), &TaskAddHookDetails{})
+	require.Eventually(t, func() bool { return len(server.getRequests()) == 1 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWebhookTaskHook_FailedPostDropsEvents(t *testing.T) {
+	server := newWebhookServer(t, http.StatusInternalServerError)
+	settings := dynamicconfig.TaskHookWebhookSettings{
+		URL:           server.URL,
+		BatchSize:     100,
+		FlushInterval: time.Hour,
+	}
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	factory := NewWebhookTaskHookFactory(
+		dynamicconfig.GetTypedPropertyFnFilteredByTaskQueue(settings),
+		metricsHandler,
+		log.NewNoopLogger(),
+	)
+	hook := factory.Create(newTestCreateDetails(t))
+	hook.Start()
+
+	hook.ProcessTaskAdd(context.Background(), &TaskAddHookDetails{})
+	hook.ProcessTaskAdd(context.Background(), &TaskAddHookDetails{})
+	hook.Stop()
+
+	require.Len(t, server.getRequests(), 1)
+	recordings := capture.Snapshot()[metrics.TaskHookWebhookDroppedEvents.Name()]
+	require.Len(t, recordings, 1)
+	require.Equal(t, int64(2), recordings[0].Value)
+	require.Equal(t, testNamespaceName, recordings[0].Tags["namespace"])
+}
+
+func TestWebhookTaskHook_StopIsBounded(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+	settings := dynamicconfig.TaskHookWebhookSettings{
+		URL:           server.URL,
+		BatchSize:     1,
+		FlushInterval: time.Hour,
+		Timeout:       time.Minute,
+	}
+	factory := NewWebhookTaskHookFactory(
+		dynamicconfig.GetTypedPropertyFnFilteredByTaskQueue(settings),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	hook := factory.Create(newTestCreateDetails(t))
+	hook.Start()
+	for range 5 {
+		hook.ProcessTaskAdd(context.Background(), &TaskAddHookDetails{})
+	}
+
+	start := time.Now()
+	hook.Stop()
+	require.Less(t, time.Since(start), webhookStopFlushTimeout+time.Second)
+}
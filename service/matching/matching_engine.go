@@ -3505,6 +3505,36 @@ func (e *matchingEngineImpl) CheckTaskQueueVersionMembership(
 	}, nil
 }
 
+func (e *matchingEngineImpl) GetWorkerScalingRecommendation(
+	ctx context.Context,
+	request *matchingservice.GetWorkerScalingRecommendationRequest,
+) (*matchingservice.GetWorkerScalingRecommendationResponse, error) {
+	partition, err := tqid.PartitionFromProto(request.GetTaskQueue(), request.GetNamespaceId(), request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	if !partition.IsRoot() || partition.Kind() != enumspb.TASK_QUEUE_KIND_NORMAL {
+		return nil, serviceerror.NewInvalidArgument("GetWorkerScalingRecommendation must be called on the root partition of a normal task queue")
+	}
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseDescribe)
+	if err != nil {
+		return nil, err
+	}
+
+	signals, err := e.getWorkerScalingSignals(ctx, pm, request)
+	if err != nil {
+		return nil, err
+	}
+	nsName, err := e.namespaceRegistry.GetNamespaceName(namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	targets := e.config.WorkerScalingTargets(nsName.String(), partition.TaskQueue().Name(), partition.TaskType())
+	return &matchingservice.GetWorkerScalingRecommendationResponse{
+		Recommendation: recommendWorkerScaling(signals, targets),
+	}, nil
+}
+
 func (e *matchingEngineImpl) UpdateTaskQueueConfig(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueConfigRequest,
@@ -3635,6 +3665,10 @@ func (e *matchingEngineImpl) newTaskTracker() *taskTracker {
 	return newTaskTracker(e.timeSource, 5*time.Second, 30*time.Second)
 }
 
+func (e *matchingEngineImpl) newLatencyTracker() *latencyTracker {
+	return newLatencyTracker(e.timeSource, 5*time.Second, 30*time.Second)
+}
+
 // migrateOldFormatVersions moves versions present in the given deployment from the
 // deprecated old-format slice into the new per-deployment map.
 //
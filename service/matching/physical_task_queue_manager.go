@@ -94,6 +94,7 @@ type (
 		taskTrackerLock sync.RWMutex
 		tasksAdded      map[priorityKey]*taskTracker
 		tasksDispatched map[priorityKey]*taskTracker
+		dispatchLatency *latencyTracker
 	}
 
 	// TODO(pri): old matcher cleanup
@@ -159,6 +160,7 @@ func newPhysicalTaskQueueManager(
 		metricsHandler:           taggedMetricsHandler,
 		tasksAdded:               make(map[priorityKey]*taskTracker),
 		tasksDispatched:          make(map[priorityKey]*taskTracker),
+		dispatchLatency:          e.newLatencyTracker(),
 		pollerScalingRateLimiter: quotas.NewDefaultOutgoingRateLimiter(pollerScalingRateLimitFn),
 		deploymentRegistrationCh: make(chan struct{}, 1),
 	}
@@ -460,6 +462,10 @@ func (c *physicalTaskQueueManagerImpl) RecordTaskAdd(result string, forwarded bo
 	)
 }
 
+func (c *physicalTaskQueueManagerImpl) ProcessTaskExpiry(ctx context.Context) {
+	c.partitionMgr.processTaskExpiryHooks(ctx, c.queue)
+}
+
 // PollTask blocks waiting for a task.
 // Returns error when context deadline is exceeded
 // maxDispatchPerSecond is the max rate at which tasks are allowed
@@ -504,6 +510,7 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 		if task.event != nil && IsTaskExpired(task.event.AllocatedTaskInfo) {
 			// task is expired while polling
 			c.metricsHandler.Counter(metrics.ExpiredTasksPerTaskQueueCounter.Name()).Record(1, metrics.TaskExpireStageMemoryTag)
+			c.ProcessTaskExpiry(ctx)
 			task.finish(nil, false)
 			continue
 		}
@@ -513,6 +520,7 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 
 		if pollMetadata.forwardedFrom == "" { // track the task on the child, not where a poll was forwarded to
 			c.getOrCreateTaskTracker(c.tasksDispatched, priorityKey(task.getPriority().GetPriorityKey())).inc(1)
+			c.dispatchLatency.record(c.partitionMgr.engine.timeSource.Now().Sub(task.getCreateTime().AsTime()))
 		}
 		return task, nil
 	}
@@ -677,6 +685,12 @@ func (c *physicalTaskQueueManagerImpl) GetStatsByPriority(includeRates bool) map
 	return stats
 }
 
+// GetAverageDispatchLatency returns the average time from creation until dispatch of the tasks dispatched from
+// this queue over the same window as the task rates.
+func (c *physicalTaskQueueManagerImpl) GetAverageDispatchLatency() time.Duration {
+	return c.dispatchLatency.average()
+}
+
 func (c *physicalTaskQueueManagerImpl) GetInternalTaskQueueStatus() []*taskqueuespb.InternalTaskQueueStatus {
 	status := c.backlogMgr.InternalStatus()
 	if m := c.getDrainBacklogMgr(); m != nil {
@@ -57,6 +57,7 @@ type (
 		// LegacyDescribeTaskQueue returns pollers info and legacy TaskQueueStatus for this physical queue
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
 		GetStatsByPriority(includeRates bool) map[int32]*taskqueuepb.TaskQueueStats
+		GetAverageDispatchLatency() time.Duration
 		GetInternalTaskQueueStatus() []*taskqueuespb.InternalTaskQueueStatus
 		UnloadFromPartitionManager(unloadCause)
 		QueueKey() *PhysicalTaskQueueKey
@@ -69,5 +70,7 @@ type (
 		// RecordTaskAdd records the outcome of a task add to this physical queue using
 		// the queue's tagged metrics handler, so all per-physical-queue labels are included.
 		RecordTaskAdd(result string, forwarded bool, behavior enumspb.VersioningBehavior)
+		// ProcessTaskExpiry is called when a backlog task of this physical queue is dropped because it expired.
+		ProcessTaskExpiry(ctx context.Context)
 	}
 )
@@ -144,6 +144,20 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) GetAllPollerInfo() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPollerInfo", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetAllPollerInfo))
 }
 
+// GetAverageDispatchLatency mocks base method.
+func (m *MockphysicalTaskQueueManager) GetAverageDispatchLatency() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAverageDispatchLatency")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetAverageDispatchLatency indicates an expected call of GetAverageDispatchLatency.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) GetAverageDispatchLatency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAverageDispatchLatency", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetAverageDispatchLatency))
+}
+
 // GetFairnessWeightOverrides mocks base method.
 func (m *MockphysicalTaskQueueManager) GetFairnessWeightOverrides() fairnessWeightOverrides {
 	m.ctrl.T.Helper()
@@ -269,6 +283,18 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) ProcessSpooledTask(ctx, task
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessSpooledTask", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).ProcessSpooledTask), ctx, task)
 }
 
+// ProcessTaskExpiry mocks base method.
+func (m *MockphysicalTaskQueueManager) ProcessTaskExpiry(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ProcessTaskExpiry", ctx)
+}
+
+// ProcessTaskExpiry indicates an expected call of ProcessTaskExpiry.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) ProcessTaskExpiry(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessTaskExpiry", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).ProcessTaskExpiry), ctx)
+}
+
 // QueueKey mocks base method.
 func (m *MockphysicalTaskQueueManager) QueueKey() *PhysicalTaskQueueKey {
 	m.ctrl.T.Helper()
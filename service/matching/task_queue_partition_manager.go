@@ -624,6 +624,48 @@ func (pm *taskQueuePartitionManagerImpl) processTaskAddHooks(ctx context.Context
 	}
 }
 
+func (pm *taskQueuePartitionManagerImpl) processPollHooks(
+	ctx context.Context,
+	queue physicalTaskQueueManager,
+	task *internalTask,
+	err error,
+) {
+	if len(pm.taskHooks) == 0 {
+		return
+	}
+	version := worker_versioning.ExternalWorkerDeploymentVersionFromDeployment(queue.QueueKey().Version().Deployment())
+	for _, l := range pm.taskHooks {
+		if task != nil {
+			if h, ok := l.(hooks.TaskDispatchHook); ok {
+				h.ProcessTaskDispatch(ctx, &hooks.TaskDispatchHookDetails{
+					DeploymentVersion: version,
+					IsSyncMatch:       task.isSyncMatchTask(),
+				})
+			}
+		} else if errors.Is(err, errNoTasks) {
+			if h, ok := l.(hooks.PollTimeoutHook); ok {
+				h.ProcessPollTimeout(ctx, &hooks.PollTimeoutHookDetails{
+					DeploymentVersion: version,
+				})
+			}
+		}
+	}
+}
+
+func (pm *taskQueuePartitionManagerImpl) processTaskExpiryHooks(ctx context.Context, queue *PhysicalTaskQueueKey) {
+	if len(pm.taskHooks) == 0 {
+		return
+	}
+	version := worker_versioning.ExternalWorkerDeploymentVersionFromDeployment(queue.Version().Deployment())
+	for _, l := range pm.taskHooks {
+		if h, ok := l.(hooks.TaskExpiryHook); ok {
+			h.ProcessTaskExpiry(ctx, &hooks.TaskExpiryHookDetails{
+				DeploymentVersion: version,
+			})
+		}
+	}
+}
+
 func taskAddErrResult(err error) string {
 	var resourceExhausted *serviceerror.ResourceExhausted
 	if errors.As(err, &resourceExhausted) {
@@ -768,6 +810,11 @@ func (pm *taskQueuePartitionManagerImpl) PollTask(
 	if task != nil {
 		task.pollerScalingDecision = dbq.MakePollerScalingDecision(ctx, pollMetadata.localPollStartTime)
 	}
+	// Only fire hooks for polls made to this partition. Forwarded polls fire hooks on the
+	// child partition that originally received the poll.
+	if pollMetadata.forwardedFrom == "" {
+		pm.processPollHooks(ctx, dbq, task, err)
+	}
 
 	// Update poller timestamp when poll ends, unless cancelled (e.g., shutdown/disconnect).
 	// Skip on cancellation to avoid re-adding entry after RemovePoller was called.
@@ -1350,6 +1397,9 @@ func (pm *taskQueuePartitionManagerImpl) describe(
 
 			vInfo.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKey = adjustedStatsByPriority
 			vInfo.PhysicalTaskQueueInfo.TaskQueueStats = aggregateStats(adjustedStatsByPriority)
+			if latency := physicalQueue.GetAverageDispatchLatency(); latency > 0 {
+				vInfo.PhysicalTaskQueueInfo.AverageDispatchLatency = durationpb.New(latency)
+			}
 		}
 		if internalTaskQueueStatus {
 			vInfo.PhysicalTaskQueueInfo.InternalTaskQueueStatus = physicalQueue.GetInternalTaskQueueStatus()
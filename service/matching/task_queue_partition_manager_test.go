@@ -1350,6 +1350,9 @@ type capturingTaskMatchHook struct {
 	taskQueueName string
 	taskQueueType enumspb.TaskQueueType
 	calls         []capturedTaskMatchDetails
+	dispatches    []*hooks.TaskDispatchHookDetails
+	expiries      []*hooks.TaskExpiryHookDetails
+	pollTimeouts  []*hooks.PollTimeoutHookDetails
 }
 
 type capturedTaskMatchDetails struct {
@@ -1388,6 +1391,42 @@ func (h *capturingTaskMatchHook) ProcessTaskAdd(ctx context.Context, event *hook
 	h.calls = append(h.calls, details)
 }
 
+func (h *capturingTaskMatchHook) ProcessTaskDispatch(ctx context.Context, event *hooks.TaskDispatchHookDetails) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.dispatches = append(h.dispatches, event)
+}
+
+func (h *capturingTaskMatchHook) ProcessTaskExpiry(ctx context.Context, event *hooks.TaskExpiryHookDetails) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.expiries = append(h.expiries, event)
+}
+
+func (h *capturingTaskMatchHook) ProcessPollTimeout(ctx context.Context, event *hooks.PollTimeoutHookDetails) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.pollTimeouts = append(h.pollTimeouts, event)
+}
+
+func (h *capturingTaskMatchHook) getDispatches() []*hooks.TaskDispatchHookDetails {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]*hooks.TaskDispatchHookDetails(nil), h.dispatches...)
+}
+
+func (h *capturingTaskMatchHook) getExpiries() []*hooks.TaskExpiryHookDetails {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]*hooks.TaskExpiryHookDetails(nil), h.expiries...)
+}
+
+func (h *capturingTaskMatchHook) getPollTimeouts() []*hooks.PollTimeoutHookDetails {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]*hooks.PollTimeoutHookDetails(nil), h.pollTimeouts...)
+}
+
 func (h *capturingTaskMatchHook) getCalls() []capturedTaskMatchDetails {
 	h.mu.Lock()
 	defer h.mu.Unlock()
@@ -1619,6 +1658,84 @@ func (s *PartitionManagerTestSuite) TestTaskAddHooks_AddHookSyncMatch() {
 	s.Nil(calls[0].DeploymentVersion)
 }
 
+func (s *PartitionManagerTestSuite) TestTaskDispatchHooks_SyncMatch() {
+	hook := &capturingTaskMatchHook{}
+	pm, cleanup := s.setupPartitionManagerWithTaskHookFactories([]hooks.TaskHookFactory{hook})
+	defer cleanup()
+
+	pollDone := make(chan *internalTask, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		task, _, _ := pm.PollTask(ctx, &pollMetadata{})
+		if task != nil && task.responseC != nil {
+			close(task.responseC)
+		}
+		pollDone <- task
+	}()
+	pq := pm.defaultQueue().(*physicalTaskQueueManagerImpl)
+	s.Require().Eventually(pq.matcher.HasWaitingPoller, 2*time.Second, time.Millisecond)
+
+	_, syncMatched, err := pm.AddTask(context.Background(), addTaskParams{
+		taskInfo: &persistencespb.TaskInfo{
+			NamespaceId: namespaceID,
+			RunId:       "run",
+			WorkflowId:  "wf",
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().True(syncMatched)
+	s.Require().NotNil(<-pollDone)
+
+	dispatches := hook.getDispatches()
+	s.Require().Len(dispatches, 1)
+	s.True(dispatches[0].IsSyncMatch)
+	s.Nil(dispatches[0].DeploymentVersion)
+	s.Empty(hook.getPollTimeouts())
+}
+
+func (s *PartitionManagerTestSuite) TestPollTimeoutHooks() {
+	hook := &capturingTaskMatchHook{}
+	pm, cleanup := s.setupPartitionManagerWithTaskHookFactories([]hooks.TaskHookFactory{hook})
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	task, _, err := pm.PollTask(ctx, &pollMetadata{})
+	s.Require().ErrorIs(err, errNoTasks)
+	s.Require().Nil(task)
+
+	pollTimeouts := hook.getPollTimeouts()
+	s.Require().Len(pollTimeouts, 1)
+	s.Nil(pollTimeouts[0].DeploymentVersion)
+	s.Empty(hook.getDispatches())
+}
+
+func (s *PartitionManagerTestSuite) TestPollHooks_NotFiredForForwardedPolls() {
+	hook := &capturingTaskMatchHook{}
+	pm, cleanup := s.setupPartitionManagerWithTaskHookFactories([]hooks.TaskHookFactory{hook})
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err := pm.PollTask(ctx, &pollMetadata{forwardedFrom: "/_sys/child/1"})
+	s.Require().ErrorIs(err, errNoTasks)
+
+	s.Empty(hook.getPollTimeouts())
+}
+
+func (s *PartitionManagerTestSuite) TestTaskExpiryHooks() {
+	hook := &capturingTaskMatchHook{}
+	pm, cleanup := s.setupPartitionManagerWithTaskHookFactories([]hooks.TaskHookFactory{hook})
+	defer cleanup()
+
+	pm.defaultQueue().ProcessTaskExpiry(context.Background())
+
+	expiries := hook.getExpiries()
+	s.Require().Len(expiries, 1)
+	s.Nil(expiries[0].DeploymentVersion)
+}
+
 func (s *PartitionManagerTestSuite) TestTaskAddHooks_AddHookNoSyncMatch() {
 	hook := &capturingTaskMatchHook{}
 	pm, cleanup := s.setupPartitionManagerWithTaskHookFactories([]hooks.TaskHookFactory{hook})
@@ -256,6 +256,7 @@ func (tr *taskReader) addTasksToBuffer(
 		if IsTaskExpired(t) {
 			// task is expired when "add tasks to buffer" is called, so when we read it
 			metrics.ExpiredTasksPerTaskQueueCounter.With(tr.taggedMetricsHandler()).Record(1, metrics.TaskExpireStageReadTag)
+			tr.backlogMgr.pqMgr.ProcessTaskExpiry(ctx)
 			// Also increment readLevel for expired tasks otherwise it could result in
 			// looping over the same tasks if all tasks read in the batch are expired
 			tr.backlogMgr.taskAckManager.setReadLevel(t.GetTaskId())
@@ -9,18 +9,18 @@ import (
 
 // a circular array of a fixed size for tracking tasks
 type circularTaskBuffer struct {
-	buffer     []int32
+	buffer     []int64
 	currentPos int
 }
 
 func newCircularTaskBuffer(size int) circularTaskBuffer {
 	return circularTaskBuffer{
-		buffer: make([]int32, size),
+		buffer: make([]int64, size),
 	}
 }
 
 func (cb *circularTaskBuffer) inc(n int) {
-	cb.buffer[cb.currentPos] += int32(n)
+	cb.buffer[cb.currentPos] += int64(n)
 }
 
 func (cb *circularTaskBuffer) advance() {
@@ -111,3 +111,35 @@ func (s *taskTracker) rate() float32 {
 	// rate per second
 	return float32(totalTasks) / float32(elapsedTime.Seconds())
 }
+
+// latencyTracker tracks the average of latencies recorded over the same sliding window as a taskTracker.
+type latencyTracker struct {
+	count       *taskTracker
+	totalMillis *taskTracker
+}
+
+func newLatencyTracker(
+	timeSource clock.TimeSource,
+	bucketSize time.Duration,
+	totalInterval time.Duration,
+) *latencyTracker {
+	return &latencyTracker{
+		count:       newTaskTracker(timeSource, bucketSize, totalInterval),
+		totalMillis: newTaskTracker(timeSource, bucketSize, totalInterval),
+	}
+}
+
+func (l *latencyTracker) record(latency time.Duration) {
+	l.totalMillis.inc(int(latency.Milliseconds()))
+	l.count.inc(1)
+}
+
+// average returns the average of the latencies recorded in the window, or zero if none were recorded.
+func (l *latencyTracker) average() time.Duration {
+	// Both trackers measure over the same window, so the ratio of their rates is the average latency.
+	countRate := l.count.rate()
+	if countRate == 0 {
+		return 0
+	}
+	return time.Duration(float64(l.totalMillis.rate()) / float64(countRate) * float64(time.Millisecond))
+}
@@ -54,3 +54,24 @@ func TestAddTasksRate(t *testing.T) {
 	timeSource.Advance(60 * time.Second)
 	require.Equal(t, float32(0), tr.rate()) // 0 tasks have been added in the last 30 seconds
 }
+
+func TestLatencyTrackerAverage(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Now())
+
+	lt := newLatencyTracker(timeSource, 5*time.Second, 30*time.Second)
+	require.Equal(t, time.Duration(0), lt.average())
+
+	lt.record(100 * time.Millisecond)
+	lt.record(300 * time.Millisecond)
+	timeSource.Advance(time.Second)
+	require.InDelta(t, float64(200*time.Millisecond), float64(lt.average()), float64(time.Millisecond))
+
+	timeSource.Advance(10 * time.Second)
+	lt.record(time.Second)
+	require.InDelta(t, float64(466*time.Millisecond), float64(lt.average()), float64(time.Millisecond))
+
+	// all latencies fall out of the window
+	timeSource.Advance(time.Minute)
+	require.Equal(t, time.Duration(0), lt.average())
+}
@@ -0,0 +1,252 @@
+package matching
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workerpb "go.temporal.io/api/worker/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	deploymentspb "go.temporal.io/server/api/deployment/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/taskqueue"
+	"go.temporal.io/server/common/worker_versioning"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const workerScalingListWorkersPageSize = 1000
+
+type (
+	// workerScalingSignals are the signals of one task queue version a scaling recommendation is computed from.
+	workerScalingSignals struct {
+		arrivalRate     float32
+		dispatchRate    float32
+		dispatchLatency time.Duration
+		backlogCount    int64
+		backlogAge      time.Duration
+		// workers is the number of workers that heartbeat or polled recently.
+		workers int32
+		// usedSlots and availableSlots are summed over the worker heartbeats which report slots.
+		usedSlots      int32
+		availableSlots int32
+	}
+)
+
+// getWorkerScalingSignals aggregates the stats and pollers of all partitions of the task queue version, and the
+// slot usage reported by the heartbeats of its workers.
+func (e *matchingEngineImpl) getWorkerScalingSignals(
+	ctx context.Context,
+	pm taskQueuePartitionManager,
+	request *matchingservice.GetWorkerScalingRecommendationRequest,
+) (workerScalingSignals, error) {
+	versions := &taskqueuepb.TaskQueueVersionSelection{Unversioned: true}
+	if request.GetVersion() != nil {
+		versions = &taskqueuepb.TaskQueueVersionSelection{
+			BuildIds: []string{worker_versioning.WorkerDeploymentVersionToStringV32(request.GetVersion())},
+		}
+	}
+
+	stats := &taskqueuepb.TaskQueueStats{}
+	var pollers []*taskqueuepb.PollerInfo
+	// Average of the partition dispatch latencies, weighted by their dispatch rates.
+	var weightedLatency float64
+	for i := 0; i < pm.PartitionCount(); i++ {
+		partitionResp, err := e.matchingRawClient.DescribeTaskQueuePartition(ctx,
+			&matchingservice.DescribeTaskQueuePartitionRequest{
+				NamespaceId: request.GetNamespaceId(),
+				TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+					TaskQueue:     request.GetTaskQueue().GetName(),
+					TaskQueueType: request.GetTaskQueueType(),
+					PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(i)},
+				},
+				Versions:      versions,
+				ReportStats:   true,
+				ReportPollers: true,
+			})
+		if err != nil {
+			return workerScalingSignals{}, err
+		}
+		for _, vii := range partitionResp.GetVersionsInfoInternal() {
+			info := vii.GetPhysicalTaskQueueInfo()
+			taskqueue.MergeStats(stats, info.GetTaskQueueStats())
+			pollers = append(pollers, info.GetPollers()...)
+			weightedLatency += info.GetAverageDispatchLatency().AsDuration().Seconds() * float64(info.GetTaskQueueStats().GetTasksDispatchRate())
+		}
+	}
+
+	signals := workerScalingSignals{
+		arrivalRate:  stats.GetTasksAddRate(),
+		dispatchRate: stats.GetTasksDispatchRate(),
+		backlogCount: stats.GetApproximateBacklogCount(),
+		backlogAge:   stats.GetApproximateBacklogAge().AsDuration(),
+		workers:      int32(len(taskqueue.DedupPollers(pollers))),
+	}
+	if signals.dispatchRate > 0 {
+		signals.dispatchLatency = time.Duration(weightedLatency / float64(signals.dispatchRate) * float64(time.Second))
+	}
+
+	heartbeats, err := e.listWorkerHeartbeats(ctx, request)
+	if err != nil {
+		return workerScalingSignals{}, err
+	}
+	if len(heartbeats) > 0 {
+		// Heartbeats are more accurate than pollers, which are only forgotten after the poller history TTL.
+		signals.workers = int32(len(heartbeats))
+	}
+	for _, hb := range heartbeats {
+		slots := workerSlotsInfo(hb, request.GetTaskQueueType())
+		signals.usedSlots += slots.GetCurrentUsedSlots()
+		signals.availableSlots += slots.GetCurrentAvailableSlots()
+	}
+	return signals, nil
+}
+
+// listWorkerHeartbeats returns the heartbeats of the running workers of the task queue version.
+func (e *matchingEngineImpl) listWorkerHeartbeats(
+	ctx context.Context,
+	request *matchingservice.GetWorkerScalingRecommendationRequest,
+) ([]*workerpb.WorkerHeartbeat, error) {
+	// The registry only returns the workers of the task queue, the remaining filters are not supported by its query.
+	query := fmt.Sprintf("TaskQueue = %s", sqlparser.String(sqlparser.NewStrVal([]byte(request.GetTaskQueue().GetName()))))
+	var heartbeats []*workerpb.WorkerHeartbeat
+	var nextPageToken []byte
+	for {
+		resp, err := e.matchingRawClient.ListWorkers(ctx, &matchingservice.ListWorkersRequest{
+			NamespaceId: request.GetNamespaceId(),
+			ListRequest: &workflowservice.ListWorkersRequest{
+				PageSize:      workerScalingListWorkersPageSize,
+				NextPageToken: nextPageToken,
+				Query:         query,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		//nolint:staticcheck // SA1019 the Workers field does not include slots.
+		for _, info := range resp.GetWorkersInfo() {
+			hb := info.GetWorkerHeartbeat()
+			// Workers that do not poll the task queue type report no slots for it.
+			if hb.GetTaskQueue() == request.GetTaskQueue().GetName() &&
+				hb.GetStatus() != enumspb.WORKER_STATUS_SHUTDOWN &&
+				workerSlotsInfo(hb, request.GetTaskQueueType()) != nil &&
+				isSameDeploymentVersion(hb, request.GetVersion()) {
+				heartbeats = append(heartbeats, hb)
+			}
+		}
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return heartbeats, nil
+		}
+	}
+}
+
+func isSameDeploymentVersion(hb *workerpb.WorkerHeartbeat, version *deploymentspb.WorkerDeploymentVersion) bool {
+	return hb.GetDeploymentVersion().GetDeploymentName() == version.GetDeploymentName() &&
+		hb.GetDeploymentVersion().GetBuildId() == version.GetBuildId()
+}
+
+func workerSlotsInfo(hb *workerpb.WorkerHeartbeat, taskQueueType enumspb.TaskQueueType) *workerpb.WorkerSlotsInfo {
+	switch taskQueueType {
+	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
+		return hb.GetWorkflowTaskSlotsInfo()
+	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+		return hb.GetActivityTaskSlotsInfo()
+	case enumspb.TASK_QUEUE_TYPE_NEXUS:
+		return hb.GetNexusTaskSlotsInfo()
+	default:
+		return nil
+	}
+}
+
+// recommendWorkerScaling computes the number of workers needed to meet the targets. Capacity is measured in task
+// slots when the workers report them, and in workers otherwise. The needed capacity is the largest of:
+//   - the busy capacity at the target slot utilization,
+//   - the busy capacity scaled by how much faster tasks arrive than they are dispatched,
+//   - the current capacity scaled by how much the dispatch latency exceeds its target,
+//
+// plus the capacity needed to drain the backlog within the target drain time at the current throughput.
+func recommendWorkerScaling(
+	signals workerScalingSignals,
+	targets dynamicconfig.WorkerScalingTargets,
+) *taskqueuespb.WorkerScalingRecommendation {
+	rec := &taskqueuespb.WorkerScalingRecommendation{
+		CurrentWorkers:         signals.workers,
+		CurrentSlots:           signals.usedSlots + signals.availableSlots,
+		ArrivalRate:            signals.arrivalRate,
+		DispatchRate:           signals.dispatchRate,
+		AverageDispatchLatency: durationpb.New(signals.dispatchLatency),
+		BacklogCount:           signals.backlogCount,
+		BacklogAge:             durationpb.New(signals.backlogAge),
+	}
+
+	if signals.workers == 0 {
+		rec.DesiredWorkers = int32(max(targets.MinWorkers, 0))
+		rec.Reason = "no workers"
+		if signals.arrivalRate > 0 || signals.backlogCount > 0 {
+			rec.DesiredWorkers = int32(max(targets.MinWorkers, 1))
+			rec.Reason = "tasks are waiting but no workers are polling"
+		}
+		return clampWorkerScaling(rec, targets)
+	}
+
+	capacity := float64(signals.workers)
+	busy := capacity * targets.SlotUtilization
+	if rec.CurrentSlots > 0 {
+		capacity = float64(rec.CurrentSlots)
+		busy = float64(signals.usedSlots)
+		rec.SlotUtilization = float32(busy / capacity)
+	}
+	capacityPerWorker := capacity / float64(signals.workers)
+
+	needed := capacity
+	rec.Reason = "targets are met"
+	if targets.SlotUtilization > 0 {
+		needed = busy / targets.SlotUtilization
+		rec.Reason = fmt.Sprintf("slot utilization %.2f, target %.2f", rec.SlotUtilization, targets.SlotUtilization)
+	}
+	if signals.dispatchRate > 0 && signals.arrivalRate > signals.dispatchRate {
+		if n := needed * float64(signals.arrivalRate/signals.dispatchRate); n > needed {
+			needed = n
+			rec.Reason = fmt.Sprintf("arrival rate %.2f/s exceeds dispatch rate %.2f/s", signals.arrivalRate, signals.dispatchRate)
+		}
+	}
+	if targets.DispatchLatency > 0 && signals.dispatchLatency > targets.DispatchLatency {
+		if n := capacity * float64(signals.dispatchLatency) / float64(targets.DispatchLatency); n > needed {
+			needed = n
+			rec.Reason = fmt.Sprintf("dispatch latency %v exceeds target %v", signals.dispatchLatency, targets.DispatchLatency)
+		}
+	}
+	if signals.backlogCount > 0 && targets.BacklogDrainTime > 0 && signals.dispatchRate > 0 && busy > 0 {
+		throughputPerCapacity := float64(signals.dispatchRate) / busy
+		needed += float64(signals.backlogCount) / targets.BacklogDrainTime.Seconds() / throughputPerCapacity
+		rec.Reason += fmt.Sprintf("; draining backlog of %d tasks within %v", signals.backlogCount, targets.BacklogDrainTime)
+	}
+
+	rec.DesiredWorkers = int32(math.Ceil(needed / capacityPerWorker))
+	rec = clampWorkerScaling(rec, targets)
+	if rec.CurrentSlots > 0 {
+		rec.DesiredSlots = int32(math.Ceil(float64(rec.DesiredWorkers) * capacityPerWorker))
+	}
+	return rec
+}
+
+func clampWorkerScaling(
+	rec *taskqueuespb.WorkerScalingRecommendation,
+	targets dynamicconfig.WorkerScalingTargets,
+) *taskqueuespb.WorkerScalingRecommendation {
+	if rec.DesiredWorkers < int32(targets.MinWorkers) {
+		rec.DesiredWorkers = int32(targets.MinWorkers)
+		rec.Reason += fmt.Sprintf("; raised to minimum of %d workers", targets.MinWorkers)
+	}
+	if targets.MaxWorkers > 0 && rec.DesiredWorkers > int32(targets.MaxWorkers) {
+		rec.DesiredWorkers = int32(targets.MaxWorkers)
+		rec.Reason += fmt.Sprintf("; capped at maximum of %d workers", targets.MaxWorkers)
+	}
+	return rec
+}
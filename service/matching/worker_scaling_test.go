@@ -0,0 +1,120 @@
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+func TestRecommendWorkerScaling(t *testing.T) {
+	t.Parallel()
+
+	targets := dynamicconfig.WorkerScalingTargets{
+		SlotUtilization:  0.8,
+		DispatchLatency:  time.Second,
+		BacklogDrainTime: time.Minute,
+		MinWorkers:       1,
+		MaxWorkers:       20,
+	}
+
+	testCases := []struct {
+		name           string
+		signals        workerScalingSignals
+		targets        dynamicconfig.WorkerScalingTargets
+		desiredWorkers int32
+		desiredSlots   int32
+	}{
+		{
+			name:           "no workers and no tasks",
+			targets:        dynamicconfig.WorkerScalingTargets{},
+			desiredWorkers: 0,
+		},
+		{
+			name:           "no workers with tasks waiting",
+			signals:        workerScalingSignals{arrivalRate: 5},
+			targets:        dynamicconfig.WorkerScalingTargets{},
+			desiredWorkers: 1,
+		},
+		{
+			name:           "no workers raised to minimum",
+			signals:        workerScalingSignals{backlogCount: 10},
+			targets:        dynamicconfig.WorkerScalingTargets{MinWorkers: 3},
+			desiredWorkers: 3,
+		},
+		{
+			name: "slot utilization above target",
+			signals: workerScalingSignals{
+				arrivalRate: 10, dispatchRate: 10, workers: 2, usedSlots: 16, availableSlots: 4,
+			},
+			targets:        targets,
+			desiredWorkers: 2,
+			desiredSlots:   20,
+		},
+		{
+			name: "fully used slots",
+			signals: workerScalingSignals{
+				arrivalRate: 10, dispatchRate: 10, workers: 4, usedSlots: 40, availableSlots: 0,
+			},
+			targets:        targets,
+			desiredWorkers: 5,
+			desiredSlots:   50,
+		},
+		{
+			name: "idle slots scale down",
+			signals: workerScalingSignals{
+				arrivalRate: 1, dispatchRate: 1, workers: 10, usedSlots: 8, availableSlots: 92,
+			},
+			targets:        targets,
+			desiredWorkers: 1,
+			desiredSlots:   10,
+		},
+		{
+			name: "arrival rate exceeds dispatch rate",
+			signals: workerScalingSignals{
+				arrivalRate: 30, dispatchRate: 10, workers: 2, usedSlots: 16, availableSlots: 4,
+			},
+			targets:        targets,
+			desiredWorkers: 6,
+			desiredSlots:   60,
+		},
+		{
+			name: "dispatch latency exceeds target without slots",
+			signals: workerScalingSignals{
+				arrivalRate: 10, dispatchRate: 10, dispatchLatency: 3 * time.Second, workers: 2,
+			},
+			targets:        targets,
+			desiredWorkers: 6,
+		},
+		{
+			name: "backlog drained within target",
+			signals: workerScalingSignals{
+				arrivalRate: 10, dispatchRate: 10, backlogCount: 1200, workers: 2, usedSlots: 16, availableSlots: 4,
+			},
+			targets: targets,
+			// 16 busy slots dispatch 10 tasks/s; draining 1200 tasks in 60s needs 20 tasks/s, i.e. 32 more slots.
+			desiredWorkers: 6,
+			desiredSlots:   60,
+		},
+		{
+			name: "capped at maximum",
+			signals: workerScalingSignals{
+				arrivalRate: 1000, dispatchRate: 10, workers: 2, usedSlots: 20, availableSlots: 0,
+			},
+			targets:        targets,
+			desiredWorkers: 20,
+			desiredSlots:   200,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := recommendWorkerScaling(tc.signals, tc.targets)
+			require.Equal(t, tc.desiredWorkers, rec.GetDesiredWorkers(), rec.GetReason())
+			require.Equal(t, tc.desiredSlots, rec.GetDesiredSlots(), rec.GetReason())
+			require.Equal(t, tc.signals.workers, rec.GetCurrentWorkers())
+			require.NotEmpty(t, rec.GetReason())
+		})
+	}
+}
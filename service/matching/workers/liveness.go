@@ -0,0 +1,141 @@
+package workers
+
+import (
+	"time"
+
+	workerpb "go.temporal.io/api/worker/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Liveness values of a worker, which can be filtered on with the Liveness column of ListWorkers queries.
+const (
+	workerLivenessHealthy = "Healthy"
+	// workerLivenessStale is a worker that stopped heartbeating but is not evicted yet.
+	workerLivenessStale = "Stale"
+	// workerLivenessSaturated is a worker whose slots of some task type have been used up for several heartbeats.
+	workerLivenessSaturated = "Saturated"
+)
+
+type (
+	// WorkerLivenessConfig contains dynamic config for the heartbeat history and liveness of workers.
+	WorkerLivenessConfig struct {
+		HistorySize          dynamicconfig.IntPropertyFn
+		StaleAfter           dynamicconfig.DurationPropertyFn
+		SaturationThreshold  dynamicconfig.FloatPropertyFn
+		SaturationHeartbeats dynamicconfig.IntPropertyFn
+	}
+
+	livenessCounts struct {
+		stale     int64
+		saturated int64
+	}
+
+	// livenessParams is a snapshot of WorkerLivenessConfig, read once per operation over many workers.
+	livenessParams struct {
+		now                  time.Time
+		staleAfter           time.Duration
+		saturationThreshold  float64
+		saturationHeartbeats int
+	}
+)
+
+func (c WorkerLivenessConfig) params(now time.Time) livenessParams {
+	p := livenessParams{now: now}
+	if c.StaleAfter != nil {
+		p.staleAfter = c.StaleAfter()
+	}
+	if c.SaturationThreshold != nil {
+		p.saturationThreshold = c.SaturationThreshold()
+	}
+	if c.SaturationHeartbeats != nil {
+		p.saturationHeartbeats = c.SaturationHeartbeats()
+	}
+	return p
+}
+
+// historySize returns the number of recent heartbeats to keep per worker. It is at least the number of
+// heartbeats needed to detect saturation.
+func (c WorkerLivenessConfig) historySize() int {
+	size := 0
+	if c.HistorySize != nil {
+		size = c.HistorySize()
+	}
+	if c.SaturationHeartbeats != nil {
+		size = max(size, c.SaturationHeartbeats())
+	}
+	return size
+}
+
+// liveness returns the liveness of a worker from the time it was last seen and its heartbeat history.
+func (p livenessParams) liveness(lastSeen time.Time, history []*workerpb.WorkerHeartbeat) string {
+	if p.staleAfter > 0 && p.now.Sub(lastSeen) > p.staleAfter {
+		return workerLivenessStale
+	}
+	if p.saturationThreshold > 0 && p.saturationHeartbeats > 0 && len(history) >= p.saturationHeartbeats {
+		saturated := true
+		for _, hb := range history[len(history)-p.saturationHeartbeats:] {
+			if slotUtilization(hb) < p.saturationThreshold {
+				saturated = false
+				break
+			}
+		}
+		if saturated {
+			return workerLivenessSaturated
+		}
+	}
+	return workerLivenessHealthy
+}
+
+// slotUtilization returns the highest ratio of used to total slots across the task types of a heartbeat.
+func slotUtilization(hb *workerpb.WorkerHeartbeat) float64 {
+	var utilization float64
+	for _, slots := range []*workerpb.WorkerSlotsInfo{
+		hb.GetWorkflowTaskSlotsInfo(),
+		hb.GetActivityTaskSlotsInfo(),
+		hb.GetNexusTaskSlotsInfo(),
+		hb.GetLocalActivitySlotsInfo(),
+	} {
+		total := slots.GetCurrentUsedSlots() + slots.GetCurrentAvailableSlots()
+		if total > 0 {
+			utilization = max(utilization, float64(slots.GetCurrentUsedSlots())/float64(total))
+		}
+	}
+	return utilization
+}
+
+// heartbeatHistorySample trims a heartbeat to the fields that change between heartbeats. The sub-messages are shared
+// with the heartbeat, which is never modified once recorded.
+func heartbeatHistorySample(hb *workerpb.WorkerHeartbeat, receivedTime time.Time) *workerpb.WorkerHeartbeat {
+	heartbeatTime := hb.GetHeartbeatTime()
+	if heartbeatTime == nil {
+		heartbeatTime = timestamppb.New(receivedTime)
+	}
+	return &workerpb.WorkerHeartbeat{
+		Status:                   hb.GetStatus(),
+		HeartbeatTime:            heartbeatTime,
+		HostInfo:                 hb.GetHostInfo(),
+		WorkflowTaskSlotsInfo:    hb.GetWorkflowTaskSlotsInfo(),
+		ActivityTaskSlotsInfo:    hb.GetActivityTaskSlotsInfo(),
+		NexusTaskSlotsInfo:       hb.GetNexusTaskSlotsInfo(),
+		LocalActivitySlotsInfo:   hb.GetLocalActivitySlotsInfo(),
+		WorkflowPollerInfo:       hb.GetWorkflowPollerInfo(),
+		WorkflowStickyPollerInfo: hb.GetWorkflowStickyPollerInfo(),
+		ActivityPollerInfo:       hb.GetActivityPollerInfo(),
+		NexusPollerInfo:          hb.GetNexusPollerInfo(),
+		CurrentStickyCacheSize:   hb.GetCurrentStickyCacheSize(),
+	}
+}
+
+// appendHistory appends a sample to a heartbeat history, dropping the oldest samples beyond size. The history is
+// modified in place, so readers must copy it under the bucket lock.
+func appendHistory(history []*workerpb.WorkerHeartbeat, sample *workerpb.WorkerHeartbeat, size int) []*workerpb.WorkerHeartbeat {
+	if size <= 0 {
+		return nil
+	}
+	if len(history) >= size {
+		// Shift out the oldest samples so that the backing array does not keep growing.
+		history = history[:copy(history, history[len(history)-size+1:])]
+	}
+	return append(history, sample)
+}
@@ -0,0 +1,172 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	workerpb "go.temporal.io/api/worker/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+)
+
+func heartbeatWithSlots(key string, used, available int32) *workerpb.WorkerHeartbeat {
+	return &workerpb.WorkerHeartbeat{
+		WorkerInstanceKey: key,
+		ActivityTaskSlotsInfo: &workerpb.WorkerSlotsInfo{
+			CurrentUsedSlots:      used,
+			CurrentAvailableSlots: available,
+		},
+	}
+}
+
+func testLivenessConfig() WorkerLivenessConfig {
+	return WorkerLivenessConfig{
+		HistorySize:          dynamicconfig.GetIntPropertyFn(5),
+		StaleAfter:           dynamicconfig.GetDurationPropertyFn(time.Minute),
+		SaturationThreshold:  dynamicconfig.GetFloatPropertyFn(0.9),
+		SaturationHeartbeats: dynamicconfig.GetIntPropertyFn(3),
+	}
+}
+
+func TestWorkerLiveness(t *testing.T) {
+	now := time.Now()
+	params := testLivenessConfig().params(now)
+	saturated := heartbeatWithSlots("w", 10, 0)
+	busy := heartbeatWithSlots("w", 5, 5)
+
+	tests := []struct {
+		name     string
+		lastSeen time.Time
+		history  []*workerpb.WorkerHeartbeat
+		expected string
+	}{
+		{
+			name:     "no history",
+			lastSeen: now,
+			expected: workerLivenessHealthy,
+		},
+		{
+			name:     "stopped heartbeating",
+			lastSeen: now.Add(-2 * time.Minute),
+			history:  []*workerpb.WorkerHeartbeat{saturated, saturated, saturated},
+			expected: workerLivenessStale,
+		},
+		{
+			name:     "saturated for fewer heartbeats than required",
+			lastSeen: now,
+			history:  []*workerpb.WorkerHeartbeat{saturated, saturated},
+			expected: workerLivenessHealthy,
+		},
+		{
+			name:     "saturated for the required heartbeats",
+			lastSeen: now,
+			history:  []*workerpb.WorkerHeartbeat{busy, saturated, saturated, saturated},
+			expected: workerLivenessSaturated,
+		},
+		{
+			name:     "no longer saturated",
+			lastSeen: now,
+			history:  []*workerpb.WorkerHeartbeat{saturated, saturated, saturated, busy},
+			expected: workerLivenessHealthy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, params.liveness(tt.lastSeen, tt.history))
+		})
+	}
+
+	// Liveness detection is disabled without config.
+	assert.Equal(t, workerLivenessHealthy, WorkerLivenessConfig{}.params(now).liveness(now.Add(-time.Hour), nil))
+}
+
+func TestSlotUtilization(t *testing.T) {
+	assert.Zero(t, slotUtilization(&workerpb.WorkerHeartbeat{}))
+	assert.InDelta(t, 0.5, slotUtilization(heartbeatWithSlots("w", 5, 5)), 0.001)
+
+	hb := heartbeatWithSlots("w", 1, 9)
+	hb.WorkflowTaskSlotsInfo = &workerpb.WorkerSlotsInfo{CurrentUsedSlots: 3, CurrentAvailableSlots: 1}
+	assert.InDelta(t, 0.75, slotUtilization(hb), 0.001, "should use the most utilized task type")
+}
+
+func TestAppendHistory(t *testing.T) {
+	var history []*workerpb.WorkerHeartbeat
+	for i := range 5 {
+		history = appendHistory(history, heartbeatWithSlots("w", int32(i), 0), 3)
+	}
+	require.Len(t, history, 3)
+	for i, hb := range history {
+		assert.Equal(t, int32(i+2), hb.GetActivityTaskSlotsInfo().GetCurrentUsedSlots())
+	}
+
+	assert.Nil(t, appendHistory(history, heartbeatWithSlots("w", 0, 0), 0))
+}
+
+func TestRegistryImpl_DescribeWorkerLiveness(t *testing.T) {
+	params := testDefaultRegistryParams(metrics.NoopMetricsHandler)
+	params.LivenessConfig = testLivenessConfig()
+	r := newRegistryImpl(params)
+
+	for range 3 {
+		r.upsertHeartbeats("ns", nil /* principal */, []*workerpb.WorkerHeartbeat{heartbeatWithSlots("worker1", 10, 0)})
+	}
+	r.upsertHeartbeats("ns", nil /* principal */, []*workerpb.WorkerHeartbeat{heartbeatWithSlots("worker2", 1, 9)})
+
+	info, err := r.DescribeWorkerLiveness("ns", "worker1")
+	require.NoError(t, err)
+	assert.Equal(t, workerLivenessSaturated, info.Liveness)
+	require.Len(t, info.HeartbeatHistory, 3)
+	assert.NotNil(t, info.HeartbeatHistory[0].GetHeartbeatTime(), "samples should have a heartbeat time")
+	assert.Empty(t, info.HeartbeatHistory[0].GetWorkerInstanceKey(), "samples should be trimmed")
+
+	info, err = r.DescribeWorkerLiveness("ns", "worker2")
+	require.NoError(t, err)
+	assert.Equal(t, workerLivenessHealthy, info.Liveness)
+	assert.Len(t, info.HeartbeatHistory, 1)
+
+	r.getBucket("ns").namespaces["ns"]["worker2"].lastSeen = time.Now().Add(-2 * time.Minute)
+	resp, err := r.ListWorkers("ns", ListWorkersParams{Query: "Liveness = 'Stale'"})
+	require.NoError(t, err)
+	require.Len(t, resp.Workers, 1)
+	assert.Equal(t, "worker2", resp.Workers[0].GetWorkerInstanceKey())
+
+	resp, err = r.ListWorkers("ns", ListWorkersParams{Query: "Liveness != 'Healthy'"})
+	require.NoError(t, err)
+	assert.Len(t, resp.Workers, 2)
+
+	_, err = r.DescribeWorkerLiveness("ns", "unknown")
+	require.Error(t, err)
+}
+
+func TestRecordLivenessMetrics(t *testing.T) {
+	captureHandler := metricstest.NewCaptureHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+
+	params := testDefaultRegistryParams(captureHandler)
+	params.LivenessConfig = testLivenessConfig()
+	r := newRegistryImpl(params)
+
+	r.RecordWorkerHeartbeats("ns-id", "ns-name", nil /* principal */, []*workerpb.WorkerHeartbeat{heartbeatWithSlots("worker1", 0, 1)})
+	r.getBucket("ns-id").namespaces["ns-id"]["worker1"].lastSeen = time.Now().Add(-2 * time.Minute)
+
+	r.recordLivenessMetrics()
+	stale := capture.Snapshot()["worker_registry_stale_workers"]
+	require.Len(t, stale, 1)
+	assert.InDelta(t, 1, stale[0].Value, 0.001)
+	assert.Equal(t, "ns-name", stale[0].Tags["namespace"])
+
+	// The gauge is reset once the worker is gone.
+	r.RecordWorkerHeartbeats("ns-id", "ns-name", nil /* principal */, []*workerpb.WorkerHeartbeat{{
+		WorkerInstanceKey: "worker1",
+		Status:            enumspb.WORKER_STATUS_SHUTDOWN,
+	}})
+	r.recordLivenessMetrics()
+	stale = capture.Snapshot()["worker_registry_stale_workers"]
+	require.Len(t, stale, 2)
+	assert.InDelta(t, 0, stale[1].Value, 0.001)
+}
@@ -1,8 +1,11 @@
 package workers
 
 import (
+	"time"
+
 	commonpb "go.temporal.io/api/common/v1"
 	workerpb "go.temporal.io/api/worker/v1"
+	deploymentspb "go.temporal.io/server/api/deployment/v1"
 	"go.temporal.io/server/common/namespace"
 )
 
@@ -21,9 +24,18 @@ type (
 		NextPageToken []byte // Opaque token for the next page; nil if no more results.
 	}
 
+	// WorkerLivenessInfo contains the heartbeat history and liveness of a worker.
+	WorkerLivenessInfo struct {
+		HeartbeatHistory []*workerpb.WorkerHeartbeat // Trimmed to the fields that change between heartbeats, oldest first.
+		Liveness         string                      // Healthy, Stale or Saturated.
+	}
+
 	Registry interface {
 		RecordWorkerHeartbeats(nsID namespace.ID, nsName namespace.Name, principal *commonpb.Principal, workerHeartbeat []*workerpb.WorkerHeartbeat)
 		ListWorkers(nsID namespace.ID, params ListWorkersParams) (ListWorkersResponse, error)
 		DescribeWorker(nsID namespace.ID, workerInstanceKey string) (*workerpb.WorkerHeartbeat, error)
+		DescribeWorkerLiveness(nsID namespace.ID, workerInstanceKey string) (WorkerLivenessInfo, error)
+		RecordNondeterminismError(nsID namespace.ID, version *deploymentspb.WorkerDeploymentVersion)
+		GetVersionHealth(nsID namespace.ID, version *deploymentspb.WorkerDeploymentVersion, since time.Time) *deploymentspb.WorkerDeploymentVersionHealth
 	}
 )
@@ -16,8 +16,10 @@ import (
 	workerpb "go.temporal.io/api/worker/v1"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.uber.org/fx"
 )
@@ -34,6 +36,7 @@ type (
 	entry struct {
 		nsID           namespace.ID
 		hb             *workerpb.WorkerHeartbeat
+		history        []*workerpb.WorkerHeartbeat // recent heartbeats trimmed to samples, oldest first
 		lastSeen       time.Time
 		elem           *list.Element
 		isSystemWorker bool
@@ -44,6 +47,12 @@ type (
 		mu         sync.Mutex
 		namespaces map[namespace.ID]map[string]*entry
 		order      *list.List // front = oldest, back = newest
+		// dirty holds the namespaces that changed since they were last persisted.
+		dirty map[namespace.ID]struct{}
+		// loaded holds the namespaces whose persisted workers were restored.
+		loaded map[namespace.ID]struct{}
+		// nondeterminismErrors holds the times of recent nondeterminism errors per version, oldest first.
+		nondeterminismErrors map[namespace.ID]map[versionKey][]time.Time
 	}
 
 	// registryImpl implements Registry interface. It contains all worker heartbeats.
@@ -60,6 +69,16 @@ type (
 		seed               maphash.Seed                     // seed for the hasher, used to ensure consistent hashing
 		metricsHandler     metrics.Handler                  // metrics handler for recording registry metrics
 		metricsEmitter     *workerMetricsEmitter            // emitter for heartbeat-derived metrics
+		livenessConfig     WorkerLivenessConfig             // dynamic config for heartbeat history and liveness
+		namespaceNames     sync.Map                         // namespace.ID → namespace.Name, for liveness metrics
+		reportedLiveness   map[namespace.Name]struct{}      // namespaces with non-zero liveness metrics; owned by the eviction loop
+
+		store                persistence.WorkerRegistryManager // optional store for persisting the registry
+		persistenceEnabledFn dynamicconfig.BoolPropertyFn
+		persistIntervalFn    dynamicconfig.DurationPropertyFn
+		loadLocks            sync.Map // namespace.ID → *sync.Mutex, serializes restoring a namespace
+		persistLoopDone      sync.WaitGroup
+		logger               log.Logger
 	}
 
 	// RegistryParams contains all parameters for creating a worker registry.
@@ -71,6 +90,13 @@ type (
 		EvictionInterval dynamicconfig.DurationPropertyFn
 		MetricsHandler   metrics.Handler
 		MetricsConfig    WorkerMetricsConfig
+		LivenessConfig   WorkerLivenessConfig
+		// Store is optional. When set and PersistenceEnabled, the workers of each namespace are persisted every
+		// PersistInterval and restored the first time the namespace is accessed on a host.
+		Store              persistence.WorkerRegistryManager
+		PersistenceEnabled dynamicconfig.BoolPropertyFn
+		PersistInterval    dynamicconfig.DurationPropertyFn
+		Logger             log.Logger
 	}
 )
 
@@ -78,18 +104,31 @@ func newBucket() *bucket {
 	return &bucket{
 		namespaces: make(map[namespace.ID]map[string]*entry),
 		order:      list.New(),
+		dirty:      make(map[namespace.ID]struct{}),
+		loaded:     make(map[namespace.ID]struct{}),
+
+		nondeterminismErrors: make(map[namespace.ID]map[versionKey][]time.Time),
 	}
 }
 
 // upsertHeartbeats inserts or refreshes a WorkerHeartbeat under the given namespace.
 // Returns the count of added and removed entries separately.
 // Workers with WORKER_STATUS_SHUTDOWN are immediately removed from the registry.
-func (b *bucket) upsertHeartbeats(nsID namespace.ID, principal *commonpb.Principal, heartbeats []*workerpb.WorkerHeartbeat) (added int64, removed int64) {
+func (b *bucket) upsertHeartbeats(
+	nsID namespace.ID,
+	principal *commonpb.Principal,
+	heartbeats []*workerpb.WorkerHeartbeat,
+	historySize int,
+) (added int64, removed int64) {
 	now := time.Now()
 
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	if len(heartbeats) > 0 {
+		b.dirty[nsID] = struct{}{}
+	}
+
 	mp, ok := b.namespaces[nsID]
 	if !ok {
 		mp = make(map[string]*entry)
@@ -110,10 +149,12 @@ func (b *bucket) upsertHeartbeats(nsID namespace.ID, principal *commonpb.Princip
 		}
 
 		isSystemWorker := isSystemWorker(principal, hb.GetTaskQueue())
+		sample := heartbeatHistorySample(hb, now)
 
 		// Normal upsert
 		if e, exists := mp[key]; exists {
 			e.hb = hb
+			e.history = appendHistory(e.history, sample, historySize)
 			e.lastSeen = now
 			e.isSystemWorker = isSystemWorker
 			b.order.MoveToBack(e.elem)
@@ -121,6 +162,7 @@ func (b *bucket) upsertHeartbeats(nsID namespace.ID, principal *commonpb.Princip
 			e = &entry{
 				nsID:           nsID,
 				hb:             hb,
+				history:        appendHistory(nil, sample, historySize),
 				lastSeen:       now,
 				isSystemWorker: isSystemWorker,
 			}
@@ -134,12 +176,12 @@ func (b *bucket) upsertHeartbeats(nsID namespace.ID, principal *commonpb.Princip
 }
 
 // filterWorkers returns all WorkerHeartbeats in a namespace
-// for which predicate(hb) returns true. System workers are excluded
+// for which predicate(entry) returns true. System workers are excluded
 // unless includeSystemWorkers is true.
 func (b *bucket) filterWorkers(
 	nsID namespace.ID,
 	includeSystemWorkers bool,
-	predicate func(*workerpb.WorkerHeartbeat) bool,
+	predicate func(*entry) bool,
 ) []*workerpb.WorkerHeartbeat {
 	b.mu.Lock()
 	defer b.mu.Unlock()
@@ -153,7 +195,7 @@ func (b *bucket) filterWorkers(
 		if !includeSystemWorkers && e.isSystemWorker {
 			continue
 		}
-		if predicate(e.hb) {
+		if predicate(e) {
 			out = append(out, e.hb)
 		}
 	}
@@ -177,6 +219,55 @@ func (b *bucket) getWorkerHeartbeat(nsID namespace.ID, workerInstanceKey string)
 	return e.hb, nil
 }
 
+func (b *bucket) getWorkerLiveness(
+	nsID namespace.ID,
+	workerInstanceKey string,
+	params livenessParams,
+) (WorkerLivenessInfo, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	mp, ok := b.namespaces[nsID]
+	if !ok {
+		return WorkerLivenessInfo{}, serviceerror.NewNamespaceNotFound(nsID.String())
+	}
+
+	e, exists := mp[workerInstanceKey]
+	if !exists {
+		return WorkerLivenessInfo{}, serviceerror.NewNotFoundf("Worker %s not found", workerInstanceKey)
+	}
+
+	return WorkerLivenessInfo{
+		HeartbeatHistory: slices.Clone(e.history),
+		Liveness:         params.liveness(e.lastSeen, e.history),
+	}, nil
+}
+
+// countLiveness adds the number of stale and saturated workers of each namespace in this bucket to counts.
+func (b *bucket) countLiveness(params livenessParams, counts map[namespace.ID]*livenessCounts) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for nsID, mp := range b.namespaces {
+		for _, e := range mp {
+			liveness := params.liveness(e.lastSeen, e.history)
+			if liveness == workerLivenessHealthy {
+				continue
+			}
+			c, ok := counts[nsID]
+			if !ok {
+				c = &livenessCounts{}
+				counts[nsID] = c
+			}
+			if liveness == workerLivenessStale {
+				c.stale++
+			} else {
+				c.saturated++
+			}
+		}
+	}
+}
+
 // evictByTTL removes entries older than expireBefore from this bucket.
 // Returns the number of entries removed.
 func (b *bucket) evictByTTL(expireBefore time.Time) int {
@@ -195,6 +286,11 @@ func (b *bucket) evictByTTL(expireBefore time.Time) int {
 		}
 		b.order.Remove(front)
 		delete(b.namespaces[e.nsID], e.hb.WorkerInstanceKey)
+		if len(b.namespaces[e.nsID]) == 0 {
+			// The namespace may have moved to another host. Restore it again if it moves back.
+			delete(b.namespaces, e.nsID)
+			delete(b.loaded, e.nsID)
+		}
 		removed++
 	}
 	return removed
@@ -225,6 +321,10 @@ func NewRegistry(lc fx.Lifecycle, params RegistryParams) Registry {
 }
 
 func newRegistryImpl(params RegistryParams) *registryImpl {
+	logger := params.Logger
+	if logger == nil {
+		logger = log.NewNoopLogger()
+	}
 	m := &registryImpl{
 		buckets:            make([]*bucket, params.NumBuckets()),
 		maxItemsFn:         params.MaxItems,
@@ -238,6 +338,12 @@ func newRegistryImpl(params RegistryParams) *registryImpl {
 			handler: params.MetricsHandler,
 			config:  params.MetricsConfig,
 		},
+		livenessConfig:       params.LivenessConfig,
+		reportedLiveness:     make(map[namespace.Name]struct{}),
+		store:                params.Store,
+		persistenceEnabledFn: params.PersistenceEnabled,
+		persistIntervalFn:    params.PersistInterval,
+		logger:               logger,
 	}
 
 	for i := range m.buckets {
@@ -261,7 +367,7 @@ func (m *registryImpl) getBucket(nsID namespace.ID) *bucket {
 // New entries increment the global counter.
 func (m *registryImpl) upsertHeartbeats(nsID namespace.ID, principal *commonpb.Principal, heartbeats []*workerpb.WorkerHeartbeat) {
 	b := m.getBucket(nsID)
-	added, removed := b.upsertHeartbeats(nsID, principal, heartbeats)
+	added, removed := b.upsertHeartbeats(nsID, principal, heartbeats, m.livenessConfig.historySize())
 	m.total.Add(added - removed)
 	if added > 0 {
 		metrics.WorkerRegistryWorkersAdded.With(m.metricsHandler).Record(added)
@@ -299,6 +405,17 @@ func (m *registryImpl) filterWorkers(
 	nsID namespace.ID,
 	includeSystemWorkers bool,
 	predicate func(*workerpb.WorkerHeartbeat) bool,
+) []*workerpb.WorkerHeartbeat {
+	return m.filterEntries(nsID, includeSystemWorkers, func(e *entry) bool {
+		return predicate(e.hb)
+	})
+}
+
+// filterEntries is like filterWorkers, with a predicate on the registry entry of the worker.
+func (m *registryImpl) filterEntries(
+	nsID namespace.ID,
+	includeSystemWorkers bool,
+	predicate func(*entry) bool,
 ) []*workerpb.WorkerHeartbeat {
 	b := m.getBucket(nsID)
 
@@ -316,6 +433,7 @@ func (m *registryImpl) evictLoop() {
 			m.evictByTTL()
 			m.evictByCapacity()
 			m.recordUtilizationMetric()
+			m.recordLivenessMetrics()
 		case <-m.quit:
 			return
 		}
@@ -329,6 +447,7 @@ func (m *registryImpl) evictByTTL() {
 	var removed int64
 	for _, b := range m.buckets {
 		removed += int64(b.evictByTTL(expireBefore))
+		b.evictNondeterminismErrors(expireBefore)
 	}
 	if removed > 0 {
 		m.total.Add(-removed)
@@ -367,39 +486,80 @@ func (m *registryImpl) evictByCapacity() {
 	}
 }
 
-// Start begins the background eviction process.
+// recordLivenessMetrics records the number of stale and saturated workers per namespace.
+func (m *registryImpl) recordLivenessMetrics() {
+	params := m.livenessConfig.params(time.Now())
+	counts := make(map[namespace.ID]*livenessCounts)
+	for _, b := range m.buckets {
+		b.countLiveness(params, counts)
+	}
+
+	reported := make(map[namespace.Name]struct{}, len(counts))
+	for nsID, c := range counts {
+		name, ok := m.namespaceNames.Load(nsID)
+		if !ok {
+			continue
+		}
+		nsName := name.(namespace.Name) //nolint:revive
+		metrics.WorkerRegistryStaleWorkers.With(m.metricsHandler).Record(float64(c.stale), metrics.NamespaceTag(nsName.String()))
+		metrics.WorkerRegistrySaturatedWorkers.With(m.metricsHandler).Record(float64(c.saturated), metrics.NamespaceTag(nsName.String()))
+		reported[nsName] = struct{}{}
+	}
+	// Reset the gauges of namespaces that have no stale or saturated workers anymore.
+	for nsName := range m.reportedLiveness {
+		if _, ok := reported[nsName]; !ok {
+			metrics.WorkerRegistryStaleWorkers.With(m.metricsHandler).Record(0, metrics.NamespaceTag(nsName.String()))
+			metrics.WorkerRegistrySaturatedWorkers.With(m.metricsHandler).Record(0, metrics.NamespaceTag(nsName.String()))
+		}
+	}
+	m.reportedLiveness = reported
+}
+
+// Start begins the background eviction and persistence processes.
 func (m *registryImpl) Start() {
 	go m.evictLoop()
+	if m.store != nil {
+		m.persistLoopDone.Add(1)
+		go m.persistLoop()
+	}
 }
 
-// Stop halts background eviction.
+// Stop halts background eviction and persists the namespaces that changed since they were last persisted.
 func (m *registryImpl) Stop() {
 	close(m.quit)
+	m.persistLoopDone.Wait()
 }
 
 func (m *registryImpl) RecordWorkerHeartbeats(nsID namespace.ID, nsName namespace.Name, principal *commonpb.Principal, workerHeartbeat []*workerpb.WorkerHeartbeat) {
+	if nsName != "" {
+		m.namespaceNames.Store(nsID, nsName)
+	}
+	m.restoreNamespace(nsID)
 	m.upsertHeartbeats(nsID, principal, workerHeartbeat)
 	m.metricsEmitter.emit(nsID, nsName, workerHeartbeat)
 }
 
 func (m *registryImpl) ListWorkers(nsID namespace.ID, params ListWorkersParams) (ListWorkersResponse, error) {
+	m.restoreNamespace(nsID)
+
 	// Build the predicate for filtering
-	var predicate func(*workerpb.WorkerHeartbeat) bool
+	var predicate func(*entry) bool
 	if params.Query == "" {
-		predicate = func(_ *workerpb.WorkerHeartbeat) bool { return true }
+		predicate = func(_ *entry) bool { return true }
 	} else {
 		queryEngine, err := newWorkerQueryEngine(nsID.String(), params.Query)
 		if err != nil {
 			return ListWorkersResponse{}, err
 		}
-		predicate = func(heartbeat *workerpb.WorkerHeartbeat) bool {
-			result, err := queryEngine.EvaluateWorker(heartbeat)
+		liveness := m.livenessConfig.params(time.Now())
+		predicate = func(e *entry) bool {
+			result, err := queryEngine.EvaluateWorkerWithLiveness(e.hb, liveness.liveness(e.lastSeen, e.history))
 			return err == nil && result
 		}
 	}
 
 	// Get all matching workers and paginate
-	workers := m.filterWorkers(nsID, params.IncludeSystemWorkers, predicate)
+	workers := m.filterEntries(nsID, params.IncludeSystemWorkers, predicate)
 	return paginateWorkers(workers, params.PageSize, params.NextPageToken)
 }
 
@@ -473,6 +633,7 @@ func paginateWorkers(workers []*workerpb.WorkerHeartbeat, pageSize int, nextPage
 }
 
 func (m *registryImpl) DescribeWorker(nsID namespace.ID, workerInstanceKey string) (*workerpb.WorkerHeartbeat, error) {
+	m.restoreNamespace(nsID)
 	b := m.getBucket(nsID)
 	if b == nil {
 		return nil, serviceerror.NewNotFoundf("namespace not found: %s", nsID.String())
@@ -480,6 +641,15 @@ func (m *registryImpl) DescribeWorker(nsID namespace.ID, workerInstanceKey strin
 	return b.getWorkerHeartbeat(nsID, workerInstanceKey)
 }
 
+func (m *registryImpl) DescribeWorkerLiveness(nsID namespace.ID, workerInstanceKey string) (WorkerLivenessInfo, error) {
+	m.restoreNamespace(nsID)
+	b := m.getBucket(nsID)
+	if b == nil {
+		return WorkerLivenessInfo{}, serviceerror.NewNotFoundf("namespace not found: %s", nsID.String())
+	}
+	return b.getWorkerLiveness(nsID, workerInstanceKey, m.livenessConfig.params(time.Now()))
+}
+
 // isSystemWorker determines if a worker is a system worker.
 // If a principal is available, it checks whether the principal identifies
 // the Temporal server itself (type="temporal"). Otherwise, it falls back to
@@ -0,0 +1,199 @@
+package workers
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const persistenceTimeout = 10 * time.Second
+
+func (m *registryImpl) persistenceEnabled() bool {
+	return m.store != nil && m.persistenceEnabledFn != nil && m.persistenceEnabledFn()
+}
+
+// restoreNamespace restores the persisted workers of a namespace the first time it is accessed on this host, e.g.
+// after a restart or after the namespace moved here from another host. Workers that heartbeat here since are kept.
+func (m *registryImpl) restoreNamespace(nsID namespace.ID) {
+	if !m.persistenceEnabled() {
+		return
+	}
+	b := m.getBucket(nsID)
+	if b.isLoaded(nsID) {
+		return
+	}
+
+	v, _ := m.loadLocks.LoadOrStore(nsID, &sync.Mutex{})
+	lock := v.(*sync.Mutex) //nolint:revive
+	lock.Lock()
+	defer lock.Unlock()
+	if b.isLoaded(nsID) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+	resp, err := m.store.LoadWorkerRegistrySnapshot(ctx, &persistence.LoadWorkerRegistrySnapshotRequest{
+		NamespaceID: nsID.String(),
+	})
+	var snapshot *persistencespb.WorkerRegistrySnapshot
+	if err != nil {
+		// The registry is rebuilt from heartbeats anyway, so don't block them on retries.
+		m.logger.Warn("Failed to restore persisted worker registry", tag.WorkflowNamespaceID(nsID.String()), tag.Error(err))
+		metrics.WorkerRegistryPersistFailures.With(m.metricsHandler).Record(1)
+	} else {
+		snapshot = resp.Snapshot
+	}
+	added := b.restore(nsID, snapshot, time.Now().Add(-m.ttlFn()), m.livenessConfig.historySize())
+	if added > 0 {
+		m.total.Add(added)
+		metrics.WorkerRegistryWorkersAdded.With(m.metricsHandler).Record(added)
+	}
+}
+
+// persistLoop periodically persists the namespaces that changed since they were last persisted.
+func (m *registryImpl) persistLoop() {
+	defer m.persistLoopDone.Done()
+	for {
+		select {
+		case <-time.After(m.persistIntervalFn()):
+			m.persistDirtyNamespaces()
+		case <-m.quit:
+			m.persistDirtyNamespaces()
+			return
+		}
+	}
+}
+
+func (m *registryImpl) persistDirtyNamespaces() {
+	enabled := m.persistenceEnabled()
+	for _, b := range m.buckets {
+		snapshots := b.takeDirtySnapshots(enabled)
+		for nsID, snapshot := range snapshots {
+			ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+			_, err := m.store.SaveWorkerRegistrySnapshot(ctx, &persistence.SaveWorkerRegistrySnapshotRequest{
+				NamespaceID: nsID.String(),
+				Snapshot:    snapshot,
+			})
+			cancel()
+			if err != nil {
+				m.logger.Warn("Failed to persist worker registry", tag.WorkflowNamespaceID(nsID.String()), tag.Error(err))
+				metrics.WorkerRegistryPersistFailures.With(m.metricsHandler).Record(1)
+				b.markDirty(nsID)
+			}
+		}
+	}
+}
+
+func (b *bucket) isLoaded(nsID namespace.ID) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.loaded[nsID]
+	return ok
+}
+
+func (b *bucket) markDirty(nsID namespace.ID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.dirty[nsID] = struct{}{}
+}
+
+// takeDirtySnapshots returns a snapshot of each namespace that changed since it was last persisted, and clears the
+// changes. Only the changes are cleared if persistence is disabled, so that stale snapshots are not written once it is
+// enabled again.
+func (b *bucket) takeDirtySnapshots(enabled bool) map[namespace.ID]*persistencespb.WorkerRegistrySnapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var snapshots map[namespace.ID]*persistencespb.WorkerRegistrySnapshot
+	if enabled {
+		snapshots = make(map[namespace.ID]*persistencespb.WorkerRegistrySnapshot, len(b.dirty))
+	}
+	for nsID := range b.dirty {
+		delete(b.dirty, nsID)
+		if !enabled {
+			continue
+		}
+		mp := b.namespaces[nsID]
+		snapshot := &persistencespb.WorkerRegistrySnapshot{
+			Workers: make([]*persistencespb.WorkerRegistryEntry, 0, len(mp)),
+		}
+		for _, e := range mp {
+			snapshot.Workers = append(snapshot.Workers, &persistencespb.WorkerRegistryEntry{
+				Heartbeat:      e.hb,
+				History:        slices.Clone(e.history),
+				LastSeenTime:   timestamppb.New(e.lastSeen),
+				IsSystemWorker: e.isSystemWorker,
+			})
+		}
+		snapshots[nsID] = snapshot
+	}
+	return snapshots
+}
+
+// restore adds the workers of a persisted snapshot that are not in the bucket and not older than expireBefore,
+// keeping the recency list ordered. Returns the number of workers added.
+func (b *bucket) restore(
+	nsID namespace.ID,
+	snapshot *persistencespb.WorkerRegistrySnapshot,
+	expireBefore time.Time,
+	historySize int,
+) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.loaded[nsID] = struct{}{}
+
+	workers := slices.Clone(snapshot.GetWorkers())
+	slices.SortFunc(workers, func(a, b *persistencespb.WorkerRegistryEntry) int {
+		return a.GetLastSeenTime().AsTime().Compare(b.GetLastSeenTime().AsTime())
+	})
+
+	mp, ok := b.namespaces[nsID]
+	if !ok {
+		mp = make(map[string]*entry)
+		b.namespaces[nsID] = mp
+	}
+	var added int64
+	// Workers are sorted by last seen time, so the insert position only moves forward.
+	next := b.order.Front()
+	for _, w := range workers {
+		key := w.GetHeartbeat().GetWorkerInstanceKey()
+		lastSeen := w.GetLastSeenTime().AsTime()
+		if _, exists := mp[key]; exists || lastSeen.Before(expireBefore) {
+			continue
+		}
+		history := w.GetHistory()
+		if len(history) > historySize {
+			history = history[len(history)-historySize:]
+		}
+		e := &entry{
+			nsID:           nsID,
+			hb:             w.GetHeartbeat(),
+			history:        slices.Clone(history),
+			lastSeen:       lastSeen,
+			isSystemWorker: w.GetIsSystemWorker(),
+		}
+		for next != nil && !next.Value.(*entry).lastSeen.After(lastSeen) { //nolint:revive
+			next = next.Next()
+		}
+		if next == nil {
+			e.elem = b.order.PushBack(e)
+		} else {
+			e.elem = b.order.InsertBefore(e, next)
+		}
+		mp[key] = e
+		added++
+	}
+	if len(mp) == 0 {
+		delete(b.namespaces, nsID)
+	}
+	return added
+}
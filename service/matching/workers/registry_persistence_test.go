@@ -0,0 +1,138 @@
+package workers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	workerpb "go.temporal.io/api/worker/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testPersistentRegistryParams(store persistence.WorkerRegistryManager) RegistryParams {
+	params := testDefaultRegistryParams(metrics.NoopMetricsHandler)
+	params.LivenessConfig = testLivenessConfig()
+	params.Store = store
+	params.PersistenceEnabled = dynamicconfig.GetBoolPropertyFn(true)
+	params.PersistInterval = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	return params
+}
+
+func TestRegistryImpl_RestoreNamespace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := persistence.NewMockWorkerRegistryManager(ctrl)
+	r := newRegistryImpl(testPersistentRegistryParams(store))
+
+	now := time.Now()
+	store.EXPECT().LoadWorkerRegistrySnapshot(gomock.Any(), &persistence.LoadWorkerRegistrySnapshotRequest{
+		NamespaceID: "ns",
+	}).Return(&persistence.LoadWorkerRegistrySnapshotResponse{
+		Snapshot: &persistencespb.WorkerRegistrySnapshot{
+			Workers: []*persistencespb.WorkerRegistryEntry{
+				{
+					Heartbeat:    &workerpb.WorkerHeartbeat{WorkerInstanceKey: "restored"},
+					History:      []*workerpb.WorkerHeartbeat{{}, {}, {}, {}, {}, {}, {}},
+					LastSeenTime: timestamppb.New(now.Add(-time.Minute)),
+				},
+				{
+					Heartbeat:    &workerpb.WorkerHeartbeat{WorkerInstanceKey: "expired"},
+					LastSeenTime: timestamppb.New(now.Add(-testDefaultEntryTTL - time.Minute)),
+				},
+				{
+					Heartbeat:    &workerpb.WorkerHeartbeat{WorkerInstanceKey: "live", HostInfo: &workerpb.WorkerHostInfo{HostName: "old"}},
+					LastSeenTime: timestamppb.New(now.Add(-time.Minute)),
+				},
+			},
+		},
+	}, nil).Times(1)
+
+	r.RecordWorkerHeartbeats("ns", "ns-name", nil /* principal */, []*workerpb.WorkerHeartbeat{{
+		WorkerInstanceKey: "live",
+		HostInfo:          &workerpb.WorkerHostInfo{HostName: "new"},
+	}})
+
+	resp, err := r.ListWorkers("ns", ListWorkersParams{PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, resp.Workers, 2)
+	assert.Equal(t, "live", resp.Workers[0].GetWorkerInstanceKey())
+	assert.Equal(t, "new", resp.Workers[0].GetHostInfo().GetHostName(), "heartbeats should take precedence")
+	assert.Equal(t, "restored", resp.Workers[1].GetWorkerInstanceKey())
+	assert.Equal(t, int64(2), r.total.Load())
+
+	info, err := r.DescribeWorkerLiveness("ns", "restored")
+	require.NoError(t, err)
+	assert.Len(t, info.HeartbeatHistory, 5, "history should be trimmed to the configured size")
+
+	// The recency list stays ordered, so the restored worker is evicted first.
+	b := r.getBucket("ns")
+	assert.Equal(t, "restored", b.order.Front().Value.(*entry).hb.GetWorkerInstanceKey())
+}
+
+func TestRegistryImpl_RestoreNamespaceFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := persistence.NewMockWorkerRegistryManager(ctrl)
+	r := newRegistryImpl(testPersistentRegistryParams(store))
+
+	store.EXPECT().LoadWorkerRegistrySnapshot(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("unavailable")).Times(1)
+
+	r.RecordWorkerHeartbeats("ns", "ns-name", nil /* principal */, []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker1"}})
+	resp, err := r.ListWorkers("ns", ListWorkersParams{})
+	require.NoError(t, err)
+	assert.Len(t, resp.Workers, 1)
+}
+
+func TestRegistryImpl_PersistDirtyNamespaces(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := persistence.NewMockWorkerRegistryManager(ctrl)
+	r := newRegistryImpl(testPersistentRegistryParams(store))
+
+	store.EXPECT().LoadWorkerRegistrySnapshot(gomock.Any(), gomock.Any()).
+		Return(&persistence.LoadWorkerRegistrySnapshotResponse{}, nil).AnyTimes()
+
+	r.RecordWorkerHeartbeats("ns", "ns-name", nil /* principal */, []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker1"}})
+	r.RecordWorkerHeartbeats("ns", "ns-name", nil /* principal */, []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker1"}})
+
+	// A failed save is retried on the next round.
+	store.EXPECT().SaveWorkerRegistrySnapshot(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("unavailable")).Times(1)
+	r.persistDirtyNamespaces()
+
+	var saved *persistence.SaveWorkerRegistrySnapshotRequest
+	store.EXPECT().SaveWorkerRegistrySnapshot(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, request *persistence.SaveWorkerRegistrySnapshotRequest) (*persistence.SaveWorkerRegistrySnapshotResponse, error) {
+			saved = request
+			return &persistence.SaveWorkerRegistrySnapshotResponse{}, nil
+		}).Times(1)
+	r.persistDirtyNamespaces()
+
+	require.NotNil(t, saved)
+	assert.Equal(t, "ns", saved.NamespaceID)
+	require.Len(t, saved.Snapshot.GetWorkers(), 1)
+	assert.Equal(t, "worker1", saved.Snapshot.GetWorkers()[0].GetHeartbeat().GetWorkerInstanceKey())
+	assert.Len(t, saved.Snapshot.GetWorkers()[0].GetHistory(), 2)
+	assert.NotNil(t, saved.Snapshot.GetWorkers()[0].GetLastSeenTime())
+
+	// Nothing changed since the last save.
+	r.persistDirtyNamespaces()
+}
+
+func TestRegistryImpl_PersistenceDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := persistence.NewMockWorkerRegistryManager(ctrl)
+	params := testPersistentRegistryParams(store)
+	params.PersistenceEnabled = dynamicconfig.GetBoolPropertyFn(false)
+	r := newRegistryImpl(params)
+
+	// No calls to the store are expected.
+	r.RecordWorkerHeartbeats("ns", "ns-name", nil /* principal */, []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker1"}})
+	r.persistDirtyNamespaces()
+	assert.Empty(t, r.getBucket("ns").dirty)
+}
@@ -0,0 +1,118 @@
+package workers
+
+import (
+	"time"
+
+	workerpb "go.temporal.io/api/worker/v1"
+	deploymentspb "go.temporal.io/server/api/deployment/v1"
+	"go.temporal.io/server/common/namespace"
+)
+
+// maxNondeterminismErrorsPerVersion bounds the nondeterminism errors kept per version. Rollouts only need to know
+// that a version has more errors than they tolerate.
+const maxNondeterminismErrorsPerVersion = 1000
+
+type versionKey struct {
+	deploymentName string
+	buildID        string
+}
+
+func newVersionKey(version *deploymentspb.WorkerDeploymentVersion) versionKey {
+	return versionKey{deploymentName: version.GetDeploymentName(), buildID: version.GetBuildId()}
+}
+
+func (k versionKey) matches(hb *workerpb.WorkerHeartbeat) bool {
+	return hb.GetDeploymentVersion().GetDeploymentName() == k.deploymentName &&
+		hb.GetDeploymentVersion().GetBuildId() == k.buildID
+}
+
+// recordNondeterminismError records a nondeterminism error of a version, dropping the oldest errors beyond
+// maxNondeterminismErrorsPerVersion.
+func (b *bucket) recordNondeterminismError(nsID namespace.ID, key versionKey, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	versions, ok := b.nondeterminismErrors[nsID]
+	if !ok {
+		versions = make(map[versionKey][]time.Time)
+		b.nondeterminismErrors[nsID] = versions
+	}
+	errorTimes := versions[key]
+	if len(errorTimes) >= maxNondeterminismErrorsPerVersion {
+		errorTimes = errorTimes[:copy(errorTimes, errorTimes[len(errorTimes)-maxNondeterminismErrorsPerVersion+1:])]
+	}
+	versions[key] = append(errorTimes, now)
+}
+
+// evictNondeterminismErrors removes the nondeterminism errors older than expireBefore.
+func (b *bucket) evictNondeterminismErrors(expireBefore time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for nsID, versions := range b.nondeterminismErrors {
+		for key, errorTimes := range versions {
+			i := 0
+			for i < len(errorTimes) && errorTimes[i].Before(expireBefore) {
+				i++
+			}
+			if i == len(errorTimes) {
+				delete(versions, key)
+			} else {
+				versions[key] = errorTimes[i:]
+			}
+		}
+		if len(versions) == 0 {
+			delete(b.nondeterminismErrors, nsID)
+		}
+	}
+}
+
+// versionHealth aggregates the task counters reported since the given time by the workers of a version, and counts
+// its nondeterminism errors.
+//
+// The result is an approximation that can only undercount:
+//   - task counters are only available for the heartbeats in the history of each worker, which is bounded by
+//     matching.workerRegistryHistorySize. When since is further back than the history covers, the older tasks are
+//     not counted.
+//   - nondeterminism errors are only kept in memory of the matching host that owns the namespace bucket, and are
+//     lost when the host restarts or the ownership moves.
+func (b *bucket) versionHealth(nsID namespace.ID, key versionKey, since time.Time) *deploymentspb.WorkerDeploymentVersionHealth {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	health := &deploymentspb.WorkerDeploymentVersionHealth{}
+	for _, e := range b.namespaces[nsID] {
+		if e.isSystemWorker || !key.matches(e.hb) {
+			continue
+		}
+		health.WorkerCount++
+		for _, sample := range e.history {
+			if sample.GetHeartbeatTime().AsTime().Before(since) {
+				continue
+			}
+			health.WorkflowTasksProcessed += int64(sample.GetWorkflowTaskSlotsInfo().GetLastIntervalProcessedTasks())
+			health.WorkflowTasksFailed += int64(sample.GetWorkflowTaskSlotsInfo().GetLastIntervalFailureTasks())
+			health.ActivityTasksProcessed += int64(sample.GetActivityTaskSlotsInfo().GetLastIntervalProcessedTasks())
+			health.ActivityTasksFailed += int64(sample.GetActivityTaskSlotsInfo().GetLastIntervalFailureTasks())
+		}
+	}
+	for _, errorTime := range b.nondeterminismErrors[nsID][key] {
+		if !errorTime.Before(since) {
+			health.NondeterminismErrors++
+		}
+	}
+	return health
+}
+
+func (m *registryImpl) RecordNondeterminismError(nsID namespace.ID, version *deploymentspb.WorkerDeploymentVersion) {
+	m.getBucket(nsID).recordNondeterminismError(nsID, newVersionKey(version), time.Now())
+}
+
+func (m *registryImpl) GetVersionHealth(
+	nsID namespace.ID,
+	version *deploymentspb.WorkerDeploymentVersion,
+	since time.Time,
+) *deploymentspb.WorkerDeploymentVersionHealth {
+	m.restoreNamespace(nsID)
+	return m.getBucket(nsID).versionHealth(nsID, newVersionKey(version), since)
+}
@@ -0,0 +1,83 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	deploymentpb "go.temporal.io/api/deployment/v1"
+	workerpb "go.temporal.io/api/worker/v1"
+	deploymentspb "go.temporal.io/server/api/deployment/v1"
+	"go.temporal.io/server/common/metrics"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func versionHeartbeat(key, buildID string, heartbeatTime time.Time, processed, failed int32) *workerpb.WorkerHeartbeat {
+	return &workerpb.WorkerHeartbeat{
+		WorkerInstanceKey: key,
+		HeartbeatTime:     timestamppb.New(heartbeatTime),
+		DeploymentVersion: &deploymentpb.WorkerDeploymentVersion{DeploymentName: "deployment", BuildId: buildID},
+		WorkflowTaskSlotsInfo: &workerpb.WorkerSlotsInfo{
+			LastIntervalProcessedTasks: processed,
+			LastIntervalFailureTasks:   failed,
+		},
+		ActivityTaskSlotsInfo: &workerpb.WorkerSlotsInfo{
+			LastIntervalProcessedTasks: 2 * processed,
+			LastIntervalFailureTasks:   2 * failed,
+		},
+	}
+}
+
+func TestRegistryImpl_GetVersionHealth(t *testing.T) {
+	params := testDefaultRegistryParams(metrics.NoopMetricsHandler)
+	params.LivenessConfig = testLivenessConfig()
+	r := newRegistryImpl(params)
+
+	now := time.Now()
+	since := now.Add(-time.Minute)
+	r.upsertHeartbeats("ns", nil /* principal */, []*workerpb.WorkerHeartbeat{
+		versionHeartbeat("worker1", "v1", now.Add(-2*time.Minute), 100, 100),
+		versionHeartbeat("worker2", "v1", now, 10, 1),
+		versionHeartbeat("worker3", "v2", now, 10, 10),
+	})
+	r.upsertHeartbeats("ns", nil /* principal */, []*workerpb.WorkerHeartbeat{
+		versionHeartbeat("worker1", "v1", now, 5, 0),
+	})
+
+	version := &deploymentspb.WorkerDeploymentVersion{DeploymentName: "deployment", BuildId: "v1"}
+	r.RecordNondeterminismError("ns", version)
+	r.RecordNondeterminismError("ns", &deploymentspb.WorkerDeploymentVersion{DeploymentName: "deployment", BuildId: "v2"})
+
+	health := r.GetVersionHealth("ns", version, since)
+	assert.Equal(t, int32(2), health.GetWorkerCount())
+	assert.Equal(t, int64(15), health.GetWorkflowTasksProcessed(), "heartbeats before since should be skipped")
+	assert.Equal(t, int64(1), health.GetWorkflowTasksFailed())
+	assert.Equal(t, int64(30), health.GetActivityTasksProcessed())
+	assert.Equal(t, int64(2), health.GetActivityTasksFailed())
+	assert.Equal(t, int64(1), health.GetNondeterminismErrors())
+
+	health = r.GetVersionHealth("ns", version, now.Add(time.Minute))
+	assert.Zero(t, health.GetWorkflowTasksProcessed())
+	assert.Zero(t, health.GetNondeterminismErrors())
+
+	health = r.GetVersionHealth("other-ns", version, since)
+	assert.Zero(t, health.GetWorkerCount())
+}
+
+func TestBucket_NondeterminismErrors(t *testing.T) {
+	b := newBucket()
+	key := versionKey{deploymentName: "deployment", buildID: "v1"}
+	start := time.Now()
+	for i := range maxNondeterminismErrorsPerVersion + 10 {
+		b.recordNondeterminismError("ns", key, start.Add(time.Duration(i)*time.Second))
+	}
+	errorTimes := b.nondeterminismErrors["ns"][key]
+	assert.Len(t, errorTimes, maxNondeterminismErrorsPerVersion)
+	assert.Equal(t, start.Add(10*time.Second), errorTimes[0], "oldest errors should be dropped")
+
+	b.evictNondeterminismErrors(start.Add(time.Duration(maxNondeterminismErrorsPerVersion) * time.Second))
+	assert.Len(t, b.nondeterminismErrors["ns"][key], 10)
+
+	b.evictNondeterminismErrors(start.Add(time.Hour))
+	assert.Empty(t, b.nondeterminismErrors)
+}
@@ -23,6 +23,7 @@ const (
 	workerStartTimeColName      = "StartTime"
 	workerHeartbeatTimeColName  = "HeartbeatTime"
 	workerStatusColName         = "WorkerStatus"
+	workerLivenessColName       = "Liveness"
 	// "Status" is a SQL reserved word, so the parser lowercases and backtick-quotes it.
 	// After stripping backticks we get "status".
 	workerStatusColNameAlias = "status"
@@ -51,6 +52,7 @@ The following worker attributes are supported as part of the query:
 * StartTime
 * HeartbeatTime
 * WorkerStatus (or Status)
+* Liveness: Healthy, Stale (stopped heartbeating) or Saturated (slots persistently used up)
 Currently metrics are not supported as a part of ListWorkers query.
 
 Field names are case-sensitive.
@@ -63,7 +65,7 @@ Example query:
 	"TaskQueue = 'my_task_queue' AND HeartbeatTime < '2023-10-27T10:30:00Z' "
 
 Different fields can support different operators.
-  - string fields (e.g., WorkerIdentity, HostName, TaskQueue, DeploymentName, BuildId, SdkName, SdkVersion):
+  - string fields (e.g., WorkerIdentity, HostName, TaskQueue, DeploymentName, BuildId, SdkName, SdkVersion, Liveness):
 		=, !=, starts_with, not starts_with, IS NULL, IS NOT NULL
   - time fields (e.g., StartTime, HeartbeatTime):
 		 =, !=, >, >=, <, <=, between, IS NULL, IS NOT NULL
@@ -145,11 +147,18 @@ type workerQueryEngine struct {
 	query                 string
 	parsedWhereExpression sqlparser.Expr
 	currentWorker         *workerpb.WorkerHeartbeat // Current worker heartbeat being evaluated
+	currentLiveness       string                    // Liveness of the current worker
 }
 
 func (w *workerQueryEngine) EvaluateWorker(hb *workerpb.WorkerHeartbeat) (bool, error) {
+	return w.EvaluateWorkerWithLiveness(hb, workerLivenessHealthy)
+}
 
+// EvaluateWorkerWithLiveness evaluates the query against a worker heartbeat and the liveness the registry computed
+// for the worker.
+func (w *workerQueryEngine) EvaluateWorkerWithLiveness(hb *workerpb.WorkerHeartbeat, liveness string) (bool, error) {
 	w.currentWorker = hb
+	w.currentLiveness = liveness
 	return w.evaluateExpression(w.parsedWhereExpression)
 }
 
@@ -283,6 +292,8 @@ func (w *workerQueryEngine) evaluateIsExpr(expr *sqlparser.IsExpr) (bool, error)
 	}
 
 	switch colName {
+	case workerLivenessColName:
+		return (w.currentLiveness == "") == isNull, nil
 	case workerStartTimeColName, workerHeartbeatTimeColName:
 		timeValue, err := w.getTimeValue(colName)
 		if err != nil {
@@ -321,8 +332,20 @@ func (w *workerQueryEngine) evaluateComparison(expr *sqlparser.ComparisonExpr) (
 		return compareQueryString(val, existingVal, expr.Operator, colName)
 	}
 
-	// If not, then check if the column name is a valid time column.
+	// If not, then check if the column name is a valid time or liveness column.
 	switch colName {
+	case workerLivenessColName:
+		val, err := sqlquery.ExtractStringValue(valStr)
+		if err != nil {
+			return false, serviceerror.NewInvalidArgumentf("invalid value for %s: %v", colName, err)
+		}
+		switch val {
+		case workerLivenessHealthy, workerLivenessStale, workerLivenessSaturated:
+		default:
+			return false, serviceerror.NewInvalidArgumentf("invalid value for %s: %s, must be one of %s, %s or %s",
+				colName, val, workerLivenessHealthy, workerLivenessStale, workerLivenessSaturated)
+		}
+		return compareQueryString(val, w.currentLiveness, expr.Operator, colName)
 	case workerStartTimeColName:
 		expectedTime, err := sqlquery.ConvertToTime(valStr)
 		if err != nil {
@@ -522,3 +522,22 @@ func TestWorkerQueryEngine_IsExprRejectsUnsupportedOperators(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not supported")
 }
+
+func TestWorkerQueryEngine_Liveness(t *testing.T) {
+	hb := &workerpb.WorkerHeartbeat{TaskQueue: "task_queue"}
+
+	engine, err := newWorkerQueryEngine("nsID", fmt.Sprintf("%s = '%s'", workerLivenessColName, workerLivenessSaturated))
+	require.NoError(t, err)
+	match, err := engine.EvaluateWorkerWithLiveness(hb, workerLivenessSaturated)
+	require.NoError(t, err)
+	assert.True(t, match)
+	match, err = engine.EvaluateWorker(hb)
+	require.NoError(t, err)
+	assert.False(t, match, "workers are healthy unless the registry says otherwise")
+
+	engine, err = newWorkerQueryEngine("nsID", fmt.Sprintf("%s = 'Dead'", workerLivenessColName))
+	require.NoError(t, err)
+	_, err = engine.EvaluateWorker(hb)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be one of")
+}
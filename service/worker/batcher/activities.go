@@ -53,6 +53,8 @@ type batchProcessorConfig struct {
 	concurrency       int
 	initialPageToken  []byte
 	initialExecutions []*commonpb.WorkflowExecution
+	// standaloneActivities is set when the query lists standalone activity executions instead of workflows.
+	standaloneActivities bool
 }
 
 // batchWorkerProcessor defines the interface for different worker processor types
@@ -200,7 +202,7 @@ func (a *activities) processWorkflowsWithProactiveFetching(
 	} else {
 		// Fetch page of executions if needed
 		var err error
-		p, err = fetchPage(ctx, sdkClient, config, config.initialPageToken, hbd.CurrentPage)
+		p, err = a.fetchPage(ctx, sdkClient, config, config.initialPageToken, hbd.CurrentPage)
 		if err != nil {
 			metrics.BatcherOperationFailures.With(metricsHandler).Record(1)
 			return HeartBeatDetails{}, fmt.Errorf("failed to fetch next page: %w", err)
@@ -210,7 +212,7 @@ func (a *activities) processWorkflowsWithProactiveFetching(
 	for {
 		// Check if we need to fetch next page
 		if p.hasNext() && p.allSubmitted() {
-			nextPage, err := fetchPage(ctx, sdkClient, config, p.nextPageToken, p.pageNumber+1)
+			nextPage, err := a.fetchPage(ctx, sdkClient, config, p.nextPageToken, p.pageNumber+1)
 			if err != nil {
 				metrics.BatcherOperationFailures.With(metricsHandler).Record(1)
 				return HeartBeatDetails{}, fmt.Errorf("failed to fetch next page: %w", err)
@@ -262,6 +264,20 @@ func (a *activities) processWorkflowsWithProactiveFetching(
 	return hbd, nil
 }
 
+// fetchPage fetches a new page of workflow or standalone activity executions, depending on the config.
+func (a *activities) fetchPage(
+	ctx context.Context,
+	sdkClient sdkclient.Client,
+	config batchProcessorConfig,
+	pageToken []byte,
+	pageNumber int,
+) (*page, error) {
+	if config.standaloneActivities && len(config.adjustedQuery) > 0 {
+		return fetchStandaloneActivityPage(ctx, a.FrontendClient, config, pageToken, pageNumber)
+	}
+	return fetchPage(ctx, sdkClient, config, pageToken, pageNumber)
+}
+
 type activities struct {
 	activityDeps
 	namespace   namespace.Name
@@ -332,9 +348,7 @@ func (a *activities) BatchActivityWithProtobuf(ctx context.Context, batchParams
 	if startOver {
 		estimateCount := int64(len(executions))
 		if len(visibilityQuery) > 0 {
-			resp, err := sdkClient.CountWorkflow(ctx, &workflowservice.CountWorkflowExecutionsRequest{
-				Query: visibilityQuery,
-			})
+			count, err := a.countExecutions(ctx, sdkClient, batchParams, visibilityQuery)
 			if err != nil {
 				metrics.BatcherOperationFailures.With(metricsHandler).Record(1)
 				logger.Error("Failed to get estimate workflow count", tag.Error(err))
@@ -344,19 +358,20 @@ func (a *activities) BatchActivityWithProtobuf(ctx context.Context, batchParams
 				}
 				return HeartBeatDetails{}, err
 			}
-			estimateCount = resp.GetCount()
+			estimateCount = count
 		}
 		hbd.TotalEstimate = estimateCount
 	}
 
 	// Prepare configuration for shared processing function
 	config := batchProcessorConfig{
-		namespace:         ns,
-		adjustedQuery:     visibilityQuery,
-		rps:               float64(a.rps(ns)),
-		concurrency:       a.getOperationConcurrency(int(batchParams.Concurrency)),
-		initialPageToken:  hbd.PageToken,
-		initialExecutions: executions,
+		namespace:            ns,
+		adjustedQuery:        visibilityQuery,
+		rps:                  float64(a.rps(ns)),
+		concurrency:          a.getOperationConcurrency(int(batchParams.Concurrency)),
+		initialPageToken:     hbd.PageToken,
+		initialExecutions:    executions,
+		standaloneActivities: batchParams.GetStandaloneActivities(),
 	}
 
 	// Create a wrapper for the task processor
@@ -376,6 +391,25 @@ func (a *activities) BatchActivityWithProtobuf(ctx context.Context, batchParams
 	return a.processWorkflowsWithProactiveFetching(ctx, config, workerProcessor, sdkClient, metricsHandler, logger, hbd)
 }
 
+func (a *activities) countExecutions(
+	ctx context.Context,
+	sdkClient sdkclient.Client,
+	batchParams *batchspb.BatchOperationInput,
+	query string,
+) (int64, error) {
+	if batchParams.GetStandaloneActivities() {
+		resp, err := a.FrontendClient.CountActivityExecutions(ctx, &workflowservice.CountActivityExecutionsRequest{
+			Namespace: a.namespace.String(),
+			Query:     query,
+		})
+		return resp.GetCount(), err
+	}
+	resp, err := sdkClient.CountWorkflow(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Query: query,
+	})
+	return resp.GetCount(), err
+}
+
 func (a *activities) getActivityLogger(ctx context.Context) log.Logger {
 	wfInfo := activity.GetInfo(ctx)
 	return log.With(
@@ -447,6 +481,12 @@ func (a *activities) startTaskProcessor(
 				continue
 			}
 
+			if batchOperation.GetStandaloneActivities() {
+				err = processStandaloneActivityTask(ctx, batchOperation, namespace, task, limiter, frontendClient)
+				a.handleTaskResult(batchOperation, task, err, taskCh, respCh, metricsHandler, logger)
+				continue
+			}
+
 			switch operation := batchOperation.Request.Operation.(type) {
 			case *workflowservice.StartBatchOperationRequest_TerminationOperation:
 				err = processTask(ctx, limiter, task,
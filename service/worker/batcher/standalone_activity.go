@@ -0,0 +1,193 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/temporalio/sqlparser"
+	batchpb "go.temporal.io/api/batch/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	batchspb "go.temporal.io/server/api/batch/v1"
+	"go.temporal.io/server/chasm/lib/activity"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+	"golang.org/x/time/rate"
+)
+
+// RewriteStandaloneActivityQuery reports whether a batch visibility query targets standalone activity executions.
+// A query targets standalone activities when it filters TemporalNamespaceDivision on the activity archetype, either by
+// name (e.g. TemporalNamespaceDivision = 'activity.activity') or by ID. Archetype names are replaced by the ID stored
+// in visibility. Queries that don't target standalone activities are returned unchanged.
+func RewriteStandaloneActivityQuery(query string) (string, bool, error) {
+	if query == "" {
+		return query, false, nil
+	}
+	stmt, err := sqlparser.Parse("select * from dummy where " + query)
+	if err != nil {
+		return "", false, serviceerror.NewInvalidArgumentf("invalid visibility query: %v", err)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil {
+		return query, false, nil
+	}
+
+	archetypeID := strconv.FormatUint(uint64(activity.ArchetypeID), 10)
+	found := false
+	rewritten := false
+	err = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		expr, ok := node.(*sqlparser.ComparisonExpr)
+		if !ok || expr.Operator != sqlparser.EqualStr {
+			return true, nil
+		}
+		col, ok := expr.Left.(*sqlparser.ColName)
+		if !ok || !strings.EqualFold(col.Name.String(), sadefs.TemporalNamespaceDivision) {
+			return true, nil
+		}
+		val, ok := expr.Right.(*sqlparser.SQLVal)
+		if !ok || val.Type != sqlparser.StrVal {
+			return true, nil
+		}
+		switch string(val.Val) {
+		case activity.Archetype:
+			val.Val = []byte(archetypeID)
+			found = true
+			rewritten = true
+		case archetypeID:
+			found = true
+		}
+		return false, nil
+	}, sel.Where.Expr)
+	if err != nil {
+		return "", false, err
+	}
+	if !rewritten {
+		return query, found, nil
+	}
+	return sqlparser.String(sel.Where.Expr), found, nil
+}
+
+// ValidateStandaloneActivityBatchOperation validates a batch operation on standalone activity executions and narrows
+// its visibility query to the targeted activity type, if any. Only termination, cancellation and resetting activity
+// attempts are supported.
+func ValidateStandaloneActivityBatchOperation(request *workflowservice.StartBatchOperationRequest) error {
+	if len(request.GetExecutions()) != 0 {
+		return serviceerror.NewInvalidArgument("standalone activities can only be targeted by a visibility query")
+	}
+	switch op := request.GetOperation().(type) {
+	case *workflowservice.StartBatchOperationRequest_TerminationOperation,
+		*workflowservice.StartBatchOperationRequest_CancellationOperation:
+		return nil
+	case *workflowservice.StartBatchOperationRequest_ResetActivitiesOperation:
+		if op.ResetActivitiesOperation.GetRestoreOriginalOptions() {
+			return serviceerror.NewInvalidArgument("restoring original options is not supported for standalone activities")
+		}
+		if t, ok := op.ResetActivitiesOperation.GetActivity().(*batchpb.BatchOperationResetActivities_Type); ok {
+			escapedType := sqlparser.String(sqlparser.NewStrVal([]byte(t.Type)))
+			request.VisibilityQuery = fmt.Sprintf("(%s) AND (ActivityType = %s)", request.GetVisibilityQuery(), escapedType)
+		}
+		return nil
+	default:
+		return serviceerror.NewInvalidArgumentf("The operation type %T is not supported for standalone activities", op)
+	}
+}
+
+// fetchStandaloneActivityPage fetches a new page of standalone activity executions. The activity ID and run ID of each
+// execution are returned in the workflow execution of the info so that pages are processed like workflow pages.
+func fetchStandaloneActivityPage(
+	ctx context.Context,
+	frontendClient workflowservice.WorkflowServiceClient,
+	config batchProcessorConfig,
+	pageToken []byte,
+	pageNumber int,
+) (*page, error) {
+	resp, err := frontendClient.ListActivityExecutions(ctx, &workflowservice.ListActivityExecutionsRequest{
+		Namespace:     config.namespace,
+		PageSize:      int32(pageSize),
+		NextPageToken: pageToken,
+		Query:         config.adjustedQuery,
+	})
+	if err != nil {
+		var invalidArgErr *serviceerror.InvalidArgument
+		if errors.As(err, &invalidArgErr) {
+			return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidArgument", err)
+		}
+		return nil, err
+	}
+
+	executionInfos := make([]*workflowpb.WorkflowExecutionInfo, 0, len(resp.Executions))
+	for _, info := range resp.Executions {
+		executionInfos = append(executionInfos, &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: info.GetActivityId(),
+				RunId:      info.GetRunId(),
+			},
+		})
+	}
+
+	return &page{
+		executionInfos: executionInfos,
+		nextPageToken:  resp.NextPageToken,
+		pageNumber:     pageNumber,
+	}, nil
+}
+
+func processStandaloneActivityTask(
+	ctx context.Context,
+	batchOperation *batchspb.BatchOperationInput,
+	namespace string,
+	task task,
+	limiter *rate.Limiter,
+	frontendClient workflowservice.WorkflowServiceClient,
+) error {
+	request := batchOperation.Request
+	// The job ID is used as request ID so that retried tasks and restarted batches are deduplicated.
+	switch operation := request.Operation.(type) {
+	case *workflowservice.StartBatchOperationRequest_TerminationOperation:
+		return processTask(ctx, limiter, task,
+			func(executionInfo *workflowpb.WorkflowExecutionInfo) error {
+				_, err := frontendClient.TerminateActivityExecution(ctx, &workflowservice.TerminateActivityExecutionRequest{
+					Namespace:  namespace,
+					ActivityId: executionInfo.Execution.WorkflowId,
+					RunId:      executionInfo.Execution.RunId,
+					Identity:   operation.TerminationOperation.GetIdentity(),
+					RequestId:  request.GetJobId(),
+					Reason:     request.Reason,
+				})
+				return err
+			})
+	case *workflowservice.StartBatchOperationRequest_CancellationOperation:
+		return processTask(ctx, limiter, task,
+			func(executionInfo *workflowpb.WorkflowExecutionInfo) error {
+				_, err := frontendClient.RequestCancelActivityExecution(ctx, &workflowservice.RequestCancelActivityExecutionRequest{
+					Namespace:  namespace,
+					ActivityId: executionInfo.Execution.WorkflowId,
+					RunId:      executionInfo.Execution.RunId,
+					Identity:   operation.CancellationOperation.GetIdentity(),
+					RequestId:  request.GetJobId(),
+					Reason:     request.Reason,
+				})
+				return err
+			})
+	case *workflowservice.StartBatchOperationRequest_ResetActivitiesOperation:
+		return processTask(ctx, limiter, task,
+			func(executionInfo *workflowpb.WorkflowExecutionInfo) error {
+				_, err := frontendClient.ResetActivityExecution(ctx, &workflowservice.ResetActivityExecutionRequest{
+					Namespace:      namespace,
+					ActivityId:     executionInfo.Execution.WorkflowId,
+					RunId:          executionInfo.Execution.RunId,
+					Identity:       operation.ResetActivitiesOperation.GetIdentity(),
+					ResetHeartbeat: operation.ResetActivitiesOperation.GetResetHeartbeat(),
+					Jitter:         operation.ResetActivitiesOperation.GetJitter(),
+				})
+				return err
+			})
+	default:
+		return fmt.Errorf("unknown batch type for standalone activities: %v", batchOperation.BatchType)
+	}
+}
@@ -0,0 +1,268 @@
+package batcher
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	activitypb "go.temporal.io/api/activity/v1"
+	batchpb "go.temporal.io/api/batch/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	batchspb "go.temporal.io/server/api/batch/v1"
+	"go.temporal.io/server/chasm/lib/activity"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/time/rate"
+)
+
+func TestRewriteStandaloneActivityQuery(t *testing.T) {
+	archetypeID := strconv.FormatUint(uint64(activity.ArchetypeID), 10)
+
+	testCases := []struct {
+		name           string
+		query          string
+		expectedQuery  string
+		expectedTarget bool
+	}{
+		{
+			name:          "empty query",
+			query:         "",
+			expectedQuery: "",
+		},
+		{
+			name:          "workflow query is unchanged",
+			query:         "WorkflowType = 'foo'",
+			expectedQuery: "WorkflowType = 'foo'",
+		},
+		{
+			name:           "archetype name is replaced by ID",
+			query:          "TemporalNamespaceDivision = 'activity.activity' AND ActivityType = 'foo'",
+			expectedQuery:  "TemporalNamespaceDivision = '" + archetypeID + "' and ActivityType = 'foo'",
+			expectedTarget: true,
+		},
+		{
+			name:           "archetype ID is kept",
+			query:          "TemporalNamespaceDivision = '" + archetypeID + "'",
+			expectedQuery:  "TemporalNamespaceDivision = '" + archetypeID + "'",
+			expectedTarget: true,
+		},
+		{
+			name:          "other namespace division is unchanged",
+			query:         "TemporalNamespaceDivision = 'TemporalScheduler'",
+			expectedQuery: "TemporalNamespaceDivision = 'TemporalScheduler'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query, target, err := RewriteStandaloneActivityQuery(tc.query)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedQuery, query)
+			require.Equal(t, tc.expectedTarget, target)
+		})
+	}
+}
+
+func TestValidateStandaloneActivityBatchOperation(t *testing.T) {
+	t.Run("terminate is supported", func(t *testing.T) {
+		request := &workflowservice.StartBatchOperationRequest{
+			VisibilityQuery: "TemporalNamespaceDivision = '1'",
+			Operation: &workflowservice.StartBatchOperationRequest_TerminationOperation{
+				TerminationOperation: &batchpb.BatchOperationTermination{},
+			},
+		}
+		require.NoError(t, ValidateStandaloneActivityBatchOperation(request))
+		require.Equal(t, "TemporalNamespaceDivision = '1'", request.VisibilityQuery)
+	})
+
+	t.Run("reset by type narrows the query", func(t *testing.T) {
+		request := &workflowservice.StartBatchOperationRequest{
+			VisibilityQuery: "TemporalNamespaceDivision = '1'",
+			Operation: &workflowservice.StartBatchOperationRequest_ResetActivitiesOperation{
+				ResetActivitiesOperation: &batchpb.BatchOperationResetActivities{
+					Activity: &batchpb.BatchOperationResetActivities_Type{Type: "it's"},
+				},
+			},
+		}
+		require.NoError(t, ValidateStandaloneActivityBatchOperation(request))
+		require.Equal(t, `(TemporalNamespaceDivision = '1') AND (ActivityType = 'it\'s')`, request.VisibilityQuery)
+	})
+
+	t.Run("reset with original options is rejected", func(t *testing.T) {
+		request := &workflowservice.StartBatchOperationRequest{
+			Operation: &workflowservice.StartBatchOperationRequest_ResetActivitiesOperation{
+				ResetActivitiesOperation: &batchpb.BatchOperationResetActivities{RestoreOriginalOptions: true},
+			},
+		}
+		require.Error(t, ValidateStandaloneActivityBatchOperation(request))
+	})
+
+	t.Run("signal is rejected", func(t *testing.T) {
+		request := &workflowservice.StartBatchOperationRequest{
+			Operation: &workflowservice.StartBatchOperationRequest_SignalOperation{
+				SignalOperation: &batchpb.BatchOperationSignal{Signal: "foo"},
+			},
+		}
+		require.Error(t, ValidateStandaloneActivityBatchOperation(request))
+	})
+
+	t.Run("executions are rejected", func(t *testing.T) {
+		request := &workflowservice.StartBatchOperationRequest{
+			Executions: []*commonpb.WorkflowExecution{{WorkflowId: "foo"}},
+			Operation: &workflowservice.StartBatchOperationRequest_TerminationOperation{
+				TerminationOperation: &batchpb.BatchOperationTermination{},
+			},
+		}
+		require.Error(t, ValidateStandaloneActivityBatchOperation(request))
+	})
+}
+
+func TestFetchStandaloneActivityPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(ctrl)
+
+	frontendClient.EXPECT().
+		ListActivityExecutions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *workflowservice.ListActivityExecutionsRequest, _ ...any) (*workflowservice.ListActivityExecutionsResponse, error) {
+			require.Equal(t, "test-namespace", req.GetNamespace())
+			require.Equal(t, "ActivityType = 'foo'", req.GetQuery())
+			require.Equal(t, []byte("token"), req.GetNextPageToken())
+			return &workflowservice.ListActivityExecutionsResponse{
+				Executions: []*activitypb.ActivityExecutionListInfo{
+					{ActivityId: "activity-1", RunId: "run-1"},
+					{ActivityId: "activity-2", RunId: "run-2"},
+				},
+				NextPageToken: []byte("next"),
+			}, nil
+		})
+
+	p, err := fetchStandaloneActivityPage(context.Background(), frontendClient, batchProcessorConfig{
+		namespace:            "test-namespace",
+		adjustedQuery:        "ActivityType = 'foo'",
+		standaloneActivities: true,
+	}, []byte("token"), 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, p.pageNumber)
+	require.Equal(t, []byte("next"), p.nextPageToken)
+	require.Len(t, p.executionInfos, 2)
+	require.Equal(t, "activity-2", p.executionInfos[1].GetExecution().GetWorkflowId())
+	require.Equal(t, "run-2", p.executionInfos[1].GetExecution().GetRunId())
+}
+
+func TestStartTaskProcessor_StandaloneActivities(t *testing.T) {
+	testCases := []struct {
+		name      string
+		operation func(*workflowservice.StartBatchOperationRequest)
+		expect    func(*workflowservicemock.MockWorkflowServiceClient)
+	}{
+		{
+			name: "terminate",
+			operation: func(request *workflowservice.StartBatchOperationRequest) {
+				request.Operation = &workflowservice.StartBatchOperationRequest_TerminationOperation{
+					TerminationOperation: &batchpb.BatchOperationTermination{Identity: "operator"},
+				}
+			},
+			expect: func(client *workflowservicemock.MockWorkflowServiceClient) {
+				client.EXPECT().
+					TerminateActivityExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *workflowservice.TerminateActivityExecutionRequest, _ ...any) (*workflowservice.TerminateActivityExecutionResponse, error) {
+						require.Equal(t, "worker-namespace", req.GetNamespace())
+						require.Equal(t, "activity-id", req.GetActivityId())
+						require.Equal(t, "run-id", req.GetRunId())
+						require.Equal(t, "job-id", req.GetRequestId())
+						require.Equal(t, "test reason", req.GetReason())
+						require.Equal(t, "operator", req.GetIdentity())
+						return &workflowservice.TerminateActivityExecutionResponse{}, nil
+					})
+			},
+		},
+		{
+			name: "cancel",
+			operation: func(request *workflowservice.StartBatchOperationRequest) {
+				request.Operation = &workflowservice.StartBatchOperationRequest_CancellationOperation{
+					CancellationOperation: &batchpb.BatchOperationCancellation{Identity: "operator"},
+				}
+			},
+			expect: func(client *workflowservicemock.MockWorkflowServiceClient) {
+				client.EXPECT().
+					RequestCancelActivityExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *workflowservice.RequestCancelActivityExecutionRequest, _ ...any) (*workflowservice.RequestCancelActivityExecutionResponse, error) {
+						require.Equal(t, "activity-id", req.GetActivityId())
+						require.Equal(t, "job-id", req.GetRequestId())
+						return &workflowservice.RequestCancelActivityExecutionResponse{}, nil
+					})
+			},
+		},
+		{
+			name: "reset attempts",
+			operation: func(request *workflowservice.StartBatchOperationRequest) {
+				request.Operation = &workflowservice.StartBatchOperationRequest_ResetActivitiesOperation{
+					ResetActivitiesOperation: &batchpb.BatchOperationResetActivities{
+						Identity:       "operator",
+						ResetHeartbeat: true,
+						Activity:       &batchpb.BatchOperationResetActivities_MatchAll{MatchAll: true},
+					},
+				}
+			},
+			expect: func(client *workflowservicemock.MockWorkflowServiceClient) {
+				client.EXPECT().
+					ResetActivityExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *workflowservice.ResetActivityExecutionRequest, _ ...any) (*workflowservice.ResetActivityExecutionResponse, error) {
+						require.Equal(t, "activity-id", req.GetActivityId())
+						require.Empty(t, req.GetWorkflowId())
+						require.True(t, req.GetResetHeartbeat())
+						return &workflowservice.ResetActivityExecutionResponse{}, nil
+					})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			ctrl := gomock.NewController(t)
+			frontendClient := workflowservicemock.NewMockWorkflowServiceClient(ctrl)
+			tc.expect(frontendClient)
+
+			a := &activities{
+				activityDeps: activityDeps{
+					FrontendClient: frontendClient,
+					Logger:         log.NewTestLogger(),
+					MetricsHandler: metrics.NoopMetricsHandler,
+				},
+			}
+
+			request := &workflowservice.StartBatchOperationRequest{
+				JobId:  "job-id",
+				Reason: "test reason",
+			}
+			tc.operation(request)
+			batchOperation := &batchspb.BatchOperationInput{
+				NamespaceId:          "namespace-id",
+				Request:              request,
+				StandaloneActivities: true,
+			}
+
+			testPage := &page{
+				executionInfos: []*workflowpb.WorkflowExecutionInfo{
+					{Execution: &commonpb.WorkflowExecution{WorkflowId: "activity-id", RunId: "run-id"}},
+				},
+			}
+			taskCh := make(chan task, 1)
+			respCh := make(chan taskResponse, 1)
+			taskCh <- task{executionInfo: testPage.executionInfos[0], attempts: 1, page: testPage}
+
+			go a.startTaskProcessor(ctx, batchOperation, "worker-namespace", taskCh, respCh, rate.NewLimiter(rate.Limit(100), 1), nil, frontendClient, metrics.NoopMetricsHandler, log.NewTestLogger())
+
+			resp := <-respCh
+			require.NoError(t, resp.err)
+		})
+	}
+}
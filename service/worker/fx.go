@@ -39,6 +39,8 @@ import (
 	"go.temporal.io/server/service/worker/dummy"
 	"go.temporal.io/server/service/worker/migration"
 	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/searchattributemigration"
+	"go.temporal.io/server/service/worker/visibilitybackfill"
 	"go.temporal.io/server/service/worker/workerdeployment"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
@@ -56,6 +58,8 @@ var Module = fx.Options(
 	wcicomponent.Module,
 	dlq.Module,
 	dummy.Module,
+	visibilitybackfill.Module,
+	searchattributemigration.Module,
 	fx.Provide(schedulerpb.NewSchedulerServiceLayeredClient),
 	fx.Provide(
 		func(c resource.HistoryClient) dlq.HistoryClient {
@@ -81,6 +85,7 @@ var Module = fx.Options(
 	),
 	fx.Provide(HostInfoProvider),
 	fx.Provide(VisibilityManagerProvider),
+	fx.Provide(VisibilityBackfillManagerFactoryProvider),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
 	fx.Provide(ConfigProvider),
 	fx.Provide(PersistenceRateLimitingParamsProvider),
@@ -130,6 +135,7 @@ func PersistenceRateLimitingParamsProvider(
 		serviceConfig.OperatorRPSRatio,
 		serviceConfig.PersistenceQPSBurstRatio,
 		serviceConfig.PersistenceDynamicRateLimitingParams,
+		serviceConfig.PersistenceConcurrencyLimitingParams,
 		persistenceLazyLoadedServiceResolver,
 		logger,
 	)
@@ -194,6 +200,48 @@ func VisibilityManagerProvider(
 	)
 }
 
+// VisibilityBackfillManagerFactoryProvider provides the factory of the visibility manager used to backfill the
+// secondary visibility store. Unlike the worker visibility manager, it is able to write to Elasticsearch.
+func VisibilityBackfillManagerFactoryProvider(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	persistenceConfig *config.Persistence,
+	customVisibilityStoreFactory visibility.VisibilityStoreFactory,
+	serviceConfig *Config,
+	persistenceServiceResolver resolver.ServiceResolver,
+	searchAttributesMapperProvider searchattribute.MapperProvider,
+	saProvider searchattribute.Provider,
+	namespaceRegistry namespace.Registry,
+	chasmRegistry *chasm.Registry,
+	serializer serialization.Serializer,
+) visibilitybackfill.VisibilityManagerFactory {
+	return func() (manager.VisibilityManager, error) {
+		return visibility.NewManager(
+			*persistenceConfig,
+			persistenceServiceResolver,
+			customVisibilityStoreFactory,
+			serviceConfig.VisibilityBackfillProcessorConfig,
+			saProvider,
+			searchAttributesMapperProvider,
+			namespaceRegistry,
+			chasmRegistry,
+			serviceConfig.VisibilityPersistenceMaxReadQPS,
+			serviceConfig.VisibilityPersistenceMaxWriteQPS,
+			serviceConfig.OperatorRPSRatio,
+			serviceConfig.VisibilityPersistenceSlowQueryThreshold,
+			serviceConfig.EnableReadFromSecondaryVisibility,
+			serviceConfig.VisibilityEnableShadowReadMode,
+			dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // backfill writes to the secondary store directly
+			serviceConfig.VisibilityDisableOrderByClause,
+			serviceConfig.VisibilityEnableManualPagination,
+			serviceConfig.VisibilityEnableUnifiedQueryConverter,
+			metricsHandler,
+			logger,
+			serializer,
+		)
+	}
+}
+
 func ServiceLifetimeHooks(lc fx.Lifecycle, svc *Service) {
 	lc.Append(fx.StartStopHook(svc.Start, svc.Stop))
 }
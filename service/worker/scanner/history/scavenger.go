@@ -33,6 +33,10 @@ type (
 		ErrorCount   int
 		SkipCount    int
 		CurrentPage  int
+		// number of branches of existing executions that are not referenced by their mutable state
+		UnreferencedCount int
+		// size of the garbage history branches found so far, by namespace ID
+		ReclaimableBytes map[string]int64
 
 		NextPageToken []byte
 	}
@@ -54,6 +58,9 @@ type (
 		historyDataMinAge           dynamicconfig.DurationPropertyFn
 		executionDataDurationBuffer dynamicconfig.DurationPropertyFn
 		enableRetentionVerification dynamicconfig.BoolPropertyFn
+		dryRun                      dynamicconfig.BoolPropertyFn
+		reportReclaimableBytes      dynamicconfig.BoolPropertyFn
+		historyBranchUtil           persistence.HistoryBranchUtil
 
 		sync.WaitGroup
 		sync.Mutex
@@ -65,6 +72,7 @@ type (
 		namespaceID string
 		workflowID  string
 		runID       string
+		branchInfo  *persistencespb.HistoryBranch
 		branchToken []byte
 	}
 )
@@ -81,6 +89,12 @@ const (
 // each branch, the scavenger will attempt
 //   - describe the corresponding workflow execution
 //   - deletion of history itself, if there are no workflow execution
+//   - deletion of history itself, if the workflow execution exists but
+//     does not reference the branch in its version histories
+//
+// When enabled, the size of the deleted branches is reported per namespace.
+// In dry run mode, unreferenced branches of existing executions are only
+// reported.
 func NewScavenger(
 	numShards int32,
 	db persistence.ExecutionManager,
@@ -92,6 +106,8 @@ func NewScavenger(
 	historyDataMinAge dynamicconfig.DurationPropertyFn,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	enableRetentionVerification dynamicconfig.BoolPropertyFn,
+	dryRun dynamicconfig.BoolPropertyFn,
+	reportReclaimableBytes dynamicconfig.BoolPropertyFn,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 	serializer serialization.Serializer,
@@ -108,6 +124,9 @@ func NewScavenger(
 		historyDataMinAge:           historyDataMinAge,
 		executionDataDurationBuffer: executionDataDurationBuffer,
 		enableRetentionVerification: enableRetentionVerification,
+		dryRun:                      dryRun,
+		reportReclaimableBytes:      reportReclaimableBytes,
+		historyBranchUtil:           persistence.NewHistoryBranchUtil(serializer),
 		metricsHandler:              metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryScavengerScope)),
 		logger:                      logger,
 		serializer:                  serializer,
@@ -129,6 +148,13 @@ func (s *Scavenger) Run(ctx context.Context) (ScavengerHeartbeatDetails, error)
 
 	s.Lock()
 	defer s.Unlock()
+	for namespaceID, size := range s.hbd.ReclaimableBytes {
+		s.logger.Info("History scavenger found garbage history branches",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.NewInt64("reclaimable-bytes", size),
+			tag.NewBoolTag("dry-run", s.dryRun()),
+		)
+	}
 	return s.hbd, nil
 }
 
@@ -244,6 +270,7 @@ func (s *Scavenger) filterTask(
 		namespaceID: namespaceID,
 		workflowID:  workflowID,
 		runID:       runID,
+		branchInfo:  branch.BranchInfo,
 		branchToken: branchToken.Data,
 	}
 }
@@ -264,6 +291,19 @@ func (s *Scavenger) handleTask(
 	})
 	switch err.(type) {
 	case nil:
+		if s.isUnreferencedBranch(task, ms.GetDatabaseMutableState()) {
+			metrics.HistoryScavengerUnreferencedBranchCount.With(s.metricsHandler).Record(1)
+			s.Lock()
+			s.hbd.UnreferencedCount++
+			s.Unlock()
+			if s.dryRun() {
+				s.logger.Info("found unreferenced history branch, not deleting it in dry run mode",
+					getTaskLoggingTags(nil, task)...)
+				s.recordReclaimableBytes(ctx, task)
+				return nil
+			}
+			return s.deleteBranch(ctx, task)
+		}
 		if s.enableRetentionVerification() {
 			return s.cleanUpWorkflowPastRetention(ctx, ms.GetDatabaseMutableState())
 		}
@@ -275,8 +315,47 @@ func (s *Scavenger) handleTask(
 		return err
 	}
 
+	return s.deleteBranch(ctx, task)
+}
+
+// isUnreferencedBranch returns whether the execution owns the history tree of the branch, but neither references the
+// branch in its version histories nor forked any referenced branch from it. Such branches are left behind when a
+// reset or conflict resolution fails after forking a new branch.
+func (s *Scavenger) isUnreferencedBranch(
+	task taskDetail,
+	mutableState *persistencespb.WorkflowMutableState,
+) bool {
+	ownsTree := false
+	for _, versionHistory := range mutableState.GetExecutionInfo().GetVersionHistories().GetHistories() {
+		branchInfo, err := s.historyBranchUtil.ParseHistoryBranchInfo(versionHistory.GetBranchToken())
+		if err != nil {
+			s.logger.Error("unable to parse the branch token of the mutable state", getTaskLoggingTags(err, task)...)
+			return false
+		}
+		if branchInfo.GetTreeId() != task.branchInfo.GetTreeId() {
+			continue
+		}
+		ownsTree = true
+		if branchInfo.GetBranchId() == task.branchInfo.GetBranchId() {
+			return false
+		}
+		for _, ancestor := range branchInfo.GetAncestors() {
+			if ancestor.GetBranchId() == task.branchInfo.GetBranchId() {
+				return false
+			}
+		}
+	}
+	return ownsTree
+}
+
+func (s *Scavenger) deleteBranch(
+	ctx context.Context,
+	task taskDetail,
+) error {
+	s.recordReclaimableBytes(ctx, task)
+
 	// deleting history branch
-	err = s.db.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+	err := s.db.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
 		ShardID:     task.shardID,
 		BranchToken: task.branchToken,
 	})
@@ -288,6 +367,68 @@ func (s *Scavenger) handleTask(
 	return err
 }
 
+// recordReclaimableBytes reports the size of the garbage history branch, if enabled. Reading the size costs as much
+// IO as the deletion itself, so it is off by default.
+func (s *Scavenger) recordReclaimableBytes(
+	ctx context.Context,
+	task taskDetail,
+) {
+	if !s.reportReclaimableBytes() {
+		return
+	}
+	size, err := s.branchSize(ctx, task)
+	if err != nil {
+		// the size is only reported, don't keep the garbage around because of it
+		s.logger.Warn("unable to read the size of garbage history branch", getTaskLoggingTags(err, task)...)
+	}
+	metrics.HistoryScavengerReclaimableBytes.With(s.metricsHandler).
+		Record(size, metrics.NamespaceIDTag(task.namespaceID))
+	s.Lock()
+	defer s.Unlock()
+	if s.hbd.ReclaimableBytes == nil {
+		s.hbd.ReclaimableBytes = make(map[string]int64)
+	}
+	s.hbd.ReclaimableBytes[task.namespaceID] += size
+}
+
+// branchSize returns the size of the history nodes of the branch that are not inherited from its ancestors. Nodes
+// still inherited by other branches are not reclaimed on deletion, so this is an upper bound.
+func (s *Scavenger) branchSize(
+	ctx context.Context,
+	task taskDetail,
+) (int64, error) {
+	minEventID := common.FirstEventID
+	if ancestors := task.branchInfo.GetAncestors(); len(ancestors) > 0 {
+		minEventID = ancestors[len(ancestors)-1].GetEndNodeId()
+	}
+
+	var size int64
+	var pageToken []byte
+	for {
+		resp, err := s.db.ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       task.shardID,
+			BranchToken:   task.branchToken,
+			MinEventID:    minEventID,
+			MaxEventID:    common.EndEventID,
+			PageSize:      pageSize,
+			NextPageToken: pageToken,
+		})
+		switch err.(type) {
+		case nil:
+		case *serviceerror.NotFound:
+			// no nodes of its own
+			return size, nil
+		default:
+			return size, err
+		}
+		size += int64(resp.Size)
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return size, nil
+		}
+	}
+}
+
 func (s *Scavenger) handleErr(
 	err error,
 ) {
@@ -13,6 +13,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -102,6 +103,8 @@ func (s *ScavengerTestSuite) createTestScavenger(
 		dataAge,
 		executionDataAge,
 		enableRetentionVerification,
+		dynamicconfig.GetBoolPropertyFn(false),
+		dynamicconfig.GetBoolPropertyFn(false),
 		s.metricHandler,
 		s.logger,
 		serialization.NewSerializer(),
@@ -744,3 +747,175 @@ func (s *ScavengerTestSuite) TestDeleteWorkflowAfterRetention() {
 	s.Equal(2, hbd.CurrentPage)
 	s.Equal(0, len(hbd.NextPageToken))
 }
+
+func (s *ScavengerTestSuite) TestDeletingUnreferencedBranches() {
+	s.scavenger.reportReclaimableBytes = dynamicconfig.GetBoolPropertyFn(true)
+	forkTime := timestamp.TimeNowPtrUtcAddDuration(-s.scavenger.historyDataMinAge() * 2)
+	info := persistence.BuildHistoryGarbageCleanupInfo("namespaceID1", "workflowID1", "runID1")
+	// branch 1 is the current branch of the execution, forked from branch 2 at node 5.
+	// branch 3 was forked from branch 2 at node 3, but never made it to the mutable state.
+	s.mockExecutionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), protomock.Eq(&persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize: pageSize,
+	})).Return(&persistence.GetAllHistoryTreeBranchesResponse{
+		Branches: []persistence.HistoryBranchDetail{
+			{
+				BranchInfo: &persistencespb.HistoryBranch{
+					TreeId:    treeID1,
+					BranchId:  branchID1,
+					Ancestors: []*persistencespb.HistoryBranchRange{{BranchId: branchID2, BeginNodeId: 1, EndNodeId: 5}},
+				},
+				ForkTime: forkTime,
+				Info:     info,
+			},
+			{
+				BranchInfo: &persistencespb.HistoryBranch{
+					TreeId:   treeID1,
+					BranchId: branchID2,
+				},
+				ForkTime: forkTime,
+				Info:     info,
+			},
+			{
+				BranchInfo: &persistencespb.HistoryBranch{
+					TreeId:    treeID1,
+					BranchId:  branchID3,
+					Ancestors: []*persistencespb.HistoryBranchRange{{BranchId: branchID2, BeginNodeId: 1, EndNodeId: 3}},
+				},
+				ForkTime: forkTime,
+				Info:     info,
+			},
+		},
+	}, nil)
+
+	currentBranchToken, err := s.historyBranchUtil.NewHistoryBranch(
+		"namespaceID1",
+		"workflowID1",
+		"runID1",
+		treeID1,
+		&branchID1,
+		[]*persistencespb.HistoryBranchRange{{BranchId: branchID2, BeginNodeId: 1, EndNodeId: 5}},
+		0,
+		0,
+		0,
+	)
+	s.Nil(err)
+	ms := &historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: &historyspb.VersionHistories{
+					Histories: []*historyspb.VersionHistory{{BranchToken: currentBranchToken}},
+				},
+			},
+		},
+	}
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(ms, nil).Times(3)
+
+	shardID := common.WorkflowIDToHistoryShard("namespaceID1", "workflowID1", s.numShards)
+	s.mockExecutionManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadRawHistoryBranchResponse, error) {
+			s.Equal(shardID, request.ShardID)
+			s.Equal(int64(3), request.MinEventID)
+			return &persistence.ReadRawHistoryBranchResponse{Size: 20}, nil
+		},
+	)
+	s.mockExecutionManager.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.DeleteHistoryBranchRequest) error {
+			s.Equal(shardID, request.ShardID)
+			branchInfo, err := s.historyBranchUtil.ParseHistoryBranchInfo(request.BranchToken)
+			s.NoError(err)
+			s.Equal(branchID3, branchInfo.GetBranchId())
+			return nil
+		},
+	)
+
+	hbd, err := s.scavenger.Run(context.Background())
+	s.Nil(err)
+	s.Equal(3, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+	s.Equal(1, hbd.UnreferencedCount)
+	s.Equal(map[string]int64{"namespaceID1": 20}, hbd.ReclaimableBytes)
+}
+
+func (s *ScavengerTestSuite) TestDryRun() {
+	s.scavenger.dryRun = dynamicconfig.GetBoolPropertyFn(true)
+	forkTime := timestamp.TimeNowPtrUtcAddDuration(-s.scavenger.historyDataMinAge() * 2)
+	// branch 1 is the current branch of workflowID1, branch 2 is an unreferenced branch of workflowID1
+	// and branch 3 belongs to workflowID2, which no longer exists.
+	s.mockExecutionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), protomock.Eq(&persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize: pageSize,
+	})).Return(&persistence.GetAllHistoryTreeBranchesResponse{
+		Branches: []persistence.HistoryBranchDetail{
+			{
+				BranchInfo: &persistencespb.HistoryBranch{
+					TreeId:   treeID1,
+					BranchId: branchID1,
+				},
+				ForkTime: forkTime,
+				Info:     persistence.BuildHistoryGarbageCleanupInfo("namespaceID1", "workflowID1", "runID1"),
+			},
+			{
+				BranchInfo: &persistencespb.HistoryBranch{
+					TreeId:    treeID1,
+					BranchId:  branchID2,
+					Ancestors: []*persistencespb.HistoryBranchRange{{BranchId: branchID1, BeginNodeId: 1, EndNodeId: 3}},
+				},
+				ForkTime: forkTime,
+				Info:     persistence.BuildHistoryGarbageCleanupInfo("namespaceID1", "workflowID1", "runID1"),
+			},
+			{
+				BranchInfo: &persistencespb.HistoryBranch{
+					TreeId:   treeID3,
+					BranchId: branchID3,
+				},
+				ForkTime: forkTime,
+				Info:     persistence.BuildHistoryGarbageCleanupInfo("namespaceID2", "workflowID2", "runID2"),
+			},
+		},
+	}, nil)
+
+	currentBranchToken, err := s.historyBranchUtil.NewHistoryBranch(
+		"namespaceID1",
+		"workflowID1",
+		"runID1",
+		treeID1,
+		&branchID1,
+		[]*persistencespb.HistoryBranchRange{},
+		0,
+		0,
+		0,
+	)
+	s.Nil(err)
+	ms := &historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: &historyspb.VersionHistories{
+					Histories: []*historyspb.VersionHistory{{BranchToken: currentBranchToken}},
+				},
+			},
+		},
+	}
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.DescribeMutableStateRequest, _ ...any) (*historyservice.DescribeMutableStateResponse, error) {
+			if request.GetExecution().GetWorkflowId() == "workflowID1" {
+				return ms, nil
+			}
+			return nil, serviceerror.NewNotFound("")
+		},
+	).Times(3)
+	// the branch of the deleted execution is still deleted in dry run mode
+	s.mockExecutionManager.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.DeleteHistoryBranchRequest) error {
+			branchInfo, err := s.historyBranchUtil.ParseHistoryBranchInfo(request.BranchToken)
+			s.NoError(err)
+			s.Equal(branchID3, branchInfo.GetBranchId())
+			return nil
+		},
+	)
+
+	hbd, err := s.scavenger.Run(context.Background())
+	s.Nil(err)
+	s.Equal(3, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+	s.Equal(1, hbd.UnreferencedCount)
+	s.Empty(hbd.ReclaimableBytes)
+}
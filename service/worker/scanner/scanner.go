@@ -27,6 +27,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
 )
 
@@ -55,6 +56,12 @@ type (
 		HistoryScannerDataMinAge dynamicconfig.DurationPropertyFn
 		// HistoryScannerVerifyRetention indicates if the history scavenger to do retention verification
 		HistoryScannerVerifyRetention dynamicconfig.BoolPropertyFn
+		// HistoryScannerDryRun indicates if the history scavenger only reports unreferenced history branches
+		// of existing executions
+		HistoryScannerDryRun dynamicconfig.BoolPropertyFn
+		// HistoryScannerReportReclaimableBytes indicates if the history scavenger reports the size of garbage
+		// history branches
+		HistoryScannerReportReclaimableBytes dynamicconfig.BoolPropertyFn
 		// ExecutionScannerPerHostQPS the max rate of calls to scan execution data per host
 		ExecutionScannerPerHostQPS dynamicconfig.IntPropertyFn
 		// ExecutionScannerPerShardQPS the max rate of calls to scan execution data per shard
@@ -71,6 +78,17 @@ type (
 		RemovableBuildIdDurationSinceDefault dynamicconfig.DurationPropertyFn
 		// BuildIdScavengerVisibilityRPS is the rate limit for visibility calls from the build ID scavenger
 		BuildIdScavengerVisibilityRPS dynamicconfig.FloatPropertyFn
+
+		// VisibilityScannerEnabled indicates if visibility scanner should be started as part of scanner
+		VisibilityScannerEnabled dynamicconfig.BoolPropertyFn
+		// VisibilityScannerSampleRate is the fraction of workflow executions checked by the visibility scanner
+		VisibilityScannerSampleRate dynamicconfig.FloatPropertyFn
+		// VisibilityScannerRPS the max rate of visibility records read by the visibility scanner per host
+		VisibilityScannerRPS dynamicconfig.IntPropertyFn
+		// VisibilityScannerDataMinAge indicates the minimum time since the last update of an execution to check it
+		VisibilityScannerDataMinAge dynamicconfig.DurationPropertyFn
+		// VisibilityScannerRepairEnabled indicates if the visibility scanner should repair mismatching records
+		VisibilityScannerRepairEnabled dynamicconfig.BoolPropertyFn
 	}
 
 	// scannerContext is the context object that gets
@@ -91,6 +109,7 @@ type (
 		currentClusterName string
 		hostInfo           membership.HostInfo
 		serializer         serialization.Serializer
+		saMapperProvider   searchattribute.MapperProvider
 	}
 
 	// Scanner is the background sub-system that does full scans
@@ -124,6 +143,7 @@ func New(
 	currentClusterName string,
 	hostInfo membership.HostInfo,
 	serializer serialization.Serializer,
+	saMapperProvider searchattribute.MapperProvider,
 ) *Scanner {
 	return &Scanner{
 		context: scannerContext{
@@ -142,6 +162,7 @@ func New(
 			currentClusterName: currentClusterName,
 			hostInfo:           hostInfo,
 			serializer:         serializer,
+			saMapperProvider:   saMapperProvider,
 		},
 	}
 }
@@ -183,6 +204,12 @@ func (s *Scanner) Start() error {
 		workerTaskQueueNames = append(workerTaskQueueNames, historyScannerTaskQueueName)
 	}
 
+	if s.context.cfg.VisibilityScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, visibilityScannerWFStartOptions, visibilityScannerWFTypeName)
+		workerTaskQueueNames = append(workerTaskQueueNames, visibilityScannerTaskQueueName)
+	}
+
 	if s.context.cfg.BuildIdScavengerEnabled() {
 		s.wg.Add(1)
 		go s.startWorkflowWithRetry(ctx, build_ids.BuildIdScavengerWFStartOptions, build_ids.BuildIdScavangerWorkflowName)
@@ -216,9 +243,11 @@ func (s *Scanner) Start() error {
 		work.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(VisibilityScannerWorkflow, workflow.RegisterOptions{Name: visibilityScannerWFTypeName})
 		work.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+		work.RegisterActivityWithOptions(VisibilityScavengerActivity, activity.RegisterOptions{Name: visibilityScavengerActivityName})
 
 		// TODO: Nothing is gracefully stopping these workers or listening for fatal errors.
 		if err := work.Start(); err != nil {
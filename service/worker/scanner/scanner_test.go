@@ -49,6 +49,10 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		WFTypeName:    historyScannerWFTypeName,
 		TaskQueueName: historyScannerTaskQueueName,
 	}
+	visibilityScanner := expectedScanner{
+		WFTypeName:    visibilityScannerWFTypeName,
+		TaskQueueName: visibilityScannerTaskQueueName,
+	}
 	buildIdScavenger := expectedScanner{
 		WFTypeName:    build_ids.BuildIdScavangerWorkflowName,
 		TaskQueueName: build_ids.BuildIdScavengerTaskQueueName,
@@ -60,6 +64,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		TaskQueueScannerEnabled  bool
 		HistoryScannerEnabled    bool
 		BuildIdScavengerEnabled  bool
+		VisibilityScannerEnabled bool
 		DefaultStore             string
 		ExpectedScanners         []expectedScanner
 	}
@@ -155,6 +160,12 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 			DefaultStore:             config.StoreTypeSQL,
 			ExpectedScanners:         []expectedScanner{buildIdScavenger},
 		},
+		{
+			Name:                     "VisibilityScanner",
+			VisibilityScannerEnabled: true,
+			DefaultStore:             config.StoreTypeNoSQL,
+			ExpectedScanners:         []expectedScanner{visibilityScanner},
+		},
 		{
 			Name:                     "AllScannersSQL",
 			ExecutionsScannerEnabled: true,
@@ -191,6 +202,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 					BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(c.BuildIdScavengerEnabled),
 					ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.ExecutionsScannerEnabled),
 					TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(c.TaskQueueScannerEnabled),
+					VisibilityScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.VisibilityScannerEnabled),
 					Persistence: &config.Persistence{
 						DefaultStore: c.DefaultStore,
 						DataStores: map[string]config.DataStore{
@@ -215,6 +227,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				"active-cluster",
 				membership.NewHostInfoFromAddress("localhost"),
 				serialization.NewSerializer(),
+				nil,
 			)
 			var wg sync.WaitGroup
 			for _, sc := range c.ExpectedScanners {
@@ -271,6 +284,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
 			BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			VisibilityScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			Persistence: &config.Persistence{
 				DefaultStore: config.StoreTypeNoSQL,
 				DataStores: map[string]config.DataStore{
@@ -292,6 +306,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		"active-cluster",
 		membership.NewHostInfoFromAddress("localhost"),
 		serialization.NewSerializer(),
+		nil,
 	)
 	mockSdkClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
 	worker.EXPECT().RegisterActivityWithOptions(gomock.Any(), gomock.Any()).AnyTimes()
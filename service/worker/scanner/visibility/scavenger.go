@@ -0,0 +1,370 @@
+package visibility
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"reflect"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+)
+
+type (
+	// ScavengerHeartbeatDetails is the heartbeat detail for VisibilityScavengerActivity
+	ScavengerHeartbeatDetails struct {
+		CheckedCount  int
+		MismatchCount int
+		RepairedCount int
+		ErrorCount    int
+		SkipCount     int
+
+		CurrentShardID int32
+		NextPageToken  []byte
+	}
+
+	// Scavenger is the type that holds the state for the visibility scavenger daemon
+	Scavenger struct {
+		numShards                      int32
+		db                             persistence.ExecutionManager
+		visibilityManager              manager.VisibilityManager
+		historyClient                  historyservice.HistoryServiceClient
+		registry                       namespace.Registry
+		searchAttributesMapperProvider searchattribute.MapperProvider
+		rateLimiter                    quotas.RateLimiter
+		metricsHandler                 metrics.Handler
+		logger                         log.Logger
+		isInTest                       bool
+		// only check executions that were not updated for this long, as visibility is eventually consistent
+		dataMinAge    dynamicconfig.DurationPropertyFn
+		sampleRate    dynamicconfig.FloatPropertyFn
+		repairEnabled dynamicconfig.BoolPropertyFn
+
+		hbd ScavengerHeartbeatDetails
+	}
+
+	mismatchType string
+)
+
+const (
+	pageSize = 100
+
+	mismatchMissingRecord    mismatchType = "missing_record"
+	mismatchStatus           mismatchType = "status"
+	mismatchCloseTime        mismatchType = "close_time"
+	mismatchSearchAttributes mismatchType = "search_attributes"
+
+	mismatchTypeTagName = "mismatch_type"
+	// closeTimeTolerance accounts for the lower time precision of some visibility stores.
+	closeTimeTolerance = time.Millisecond
+)
+
+// NewScavenger returns an instance of visibility scavenger daemon
+// The Scavenger can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over all of the workflow executions in the system. For
+// a sample of the executions, the scavenger will
+//   - compare the status, close time and search attributes of the mutable state
+//     against the visibility record
+//   - if enabled, refresh the tasks of the executions with a mismatch to regenerate
+//     their visibility tasks
+func NewScavenger(
+	numShards int32,
+	db persistence.ExecutionManager,
+	visibilityManager manager.VisibilityManager,
+	historyClient historyservice.HistoryServiceClient,
+	registry namespace.Registry,
+	searchAttributesMapperProvider searchattribute.MapperProvider,
+	rps dynamicconfig.IntPropertyFn,
+	dataMinAge dynamicconfig.DurationPropertyFn,
+	sampleRate dynamicconfig.FloatPropertyFn,
+	repairEnabled dynamicconfig.BoolPropertyFn,
+	hbd ScavengerHeartbeatDetails,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Scavenger {
+	return &Scavenger{
+		numShards:                      numShards,
+		db:                             db,
+		visibilityManager:              visibilityManager,
+		historyClient:                  historyClient,
+		registry:                       registry,
+		searchAttributesMapperProvider: searchAttributesMapperProvider,
+		rateLimiter: quotas.NewDefaultOutgoingRateLimiter(
+			func() float64 { return float64(rps()) },
+		),
+		dataMinAge:     dataMinAge,
+		sampleRate:     sampleRate,
+		repairEnabled:  repairEnabled,
+		metricsHandler: metricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityScavengerScope)),
+		logger:         logger,
+		hbd:            hbd,
+	}
+}
+
+// Run runs the scavenger
+func (s *Scavenger) Run(ctx context.Context) (ScavengerHeartbeatDetails, error) {
+	// shard IDs start at 1
+	if s.hbd.CurrentShardID < 1 {
+		s.hbd.CurrentShardID = 1
+	}
+
+	for ; s.hbd.CurrentShardID <= s.numShards; s.hbd.CurrentShardID++ {
+		for {
+			resp, err := s.db.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+				ShardID:   s.hbd.CurrentShardID,
+				PageSize:  pageSize,
+				PageToken: s.hbd.NextPageToken,
+			})
+			if err != nil {
+				return s.hbd, err
+			}
+
+			for _, state := range resp.States {
+				if err := s.handleExecution(ctx, state); err != nil {
+					if ctx.Err() != nil {
+						return s.hbd, ctx.Err()
+					}
+					s.logger.Error("unable to check visibility record", getLoggingTags(err, state)...)
+					metrics.VisibilityScavengerErrorCount.With(s.metricsHandler).Record(1)
+					s.hbd.ErrorCount++
+				}
+			}
+
+			s.hbd.NextPageToken = resp.PageToken
+			s.heartbeat(ctx)
+			if len(s.hbd.NextPageToken) == 0 {
+				break
+			}
+		}
+	}
+	return s.hbd, nil
+}
+
+func (s *Scavenger) heartbeat(ctx context.Context) {
+	if !s.isInTest {
+		activity.RecordHeartbeat(ctx, s.hbd)
+	}
+}
+
+func (s *Scavenger) handleExecution(
+	ctx context.Context,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	if !s.shouldCheck(state) {
+		metrics.VisibilityScavengerSkipCount.With(s.metricsHandler).Record(1)
+		s.hbd.SkipCount++
+		return nil
+	}
+
+	executionInfo := state.GetExecutionInfo()
+	ns, err := s.registry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
+	if err != nil {
+		var nsNotFound *serviceerror.NamespaceNotFound
+		if errors.As(err, &nsNotFound) {
+			metrics.VisibilityScavengerSkipCount.With(s.metricsHandler).Record(1)
+			s.hbd.SkipCount++
+			return nil
+		}
+		return err
+	}
+
+	if err := s.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	mismatch, err := s.checkExecution(ctx, ns, state)
+	if err != nil {
+		return err
+	}
+	metrics.VisibilityScavengerCheckedCount.With(s.metricsHandler).Record(1)
+	s.hbd.CheckedCount++
+	if mismatch == "" {
+		return nil
+	}
+
+	s.logger.Warn("visibility record doesn't match mutable state",
+		append(getLoggingTags(nil, state), tag.NewStringTag(mismatchTypeTagName, string(mismatch)))...)
+	metrics.VisibilityScavengerMismatchCount.With(s.metricsHandler).Record(
+		1,
+		metrics.NamespaceTag(ns.Name().String()),
+		metrics.StringTag(mismatchTypeTagName, string(mismatch)),
+	)
+	s.hbd.MismatchCount++
+
+	if !s.repairEnabled() {
+		return nil
+	}
+	return s.repair(ctx, state)
+}
+
+// shouldCheck returns true if the visibility record of the execution should be checked. Only a sample of the
+// workflow executions that were not updated recently are checked.
+func (s *Scavenger) shouldCheck(state *persistencespb.WorkflowMutableState) bool {
+	switch state.GetExecutionState().GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+	default:
+		return false
+	}
+	if rootNode, ok := state.GetChasmNodes()[""]; ok {
+		if componentAttrs := rootNode.GetMetadata().GetComponentAttributes(); componentAttrs != nil &&
+			chasm.ArchetypeID(componentAttrs.TypeId) != chasm.WorkflowArchetypeID {
+			return false
+		}
+	}
+	lastUpdateTime := timestamp.TimeValue(state.GetExecutionInfo().GetLastUpdateTime())
+	if time.Now().UTC().Add(-s.dataMinAge()).Before(lastUpdateTime) {
+		return false
+	}
+	return rand.Float64() < s.sampleRate()
+}
+
+// checkExecution compares the visibility record of an execution with its mutable state and returns the first
+// mismatch found, if any.
+func (s *Scavenger) checkExecution(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	state *persistencespb.WorkflowMutableState,
+) (mismatchType, error) {
+	executionInfo := state.GetExecutionInfo()
+	executionState := state.GetExecutionState()
+
+	resp, err := s.visibilityManager.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+		NamespaceID: ns.ID(),
+		Namespace:   ns.Name(),
+		WorkflowID:  executionInfo.GetWorkflowId(),
+		RunID:       executionState.GetRunId(),
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return mismatchMissingRecord, nil
+		}
+		return "", err
+	}
+	record := resp.Execution
+
+	if record.GetStatus() != executionState.GetStatus() {
+		return mismatchStatus, nil
+	}
+	if executionState.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING && executionInfo.GetCloseTime() != nil {
+		diff := record.GetCloseTime().AsTime().Sub(executionInfo.GetCloseTime().AsTime())
+		if diff > closeTimeTolerance || diff < -closeTimeTolerance {
+			return mismatchCloseTime, nil
+		}
+	}
+
+	expected, err := searchattribute.AliasFields(
+		s.searchAttributesMapperProvider,
+		&commonpb.SearchAttributes{IndexedFields: executionInfo.GetSearchAttributes()},
+		ns.Name().String(),
+	)
+	if err != nil {
+		return "", err
+	}
+	if !customSearchAttributesEqual(expected.GetIndexedFields(), record.GetSearchAttributes().GetIndexedFields()) {
+		return mismatchSearchAttributes, nil
+	}
+	return "", nil
+}
+
+// repair refreshes the tasks of the execution, which regenerates its visibility tasks.
+func (s *Scavenger) repair(
+	ctx context.Context,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	namespaceID := state.GetExecutionInfo().GetNamespaceId()
+	_, err := s.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: namespaceID,
+		ArchetypeId: chasm.WorkflowArchetypeID,
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: namespaceID,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: state.GetExecutionInfo().GetWorkflowId(),
+				RunId:      state.GetExecutionState().GetRunId(),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	metrics.VisibilityScavengerRepairCount.With(s.metricsHandler).Record(1)
+	s.hbd.RepairedCount++
+	return nil
+}
+
+// customSearchAttributesEqual compares the custom search attributes of the mutable state and of the visibility
+// record. System and predefined search attributes are not returned by visibility and are ignored.
+func customSearchAttributesEqual(expected map[string]*commonpb.Payload, actual map[string]*commonpb.Payload) bool {
+	expectedCount := 0
+	for name, expectedValue := range expected {
+		if !sadefs.IsMappable(name) {
+			continue
+		}
+		expectedCount++
+		actualValue, ok := actual[name]
+		if !ok || !searchAttributeValuesEqual(expectedValue, actualValue) {
+			return false
+		}
+	}
+	actualCount := 0
+	for name := range actual {
+		if sadefs.IsMappable(name) {
+			actualCount++
+		}
+	}
+	return expectedCount == actualCount
+}
+
+// searchAttributeValuesEqual compares decoded values, as the same value might be encoded differently by history and
+// by the visibility store.
+func searchAttributeValuesEqual(expected *commonpb.Payload, actual *commonpb.Payload) bool {
+	valueType := sadefs.GetMetadataType(actual)
+	if valueType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		valueType = sadefs.GetMetadataType(expected)
+	}
+	expectedValue, err := sadefs.DecodeValue(expected, valueType, true)
+	if err != nil {
+		return false
+	}
+	actualValue, err := sadefs.DecodeValue(actual, valueType, true)
+	if err != nil {
+		return false
+	}
+	if expectedTime, ok := expectedValue.(time.Time); ok {
+		actualTime, ok := actualValue.(time.Time)
+		return ok && expectedTime.Equal(actualTime)
+	}
+	return reflect.DeepEqual(expectedValue, actualValue)
+}
+
+func getLoggingTags(err error, state *persistencespb.WorkflowMutableState) []tag.Tag {
+	tags := []tag.Tag{
+		tag.WorkflowNamespaceID(state.GetExecutionInfo().GetNamespaceId()),
+		tag.WorkflowID(state.GetExecutionInfo().GetWorkflowId()),
+		tag.WorkflowRunID(state.GetExecutionState().GetRunId()),
+	}
+	if err != nil {
+		tags = append(tags, tag.Error(err))
+	}
+	return tags
+}
@@ -0,0 +1,277 @@
+package visibility
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	ScavengerTestSuite struct {
+		suite.Suite
+		controller *gomock.Controller
+
+		mockExecutionManager  *persistence.MockExecutionManager
+		mockVisibilityManager *manager.MockVisibilityManager
+		mockHistoryClient     *historyservicemock.MockHistoryServiceClient
+		mockRegistry          *namespace.MockRegistry
+		mockMapperProvider    *searchattribute.MockMapperProvider
+		mockMapper            *searchattribute.MockMapper
+
+		repairEnabled bool
+		namespace     *namespace.Namespace
+	}
+)
+
+const (
+	testNamespaceID = "namespace-id"
+	testNamespace   = "namespace"
+)
+
+func TestScavengerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScavengerTestSuite))
+}
+
+func (s *ScavengerTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
+	s.mockVisibilityManager = manager.NewMockVisibilityManager(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.mockRegistry = namespace.NewMockRegistry(s.controller)
+	s.mockMapperProvider = searchattribute.NewMockMapperProvider(s.controller)
+	s.mockMapper = searchattribute.NewMockMapper(s.controller)
+	s.repairEnabled = false
+	s.namespace = namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: testNamespace}, nil, "active")
+
+	s.mockRegistry.EXPECT().GetNamespaceByID(namespace.ID(testNamespaceID)).Return(s.namespace, nil).AnyTimes()
+	s.mockMapperProvider.EXPECT().GetMapper(namespace.Name(testNamespace)).Return(s.mockMapper, nil).AnyTimes()
+	s.mockMapper.EXPECT().GetAlias("Keyword01", testNamespace).Return("CustomKeyword", nil).AnyTimes()
+}
+
+func (s *ScavengerTestSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *ScavengerTestSuite) newTestScavenger(numShards int32, hbd ScavengerHeartbeatDetails) *Scavenger {
+	scavenger := NewScavenger(
+		numShards,
+		s.mockExecutionManager,
+		s.mockVisibilityManager,
+		s.mockHistoryClient,
+		s.mockRegistry,
+		s.mockMapperProvider,
+		dynamicconfig.GetIntPropertyFn(10000),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		dynamicconfig.GetFloatPropertyFn(1),
+		func() bool { return s.repairEnabled },
+		hbd,
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger(),
+	)
+	scavenger.isInTest = true
+	return scavenger
+}
+
+func (s *ScavengerTestSuite) newState(
+	workflowID string,
+	status enumspb.WorkflowExecutionStatus,
+) *persistencespb.WorkflowMutableState {
+	state := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:    testNamespaceID,
+			WorkflowId:     workflowID,
+			LastUpdateTime: timestamppb.New(time.Now().Add(-time.Hour)),
+			SearchAttributes: map[string]*commonpb.Payload{
+				"Keyword01": s.encode("value", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+			},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:  workflowID + "-run",
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			Status: status,
+		},
+	}
+	if status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		state.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+		state.ExecutionInfo.CloseTime = timestamppb.New(time.Unix(1000, 0))
+	}
+	return state
+}
+
+func (s *ScavengerTestSuite) newRecord(state *persistencespb.WorkflowMutableState) *workflowpb.WorkflowExecutionInfo {
+	record := &workflowpb.WorkflowExecutionInfo{
+		Status: state.GetExecutionState().GetStatus(),
+		SearchAttributes: &commonpb.SearchAttributes{
+			IndexedFields: map[string]*commonpb.Payload{
+				"CustomKeyword": s.encode("value", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+				// predefined search attributes are ignored
+				sadefs.BuildIds: s.encode([]string{"build-id"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST),
+			},
+		},
+	}
+	if state.GetExecutionInfo().GetCloseTime() != nil {
+		record.CloseTime = state.GetExecutionInfo().GetCloseTime()
+	}
+	return record
+}
+
+func (s *ScavengerTestSuite) encode(value any, valueType enumspb.IndexedValueType) *commonpb.Payload {
+	payload, err := sadefs.EncodeValue(value, valueType)
+	s.NoError(err)
+	return payload
+}
+
+func (s *ScavengerTestSuite) expectListExecutions(shardID int32, states ...*persistencespb.WorkflowMutableState) {
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  shardID,
+		PageSize: pageSize,
+	}).Return(&persistence.ListConcreteExecutionsResponse{States: states}, nil)
+}
+
+func (s *ScavengerTestSuite) expectGetRecord(state *persistencespb.WorkflowMutableState, record *workflowpb.WorkflowExecutionInfo, err error) {
+	var resp *manager.GetWorkflowExecutionResponse
+	if record != nil {
+		resp = &manager.GetWorkflowExecutionResponse{Execution: record}
+	}
+	s.mockVisibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), &manager.GetWorkflowExecutionRequest{
+		NamespaceID: namespace.ID(testNamespaceID),
+		Namespace:   namespace.Name(testNamespace),
+		WorkflowID:  state.GetExecutionInfo().GetWorkflowId(),
+		RunID:       state.GetExecutionState().GetRunId(),
+	}).Return(resp, err)
+}
+
+func (s *ScavengerTestSuite) TestNoMismatch() {
+	running := s.newState("running", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	completed := s.newState("completed", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	s.expectListExecutions(1, running)
+	s.expectListExecutions(2, completed)
+	s.expectGetRecord(running, s.newRecord(running), nil)
+	completedRecord := s.newRecord(completed)
+	// lower precision of the visibility store
+	completedRecord.CloseTime = timestamppb.New(time.Unix(1000, 500))
+	s.expectGetRecord(completed, completedRecord, nil)
+
+	hbd, err := s.newTestScavenger(2, ScavengerHeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(2, hbd.CheckedCount)
+	s.Equal(0, hbd.MismatchCount)
+	s.Equal(0, hbd.ErrorCount)
+	s.Equal(int32(3), hbd.CurrentShardID)
+	s.Empty(hbd.NextPageToken)
+}
+
+func (s *ScavengerTestSuite) TestMismatches() {
+	missing := s.newState("missing", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	status := s.newState("status", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	closeTime := s.newState("close-time", enumspb.WORKFLOW_EXECUTION_STATUS_FAILED)
+	searchAttributes := s.newState("search-attributes", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	s.expectListExecutions(1, missing, status, closeTime, searchAttributes)
+
+	s.expectGetRecord(missing, nil, serviceerror.NewNotFound("not found"))
+	statusRecord := s.newRecord(status)
+	statusRecord.Status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	s.expectGetRecord(status, statusRecord, nil)
+	closeTimeRecord := s.newRecord(closeTime)
+	closeTimeRecord.CloseTime = timestamppb.New(time.Unix(1001, 0))
+	s.expectGetRecord(closeTime, closeTimeRecord, nil)
+	searchAttributesRecord := s.newRecord(searchAttributes)
+	searchAttributesRecord.SearchAttributes.IndexedFields["CustomKeyword"] = s.encode("stale", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.expectGetRecord(searchAttributes, searchAttributesRecord, nil)
+
+	hbd, err := s.newTestScavenger(1, ScavengerHeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(4, hbd.CheckedCount)
+	s.Equal(4, hbd.MismatchCount)
+	s.Equal(0, hbd.RepairedCount)
+	s.Equal(0, hbd.ErrorCount)
+}
+
+func (s *ScavengerTestSuite) TestRepair() {
+	s.repairEnabled = true
+	missing := s.newState("missing", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	s.expectListExecutions(1, missing)
+	s.expectGetRecord(missing, nil, serviceerror.NewNotFound("not found"))
+	s.mockHistoryClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.RefreshWorkflowTasksRequest, _ ...any) (*historyservice.RefreshWorkflowTasksResponse, error) {
+			s.Equal(testNamespaceID, request.GetNamespaceId())
+			s.Equal(chasm.WorkflowArchetypeID, request.GetArchetypeId())
+			s.Equal("missing", request.GetRequest().GetExecution().GetWorkflowId())
+			s.Equal("missing-run", request.GetRequest().GetExecution().GetRunId())
+			return &historyservice.RefreshWorkflowTasksResponse{}, nil
+		})
+
+	hbd, err := s.newTestScavenger(1, ScavengerHeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.MismatchCount)
+	s.Equal(1, hbd.RepairedCount)
+}
+
+func (s *ScavengerTestSuite) TestSkip() {
+	recentlyUpdated := s.newState("recently-updated", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	recentlyUpdated.ExecutionInfo.LastUpdateTime = timestamppb.Now()
+	zombie := s.newState("zombie", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	zombie.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE
+	chasmExecution := s.newState("chasm", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	chasmExecution.ChasmNodes = map[string]*persistencespb.ChasmNode{
+		"": {
+			Metadata: &persistencespb.ChasmNodeMetadata{
+				Attributes: &persistencespb.ChasmNodeMetadata_ComponentAttributes{
+					ComponentAttributes: &persistencespb.ChasmComponentAttributes{TypeId: 1234},
+				},
+			},
+		},
+	}
+	deletedNamespace := s.newState("deleted-namespace", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	deletedNamespace.ExecutionInfo.NamespaceId = "deleted-namespace-id"
+	s.mockRegistry.EXPECT().GetNamespaceByID(namespace.ID("deleted-namespace-id")).
+		Return(nil, serviceerror.NewNamespaceNotFound("deleted-namespace-id"))
+	s.expectListExecutions(1, recentlyUpdated, zombie, chasmExecution, deletedNamespace)
+
+	hbd, err := s.newTestScavenger(1, ScavengerHeartbeatDetails{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(0, hbd.CheckedCount)
+	s.Equal(4, hbd.SkipCount)
+}
+
+func (s *ScavengerTestSuite) TestResumeFromHeartbeat() {
+	running := s.newState("running", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	s.mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   2,
+		PageSize:  pageSize,
+		PageToken: []byte("page1"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{States: []*persistencespb.WorkflowMutableState{running}}, nil)
+	s.expectGetRecord(running, nil, serviceerror.NewUnavailable("unavailable"))
+
+	hbd, err := s.newTestScavenger(2, ScavengerHeartbeatDetails{
+		CheckedCount:   5,
+		CurrentShardID: 2,
+		NextPageToken:  []byte("page1"),
+	}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(5, hbd.CheckedCount)
+	s.Equal(1, hbd.ErrorCount)
+	s.Equal(int32(3), hbd.CurrentShardID)
+}
@@ -13,6 +13,7 @@ import (
 	"go.temporal.io/server/service/worker/scanner/executions"
 	"go.temporal.io/server/service/worker/scanner/history"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
+	"go.temporal.io/server/service/worker/scanner/visibility"
 )
 
 const (
@@ -32,6 +33,11 @@ const (
 	executionsScannerWFTypeName     = "temporal-sys-executions-scanner-workflow"
 	executionsScannerTaskQueueName  = "temporal-sys-executions-scanner-taskqueue-0"
 	executionsScavengerActivityName = "temporal-sys-executions-scanner-scvg-activity"
+
+	visibilityScannerWFID           = "temporal-sys-visibility-scanner"
+	visibilityScannerWFTypeName     = "temporal-sys-visibility-scanner-workflow"
+	visibilityScannerTaskQueueName  = "temporal-sys-visibility-scanner-taskqueue-0"
+	visibilityScavengerActivityName = "temporal-sys-visibility-scanner-scvg-activity"
 )
 
 type (
@@ -73,6 +79,12 @@ var (
 		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 		CronSchedule:          "0 */12 * * *",
 	}
+	visibilityScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    visibilityScannerWFID,
+		TaskQueue:             visibilityScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */12 * * *",
+	}
 )
 
 // TaskQueueScannerWorkflow is the workflow that runs the task queue scanner background daemon
@@ -104,6 +116,14 @@ func ExecutionsScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
+// VisibilityScannerWorkflow is the workflow that runs the visibility scanner background daemon
+func VisibilityScannerWorkflow(
+	ctx workflow.Context,
+) error {
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), visibilityScavengerActivityName)
+	return future.Get(ctx, nil)
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(
 	activityCtx context.Context,
@@ -131,6 +151,8 @@ func HistoryScavengerActivity(
 		ctx.cfg.HistoryScannerDataMinAge,
 		ctx.cfg.ExecutionDataDurationBuffer,
 		ctx.cfg.HistoryScannerVerifyRetention,
+		ctx.cfg.HistoryScannerDryRun,
+		ctx.cfg.HistoryScannerReportReclaimableBytes,
 		ctx.metricsHandler,
 		ctx.logger,
 		ctx.serializer,
@@ -192,3 +214,34 @@ func ExecutionsScavengerActivity(
 	}
 	return nil
 }
+
+// VisibilityScavengerActivity is the activity that runs visibility scavenger
+func VisibilityScavengerActivity(
+	activityCtx context.Context,
+) (visibility.ScavengerHeartbeatDetails, error) {
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+
+	hbd := visibility.ScavengerHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			ctx.logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	scavenger := visibility.NewScavenger(
+		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.executionManager,
+		ctx.visibilityManager,
+		ctx.historyClient,
+		ctx.namespaceRegistry,
+		ctx.saMapperProvider,
+		ctx.cfg.VisibilityScannerRPS,
+		ctx.cfg.VisibilityScannerDataMinAge,
+		ctx.cfg.VisibilityScannerSampleRate,
+		ctx.cfg.VisibilityScannerRepairEnabled,
+		hbd,
+		ctx.metricsHandler,
+		ctx.logger,
+	)
+	return scavenger.Run(activityCtx)
+}
@@ -0,0 +1,176 @@
+package searchattributemigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	activities struct {
+		metadataManager   persistence.MetadataManager
+		visibilityManager manager.VisibilityManager
+		historyClient     historyservice.HistoryServiceClient
+		timeSource        clock.TimeSource
+		logger            log.Logger
+	}
+
+	rewriteVisibilityRecordsRequest struct {
+		NamespaceID     string
+		SourceFieldName string
+		RPS             float64
+		PageSize        int
+	}
+
+	rewriteVisibilityRecordsResponse struct {
+		RewrittenCount int64
+	}
+
+	rewriteVisibilityRecordsHeartbeatDetails struct {
+		rewriteVisibilityRecordsResponse
+		NextPageToken []byte
+	}
+
+	completeMigrationRequest struct {
+		NamespaceID     string
+		SourceFieldName string
+	}
+)
+
+var errMigrationNotFound = temporal.NewNonRetryableApplicationError(
+	"search attribute type migration was removed from the namespace", "SearchAttributeTypeMigrationNotFound", nil)
+
+// RewriteVisibilityRecords refreshes the tasks of every execution of the namespace that has a value for the migrated
+// search attribute. The regenerated visibility tasks write the converted value to the target field. Progress is
+// heartbeated after every page so that a retried activity resumes where the previous attempt stopped.
+func (a *activities) RewriteVisibilityRecords(
+	ctx context.Context,
+	request *rewriteVisibilityRecordsRequest,
+) (*rewriteVisibilityRecordsResponse, error) {
+	nsResponse, err := a.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{ID: request.NamespaceID})
+	if err != nil {
+		return nil, err
+	}
+	migration, ok := nsResponse.Namespace.GetConfig().GetSearchAttributeTypeMigrations()[request.SourceFieldName]
+	if !ok {
+		return nil, errMigrationNotFound
+	}
+	nsName := namespace.Name(nsResponse.Namespace.GetInfo().GetName())
+
+	var details rewriteVisibilityRecordsHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return nil, err
+		}
+	}
+
+	rateLimiter := quotas.NewRateLimiter(request.RPS, max(1, int(request.RPS)))
+	for {
+		response, err := a.visibilityManager.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID:   namespace.ID(request.NamespaceID),
+			Namespace:     nsName,
+			PageSize:      request.PageSize,
+			NextPageToken: details.NextPageToken,
+			Query:         fmt.Sprintf("`%s` IS NOT NULL", migration.GetAlias()),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, execution := range response.Executions {
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			_, err := a.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+				NamespaceId: request.NamespaceID,
+				Request: &adminservice.RefreshWorkflowTasksRequest{
+					NamespaceId: request.NamespaceID,
+					Execution:   execution.GetExecution(),
+				},
+			})
+			if err != nil {
+				var notFound *serviceerror.NotFound
+				if errors.As(err, &notFound) {
+					// The execution was deleted after it was listed.
+					continue
+				}
+				return nil, err
+			}
+			details.RewrittenCount++
+		}
+
+		details.NextPageToken = response.NextPageToken
+		activity.RecordHeartbeat(ctx, details)
+		if len(details.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	a.logger.Info("Visibility records rewritten for search attribute type migration.",
+		tag.WorkflowNamespace(nsName.String()),
+		tag.NewStringTag("search-attribute", migration.GetAlias()),
+		tag.NewInt64("rewritten-count", details.RewrittenCount),
+	)
+	return &details.rewriteVisibilityRecordsResponse, nil
+}
+
+// CompleteMigration maps the alias of the migrated search attribute to the target field.
+func (a *activities) CompleteMigration(ctx context.Context, request *completeMigrationRequest) error {
+	metadata, err := a.metadataManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	nsResponse, err := a.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{ID: request.NamespaceID})
+	if err != nil {
+		return err
+	}
+	existing := nsResponse.Namespace
+	config := existing.GetConfig()
+	migration, ok := config.GetSearchAttributeTypeMigrations()[request.SourceFieldName]
+	if !ok {
+		return errMigrationNotFound
+	}
+	if migration.GetCompleteTime() != nil {
+		return nil
+	}
+	if config.GetCustomSearchAttributeAliases()[request.SourceFieldName] != migration.GetAlias() {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("search attribute %s is no longer mapped to field %s", migration.GetAlias(), request.SourceFieldName),
+			"SearchAttributeTypeMigrationAliasChanged",
+			nil,
+		)
+	}
+
+	delete(config.CustomSearchAttributeAliases, request.SourceFieldName)
+	config.CustomSearchAttributeAliases[migration.GetTargetFieldName()] = migration.GetAlias()
+	migration.CompleteTime = timestamppb.New(a.timeSource.Now())
+	// The migration rewrote the visibility records of this cluster only, so the update is local and keeps the
+	// config version to not be replicated.
+	return a.metadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        existing.Info,
+			Config:                      config,
+			ReplicationConfig:           existing.ReplicationConfig,
+			ConfigVersion:               existing.ConfigVersion,
+			FailoverVersion:             existing.FailoverVersion,
+			FailoverNotificationVersion: existing.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   nsResponse.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+}
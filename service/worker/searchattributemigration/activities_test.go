@@ -0,0 +1,188 @@
+package searchattributemigration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestNamespace() *persistence.GetNamespaceResponse {
+	return &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"},
+			Config: &persistencespb.NamespaceConfig{
+				CustomSearchAttributeAliases: map[string]string{"Keyword01": "CustomerId"},
+				SearchAttributeTypeMigrations: map[string]*persistencespb.SearchAttributeTypeMigration{
+					"Keyword01": {
+						Alias:           "CustomerId",
+						SourceType:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						TargetFieldName: "KeywordList01",
+						TargetType:      enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+					},
+				},
+			},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+			ConfigVersion:     3,
+		},
+	}
+}
+
+func TestRewriteVisibilityRecords(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	visibilityManager := manager.NewMockVisibilityManager(ctrl)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+
+	a := &activities{
+		metadataManager:   metadataManager,
+		visibilityManager: visibilityManager,
+		historyClient:     historyClient,
+		logger:            log.NewTestLogger(),
+	}
+
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{ID: "ns-id"}).Return(newTestNamespace(), nil)
+	visibilityManager.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: "ns-id",
+		Namespace:   "ns",
+		PageSize:    2,
+		Query:       "`CustomerId` IS NOT NULL",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-1"}},
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-2", RunId: "run-2"}},
+		},
+		NextPageToken: []byte("next"),
+	}, nil)
+	visibilityManager.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   "ns-id",
+		Namespace:     "ns",
+		PageSize:      2,
+		NextPageToken: []byte("next"),
+		Query:         "`CustomerId` IS NOT NULL",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-3", RunId: "run-3"}},
+		},
+	}, nil)
+
+	var refreshed []string
+	historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.RefreshWorkflowTasksRequest, _ ...any) (*historyservice.RefreshWorkflowTasksResponse, error) {
+			require.Equal(t, "ns-id", request.GetNamespaceId())
+			workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+			refreshed = append(refreshed, workflowID)
+			if workflowID == "wf-2" {
+				return nil, serviceerror.NewNotFound("workflow not found")
+			}
+			return &historyservice.RefreshWorkflowTasksResponse{}, nil
+		}).Times(3)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	val, err := env.ExecuteActivity(a.RewriteVisibilityRecords, &rewriteVisibilityRecordsRequest{
+		NamespaceID:     "ns-id",
+		SourceFieldName: "Keyword01",
+		RPS:             1000,
+		PageSize:        2,
+	})
+	require.NoError(t, err)
+
+	var response rewriteVisibilityRecordsResponse
+	require.NoError(t, val.Get(&response))
+	require.Equal(t, int64(2), response.RewrittenCount)
+	require.Equal(t, []string{"wf-1", "wf-2", "wf-3"}, refreshed)
+}
+
+func TestRewriteVisibilityRecords_MigrationNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+
+	a := &activities{
+		metadataManager: metadataManager,
+		logger:          log.NewTestLogger(),
+	}
+
+	nsResponse := newTestNamespace()
+	nsResponse.Namespace.Config.SearchAttributeTypeMigrations = nil
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(nsResponse, nil)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	_, err := env.ExecuteActivity(a.RewriteVisibilityRecords, &rewriteVisibilityRecordsRequest{
+		NamespaceID:     "ns-id",
+		SourceFieldName: "Keyword01",
+		RPS:             10,
+		PageSize:        10,
+	})
+	require.ErrorContains(t, err, "search attribute type migration was removed from the namespace")
+}
+
+func TestCompleteMigration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	timeSource := clock.NewEventTimeSource()
+	now := time.Unix(1000, 0).UTC()
+	timeSource.Update(now)
+
+	a := &activities{
+		metadataManager: metadataManager,
+		timeSource:      timeSource,
+		logger:          log.NewTestLogger(),
+	}
+
+	metadataManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{ID: "ns-id"}).Return(newTestNamespace(), nil)
+	metadataManager.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			require.Equal(t, int64(7), request.NotificationVersion)
+			require.Equal(t, int64(3), request.Namespace.ConfigVersion, "the update is local and must not be replicated")
+			config := request.Namespace.GetConfig()
+			require.Equal(t, map[string]string{"KeywordList01": "CustomerId"}, config.GetCustomSearchAttributeAliases())
+			require.Equal(t, timestamppb.New(now), config.GetSearchAttributeTypeMigrations()["Keyword01"].GetCompleteTime())
+			return nil
+		})
+
+	require.NoError(t, a.CompleteMigration(context.Background(), &completeMigrationRequest{
+		NamespaceID:     "ns-id",
+		SourceFieldName: "Keyword01",
+	}))
+}
+
+func TestCompleteMigration_AliasChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+
+	a := &activities{
+		metadataManager: metadataManager,
+		logger:          log.NewTestLogger(),
+	}
+
+	nsResponse := newTestNamespace()
+	nsResponse.Namespace.Config.CustomSearchAttributeAliases["Keyword01"] = "OtherName"
+	metadataManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil)
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(nsResponse, nil)
+
+	err := a.CompleteMigration(context.Background(), &completeMigrationRequest{
+		NamespaceID:     "ns-id",
+		SourceFieldName: "Keyword01",
+	})
+	require.ErrorContains(t, err, "search attribute CustomerId is no longer mapped to field Keyword01")
+}
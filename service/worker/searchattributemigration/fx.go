@@ -0,0 +1,72 @@
+package searchattributemigration
+
+import (
+	"context"
+
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/resource"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	// searchAttributeMigrationComponent represents the background work needed to migrate search attributes to
+	// another type.
+	searchAttributeMigrationComponent struct {
+		initParams
+	}
+
+	initParams struct {
+		fx.In
+		MetadataManager   persistence.MetadataManager
+		VisibilityManager manager.VisibilityManager
+		HistoryClient     resource.HistoryClient
+		TimeSource        clock.TimeSource
+		Logger            log.Logger
+	}
+)
+
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params initParams) workercommon.WorkerComponent {
+	return &searchAttributeMigrationComponent{initParams: params}
+}
+
+func (wc *searchAttributeMigrationComponent) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(MigrateSearchAttributeTypeWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+}
+
+func (wc *searchAttributeMigrationComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *searchAttributeMigrationComponent) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivity(wc.activities())
+}
+
+func (wc *searchAttributeMigrationComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	return &workercommon.DedicatedWorkerOptions{
+		TaskQueue: primitives.SearchAttributeMigrationActivityTQ,
+		Options: sdkworker.Options{
+			BackgroundActivityContext: headers.SetCallerType(context.Background(), headers.CallerTypePreemptable),
+		},
+	}
+}
+
+func (wc *searchAttributeMigrationComponent) activities() *activities {
+	return &activities{
+		metadataManager:   wc.MetadataManager,
+		visibilityManager: wc.VisibilityManager,
+		historyClient:     wc.HistoryClient,
+		timeSource:        wc.TimeSource,
+		logger:            wc.Logger,
+	}
+}
@@ -0,0 +1,102 @@
+package searchattributemigration
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	// WorkflowParams are the input of [MigrateSearchAttributeTypeWorkflow].
+	WorkflowParams struct {
+		NamespaceID string
+		// SourceFieldName identifies the migration in the namespace config.
+		SourceFieldName string
+		// RPS limits the number of visibility records rewritten per second. Optional.
+		RPS float64
+		// PageSize is the number of visibility records listed at a time. Optional.
+		PageSize int
+	}
+
+	WorkflowResult struct {
+		RewrittenCount int64
+	}
+)
+
+const (
+	WorkflowName = "temporal-sys-search-attribute-migration-workflow"
+
+	defaultRPS      = 50
+	defaultPageSize = 100
+)
+
+var (
+	activityOptions = workflow.ActivityOptions{
+		TaskQueue:           primitives.SearchAttributeMigrationActivityTQ,
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			MaximumInterval:    time.Minute,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    10,
+		},
+	}
+	rewriteActivityOptions = workflow.ActivityOptions{
+		TaskQueue: primitives.SearchAttributeMigrationActivityTQ,
+		// Visibility records are rewritten in a single long-running activity that heartbeats its progress. Retries
+		// resume from the last heartbeated page.
+		StartToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			MaximumInterval:    time.Minute,
+			BackoffCoefficient: 2,
+		},
+	}
+)
+
+// WorkflowID returns the ID of the workflow migrating the given source field of a namespace.
+func WorkflowID(namespaceID string, sourceFieldName string) string {
+	return WorkflowName + "-" + namespaceID + "-" + sourceFieldName
+}
+
+// MigrateSearchAttributeTypeWorkflow rewrites the visibility records of the executions of a namespace that have a
+// value for the migrated search attribute, and maps its alias to the target field once done. The visibility records
+// are regenerated by history, which writes the converted value to the target field while the migration exists.
+func MigrateSearchAttributeTypeWorkflow(ctx workflow.Context, params WorkflowParams) (WorkflowResult, error) {
+	if params.RPS <= 0 {
+		params.RPS = defaultRPS
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+
+	var a *activities
+	var rewriteResponse rewriteVisibilityRecordsResponse
+	if err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, rewriteActivityOptions),
+		a.RewriteVisibilityRecords,
+		&rewriteVisibilityRecordsRequest{
+			NamespaceID:     params.NamespaceID,
+			SourceFieldName: params.SourceFieldName,
+			RPS:             params.RPS,
+			PageSize:        params.PageSize,
+		},
+	).Get(ctx, &rewriteResponse); err != nil {
+		return WorkflowResult{}, err
+	}
+
+	if err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		a.CompleteMigration,
+		&completeMigrationRequest{
+			NamespaceID:     params.NamespaceID,
+			SourceFieldName: params.SourceFieldName,
+		},
+	).Get(ctx, nil); err != nil {
+		return WorkflowResult{}, err
+	}
+	return WorkflowResult{RewrittenCount: rewriteResponse.RewrittenCount}, nil
+}
@@ -0,0 +1,58 @@
+package searchattributemigration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/server/common/log"
+)
+
+func TestMigrateSearchAttributeTypeWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.RewriteVisibilityRecords, mock.Anything, &rewriteVisibilityRecordsRequest{
+		NamespaceID:     "ns-id",
+		SourceFieldName: "Keyword01",
+		RPS:             defaultRPS,
+		PageSize:        defaultPageSize,
+	}).Return(&rewriteVisibilityRecordsResponse{RewrittenCount: 5}, nil).Once()
+	env.OnActivity(a.CompleteMigration, mock.Anything, &completeMigrationRequest{
+		NamespaceID:     "ns-id",
+		SourceFieldName: "Keyword01",
+	}).Return(nil).Once()
+
+	env.ExecuteWorkflow(MigrateSearchAttributeTypeWorkflow, WorkflowParams{
+		NamespaceID:     "ns-id",
+		SourceFieldName: "Keyword01",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result WorkflowResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, int64(5), result.RewrittenCount)
+	env.AssertExpectations(t)
+}
+
+func TestMigrateSearchAttributeTypeWorkflow_RewriteFailed(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.RewriteVisibilityRecords, mock.Anything, mock.Anything).Return(nil, errMigrationNotFound).Once()
+
+	env.ExecuteWorkflow(MigrateSearchAttributeTypeWorkflow, WorkflowParams{
+		NamespaceID:     "ns-id",
+		SourceFieldName: "Keyword01",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "search attribute type migration was removed from the namespace")
+	env.AssertExpectations(t)
+}
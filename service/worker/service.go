@@ -21,9 +21,11 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
 	"go.temporal.io/server/service/worker/scanner"
@@ -87,6 +89,7 @@ type (
 		PersistenceGlobalNamespaceMaxQPS     dynamicconfig.IntPropertyFnWithNamespaceFilter
 		PersistencePerShardNamespaceMaxQPS   dynamicconfig.IntPropertyFnWithNamespaceFilter
 		PersistenceDynamicRateLimitingParams dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicRateLimitingParams]
+		PersistenceConcurrencyLimitingParams dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicConcurrencyLimitingParams]
 		PersistenceQPSBurstRatio             dynamicconfig.FloatPropertyFn
 		OperatorRPSRatio                     dynamicconfig.FloatPropertyFn
 		EnableBatcher                        dynamicconfig.BoolPropertyFn
@@ -105,6 +108,7 @@ type (
 		VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableManualPagination        dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableUnifiedQueryConverter   dynamicconfig.BoolPropertyFn
+		VisibilityBackfillProcessorConfig       *elasticsearch.ProcessorConfig
 	}
 )
 
@@ -130,6 +134,7 @@ func NewService(
 	matchingClient resource.MatchingClient,
 	namespaceReplicationTaskExecutor nsreplication.TaskExecutor,
 	serializer serialization.Serializer,
+	saMapperProvider searchattribute.MapperProvider,
 	server *grpc.Server,
 	grpcListener net.Listener,
 	healthServer *health.Server,
@@ -167,7 +172,7 @@ func NewService(
 		grpcListener: grpcListener,
 		healthServer: healthServer,
 	}
-	if err := s.initScanner(serializer); err != nil {
+	if err := s.initScanner(serializer, saMapperProvider); err != nil {
 		return nil, err
 	}
 	return s, nil
@@ -205,6 +210,8 @@ func NewConfig(
 			ExecutionsScannerEnabled:                dynamicconfig.ExecutionsScannerEnabled.Get(dc),
 			HistoryScannerDataMinAge:                dynamicconfig.HistoryScannerDataMinAge.Get(dc),
 			HistoryScannerVerifyRetention:           dynamicconfig.HistoryScannerVerifyRetention.Get(dc),
+			HistoryScannerDryRun:                    dynamicconfig.HistoryScannerDryRun.Get(dc),
+			HistoryScannerReportReclaimableBytes:    dynamicconfig.HistoryScannerReportReclaimableBytes.Get(dc),
 			ExecutionScannerPerHostQPS:              dynamicconfig.ExecutionScannerPerHostQPS.Get(dc),
 			ExecutionScannerPerShardQPS:             dynamicconfig.ExecutionScannerPerShardQPS.Get(dc),
 			ExecutionDataDurationBuffer:             dynamicconfig.ExecutionDataDurationBuffer.Get(dc),
@@ -212,6 +219,11 @@ func NewConfig(
 			ExecutionScannerHistoryEventIdValidator: dynamicconfig.ExecutionScannerHistoryEventIdValidator.Get(dc),
 			RemovableBuildIdDurationSinceDefault:    dynamicconfig.RemovableBuildIdDurationSinceDefault.Get(dc),
 			BuildIdScavengerVisibilityRPS:           dynamicconfig.BuildIdScavengerVisibilityRPS.Get(dc),
+			VisibilityScannerEnabled:                dynamicconfig.VisibilityScannerEnabled.Get(dc),
+			VisibilityScannerSampleRate:             dynamicconfig.VisibilityScannerSampleRate.Get(dc),
+			VisibilityScannerRPS:                    dynamicconfig.VisibilityScannerRPS.Get(dc),
+			VisibilityScannerDataMinAge:             dynamicconfig.VisibilityScannerDataMinAge.Get(dc),
+			VisibilityScannerRepairEnabled:          dynamicconfig.VisibilityScannerRepairEnabled.Get(dc),
 		},
 		BatcherRPS:                           dynamicconfig.BatcherRPS.Get(dc),
 		BatcherConcurrency:                   dynamicconfig.BatcherConcurrency.Get(dc),
@@ -226,6 +238,7 @@ func NewConfig(
 		PersistenceGlobalNamespaceMaxQPS:     dynamicconfig.WorkerPersistenceGlobalNamespaceMaxQPS.Get(dc),
 		PersistencePerShardNamespaceMaxQPS:   dynamicconfig.DefaultPerShardNamespaceRPSMax,
 		PersistenceDynamicRateLimitingParams: dynamicconfig.WorkerPersistenceDynamicRateLimitingParams.Get(dc),
+		PersistenceConcurrencyLimitingParams: dynamicconfig.WorkerPersistenceConcurrencyLimitingParams.Get(dc),
 		PersistenceQPSBurstRatio:             dynamicconfig.PersistenceQPSBurstRatio.Get(dc),
 		OperatorRPSRatio:                     dynamicconfig.OperatorRPSRatio.Get(dc),
 
@@ -237,6 +250,14 @@ func NewConfig(
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
 		VisibilityEnableManualPagination:        dynamicconfig.VisibilityEnableManualPagination.Get(dc),
 		VisibilityEnableUnifiedQueryConverter:   dynamicconfig.VisibilityEnableUnifiedQueryConverter.Get(dc),
+		VisibilityBackfillProcessorConfig: &elasticsearch.ProcessorConfig{
+			IndexerConcurrency:       dynamicconfig.WorkerIndexerConcurrency.Get(dc),
+			ESProcessorNumOfWorkers:  dynamicconfig.WorkerESProcessorNumOfWorkers.Get(dc),
+			ESProcessorBulkActions:   dynamicconfig.WorkerESProcessorBulkActions.Get(dc),
+			ESProcessorBulkSize:      dynamicconfig.WorkerESProcessorBulkSize.Get(dc),
+			ESProcessorFlushInterval: dynamicconfig.WorkerESProcessorFlushInterval.Get(dc),
+			ESProcessorAckTimeout:    dynamicconfig.WorkerESProcessorAckTimeout.Get(dc),
+		},
 	}
 	return config
 }
@@ -325,7 +346,7 @@ func (s *Service) startParentClosePolicyProcessor() {
 	}
 }
 
-func (s *Service) initScanner(serializer serialization.Serializer) error {
+func (s *Service) initScanner(serializer serialization.Serializer, saMapperProvider searchattribute.MapperProvider) error {
 	currentCluster := s.clusterMetadata.GetCurrentClusterName()
 	adminClient, err := s.clientBean.GetRemoteAdminClient(currentCluster)
 	if err != nil {
@@ -347,6 +368,7 @@ func (s *Service) initScanner(serializer serialization.Serializer) error {
 		currentCluster,
 		s.hostInfo,
 		serializer,
+		saMapperProvider,
 	)
 	return nil
 }
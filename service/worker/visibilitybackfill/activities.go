@@ -0,0 +1,380 @@
+package visibilitybackfill
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/api/visibilityservice/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
+)
+
+type (
+	// dualVisibilityManager is the subset of [visibility.VisibilityManagerDual] used by the backfill. Records are
+	// written to the secondary store directly, regardless of the configured secondary visibility writing mode.
+	dualVisibilityManager interface {
+		GetPrimaryVisibility() manager.VisibilityManager
+		GetSecondaryVisibility() manager.VisibilityManager
+	}
+
+	activities struct {
+		shardCount        int32
+		executionManager  persistence.ExecutionManager
+		namespaceRegistry namespace.Registry
+		historyClient     historyservice.HistoryServiceClient
+		chasmRegistry     *chasm.Registry
+		visibilityManager func() (dualVisibilityManager, error)
+		logger            log.Logger
+	}
+
+	backfillShardRequest struct {
+		ShardID  int32
+		PageSize int
+		RPS      float64
+	}
+
+	backfillShardResponse struct {
+		BackfilledCount int64
+		SkippedCount    int64
+		Archetypes      []BackfilledArchetype
+	}
+
+	backfillShardHeartbeatDetails struct {
+		backfillShardResponse
+		NextPageToken []byte
+	}
+
+	verifyCountParityRequest struct {
+		Archetypes []BackfilledArchetype
+	}
+
+	verifyCountParityResponse struct {
+		Mismatches []CountMismatch
+	}
+)
+
+var errSecondaryStoreNotConfigured = temporal.NewNonRetryableApplicationError(
+	"secondary visibility store is not configured", "SecondaryVisibilityStoreNotConfigured", nil)
+
+func (a *activities) GetShardCount(_ context.Context) (int32, error) {
+	return a.shardCount, nil
+}
+
+// BackfillShard backfills the visibility records of all executions of a shard in the secondary visibility store.
+// Progress is heartbeated after every page so that a retried activity resumes where the previous attempt stopped.
+func (a *activities) BackfillShard(ctx context.Context, request *backfillShardRequest) (*backfillShardResponse, error) {
+	visibilityManager, err := a.visibilityManager()
+	if err != nil {
+		return nil, err
+	}
+	secondaryVisibility := visibilityManager.GetSecondaryVisibility()
+
+	var details backfillShardHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return nil, err
+		}
+	}
+
+	rateLimiter := quotas.NewRateLimiter(request.RPS, max(1, int(request.RPS)))
+	for {
+		response, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   request.ShardID,
+			PageSize:  request.PageSize,
+			PageToken: details.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, state := range response.States {
+			namespaceEntry, archetypeID, ok, err := a.shouldBackfill(state)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				details.SkippedCount++
+				continue
+			}
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			if archetypeID == chasm.WorkflowArchetypeID {
+				err = backfillExecution(ctx, secondaryVisibility, namespaceEntry, request.ShardID, state)
+			} else {
+				err = a.backfillChasmExecution(ctx, namespaceEntry, archetypeID, state)
+			}
+			if err != nil {
+				return nil, err
+			}
+			details.BackfilledCount++
+			details.Archetypes = addArchetype(details.Archetypes, BackfilledArchetype{
+				NamespaceID: namespaceEntry.ID().String(),
+				ArchetypeID: archetypeID,
+			})
+		}
+
+		details.NextPageToken = response.PageToken
+		activity.RecordHeartbeat(ctx, details)
+		if len(details.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	a.logger.Info("Visibility backfill completed for shard.",
+		tag.ShardID(request.ShardID),
+		tag.NewInt64("backfilled-count", details.BackfilledCount),
+		tag.NewInt64("skipped-count", details.SkippedCount),
+	)
+	return &details.backfillShardResponse, nil
+}
+
+// VerifyCountParity compares the number of executions of each archetype of each namespace in the primary and
+// secondary visibility stores.
+func (a *activities) VerifyCountParity(ctx context.Context, request *verifyCountParityRequest) (*verifyCountParityResponse, error) {
+	visibilityManager, err := a.visibilityManager()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &verifyCountParityResponse{}
+	for _, archetype := range request.Archetypes {
+		namespaceEntry, err := a.namespaceRegistry.GetNamespaceByID(namespace.ID(archetype.NamespaceID))
+		if err != nil {
+			var nsNotFound *serviceerror.NamespaceNotFound
+			if errors.As(err, &nsNotFound) {
+				// The namespace was deleted after it was backfilled.
+				continue
+			}
+			return nil, err
+		}
+
+		primaryCount, err := countExecutions(ctx, visibilityManager.GetPrimaryVisibility(), namespaceEntry, archetype.ArchetypeID)
+		if err != nil {
+			return nil, err
+		}
+		secondaryCount, err := countExecutions(ctx, visibilityManager.GetSecondaryVisibility(), namespaceEntry, archetype.ArchetypeID)
+		if err != nil {
+			return nil, err
+		}
+		if primaryCount != secondaryCount {
+			archetypeName := a.archetypeName(archetype.ArchetypeID)
+			a.logger.Warn("Visibility store counts don't match after backfill.",
+				tag.WorkflowNamespace(namespaceEntry.Name().String()),
+				tag.ArchetypeID(archetype.ArchetypeID),
+				tag.NewInt64("primary-count", primaryCount),
+				tag.NewInt64("secondary-count", secondaryCount),
+			)
+			response.Mismatches = append(response.Mismatches, CountMismatch{
+				NamespaceID:    archetype.NamespaceID,
+				Namespace:      namespaceEntry.Name().String(),
+				ArchetypeID:    archetype.ArchetypeID,
+				Archetype:      archetypeName,
+				PrimaryCount:   primaryCount,
+				SecondaryCount: secondaryCount,
+			})
+		}
+	}
+	return response, nil
+}
+
+// countExecutions returns the number of executions of an archetype of a namespace in a visibility store.
+func countExecutions(
+	ctx context.Context,
+	visibilityManager manager.VisibilityManager,
+	namespaceEntry *namespace.Namespace,
+	archetypeID chasm.ArchetypeID,
+) (int64, error) {
+	if archetypeID == chasm.WorkflowArchetypeID {
+		response, err := visibilityManager.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+			NamespaceID: namespaceEntry.ID(),
+			Namespace:   namespaceEntry.Name(),
+		})
+		if err != nil {
+			return 0, err
+		}
+		return response.Count, nil
+	}
+	response, err := visibilityManager.CountChasmExecutions(ctx, &visibilityservice.CountChasmExecutionsRequest{
+		ArchetypeId: archetypeID,
+		NamespaceId: namespaceEntry.ID().String(),
+		Namespace:   namespaceEntry.Name().String(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return response.GetCount(), nil
+}
+
+func (a *activities) archetypeName(archetypeID chasm.ArchetypeID) string {
+	if archetypeID == chasm.WorkflowArchetypeID {
+		return chasm.WorkflowComponentName
+	}
+	if name, ok := a.chasmRegistry.ArchetypeDisplayName(archetypeID); ok {
+		return name
+	}
+	return strconv.FormatUint(uint64(archetypeID), 10)
+}
+
+// shouldBackfill returns the namespace and the archetype of the execution if it has a visibility record to backfill.
+// Executions that are not zombies are backfilled if they are workflows or if their archetype has a Visibility
+// component.
+func (a *activities) shouldBackfill(
+	state *persistencespb.WorkflowMutableState,
+) (*namespace.Namespace, chasm.ArchetypeID, bool, error) {
+	switch state.GetExecutionState().GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+	default:
+		return nil, chasm.UnspecifiedArchetypeID, false, nil
+	}
+	archetypeID := chasm.WorkflowArchetypeID
+	if rootNode, ok := state.GetChasmNodes()[""]; ok {
+		if componentAttrs := rootNode.GetMetadata().GetComponentAttributes(); componentAttrs != nil {
+			archetypeID = chasm.ArchetypeID(componentAttrs.TypeId)
+		}
+	}
+	if archetypeID != chasm.WorkflowArchetypeID {
+		rc, ok := a.chasmRegistry.ComponentByID(archetypeID)
+		if !ok || !rc.HasVisibility() {
+			return nil, chasm.UnspecifiedArchetypeID, false, nil
+		}
+	}
+
+	namespaceEntry, err := a.namespaceRegistry.GetNamespaceByID(namespace.ID(state.GetExecutionInfo().GetNamespaceId()))
+	if err != nil {
+		var nsNotFound *serviceerror.NamespaceNotFound
+		if errors.As(err, &nsNotFound) {
+			return nil, chasm.UnspecifiedArchetypeID, false, nil
+		}
+		return nil, chasm.UnspecifiedArchetypeID, false, err
+	}
+	return namespaceEntry, archetypeID, true, nil
+}
+
+// backfillChasmExecution has the history service regenerate the visibility record of a CHASM execution. The record
+// is built from the CHASM components, which are only available in the history service, by refreshing the tasks of
+// the execution. It's written by the visibility queue, and reaches the secondary store through dual writes.
+func (a *activities) backfillChasmExecution(
+	ctx context.Context,
+	namespaceEntry *namespace.Namespace,
+	archetypeID chasm.ArchetypeID,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	_, err := a.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: namespaceEntry.ID().String(),
+		ArchetypeId: archetypeID,
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: namespaceEntry.ID().String(),
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: state.GetExecutionInfo().GetWorkflowId(),
+				RunId:      state.GetExecutionState().GetRunId(),
+			},
+			ArchetypeId: archetypeID,
+		},
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		// The execution was deleted after it was listed.
+		return nil
+	}
+	return err
+}
+
+// backfillExecution regenerates the visibility record of an execution from its mutable state, the same way the
+// visibility queue does, and writes it to the given store.
+func backfillExecution(
+	ctx context.Context,
+	visibilityManager manager.VisibilityManager,
+	namespaceEntry *namespace.Namespace,
+	shardID int32,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	executionInfo := state.GetExecutionInfo()
+	executionState := state.GetExecutionState()
+
+	var parentExecution *commonpb.WorkflowExecution
+	if executionInfo.GetParentWorkflowId() != "" && executionInfo.GetParentRunId() != "" {
+		parentExecution = &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetParentWorkflowId(),
+			RunId:      executionInfo.GetParentRunId(),
+		}
+	}
+	var searchAttributes *commonpb.SearchAttributes
+	if len(executionInfo.GetSearchAttributes()) > 0 {
+		searchAttributes = &commonpb.SearchAttributes{IndexedFields: executionInfo.GetSearchAttributes()}
+	}
+	var memo *commonpb.Memo
+	if len(executionInfo.GetMemo()) > 0 {
+		memo = &commonpb.Memo{Fields: executionInfo.GetMemo()}
+	}
+
+	requestBase := &manager.VisibilityRequestBase{
+		NamespaceID: namespaceEntry.ID(),
+		Namespace:   namespaceEntry.Name(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetWorkflowId(),
+			RunId:      executionState.GetRunId(),
+		},
+		WorkflowTypeName: executionInfo.GetWorkflowTypeName(),
+		StartTime:        timestamp.TimeValue(executionState.GetStartTime()),
+		Status:           executionState.GetStatus(),
+		ExecutionTime:    timestamp.TimeValue(executionInfo.GetExecutionTime()),
+		// The task ID of the last transaction is used as version, so that records written by the visibility queue
+		// for later transactions take precedence over the backfilled record.
+		TaskID:           executionInfo.GetLastFirstEventTxnId(),
+		ShardID:          shardID,
+		Memo:             memo,
+		TaskQueue:        executionInfo.GetTaskQueue(),
+		SearchAttributes: searchAttributes,
+		ParentExecution:  parentExecution,
+		RootExecution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetRootWorkflowId(),
+			RunId:      executionInfo.GetRootRunId(),
+		},
+	}
+
+	if executionState.GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		return visibilityManager.UpsertWorkflowExecution(ctx, &manager.UpsertWorkflowExecutionRequest{
+			VisibilityRequestBase: requestBase,
+		})
+	}
+
+	// Executions closed before v1.16 don't have a close time in mutable state.
+	closeTime := executionInfo.GetCloseTime()
+	if closeTime == nil {
+		closeTime = executionInfo.GetLastUpdateTime()
+	}
+	var executionDuration time.Duration
+	if executionInfo.GetExecutionTime() != nil {
+		executionDuration = closeTime.AsTime().Sub(executionInfo.GetExecutionTime().AsTime())
+	}
+	if requestBase.SearchAttributes == nil {
+		requestBase.SearchAttributes = &commonpb.SearchAttributes{IndexedFields: make(map[string]*commonpb.Payload)}
+	}
+	return visibilityManager.RecordWorkflowExecutionClosed(ctx, &manager.RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: requestBase,
+		CloseTime:             closeTime.AsTime(),
+		ExecutionDuration:     executionDuration,
+		HistoryLength:         state.GetNextEventId() - 1,
+		HistorySizeBytes:      executionInfo.GetExecutionStats().GetHistorySize(),
+		StateTransitionCount:  executionInfo.GetStateTransitionCount(),
+	})
+}
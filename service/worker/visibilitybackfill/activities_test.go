@@ -0,0 +1,255 @@
+package visibilitybackfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/api/visibilityservice/v1"
+	"go.temporal.io/server/chasm"
+	chasmtests "go.temporal.io/server/chasm/lib/tests"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type fakeDualVisibilityManager struct {
+	primary   manager.VisibilityManager
+	secondary manager.VisibilityManager
+}
+
+func (m *fakeDualVisibilityManager) GetPrimaryVisibility() manager.VisibilityManager {
+	return m.primary
+}
+
+func (m *fakeDualVisibilityManager) GetSecondaryVisibility() manager.VisibilityManager {
+	return m.secondary
+}
+
+func newTestState(namespaceID string, workflowID string, state enumsspb.WorkflowExecutionState) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:         namespaceID,
+			WorkflowId:          workflowID,
+			WorkflowTypeName:    "workflow-type",
+			TaskQueue:           "task-queue",
+			LastFirstEventTxnId: 42,
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:     workflowID + "-run",
+			State:     state,
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			StartTime: timestamppb.New(time.Unix(100, 0)),
+		},
+		NextEventId: 11,
+	}
+}
+
+func newTestChasmState(namespaceID string, businessID string, archetypeID chasm.ArchetypeID) *persistencespb.WorkflowMutableState {
+	state := newTestState(namespaceID, businessID, enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING)
+	state.ChasmNodes = map[string]*persistencespb.ChasmNode{
+		"": {
+			Metadata: &persistencespb.ChasmNodeMetadata{
+				Attributes: &persistencespb.ChasmNodeMetadata_ComponentAttributes{
+					ComponentAttributes: &persistencespb.ChasmComponentAttributes{TypeId: archetypeID},
+				},
+			},
+		},
+	}
+	return state
+}
+
+func TestBackfillShard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	secondary := manager.NewMockVisibilityManager(ctrl)
+	chasmRegistry := chasm.NewRegistry(log.NewTestLogger())
+	require.NoError(t, chasmRegistry.Register(chasmtests.Library))
+
+	a := &activities{
+		executionManager:  executionManager,
+		namespaceRegistry: namespaceRegistry,
+		historyClient:     historyClient,
+		chasmRegistry:     chasmRegistry,
+		visibilityManager: func() (dualVisibilityManager, error) {
+			return &fakeDualVisibilityManager{secondary: secondary}, nil
+		},
+		logger: log.NewTestLogger(),
+	}
+
+	running := newTestState("ns-id", "running", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING)
+	completed := newTestState("ns-id", "completed", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED)
+	completed.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	completed.ExecutionInfo.ExecutionTime = timestamppb.New(time.Unix(100, 0))
+	completed.ExecutionInfo.CloseTime = timestamppb.New(time.Unix(160, 0))
+	zombie := newTestState("ns-id", "zombie", enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE)
+	chasmExecution := newTestChasmState("ns-id", "chasm", chasmtests.ArchetypeID)
+	// Executions of archetypes without visibility have no record to backfill.
+	unknownArchetypeExecution := newTestChasmState("ns-id", "unknown", 1234)
+	deletedNamespace := newTestState("deleted-ns-id", "deleted", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING)
+
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  3,
+		PageSize: 10,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States:    []*persistencespb.WorkflowMutableState{running, zombie, chasmExecution, unknownArchetypeExecution},
+		PageToken: []byte("next"),
+	}, nil)
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   3,
+		PageSize:  10,
+		PageToken: []byte("next"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{completed, deletedNamespace},
+	}, nil)
+
+	nsEntry := namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Id: "ns-id", Name: "ns"}, nil, "active")
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("ns-id")).Return(nsEntry, nil).Times(3)
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("deleted-ns-id")).Return(nil, serviceerror.NewNamespaceNotFound("deleted-ns-id"))
+
+	secondary.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.UpsertWorkflowExecutionRequest) error {
+			require.Equal(t, namespace.Name("ns"), request.Namespace)
+			require.Equal(t, "running", request.Execution.GetWorkflowId())
+			require.Equal(t, "running-run", request.Execution.GetRunId())
+			require.Equal(t, "workflow-type", request.WorkflowTypeName)
+			require.Equal(t, int64(42), request.TaskID)
+			require.Equal(t, int32(3), request.ShardID)
+			return nil
+		})
+	secondary.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.RecordWorkflowExecutionClosedRequest) error {
+			require.Equal(t, "completed", request.Execution.GetWorkflowId())
+			require.Equal(t, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, request.Status)
+			require.Equal(t, time.Unix(160, 0).UTC(), request.CloseTime.UTC())
+			require.Equal(t, time.Minute, request.ExecutionDuration)
+			require.Equal(t, int64(10), request.HistoryLength)
+			return nil
+		})
+
+	historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: "ns-id",
+		ArchetypeId: chasmtests.ArchetypeID,
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: "ns-id",
+			Execution:   &commonpb.WorkflowExecution{WorkflowId: "chasm", RunId: "chasm-run"},
+			ArchetypeId: chasmtests.ArchetypeID,
+		},
+	}).Return(&historyservice.RefreshWorkflowTasksResponse{}, nil)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	val, err := env.ExecuteActivity(a.BackfillShard, &backfillShardRequest{ShardID: 3, PageSize: 10, RPS: 1000})
+	require.NoError(t, err)
+
+	var response backfillShardResponse
+	require.NoError(t, val.Get(&response))
+	require.Equal(t, int64(3), response.BackfilledCount)
+	require.Equal(t, int64(3), response.SkippedCount)
+	require.ElementsMatch(t, []BackfilledArchetype{
+		{NamespaceID: "ns-id", ArchetypeID: chasm.WorkflowArchetypeID},
+		{NamespaceID: "ns-id", ArchetypeID: chasmtests.ArchetypeID},
+	}, response.Archetypes)
+}
+
+func TestBackfillShard_SecondaryStoreNotConfigured(t *testing.T) {
+	a := &activities{
+		visibilityManager: func() (dualVisibilityManager, error) {
+			return nil, errSecondaryStoreNotConfigured
+		},
+		logger: log.NewTestLogger(),
+	}
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+	_, err := env.ExecuteActivity(a.BackfillShard, &backfillShardRequest{ShardID: 1, PageSize: 10, RPS: 10})
+	require.ErrorContains(t, err, "secondary visibility store is not configured")
+}
+
+func TestVerifyCountParity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	primary := manager.NewMockVisibilityManager(ctrl)
+	secondary := manager.NewMockVisibilityManager(ctrl)
+	chasmRegistry := chasm.NewRegistry(log.NewTestLogger())
+	require.NoError(t, chasmRegistry.Register(chasmtests.Library))
+
+	a := &activities{
+		namespaceRegistry: namespaceRegistry,
+		chasmRegistry:     chasmRegistry,
+		visibilityManager: func() (dualVisibilityManager, error) {
+			return &fakeDualVisibilityManager{primary: primary, secondary: secondary}, nil
+		},
+		logger: log.NewTestLogger(),
+	}
+
+	nsA := namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Id: "ns-a", Name: "a"}, nil, "active")
+	nsB := namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Id: "ns-b", Name: "b"}, nil, "active")
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("ns-a")).Return(nsA, nil).Times(2)
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("ns-b")).Return(nsB, nil)
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("ns-deleted")).Return(nil, serviceerror.NewNamespaceNotFound("ns-deleted"))
+
+	primary.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{NamespaceID: "ns-a", Namespace: "a"}).
+		Return(&manager.CountWorkflowExecutionsResponse{Count: 5}, nil)
+	secondary.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{NamespaceID: "ns-a", Namespace: "a"}).
+		Return(&manager.CountWorkflowExecutionsResponse{Count: 5}, nil)
+	primary.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{NamespaceID: "ns-b", Namespace: "b"}).
+		Return(&manager.CountWorkflowExecutionsResponse{Count: 7}, nil)
+	secondary.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{NamespaceID: "ns-b", Namespace: "b"}).
+		Return(&manager.CountWorkflowExecutionsResponse{Count: 6}, nil)
+
+	chasmCountRequest := &visibilityservice.CountChasmExecutionsRequest{
+		ArchetypeId: chasmtests.ArchetypeID,
+		NamespaceId: "ns-a",
+		Namespace:   "a",
+	}
+	primary.EXPECT().CountChasmExecutions(gomock.Any(), chasmCountRequest).
+		Return(&visibilityservice.CountChasmExecutionsResponse{Count: 4}, nil)
+	secondary.EXPECT().CountChasmExecutions(gomock.Any(), chasmCountRequest).
+		Return(&visibilityservice.CountChasmExecutionsResponse{Count: 3}, nil)
+
+	response, err := a.VerifyCountParity(context.Background(), &verifyCountParityRequest{
+		Archetypes: []BackfilledArchetype{
+			{NamespaceID: "ns-a", ArchetypeID: chasmtests.ArchetypeID},
+			{NamespaceID: "ns-a", ArchetypeID: chasm.WorkflowArchetypeID},
+			{NamespaceID: "ns-b", ArchetypeID: chasm.WorkflowArchetypeID},
+			{NamespaceID: "ns-deleted", ArchetypeID: chasm.WorkflowArchetypeID},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []CountMismatch{
+		{
+			NamespaceID:    "ns-a",
+			Namespace:      "a",
+			ArchetypeID:    chasmtests.ArchetypeID,
+			Archetype:      "payloadStore",
+			PrimaryCount:   4,
+			SecondaryCount: 3,
+		},
+		{
+			NamespaceID:    "ns-b",
+			Namespace:      "b",
+			ArchetypeID:    chasm.WorkflowArchetypeID,
+			Archetype:      chasm.WorkflowComponentName,
+			PrimaryCount:   7,
+			SecondaryCount: 6,
+		},
+	}, response.Mismatches)
+}
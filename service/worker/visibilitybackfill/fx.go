@@ -0,0 +1,134 @@
+package visibilitybackfill
+
+import (
+	"context"
+	"sync"
+
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/resource"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	// VisibilityManagerFactory creates a visibility manager that is able to write to the secondary visibility store.
+	// The returned manager must expose both stores, see [dualVisibilityManager].
+	VisibilityManagerFactory func() (manager.VisibilityManager, error)
+
+	initParams struct {
+		fx.In
+		Lifecycle                fx.Lifecycle
+		PersistenceConfig        *config.Persistence
+		ExecutionManager         persistence.ExecutionManager
+		NamespaceRegistry        namespace.Registry
+		HistoryClient            resource.HistoryClient
+		ChasmRegistry            *chasm.Registry
+		VisibilityManagerFactory VisibilityManagerFactory
+		Logger                   log.Logger
+	}
+
+	fxResult struct {
+		fx.Out
+		Component workercommon.WorkerComponent `group:"workerComponent"`
+	}
+
+	visibilityBackfillWorkerComponent struct {
+		initParams
+		visibilityManager *lazyVisibilityManager
+	}
+
+	// lazyVisibilityManager creates the backfill visibility manager on first use, so that workers that never run a
+	// backfill don't open connections to the secondary visibility store.
+	lazyVisibilityManager struct {
+		factory VisibilityManagerFactory
+
+		sync.Mutex
+		manager manager.VisibilityManager
+	}
+)
+
+var Module = fx.Options(
+	fx.Provide(NewResult),
+)
+
+func NewResult(params initParams) fxResult {
+	component := &visibilityBackfillWorkerComponent{
+		initParams:        params,
+		visibilityManager: &lazyVisibilityManager{factory: params.VisibilityManagerFactory},
+	}
+	params.Lifecycle.Append(fx.StopHook(component.visibilityManager.close))
+	return fxResult{
+		Component: component,
+	}
+}
+
+func (wc *visibilityBackfillWorkerComponent) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(BackfillWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+}
+
+func (wc *visibilityBackfillWorkerComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// Use default worker
+	return nil
+}
+
+func (wc *visibilityBackfillWorkerComponent) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivity(wc.activities())
+}
+
+func (wc *visibilityBackfillWorkerComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	return &workercommon.DedicatedWorkerOptions{
+		TaskQueue: primitives.VisibilityBackfillActivityTQ,
+		Options: sdkworker.Options{
+			BackgroundActivityContext: headers.SetCallerType(context.Background(), headers.CallerTypePreemptable),
+		},
+	}
+}
+
+func (wc *visibilityBackfillWorkerComponent) activities() *activities {
+	return &activities{
+		shardCount:        wc.PersistenceConfig.NumHistoryShards,
+		executionManager:  wc.ExecutionManager,
+		namespaceRegistry: wc.NamespaceRegistry,
+		historyClient:     wc.HistoryClient,
+		chasmRegistry:     wc.ChasmRegistry,
+		visibilityManager: wc.visibilityManager.get,
+		logger:            wc.Logger,
+	}
+}
+
+func (l *lazyVisibilityManager) get() (dualVisibilityManager, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.manager == nil {
+		visibilityManager, err := l.factory()
+		if err != nil {
+			return nil, err
+		}
+		l.manager = visibilityManager
+	}
+	dualManager, ok := l.manager.(dualVisibilityManager)
+	if !ok {
+		return nil, errSecondaryStoreNotConfigured
+	}
+	return dualManager, nil
+}
+
+func (l *lazyVisibilityManager) close() {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.manager != nil {
+		l.manager.Close()
+		l.manager = nil
+	}
+}
@@ -0,0 +1,252 @@
+package visibilitybackfill
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	// BackfillParams are the input of [BackfillWorkflow]. All fields but the progress are optional.
+	BackfillParams struct {
+		// ShardCount is the number of history shards to scan. It's looked up on the first run if not set.
+		ShardCount int32
+		// ConcurrentShardCount is the number of shards backfilled in parallel.
+		ConcurrentShardCount int
+		// RPS limits the number of visibility records written to the secondary store per second, across all shards.
+		RPS float64
+		// PageSize is the number of executions loaded from the execution store at a time.
+		PageSize int
+		// ShardsPerExecution is the number of shards backfilled before continuing as new.
+		ShardsPerExecution int
+		// SkipVerification skips verifying the count parity between both stores once all shards are backfilled.
+		SkipVerification bool
+
+		// NextShardID and Progress are carried over on continue-as-new.
+		NextShardID int32
+		Progress    BackfillProgress
+	}
+
+	BackfillProgress struct {
+		CompletedShardCount int32
+		BackfilledCount     int64
+		SkippedCount        int64
+		ContinuedAsNewCount int
+		// Archetypes of the backfilled executions, in order. Count parity is verified for each of them.
+		Archetypes []BackfilledArchetype
+	}
+
+	// BackfilledArchetype identifies the executions of an archetype in a namespace.
+	BackfilledArchetype struct {
+		NamespaceID string
+		ArchetypeID chasm.ArchetypeID
+	}
+
+	// BackfillStatus is returned by the [StatusQueryType] query.
+	BackfillStatus struct {
+		BackfillProgress
+		ShardCount  int32
+		NextShardID int32
+		Verifying   bool
+		// CountMismatches is set once the verification is done.
+		CountMismatches []CountMismatch
+	}
+
+	BackfillResult struct {
+		BackfillProgress
+		// CountMismatches lists the archetypes for which the primary and secondary stores report different counts.
+		// Executions created, closed or deleted during the verification may cause transient mismatches.
+		CountMismatches []CountMismatch
+	}
+
+	CountMismatch struct {
+		NamespaceID    string
+		Namespace      string
+		ArchetypeID    chasm.ArchetypeID
+		Archetype      string
+		PrimaryCount   int64
+		SecondaryCount int64
+	}
+)
+
+const (
+	WorkflowName = "temporal-sys-visibility-backfill-workflow"
+	// WorkflowID is the ID of the backfill workflow started through the admin API. A single backfill runs at a time.
+	WorkflowID = "temporal-sys-visibility-backfill"
+	// StatusQueryType returns the [BackfillStatus] of a running backfill.
+	StatusQueryType = "visibility-backfill-status"
+
+	defaultConcurrentShardCount = 4
+	defaultRPS                  = 100
+	defaultPageSize             = 100
+	defaultShardsPerExecution   = 256
+)
+
+var (
+	activityOptions = workflow.ActivityOptions{
+		TaskQueue:           primitives.VisibilityBackfillActivityTQ,
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			MaximumInterval:    time.Minute,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    10,
+		},
+	}
+	backfillShardActivityOptions = workflow.ActivityOptions{
+		TaskQueue: primitives.VisibilityBackfillActivityTQ,
+		// Shards are backfilled in a single long-running activity that heartbeats its progress. Retries resume from
+		// the last heartbeated page.
+		StartToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			MaximumInterval:    time.Minute,
+			BackoffCoefficient: 2,
+		},
+	}
+)
+
+// BackfillWorkflow scans the executions of every history shard and backfills their visibility records in the secondary
+// visibility store. Workflow records are regenerated from mutable state and written to the secondary store directly.
+// Records of other CHASM archetypes are regenerated by the history service, which writes them through dual writes.
+// Once all shards are backfilled, it verifies that both stores report the same number of executions for every
+// backfilled archetype of every namespace. This allows migrating to a new visibility store without downtime: enable
+// dual writes first, then backfill the existing executions.
+func BackfillWorkflow(ctx workflow.Context, params BackfillParams) (BackfillResult, error) {
+	params.applyDefaults()
+
+	verifying := false
+	var countMismatches []CountMismatch
+	if err := workflow.SetQueryHandler(ctx, StatusQueryType, func() (BackfillStatus, error) {
+		return BackfillStatus{
+			BackfillProgress: params.Progress,
+			ShardCount:       params.ShardCount,
+			NextShardID:      params.NextShardID,
+			Verifying:        verifying,
+			CountMismatches:  countMismatches,
+		}, nil
+	}); err != nil {
+		return BackfillResult{}, err
+	}
+
+	var a *activities
+	actx := workflow.WithActivityOptions(ctx, activityOptions)
+	if params.ShardCount == 0 {
+		if err := workflow.ExecuteActivity(actx, a.GetShardCount).Get(ctx, &params.ShardCount); err != nil {
+			return BackfillResult{}, err
+		}
+	}
+
+	lastShardID := min(params.NextShardID+int32(params.ShardsPerExecution)-1, params.ShardCount)
+	if err := backfillShards(ctx, &params, lastShardID); err != nil {
+		return BackfillResult{}, err
+	}
+	params.NextShardID = lastShardID + 1
+
+	if params.NextShardID <= params.ShardCount {
+		// There are still more shards to backfill. Continue-as-new to process them on a new run.
+		params.Progress.ContinuedAsNewCount++
+		return BackfillResult{}, workflow.NewContinueAsNewError(ctx, BackfillWorkflow, params)
+	}
+
+	result := BackfillResult{BackfillProgress: params.Progress}
+	if params.SkipVerification || len(params.Progress.Archetypes) == 0 {
+		return result, nil
+	}
+
+	verifying = true
+	var verifyResponse verifyCountParityResponse
+	if err := workflow.ExecuteActivity(actx, a.VerifyCountParity, &verifyCountParityRequest{
+		Archetypes: params.Progress.Archetypes,
+	}).Get(ctx, &verifyResponse); err != nil {
+		return BackfillResult{}, err
+	}
+	verifying = false
+	countMismatches = verifyResponse.Mismatches
+	result.CountMismatches = verifyResponse.Mismatches
+	return result, nil
+}
+
+// backfillShards backfills the shards from params.NextShardID to lastShardID, both included, with at most
+// params.ConcurrentShardCount activities running at a time.
+func backfillShards(ctx workflow.Context, params *BackfillParams, lastShardID int32) error {
+	var a *activities
+	actx := workflow.WithActivityOptions(ctx, backfillShardActivityOptions)
+	// The write rate limit is shared by the concurrently running activities.
+	shardRPS := params.RPS / float64(params.ConcurrentShardCount)
+
+	selector := workflow.NewSelector(ctx)
+	pending := 0
+	var backfillErr error
+	for shardID := params.NextShardID; shardID <= lastShardID && backfillErr == nil; shardID++ {
+		future := workflow.ExecuteActivity(actx, a.BackfillShard, &backfillShardRequest{
+			ShardID:  shardID,
+			PageSize: params.PageSize,
+			RPS:      shardRPS,
+		})
+		selector.AddFuture(future, func(f workflow.Future) {
+			var response backfillShardResponse
+			if err := f.Get(ctx, &response); err != nil {
+				backfillErr = err
+				return
+			}
+			params.Progress.add(&response)
+		})
+		pending++
+		if pending == params.ConcurrentShardCount {
+			selector.Select(ctx)
+			pending--
+		}
+	}
+	for ; pending > 0; pending-- {
+		selector.Select(ctx)
+	}
+	return backfillErr
+}
+
+func (p *BackfillParams) applyDefaults() {
+	if p.ConcurrentShardCount <= 0 {
+		p.ConcurrentShardCount = defaultConcurrentShardCount
+	}
+	if p.RPS <= 0 {
+		p.RPS = defaultRPS
+	}
+	if p.PageSize <= 0 {
+		p.PageSize = defaultPageSize
+	}
+	if p.ShardsPerExecution <= 0 {
+		p.ShardsPerExecution = defaultShardsPerExecution
+	}
+	// Shard IDs start at 1.
+	if p.NextShardID <= 0 {
+		p.NextShardID = 1
+	}
+}
+
+func (p *BackfillProgress) add(response *backfillShardResponse) {
+	p.CompletedShardCount++
+	p.BackfilledCount += response.BackfilledCount
+	p.SkippedCount += response.SkippedCount
+	for _, archetype := range response.Archetypes {
+		p.Archetypes = addArchetype(p.Archetypes, archetype)
+	}
+}
+
+// addArchetype inserts the archetype into the sorted slice, unless it's already there.
+func addArchetype(archetypes []BackfilledArchetype, archetype BackfilledArchetype) []BackfilledArchetype {
+	i, found := slices.BinarySearchFunc(archetypes, archetype, compareArchetypes)
+	if found {
+		return archetypes
+	}
+	return slices.Insert(archetypes, i, archetype)
+}
+
+func compareArchetypes(a, b BackfilledArchetype) int {
+	return cmp.Or(cmp.Compare(a.NamespaceID, b.NamespaceID), cmp.Compare(a.ArchetypeID, b.ArchetypeID))
+}
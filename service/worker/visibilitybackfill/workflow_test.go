@@ -0,0 +1,109 @@
+package visibilitybackfill
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/log"
+)
+
+func TestBackfillWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.GetShardCount, mock.Anything).Return(int32(3), nil).Once()
+	for shardID, archetypes := range map[int32][]BackfilledArchetype{
+		1: {{NamespaceID: "ns-b", ArchetypeID: chasm.WorkflowArchetypeID}},
+		2: {{NamespaceID: "ns-a", ArchetypeID: chasm.WorkflowArchetypeID}, {NamespaceID: "ns-b", ArchetypeID: chasm.WorkflowArchetypeID}},
+		3: nil,
+	} {
+		env.OnActivity(a.BackfillShard, mock.Anything, &backfillShardRequest{
+			ShardID:  shardID,
+			PageSize: defaultPageSize,
+			RPS:      50,
+		}).Return(&backfillShardResponse{
+			BackfilledCount: int64(len(archetypes)),
+			SkippedCount:    1,
+			Archetypes:      archetypes,
+		}, nil).Once()
+	}
+	env.OnActivity(a.VerifyCountParity, mock.Anything, &verifyCountParityRequest{
+		Archetypes: []BackfilledArchetype{
+			{NamespaceID: "ns-a", ArchetypeID: chasm.WorkflowArchetypeID},
+			{NamespaceID: "ns-b", ArchetypeID: chasm.WorkflowArchetypeID},
+		},
+	}).Return(&verifyCountParityResponse{
+		Mismatches: []CountMismatch{{NamespaceID: "ns-b", Namespace: "b", PrimaryCount: 3, SecondaryCount: 2}},
+	}, nil).Once()
+
+	env.ExecuteWorkflow(BackfillWorkflow, BackfillParams{
+		ConcurrentShardCount: 2,
+		RPS:                  100,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result BackfillResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, int32(3), result.CompletedShardCount)
+	require.Equal(t, int64(3), result.BackfilledCount)
+	require.Equal(t, int64(3), result.SkippedCount)
+	require.Equal(t, []BackfilledArchetype{
+		{NamespaceID: "ns-a", ArchetypeID: chasm.WorkflowArchetypeID},
+		{NamespaceID: "ns-b", ArchetypeID: chasm.WorkflowArchetypeID},
+	}, result.Archetypes)
+	require.Len(t, result.CountMismatches, 1)
+	env.AssertExpectations(t)
+}
+
+func TestBackfillWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.BackfillShard, mock.Anything, mock.Anything).Return(&backfillShardResponse{BackfilledCount: 1}, nil).Twice()
+
+	env.ExecuteWorkflow(BackfillWorkflow, BackfillParams{
+		ShardCount:         3,
+		ShardsPerExecution: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	env.AssertExpectations(t)
+}
+
+func TestBackfillWorkflow_SkipVerification(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.BackfillShard, mock.Anything, mock.Anything).Return(&backfillShardResponse{
+		BackfilledCount: 1,
+		Archetypes:      []BackfilledArchetype{{NamespaceID: "ns-a", ArchetypeID: chasm.WorkflowArchetypeID}},
+	}, nil).Once()
+
+	env.ExecuteWorkflow(BackfillWorkflow, BackfillParams{
+		ShardCount:       1,
+		NextShardID:      1,
+		SkipVerification: true,
+		Progress:         BackfillProgress{CompletedShardCount: 0, ContinuedAsNewCount: 1},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result BackfillResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, int32(1), result.CompletedShardCount)
+	require.Equal(t, 1, result.ContinuedAsNewCount)
+	require.Empty(t, result.CountMismatches)
+	env.AssertExpectations(t)
+}
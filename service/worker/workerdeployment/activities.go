@@ -145,6 +145,23 @@ func (a *Activities) IsVersionMissingTaskQueues(ctx context.Context, args *deplo
 	}, nil
 }
 
+func (a *Activities) GetVersionHealth(ctx context.Context, args *deploymentspb.GetVersionHealthActivityArgs) (*deploymentspb.GetVersionHealthActivityResult, error) {
+	res, err := a.MatchingClient.GetWorkerDeploymentVersionHealth(ctx, &matchingservice.GetWorkerDeploymentVersionHealthRequest{
+		NamespaceId: a.namespace.ID().String(),
+		Version: &deploymentspb.WorkerDeploymentVersion{
+			DeploymentName: args.DeploymentName,
+			BuildId:        args.BuildId,
+		},
+		Since: args.Since,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &deploymentspb.GetVersionHealthActivityResult{
+		Health: res.GetHealth(),
+	}, nil
+}
+
 func (a *Activities) DeleteWorkerDeploymentVersion(ctx context.Context, args *deploymentspb.DeleteVersionActivityArgs) error {
 	identity := "worker-deployment workflow " + activity.GetInfo(ctx).WorkflowExecution.ID
 	versionObj, err := worker_versioning.WorkerDeploymentVersionFromStringV31(args.Version)
@@ -23,6 +23,7 @@ import (
 	wciclient "go.temporal.io/auto-scaled-workers/wci/client"
 	"go.temporal.io/sdk/temporal"
 	deploymentspb "go.temporal.io/server/api/deployment/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
@@ -152,6 +153,31 @@ type Client interface {
 		request *workflowservice.SetWorkerDeploymentManagerRequest,
 	) (*workflowservice.SetWorkerDeploymentManagerResponse, error)
 
+	StartRollout(
+		ctx context.Context,
+		namespaceEntry *namespace.Namespace,
+		deploymentName string,
+		buildID string,
+		plan *deploymentspb.WorkerDeploymentRolloutPlan,
+		identity string,
+		ignoreMissingTaskQueues bool,
+	) (*deploymentspb.WorkerDeploymentRollout, error)
+
+	UpdateRollout(
+		ctx context.Context,
+		namespaceEntry *namespace.Namespace,
+		deploymentName string,
+		action enumsspb.WorkerDeploymentRolloutAction,
+		identity string,
+	) (*deploymentspb.WorkerDeploymentRollout, error)
+
+	// DescribeRollout returns the last rollout of a Worker Deployment, including finished ones.
+	DescribeRollout(
+		ctx context.Context,
+		namespaceEntry *namespace.Namespace,
+		deploymentName string,
+	) (*deploymentspb.WorkerDeploymentRollout, error)
+
 	// Used internally by the Worker Deployment Version workflow in its StartWorkerDeployment Activity
 	// Deprecated.
 	StartWorkerDeployment(
@@ -333,6 +359,129 @@ func (d *ClientImpl) SetManager(
 	}, nil
 }
 
+func (d *ClientImpl) StartRollout(
+	ctx context.Context,
+	namespaceEntry *namespace.Namespace,
+	deploymentName string,
+	buildID string,
+	plan *deploymentspb.WorkerDeploymentRolloutPlan,
+	identity string,
+	ignoreMissingTaskQueues bool,
+) (_ *deploymentspb.WorkerDeploymentRollout, retErr error) {
+	//revive:disable-next-line:defer
+	defer d.convertAndRecordError("StartRollout", deploymentName, &retErr, namespaceEntry.Name(), buildID, identity)()
+
+	if err := validateVersionWfParams(worker_versioning.WorkerDeploymentNameFieldName, deploymentName, d.maxIDLengthLimit()); err != nil {
+		return nil, err
+	}
+	if err := validateVersionWfParams(worker_versioning.WorkerDeploymentBuildIDFieldName, buildID, d.maxIDLengthLimit()); err != nil {
+		return nil, err
+	}
+	if err := validateRolloutPlan(plan); err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	version := worker_versioning.WorkerDeploymentVersionToStringV31(&deploymentspb.WorkerDeploymentVersion{
+		DeploymentName: deploymentName,
+		BuildId:        buildID,
+	})
+	updatePayload, err := sdk.PreferProtoDataConverter.ToPayloads(&deploymentspb.StartRolloutArgs{
+		Identity:                identity,
+		Version:                 version,
+		Plan:                    plan,
+		IgnoreMissingTaskQueues: ignoreMissingTaskQueues,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d.updateRollout(ctx, namespaceEntry, deploymentName, buildID, identity, &updatepb.Input{Name: StartRollout, Args: updatePayload})
+}
+
+func (d *ClientImpl) UpdateRollout(
+	ctx context.Context,
+	namespaceEntry *namespace.Namespace,
+	deploymentName string,
+	action enumsspb.WorkerDeploymentRolloutAction,
+	identity string,
+) (_ *deploymentspb.WorkerDeploymentRollout, retErr error) {
+	//revive:disable-next-line:defer
+	defer d.convertAndRecordError("UpdateRollout", deploymentName, &retErr, namespaceEntry.Name(), action, identity)()
+
+	if err := validateVersionWfParams(worker_versioning.WorkerDeploymentNameFieldName, deploymentName, d.maxIDLengthLimit()); err != nil {
+		return nil, err
+	}
+	if action == enumsspb.WORKER_DEPLOYMENT_ROLLOUT_ACTION_UNSPECIFIED {
+		return nil, serviceerror.NewInvalidArgument("rollout action is not set")
+	}
+
+	updatePayload, err := sdk.PreferProtoDataConverter.ToPayloads(&deploymentspb.UpdateRolloutArgs{
+		Identity: identity,
+		Action:   action,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d.updateRollout(ctx, namespaceEntry, deploymentName, "", identity, &updatepb.Input{Name: UpdateRollout, Args: updatePayload})
+}
+
+func (d *ClientImpl) updateRollout(
+	ctx context.Context,
+	namespaceEntry *namespace.Namespace,
+	deploymentName string,
+	buildID string,
+	identity string,
+	input *updatepb.Input,
+) (*deploymentspb.WorkerDeploymentRollout, error) {
+	outcome, err := updateWorkflow(
+		ctx,
+		d.historyClient,
+		namespaceEntry,
+		GenerateDeploymentWorkflowID(deploymentName),
+		&updatepb.Request{
+			Input: input,
+			Meta:  &updatepb.Meta{UpdateId: uuid.NewString(), Identity: identity},
+		},
+	)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return nil, serviceerror.NewNotFoundf(ErrWorkerDeploymentNotFound, deploymentName)
+		}
+		return nil, err
+	}
+	if err := d.handleUpdateVersionFailures(outcome, deploymentName, buildID); err != nil {
+		return nil, err
+	}
+
+	var res deploymentspb.RolloutResponse
+	if err := sdk.PreferProtoDataConverter.FromPayloads(outcome.GetSuccess(), &res); err != nil {
+		return nil, err
+	}
+	return res.GetRollout(), nil
+}
+
+func (d *ClientImpl) DescribeRollout(
+	ctx context.Context,
+	namespaceEntry *namespace.Namespace,
+	deploymentName string,
+) (_ *deploymentspb.WorkerDeploymentRollout, retErr error) {
+	//revive:disable-next-line:defer
+	defer d.convertAndRecordError("DescribeRollout", deploymentName, &retErr)()
+
+	if err := validateVersionWfParams(worker_versioning.WorkerDeploymentNameFieldName, deploymentName, d.maxIDLengthLimit()); err != nil {
+		return nil, err
+	}
+
+	state, err := d.queryDeploymentState(ctx, namespaceEntry, deploymentName)
+	if err != nil {
+		return nil, err
+	}
+	if state.GetRollout() == nil {
+		return nil, serviceerror.NewNotFoundf("no rollout found in Worker Deployment '%s'", deploymentName)
+	}
+	return state.GetRollout(), nil
+}
+
 var _ Client = (*ClientImpl)(nil)
 
 var errUpdateInProgress = errors.New("update in progress")
@@ -585,6 +734,24 @@ func (d *ClientImpl) DescribeWorkerDeployment(
 		return nil, nil, err
 	}
 
+	state, err := d.queryDeploymentState(ctx, namespaceEntry, deploymentName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dInfo, err := d.deploymentStateToDeploymentInfo(deploymentName, state)
+	if err != nil {
+		return nil, nil, err
+	}
+	return dInfo, state.GetConflictToken(), nil
+}
+
+// queryDeploymentState returns the local state of a Worker Deployment workflow.
+func (d *ClientImpl) queryDeploymentState(
+	ctx context.Context,
+	namespaceEntry *namespace.Namespace,
+	deploymentName string,
+) (*deploymentspb.WorkerDeploymentLocalState, error) {
 	deploymentWorkflowID := GenerateDeploymentWorkflowID(deploymentName)
 
 	req := &historyservice.QueryWorkflowRequest{
@@ -602,35 +769,31 @@ func (d *ClientImpl) DescribeWorkerDeployment(
 	if err != nil {
 		var notFound *serviceerror.NotFound
 		if errors.As(err, &notFound) {
-			return nil, nil, serviceerror.NewNotFoundf(ErrWorkerDeploymentNotFound, deploymentName)
+			return nil, serviceerror.NewNotFoundf(ErrWorkerDeploymentNotFound, deploymentName)
 		}
 		var queryFailed *serviceerror.QueryFailed
 		if errors.As(err, &queryFailed) && queryFailed.Error() == errDeploymentDeleted {
-			return nil, nil, serviceerror.NewNotFoundf(ErrWorkerDeploymentNotFound, deploymentName)
+			return nil, serviceerror.NewNotFoundf(ErrWorkerDeploymentNotFound, deploymentName)
 		}
-		return nil, nil, err
+		return nil, err
 	}
 
 	if rej := res.GetResponse().GetQueryRejected(); rej != nil {
 		// This should not happen
-		return nil, nil, serviceerror.NewInternalf("describe deployment query rejected with status %s", rej.GetStatus())
+		return nil, serviceerror.NewInternalf("describe deployment query rejected with status %s", rej.GetStatus())
 	}
 
 	if res.GetResponse().GetQueryResult() == nil {
-		return nil, nil, serviceerror.NewInternal("Did not receive deployment info")
+		return nil, serviceerror.NewInternal("Did not receive deployment info")
 	}
 
 	var queryResponse deploymentspb.QueryDescribeWorkerDeploymentResponse
 	err = sdk.PreferProtoDataConverter.FromPayloads(res.GetResponse().GetQueryResult(), &queryResponse)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	dInfo, err := d.deploymentStateToDeploymentInfo(deploymentName, queryResponse.State)
-	if err != nil {
-		return nil, nil, err
-	}
-	return dInfo, queryResponse.GetState().GetConflictToken(), nil
+	return queryResponse.GetState(), nil
 }
 
 func (d *ClientImpl) queryCreateRequestID(
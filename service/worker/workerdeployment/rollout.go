@@ -0,0 +1,439 @@
+package workerdeployment
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	deploymentspb "go.temporal.io/server/api/deployment/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/worker_versioning"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultRolloutHealthCheckInterval = time.Minute
+	// maxRolloutHistory bounds the transitions kept in the state of a rollout.
+	maxRolloutHistory = 100
+)
+
+// validateRolloutPlan returns an error describing why a rollout plan is invalid.
+func validateRolloutPlan(plan *deploymentspb.WorkerDeploymentRolloutPlan) error {
+	if len(plan.GetSteps()) == 0 {
+		return errors.New("rollout plan must have at least one step")
+	}
+	var prev float32
+	for i, step := range plan.GetSteps() {
+		if step.GetRampPercentage() <= prev || step.GetRampPercentage() >= 100 {
+			return fmt.Errorf("ramp percentage of step %d must be above %v and below 100", i, prev)
+		}
+		if step.GetDwellTime().AsDuration() < 0 {
+			return fmt.Errorf("dwell time of step %d must not be negative", i)
+		}
+		prev = step.GetRampPercentage()
+	}
+	if plan.GetHealthCheckInterval().AsDuration() < 0 {
+		return errors.New("health check interval must not be negative")
+	}
+	gates := plan.GetHealthGates()
+	if gates.GetMaxWorkflowTaskFailureRate() < 0 || gates.GetMaxWorkflowTaskFailureRate() > 1 ||
+		gates.GetMaxActivityFailureRate() < 0 || gates.GetMaxActivityFailureRate() > 1 {
+		return errors.New("max failure rates must be between 0 and 1")
+	}
+	if gates.GetMinProcessedTasks() < 0 {
+		return errors.New("min processed tasks must not be negative")
+	}
+	return nil
+}
+
+// rolloutGateFailure returns why the health of a version fails the health gates of a rollout, or an empty string if
+// it passes them.
+func rolloutGateFailure(
+	gates *deploymentspb.WorkerDeploymentRolloutHealthGates,
+	health *deploymentspb.WorkerDeploymentVersionHealth,
+) string {
+	if maxErrors := gates.GetMaxNondeterminismErrors(); maxErrors >= 0 && health.GetNondeterminismErrors() > maxErrors {
+		return fmt.Sprintf("%d nondeterminism errors exceed the max of %d", health.GetNondeterminismErrors(), maxErrors)
+	}
+	if rate, ok := failureRate(health.GetWorkflowTasksProcessed(), health.GetWorkflowTasksFailed(), gates.GetMinProcessedTasks()); ok &&
+		gates.GetMaxWorkflowTaskFailureRate() > 0 && rate > gates.GetMaxWorkflowTaskFailureRate() {
+		return fmt.Sprintf("workflow task failure rate %.4f exceeds the max of %.4f", rate, gates.GetMaxWorkflowTaskFailureRate())
+	}
+	if rate, ok := failureRate(health.GetActivityTasksProcessed(), health.GetActivityTasksFailed(), gates.GetMinProcessedTasks()); ok &&
+		gates.GetMaxActivityFailureRate() > 0 && rate > gates.GetMaxActivityFailureRate() {
+		return fmt.Sprintf("activity failure rate %.4f exceeds the max of %.4f", rate, gates.GetMaxActivityFailureRate())
+	}
+	return ""
+}
+
+func failureRate(processed, failed, minProcessed int64) (float64, bool) {
+	if processed == 0 || processed < minProcessed {
+		return 0, false
+	}
+	return float64(failed) / float64(processed), true
+}
+
+func rolloutActive(rollout *deploymentspb.WorkerDeploymentRollout) bool {
+	return rollout.GetStatus() == enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING ||
+		rollout.GetStatus() == enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED
+}
+
+func isNoChangeError(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == errNoChangeType
+}
+
+func (d *WorkflowRunner) validateStateBeforeAcceptingStartRollout(args *deploymentspb.StartRolloutArgs) error {
+	if rollout := d.State.GetRollout(); rolloutActive(rollout) {
+		return temporal.NewApplicationError(fmt.Sprintf("rollout of version %s is already in progress", rollout.GetVersion()), errFailedPrecondition)
+	}
+	if _, ok := d.State.GetVersions()[args.Version]; !ok {
+		return temporal.NewApplicationError(fmt.Sprintf("version %s not found in deployment", args.Version), errVersionNotFound)
+	}
+	//nolint:staticcheck // SA1019: worker versioning v0.31
+	if args.Version == d.State.GetRoutingConfig().GetCurrentVersion() {
+		return temporal.NewApplicationError(fmt.Sprintf("version %s is already current", args.Version), errFailedPrecondition)
+	}
+	if err := validateRolloutPlan(args.Plan); err != nil {
+		return temporal.NewApplicationError(err.Error(), errFailedPrecondition)
+	}
+	if d.State.ManagerIdentity != "" && d.State.ManagerIdentity != args.Identity {
+		return serviceerror.NewFailedPrecondition(fmt.Sprintf(ErrManagerIdentityMismatch, d.State.ManagerIdentity, args.Identity))
+	}
+	return nil
+}
+
+func (d *WorkflowRunner) validateStartRollout(args *deploymentspb.StartRolloutArgs) error {
+	if err := d.ensureNotDeleted(); err != nil {
+		return err
+	}
+	return d.validateStateBeforeAcceptingStartRollout(args)
+}
+
+func (d *WorkflowRunner) handleStartRollout(ctx workflow.Context, args *deploymentspb.StartRolloutArgs) (*deploymentspb.RolloutResponse, error) {
+	if err := d.preUpdateChecks(ctx); err != nil {
+		return nil, err
+	}
+
+	// The rollout lock serializes the rollout updates and health checks. The routing config updates they make take
+	// the workflow lock, so it must not be held here.
+	err := d.rolloutLock.Lock(ctx)
+	if err != nil {
+		d.logger.Error("Could not acquire rollout lock")
+		return nil, serviceerror.NewDeadlineExceeded("Could not acquire rollout lock")
+	}
+	defer func() {
+		d.setStateChanged()
+		d.rolloutLock.Unlock()
+	}()
+
+	if err = d.validateStateBeforeAcceptingStartRollout(args); err != nil {
+		return nil, err
+	}
+
+	if err = d.setRolloutRamp(ctx, args.Identity, args.Version, args.Plan.GetSteps()[0].GetRampPercentage(), args.IgnoreMissingTaskQueues); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.New(workflow.Now(ctx))
+	d.State.Rollout = &deploymentspb.WorkerDeploymentRollout{
+		Version:                 args.Version,
+		Plan:                    args.Plan,
+		StepStartTime:           now,
+		StartTime:               now,
+		Identity:                args.Identity,
+		IgnoreMissingTaskQueues: args.IgnoreMissingTaskQueues,
+	}
+	d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING, args.Identity, "")
+	d.rolloutGeneration++
+
+	return &deploymentspb.RolloutResponse{Rollout: d.State.Rollout}, nil
+}
+
+func (d *WorkflowRunner) validateStateBeforeAcceptingUpdateRollout(args *deploymentspb.UpdateRolloutArgs) error {
+	rollout := d.State.GetRollout()
+	switch args.Action {
+	case enumsspb.WORKER_DEPLOYMENT_ROLLOUT_ACTION_PAUSE:
+		if rollout.GetStatus() != enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING {
+			return temporal.NewApplicationError("no running rollout to pause", errFailedPrecondition)
+		}
+	case enumsspb.WORKER_DEPLOYMENT_ROLLOUT_ACTION_RESUME:
+		if rollout.GetStatus() != enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED {
+			return temporal.NewApplicationError("no paused rollout to resume", errFailedPrecondition)
+		}
+	case enumsspb.WORKER_DEPLOYMENT_ROLLOUT_ACTION_CANCEL, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_ACTION_ROLLBACK:
+		if !rolloutActive(rollout) {
+			return temporal.NewApplicationError("no rollout in progress", errFailedPrecondition)
+		}
+	default:
+		return temporal.NewApplicationError(fmt.Sprintf("unknown rollout action %s", args.Action), errFailedPrecondition)
+	}
+	if d.State.ManagerIdentity != "" && d.State.ManagerIdentity != args.Identity {
+		return serviceerror.NewFailedPrecondition(fmt.Sprintf(ErrManagerIdentityMismatch, d.State.ManagerIdentity, args.Identity))
+	}
+	return nil
+}
+
+func (d *WorkflowRunner) validateUpdateRollout(args *deploymentspb.UpdateRolloutArgs) error {
+	if err := d.ensureNotDeleted(); err != nil {
+		return err
+	}
+	return d.validateStateBeforeAcceptingUpdateRollout(args)
+}
+
+func (d *WorkflowRunner) handleUpdateRollout(ctx workflow.Context, args *deploymentspb.UpdateRolloutArgs) (*deploymentspb.RolloutResponse, error) {
+	if err := d.preUpdateChecks(ctx); err != nil {
+		return nil, err
+	}
+
+	err := d.rolloutLock.Lock(ctx)
+	if err != nil {
+		d.logger.Error("Could not acquire rollout lock")
+		return nil, serviceerror.NewDeadlineExceeded("Could not acquire rollout lock")
+	}
+	defer func() {
+		d.setStateChanged()
+		d.rolloutLock.Unlock()
+	}()
+
+	if err = d.validateStateBeforeAcceptingUpdateRollout(args); err != nil {
+		return nil, err
+	}
+
+	rollout := d.State.Rollout
+	switch args.Action {
+	case enumsspb.WORKER_DEPLOYMENT_ROLLOUT_ACTION_PAUSE:
+		d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED, args.Identity, "")
+	case enumsspb.WORKER_DEPLOYMENT_ROLLOUT_ACTION_RESUME:
+		// Health is measured from the resume, so that the failures that paused the rollout don't fail it again.
+		rollout.StepStartTime = timestamppb.New(workflow.Now(ctx))
+		rollout.LastHealthCheckTime = nil
+		rollout.LastHealth = nil
+		d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING, args.Identity, "")
+	case enumsspb.WORKER_DEPLOYMENT_ROLLOUT_ACTION_CANCEL:
+		d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_CANCELLED, args.Identity, "")
+	case enumsspb.WORKER_DEPLOYMENT_ROLLOUT_ACTION_ROLLBACK:
+		if err = d.unsetRolloutRamp(ctx, args.Identity); err != nil {
+			return nil, err
+		}
+		d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_ROLLED_BACK, args.Identity, "")
+	default:
+	}
+	d.rolloutGeneration++
+
+	return &deploymentspb.RolloutResponse{Rollout: rollout}, nil
+}
+
+// runRollout checks the health of the version of the running rollout at every health check interval, and advances
+// the rollout once the dwell time of its step passed. It runs for the lifetime of the workflow run.
+func (d *WorkflowRunner) runRollout(ctx workflow.Context) {
+	for {
+		// Nothing is done while history is too long: the routing config updates would be rejected, and the workflow
+		// continues-as-new once the rollout is idle.
+		err := workflow.Await(ctx, func() bool {
+			return d.deleteDeployment ||
+				(d.State.GetRollout().GetStatus() == enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING &&
+					!workflow.GetInfo(ctx).GetContinueAsNewSuggested())
+		})
+		if err != nil || d.deleteDeployment {
+			return
+		}
+
+		generation := d.rolloutGeneration
+		if wait := d.nextRolloutCheckTime().Sub(workflow.Now(ctx)); wait > 0 {
+			changed, err := workflow.AwaitWithTimeout(ctx, wait, func() bool {
+				return d.deleteDeployment || d.rolloutGeneration != generation
+			})
+			if err != nil {
+				return
+			}
+			if changed {
+				continue
+			}
+		}
+		d.checkRollout(ctx, generation)
+	}
+}
+
+func (d *WorkflowRunner) nextRolloutCheckTime() time.Time {
+	rollout := d.State.GetRollout()
+	interval := rollout.GetPlan().GetHealthCheckInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultRolloutHealthCheckInterval
+	}
+	next := rollout.GetStepStartTime().AsTime().Add(interval)
+	if rollout.GetLastHealthCheckTime() != nil {
+		next = rollout.GetLastHealthCheckTime().AsTime().Add(interval)
+	}
+	if stepEnd := d.rolloutStepEndTime(); stepEnd.Before(next) {
+		next = stepEnd
+	}
+	return next
+}
+
+func (d *WorkflowRunner) rolloutStepEndTime() time.Time {
+	rollout := d.State.GetRollout()
+	step := rollout.GetPlan().GetSteps()[rollout.GetStepIndex()]
+	return rollout.GetStepStartTime().AsTime().Add(step.GetDwellTime().AsDuration())
+}
+
+// checkRollout checks the health of the version of the running rollout. The rollout is failed if a health gate fails,
+// and advanced if the dwell time of its step passed. Every path updates the rollout, so that the next check is
+// scheduled later.
+func (d *WorkflowRunner) checkRollout(ctx workflow.Context, generation int) {
+	if err := d.rolloutLock.Lock(ctx); err != nil {
+		return
+	}
+	defer d.rolloutLock.Unlock()
+	rollout := d.State.GetRollout()
+	if d.deleteDeployment || d.rolloutGeneration != generation ||
+		rollout.GetStatus() != enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING {
+		return
+	}
+	defer d.setStateChanged()
+
+	health, err := d.getVersionHealth(ctx, rollout.GetVersion(), rollout.GetStepStartTime())
+	now := workflow.Now(ctx)
+	rollout.LastHealthCheckTime = timestamppb.New(now)
+	if err != nil {
+		d.logger.Warn("Failed to get version health for rollout", "version", rollout.GetVersion(), "error", err)
+		return
+	}
+	rollout.LastHealth = health
+
+	routingConfig := d.State.GetRoutingConfig()
+	//nolint:staticcheck // SA1019: worker versioning v0.31
+	if routingConfig.GetRampingVersion() != rollout.GetVersion() ||
+		routingConfig.GetRampingVersionPercentage() != rollout.GetPlan().GetSteps()[rollout.GetStepIndex()].GetRampPercentage() {
+		d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_CANCELLED, rollout.GetIdentity(), "routing config changed outside of the rollout")
+		return
+	}
+
+	if reason := rolloutGateFailure(rollout.GetPlan().GetHealthGates(), health); reason != "" {
+		d.failRollout(ctx, reason)
+		return
+	}
+	if now.Before(d.rolloutStepEndTime()) {
+		return
+	}
+	d.advanceRollout(ctx)
+}
+
+func (d *WorkflowRunner) failRollout(ctx workflow.Context, reason string) {
+	rollout := d.State.Rollout
+	if rollout.GetPlan().GetFailureAction() == enumsspb.WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_PAUSE {
+		d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED, rollout.GetIdentity(), reason)
+		return
+	}
+	if err := d.unsetRolloutRamp(ctx, rollout.GetIdentity()); err != nil {
+		d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED, rollout.GetIdentity(),
+			fmt.Sprintf("%s; rollback failed: %v", reason, err))
+		return
+	}
+	d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_ROLLED_BACK, rollout.GetIdentity(), reason)
+}
+
+// advanceRollout ramps the version of the rollout to the next step, or makes it current after the last step. The
+// rollout is paused if the routing config can't be updated.
+func (d *WorkflowRunner) advanceRollout(ctx workflow.Context) {
+	rollout := d.State.Rollout
+	next := rollout.GetStepIndex() + 1
+	if int(next) < len(rollout.GetPlan().GetSteps()) {
+		err := d.setRolloutRamp(ctx, rollout.GetIdentity(), rollout.GetVersion(), rollout.GetPlan().GetSteps()[next].GetRampPercentage(), false)
+		if err != nil {
+			d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED, rollout.GetIdentity(),
+				fmt.Sprintf("failed to advance the ramp: %v", err))
+			return
+		}
+		rollout.StepIndex = next
+		rollout.StepStartTime = timestamppb.New(workflow.Now(ctx))
+		d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING, rollout.GetIdentity(), "")
+		return
+	}
+
+	_, err := d.handleSetCurrent(ctx, &deploymentspb.SetCurrentVersionArgs{
+		Identity:                rollout.GetIdentity(),
+		Version:                 rollout.GetVersion(),
+		IgnoreMissingTaskQueues: rollout.GetIgnoreMissingTaskQueues(),
+	})
+	if err != nil && !isNoChangeError(err) {
+		d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED, rollout.GetIdentity(),
+			fmt.Sprintf("failed to set the version as current: %v", err))
+		return
+	}
+	d.setRolloutStatus(ctx, enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_COMPLETED, rollout.GetIdentity(), "")
+}
+
+func (d *WorkflowRunner) setRolloutRamp(ctx workflow.Context, identity, version string, percentage float32, ignoreMissingTaskQueues bool) error {
+	_, err := d.handleSetRampingVersion(ctx, &deploymentspb.SetRampingVersionArgs{
+		Identity:                identity,
+		Version:                 version,
+		Percentage:              percentage,
+		IgnoreMissingTaskQueues: ignoreMissingTaskQueues,
+	})
+	if isNoChangeError(err) {
+		return nil
+	}
+	return err
+}
+
+// unsetRolloutRamp removes the ramp of the version of the rollout, if it is still ramping.
+func (d *WorkflowRunner) unsetRolloutRamp(ctx workflow.Context, identity string) error {
+	//nolint:staticcheck // SA1019: worker versioning v0.31
+	if d.State.GetRoutingConfig().GetRampingVersion() != d.State.GetRollout().GetVersion() {
+		return nil
+	}
+	return d.setRolloutRamp(ctx, identity, "", 0, false)
+}
+
+// setRolloutStatus sets the status of the rollout and records the transition in its history.
+func (d *WorkflowRunner) setRolloutStatus(ctx workflow.Context, status enumsspb.WorkerDeploymentRolloutStatus, identity, reason string) {
+	rollout := d.State.Rollout
+	rollout.Status = status
+
+	var rampPercentage float32
+	//nolint:staticcheck // SA1019: worker versioning v0.31
+	if routingConfig := d.State.GetRoutingConfig(); routingConfig.GetRampingVersion() == rollout.GetVersion() {
+		rampPercentage = routingConfig.GetRampingVersionPercentage()
+	} else if routingConfig.GetCurrentVersion() == rollout.GetVersion() {
+		rampPercentage = 100
+	}
+	rollout.History = append(rollout.History, &deploymentspb.WorkerDeploymentRolloutEvent{
+		EventTime:      timestamppb.New(workflow.Now(ctx)),
+		Status:         status,
+		StepIndex:      rollout.GetStepIndex(),
+		RampPercentage: rampPercentage,
+		Reason:         reason,
+		Identity:       identity,
+	})
+	if len(rollout.History) > maxRolloutHistory {
+		rollout.History = rollout.History[len(rollout.History)-maxRolloutHistory:]
+	}
+	d.logger.Info("Rollout status changed",
+		"version", rollout.GetVersion(),
+		"status", status.String(),
+		"step_index", rollout.GetStepIndex(),
+		"reason", reason)
+}
+
+func (d *WorkflowRunner) getVersionHealth(
+	ctx workflow.Context,
+	version string,
+	since *timestamppb.Timestamp,
+) (*deploymentspb.WorkerDeploymentVersionHealth, error) {
+	versionObj, err := worker_versioning.WorkerDeploymentVersionFromStringV31(version)
+	if err != nil {
+		return nil, err
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, defaultActivityOptions)
+	var res deploymentspb.GetVersionHealthActivityResult
+	err = workflow.ExecuteActivity(activityCtx, d.a.GetVersionHealth, &deploymentspb.GetVersionHealthActivityArgs{
+		DeploymentName: d.DeploymentName,
+		BuildId:        versionObj.GetBuildId(),
+		Since:          since,
+	}).Get(ctx, &res)
+	return res.GetHealth(), err
+}
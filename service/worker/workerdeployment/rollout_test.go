@@ -0,0 +1,144 @@
+package workerdeployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	deploymentspb "go.temporal.io/server/api/deployment/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestValidateRolloutPlan(t *testing.T) {
+	t.Parallel()
+
+	step := func(percentage float32) *deploymentspb.WorkerDeploymentRolloutStep {
+		return &deploymentspb.WorkerDeploymentRolloutStep{RampPercentage: percentage, DwellTime: durationpb.New(time.Minute)}
+	}
+	tests := []struct {
+		name    string
+		plan    *deploymentspb.WorkerDeploymentRolloutPlan
+		wantErr string
+	}{
+		{
+			name:    "no plan",
+			wantErr: "at least one step",
+		},
+		{
+			name: "valid",
+			plan: &deploymentspb.WorkerDeploymentRolloutPlan{
+				Steps: []*deploymentspb.WorkerDeploymentRolloutStep{step(10), step(50), step(99.5)},
+				HealthGates: &deploymentspb.WorkerDeploymentRolloutHealthGates{
+					MaxWorkflowTaskFailureRate: 0.1,
+					MaxNondeterminismErrors:    -1,
+				},
+			},
+		},
+		{
+			name:    "zero percentage",
+			plan:    &deploymentspb.WorkerDeploymentRolloutPlan{Steps: []*deploymentspb.WorkerDeploymentRolloutStep{step(0)}},
+			wantErr: "step 0",
+		},
+		{
+			name:    "full ramp",
+			plan:    &deploymentspb.WorkerDeploymentRolloutPlan{Steps: []*deploymentspb.WorkerDeploymentRolloutStep{step(100)}},
+			wantErr: "below 100",
+		},
+		{
+			name:    "decreasing percentage",
+			plan:    &deploymentspb.WorkerDeploymentRolloutPlan{Steps: []*deploymentspb.WorkerDeploymentRolloutStep{step(50), step(50)}},
+			wantErr: "step 1",
+		},
+		{
+			name: "negative dwell time",
+			plan: &deploymentspb.WorkerDeploymentRolloutPlan{Steps: []*deploymentspb.WorkerDeploymentRolloutStep{
+				{RampPercentage: 10, DwellTime: durationpb.New(-time.Minute)},
+			}},
+			wantErr: "dwell time",
+		},
+		{
+			name: "failure rate above one",
+			plan: &deploymentspb.WorkerDeploymentRolloutPlan{
+				Steps:       []*deploymentspb.WorkerDeploymentRolloutStep{step(10)},
+				HealthGates: &deploymentspb.WorkerDeploymentRolloutHealthGates{MaxActivityFailureRate: 2},
+			},
+			wantErr: "failure rates",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRolloutPlan(tt.plan)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRolloutGateFailure(t *testing.T) {
+	t.Parallel()
+
+	gates := &deploymentspb.WorkerDeploymentRolloutHealthGates{
+		MaxWorkflowTaskFailureRate: 0.1,
+		MaxActivityFailureRate:     0.5,
+		MaxNondeterminismErrors:    0,
+		MinProcessedTasks:          10,
+	}
+	tests := []struct {
+		name    string
+		gates   *deploymentspb.WorkerDeploymentRolloutHealthGates
+		health  *deploymentspb.WorkerDeploymentVersionHealth
+		failure string
+	}{
+		{
+			name:   "no tasks",
+			gates:  gates,
+			health: &deploymentspb.WorkerDeploymentVersionHealth{},
+		},
+		{
+			name:    "nondeterminism error",
+			gates:   gates,
+			health:  &deploymentspb.WorkerDeploymentVersionHealth{NondeterminismErrors: 1},
+			failure: "1 nondeterminism errors exceed the max of 0",
+		},
+		{
+			name:   "nondeterminism gate disabled",
+			gates:  &deploymentspb.WorkerDeploymentRolloutHealthGates{MaxNondeterminismErrors: -1},
+			health: &deploymentspb.WorkerDeploymentVersionHealth{NondeterminismErrors: 5},
+		},
+		{
+			name:   "too few tasks to check failure rates",
+			gates:  gates,
+			health: &deploymentspb.WorkerDeploymentVersionHealth{WorkflowTasksProcessed: 9, WorkflowTasksFailed: 9},
+		},
+		{
+			name:    "workflow task failure rate",
+			gates:   gates,
+			health:  &deploymentspb.WorkerDeploymentVersionHealth{WorkflowTasksProcessed: 10, WorkflowTasksFailed: 2},
+			failure: "workflow task failure rate 0.2000 exceeds the max of 0.1000",
+		},
+		{
+			name:  "activity failure rate",
+			gates: gates,
+			health: &deploymentspb.WorkerDeploymentVersionHealth{
+				WorkflowTasksProcessed: 100,
+				WorkflowTasksFailed:    10,
+				ActivityTasksProcessed: 10,
+				ActivityTasksFailed:    6,
+			},
+			failure: "activity failure rate 0.6000 exceeds the max of 0.5000",
+		},
+		{
+			name:   "failure rate gates disabled",
+			gates:  &deploymentspb.WorkerDeploymentRolloutHealthGates{MaxNondeterminismErrors: -1},
+			health: &deploymentspb.WorkerDeploymentVersionHealth{WorkflowTasksProcessed: 10, WorkflowTasksFailed: 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.failure, rolloutGateFailure(tt.gates, tt.health))
+		})
+	}
+}
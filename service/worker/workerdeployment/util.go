@@ -55,6 +55,8 @@ const (
 	DeleteVersion                     = "delete-version"                // for WorkerDeployment wfs
 	DeleteDeployment                  = "delete-deployment"             // for WorkerDeployment wfs
 	SetManagerIdentity                = "set-manager-identity"          // for WorkerDeployment wfs
+	StartRollout                      = "start-rollout"                 // for WorkerDeployment wfs
+	UpdateRollout                     = "update-rollout"                // for WorkerDeployment wfs
 	serverDeleteVersionIdentity       = "try-delete-for-add-version"    // identity of the worker-deployment workflow when it tries to delete a version on the event that the addition
 	// of a version exceeds the max number of versions allowed in a worker-deployment (defaultMaxVersions)
 
@@ -53,6 +53,11 @@ type (
 		// that completes the first task. It remains constant for the lifetime of the run and
 		// only updates when the workflow performs continue-as-new.
 		workflowVersion DeploymentWorkflowVersion
+		// rolloutLock serializes the updates and health checks of the managed rollout.
+		rolloutLock workflow.Mutex
+		// rolloutGeneration is incremented whenever a user starts or changes the rollout, so that pending health
+		// checks are rescheduled.
+		rolloutGeneration int
 	}
 )
 
@@ -69,6 +74,7 @@ func Workflow(ctx workflow.Context, unsafeWorkflowVersionGetter func() Deploymen
 		logger:                       sdklog.With(workflow.GetLogger(ctx), "wf-namespace", args.NamespaceName),
 		metrics:                      workflow.GetMetricsHandler(ctx).WithTags(map[string]string{"namespace": args.NamespaceName}),
 		lock:                         workflow.NewMutex(ctx),
+		rolloutLock:                  workflow.NewMutex(ctx),
 		unsafeMaxVersion:             unsafeMaxVersion,
 		signalHandler: &SignalHandler{
 			signalSelector: workflow.NewSelector(ctx),
@@ -380,15 +386,41 @@ func (d *WorkflowRunner) run(ctx workflow.Context) error {
 		return err
 	}
 
+	if err := workflow.SetUpdateHandlerWithOptions(
+		ctx,
+		StartRollout,
+		d.handleStartRollout,
+		workflow.UpdateHandlerOptions{
+			Validator: d.validateStartRollout,
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := workflow.SetUpdateHandlerWithOptions(
+		ctx,
+		UpdateRollout,
+		d.handleUpdateRollout,
+		workflow.UpdateHandlerOptions{
+			Validator: d.validateUpdateRollout,
+		},
+	); err != nil {
+		return err
+	}
+
 	// Listen to signals in a different goroutine to make business logic clearer
 	workflow.Go(ctx, d.listenToSignals)
 
+	// Advance the managed rollout, if any, in a different goroutine
+	workflow.Go(ctx, d.runRollout)
+
 	// Wait until we can continue as new or are cancelled. The workflow will continue-as-new iff
 	// there are no pending updates/signals and the state has changed.
 	err = workflow.Await(ctx, func() bool {
 		canContinue := d.deleteDeployment || // deployment is deleted -> it's ok to drop all signals and updates.
 			// There is no pending signal or update, but the state is dirty or forceCaN is requested:
 			(!d.signalHandler.signalSelector.HasPending() && d.signalHandler.processingSignals == 0 && workflow.AllHandlersFinished(ctx) &&
+				!d.rolloutLock.IsLocked() &&
 				(d.forceCAN || d.stateChanged || workflow.GetInfo(ctx).GetContinueAsNewSuggested()))
 
 		// TODO(carlydf): remove verbose logging
@@ -400,6 +432,7 @@ func (d *WorkflowRunner) run(ctx workflow.Context) error {
 				"has_pending_signals", d.signalHandler.signalSelector.HasPending(),
 				"processing_signals", d.signalHandler.processingSignals,
 				"all_handlers_finished", workflow.AllHandlersFinished(ctx),
+				"rollout_locked", d.rolloutLock.IsLocked(),
 				"force_can", d.forceCAN,
 				"state_changed", d.stateChanged,
 				"routing_config", d.State.GetRoutingConfig())
@@ -15,9 +15,12 @@ import (
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
 	deploymentspb "go.temporal.io/server/api/deployment/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/testing/testvars"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -1857,3 +1860,263 @@ func (s *WorkerDeploymentSuite) Test_CreateWorkerDeploymentVersion_SyncSummaryPr
 
 	s.True(s.env.IsWorkflowCompleted())
 }
+
+func (s *WorkerDeploymentSuite) rolloutTestArgs(tv *testvars.TestVars, rollout *deploymentspb.WorkerDeploymentRollout) *deploymentspb.WorkerDeploymentWorkflowArgs {
+	version := tv.DeploymentVersionString()
+	args := &deploymentspb.WorkerDeploymentWorkflowArgs{
+		NamespaceName:  tv.NamespaceName().String(),
+		NamespaceId:    tv.NamespaceID().String(),
+		DeploymentName: tv.DeploymentSeries(),
+		State: &deploymentspb.WorkerDeploymentLocalState{
+			Versions: map[string]*deploymentspb.WorkerDeploymentVersionSummary{
+				version: {Version: version},
+			},
+			Rollout: rollout,
+		},
+	}
+	if rollout != nil {
+		args.State.RoutingConfig = &deploymentpb.RoutingConfig{
+			CurrentVersion:           worker_versioning.UnversionedVersionId,
+			RampingVersion:           version,
+			RampingVersionPercentage: rollout.GetPlan().GetSteps()[rollout.GetStepIndex()].GetRampPercentage(),
+		}
+	}
+	return args
+}
+
+func (s *WorkerDeploymentSuite) rolloutTestPlan(failureAction enumsspb.WorkerDeploymentRolloutFailureAction) *deploymentspb.WorkerDeploymentRolloutPlan {
+	return &deploymentspb.WorkerDeploymentRolloutPlan{
+		Steps: []*deploymentspb.WorkerDeploymentRolloutStep{
+			{RampPercentage: 10, DwellTime: durationpb.New(time.Minute)},
+			{RampPercentage: 50, DwellTime: durationpb.New(time.Minute)},
+		},
+		HealthGates:   &deploymentspb.WorkerDeploymentRolloutHealthGates{MaxNondeterminismErrors: 0},
+		FailureAction: failureAction,
+	}
+}
+
+// continuedAsNewState returns the state the workflow continued-as-new with.
+func (s *WorkerDeploymentSuite) continuedAsNewState() *deploymentspb.WorkerDeploymentLocalState {
+	s.True(s.env.IsWorkflowCompleted())
+	var canErr *workflow.ContinueAsNewError
+	s.Require().ErrorAs(s.env.GetWorkflowError(), &canErr, "workflow should have continued as new")
+	var args deploymentspb.WorkerDeploymentWorkflowArgs
+	s.Require().NoError(sdk.PreferProtoDataConverter.FromPayloads(canErr.Input, &args))
+	return args.GetState()
+}
+
+func (s *WorkerDeploymentSuite) mockSyncVersion() {
+	var a *Activities
+	s.env.RegisterActivity(a.SyncWorkerDeploymentVersion)
+	s.env.OnActivity(a.SyncWorkerDeploymentVersion, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, args *deploymentspb.SyncVersionStateActivityArgs) (*deploymentspb.SyncVersionStateActivityResult, error) {
+			return &deploymentspb.SyncVersionStateActivityResult{Summary: &deploymentspb.WorkerDeploymentVersionSummary{}}, nil
+		},
+	)
+}
+
+func (s *WorkerDeploymentSuite) mockVersionHealth(health *deploymentspb.WorkerDeploymentVersionHealth) {
+	var a *Activities
+	s.env.RegisterActivity(a.GetVersionHealth)
+	s.env.OnActivity(a.GetVersionHealth, mock.Anything, mock.Anything).Return(
+		&deploymentspb.GetVersionHealthActivityResult{Health: health}, nil,
+	)
+}
+
+func (s *WorkerDeploymentSuite) Test_StartRollout_RampsFirstStep() {
+	tv := testvars.New(s.T())
+	s.env.OnUpsertMemo(mock.Anything).Return(nil)
+	s.mockSyncVersion()
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.UpdateWorkflow(StartRollout, "", &testsuite.TestUpdateCallback{
+			OnReject: func(err error) {
+				s.Fail("StartRollout should not have been rejected", err)
+			},
+			OnAccept: func() {},
+			OnComplete: func(result any, err error) {
+				s.Require().NoError(err)
+				resp, ok := result.(*deploymentspb.RolloutResponse)
+				s.Require().True(ok)
+				s.Equal(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING, resp.GetRollout().GetStatus())
+				s.Len(resp.GetRollout().GetHistory(), 1)
+			},
+		}, &deploymentspb.StartRolloutArgs{
+			Identity:                tv.ClientIdentity(),
+			Version:                 tv.DeploymentVersionString(),
+			Plan:                    s.rolloutTestPlan(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_UNSPECIFIED),
+			IgnoreMissingTaskQueues: true,
+		})
+	}, 1*time.Millisecond)
+
+	s.env.ExecuteWorkflow(WorkerDeploymentWorkflowType, s.rolloutTestArgs(tv, nil))
+
+	state := s.continuedAsNewState()
+	//nolint:staticcheck // SA1019: worker versioning v0.31
+	s.Equal(tv.DeploymentVersionString(), state.GetRoutingConfig().GetRampingVersion())
+	s.InDelta(10, state.GetRoutingConfig().GetRampingVersionPercentage(), 0.001)
+	s.Equal(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING, state.GetRollout().GetStatus())
+	s.Equal(tv.ClientIdentity(), state.GetLastModifierIdentity())
+}
+
+func (s *WorkerDeploymentSuite) Test_StartRollout_RejectInvalidPlan() {
+	tv := testvars.New(s.T())
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.UpdateWorkflow(StartRollout, "", &testsuite.TestUpdateCallback{
+			OnReject: func(err error) {
+				s.ErrorContains(err, "at least one step")
+			},
+			OnAccept: func() {
+				s.Fail("StartRollout should have been rejected")
+			},
+			OnComplete: func(any, error) {},
+		}, &deploymentspb.StartRolloutArgs{
+			Identity: tv.ClientIdentity(),
+			Version:  tv.DeploymentVersionString(),
+			Plan:     &deploymentspb.WorkerDeploymentRolloutPlan{},
+		})
+	}, 1*time.Millisecond)
+
+	s.env.ExecuteWorkflow(WorkerDeploymentWorkflowType, s.rolloutTestArgs(tv, nil))
+	s.True(s.env.IsWorkflowCompleted())
+}
+
+func (s *WorkerDeploymentSuite) Test_Rollout_RollsBackOnGateFailure() {
+	tv := testvars.New(s.T())
+	s.env.OnUpsertMemo(mock.Anything).Return(nil)
+	s.mockSyncVersion()
+	s.mockVersionHealth(&deploymentspb.WorkerDeploymentVersionHealth{NondeterminismErrors: 1})
+
+	s.env.ExecuteWorkflow(WorkerDeploymentWorkflowType, s.rolloutTestArgs(tv, &deploymentspb.WorkerDeploymentRollout{
+		Version:       tv.DeploymentVersionString(),
+		Plan:          s.rolloutTestPlan(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_ROLLBACK),
+		Status:        enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING,
+		StepStartTime: timestamppb.New(time.Now().Add(-time.Hour)),
+		Identity:      tv.ClientIdentity(),
+	}))
+
+	state := s.continuedAsNewState()
+	//nolint:staticcheck // SA1019: worker versioning v0.31
+	s.Empty(state.GetRoutingConfig().GetRampingVersion())
+	rollout := state.GetRollout()
+	s.Equal(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_ROLLED_BACK, rollout.GetStatus())
+	s.Equal(int64(1), rollout.GetLastHealth().GetNondeterminismErrors())
+	s.Require().Len(rollout.GetHistory(), 1)
+	s.Contains(rollout.GetHistory()[0].GetReason(), "nondeterminism errors")
+}
+
+func (s *WorkerDeploymentSuite) Test_Rollout_PausesOnGateFailure() {
+	tv := testvars.New(s.T())
+	s.mockVersionHealth(&deploymentspb.WorkerDeploymentVersionHealth{NondeterminismErrors: 1})
+
+	s.env.ExecuteWorkflow(WorkerDeploymentWorkflowType, s.rolloutTestArgs(tv, &deploymentspb.WorkerDeploymentRollout{
+		Version:       tv.DeploymentVersionString(),
+		Plan:          s.rolloutTestPlan(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_PAUSE),
+		Status:        enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING,
+		StepStartTime: timestamppb.New(time.Now().Add(-time.Hour)),
+		Identity:      tv.ClientIdentity(),
+	}))
+
+	state := s.continuedAsNewState()
+	//nolint:staticcheck // SA1019: worker versioning v0.31
+	s.Equal(tv.DeploymentVersionString(), state.GetRoutingConfig().GetRampingVersion(), "the ramp should be kept")
+	s.Equal(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED, state.GetRollout().GetStatus())
+}
+
+func (s *WorkerDeploymentSuite) Test_Rollout_AdvancesAfterDwellTime() {
+	tv := testvars.New(s.T())
+	s.env.OnUpsertMemo(mock.Anything).Return(nil)
+	s.mockSyncVersion()
+	s.mockVersionHealth(&deploymentspb.WorkerDeploymentVersionHealth{WorkerCount: 1})
+
+	s.env.ExecuteWorkflow(WorkerDeploymentWorkflowType, s.rolloutTestArgs(tv, &deploymentspb.WorkerDeploymentRollout{
+		Version:       tv.DeploymentVersionString(),
+		Plan:          s.rolloutTestPlan(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_UNSPECIFIED),
+		Status:        enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING,
+		StepStartTime: timestamppb.New(time.Now().Add(-time.Hour)),
+		Identity:      tv.ClientIdentity(),
+	}))
+
+	state := s.continuedAsNewState()
+	//nolint:staticcheck // SA1019: worker versioning v0.31
+	s.Equal(tv.DeploymentVersionString(), state.GetRoutingConfig().GetRampingVersion())
+	s.InDelta(50, state.GetRoutingConfig().GetRampingVersionPercentage(), 0.001)
+	s.Equal(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING, state.GetRollout().GetStatus())
+	s.Equal(int32(1), state.GetRollout().GetStepIndex())
+}
+
+func (s *WorkerDeploymentSuite) Test_Rollout_CompletesAfterLastStep() {
+	tv := testvars.New(s.T())
+	s.env.OnUpsertMemo(mock.Anything).Return(nil)
+	s.mockSyncVersion()
+	s.mockVersionHealth(&deploymentspb.WorkerDeploymentVersionHealth{WorkerCount: 1})
+
+	s.env.ExecuteWorkflow(WorkerDeploymentWorkflowType, s.rolloutTestArgs(tv, &deploymentspb.WorkerDeploymentRollout{
+		Version:                 tv.DeploymentVersionString(),
+		Plan:                    s.rolloutTestPlan(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_UNSPECIFIED),
+		Status:                  enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING,
+		StepIndex:               1,
+		StepStartTime:           timestamppb.New(time.Now().Add(-time.Hour)),
+		Identity:                tv.ClientIdentity(),
+		IgnoreMissingTaskQueues: true,
+	}))
+
+	state := s.continuedAsNewState()
+	//nolint:staticcheck // SA1019: worker versioning v0.31
+	s.Equal(tv.DeploymentVersionString(), state.GetRoutingConfig().GetCurrentVersion())
+	s.Equal(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_COMPLETED, state.GetRollout().GetStatus())
+	s.InDelta(100, state.GetRollout().GetHistory()[0].GetRampPercentage(), 0.001)
+}
+
+func (s *WorkerDeploymentSuite) Test_Rollout_CancelledWhenRoutingConfigChanged() {
+	tv := testvars.New(s.T())
+	s.mockVersionHealth(&deploymentspb.WorkerDeploymentVersionHealth{})
+
+	args := s.rolloutTestArgs(tv, &deploymentspb.WorkerDeploymentRollout{
+		Version:       tv.DeploymentVersionString(),
+		Plan:          s.rolloutTestPlan(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_UNSPECIFIED),
+		Status:        enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING,
+		StepStartTime: timestamppb.New(time.Now().Add(-time.Hour)),
+		Identity:      tv.ClientIdentity(),
+	})
+	args.State.RoutingConfig.RampingVersionPercentage = 30
+	s.env.ExecuteWorkflow(WorkerDeploymentWorkflowType, args)
+
+	state := s.continuedAsNewState()
+	s.Equal(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_CANCELLED, state.GetRollout().GetStatus())
+	s.Equal("routing config changed outside of the rollout", state.GetRollout().GetHistory()[0].GetReason())
+}
+
+func (s *WorkerDeploymentSuite) Test_UpdateRollout_Pause() {
+	tv := testvars.New(s.T())
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.UpdateWorkflow(UpdateRollout, "", &testsuite.TestUpdateCallback{
+			OnReject: func(err error) {
+				s.Fail("UpdateRollout should not have been rejected", err)
+			},
+			OnAccept: func() {},
+			OnComplete: func(result any, err error) {
+				s.Require().NoError(err)
+			},
+		}, &deploymentspb.UpdateRolloutArgs{
+			Identity: tv.ClientIdentity(),
+			Action:   enumsspb.WORKER_DEPLOYMENT_ROLLOUT_ACTION_PAUSE,
+		})
+	}, 1*time.Millisecond)
+
+	// The first health check is not due before the update.
+	s.env.ExecuteWorkflow(WorkerDeploymentWorkflowType, s.rolloutTestArgs(tv, &deploymentspb.WorkerDeploymentRollout{
+		Version:       tv.DeploymentVersionString(),
+		Plan:          s.rolloutTestPlan(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_FAILURE_ACTION_UNSPECIFIED),
+		Status:        enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_RUNNING,
+		StepStartTime: timestamppb.New(time.Now()),
+		Identity:      tv.ClientIdentity(),
+	}))
+
+	state := s.continuedAsNewState()
+	s.Equal(enumsspb.WORKER_DEPLOYMENT_ROLLOUT_STATUS_PAUSED, state.GetRollout().GetStatus())
+	s.Require().Len(state.GetRollout().GetHistory(), 1)
+	s.Equal(tv.ClientIdentity(), state.GetRollout().GetHistory()[0].GetIdentity())
+}
@@ -36,6 +36,10 @@ const (
 	postgresSeedsEnv    = "POSTGRES_SEEDS"
 	postgresPortEnv     = "POSTGRES_PORT"
 	postgresDefaultPort = 5432
+
+	cockroachDBSeedsEnv    = "COCKROACHDB_SEEDS"
+	cockroachDBPortEnv     = "COCKROACHDB_PORT"
+	cockroachDBDefaultPort = 26257
 )
 
 func lookupLocalhostIP(domain string) string {
@@ -163,3 +167,26 @@ func GetPostgreSQLPort() int {
 	}
 	return p
 }
+
+// GetCockroachDBAddress return the CockroachDB address
+func GetCockroachDBAddress() string {
+	addr := os.Getenv(cockroachDBSeedsEnv)
+	if addr == "" {
+		addr = GetLocalhostIP()
+	}
+	return addr
+}
+
+// GetCockroachDBPort return the CockroachDB port
+func GetCockroachDBPort() int {
+	port := os.Getenv(cockroachDBPortEnv)
+	if port == "" {
+		return cockroachDBDefaultPort
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		//nolint:forbidigo // used in test code only
+		panic(fmt.Sprintf("error getting env %v", cockroachDBPortEnv))
+	}
+	return p
+}
@@ -628,6 +628,7 @@ func ApplyClusterMetadataConfigProvider(
 		metricsHandler,
 		telemetry.NoopTracerProvider,
 		serializer,
+		nil,
 	)
 	factory := persistenceFactoryProvider(persistenceClient.NewFactoryParams{
 		DataStoreFactory:           dataStoreFactory,
@@ -167,6 +167,7 @@ func initSystemNamespaces(
 		metricsHandler,
 		telemetry.NoopTracerProvider,
 		serializer,
+		nil,
 	)
 	factory := persistenceFactoryProvider(persistenceClient.NewFactoryParams{
 		DataStoreFactory:           dataStoreFactory,
@@ -837,7 +837,7 @@ func (c *TemporalImpl) newRPCFactory(
 		int(httpPort),
 		frontendTLSConfig,
 		options,
-		resource.PerServiceDialOptionsProvider(logger),
+		resource.PerServiceDialOptionsProvider(logger, nil),
 		monitor,
 		c.tokenProvider,
 	), nil
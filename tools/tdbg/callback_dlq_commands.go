@@ -0,0 +1,112 @@
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminListDeadLetteredCallbacks lists the callbacks of a namespace whose delivery was abandoned
+func AdminListDeadLetteredCallbacks(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	maxCount := c.Int(FlagMaxMessageCount)
+	if maxCount <= 0 {
+		return fmt.Errorf("%s must be positive but was %d", FlagMaxMessageCount, maxCount)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	result := &adminservice.ListDeadLetteredCallbacksResponse{}
+	var nextPageToken []byte
+	for len(result.Messages) < maxCount {
+		resp, err := adminClient.ListDeadLetteredCallbacks(ctx, &adminservice.ListDeadLetteredCallbacksRequest{
+			Namespace:     namespace,
+			PageSize:      int32(min(c.Int(FlagPageSize), maxCount-len(result.Messages))),
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to list dead-lettered callbacks: %w", err)
+		}
+		result.Messages = append(result.Messages, resp.GetMessages()...)
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 || len(resp.GetMessages()) == 0 {
+			break
+		}
+	}
+	result.NextPageToken = nextPageToken
+	prettyPrintJSONObject(c, result)
+	return nil
+}
+
+// AdminShowDeadLetteredCallback displays a single dead-lettered callback including the request that was sent
+func AdminShowDeadLetteredCallback(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetDeadLetteredCallback(ctx, &adminservice.GetDeadLetteredCallbackRequest{
+		Namespace: namespace,
+		MessageId: c.Int64(FlagMessageID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get dead-lettered callback: %w", err)
+	}
+	prettyPrintJSONObject(c, resp.GetMessage())
+	return nil
+}
+
+// AdminReplayDeadLetteredCallbacks re-delivers the dead-lettered callbacks of a namespace
+func AdminReplayDeadLetteredCallbacks(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ReplayDeadLetteredCallbacks(ctx, &adminservice.ReplayDeadLetteredCallbacksRequest{
+		Namespace:             namespace,
+		InclusiveMaxMessageId: c.Int64(FlagLastMessageID),
+		DeleteDelivered:       !c.Bool(FlagKeepDelivered),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to replay dead-lettered callbacks: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminPurgeDeadLetteredCallbacks deletes the dead-lettered callbacks of a namespace up to a message ID
+func AdminPurgeDeadLetteredCallbacks(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.PurgeDeadLetteredCallbacks(ctx, &adminservice.PurgeDeadLetteredCallbacksRequest{
+		Namespace:             namespace,
+		InclusiveMaxMessageId: c.Int64(FlagLastMessageID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to purge dead-lettered callbacks: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
@@ -0,0 +1,78 @@
+package tdbg
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type callbackDLQTestClient struct {
+	outboundTestClient
+	listRequests   []*adminservice.ListDeadLetteredCallbacksRequest
+	replayRequests []*adminservice.ReplayDeadLetteredCallbacksRequest
+	purgeRequests  []*adminservice.PurgeDeadLetteredCallbacksRequest
+}
+
+func (t *callbackDLQTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *callbackDLQTestClient) ListDeadLetteredCallbacks(_ context.Context, request *adminservice.ListDeadLetteredCallbacksRequest, _ ...grpc.CallOption) (*adminservice.ListDeadLetteredCallbacksResponse, error) {
+	t.listRequests = append(t.listRequests, request)
+	return &adminservice.ListDeadLetteredCallbacksResponse{
+		Messages:      []*adminservice.DeadLetteredCallbackMessage{{MessageId: int64(len(t.listRequests))}},
+		NextPageToken: []byte("token"),
+	}, nil
+}
+
+func (t *callbackDLQTestClient) ReplayDeadLetteredCallbacks(_ context.Context, request *adminservice.ReplayDeadLetteredCallbacksRequest, _ ...grpc.CallOption) (*adminservice.ReplayDeadLetteredCallbacksResponse, error) {
+	t.replayRequests = append(t.replayRequests, request)
+	return &adminservice.ReplayDeadLetteredCallbacksResponse{}, nil
+}
+
+func (t *callbackDLQTestClient) PurgeDeadLetteredCallbacks(_ context.Context, request *adminservice.PurgeDeadLetteredCallbacksRequest, _ ...grpc.CallOption) (*adminservice.PurgeDeadLetteredCallbacksResponse, error) {
+	t.purgeRequests = append(t.purgeRequests, request)
+	return &adminservice.PurgeDeadLetteredCallbacksResponse{}, nil
+}
+
+func TestCallbackDLQList(t *testing.T) {
+	client := &callbackDLQTestClient{}
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "callback-dlq", "list",
+		"--pagesize", "1", "--max-message-count", "3"}))
+	require.Len(t, client.listRequests, 3)
+	require.Equal(t, "ns", client.listRequests[0].GetNamespace())
+	require.Equal(t, int32(1), client.listRequests[0].GetPageSize())
+	require.Equal(t, []byte("token"), client.listRequests[1].GetNextPageToken())
+}
+
+func TestCallbackDLQReplayAndPurge(t *testing.T) {
+	client := &callbackDLQTestClient{}
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "callback-dlq", "replay"}))
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "callback-dlq", "replay",
+		"--last-message-id", "5", "--keep-delivered"}))
+	require.Error(t, app.Run([]string{"tdbg", "--namespace", "ns", "callback-dlq", "purge"}))
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "callback-dlq", "purge", "--last-message-id", "7"}))
+
+	require.Len(t, client.replayRequests, 2)
+	require.Equal(t, int64(math.MaxInt64), client.replayRequests[0].GetInclusiveMaxMessageId())
+	require.True(t, client.replayRequests[0].GetDeleteDelivered())
+	require.Equal(t, int64(5), client.replayRequests[1].GetInclusiveMaxMessageId())
+	require.False(t, client.replayRequests[1].GetDeleteDelivered())
+	require.Len(t, client.purgeRequests, 1)
+	require.Equal(t, int64(7), client.purgeRequests[0].GetInclusiveMaxMessageId())
+}
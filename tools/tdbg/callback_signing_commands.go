@@ -0,0 +1,87 @@
+package tdbg
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// AdminRotateCallbackSigningKey adds a new key used to sign the outbound completion callbacks of a namespace
+func AdminRotateCallbackSigningKey(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	var secret []byte
+	if c.IsSet(FlagSecret) {
+		secret, err = base64.StdEncoding.DecodeString(c.String(FlagSecret))
+		if err != nil {
+			return fmt.Errorf("%s must be base64 encoded: %w", FlagSecret, err)
+		}
+	}
+	if c.Duration(FlagOverlap) < 0 {
+		return fmt.Errorf("%s must not be negative", FlagOverlap)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.RotateCallbackSigningKey(ctx, &adminservice.RotateCallbackSigningKeyRequest{
+		Namespace:          namespace,
+		KeyId:              c.String(FlagKeyID),
+		Secret:             secret,
+		PreviousKeyOverlap: durationpb.New(c.Duration(FlagOverlap)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to rotate callback signing key: %w", err)
+	}
+	prettyPrintJSONObject(c, resp.GetKey())
+	return nil
+}
+
+// AdminListCallbackSigningKeys lists the callback signing keys of a namespace
+func AdminListCallbackSigningKeys(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListCallbackSigningKeys(ctx, &adminservice.ListCallbackSigningKeysRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list callback signing keys: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminDeleteCallbackSigningKey deletes a callback signing key of a namespace
+func AdminDeleteCallbackSigningKey(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.DeleteCallbackSigningKey(ctx, &adminservice.DeleteCallbackSigningKeyRequest{
+		Namespace: namespace,
+		KeyId:     c.String(FlagKeyID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete callback signing key: %w", err)
+	}
+	_, _ = fmt.Fprintf(c.App.Writer, "Deleted callback signing key %s\n", c.String(FlagKeyID))
+	return nil
+}
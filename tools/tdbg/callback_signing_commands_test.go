@@ -0,0 +1,67 @@
+package tdbg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type callbackSigningTestClient struct {
+	outboundTestClient
+	rotateRequests []*adminservice.RotateCallbackSigningKeyRequest
+	deleteRequests []*adminservice.DeleteCallbackSigningKeyRequest
+}
+
+func (t *callbackSigningTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *callbackSigningTestClient) RotateCallbackSigningKey(_ context.Context, request *adminservice.RotateCallbackSigningKeyRequest, _ ...grpc.CallOption) (*adminservice.RotateCallbackSigningKeyResponse, error) {
+	t.rotateRequests = append(t.rotateRequests, request)
+	return &adminservice.RotateCallbackSigningKeyResponse{}, nil
+}
+
+func (t *callbackSigningTestClient) DeleteCallbackSigningKey(_ context.Context, request *adminservice.DeleteCallbackSigningKeyRequest, _ ...grpc.CallOption) (*adminservice.DeleteCallbackSigningKeyResponse, error) {
+	t.deleteRequests = append(t.deleteRequests, request)
+	return &adminservice.DeleteCallbackSigningKeyResponse{}, nil
+}
+
+func TestCallbackSigningKeyRotate(t *testing.T) {
+	client := &callbackSigningTestClient{}
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "callback-signing-key", "rotate"}))
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "callback-signing-key", "rotate",
+		"--key-id", "k2", "--secret", "c2VjcmV0", "--overlap", "1h"}))
+	require.Error(t, app.Run([]string{"tdbg", "--namespace", "ns", "callback-signing-key", "rotate",
+		"--secret", "not base64!"}))
+
+	require.Len(t, client.rotateRequests, 2)
+	require.Empty(t, client.rotateRequests[0].GetKeyId())
+	require.Empty(t, client.rotateRequests[0].GetSecret())
+	require.Equal(t, 24*time.Hour, client.rotateRequests[0].GetPreviousKeyOverlap().AsDuration())
+	require.Equal(t, "k2", client.rotateRequests[1].GetKeyId())
+	require.Equal(t, []byte("secret"), client.rotateRequests[1].GetSecret())
+	require.Equal(t, time.Hour, client.rotateRequests[1].GetPreviousKeyOverlap().AsDuration())
+}
+
+func TestCallbackSigningKeyDelete(t *testing.T) {
+	client := &callbackSigningTestClient{}
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	require.Error(t, app.Run([]string{"tdbg", "--namespace", "ns", "callback-signing-key", "delete"}))
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "callback-signing-key", "delete", "--key-id", "k1"}))
+	require.Len(t, client.deleteRequests, 1)
+	require.Equal(t, "k1", client.deleteRequests[0].GetKeyId())
+}
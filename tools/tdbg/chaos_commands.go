@@ -0,0 +1,89 @@
+package tdbg
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	faultinjectionspb "go.temporal.io/server/api/faultinjection/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminEnableFault injects a fault into the persistence or RPC client calls of all frontend, history and matching
+// hosts
+func AdminEnableFault(c *cli.Context, clientFactory ClientFactory) error {
+	fault := &faultinjectionspb.Fault{
+		Id:          c.String(FlagFaultID),
+		Target:      c.String(FlagTarget),
+		Services:    c.StringSlice(FlagService),
+		Component:   c.String(FlagComponent),
+		Method:      c.String(FlagMethod),
+		NamespaceId: c.String(FlagNamespaceID),
+		ShardId:     int32(c.Int(FlagShardID)),
+		ErrorType:   c.String(FlagErrorType),
+		ErrorRate:   c.Float64(FlagErrorRate),
+		LatencyRate: c.Float64(FlagLatencyRate),
+	}
+	if c.IsSet(FlagLatency) {
+		fault.Latency = durationpb.New(c.Duration(FlagLatency))
+	}
+	if c.IsSet(FlagExpireAfter) {
+		if c.Duration(FlagExpireAfter) <= 0 {
+			return fmt.Errorf("%s must be positive", FlagExpireAfter)
+		}
+		fault.ExpireTime = timestamppb.New(time.Now().Add(c.Duration(FlagExpireAfter)))
+	}
+
+	return updateFaultInjection(c, clientFactory, &adminservice.UpdateFaultInjectionRequest{
+		UpsertFaults: []*faultinjectionspb.Fault{fault},
+	})
+}
+
+// AdminDisableFaults stops injecting faults on all frontend, history and matching hosts
+func AdminDisableFaults(c *cli.Context, clientFactory ClientFactory) error {
+	faultIDs := c.StringSlice(FlagFaultID)
+	if len(faultIDs) == 0 && !c.Bool(FlagAll) {
+		return fmt.Errorf("either %s or %s must be set", FlagFaultID, FlagAll)
+	}
+
+	return updateFaultInjection(c, clientFactory, &adminservice.UpdateFaultInjectionRequest{
+		DeleteFaultIds:  faultIDs,
+		DeleteAllFaults: c.Bool(FlagAll),
+	})
+}
+
+// AdminListFaults lists the faults injected on each frontend, history and matching host
+func AdminListFaults(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeFaultInjection(ctx, &adminservice.DescribeFaultInjectionRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to describe fault injection: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+func updateFaultInjection(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	request *adminservice.UpdateFaultInjectionRequest,
+) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.UpdateFaultInjection(ctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to update fault injection: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	if len(resp.GetUnreachableHosts()) > 0 {
+		_, _ = fmt.Fprintf(c.App.ErrWriter, "The update could not be applied to hosts %v, retry or check them with the list command\n", resp.GetUnreachableHosts())
+	}
+	return nil
+}
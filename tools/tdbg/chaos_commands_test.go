@@ -0,0 +1,102 @@
+package tdbg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type chaosTestClient struct {
+	adminservice.AdminServiceClient
+	updateRequests   []*adminservice.UpdateFaultInjectionRequest
+	describeRequests []*adminservice.DescribeFaultInjectionRequest
+}
+
+func (t *chaosTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *chaosTestClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	panic("unimplemented")
+}
+
+func (t *chaosTestClient) UpdateFaultInjection(_ context.Context, request *adminservice.UpdateFaultInjectionRequest, _ ...grpc.CallOption) (*adminservice.UpdateFaultInjectionResponse, error) {
+	t.updateRequests = append(t.updateRequests, request)
+	return &adminservice.UpdateFaultInjectionResponse{}, nil
+}
+
+func (t *chaosTestClient) DescribeFaultInjection(_ context.Context, request *adminservice.DescribeFaultInjectionRequest, _ ...grpc.CallOption) (*adminservice.DescribeFaultInjectionResponse, error) {
+	t.describeRequests = append(t.describeRequests, request)
+	return &adminservice.DescribeFaultInjectionResponse{}, nil
+}
+
+func newChaosTestApp(client *chaosTestClient) *cli.App {
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+	return app
+}
+
+func TestChaosEnable(t *testing.T) {
+	client := &chaosTestClient{}
+	app := newChaosTestApp(client)
+
+	require.NoError(t, app.Run([]string{"tdbg", "chaos", "enable",
+		"--fault-id", "slow-matching",
+		"--target", "rpc",
+		"--service", "history",
+		"--component", "matching",
+		"--method", "AddWorkflowTask",
+		"--error", "Unavailable",
+		"--error-rate", "0.1",
+		"--latency", "2s",
+		"--latency-rate", "0.5",
+		"--expire-after", "1h",
+	}))
+	require.Len(t, client.updateRequests, 1)
+	require.Len(t, client.updateRequests[0].GetUpsertFaults(), 1)
+	fault := client.updateRequests[0].GetUpsertFaults()[0]
+	require.Equal(t, "slow-matching", fault.GetId())
+	require.Equal(t, "rpc", fault.GetTarget())
+	require.Equal(t, []string{"history"}, fault.GetServices())
+	require.Equal(t, "matching", fault.GetComponent())
+	require.Equal(t, "AddWorkflowTask", fault.GetMethod())
+	require.Equal(t, "Unavailable", fault.GetErrorType())
+	require.InDelta(t, 0.1, fault.GetErrorRate(), 1e-9)
+	require.Equal(t, 2*time.Second, fault.GetLatency().AsDuration())
+	require.InDelta(t, 0.5, fault.GetLatencyRate(), 1e-9)
+	require.WithinDuration(t, time.Now().Add(time.Hour), fault.GetExpireTime().AsTime(), time.Minute)
+
+	require.Error(t, app.Run([]string{"tdbg", "chaos", "enable", "--fault-id", "f", "--expire-after", "-1s"}))
+	require.Len(t, client.updateRequests, 1)
+}
+
+func TestChaosDisable(t *testing.T) {
+	client := &chaosTestClient{}
+	app := newChaosTestApp(client)
+
+	require.Error(t, app.Run([]string{"tdbg", "chaos", "disable"}))
+	require.Empty(t, client.updateRequests)
+
+	require.NoError(t, app.Run([]string{"tdbg", "chaos", "disable", "--fault-id", "a", "--fault-id", "b"}))
+	require.NoError(t, app.Run([]string{"tdbg", "chaos", "disable", "--all"}))
+	require.Len(t, client.updateRequests, 2)
+	require.Equal(t, []string{"a", "b"}, client.updateRequests[0].GetDeleteFaultIds())
+	require.False(t, client.updateRequests[0].GetDeleteAllFaults())
+	require.True(t, client.updateRequests[1].GetDeleteAllFaults())
+}
+
+func TestChaosList(t *testing.T) {
+	client := &chaosTestClient{}
+	app := newChaosTestApp(client)
+
+	require.NoError(t, app.Run([]string{"tdbg", "chaos", "list"}))
+	require.Len(t, client.describeRequests, 1)
+}
@@ -80,4 +80,31 @@ var (
 	FlagScheduleID                 = "schedule-id"
 	FlagScheduleIDAlias            = []string{"sid"}
 	FlagTarget                     = "target"
+	FlagDestination                = "destination"
+	FlagTaskGroup                  = "task-group"
+	FlagUnhealthyOnly              = "unhealthy-only"
+	FlagEndpointID                 = "endpoint-id"
+	FlagEndpointVersion            = "endpoint-version"
+	FlagMessageID                  = "message-id"
+	FlagKeepDelivered              = "keep-delivered"
+	FlagKeyID                      = "key-id"
+	FlagSecret                     = "secret"
+	FlagOverlap                    = "overlap"
+	FlagFaultID                    = "fault-id"
+	FlagService                    = "service"
+	FlagComponent                  = "component"
+	FlagMethod                     = "method"
+	FlagErrorType                  = "error"
+	FlagErrorRate                  = "error-rate"
+	FlagLatency                    = "latency"
+	FlagLatencyRate                = "latency-rate"
+	FlagExpireAfter                = "expire-after"
+	FlagAll                        = "all"
+	FlagStuckOnly                  = "stuck-only"
+	FlagLagThreshold               = "lag-threshold"
+	FlagWorkerInstanceKey          = "worker-instance-key"
+	FlagDeploymentName             = "deployment-name"
+	FlagConcurrentShardCount       = "concurrent-shard-count"
+	FlagRPS                        = "rps"
+	FlagSkipVerification           = "skip-verification"
 )
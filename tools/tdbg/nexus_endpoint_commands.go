@@ -0,0 +1,73 @@
+package tdbg
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// AdminUpdateNexusEndpointHTTPTarget switches a Nexus endpoint to a plain HTTP service target read from a JSON file
+func AdminUpdateNexusEndpointHTTPTarget(c *cli.Context, clientFactory ClientFactory) error {
+	id, err := getRequiredOption(c, FlagEndpointID)
+	if err != nil {
+		return err
+	}
+	inputFileName, err := getRequiredOption(c, FlagInputFilename)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(inputFileName)
+	if err != nil {
+		return fmt.Errorf("unable to read HTTP target file: %w", err)
+	}
+	target := &persistencespb.NexusEndpointTarget_Http{}
+	if err := protojson.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("unable to parse HTTP target: %w", err)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.UpdateNexusEndpointHttpTarget(ctx, &adminservice.UpdateNexusEndpointHttpTargetRequest{
+		Id:      id,
+		Version: c.Int64(FlagEndpointVersion),
+		Target:  target,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update Nexus endpoint target: %w", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminDescribeNexusEndpoint displays a Nexus endpoint with the circuit breaker state, recent error classes and
+// latencies of its outbound destination
+func AdminDescribeNexusEndpoint(c *cli.Context, clientFactory ClientFactory) error {
+	id, err := getRequiredOption(c, FlagEndpointID)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeNexusEndpoint(ctx, &adminservice.DescribeNexusEndpointRequest{
+		Id: id,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe Nexus endpoint: %w", err)
+	}
+
+	if !c.Bool(FlagPrintFullyDetail) {
+		resp.HostDestinations = nil
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
@@ -0,0 +1,75 @@
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminDescribeOutboundDestinationHealth displays the outbound queue circuit breaker state and recent request
+// outcomes of Nexus endpoints and callback destinations
+func AdminDescribeOutboundDestinationHealth(c *cli.Context, clientFactory ClientFactory) error {
+	// Describe all namespaces unless one is explicitly requested.
+	var namespace string
+	if c.IsSet(FlagNamespace) {
+		namespace = c.String(FlagNamespace)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeOutboundDestinationHealth(ctx, &adminservice.DescribeOutboundDestinationHealthRequest{
+		Namespace:   namespace,
+		TaskGroup:   c.String(FlagTaskGroup),
+		Destination: c.String(FlagDestination),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe outbound destination health: %w", err)
+	}
+
+	if c.Bool(FlagUnhealthyOnly) {
+		destinations := resp.Destinations[:0]
+		for _, destination := range resp.Destinations {
+			if destination.GetCircuitBreakerState() != "closed" || destination.GetManuallyTripped() {
+				destinations = append(destinations, destination)
+			}
+		}
+		resp.Destinations = destinations
+	}
+	if !c.Bool(FlagPrintFullyDetail) {
+		resp.HostDestinations = nil
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminUpdateOutboundCircuitBreaker manually trips or resets the outbound queue circuit breakers of a destination
+func AdminUpdateOutboundCircuitBreaker(c *cli.Context, clientFactory ClientFactory, trip bool) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	destination, err := getRequiredOption(c, FlagDestination)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.UpdateOutboundCircuitBreaker(ctx, &adminservice.UpdateOutboundCircuitBreakerRequest{
+		Namespace:   namespace,
+		TaskGroup:   c.String(FlagTaskGroup),
+		Destination: destination,
+		Trip:        trip,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update outbound circuit breaker: %w", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
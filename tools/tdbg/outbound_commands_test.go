@@ -0,0 +1,74 @@
+package tdbg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type outboundTestClient struct {
+	adminservice.AdminServiceClient
+	describeRequests []*adminservice.DescribeOutboundDestinationHealthRequest
+	updateRequests   []*adminservice.UpdateOutboundCircuitBreakerRequest
+}
+
+func (t *outboundTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *outboundTestClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	panic("unimplemented")
+}
+
+func (t *outboundTestClient) DescribeOutboundDestinationHealth(_ context.Context, request *adminservice.DescribeOutboundDestinationHealthRequest, _ ...grpc.CallOption) (*adminservice.DescribeOutboundDestinationHealthResponse, error) {
+	t.describeRequests = append(t.describeRequests, request)
+	return &adminservice.DescribeOutboundDestinationHealthResponse{}, nil
+}
+
+func (t *outboundTestClient) UpdateOutboundCircuitBreaker(_ context.Context, request *adminservice.UpdateOutboundCircuitBreakerRequest, _ ...grpc.CallOption) (*adminservice.UpdateOutboundCircuitBreakerResponse, error) {
+	t.updateRequests = append(t.updateRequests, request)
+	return &adminservice.UpdateOutboundCircuitBreakerResponse{UpdatedCount: 1}, nil
+}
+
+func newOutboundTestApp(client *outboundTestClient) *cli.App {
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+	return app
+}
+
+func TestOutboundHealth(t *testing.T) {
+	client := &outboundTestClient{}
+	app := newOutboundTestApp(client)
+
+	require.NoError(t, app.Run([]string{"tdbg", "outbound", "health"}))
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "outbound", "health",
+		"--task-group", "nexus", "--destination", "endpoint"}))
+
+	require.Len(t, client.describeRequests, 2)
+	require.Empty(t, client.describeRequests[0].GetNamespace())
+	require.Equal(t, "ns", client.describeRequests[1].GetNamespace())
+	require.Equal(t, "nexus", client.describeRequests[1].GetTaskGroup())
+	require.Equal(t, "endpoint", client.describeRequests[1].GetDestination())
+}
+
+func TestOutboundTripAndReset(t *testing.T) {
+	client := &outboundTestClient{}
+	app := newOutboundTestApp(client)
+
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "outbound", "trip", "--destination", "endpoint"}))
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "outbound", "reset", "--destination", "endpoint"}))
+	require.Error(t, app.Run([]string{"tdbg", "outbound", "trip"}))
+
+	require.Len(t, client.updateRequests, 2)
+	require.True(t, client.updateRequests[0].GetTrip())
+	require.False(t, client.updateRequests[1].GetTrip())
+	require.Equal(t, "ns", client.updateRequests[0].GetNamespace())
+	require.Equal(t, "endpoint", client.updateRequests[0].GetDestination())
+}
@@ -0,0 +1,24 @@
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminDescribePersistenceConcurrencyLimiter displays the state of the adaptive persistence concurrency limiter of
+// frontend, history and matching hosts
+func AdminDescribePersistenceConcurrencyLimiter(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribePersistenceConcurrencyLimiter(ctx, &adminservice.DescribePersistenceConcurrencyLimiterRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to describe persistence concurrency limiter: %w", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
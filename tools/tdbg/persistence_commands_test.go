@@ -0,0 +1,41 @@
+package tdbg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type persistenceTestClient struct {
+	adminservice.AdminServiceClient
+	describeRequests []*adminservice.DescribePersistenceConcurrencyLimiterRequest
+}
+
+func (t *persistenceTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *persistenceTestClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	panic("unimplemented")
+}
+
+func (t *persistenceTestClient) DescribePersistenceConcurrencyLimiter(_ context.Context, request *adminservice.DescribePersistenceConcurrencyLimiterRequest, _ ...grpc.CallOption) (*adminservice.DescribePersistenceConcurrencyLimiterResponse, error) {
+	t.describeRequests = append(t.describeRequests, request)
+	return &adminservice.DescribePersistenceConcurrencyLimiterResponse{}, nil
+}
+
+func TestPersistenceConcurrencyLimit(t *testing.T) {
+	client := &persistenceTestClient{}
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	require.NoError(t, app.Run([]string{"tdbg", "persistence", "concurrency-limit"}))
+	require.Len(t, client.describeRequests, 1)
+}
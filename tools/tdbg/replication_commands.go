@@ -0,0 +1,120 @@
+package tdbg
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+)
+
+// AdminDescribeReplicationStatus displays how far each remote cluster is behind each shard and the sizes of the
+// replication DLQs, highlighting the shards whose replication is stuck
+func AdminDescribeReplicationStatus(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeReplicationLag(ctx, &adminservice.DescribeReplicationLagRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to describe replication lag: %w", err)
+	}
+
+	lagThreshold := c.Duration(FlagLagThreshold)
+	if c.Bool(FlagStuckOnly) {
+		shards := resp.Shards[:0]
+		for _, shard := range resp.Shards {
+			if len(replicationStuckReasons(shard, lagThreshold)) > 0 {
+				shards = append(shards, shard)
+			}
+		}
+		resp.Shards = shards
+	}
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(c, resp)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "SHARD\tREMOTE CLUSTER\tHOST\tTASK ID LAG\tLAG\tSTREAMS\tPAUSED\tSTATUS")
+	for _, shard := range resp.Shards {
+		connected := 0
+		var paused []string
+		for _, stream := range shard.GetStreams() {
+			if stream.GetConnected() {
+				connected++
+			}
+			for _, state := range stream.GetFlowControl() {
+				if state.GetPaused() {
+					paused = append(paused, fmt.Sprintf("%d/%s", stream.GetRemoteShardId(), state.GetPriority()))
+				}
+			}
+		}
+		pausedColumn := "-"
+		if len(paused) > 0 {
+			pausedColumn = strings.Join(paused, ",")
+		}
+		status := "OK"
+		if reasons := replicationStuckReasons(shard, lagThreshold); len(reasons) > 0 {
+			status = color.RedString("STUCK (%s)", strings.Join(reasons, ", "))
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%d/%d connected\t%s\t%s\n",
+			shard.GetShardId(),
+			shard.GetRemoteCluster(),
+			shard.GetHostAddress(),
+			shard.GetTaskIdLag(),
+			shard.GetLag().AsDuration(),
+			connected,
+			len(shard.GetStreams()),
+			pausedColumn,
+			status,
+		)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(resp.Dlqs) > 0 {
+		_, _ = fmt.Fprintln(c.App.Writer)
+		w = tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "DLQ SOURCE CLUSTER\tQUEUE\tMESSAGES")
+		for _, dlq := range resp.Dlqs {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%d\n", dlq.GetSourceCluster(), dlq.GetQueueName(), dlq.GetMessageCount())
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	if len(resp.UnreachableHosts) > 0 {
+		_, _ = fmt.Fprintln(c.App.Writer)
+		_, _ = fmt.Fprintln(c.App.Writer, color.YellowString(
+			"Unable to reach history hosts, their shards are missing: %s", strings.Join(resp.UnreachableHosts, ", "),
+		))
+	}
+	return nil
+}
+
+// replicationStuckReasons returns why the replication of a shard to a remote cluster looks stuck, or nothing if
+// the shard is healthy
+func replicationStuckReasons(shard *replicationspb.ShardReplicationLag, lagThreshold time.Duration) []string {
+	var reasons []string
+	var notProgressing, disconnected bool
+	for _, stream := range shard.GetStreams() {
+		notProgressing = notProgressing || stream.GetStuck()
+		disconnected = disconnected || !stream.GetConnected()
+	}
+	if notProgressing {
+		reasons = append(reasons, "ack level not moving")
+	}
+	if disconnected && shard.GetTaskIdLag() > 0 {
+		reasons = append(reasons, "stream disconnected")
+	}
+	if lagThreshold > 0 && shard.GetLag().AsDuration() > lagThreshold {
+		reasons = append(reasons, "lag above threshold")
+	}
+	return reasons
+}
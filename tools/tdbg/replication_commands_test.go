@@ -0,0 +1,96 @@
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type replicationTestClient struct {
+	adminservice.AdminServiceClient
+	response *adminservice.DescribeReplicationLagResponse
+}
+
+func (t *replicationTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *replicationTestClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	panic("unimplemented")
+}
+
+func (t *replicationTestClient) DescribeReplicationLag(_ context.Context, _ *adminservice.DescribeReplicationLagRequest, _ ...grpc.CallOption) (*adminservice.DescribeReplicationLagResponse, error) {
+	return t.response, nil
+}
+
+func newReplicationTestResponse() *adminservice.DescribeReplicationLagResponse {
+	return &adminservice.DescribeReplicationLagResponse{
+		Shards: []*replicationspb.ShardReplicationLag{
+			{
+				ShardId:       1,
+				RemoteCluster: "standby",
+				Lag:           durationpb.New(0),
+				Streams:       []*replicationspb.ReplicationStreamSenderStatus{{RemoteShardId: 1, Connected: true}},
+			},
+			{
+				ShardId:       2,
+				RemoteCluster: "standby",
+				TaskIdLag:     100,
+				Lag:           durationpb.New(time.Second),
+				Streams:       []*replicationspb.ReplicationStreamSenderStatus{{RemoteShardId: 2, Connected: true, Stuck: true}},
+			},
+			{
+				ShardId:       3,
+				RemoteCluster: "standby",
+				TaskIdLag:     100,
+				Lag:           durationpb.New(time.Hour),
+				Streams:       []*replicationspb.ReplicationStreamSenderStatus{{RemoteShardId: 3}},
+			},
+		},
+		Dlqs: []*adminservice.ReplicationDLQSize{
+			{SourceCluster: "standby", QueueName: "3_standby_active_hash", MessageCount: 7},
+		},
+	}
+}
+
+func runReplicationStatus(t *testing.T, args ...string) string {
+	client := &replicationTestClient{response: newReplicationTestResponse()}
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+	var out bytes.Buffer
+	app.Writer = &out
+
+	require.NoError(t, app.Run(append([]string{"tdbg", "replication", "status"}, args...)))
+	return out.String()
+}
+
+func TestReplicationStatus(t *testing.T) {
+	lines := strings.Split(runReplicationStatus(t), "\n")
+	require.Contains(t, lines[0], "SHARD")
+	require.Contains(t, lines[1], "OK")
+	require.Contains(t, lines[2], "STUCK (ack level not moving)")
+	require.Contains(t, lines[3], "STUCK (stream disconnected, lag above threshold)")
+	require.Contains(t, lines[3], "0/1 connected")
+	require.Contains(t, lines[6], "3_standby_active_hash")
+	require.Contains(t, lines[6], "7")
+}
+
+func TestReplicationStatus_StuckOnly(t *testing.T) {
+	out := runReplicationStatus(t, "--stuck-only", "--lag-threshold", "0")
+	lines := strings.Split(out, "\n")
+	require.Contains(t, lines[1], "STUCK (ack level not moving)")
+	require.Contains(t, lines[2], "STUCK (stream disconnected)")
+	require.NotContains(t, out, "OK")
+}
@@ -3,8 +3,10 @@ package tdbg
 import (
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
@@ -75,6 +77,57 @@ func getCommands(
 			Usage:       "Decode payload",
 			Subcommands: newDecodeCommands(taskBlobEncoder),
 		},
+		{
+			Name:        "outbound",
+			Aliases:     []string{"ob"},
+			Usage:       "Run admin operation on outbound destinations (Nexus endpoints and callbacks)",
+			Subcommands: newAdminOutboundCommands(clientFactory),
+		},
+		{
+			Name:        "nexus-endpoint",
+			Usage:       "Run admin operation on Nexus endpoints",
+			Subcommands: newAdminNexusEndpointCommands(clientFactory),
+		},
+		{
+			Name:        "callback-dlq",
+			Usage:       "Run admin operation on callbacks whose delivery was abandoned",
+			Subcommands: newAdminCallbackDLQCommands(clientFactory),
+		},
+		{
+			Name:        "callback-signing-key",
+			Usage:       "Run admin operation on the keys used to sign outbound completion callbacks",
+			Subcommands: newAdminCallbackSigningKeyCommands(clientFactory),
+		},
+		{
+			Name:        "persistence",
+			Usage:       "Run admin operation on persistence load shedding",
+			Subcommands: newAdminPersistenceCommands(clientFactory),
+		},
+		{
+			Name:        "chaos",
+			Usage:       "Run admin operation on faults injected at runtime into persistence and RPC client calls",
+			Subcommands: newAdminChaosCommands(clientFactory),
+		},
+		{
+			Name:        "replication",
+			Usage:       "Run admin operation on multi-cluster replication",
+			Subcommands: newAdminReplicationCommands(clientFactory),
+		},
+		{
+			Name:        "worker",
+			Usage:       "Run admin operation on workers",
+			Subcommands: newAdminWorkerCommands(clientFactory),
+		},
+		{
+			Name:        "worker-deployment",
+			Usage:       "Run admin operation on Worker Deployments",
+			Subcommands: newAdminWorkerDeploymentCommands(clientFactory),
+		},
+		{
+			Name:        "visibility-backfill",
+			Usage:       "Run admin operation on the backfill of the secondary visibility store",
+			Subcommands: newAdminVisibilityBackfillCommands(clientFactory),
+		},
 	}
 }
 
@@ -322,6 +375,232 @@ func newAdminScheduleCommands(clientFactory ClientFactory) []*cli.Command {
 	}
 }
 
+func newAdminNexusEndpointCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "describe",
+			Usage: "Describe a Nexus endpoint and the health of its outbound destination across history hosts",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagEndpointID,
+					Usage:    "Endpoint ID",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintFullyDetail,
+					Usage: "Also print the health reported by each history host",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeNexusEndpoint(c, clientFactory)
+			},
+		},
+		{
+			Name:  "set-http-target",
+			Usage: "Switch a Nexus endpoint to call a plain HTTP/JSON service",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagEndpointID,
+					Usage:    "Endpoint ID",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:     FlagEndpointVersion,
+					Usage:    "Current endpoint version, used for optimistic concurrency",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagInputFilename,
+					Usage:    "File containing the HTTP target in protobuf JSON format",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateNexusEndpointHTTPTarget(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminCallbackDLQCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "list",
+			Usage: "List dead-lettered callbacks of a namespace",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of callbacks to read per request",
+					Value: 100,
+				},
+				&cli.IntFlag{
+					Name:  FlagMaxMessageCount,
+					Usage: "Maximum number of callbacks to list",
+					Value: 100,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListDeadLetteredCallbacks(c, clientFactory)
+			},
+		},
+		{
+			Name:  "show",
+			Usage: "Show a dead-lettered callback, including the request that was sent",
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:     FlagMessageID,
+					Usage:    "Message ID of the callback in the dead-letter queue",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminShowDeadLetteredCallback(c, clientFactory)
+			},
+		},
+		{
+			Name:  "replay",
+			Usage: "Re-deliver dead-lettered callbacks in order, stopping at the first failure",
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:  FlagLastMessageID,
+					Usage: "Last message ID to re-deliver (inclusive, defaults to all callbacks)",
+					Value: math.MaxInt64,
+				},
+				&cli.BoolFlag{
+					Name:  FlagKeepDelivered,
+					Usage: "Keep delivered callbacks in the dead-letter queue",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminReplayDeadLetteredCallbacks(c, clientFactory)
+			},
+		},
+		{
+			Name:  "purge",
+			Usage: "Delete dead-lettered callbacks without delivering them",
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:     FlagLastMessageID,
+					Usage:    "Last message ID to delete (inclusive)",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPurgeDeadLetteredCallbacks(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminCallbackSigningKeyCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "rotate",
+			Usage: "Add a new signing key; previous keys keep signing callbacks until the overlap ends",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagKeyID,
+					Usage: "ID of the new key (optional, generated when not set)",
+				},
+				&cli.StringFlag{
+					Name:  FlagSecret,
+					Usage: "Base64 encoded secret of the new key (optional, generated when not set)",
+				},
+				&cli.DurationFlag{
+					Name:  FlagOverlap,
+					Usage: "How long previous keys keep signing callbacks alongside the new key",
+					Value: 24 * time.Hour,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRotateCallbackSigningKey(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List the signing keys of a namespace without their secrets",
+			Action: func(c *cli.Context) error {
+				return AdminListCallbackSigningKeys(c, clientFactory)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a signing key; callbacks are sent unsigned once no keys are left",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKeyID,
+					Usage:    "ID of the key to delete",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDeleteCallbackSigningKey(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminOutboundCommands(clientFactory ClientFactory) []*cli.Command {
+	taskGroupFlag := &cli.StringFlag{
+		Name:  FlagTaskGroup,
+		Usage: "Outbound task group, e.g. nexus (optional, defaults to all task groups)",
+	}
+	return []*cli.Command{
+		{
+			Name:  "health",
+			Usage: "Describe circuit breaker state, recent error classes and latencies of outbound destinations",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagDestination,
+					Usage: "Nexus endpoint name or callback destination (optional, defaults to all destinations)",
+				},
+				taskGroupFlag,
+				&cli.BoolFlag{
+					Name:  FlagUnhealthyOnly,
+					Usage: "Only show destinations whose circuit breaker is not closed",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintFullyDetail,
+					Usage: "Also print the health reported by each history host",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeOutboundDestinationHealth(c, clientFactory)
+			},
+		},
+		{
+			Name:  "trip",
+			Usage: "Force the circuit breaker of an outbound destination open on all history hosts until it is reset",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagDestination,
+					Usage:    "Nexus endpoint name or callback destination",
+					Required: true,
+				},
+				taskGroupFlag,
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateOutboundCircuitBreaker(c, clientFactory, true)
+			},
+		},
+		{
+			Name:  "reset",
+			Usage: "Clear a manual trip and restart the circuit breaker of an outbound destination in the closed state",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagDestination,
+					Usage:    "Nexus endpoint name or callback destination",
+					Required: true,
+				},
+				taskGroupFlag,
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateOutboundCircuitBreaker(c, clientFactory, false)
+			},
+		},
+	}
+}
+
 func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRegistry tasks.TaskCategoryRegistry) []*cli.Command {
 	// There are two different categories for the task type, and they have slightly
 	// different semantics. The first is the task category for the list-tasks command,
@@ -990,3 +1269,206 @@ func newDecodeCommands(
 		},
 	}
 }
+
+func newAdminPersistenceCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "concurrency-limit",
+			Usage: "Describe the current persistence concurrency limit and in-flight requests of frontend, history and matching hosts",
+			Action: func(c *cli.Context) error {
+				return AdminDescribePersistenceConcurrencyLimiter(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminChaosCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "enable",
+			Usage: "Inject a fault on all frontend, history and matching hosts, or replace the fault with the same ID",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagFaultID,
+					Usage:    "Fault ID, used to replace or disable the fault",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTarget,
+					Usage: "Calls to inject the fault into, options: persistence, rpc",
+					Value: "persistence",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagService,
+					Usage: "Service making the calls, e.g. history (optional, defaults to all services)",
+				},
+				&cli.StringFlag{
+					Name:  FlagComponent,
+					Usage: "Persistence store, e.g. ExecutionStore, or destination service of RPC calls, e.g. matching (optional)",
+				},
+				&cli.StringFlag{
+					Name:  FlagMethod,
+					Usage: "Persistence or RPC method, e.g. UpdateWorkflowExecution (optional)",
+				},
+				&cli.StringFlag{
+					Name:  FlagNamespaceID,
+					Usage: "Namespace ID of the calls (optional)",
+				},
+				&cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "Shard ID of the persistence calls (optional)",
+				},
+				&cli.StringFlag{
+					Name: FlagErrorType,
+					Usage: "Error to return, options: Unavailable, ResourceExhausted, DeadlineExceeded, " +
+						"and for persistence faults Timeout, ExecuteAndTimeout, ShardOwnershipLost",
+				},
+				&cli.Float64Flag{
+					Name:  FlagErrorRate,
+					Usage: "Fraction of the calls failing with the error, between 0 and 1",
+				},
+				&cli.DurationFlag{
+					Name:  FlagLatency,
+					Usage: "Latency added to the calls",
+				},
+				&cli.Float64Flag{
+					Name:  FlagLatencyRate,
+					Usage: "Fraction of the calls delayed by the latency, between 0 and 1",
+				},
+				&cli.DurationFlag{
+					Name:  FlagExpireAfter,
+					Usage: "Disable the fault automatically after this duration (optional, defaults to never)",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminEnableFault(c, clientFactory)
+			},
+		},
+		{
+			Name:  "disable",
+			Usage: "Stop injecting a fault, or all faults, on all frontend, history and matching hosts",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:  FlagFaultID,
+					Usage: "Fault ID",
+				},
+				&cli.BoolFlag{
+					Name:  FlagAll,
+					Usage: "Disable all faults",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDisableFaults(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List the faults injected on each frontend, history and matching host",
+			Action: func(c *cli.Context) error {
+				return AdminListFaults(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminReplicationCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "status",
+			Usage: "Describe the replication lag of each shard to each remote cluster and the replication DLQ sizes",
+			Flags: []cli.Flag{
+				&cli.DurationFlag{
+					Name:  FlagLagThreshold,
+					Usage: "Mark shards lagging behind by more than this duration as stuck, 0 to disable",
+					Value: 5 * time.Minute,
+				},
+				&cli.BoolFlag{
+					Name:  FlagStuckOnly,
+					Usage: "Only display the shards whose replication is stuck",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print the raw response in JSON format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeReplicationStatus(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminWorkerCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "describe",
+			Usage: "Describe a worker with its recent heartbeats and its liveness",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagWorkerInstanceKey,
+					Usage:    "Instance key of the worker",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeWorker(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminWorkerDeploymentCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "describe-rollout",
+			Usage: "Describe the managed rollout of a Worker Deployment and its history",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagDeploymentName,
+					Usage:    "Name of the Worker Deployment",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeWorkerDeploymentRollout(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminVisibilityBackfillCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "start",
+			Usage: "Start backfilling the visibility records of all executions in the secondary visibility store",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:  FlagConcurrentShardCount,
+					Usage: "Number of shards backfilled in parallel, 0 for the default",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of visibility records backfilled per second, 0 for the default",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of executions loaded from the execution store at a time, 0 for the default",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipVerification,
+					Usage: "Skip verifying the execution counts of both stores once all shards are backfilled",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartVisibilityBackfill(c, clientFactory)
+			},
+		},
+		{
+			Name:  "describe",
+			Usage: "Describe the progress of the last visibility backfill",
+			Action: func(c *cli.Context) error {
+				return AdminDescribeVisibilityBackfill(c, clientFactory)
+			},
+		},
+	}
+}
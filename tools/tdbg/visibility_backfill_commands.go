@@ -0,0 +1,47 @@
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminStartVisibilityBackfill starts backfilling the secondary visibility store
+func AdminStartVisibilityBackfill(c *cli.Context, clientFactory ClientFactory) error {
+	if c.Int(FlagConcurrentShardCount) < 0 || c.Float64(FlagRPS) < 0 || c.Int(FlagPageSize) < 0 {
+		return fmt.Errorf("%s, %s and %s must not be negative", FlagConcurrentShardCount, FlagRPS, FlagPageSize)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.StartVisibilityBackfill(ctx, &adminservice.StartVisibilityBackfillRequest{
+		ConcurrentShardCount: int32(c.Int(FlagConcurrentShardCount)),
+		Rps:                  c.Float64(FlagRPS),
+		PageSize:             int32(c.Int(FlagPageSize)),
+		SkipVerification:     c.Bool(FlagSkipVerification),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start visibility backfill: %w", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminDescribeVisibilityBackfill displays the progress of the last visibility backfill
+func AdminDescribeVisibilityBackfill(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeVisibilityBackfill(ctx, &adminservice.DescribeVisibilityBackfillRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to describe visibility backfill: %w", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
@@ -0,0 +1,63 @@
+package tdbg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type visibilityBackfillTestClient struct {
+	outboundTestClient
+	startRequests    []*adminservice.StartVisibilityBackfillRequest
+	describeRequests int
+}
+
+func (t *visibilityBackfillTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *visibilityBackfillTestClient) StartVisibilityBackfill(_ context.Context, request *adminservice.StartVisibilityBackfillRequest, _ ...grpc.CallOption) (*adminservice.StartVisibilityBackfillResponse, error) {
+	t.startRequests = append(t.startRequests, request)
+	return &adminservice.StartVisibilityBackfillResponse{}, nil
+}
+
+func (t *visibilityBackfillTestClient) DescribeVisibilityBackfill(context.Context, *adminservice.DescribeVisibilityBackfillRequest, ...grpc.CallOption) (*adminservice.DescribeVisibilityBackfillResponse, error) {
+	t.describeRequests++
+	return &adminservice.DescribeVisibilityBackfillResponse{}, nil
+}
+
+func TestVisibilityBackfillStart(t *testing.T) {
+	client := &visibilityBackfillTestClient{}
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	require.NoError(t, app.Run([]string{"tdbg", "visibility-backfill", "start"}))
+	require.NoError(t, app.Run([]string{"tdbg", "visibility-backfill", "start",
+		"--concurrent-shard-count", "8", "--rps", "500", "--pagesize", "50", "--skip-verification"}))
+	require.Error(t, app.Run([]string{"tdbg", "visibility-backfill", "start", "--rps", "-1"}))
+
+	require.Len(t, client.startRequests, 2)
+	require.Zero(t, client.startRequests[0].GetConcurrentShardCount())
+	require.False(t, client.startRequests[0].GetSkipVerification())
+	require.Equal(t, int32(8), client.startRequests[1].GetConcurrentShardCount())
+	require.Equal(t, float64(500), client.startRequests[1].GetRps())
+	require.Equal(t, int32(50), client.startRequests[1].GetPageSize())
+	require.True(t, client.startRequests[1].GetSkipVerification())
+}
+
+func TestVisibilityBackfillDescribe(t *testing.T) {
+	client := &visibilityBackfillTestClient{}
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	require.NoError(t, app.Run([]string{"tdbg", "visibility-backfill", "describe"}))
+	require.Equal(t, 1, client.describeRequests)
+}
@@ -0,0 +1,35 @@
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminDescribeWorker displays a worker of a namespace with its recent heartbeats and its liveness
+func AdminDescribeWorker(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	workerInstanceKey, err := getRequiredOption(c, FlagWorkerInstanceKey)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeWorker(ctx, &adminservice.DescribeWorkerRequest{
+		Namespace:         namespace,
+		WorkerInstanceKey: workerInstanceKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe worker: %w", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
@@ -0,0 +1,39 @@
+package tdbg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type workerTestClient struct {
+	outboundTestClient
+	describeRequests []*adminservice.DescribeWorkerRequest
+}
+
+func (t *workerTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *workerTestClient) DescribeWorker(_ context.Context, request *adminservice.DescribeWorkerRequest, _ ...grpc.CallOption) (*adminservice.DescribeWorkerResponse, error) {
+	t.describeRequests = append(t.describeRequests, request)
+	return &adminservice.DescribeWorkerResponse{Liveness: "Healthy"}, nil
+}
+
+func TestWorkerDescribe(t *testing.T) {
+	client := &workerTestClient{}
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	require.Error(t, app.Run([]string{"tdbg", "--namespace", "ns", "worker", "describe"}))
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "worker", "describe", "--worker-instance-key", "w1"}))
+	require.Len(t, client.describeRequests, 1)
+	require.Equal(t, "ns", client.describeRequests[0].GetNamespace())
+	require.Equal(t, "w1", client.describeRequests[0].GetWorkerInstanceKey())
+}
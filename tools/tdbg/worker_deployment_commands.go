@@ -0,0 +1,35 @@
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminDescribeWorkerDeploymentRollout displays the managed rollout of a Worker Deployment with its history
+func AdminDescribeWorkerDeploymentRollout(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	deploymentName, err := getRequiredOption(c, FlagDeploymentName)
+	if err != nil {
+		return err
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeWorkerDeploymentRollout(ctx, &adminservice.DescribeWorkerDeploymentRolloutRequest{
+		Namespace:      namespace,
+		DeploymentName: deploymentName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe Worker Deployment rollout: %w", err)
+	}
+
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
@@ -0,0 +1,40 @@
+package tdbg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/grpc"
+)
+
+type workerDeploymentTestClient struct {
+	outboundTestClient
+	describeRolloutRequests []*adminservice.DescribeWorkerDeploymentRolloutRequest
+}
+
+func (t *workerDeploymentTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *workerDeploymentTestClient) DescribeWorkerDeploymentRollout(_ context.Context, request *adminservice.DescribeWorkerDeploymentRolloutRequest, _ ...grpc.CallOption) (*adminservice.DescribeWorkerDeploymentRolloutResponse, error) {
+	t.describeRolloutRequests = append(t.describeRolloutRequests, request)
+	return &adminservice.DescribeWorkerDeploymentRolloutResponse{}, nil
+}
+
+func TestWorkerDeploymentDescribeRollout(t *testing.T) {
+	client := &workerDeploymentTestClient{}
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = client
+	})
+	app.ExitErrHandler = func(context *cli.Context, err error) {}
+
+	require.Error(t, app.Run([]string{"tdbg", "--namespace", "ns", "worker-deployment", "describe-rollout"}))
+	require.NoError(t, app.Run([]string{"tdbg", "--namespace", "ns", "worker-deployment", "describe-rollout",
+		"--deployment-name", "d1"}))
+	require.Len(t, client.describeRolloutRequests, 1)
+	require.Equal(t, "ns", client.describeRolloutRequests[0].GetNamespace())
+	require.Equal(t, "d1", client.describeRolloutRequests[0].GetDeploymentName())
+}